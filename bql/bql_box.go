@@ -1,11 +1,13 @@
 package bql
 
 import (
+	"fmt"
 	"github.com/Sirupsen/logrus"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math/rand"
 	"sync"
 	"time"
@@ -42,7 +44,8 @@ type bqlBox struct {
 	// the underlying plan.
 	lastTuple *core.Tuple
 	// lastWriter points to the last writer that was passed to
-	// `Process()`
+	// `Process()`. It is protected by mutex and also used to emit
+	// results of the plan's Tick and Flush methods.
 	lastWriter core.Writer
 	// stopped is an additional flag to signal the time-based emitter
 	// that it should stop emitting items.
@@ -50,6 +53,9 @@ type bqlBox struct {
 	// removeMe is a function to remove this bqlBox from its
 	// topology. A nil check must be done before calling.
 	removeMe func()
+	// numUDFErrors holds the number of errors returned from each
+	// UDF while processing tuples. It is protected by mutex.
+	numUDFErrors map[string]int64
 }
 
 func NewBQLBox(stmt *parser.SelectStmt, reg udf.FunctionRegistry) *bqlBox {
@@ -76,6 +82,9 @@ func (b *bqlBox) Init(ctx *core.Context) error {
 	if b.emitterSamplingType == parser.TimeBasedSampling {
 		go b.timeEmitter(ctx)
 	}
+	if tp, ok := b.execPlan.(execution.TimedPhysicalPlan); ok && tp.TickInterval() > 0 {
+		go b.ticker(ctx, tp)
+	}
 	return nil
 }
 
@@ -96,9 +105,16 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 	// feed tuple into plan
 	resultData, err := b.execPlan.Process(t)
 	if err != nil {
+		b.countUDFError(err)
 		return err
 	}
+	b.lastWriter = s
+	return b.writeResults(ctx, t, resultData, s)
+}
 
+// writeResults emits the given result data as tuples derived from t
+// to the given writer. The caller must hold b.mutex.
+func (b *bqlBox) writeResults(ctx *core.Context, t *core.Tuple, resultData []data.Map, s core.Writer) error {
 	// emit result data as tuples
 	for _, data := range resultData {
 		tup := t.ShallowCopy()
@@ -123,7 +139,6 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 			// when the time-based emitter is used
 			b.timeEmitterMutex.Lock()
 			b.lastTuple = tup
-			b.lastWriter = s
 			b.timeEmitterMutex.Unlock()
 			continue
 		}
@@ -173,6 +188,8 @@ func (b *bqlBox) timeEmitter(ctx *core.Context) {
 		shouldContinue := func() bool {
 			// we need to lock here because we access the `stopped` flag, the
 			// `lastTuple` and `lastWriter` pointer, as well as`emitCount`
+			b.mutex.Lock()
+			defer b.mutex.Unlock()
 			b.timeEmitterMutex.Lock()
 			defer b.timeEmitterMutex.Unlock()
 			// b.stopped is set to true by either
@@ -220,12 +237,220 @@ func (b *bqlBox) timeEmitter(ctx *core.Context) {
 	}
 }
 
+// ticker regularly calls the Tick method of the plan so that results
+// are also emitted when windows time out without new tuples arriving.
+func (b *bqlBox) ticker(ctx *core.Context, plan execution.TimedPhysicalPlan) {
+	ticker := time.NewTicker(plan.TickInterval())
+	defer ticker.Stop()
+	for now := range ticker.C {
+		b.timeEmitterMutex.Lock()
+		stopped := b.stopped
+		b.timeEmitterMutex.Unlock()
+		if stopped {
+			break
+		}
+
+		if err := b.tick(ctx, plan, now); err != nil {
+			if ctx != nil {
+				ctx.ErrLog(err).WithField("node_type", "box").
+					Error("Cannot emit the results of timed out windows")
+			}
+		}
+	}
+}
+
+func (b *bqlBox) tick(ctx *core.Context, plan execution.TimedPhysicalPlan, now time.Time) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	// there can only be results if a tuple has been processed
+	if b.lastWriter == nil {
+		return nil
+	}
+	b.timeEmitterMutex.Lock()
+	overLimit := b.emitterLimit >= 0 && b.emitCount >= b.emitterLimit
+	b.timeEmitterMutex.Unlock()
+	if overLimit {
+		return nil
+	}
+
+	resultData, err := plan.Tick(now)
+	if err != nil {
+		b.countUDFError(err)
+		return err
+	}
+	if len(resultData) == 0 {
+		return nil
+	}
+	t := core.NewTuple(data.Map{})
+	t.Timestamp = now
+	t.ProcTimestamp = now
+	return b.writeResults(ctx, t, resultData, b.lastWriter)
+}
+
+// countUDFError increments the error count of the UDF if err was returned
+// from it. The caller must hold b.mutex.
+func (b *bqlBox) countUDFError(err error) {
+	e, ok := err.(*execution.UDFError)
+	if !ok {
+		return
+	}
+	if b.numUDFErrors == nil {
+		b.numUDFErrors = map[string]int64{}
+	}
+	b.numUDFErrors[e.Function]++
+}
+
+// Status returns the number of errors returned from each UDF.
+func (b *bqlBox) Status() data.Map {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return data.Map{
+		"num_udf_errors": udfErrorsToMap(b.numUDFErrors),
+	}
+}
+
+func udfErrorsToMap(errs map[string]int64) data.Map {
+	m := make(data.Map, len(errs))
+	for name, n := range errs {
+		m[name] = data.Int(n)
+	}
+	return m
+}
+
+// SaveCheckpoint returns the tuples held in the windows of the execution plan
+// and the state of the windows along with the counters of the emitter.
+func (b *bqlBox) SaveCheckpoint(ctx *core.Context) (data.Value, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	m, err := b.saveWindows()
+	if err != nil {
+		return nil, err
+	}
+
+	b.timeEmitterMutex.Lock()
+	defer b.timeEmitterMutex.Unlock()
+	m["gen_count"] = data.Int(b.genCount)
+	m["emit_count"] = data.Int(b.emitCount)
+	return m, nil
+}
+
+// LoadCheckpoint restores the windows of the execution plan from the tuples
+// and the state returned by SaveCheckpoint.
+func (b *bqlBox) LoadCheckpoint(ctx *core.Context, v data.Value) error {
+	m, err := data.AsMap(v)
+	if err != nil {
+		return err
+	}
+	genCount, err := data.AsInt(m["gen_count"])
+	if err != nil {
+		return err
+	}
+	emitCount, err := data.AsInt(m["emit_count"])
+	if err != nil {
+		return err
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if err := b.restoreWindows(m); err != nil {
+		return err
+	}
+	b.genCount = genCount
+	b.timeEmitterMutex.Lock()
+	b.emitCount = emitCount
+	b.timeEmitterMutex.Unlock()
+	return nil
+}
+
+// saveWindows returns the tuples held in the windows of the execution plan
+// and the state of the windows that cannot be derived from them. The caller
+// must hold b.mutex.
+func (b *bqlBox) saveWindows() (data.Map, error) {
+	p, ok := b.execPlan.(execution.CheckpointablePhysicalPlan)
+	if !ok {
+		return nil, fmt.Errorf("the execution plan of the statement cannot be checkpointed")
+	}
+	tuples, windows, err := p.WindowTuples()
+	if err != nil {
+		return nil, err
+	}
+	m := data.Map{
+		"tuples": tuplesToArray(tuples),
+	}
+	if windows != nil {
+		m["windows"] = windows
+	}
+	return m, nil
+}
+
+// restoreWindows restores the windows of the execution plan from the tuples
+// and the state returned by saveWindows. The results are discarded because
+// they had already been emitted when the tuples were processed for the first
+// time. The caller must hold b.mutex.
+func (b *bqlBox) restoreWindows(m data.Map) error {
+	tuples, err := arrayToTuples(m["tuples"])
+	if err != nil {
+		return err
+	}
+	var windows data.Map
+	if w, ok := m["windows"]; ok {
+		if windows, err = data.AsMap(w); err != nil {
+			return err
+		}
+	}
+	p, ok := b.execPlan.(execution.CheckpointablePhysicalPlan)
+	if !ok {
+		return fmt.Errorf("the execution plan of the statement cannot be checkpointed")
+	}
+	return p.RestoreWindows(tuples, windows)
+}
+
 func (b *bqlBox) Terminate(ctx *core.Context) error {
+	// emit the results of tuples the plan still holds back. the
+	// destinations of the box are closed after Terminate returns.
+	var err error
+	if fp, ok := b.execPlan.(execution.FlushablePhysicalPlan); ok {
+		err = b.flush(ctx, fp)
+	}
+
 	// signal to the time-based emitter that it should stop
 	b.timeEmitterMutex.Lock()
 	b.stopped = true
 	b.timeEmitterMutex.Unlock()
-	return nil
+	return err
+}
+
+// flush emits the results of the tuples held back by the plan when
+// no more tuples will arrive.
+func (b *bqlBox) flush(ctx *core.Context, plan execution.FlushablePhysicalPlan) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	// there can only be results if a tuple has been processed
+	if b.lastWriter == nil {
+		return nil
+	}
+	b.timeEmitterMutex.Lock()
+	overLimit := b.emitterLimit >= 0 && b.emitCount >= b.emitterLimit
+	b.timeEmitterMutex.Unlock()
+	if overLimit {
+		return nil
+	}
+
+	resultData, err := plan.Flush()
+	if err != nil {
+		b.countUDFError(err)
+		return err
+	}
+	if len(resultData) == 0 {
+		return nil
+	}
+	now := time.Now()
+	t := core.NewTuple(data.Map{})
+	t.Timestamp = now
+	t.ProcTimestamp = now
+	return b.writeResults(ctx, t, resultData, b.lastWriter)
 }
 
 func (b *bqlBox) callRemoveMeIgnoringPanic() {
@@ -270,6 +270,59 @@ func TestBQLBoxEmitterParams(t *testing.T) {
 		})
 	})
 
+	Convey("Given a BQL statement with a SESSION GAP window", t, func() {
+		s := "CREATE STREAM box AS SELECT " +
+			"RSTREAM count(*) AS c, sum(int) AS s FROM source [SESSION GAP 10 SECONDS]"
+		tb, err := setupTopology(s, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+
+			Convey("Then the session is closed by the timer", func() {
+				// the tuples' timestamps are far in the past, so the
+				// session times out as soon as the timer fires
+				si.Wait(1)
+				So(si.len(), ShouldEqual, 1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"c": data.Int(4), "s": data.Int(10)})
+			})
+		})
+	})
+
+	Convey("Given a BQL statement with a LATENESS", t, func() {
+		s := "CREATE STREAM box AS SELECT " +
+			"ISTREAM int FROM source [RANGE 10 SECONDS, LATENESS 1 SECONDS]"
+		tb, err := setupTopology(s, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			// the last tuple is held back until the watermark passes it
+			si.Wait(3)
+			So(si.len(), ShouldEqual, 3)
+
+			Convey("Then the last tuple should be emitted when the topology stops", func() {
+				So(dt.Stop(), ShouldBeNil)
+				So(si.len(), ShouldEqual, 4)
+				So(si.get(3).Data, ShouldResemble, data.Map{"int": data.Int(4)})
+			})
+		})
+	})
+
 	// This test expects that "box" receives all tuples generated from "source"
 	// within 1ms. Because timing and speed of execution vary every time, this
 	// test sometimes fails. To avoid such occasional failures that disturb CI,
@@ -655,6 +708,36 @@ func TestBQLBoxGroupByCapability(t *testing.T) {
 	})
 }
 
+func TestBQLBoxUDFErrors(t *testing.T) {
+	Convey("Given a BQL statement calling a UDF which fails for some tuples", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM
+			abs(CASE WHEN int % 2 = 0 THEN int ELSE "a" END) AS x FROM source [RANGE 1 TUPLES]`
+		tb, err := setupTopology(s, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			si.Wait(2)
+
+			Convey("Then the status of the box should have the number of errors", func() {
+				bn, err := dt.Box("box")
+				So(err, ShouldBeNil)
+				st := bn.Status()
+				v, err := st.Get(data.MustCompilePath("box.num_udf_errors.abs"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(2))
+			})
+		})
+	})
+}
+
 func TestBQLBoxUDSF(t *testing.T) {
 	Convey("Given a topology using UDSF", t, func() {
 		tb, err := setupTopology(`CREATE STREAM box AS SELECT RSTREAM duplicate:int FROM duplicate("source", 3) [RANGE 1 TUPLES]`, false)
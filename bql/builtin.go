@@ -1,9 +1,6 @@
 package bql
 
 import (
-	"bufio"
-	"bytes"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
@@ -40,6 +37,7 @@ func init() {
 
 type readerSource struct {
 	filename string
+	format   *fileFormat
 	tsField  data.Path
 	ioParams *IOParams
 
@@ -54,51 +52,68 @@ type readerSource struct {
 	// tuples as fast as possible.
 	interval time.Duration
 	stopCh   chan struct{}
+
+	m sync.Mutex
+
+	// offset is the offset of the next tuple to be written in the current
+	// pass over the input.
+	offset int64
+
+	// start is the position from which the next call of GenerateStream
+	// starts. It's set by SetStartPosition.
+	start *core.SeekPosition
 }
 
+var (
+	_ core.Seeker = &readerSource{}
+)
+
 func (s *readerSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	s.m.Lock()
+	start := s.start
+	s.start = nil
+	s.m.Unlock()
+
 	for r := int64(0); s.repeat < 0 || r <= s.repeat; r++ {
-		if err := s.generateStream(ctx, w); err != nil {
+		// Only the first pass starts from the given position.
+		if err := s.generateStream(ctx, w, start); err != nil {
 			return err
 		}
+		start = nil
 	}
 	return nil
 }
 
-func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
-	f, err := os.Open(s.filename)
+func (s *readerSource) generateStream(ctx *core.Context, w core.Writer, start *core.SeekPosition) error {
+	s.setOffset(0)
+	r, c, err := s.format.openReader(s.filename)
 	if err != nil {
 		return err
 	}
 	defer func() {
-		if err := f.Close(); err != nil {
+		if err := c.Close(); err != nil {
 			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
 				Warning("Cannot close the file")
 		}
 	}()
 
-	r := bufio.NewReader(f)
+	dec := s.format.newDecoder(r)
 	next := time.Now()
-	for lineNumber := 0; ; lineNumber++ {
-		line, err := r.ReadBytes('\n')
-		if err != nil && err != io.EOF {
-			return err
-		}
-
-		line = bytes.TrimSpace(line)
-		if len(line) == 0 {
+	offset := int64(0)
+	for {
+		m, err := dec.Decode()
+		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			continue
-		}
-
-		m := data.Map{}
-		if err := json.Unmarshal(line, &m); err != nil {
-			ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-				WithField("jsonl_line_number", lineNumber).
-				WithField("body", string(line)).Warning("Ignoring the line due to a json parse error")
-			continue
+			if re, ok := err.(*recordError); ok {
+				posName, pos := dec.Position()
+				ctx.ErrLog(re.err).WithField("node_name", s.ioParams.Name).
+					WithField(posName, pos).
+					WithField("body", re.body).Warning("Ignoring the record due to a parse error")
+				continue
+			}
+			return err
 		}
 
 		t := core.NewTuple(m)
@@ -110,8 +125,9 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 		if s.tsField != nil {
 			if v, err := t.Data.Get(s.tsField); err == nil {
 				if ts, err := data.ToTimestamp(v); err != nil {
+					posName, pos := dec.Position()
 					ctx.ErrLog(err).WithField("node_name", s.ioParams.Name).
-						WithField("jsonl_line_number", lineNumber).
+						WithField(posName, pos).
 						WithField("timestamp_field", s.tsField).
 						WithField("timestamp_field_value", v).
 						Warning("Cannot convert a value in timestamp_field to a timestamp")
@@ -121,9 +137,26 @@ func (s *readerSource) generateStream(ctx *core.Context, w core.Writer) error {
 			}
 		}
 
+		if start != nil {
+			if start.Timestamp.IsZero() {
+				if offset < start.Offset {
+					offset++
+					s.setOffset(offset)
+					continue
+				}
+			} else if t.Timestamp.Before(start.Timestamp) {
+				offset++
+				s.setOffset(offset)
+				continue
+			}
+			start = nil // all following tuples are written
+		}
+
 		if err := w.Write(ctx, t); err != nil {
 			return err
 		}
+		offset++
+		s.setOffset(offset)
 
 		if s.interval > 0 {
 			// wait as accurate as possible
@@ -151,14 +184,43 @@ func (s *readerSource) Stop(ctx *core.Context) error {
 	return nil
 }
 
-func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
-	// TODO: add format parameter
+func (s *readerSource) setOffset(o int64) {
+	s.m.Lock()
+	s.offset = o
+	s.m.Unlock()
+}
+
+func (s *readerSource) Offset() int64 {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.offset
+}
+
+func (s *readerSource) SetStartPosition(pos core.SeekPosition) error {
+	if pos.Offset < 0 {
+		return fmt.Errorf("the offset must not be negative: %v", pos.Offset)
+	}
+	if !pos.Timestamp.IsZero() && s.tsField == nil {
+		return errors.New("the source cannot seek to a timestamp without 'timestamp_field' parameter")
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.start = &pos
+	return nil
+}
 
+func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
 	fpath, err := extractPathParameter(params)
 	if err != nil {
 		return nil, err
 	}
 
+	format, err := parseFileFormat(params)
+	if err != nil {
+		return nil, err
+	}
+
 	rewindable := false
 	if v, ok := params["rewindable"]; ok {
 		r, err := data.AsBool(v)
@@ -198,6 +260,7 @@ func createFileSource(ctx *core.Context, ioParams *IOParams, params data.Map) (c
 	}
 	s := &readerSource{
 		filename: fpath,
+		format:   format,
 		tsField:  tsField,
 		ioParams: ioParams,
 		repeat:   repeat,
@@ -231,25 +294,32 @@ func init() {
 type writerSink struct {
 	m           sync.Mutex
 	w           io.Writer
+	enc         tupleEncoder
 	shouldClose bool
+	// stopFlusher stops the goroutine flushing compressed data
+	// periodically. It is nil if there's no such goroutine.
+	stopFlusher chan struct{}
 }
 
 func (s *writerSink) Write(ctx *core.Context, t *core.Tuple) error {
-	// TODO: support custom formatting. There're several things that need to
-	// be considered such as concurrent formatting, zero-copy write, and so on.
-	// While encoding tuples outside the lock supports concurrent formatting,
-	// it makes it difficult to support zero-copy write.
-
-	js := t.Data.String() // Format this outside the lock
+	// TODO: support concurrent formatting. Tuples are encoded inside the
+	// lock because some formats such as CSV have a header which has to be
+	// written before the first tuple. Encoding tuples outside the lock would
+	// also make it difficult to support zero-copy write.
 
-	// This lock is required to avoid interleaving JSONs.
+	// This lock is required to avoid interleaving records.
 	s.m.Lock()
 	defer s.m.Unlock()
 	if s.w == nil {
 		return errors.New("the sink is already closed")
 	}
-	_, err := fmt.Fprintln(s.w, js)
-	return err
+	if err := s.enc.Encode(t.Data); err != nil {
+		return err
+	}
+	if g, ok := s.w.(*gzipFileWriter); ok {
+		return g.endRecord()
+	}
+	return nil
 }
 
 func (s *writerSink) Close(ctx *core.Context) error {
@@ -258,6 +328,10 @@ func (s *writerSink) Close(ctx *core.Context) error {
 	if s.w == nil {
 		return nil
 	}
+	if s.stopFlusher != nil {
+		close(s.stopFlusher)
+		s.stopFlusher = nil
+	}
 	if s.shouldClose {
 		if c, ok := s.w.(io.Closer); ok {
 			return c.Close()
@@ -266,24 +340,57 @@ func (s *writerSink) Close(ctx *core.Context) error {
 	return nil
 }
 
+// flushPeriodically flushes compressed data of the records written since
+// the last flush every gzipFlushInterval so that the records don't stay in
+// the buffer of the gzip.Writer while no more tuples arrive.
+func (s *writerSink) flushPeriodically(ctx *core.Context, g *gzipFileWriter, name string, stop <-chan struct{}) {
+	ticker := time.NewTicker(gzipFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		err := func() error {
+			s.m.Lock()
+			defer s.m.Unlock()
+			select {
+			case <-stop: // the sink has been closed while waiting for the lock
+				return nil
+			default:
+			}
+			return g.flushRecords()
+		}()
+		if err != nil {
+			ctx.ErrLog(err).WithField("node_name", name).
+				Error("Cannot flush compressed data to the file")
+		}
+	}
+}
+
 func createStdoutSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	return &writerSink{
-		w: os.Stdout,
+		w:   os.Stdout,
+		enc: &jsonlEncoder{w: os.Stdout},
 	}, nil
 }
 
 func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Sink, error) {
 	// TODO: currently this sink isn't secure because it accepts any path.
 	// TODO: support buffering
-	// TODO: provide "format" parameter to support output formats other than "jsonl".
-	//       "jsonl" should be the default value.
-	// TODO: support "compression" parameter with values like "gz".
 
 	fpath, err := extractPathParameter(params)
 	if err != nil {
 		return nil, err
 	}
 
+	format, err := parseFileFormat(params)
+	if err != nil {
+		return nil, err
+	}
+
 	flags := os.O_WRONLY | os.O_APPEND | os.O_CREATE
 	if v, ok := params["truncate"]; ok {
 		t, err := data.AsBool(v)
@@ -299,10 +406,32 @@ func createFileSink(ctx *core.Context, ioParams *IOParams, params data.Map) (cor
 	if err != nil {
 		return nil, err
 	}
-	return &writerSink{
-		w:           file,
+	st, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	var w io.Writer = file
+	var g *gzipFileWriter
+	if format.compression == "gzip" {
+		// Appending to a gzip file creates a multistream gzip file, which
+		// can still be read by the file source.
+		g = newGzipFileWriter(file)
+		w = g
+	}
+	s := &writerSink{
+		w: w,
+		// A CSV header is only written to an empty file so that appending
+		// tuples to an existing file doesn't produce multiple headers.
+		enc:         format.newEncoder(w, st.Size() == 0),
 		shouldClose: true,
-	}, nil
+	}
+	if g != nil {
+		s.stopFlusher = make(chan struct{})
+		go s.flushPeriodically(ctx, g, ioParams.Name, s.stopFlusher)
+	}
+	return s, nil
 }
 
 func init() {
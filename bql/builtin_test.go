@@ -139,6 +139,22 @@ func TestFileSource(t *testing.T) {
 				}
 			})
 
+			Convey("Then it should be able to seek to an offset", func() {
+				w.wait(3)
+				ss := s.(core.SeekableSource)
+				So(ss.Offset(), ShouldEqual, 3)
+				So(ss.Seek(ctx, core.SeekPosition{Offset: 2}), ShouldBeNil)
+				w.wait(4)
+				So(w.cnt, ShouldEqual, 4)
+				So(ss.Offset(), ShouldEqual, 3)
+			})
+
+			Convey("Then it should fail to seek to a timestamp", func() {
+				// timestamp_field isn't given
+				ss := s.(core.SeekableSource)
+				So(ss.Seek(ctx, core.SeekPosition{Timestamp: now}), ShouldNotBeNil)
+			})
+
 			Convey("Then it should be able to stop", func() {
 				So(s.Stop(ctx), ShouldBeNil)
 				err := <-ch
@@ -146,6 +162,29 @@ func TestFileSource(t *testing.T) {
 			})
 		})
 
+		Convey("When reading the file restored from a checkpoint", func() {
+			s, err := createFileSource(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Stop(ctx)
+			})
+
+			c := s.(core.Checkpointable)
+			So(c.LoadCheckpoint(ctx, data.Int(1)), ShouldBeNil)
+			err = s.GenerateStream(ctx, w)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit tuples after the offset", func() {
+				So(w.cnt, ShouldEqual, 2)
+			})
+
+			Convey("Then its checkpoint should have the offset of the next tuple", func() {
+				v, err := c.SaveCheckpoint(ctx)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(3))
+			})
+		})
+
 		Convey("When reading the file with a repeat parameter", func() {
 			params["repeat"] = data.Int(3)
 			s, err := createFileSource(ctx, &IOParams{}, params)
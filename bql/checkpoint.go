@@ -0,0 +1,496 @@
+package bql
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+const (
+	// checkpointStateName is the state name under which checkpoints are
+	// written to the UDS storage.
+	checkpointStateName = "sensorbee_checkpoint"
+
+	// checkpointVersion is the version of the format of checkpoints.
+	checkpointVersion = 1
+
+	// checkpointTimestampKey is the key of a map representing a
+	// data.Timestamp in a saved checkpoint. Timestamps are stored in this
+	// form because msgpack only keeps them as seconds.
+	checkpointTimestampKey = ":sensorbee:timestamp"
+
+	// DefaultCheckpointTimeout is the default maximum time to wait for a
+	// topology to become quiescent when taking a checkpoint.
+	DefaultCheckpointTimeout = 10 * time.Second
+)
+
+var (
+	inputStatsPath  = data.MustCompilePath("input_stats")
+	outputStatsPath = data.MustCompilePath("output_stats")
+)
+
+// Checkpoint is a consistent snapshot of the states and the nodes of a
+// topology.
+type Checkpoint struct {
+	// Timestamp is the time when the checkpoint was taken.
+	Timestamp time.Time
+
+	// States has the data of all core.SavableSharedStates in the topology,
+	// keyed by the name of each state.
+	States map[string]*CheckpointedState
+
+	// Nodes has the values returned from core.Checkpointable.SaveCheckpoint
+	// of Sources and Boxes in the topology, keyed by the name of each node.
+	Nodes data.Map
+}
+
+// CheckpointedState has the data written by core.SavableSharedState.Save.
+type CheckpointedState struct {
+	TypeName string
+	Data     []byte
+}
+
+// TakeCheckpoint takes a checkpoint of the given topology. It pauses all
+// running sources and waits until no tuple is being processed in the
+// topology so that the states of all nodes are consistent with each other.
+// The sources are resumed before TakeCheckpoint returns. It fails when the
+// topology doesn't become quiescent within the timeout.
+//
+// It also fails when a node which implements core.Checkpointable cannot save
+// its state because a checkpoint without the state of the node couldn't
+// restore the topology correctly.
+func TakeCheckpoint(t core.Topology, timeout time.Duration) (*Checkpoint, error) {
+	ctx := t.Context()
+	var paused []core.SourceNode
+	defer func() {
+		for _, sn := range paused {
+			if err := sn.Resume(); err != nil {
+				ctx.ErrLog(err).WithField("node_name", sn.Name()).
+					Error("Cannot resume the source paused for a checkpoint")
+			}
+		}
+	}()
+	for _, sn := range t.Sources() {
+		if sn.State().Get() != core.TSRunning {
+			continue
+		}
+		if err := sn.Pause(); err != nil {
+			return nil, err
+		}
+		paused = append(paused, sn)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		counts, err := waitForQuiescence(t, deadline)
+		if err != nil {
+			return nil, err
+		}
+		cp, err := snapshot(t)
+		if err != nil {
+			return nil, err
+		}
+
+		// The snapshot is only consistent when no tuple has moved
+		// while it was taken.
+		if c, q := tupleCounts(t); q && data.Equal(c, counts) {
+			return cp, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the topology didn't become quiescent within %v", timeout)
+		}
+	}
+}
+
+// waitForQuiescence waits until no tuple is queued in the topology and the
+// numbers of tuples received and sent by nodes stop changing. It returns
+// those numbers.
+func waitForQuiescence(t core.Topology, deadline time.Time) (data.Map, error) {
+	prev, prevQuiescent := tupleCounts(t)
+	for {
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the topology didn't become quiescent")
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		c, q := tupleCounts(t)
+		if q && prevQuiescent && data.Equal(c, prev) {
+			return c, nil
+		}
+		prev, prevQuiescent = c, q
+	}
+}
+
+// tupleCounts returns the numbers of tuples received and sent by each node
+// of the topology. quiescent is false when a tuple is queued in front of
+// any node.
+func tupleCounts(t core.Topology) (counts data.Map, quiescent bool) {
+	counts = data.Map{}
+	quiescent = true
+	for name, n := range t.Nodes() {
+		st := n.Status()
+		c := data.Map{}
+		if v, err := st.Get(inputStatsPath); err == nil {
+			in, _ := data.AsMap(v)
+			c["received"] = in["num_received_total"]
+			if q, ok := in["num_queued_in_partitions"]; ok && !data.Equal(q, data.Int(0)) {
+				quiescent = false
+			}
+			inputs, _ := data.AsMap(in["inputs"])
+			for _, i := range inputs {
+				m, _ := data.AsMap(i)
+				if !data.Equal(m["num_queued"], data.Int(0)) {
+					quiescent = false
+				}
+			}
+		}
+		if v, err := st.Get(outputStatsPath); err == nil {
+			out, _ := data.AsMap(v)
+			c["sent"] = out["num_sent_total"]
+		}
+		counts[name] = c
+	}
+	return
+}
+
+// snapshot saves the states and the nodes of the topology.
+func snapshot(t core.Topology) (*Checkpoint, error) {
+	ctx := t.Context()
+	cp := &Checkpoint{
+		Timestamp: time.Now().In(time.UTC),
+		States:    map[string]*CheckpointedState{},
+		Nodes:     data.Map{},
+	}
+
+	states, err := ctx.SharedStates.List()
+	if err != nil {
+		return nil, err
+	}
+	for name, s := range states {
+		ss, ok := s.(core.SavableSharedState)
+		if !ok {
+			continue
+		}
+		typeName, err := ctx.SharedStates.Type(name)
+		if err != nil {
+			if core.IsNotExist(err) { // removed after List was called
+				continue
+			}
+			return nil, err
+		}
+		buf := bytes.NewBuffer(nil)
+		if err := ss.Save(ctx, buf, data.Map{}); err != nil {
+			return nil, fmt.Errorf("cannot save the state '%v': %v", name, err)
+		}
+		cp.States[name] = &CheckpointedState{
+			TypeName: typeName,
+			Data:     buf.Bytes(),
+		}
+	}
+
+	for name, n := range t.Nodes() {
+		c := checkpointableOf(n)
+		if c == nil || n.State().Get() >= core.TSStopping {
+			continue
+		}
+		v, err := c.SaveCheckpoint(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot save the node '%v': %v", name, err)
+		}
+		cp.Nodes[name] = v
+	}
+	return cp, nil
+}
+
+// checkpointableOf returns the Source or the Box of the node if it
+// implements core.Checkpointable. Otherwise, it returns nil.
+func checkpointableOf(n core.Node) core.Checkpointable {
+	var v interface{}
+	switch n := n.(type) {
+	case core.SourceNode:
+		v = n.Source()
+	case core.BoxNode:
+		v = n.Box()
+	}
+	c, _ := v.(core.Checkpointable)
+	return c
+}
+
+// Save writes the checkpoint of the topology to the storage. It replaces
+// the checkpoint previously saved.
+func (cp *Checkpoint) Save(s udf.UDSStorage, topologyName string) error {
+	states := data.Map{}
+	for name, st := range cp.States {
+		states[name] = data.Map{
+			"type": data.String(st.TypeName),
+			"data": data.Blob(st.Data),
+		}
+	}
+	b, err := data.MarshalMsgpack(encodeTimestamps(data.Map{
+		"version":   data.Int(checkpointVersion),
+		"timestamp": data.Timestamp(cp.Timestamp),
+		"states":    states,
+		"nodes":     cp.Nodes,
+	}).(data.Map))
+	if err != nil {
+		return err
+	}
+
+	w, err := s.Save(topologyName, checkpointStateName, "")
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		w.Abort()
+		return err
+	}
+	return w.Commit()
+}
+
+// LoadCheckpoint reads the last checkpoint of the topology from the storage.
+// It returns an error satisfying core.IsNotExist when no checkpoint has been
+// saved.
+func LoadCheckpoint(s udf.UDSStorage, topologyName string) (*Checkpoint, error) {
+	r, err := s.Load(topologyName, checkpointStateName, "")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := data.UnmarshalMsgpack(b)
+	if err != nil {
+		return nil, err
+	}
+	m := decodeTimestamps(raw).(data.Map)
+
+	if v, err := data.AsInt(m["version"]); err != nil {
+		return nil, err
+	} else if v != checkpointVersion {
+		return nil, fmt.Errorf("unsupported checkpoint version: %v", v)
+	}
+	cp := &Checkpoint{
+		States: map[string]*CheckpointedState{},
+	}
+	if cp.Timestamp, err = data.AsTimestamp(m["timestamp"]); err != nil {
+		return nil, err
+	}
+	states, err := data.AsMap(m["states"])
+	if err != nil {
+		return nil, err
+	}
+	for name, v := range states {
+		st, err := data.AsMap(v)
+		if err != nil {
+			return nil, err
+		}
+		typeName, err := data.AsString(st["type"])
+		if err != nil {
+			return nil, err
+		}
+		// data.UnmarshalMsgpack decodes binaries as strings
+		var b []byte
+		switch d := st["data"].(type) {
+		case data.Blob:
+			b = d
+		case data.String:
+			b = []byte(d)
+		default:
+			return nil, fmt.Errorf("the data of the state '%v' isn't a blob", name)
+		}
+		cp.States[name] = &CheckpointedState{
+			TypeName: typeName,
+			Data:     b,
+		}
+	}
+	if cp.Nodes, err = data.AsMap(m["nodes"]); err != nil {
+		return nil, err
+	}
+	return cp, nil
+}
+
+// encodeTimestamps replaces all data.Timestamp values in v with maps having
+// the time in nanoseconds so that they're restored precisely.
+func encodeTimestamps(v data.Value) data.Value {
+	switch v := v.(type) {
+	case data.Timestamp:
+		return data.Map{checkpointTimestampKey: data.Int(time.Time(v).UnixNano())}
+	case data.Map:
+		m := make(data.Map, len(v))
+		for k, e := range v {
+			m[k] = encodeTimestamps(e)
+		}
+		return m
+	case data.Array:
+		a := make(data.Array, len(v))
+		for i, e := range v {
+			a[i] = encodeTimestamps(e)
+		}
+		return a
+	default:
+		return v
+	}
+}
+
+// decodeTimestamps reverts encodeTimestamps.
+func decodeTimestamps(v data.Value) data.Value {
+	switch v := v.(type) {
+	case data.Map:
+		if ns, ok := v[checkpointTimestampKey]; ok && len(v) == 1 {
+			if i, err := data.AsInt(ns); err == nil {
+				return data.Timestamp(time.Unix(0, i).In(time.UTC))
+			}
+		}
+		m := make(data.Map, len(v))
+		for k, e := range v {
+			m[k] = decodeTimestamps(e)
+		}
+		return m
+	case data.Array:
+		a := make(data.Array, len(v))
+		for i, e := range v {
+			a[i] = decodeTimestamps(e)
+		}
+		return a
+	default:
+		return v
+	}
+}
+
+// DeleteCheckpoint deletes the checkpoint of the topology saved in the
+// storage. It returns an error satisfying core.IsNotExist when no checkpoint
+// has been saved.
+func DeleteCheckpoint(s udf.UDSStorage, topologyName string) error {
+	d, ok := s.(udf.UDSStorageDeleter)
+	if !ok {
+		return fmt.Errorf("the UDS storage cannot delete checkpoints")
+	}
+	return d.Delete(topologyName, checkpointStateName, "")
+}
+
+// Checkpointer periodically takes checkpoints of a topology and saves them
+// to the UDS storage of the TopologyBuilder.
+type Checkpointer struct {
+	tb       *TopologyBuilder
+	interval time.Duration
+
+	// Timeout is the maximum time to wait for the topology to become
+	// quiescent. It's DefaultCheckpointTimeout by default.
+	Timeout time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// NewCheckpointer creates a Checkpointer which takes a checkpoint of the
+// topology of the given TopologyBuilder every interval after Start is called.
+func NewCheckpointer(tb *TopologyBuilder, interval time.Duration) *Checkpointer {
+	return &Checkpointer{
+		tb:       tb,
+		interval: interval,
+		Timeout:  DefaultCheckpointTimeout,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start starts taking checkpoints in background. It stops when Stop is
+// called or the topology is stopped.
+func (c *Checkpointer) Start() {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-c.stop:
+				return
+			case <-ticker.C:
+			}
+			if c.tb.Topology().State().Get() >= core.TSStopping {
+				return
+			}
+
+			if err := c.Checkpoint(); err != nil {
+				c.tb.Topology().Context().ErrLog(err).
+					Error("Cannot take a checkpoint of the topology")
+			}
+		}
+	}()
+}
+
+// Checkpoint takes a checkpoint of the topology and saves it.
+func (c *Checkpointer) Checkpoint() error {
+	t := c.tb.Topology()
+	cp, err := TakeCheckpoint(t, c.Timeout)
+	if err != nil {
+		return err
+	}
+	return cp.Save(c.tb.UDSStorage, t.Name())
+}
+
+// Stop stops taking checkpoints. It waits until the checkpoint being taken,
+// if any, is saved.
+func (c *Checkpointer) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+	c.wg.Wait()
+}
+
+// tuplesToArray converts tuples to a data.Array so that they can be included
+// in a checkpoint.
+func tuplesToArray(tuples []*core.Tuple) data.Array {
+	a := make(data.Array, len(tuples))
+	for i, t := range tuples {
+		a[i] = data.Map{
+			"data":           t.Data,
+			"input_name":     data.String(t.InputName),
+			"timestamp":      data.Timestamp(t.Timestamp),
+			"proc_timestamp": data.Timestamp(t.ProcTimestamp),
+			"batch_id":       data.Int(t.BatchID),
+		}
+	}
+	return a
+}
+
+// arrayToTuples converts a data.Array created by tuplesToArray to tuples.
+func arrayToTuples(v data.Value) ([]*core.Tuple, error) {
+	a, err := data.AsArray(v)
+	if err != nil {
+		return nil, err
+	}
+	tuples := make([]*core.Tuple, len(a))
+	for i, e := range a {
+		m, err := data.AsMap(e)
+		if err != nil {
+			return nil, err
+		}
+		t := &core.Tuple{}
+		if t.Data, err = data.AsMap(m["data"]); err != nil {
+			return nil, err
+		}
+		if t.InputName, err = data.AsString(m["input_name"]); err != nil {
+			return nil, err
+		}
+		if t.Timestamp, err = data.AsTimestamp(m["timestamp"]); err != nil {
+			return nil, err
+		}
+		if t.ProcTimestamp, err = data.AsTimestamp(m["proc_timestamp"]); err != nil {
+			return nil, err
+		}
+		if t.BatchID, err = data.AsInt(m["batch_id"]); err != nil {
+			return nil, err
+		}
+		tuples[i] = t
+	}
+	return tuples, nil
+}
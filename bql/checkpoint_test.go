@@ -0,0 +1,302 @@
+package bql
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+	"time"
+)
+
+// offsetSource emits tuples having "int" from offset+1 to num and reports
+// the offset of the next tuple in its checkpoint. It doesn't stop until
+// Stop is called.
+type offsetSource struct {
+	num      int64
+	m        sync.Mutex
+	offset   int64
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (s *offsetSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for {
+		s.m.Lock()
+		i := s.offset
+		s.m.Unlock()
+		if i >= s.num {
+			break
+		}
+		if err := w.Write(ctx, core.NewTuple(data.Map{"int": data.Int(i + 1)})); err != nil {
+			return err
+		}
+		s.m.Lock()
+		s.offset = i + 1
+		s.m.Unlock()
+	}
+	<-s.stop
+	return nil
+}
+
+func (s *offsetSource) Stop(ctx *core.Context) error {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+	return nil
+}
+
+func (s *offsetSource) SaveCheckpoint(ctx *core.Context) (data.Value, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Int(s.offset), nil
+}
+
+func (s *offsetSource) LoadCheckpoint(ctx *core.Context, v data.Value) error {
+	o, err := data.AsInt(v)
+	if err != nil {
+		return err
+	}
+	s.m.Lock()
+	s.offset = o
+	s.m.Unlock()
+	return nil
+}
+
+func createOffsetSource(ctx *core.Context, ioParams *IOParams, params data.Map) (core.Source, error) {
+	num, err := data.ToInt(params["num"])
+	if err != nil {
+		return nil, err
+	}
+	return &offsetSource{
+		num:  num,
+		stop: make(chan struct{}),
+	}, nil
+}
+
+func init() {
+	MustRegisterGlobalSourceCreator("offset_dummy", SourceCreatorFunc(createOffsetSource))
+}
+
+func TestCheckpoint(t *testing.T) {
+	stmts := func(num int) string {
+		return fmt.Sprintf(`
+			CREATE STATE s1 TYPE dummy_uds WITH num=1;
+			CREATE STATE s2 TYPE dummy_updatable_uds WITH num=2;
+			CREATE PAUSED SOURCE source TYPE offset_dummy WITH num=%v;
+			CREATE STREAM box AS SELECT ISTREAM sum(int) AS s FROM source [RANGE 3 TUPLES];
+			CREATE SINK snk TYPE collector;
+			INSERT INTO snk FROM box;
+			RESUME SOURCE source;`, num)
+	}
+
+	Convey("Given a topology with a checkpointable source, a box, and states", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, stmts(4)), ShouldBeNil)
+		So(addBQLToTopology(tb, `UPDATE STATE s2 SET num=7;`), ShouldBeNil)
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		sin.Sink().(*tupleCollectorSink).Wait(4)
+
+		Convey("When taking a checkpoint", func() {
+			cp, err := TakeCheckpoint(dt, DefaultCheckpointTimeout)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the offset of the source", func() {
+				So(cp.Nodes["source"], ShouldEqual, data.Int(4))
+			})
+
+			Convey("Then it should have the tuples in the window of the box", func() {
+				v, err := cp.Nodes.Get(data.MustCompilePath("box.tuples"))
+				So(err, ShouldBeNil)
+				tuples, err := arrayToTuples(v)
+				So(err, ShouldBeNil)
+				So(len(tuples), ShouldEqual, 3)
+				for i, t := range tuples {
+					So(t.Data, ShouldResemble, data.Map{"int": data.Int(i + 2)})
+					So(t.InputName, ShouldEqual, "source")
+				}
+			})
+
+			Convey("Then it should only have savable states", func() {
+				So(cp.States, ShouldContainKey, "s2")
+				So(cp.States["s2"].TypeName, ShouldEqual, "dummy_updatable_uds")
+				So(cp.States, ShouldNotContainKey, "s1")
+			})
+
+			Convey("Then the source should be resumed", func() {
+				sn, err := dt.Source("source")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldEqual, core.TSRunning)
+			})
+
+			Convey("And recovering a new topology from the saved checkpoint", func() {
+				So(cp.Save(tb.UDSStorage, dt.Name()), ShouldBeNil)
+
+				dt2 := newTestTopology()
+				Reset(func() {
+					dt2.Stop()
+				})
+				tb2, err := NewTopologyBuilder(dt2)
+				So(err, ShouldBeNil)
+				tb2.UDSStorage = tb.UDSStorage
+
+				loaded, err := LoadCheckpoint(tb2.UDSStorage, dt2.Name())
+				So(err, ShouldBeNil)
+				So(loaded.Timestamp, ShouldResemble, cp.Timestamp)
+				tb2.StartRecovery(loaded)
+				So(addBQLToTopology(tb2, stmts(6)), ShouldBeNil)
+
+				sn, err := dt2.Source("source")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldEqual, core.TSPaused)
+				So(tb2.FinishRecovery(), ShouldBeNil)
+
+				sin2, err := dt2.Sink("snk")
+				So(err, ShouldBeNil)
+				si2 := sin2.Sink().(*tupleCollectorSink)
+				si2.Wait(2)
+
+				Convey("Then the source should continue from the offset", func() {
+					So(si2.len(), ShouldEqual, 2)
+				})
+
+				Convey("Then the window of the box should be restored", func() {
+					sums := []data.Value{}
+					si2.forEachTuple(func(t *core.Tuple) {
+						sums = append(sums, t.Data["s"])
+					})
+					So(sums, ShouldResemble, []data.Value{data.Int(12), data.Int(15)})
+				})
+
+				Convey("Then the state should be restored", func() {
+					s, err := dt2.Context().SharedStates.Get("s2")
+					So(err, ShouldBeNil)
+					So(s.(*dummyUpdatableUDS).num, ShouldEqual, 7)
+				})
+			})
+		})
+
+		Convey("When taking checkpoints with a Checkpointer", func() {
+			c := NewCheckpointer(tb, 10*time.Millisecond)
+			c.Start()
+			Reset(c.Stop)
+
+			Convey("Then a checkpoint should be saved periodically", func() {
+				var err error
+				for i := 0; i < 100; i++ {
+					if _, err = LoadCheckpoint(tb.UDSStorage, dt.Name()); err == nil {
+						break
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				So(err, ShouldBeNil)
+
+				Convey("And deleting the checkpoint after stopping the Checkpointer", func() {
+					c.Stop()
+					So(DeleteCheckpoint(tb.UDSStorage, dt.Name()), ShouldBeNil)
+
+					Convey("Then it shouldn't be able to be loaded", func() {
+						_, err := LoadCheckpoint(tb.UDSStorage, dt.Name())
+						So(core.IsNotExist(err), ShouldBeTrue)
+					})
+				})
+			})
+		})
+	})
+
+	Convey("Given a topology with a HOPPING window", t, func() {
+		hopping := func(num int) string {
+			return fmt.Sprintf(`
+				CREATE PAUSED SOURCE source TYPE offset_dummy WITH num=%v;
+				CREATE STREAM box AS SELECT RSTREAM sum(int) AS s
+					FROM source [HOPPING 3 TUPLES, SLIDE 2 TUPLES];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				RESUME SOURCE source;`, num)
+		}
+
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, hopping(6)), ShouldBeNil)
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		sin.Sink().(*tupleCollectorSink).Wait(3)
+
+		Convey("When taking a checkpoint", func() {
+			cp, err := TakeCheckpoint(dt, DefaultCheckpointTimeout)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the state of the window", func() {
+				v, err := cp.Nodes.Get(data.MustCompilePath("box.windows.window.count"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(6))
+			})
+
+			Convey("And recovering a new topology from the saved checkpoint", func() {
+				So(cp.Save(tb.UDSStorage, dt.Name()), ShouldBeNil)
+
+				dt2 := newTestTopology()
+				Reset(func() {
+					dt2.Stop()
+				})
+				tb2, err := NewTopologyBuilder(dt2)
+				So(err, ShouldBeNil)
+				tb2.UDSStorage = tb.UDSStorage
+
+				loaded, err := LoadCheckpoint(tb2.UDSStorage, dt2.Name())
+				So(err, ShouldBeNil)
+				tb2.StartRecovery(loaded)
+				So(addBQLToTopology(tb2, hopping(8)), ShouldBeNil)
+				So(tb2.FinishRecovery(), ShouldBeNil)
+
+				sin2, err := dt2.Sink("snk")
+				So(err, ShouldBeNil)
+				si2 := sin2.Sink().(*tupleCollectorSink)
+				si2.Wait(1)
+
+				Convey("Then the window should continue at the same position", func() {
+					So(si2.len(), ShouldEqual, 1)
+					So(si2.get(0).Data, ShouldResemble, data.Map{"s": data.Int(21)})
+				})
+			})
+		})
+	})
+
+	Convey("Given a TopologyBuilder which isn't recovering a topology", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		Convey("When finishing a recovery", func() {
+			err := tb.FinishRecovery()
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When loading a checkpoint which hasn't been saved", func() {
+			_, err := LoadCheckpoint(tb.UDSStorage, dt.Name())
+
+			Convey("Then it should fail with a not exist error", func() {
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+	})
+}
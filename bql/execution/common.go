@@ -6,6 +6,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
 )
 
 type aliasedEvaluator struct {
@@ -16,6 +17,12 @@ type aliasedEvaluator struct {
 	aggrEvals    map[string]Evaluator
 }
 
+// isSortKey returns true if the evaluator computes the value of an
+// ORDER BY expression rather than an output column.
+func (a *aliasedEvaluator) isSortKey() bool {
+	return strings.HasPrefix(a.alias, ":order:")
+}
+
 type commonExecutionPlan struct {
 	projections []aliasedEvaluator
 	groupList   []Evaluator
@@ -46,7 +53,8 @@ func prepareProjections(projections []aliasedExpression, reg udf.FunctionRegistr
 			}
 		}
 		var path data.Path
-		if proj.alias != "*" && proj.alias != ":having:" {
+		if proj.alias != "*" && proj.alias != ":having:" &&
+			!strings.HasPrefix(proj.alias, ":order:") {
 			path, err = data.CompilePath(proj.alias)
 			if err != nil {
 				return nil, err
@@ -0,0 +1,116 @@
+package execution
+
+import (
+	"errors"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// dateOperand returns the Interval a String operand represents when the
+// other operand is a Timestamp or an Interval, like an untyped literal
+// in PostgreSQL, so that `ts + "5 minutes"` can be written without a
+// cast. Other values are returned as they are.
+func dateOperand(v, other data.Value) (data.Value, error) {
+	if v.Type() != data.TypeString {
+		return v, nil
+	}
+	if t := other.Type(); t != data.TypeTimestamp && t != data.TypeInterval {
+		return v, nil
+	}
+	s, _ := data.AsString(v)
+	iv, err := data.ParseInterval(s)
+	if err != nil {
+		return nil, err
+	}
+	return iv, nil
+}
+
+func dateOperands(l, r data.Value) (data.Value, data.Value, error) {
+	l2, err := dateOperand(l, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	r2, err := dateOperand(r, l)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l2, r2, nil
+}
+
+// addDates computes Timestamp + Interval, Interval + Timestamp, and
+// Interval + Interval.
+func addDates(l, r data.Value) (data.Value, error) {
+	l, r, err := dateOperands(l, r)
+	if err != nil {
+		return nil, err
+	}
+	if l.Type() == data.TypeInterval && r.Type() == data.TypeTimestamp {
+		l, r = r, l
+	}
+	if r.Type() != data.TypeInterval {
+		return nil, nil
+	}
+	iv, _ := data.AsInterval(r)
+	switch l.Type() {
+	case data.TypeTimestamp:
+		t, _ := data.AsTimestamp(l)
+		return data.Timestamp(iv.AddTo(t)), nil
+	case data.TypeInterval:
+		liv, _ := data.AsInterval(l)
+		return liv.Add(iv), nil
+	}
+	return nil, nil
+}
+
+// subtractDates computes Timestamp - Interval, Interval - Interval,
+// and Timestamp - Timestamp, which results in an Interval whose full
+// days of 24 hours are held in Days.
+func subtractDates(l, r data.Value) (data.Value, error) {
+	l, r, err := dateOperands(l, r)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case l.Type() == data.TypeTimestamp && r.Type() == data.TypeTimestamp:
+		lt, _ := data.AsTimestamp(l)
+		rt, _ := data.AsTimestamp(r)
+		return data.NewInterval(lt.Sub(rt)), nil
+	case r.Type() == data.TypeInterval:
+		iv, _ := data.AsInterval(r)
+		return addDates(l, iv.Scale(-1))
+	}
+	return nil, nil
+}
+
+// multiplyInterval computes Interval * number and number * Interval.
+func multiplyInterval(l, r data.Value) (data.Value, error) {
+	if r.Type() == data.TypeInterval {
+		l, r = r, l
+	}
+	if l.Type() != data.TypeInterval {
+		return nil, nil
+	}
+	iv, _ := data.AsInterval(l)
+	switch r.Type() {
+	case data.TypeInt, data.TypeFloat:
+		f, _ := data.ToFloat(r)
+		return iv.Scale(f), nil
+	}
+	return nil, nil
+}
+
+// divideInterval computes Interval / number.
+func divideInterval(l, r data.Value) (data.Value, error) {
+	if l.Type() != data.TypeInterval {
+		return nil, nil
+	}
+	iv, _ := data.AsInterval(l)
+	switch r.Type() {
+	case data.TypeInt, data.TypeFloat:
+		f, _ := data.ToFloat(r)
+		if f == 0 {
+			return nil, errors.New("division by zero")
+		}
+		return iv.Scale(1 / f), nil
+	}
+	return nil, nil
+}
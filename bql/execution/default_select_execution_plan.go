@@ -5,6 +5,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
 )
 
 type defaultSelectExecutionPlan struct {
@@ -44,6 +45,23 @@ func (ep *defaultSelectExecutionPlan) Process(input *core.Tuple) ([]data.Map, er
 	return ep.process(input, ep.performQueryOnBuffer)
 }
 
+// Tick closes timed out windows and returns the data to be emitted.
+func (ep *defaultSelectExecutionPlan) Tick(now time.Time) ([]data.Map, error) {
+	return ep.tick(now, ep.performQueryOnBuffer)
+}
+
+// Flush processes the tuples held in the reorder buffers of input
+// streams with a LATENESS and returns the data to be emitted.
+func (ep *defaultSelectExecutionPlan) Flush() ([]data.Map, error) {
+	return ep.flushReorderBuffers(ep.performQueryOnBuffer)
+}
+
+// RestoreWindows restores the windows returned by WindowTuples of
+// another instance of the same plan.
+func (ep *defaultSelectExecutionPlan) RestoreWindows(tuples []*core.Tuple, state data.Map) error {
+	return ep.restoreWindows(tuples, state, ep.performQueryOnBuffer)
+}
+
 // performQueryOnBuffer computes the projections of a SELECT query on the data
 // stored in `ep.filteredInputRows`. The query results (which is a set of
 // data.Value, not core.Tuple) is stored in ep.curResults. The data
@@ -84,17 +102,22 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 			if err != nil {
 				return fmt.Errorf("cached data was not a map: %v", io.cache)
 			}
-			output = append(output, resultRow{row: cachedResults, hash: io.hash})
+			output = append(output, resultRow{row: cachedResults, hash: io.hash, sortKey: io.sortKey})
 			return nil
 		}
 		// otherwise, compute all the expressions
 		d := *io.input
 		result := data.Map(make(map[string]data.Value, len(ep.projections)))
+		var sortKey data.Array
 		for _, proj := range ep.projections {
 			value, err := proj.evaluator.Eval(d)
 			if err != nil {
 				return err
 			}
+			if proj.isSortKey() {
+				sortKey = append(sortKey, value)
+				continue
+			}
 			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
 				return err
 			}
@@ -102,9 +125,10 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		// update the fields of the input data for the next iteration
 		io.cache = result
 		io.hash = data.Hash(io.cache)
+		io.sortKey = sortKey
 		// since we have no grouping etc., "output data" = "cached data"
 		// and "hash of output data" = "hash of cached data"
-		output = append(output, resultRow{row: result, hash: io.hash})
+		output = append(output, resultRow{row: result, hash: io.hash, sortKey: sortKey})
 		return nil
 	}
 
@@ -141,6 +141,121 @@ func TestDefaultSelectExecutionPlan(t *testing.T) {
 		})
 	})
 
+	Convey("Given a SELECT clause with date/time functions and arithmetic", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM int,
+			date_trunc("minute", ts() + "30 seconds") AS bucket,
+			ts() - date_bin("2 seconds", ts()) AS offset,
+			to_char(ts() + "1 day"::interval * int, "MM/DD HH24:MI:SS") AS s
+			FROM src [RANGE 1 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+					So(out[0], ShouldResemble, data.Map{
+						"int":    data.Int(idx + 1),
+						"bucket": data.Timestamp(time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)),
+						"offset": data.Interval{0, 0, int64(idx%2) * 1000000},
+						"s":      data.String(fmt.Sprintf("04/%02d 10:23:%02d", 11+idx, idx)),
+					})
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with pattern matching in WHERE", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 1 TUPLES]
+			WHERE int::string LIKE "%2" OR int::string NOT SIMILAR TO "(1|2|3)"`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					if idx == 1 || idx == 3 {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{"int": data.Int(idx + 1)})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with array functions and lambda expressions", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM
+			transform(filter([int, int * 2, int * 3], x -> x % 2 = 0), x -> x + int) AS a,
+			array_sort(map_values({"a": int, "b": 0})) AS b
+			FROM src [RANGE 1 TUPLES] WHERE array_contains([1, 3], int)`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					if idx == 0 {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"a": data.Array{data.Int(3)},
+							"b": data.Array{data.Int(0), data.Int(1)},
+						})
+					} else if idx == 2 {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"a": data.Array{data.Int(9)},
+							"b": data.Array{data.Int(0), data.Int(3)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with a lambda expression in a join", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM
+			filter([s:int, s:int + 1], x -> x = t:int) AS a
+			FROM src [RANGE 2 TUPLES] AS s, src [RANGE 2 TUPLES] AS t
+			WHERE s:int < t:int`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					if idx == 0 {
+						So(out, ShouldBeEmpty)
+					} else {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"a": data.Array{data.Int(idx + 1)},
+						})
+					}
+				})
+			}
+		})
+	})
+
 	Convey("Given a SELECT clause with only a column using the table name", t, func() {
 		tuples := getTuples(4)
 		s := `CREATE STREAM box AS SELECT ISTREAM src:int FROM src [RANGE 2 SECONDS]`
@@ -47,7 +47,14 @@ func EvaluateFoldable(expr parser.Expression, reg udf.FunctionRegistry) (data.Va
 	if err != nil {
 		return nil, err
 	}
-	evaluator, err := ExpressionToEvaluator(flatExpr, reg)
+	return evaluateFlatFoldable(flatExpr, reg)
+}
+
+// evaluateFlatFoldable evaluates a FlatExpression that doesn't
+// refer to any input row. It is also used for constant folding
+// by LogicalOptimize.
+func evaluateFlatFoldable(expr FlatExpression, reg udf.FunctionRegistry) (data.Value, error) {
+	evaluator, err := ExpressionToEvaluator(expr, reg)
 	if err != nil {
 		return nil, err
 	}
@@ -95,17 +102,9 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return &timestampCast{pa}, nil
 		}
 	case rowValue:
-		path := obj.Column
-		if obj.Relation != "" {
-			if strings.HasPrefix(path, "[") {
-				path = obj.Relation + path
-			} else {
-				path = obj.Relation + "." + path
-			}
-		}
-		return newPathAccess(path)
+		return newColumnAccess(rowValuePath(obj))
 	case aggInputRef:
-		return newPathAccess(obj.Ref)
+		return newColumnAccess(obj.Ref)
 	case nullLiteral:
 		return &nullConstant{}, nil
 	case numericLiteral:
@@ -126,6 +125,12 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		if err != nil {
 			return nil, err
 		}
+		// use an Evaluator specialized for the types of the
+		// operands if they are known
+		lt, rt := inferType(obj.Left), inferType(obj.Right)
+		if eval := newTypedBinOp(obj.Op, left, right, lt, rt); eval != nil {
+			return eval, nil
+		}
 		// assemble both children with the correct operator
 		bo := binOp{left, right}
 		switch obj.Op {
@@ -148,6 +153,9 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.Like, parser.NotLike, parser.ILike, parser.NotILike,
+			parser.SimilarTo, parser.NotSimilarTo:
+			return newPatternMatch(obj.Op, bo, obj.Right)
 		case parser.Concat:
 			return &concat{bo}, nil
 		case parser.Is:
@@ -188,12 +196,26 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newNot(expr), nil
 		case parser.UnaryMinus:
 			// implement negation as multiplication with -1
+			t := inferType(obj.Expr)
+			if eval := newTypedBinOp(parser.Multiply, expr, &intConstant{-1}, t, intType); eval != nil {
+				return eval, nil
+			}
 			bo := binOp{expr, &intConstant{-1}}
 			return newMultiply(bo), nil
 		}
 	case missing:
-		// recurse
-		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		// the check needs a pathAccess, which is not necessarily
+		// the Evaluator used for a column
+		var path string
+		switch e := obj.Expr.(type) {
+		case rowValue:
+			path = rowValuePath(e)
+		case lambdaParam:
+			path = lambdaParamPath(e)
+		default:
+			return nil, fmt.Errorf("IS [NOT] MISSING does not work with %s", obj.Expr.Repr())
+		}
+		expr, err := newPathAccess(path)
 		if err != nil {
 			return nil, err
 		}
@@ -223,7 +245,33 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			}
 			evals[i] = eval
 		}
+		// let the function prepare for constant arguments
+		if s, ok := f.(udf.CallSiteSpecializer); ok {
+			consts := make([]data.Value, len(evals))
+			for i, e := range obj.Expressions {
+				if isLiteral(e) {
+					if consts[i], err = evals[i].Eval(nil); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if f, err = s.Specialize(consts); err != nil {
+				return nil, err
+			}
+		}
 		return FuncApp(fName, f, reg.Context(), evals), nil
+	case higherOrderFuncAppAST:
+		array, err := ExpressionToEvaluator(obj.Array, reg)
+		if err != nil {
+			return nil, err
+		}
+		body, err := ExpressionToEvaluator(obj.Body, reg)
+		if err != nil {
+			return nil, err
+		}
+		return newHigherOrderFuncApp(string(obj.Function), array, obj.Param, body)
+	case lambdaParam:
+		return newPathAccess(lambdaParamPath(obj))
 	case aggregateInputSorter:
 		return newSortedInputAggFuncApp(obj.funcAppAST, obj.ID, obj.Ordering, reg)
 	case arrayAST:
@@ -333,6 +381,20 @@ func (s *stringConstant) Eval(input data.Value) (data.Value, error) {
 	return data.String(s.value), nil
 }
 
+// rowValuePath returns the JSON Path that is used to access the
+// given column in an input row.
+func rowValuePath(rv rowValue) string {
+	path := rv.Column
+	if rv.Relation != "" {
+		if strings.HasPrefix(path, "[") {
+			path = rv.Relation + path
+		} else {
+			path = rv.Relation + "." + path
+		}
+	}
+	return path
+}
+
 // pathAccess only works for maps and returns the Value at the given
 // JSON path.
 type pathAccess struct {
@@ -408,23 +470,9 @@ func newTypeCast(e Evaluator, t parser.Type) (Evaluator, error) {
 		}
 		return &typeCast{e, conv}, nil
 	case parser.Int:
-		conv := func(v data.Value) (data.Value, error) {
-			x, err := data.ToInt(v)
-			if err != nil {
-				return nil, err
-			}
-			return data.Int(x), nil
-		}
-		return &typeCast{e, conv}, nil
+		return &intCast{e}, nil
 	case parser.Float:
-		conv := func(v data.Value) (data.Value, error) {
-			x, err := data.ToFloat(v)
-			if err != nil {
-				return nil, err
-			}
-			return data.Float(x), nil
-		}
-		return &typeCast{e, conv}, nil
+		return &floatCast{e}, nil
 	case parser.String:
 		conv := func(v data.Value) (data.Value, error) {
 			x, err := data.ToString(v)
@@ -452,6 +500,15 @@ func newTypeCast(e Evaluator, t parser.Type) (Evaluator, error) {
 			return data.Timestamp(x), nil
 		}
 		return &typeCast{e, conv}, nil
+	case parser.Interval:
+		conv := func(v data.Value) (data.Value, error) {
+			x, err := data.ToInterval(v)
+			if err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+		return &typeCast{e, conv}, nil
 	}
 	return nil, fmt.Errorf("no converter for type %s known", t)
 }
@@ -466,6 +523,11 @@ func (t *timestampCast) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the timestamp of a relation is NULL in rows that were
+	// NULL-extended by a LEFT JOIN
+	if val.Type() == data.TypeNull {
+		return val, nil
+	}
 	if val.Type() != data.TypeTimestamp {
 		return nil, fmt.Errorf("value %v was %T, not Time", val, val)
 	}
@@ -703,6 +765,8 @@ func newLess(bo binOp) Evaluator {
 				l, _ := data.AsTimestamp(leftVal)
 				r, _ := data.AsTimestamp(rightVal)
 				retVal = l.Before(r)
+			case data.TypeInterval:
+				retVal = data.Less(leftVal, rightVal)
 			}
 			return retVal, nil
 		} else if leftType == data.TypeInt && rightType == data.TypeFloat {
@@ -766,6 +830,11 @@ type numBinOp struct {
 	verb    string
 	intOp   func(int64, int64) int64
 	floatOp func(float64, float64) float64
+	// dateOp evaluates the operation on operands that are not both
+	// numeric, such as a Timestamp and an Interval. It returns a nil
+	// Value if the operation is not defined on the operands. It is
+	// nil if the operation is only defined on numbers.
+	dateOp func(data.Value, data.Value) (data.Value, error)
 }
 
 func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
@@ -792,8 +861,6 @@ func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
 	// the corresponding operation)
 	if leftType == rightType {
 		switch leftType {
-		default:
-			return nil, stdErr
 		case data.TypeInt:
 			l, _ := data.AsInt(leftVal)
 			r, _ := data.AsInt(rightVal)
@@ -816,6 +883,11 @@ func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
 		r, _ := data.AsInt(rightVal)
 		return data.Float(nbo.floatOp(l, float64(r))), nil
 	}
+	if nbo.dateOp != nil {
+		if v, err := nbo.dateOp(leftVal, rightVal); err != nil || v != nil {
+			return v, err
+		}
+	}
 	return nil, stdErr
 }
 
@@ -827,7 +899,7 @@ func newPlus(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a + b
 	}
-	return &numBinOp{bo, "add", intOp, floatOp}
+	return &numBinOp{bo, "add", intOp, floatOp, addDates}
 }
 
 func newMinus(bo binOp) Evaluator {
@@ -838,7 +910,7 @@ func newMinus(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a - b
 	}
-	return &numBinOp{bo, "subtract", intOp, floatOp}
+	return &numBinOp{bo, "subtract", intOp, floatOp, subtractDates}
 }
 
 func newMultiply(bo binOp) Evaluator {
@@ -849,7 +921,7 @@ func newMultiply(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a * b
 	}
-	return &numBinOp{bo, "multiply", intOp, floatOp}
+	return &numBinOp{bo, "multiply", intOp, floatOp, multiplyInterval}
 }
 
 func newDivide(bo binOp) Evaluator {
@@ -860,7 +932,7 @@ func newDivide(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a / b
 	}
-	return &numBinOp{bo, "divide", intOp, floatOp}
+	return &numBinOp{bo, "divide", intOp, floatOp, divideInterval}
 }
 
 func newModulo(bo binOp) Evaluator {
@@ -870,7 +942,7 @@ func newModulo(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return math.Mod(a, b)
 	}
-	return &numBinOp{bo, "compute modulo for", intOp, floatOp}
+	return &numBinOp{bo, "compute modulo for", intOp, floatOp, nil}
 }
 
 /// Other Binary Operations
@@ -909,6 +981,30 @@ func (nbo *concat) Eval(input data.Value) (v data.Value, err error) {
 
 /// Function Evaluation
 
+// UDFError is an error returned from Evaluator.Eval when a UDF returned an
+// error or panicked.
+type UDFError struct {
+	// Function is the name of the UDF.
+	Function string
+
+	// Err is the error returned from the UDF.
+	Err error
+}
+
+func (e *UDFError) Error() string {
+	return e.Err.Error()
+}
+
+// Fatal returns true when Err is a fatal error.
+func (e *UDFError) Fatal() bool {
+	return core.IsFatalError(e.Err)
+}
+
+// Temporary returns true when Err is a temporary error.
+func (e *UDFError) Temporary() bool {
+	return core.IsTemporaryError(e.Err)
+}
+
 type funcApp struct {
 	name        string
 	fVal        reflect.Value
@@ -921,7 +1017,7 @@ func (f *funcApp) Eval(input data.Value) (v data.Value, err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			v = nil
-			err = fmt.Errorf("evaluating '%s' paniced: %s", f.name, r)
+			err = &UDFError{f.name, fmt.Errorf("evaluating '%s' paniced: %s", f.name, r)}
 		}
 	}()
 	// evaluate all the parameters and store the results
@@ -942,7 +1038,7 @@ func (f *funcApp) Eval(input data.Value) (v data.Value, err error) {
 	resultVal, errVal := results[0], results[1]
 	if !errVal.IsNil() {
 		err := errVal.Interface().(error)
-		return nil, err
+		return nil, &UDFError{f.name, err}
 	}
 	result := resultVal.Interface().(data.Value)
 	return result, nil
@@ -1108,6 +1204,90 @@ func newSortedInputAggFuncApp(obj funcAppAST, id string, ordering []sortExpressi
 	return &sortedInputAggFuncApp{backendFun, inOutKeys, sortEvals}, nil
 }
 
+// lambdaParamsKey is the key of the input row that holds the arguments
+// of the enclosing lambda expressions as a Map. It contains ":meta:"
+// so that wildcards do not pick it up.
+const lambdaParamsKey = ":meta:LAMBDA"
+
+// lambdaParamPath returns the JSON Path that is used to access the
+// given lambda parameter in an input row.
+func lambdaParamPath(lp lambdaParam) string {
+	return fmt.Sprintf(`["%s"].%s`, lambdaParamsKey, lp.Column)
+}
+
+// higherOrderFuncApp evaluates a higher-order function by evaluating
+// the body of its lambda expression for each element of the given
+// array. The body is evaluated on a copy of the input row which also
+// holds the element as the argument of the lambda expression.
+//
+// transform returns an array of the results of the body and filter
+// returns an array of the elements for which the body is true.
+type higherOrderFuncApp struct {
+	function string
+	array    Evaluator
+	param    string
+	body     Evaluator
+}
+
+func (h *higherOrderFuncApp) Eval(input data.Value) (data.Value, error) {
+	v, err := h.array.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	if v.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("%s requires an array as its first parameter: %v", h.function, v)
+	}
+
+	// arguments of enclosing lambda expressions remain accessible
+	// unless they are shadowed by the parameter
+	row := data.Map{}
+	args := data.Map{}
+	if m, ok := input.(data.Map); ok {
+		for k, v := range m {
+			row[k] = v
+		}
+		if outer, ok := m[lambdaParamsKey].(data.Map); ok {
+			for k, v := range outer {
+				args[k] = v
+			}
+		}
+	}
+	row[lambdaParamsKey] = args
+
+	results := make(data.Array, 0, len(arr))
+	for _, elem := range arr {
+		args[h.param] = elem
+		switch h.function {
+		case "transform":
+			res, err := h.body.Eval(row)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, res)
+		case "filter":
+			ok, err := evalCondition(h.body, row)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				results = append(results, elem)
+			}
+		}
+	}
+	return results, nil
+}
+
+func newHigherOrderFuncApp(function string, array Evaluator, param string, body Evaluator) (Evaluator, error) {
+	if !higherOrderFuncs[function] {
+		return nil, fmt.Errorf("function '%s' does not accept lambda expressions", function)
+	}
+	return &higherOrderFuncApp{function, array, param, body}, nil
+}
+
 /// JSON-like data structures
 
 type arrayBuilder struct {
@@ -268,6 +268,51 @@ func TestFuncAppConversion(t *testing.T) {
 			})
 		})
 
+		Convey("When a higher-order function is used", func() {
+			ast := parser.FuncAppAST{parser.FuncName("TRANSFORM"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"},
+					parser.LambdaAST{"x", parser.LambdaParam{"x"}},
+				}}, nil}
+
+			Convey("Then we obtain an evaluatable higherOrderFuncApp", func() {
+				flatExpr, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldBeNil)
+				eval, err := ExpressionToEvaluator(flatExpr, reg)
+				So(err, ShouldBeNil)
+				So(eval, ShouldHaveSameTypeAs, &higherOrderFuncApp{})
+			})
+		})
+
+		Convey("When a lambda expression is passed to a regular function", func() {
+			ast := parser.FuncAppAST{parser.FuncName("plusone"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.LambdaAST{"x", parser.LambdaParam{"x"}},
+				}}, nil}
+
+			Convey("Then converting to an Evaluator fails", func() {
+				_, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual,
+					"function 'plusone' does not accept lambda expressions")
+			})
+		})
+
+		Convey("When a higher-order function has wrong parameters", func() {
+			ast := parser.FuncAppAST{parser.FuncName("filter"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.LambdaAST{"x", parser.LambdaParam{"x"}},
+					parser.RowValue{"", "a"},
+				}}, nil}
+
+			Convey("Then converting to an Evaluator fails", func() {
+				_, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual,
+					"function 'filter' requires an array and a lambda expression as parameters")
+			})
+		})
+
 		Convey("When the now() function is used", func() {
 			ast := parser.FuncAppAST{parser.FuncName("now"),
 				parser.ExpressionsAST{[]parser.Expression{}}, nil}
@@ -283,6 +328,67 @@ func TestFuncAppConversion(t *testing.T) {
 	})
 }
 
+func TestConstantPatternConversion(t *testing.T) {
+	Convey("Given a function registry", t, func() {
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+		Convey("When LIKE has a constant pattern", func() {
+			ast := parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.StringLiteral{"a%"}}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			eval, err := ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then the pattern should be compiled beforehand", func() {
+				So(eval, ShouldHaveSameTypeAs, &patternMatch{})
+				So(eval.(*patternMatch).re, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When LIKE has an invalid constant pattern", func() {
+			ast := parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.StringLiteral{`a\`}}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			_, err = ExpressionToEvaluator(flatExpr, reg)
+
+			Convey("Then converting to an Evaluator fails", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When a regular expression function has a constant pattern", func() {
+			ast := parser.FuncAppAST{parser.FuncName("regexp_replace"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"}, parser.StringLiteral{"o+"}, parser.StringLiteral{"0"},
+				}}, nil}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			eval, err := ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be evaluated correctly", func() {
+				v, err := eval.Eval(data.Map{"a": data.String("foo boo")})
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.String("f0 boo"))
+			})
+		})
+
+		Convey("When a regular expression function has an invalid constant pattern", func() {
+			ast := parser.FuncAppAST{parser.FuncName("regexp_match"),
+				parser.ExpressionsAST{[]parser.Expression{
+					parser.RowValue{"", "a"}, parser.StringLiteral{"("},
+				}}, nil}
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			_, err = ExpressionToEvaluator(flatExpr, reg)
+
+			Convey("Then converting to an Evaluator fails", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestAggFuncAppConversion(t *testing.T) {
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 
@@ -579,6 +685,7 @@ func getTestCases() []struct {
 	inputs []evalTest
 } {
 	now := time.Now()
+	someDay := time.Date(2015, time.May, 2, 10, 0, 0, 0, time.UTC)
 
 	// whatever binary operator we use (comparison or computation, but not
 	// boolean/logical), if NULL is involved then the result should also always
@@ -1376,6 +1483,140 @@ func getTestCases() []struct {
 					"b": data.Map{}}, data.Bool(true)},
 			}, nullOps...),
 		},
+		// Higher-Order Functions
+		{parser.FuncAppAST{parser.FuncName("transform"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.BinaryOpAST{parser.Multiply, parser.LambdaParam{"x"}, parser.NumericLiteral{2}}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{data.Int(1), data.Int(2)}}, data.Array{data.Int(2), data.Int(4)}},
+				{data.Map{"a": data.Array{data.Int(1), data.Null{}}}, data.Array{data.Int(2), data.Null{}}},
+				{data.Map{"a": data.Array{}}, data.Array{}},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Array{data.String("a")}}, nil},
+				{data.Map{"a": data.Int(1)}, nil},
+			},
+		},
+		{parser.FuncAppAST{parser.FuncName("filter"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.BinaryOpAST{parser.Greater, parser.LambdaParam{"x.v"}, parser.RowValue{"", "b"}}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{data.Map{"v": data.Int(1)}, data.Map{"v": data.Int(3)}, data.Map{"v": data.Null{}}},
+					"b": data.Int(2)}, data.Array{data.Map{"v": data.Int(3)}}},
+				{data.Map{"a": data.Array{data.Map{"v": data.Int(1)}}, "b": data.Int(0)}, data.Array{data.Map{"v": data.Int(1)}}},
+				{data.Map{"a": data.Array{data.Map{"w": data.Int(1)}}, "b": data.Int(0)}, nil},
+			},
+		},
+		{parser.FuncAppAST{parser.FuncName("filter"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.LambdaParam{"x"}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{data.Bool(true), data.Bool(false), data.Null{}}}, data.Array{data.Bool(true)}},
+				{data.Map{"a": data.Array{data.Int(1)}}, nil},
+			},
+		},
+		{parser.FuncAppAST{parser.FuncName("filter"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.BinaryOpAST{parser.IsNot, parser.LambdaParam{"x.v"}, parser.Missing{}}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{data.Map{"v": data.Int(1)}, data.Map{"w": data.Int(2)}, data.Map{"v": data.Null{}}}},
+					data.Array{data.Map{"v": data.Int(1)}, data.Map{"v": data.Null{}}}},
+				{data.Map{"a": data.Array{data.Map{"w": data.Int(1)}}}, data.Array{}},
+			},
+		},
+		{parser.FuncAppAST{parser.FuncName("transform"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.BinaryOpAST{parser.Is, parser.LambdaParam{"x.v"}, parser.Missing{}}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{data.Map{"v": data.Int(1)}, data.Map{"w": data.Int(2)}}},
+					data.Array{data.Bool(false), data.Bool(true)}},
+			},
+		},
+		// the outer parameter is accessible in the inner lambda
+		{parser.FuncAppAST{parser.FuncName("transform"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.FuncAppAST{parser.FuncName("filter"), parser.ExpressionsAST{[]parser.Expression{
+				parser.LambdaParam{"x"},
+				parser.LambdaAST{"y", parser.BinaryOpAST{parser.Greater, parser.LambdaParam{"y"}, parser.LambdaParam{"x[0]"}}},
+			}}, nil}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{
+					data.Array{data.Int(1), data.Int(2), data.Int(3)},
+					data.Array{data.Int(3), data.Int(1), data.Int(5)},
+				}}, data.Array{
+					data.Array{data.Int(2), data.Int(3)},
+					data.Array{data.Int(5)},
+				}},
+			},
+		},
+		// the outer parameter is shadowed by the inner one
+		{parser.FuncAppAST{parser.FuncName("transform"), parser.ExpressionsAST{[]parser.Expression{
+			parser.RowValue{"", "a"},
+			parser.LambdaAST{"x", parser.FuncAppAST{parser.FuncName("transform"), parser.ExpressionsAST{[]parser.Expression{
+				parser.LambdaParam{"x"},
+				parser.LambdaAST{"x", parser.BinaryOpAST{parser.Plus, parser.LambdaParam{"x"}, parser.NumericLiteral{1}}},
+			}}, nil}},
+		}}, nil},
+			[]evalTest{
+				{data.Map{"a": data.Array{
+					data.Array{data.Int(1)},
+					data.Array{data.Int(2), data.Int(3)},
+				}}, data.Array{
+					data.Array{data.Int(2)},
+					data.Array{data.Int(3), data.Int(4)},
+				}},
+			},
+		},
+		// Pattern Matching
+		{parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.String("hoge"), "b": data.String("h%")}, data.Bool(true)},
+				{data.Map{"a": data.String("hoge"), "b": data.String("_o_e")}, data.Bool(true)},
+				{data.Map{"a": data.String("hoge"), "b": data.String("%o")}, data.Bool(false)},
+				{data.Map{"a": data.String("hoge"), "b": data.String("H%")}, data.Bool(false)},
+				{data.Map{"a": data.String("h.ge"), "b": data.String("h.g_")}, data.Bool(true)},
+				{data.Map{"a": data.String("hoge"), "b": data.String("h.g_")}, data.Bool(false)},
+				{data.Map{"a": data.String("50%"), "b": data.String(`50\%`)}, data.Bool(true)},
+				{data.Map{"a": data.String("500"), "b": data.String(`50\%`)}, data.Bool(false)},
+				{data.Map{"a": data.String("日本\n語"), "b": data.String("日%語")}, data.Bool(true)},
+				{data.Map{"a": data.String("hoge"), "b": data.String(`h\`)}, nil},
+				{data.Map{"a": data.Int(1), "b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"), "b": data.Int(1)}, nil},
+				{data.Map{"a": data.Null{}, "b": data.String("1")}, data.Null{}},
+				{data.Map{"a": data.String("1"), "b": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotILike, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.String("hoge"), "b": data.String("H%")}, data.Bool(false)},
+				{data.Map{"a": data.String("hoge"), "b": data.String("x%")}, data.Bool(true)},
+				{data.Map{"a": data.Null{}, "b": data.String("1")}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.SimilarTo, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.String("abc"), "b": data.String("abc")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"), "b": data.String("a")}, data.Bool(false)},
+				{data.Map{"a": data.String("abc"), "b": data.String("%(b|d)%")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"), "b": data.String("(b|c)%")}, data.Bool(false)},
+				{data.Map{"a": data.String("abbbc"), "b": data.String("ab{2,3}c")}, data.Bool(true)},
+				{data.Map{"a": data.String("a1"), "b": data.String("[a-c][0-9]+")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"), "b": data.String("a.c")}, data.Bool(false)},
+				{data.Map{"a": data.String("a.c"), "b": data.String("a.c")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"), "b": data.String("(abc")}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotSimilarTo, parser.RowValue{"", "a"}, parser.StringLiteral{"%(b|d)%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("abc")}, data.Bool(false)},
+				{data.Map{"a": data.String("ace")}, data.Bool(true)},
+			},
+		},
 		// Concatenation
 		{parser.BinaryOpAST{parser.Concat, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
 			append([]evalTest{
@@ -1523,13 +1764,13 @@ func getTestCases() []struct {
 					"b": data.Int(4)}, data.Float(3.14 - float64(4))},
 				{data.Map{"a": data.Float(3.14),
 					"b": data.Float(3.15)}, data.Float(float64(3.14) - 3.15)},
+				{data.Map{"a": data.Timestamp(now),
+					"b": data.Timestamp(now.Add(time.Second))}, data.Interval{0, 0, -1000000}},
 				// left and right present and cannot be subtracted
 				{data.Map{"a": data.Bool(false),
 					"b": data.Bool(true)}, nil},
 				{data.Map{"a": data.String("hoge"),
 					"b": data.String("hogee")}, nil},
-				{data.Map{"a": data.Timestamp(now),
-					"b": data.Timestamp(now.Add(time.Second))}, nil},
 				// left and right present and not comparable => error
 			}, incomparables...),
 		},
@@ -1691,6 +1932,78 @@ func getTestCases() []struct {
 				{data.Map{"a": data.Null{}}, data.Null{}},
 			},
 		},
+		{parser.TypeCastAST{parser.RowValue{"", "a"}, parser.Interval},
+			[]evalTest{
+				// key present and convertable => ok
+				{data.Map{"a": data.String("1 day 2 hours")}, data.Interval{0, 1, 7200000000}},
+				{data.Map{"a": data.Int(90)}, data.Interval{0, 0, 90000000}},
+				{data.Map{"a": data.Float(1.5)}, data.Interval{0, 0, 1500000}},
+				{data.Map{"a": data.Interval{1, 2, 3}}, data.Interval{1, 2, 3}},
+				// null propagation
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				// key present and other data type => error
+				{data.Map{"a": data.String("日本語")}, nil},
+				{data.Map{"a": data.Bool(true)}, nil},
+				{data.Map{"a": data.Timestamp(now)}, nil},
+			},
+		},
+		/// Date/Time Arithmetic
+		{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(someDay), "b": data.Interval{1, 1, 60000000}},
+					data.Timestamp(time.Date(2015, time.June, 3, 10, 1, 0, 0, time.UTC))},
+				{data.Map{"a": data.Interval{0, 0, 60000000}, "b": data.Timestamp(someDay)},
+					data.Timestamp(time.Date(2015, time.May, 2, 10, 1, 0, 0, time.UTC))},
+				// strings are interpreted as intervals
+				{data.Map{"a": data.Timestamp(someDay), "b": data.String("5 minutes")},
+					data.Timestamp(time.Date(2015, time.May, 2, 10, 5, 0, 0, time.UTC))},
+				{data.Map{"a": data.Interval{0, 1, 0}, "b": data.String("1 hour")},
+					data.Interval{0, 1, 3600000000}},
+				{data.Map{"a": data.Timestamp(someDay), "b": data.String("hoge")}, nil},
+				{data.Map{"a": data.Timestamp(someDay), "b": data.Int(3)}, nil},
+				{data.Map{"a": data.Timestamp(someDay), "b": data.Timestamp(someDay)}, nil},
+				{data.Map{"a": data.Interval{}, "b": data.Float(1)}, nil},
+				{data.Map{"a": data.Interval{}, "b": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.Minus, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(someDay), "b": data.String("1 day")},
+					data.Timestamp(time.Date(2015, time.May, 1, 10, 0, 0, 0, time.UTC))},
+				{data.Map{"a": data.Timestamp(someDay),
+					"b": data.Timestamp(time.Date(2015, time.April, 30, 9, 0, 0, 0, time.UTC))},
+					data.Interval{0, 2, 3600000000}},
+				{data.Map{"a": data.Interval{0, 1, 0}, "b": data.Interval{0, 0, 1}},
+					data.Interval{0, 1, -1}},
+				{data.Map{"a": data.Interval{}, "b": data.Timestamp(someDay)}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Multiply, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Interval{1, 2, 3}, "b": data.Int(2)}, data.Interval{2, 4, 6}},
+				{data.Map{"a": data.Float(0.5), "b": data.Interval{0, 1, 0}}, data.Interval{0, 0, 43200000000}},
+				{data.Map{"a": data.Interval{}, "b": data.Interval{}}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Divide, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Interval{0, 0, 60000000}, "b": data.Int(4)}, data.Interval{0, 0, 15000000}},
+				{data.Map{"a": data.Interval{0, 0, 60000000}, "b": data.Int(0)}, nil},
+				{data.Map{"a": data.Int(4), "b": data.Interval{0, 0, 60000000}}, nil},
+			},
+		},
+		{parser.UnaryOpAST{parser.UnaryMinus, parser.RowValue{"", "a"}},
+			[]evalTest{
+				{data.Map{"a": data.Interval{1, -2, 3}}, data.Interval{-1, 2, -3}},
+			},
+		},
+		{parser.BinaryOpAST{parser.Less, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			[]evalTest{
+				{data.Map{"a": data.Interval{0, 29, 0}, "b": data.Interval{1, 0, 0}}, data.Bool(true)},
+				{data.Map{"a": data.Interval{0, 30, 0}, "b": data.Interval{1, 0, 0}}, data.Bool(false)},
+				{data.Map{"a": data.Interval{}, "b": data.Int(1)}, nil},
+			},
+		},
 		/// Function Application
 		{parser.FuncAppAST{parser.FuncName("plusone"),
 			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
@@ -0,0 +1,328 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"time"
+)
+
+// reorderBuffer holds the tuples of an input stream that has an
+// allowed lateness until they can be processed in the order of their
+// timestamps (event time) rather than in the order of their arrival.
+//
+// The watermark of the stream is the largest timestamp seen so far
+// minus the allowed lateness. A tuple is held back until the watermark
+// has reached its timestamp, so as long as tuples are not delayed by
+// more than the lateness, the results are the same as if the tuples
+// had arrived in order. Tuples with a timestamp before the watermark
+// are late and are handled according to the policy.
+//
+// The watermark only advances with the timestamps of the input tuples.
+// The tuples still held back when the input ends are released by
+// flush.
+type reorderBuffer struct {
+	lateness time.Duration
+	policy   parser.LateTuplePolicy
+	// tuples holds *core.Tuple items sorted by timestamp
+	tuples *list.List
+	// maxTimestamp is the largest timestamp seen so far
+	// (valid if started is true)
+	maxTimestamp time.Time
+	started      bool
+	// wm is the current watermark, which never goes backwards
+	wm time.Time
+}
+
+func newReorderBuffer(lateness parser.LatenessAST) *reorderBuffer {
+	policy := lateness.Policy
+	if policy == parser.UnspecifiedLateTuplePolicy {
+		policy = parser.DropLate
+	}
+	return &reorderBuffer{
+		lateness: time.Duration(intervalNanoseconds(lateness.IntervalAST)),
+		policy:   policy,
+		tuples:   list.New(),
+	}
+}
+
+func (b *reorderBuffer) watermark() time.Time {
+	return b.wm
+}
+
+// advanceWatermark moves the watermark to the given time unless it
+// is already later.
+func (b *reorderBuffer) advanceWatermark(wm time.Time) {
+	if wm.After(b.wm) {
+		b.wm = wm
+	}
+}
+
+// isLate returns true if the watermark of the stream has already
+// passed the timestamp of the given tuple.
+func (b *reorderBuffer) isLate(t *core.Tuple) bool {
+	return b.started && t.Timestamp.Before(b.watermark())
+}
+
+// add inserts a tuple that is not late into the buffer and returns
+// all tuples that are now behind the watermark, in timestamp order.
+// Tuples with the same timestamp are kept in arrival order.
+func (b *reorderBuffer) add(t *core.Tuple) []*core.Tuple {
+	// the tuple is held across calls, so its data must be
+	// marked as shared
+	t = t.ShallowCopy()
+
+	// tuples arrive mostly in order, so we search from the back
+	e := b.tuples.Back()
+	for e != nil && e.Value.(*core.Tuple).Timestamp.After(t.Timestamp) {
+		e = e.Prev()
+	}
+	if e == nil {
+		b.tuples.PushFront(t)
+	} else {
+		b.tuples.InsertAfter(t, e)
+	}
+	if !b.started || t.Timestamp.After(b.maxTimestamp) {
+		b.maxTimestamp = t.Timestamp
+		b.started = true
+	}
+	b.advanceWatermark(b.maxTimestamp.Add(-b.lateness))
+	return b.release()
+}
+
+// flush advances the watermark to the largest timestamp seen so far
+// and returns all tuples in the buffer in timestamp order. It is used
+// when no more tuples will arrive.
+func (b *reorderBuffer) flush() []*core.Tuple {
+	if !b.started {
+		return nil
+	}
+	b.advanceWatermark(b.maxTimestamp)
+	return b.release()
+}
+
+// release removes all tuples that are behind the watermark from the
+// buffer and returns them in timestamp order.
+func (b *reorderBuffer) release() []*core.Tuple {
+	var ready []*core.Tuple
+	watermark := b.watermark()
+	var next *list.Element
+	for e := b.tuples.Front(); e != nil; e = next {
+		next = e.Next()
+		tup := e.Value.(*core.Tuple)
+		if tup.Timestamp.After(watermark) {
+			break
+		}
+		ready = append(ready, tup)
+		b.tuples.Remove(e)
+	}
+	return ready
+}
+
+// processInEventTime passes the given tuple through the reorder buffer
+// of its input stream (if there is one) and processes all tuples that
+// become ready in timestamp order.
+func (ep *streamRelationStreamExecutionPlan) processInEventTime(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	b, ok := ep.reorderBuffers[input.InputName]
+	if !ok {
+		return ep.processTuple(input, performQueryOnBuffer)
+	}
+
+	if b.isLate(input) {
+		switch b.policy {
+		case parser.CorrectLate:
+			return ep.processTuple(input, performQueryOnBuffer)
+		case parser.ReportLate:
+			// returning an error makes the topology report the
+			// tuple to the dropped_tuples source
+			return nil, fmt.Errorf("tuple with timestamp %s arrived after the "+
+				"watermark %s of '%s'", data.Timestamp(input.Timestamp),
+				data.Timestamp(b.watermark()), input.InputName)
+		default:
+			return nil, nil
+		}
+	}
+
+	return ep.processReleasedTuples(b.add(input), performQueryOnBuffer)
+}
+
+// flushReorderBuffers releases all tuples held in the reorder buffers
+// and processes them in timestamp order as if the input streams had
+// ended. Then it closes the TUMBLING, HOPPING and SESSION windows that
+// have ended before the new watermark. Windows that are still open at
+// the largest timestamp seen are not closed because the end of the
+// input does not tell anything about the event time.
+func (ep *streamRelationStreamExecutionPlan) flushReorderBuffers(performQueryOnBuffer func() error) ([]data.Map, error) {
+	if len(ep.reorderBuffers) == 0 {
+		return nil, nil
+	}
+	ep.now = time.Now().In(time.UTC)
+
+	// process the buffers in a fixed order
+	names := make([]string, 0, len(ep.reorderBuffers))
+	for name := range ep.reorderBuffers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var released []*core.Tuple
+	var wm time.Time
+	started := false
+	for _, name := range names {
+		b := ep.reorderBuffers[name]
+		released = append(released, b.flush()...)
+		// buffers which haven't received a tuple yet don't have
+		// a watermark
+		if !b.started {
+			continue
+		}
+		if !started || b.watermark().Before(wm) {
+			wm = b.watermark()
+			started = true
+		}
+	}
+
+	// the tuples released from different buffers are processed in
+	// timestamp order, too (the sort is stable, so tuples having the
+	// same timestamp are processed in the order of the buffer names)
+	sort.Stable(tuplesByTimestamp(released))
+	output, err := ep.processReleasedTuples(released, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return output, nil
+	}
+
+	out, err := ep.closeWindowsBefore(wm, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+	return append(output, out...), nil
+}
+
+// closeWindowsBefore evaluates the TUMBLING, HOPPING and SESSION windows
+// that would be closed by a tuple with the given timestamp and returns
+// the data to be emitted.
+func (ep *streamRelationStreamExecutionPlan) closeWindowsBefore(wm time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	switch {
+	case ep.window != nil:
+		w := ep.window
+		if !w.timeBased || w.count == 0 || !w.hasTuplesFrom(w.nextEnd-w.size) {
+			return nil, nil
+		}
+		return ep.closeHoppingWindows(wm.UnixNano(), performQueryOnBuffer)
+	case ep.sessions != nil:
+		gap := ep.sessions.gap
+		return ep.closeSessions(func(s *session) bool {
+			return !s.last.Add(gap).After(wm)
+		}, performQueryOnBuffer)
+	}
+	return nil, nil
+}
+
+// saveReorderBuffers returns the tuples held in the reorder buffers
+// in the order of the input names and the rest of the state of the
+// buffers.
+func (ep *streamRelationStreamExecutionPlan) saveReorderBuffers() ([]*core.Tuple, data.Map) {
+	names := make([]string, 0, len(ep.reorderBuffers))
+	for name := range ep.reorderBuffers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var tuples []*core.Tuple
+	state := data.Map{}
+	for _, name := range names {
+		b := ep.reorderBuffers[name]
+		for e := b.tuples.Front(); e != nil; e = e.Next() {
+			tuples = append(tuples, e.Value.(*core.Tuple))
+		}
+		state[name] = data.Map{
+			"num_tuples":    data.Int(b.tuples.Len()),
+			"max_timestamp": data.Timestamp(b.maxTimestamp),
+			"started":       data.Bool(b.started),
+			"watermark":     data.Timestamp(b.wm),
+		}
+	}
+	return tuples, state
+}
+
+// restoreReorderBuffers restores the reorder buffers saved by
+// saveReorderBuffers from the last tuples of the given ones. It
+// returns the number of tuples used.
+func (ep *streamRelationStreamExecutionPlan) restoreReorderBuffers(tuples []*core.Tuple, v data.Value) (int, error) {
+	state, err := data.AsMap(v)
+	if err != nil {
+		return 0, err
+	}
+	names := make([]string, 0, len(ep.reorderBuffers))
+	for name := range ep.reorderBuffers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	states := make([]data.Map, len(names))
+	total := 0
+	for i, name := range names {
+		m, err := data.AsMap(state[name])
+		if err != nil {
+			return 0, fmt.Errorf("invalid state of the reorder buffer of '%s': %v", name, err)
+		}
+		n, err := data.AsInt(m["num_tuples"])
+		if err != nil {
+			return 0, err
+		}
+		states[i] = m
+		total += int(n)
+	}
+	if total > len(tuples) {
+		return 0, fmt.Errorf("the reorder buffers have %d tuples but only %d are given",
+			total, len(tuples))
+	}
+
+	held := tuples[len(tuples)-total:]
+	for i, name := range names {
+		b := ep.reorderBuffers[name]
+		m := states[i]
+		n, _ := data.AsInt(m["num_tuples"])
+		if b.maxTimestamp, err = data.AsTimestamp(m["max_timestamp"]); err != nil {
+			return 0, err
+		}
+		if b.started, err = data.AsBool(m["started"]); err != nil {
+			return 0, err
+		}
+		if b.wm, err = data.AsTimestamp(m["watermark"]); err != nil {
+			return 0, err
+		}
+		for _, t := range held[:n] {
+			b.tuples.PushBack(t)
+		}
+		held = held[n:]
+	}
+	return total, nil
+}
+
+// tuplesByTimestamp sorts tuples by their timestamps.
+type tuplesByTimestamp []*core.Tuple
+
+func (t tuplesByTimestamp) Len() int           { return len(t) }
+func (t tuplesByTimestamp) Less(i, j int) bool { return t[i].Timestamp.Before(t[j].Timestamp) }
+func (t tuplesByTimestamp) Swap(i, j int)      { t[i], t[j] = t[j], t[i] }
+
+// processReleasedTuples processes the tuples released from a reorder
+// buffer in the given order.
+func (ep *streamRelationStreamExecutionPlan) processReleasedTuples(tuples []*core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	var output []data.Map
+	for _, t := range tuples {
+		out, err := ep.processTuple(t, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, out...)
+	}
+	return output, nil
+}
@@ -0,0 +1,262 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func TestEventTimeProcessing(t *testing.T) {
+	Convey("Given a RANGE window with a LATENESS", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s
+			FROM src [RANGE 2 SECONDS, LATENESS 2 SECONDS]`
+
+		Convey("When feeding it with tuples in order and out of order", func() {
+			live, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+			replay, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			tuples := getTuples(8)
+			var liveOut []data.Map
+			for _, inTup := range tuples {
+				out, err := live.Process(inTup.Copy())
+				So(err, ShouldBeNil)
+				liveOut = append(liveOut, out...)
+			}
+			var replayOut []data.Map
+			for _, idx := range []int{1, 0, 2, 4, 3, 5, 7, 6} {
+				out, err := replay.Process(tuples[idx].Copy())
+				So(err, ShouldBeNil)
+				replayOut = append(replayOut, out...)
+			}
+
+			Convey("Then both should emit the same results", func() {
+				So(len(liveOut), ShouldEqual, 6)
+				So(replayOut, ShouldResemble, liveOut)
+			})
+		})
+
+		Convey("When feeding it with a tuple behind the watermark", func() {
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			tuples := getTuples(6)
+			for _, inTup := range tuples[2:] {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+			out, err := plan.Process(tuples[0])
+
+			Convey("Then it should be dropped", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			})
+		})
+	})
+
+	Convey("Given a RANGE window with a LATENESS using now()", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM int, now() AS n
+			FROM src [RANGE 5 SECONDS, LATENESS 2 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When several tuples are released by one tuple", func() {
+			tuples := getTuples(3)
+			tuples[2].Timestamp = tuples[2].Timestamp.Add(10 * time.Second)
+			for _, inTup := range tuples[:2] {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+			out, err := plan.Process(tuples[2])
+			So(err, ShouldBeNil)
+
+			Convey("Then now() should be the same for all of them", func() {
+				So(len(out), ShouldEqual, 3)
+				for _, o := range out {
+					So(o["n"], ShouldHaveSameTypeAs, data.Timestamp{})
+					So(o["n"], ShouldResemble, out[0]["n"])
+				}
+			})
+		})
+	})
+
+	Convey("Given a RANGE window with a LATENESS at the end of the input", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM int
+			FROM src [RANGE 5 SECONDS, LATENESS 2 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+		tp, ok := plan.(TimedPhysicalPlan)
+		So(ok, ShouldBeTrue)
+		fp, ok := plan.(FlushablePhysicalPlan)
+		So(ok, ShouldBeTrue)
+
+		Convey("Then it should not be called by a timer", func() {
+			So(tp.TickInterval(), ShouldEqual, 0)
+		})
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getTuples(3)
+			var output []data.Map
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				output = append(output, out...)
+			}
+			So(output, ShouldResemble, []data.Map{{"int": data.Int(1)}})
+
+			Convey("Then the wall clock should not release the buffered tuples", func() {
+				out, err := tp.Tick(time.Now().Add(time.Hour))
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			})
+
+			Convey("Then flushing it should release the buffered tuples", func() {
+				out, err := fp.Flush()
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(3)}})
+
+				Convey("And a tuple behind the advanced watermark should be dropped", func() {
+					late := tuples[1].Copy()
+					out, err := plan.Process(late)
+					So(err, ShouldBeNil)
+					So(out, ShouldBeEmpty)
+				})
+
+				Convey("And flushing it again should return nothing", func() {
+					out, err := fp.Flush()
+					So(err, ShouldBeNil)
+					So(out, ShouldBeEmpty)
+				})
+			})
+		})
+	})
+
+	Convey("Given a TUMBLING window with a LATENESS at the end of the input", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [TUMBLING 2 SECONDS, LATENESS 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		fp, ok := plan.(FlushablePhysicalPlan)
+		So(ok, ShouldBeTrue)
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range getTuples(3) {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+
+			Convey("Then flushing it should only close the window before the last tuple", func() {
+				out, err := fp.Flush()
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+			})
+		})
+	})
+
+	Convey("Given a JOIN of streams with a LATENESS", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:int AS l, src2:int AS r
+			FROM src1 [RANGE 5 SECONDS, LATENESS 2 SECONDS],
+				src2 [RANGE 5 SECONDS, LATENESS 2 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+		fp, ok := plan.(FlushablePhysicalPlan)
+		So(ok, ShouldBeTrue)
+
+		Convey("When only the second stream receives tuples", func() {
+			tuples := getTuples(3)
+			for _, inTup := range tuples {
+				inTup.InputName = "src2"
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then flushing it should release the tuples of the second stream", func() {
+				_, err := fp.Flush()
+				So(err, ShouldBeNil)
+				ep := plan.(*defaultSelectExecutionPlan)
+				So(ep.reorderBuffers["src2"].tuples.Len(), ShouldEqual, 0)
+			})
+		})
+
+		Convey("When both streams receive tuples", func() {
+			tuples := getTuples(4)
+			// src2 gets tuples at 0s and 2s, src1 at 1s and 3s
+			for i, inTup := range tuples {
+				inTup.InputName = fmt.Sprintf("src%d", 2-i%2)
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then flushing it should join the released tuples in timestamp order", func() {
+				out, err := fp.Flush()
+				So(err, ShouldBeNil)
+				// the tuple of src2 at 2s is joined before the tuple
+				// of src1 at 3s arrives
+				So(len(out), ShouldEqual, 3)
+				So(out[0], ShouldResemble, data.Map{"l": data.Int(2), "r": data.Int(3)})
+			})
+		})
+	})
+
+	Convey("Given a RANGE window with a LATENESS reporting late tuples", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM int
+			FROM src [RANGE 2 SECONDS, LATENESS 1 SECONDS REPORT LATE]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with a tuple behind the watermark", func() {
+			tuples := getTuples(4)
+			for _, inTup := range tuples[2:] {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+			_, err := plan.Process(tuples[0])
+
+			Convey("Then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "arrived after the watermark")
+			})
+		})
+	})
+
+	Convey("Given a TUMBLING window with a LATENESS correcting late tuples", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [TUMBLING 2 SECONDS, LATENESS 1 SECONDS CORRECT LATE]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getTuples(6)
+			late := tuples[0].Copy()
+			late.Timestamp = late.Timestamp.Add(500 * time.Millisecond)
+			input := []*core.Tuple{tuples[0], tuples[1], tuples[2], tuples[3],
+				late, tuples[4], tuples[5]}
+			expected := [][]data.Map{
+				{},
+				{},
+				{},
+				{{"c": data.Int(2)}},
+				{{"c": data.Int(3)}},
+				{},
+				{{"c": data.Int(2)}},
+			}
+			for idx, inTup := range input {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then windows should be emitted after the watermark in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,209 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// Explain analyzes and optimizes the given SELECT statement and creates its
+// physical plan in the same way as a BQL box does, but it doesn't execute
+// the plan. It returns the description of the plan as a data.Map having the
+// following fields:
+//
+//	* physical_plan: the name of the plan, one of "default", "groupby",
+//	  and "filter"
+//	* base_plan: "stream_relation_stream" when the plan computes results
+//	  with the stream-relation-stream model, i.e. it evaluates the query
+//	  on the whole window every time a tuple arrives
+//	* grouping: true if the statement has aggregates or GROUP BY
+//	* emitter: the emitter type and its options
+//	* udfs: UDFs used in the statement with their arity
+//	* windows: the window buffer of each input relation. When the plan
+//	  is a stream_relation_stream plan, the predicate evaluated before
+//	  a tuple is added to the buffer ("filter") and the keys of the
+//	  tuple that are kept in the buffer ("columns") are also included
+//	  if LogicalOptimize computed them
+//
+// Subqueries in the FROM clause must be replaced with streams before the
+// statement is passed to Explain.
+func Explain(s parser.SelectStmt, reg udf.FunctionRegistry) (data.Map, error) {
+	analyzedPlan, err := Analyze(s, reg)
+	if err != nil {
+		return nil, err
+	}
+	optimizedPlan, err := analyzedPlan.LogicalOptimize()
+	if err != nil {
+		return nil, err
+	}
+	plan, err := optimizedPlan.MakePhysicalPlan(reg)
+	if err != nil {
+		return nil, err
+	}
+	udfs, err := explainUDFs(optimizedPlan, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make(data.Array, len(optimizedPlan.Relations))
+	for i, rel := range optimizedPlan.Relations {
+		windows[i] = ExplainWindow(&rel)
+	}
+	m := data.Map{
+		"physical_plan": data.String(PhysicalPlanName(plan)),
+		"grouping":      data.Bool(optimizedPlan.GroupingStmt),
+		"emitter":       explainEmitter(optimizedPlan),
+		"udfs":          udfs,
+		"windows":       windows,
+	}
+	switch plan.(type) {
+	case *defaultSelectExecutionPlan, *groupbyExecutionPlan:
+		m["base_plan"] = data.String("stream_relation_stream")
+		for i, rel := range optimizedPlan.Relations {
+			w := windows[i].(data.Map)
+			if f, ok := optimizedPlan.PushedFilters[rel.Alias]; ok {
+				w["filter"] = data.String(f.Repr())
+			}
+			if cols, ok := optimizedPlan.RequiredColumns[rel.Alias]; ok {
+				a := make(data.Array, len(cols))
+				for j, c := range cols {
+					a[j] = data.String(c)
+				}
+				w["columns"] = a
+			}
+		}
+	}
+	return m, nil
+}
+
+// PhysicalPlanName returns the name of the given physical plan used in the
+// result of Explain.
+func PhysicalPlanName(p PhysicalPlan) string {
+	switch p.(type) {
+	case *defaultSelectExecutionPlan:
+		return "default"
+	case *groupbyExecutionPlan:
+		return "groupby"
+	case *filterPlan:
+		return "filter"
+	}
+	return fmt.Sprintf("%T", p)
+}
+
+func explainEmitter(lp *LogicalPlan) data.Map {
+	m := data.Map{
+		"type": data.String(lp.EmitterType.String()),
+	}
+	if lp.EmitterLimit >= 0 {
+		m["limit"] = data.Int(lp.EmitterLimit)
+	}
+	if lp.EmitterSamplingType != parser.UnspecifiedSamplingType {
+		m["sampling"] = data.Map{
+			"type":  data.String(lp.EmitterSamplingType.String()),
+			"value": data.Float(lp.EmitterSampling),
+		}
+	}
+	return m
+}
+
+// ExplainWindow returns the description of the window buffer of the given
+// relation. Unspecified options such as the capacity of the input queue
+// aren't included in the result.
+func ExplainWindow(rel *parser.AliasedStreamWindowAST) data.Map {
+	m := data.Map{
+		"stream": data.String(rel.Name),
+		"alias":  data.String(rel.Alias),
+		"type":   data.String(rel.Window.String()),
+		"range":  explainInterval(rel.IntervalAST),
+	}
+	switch rel.Type {
+	case parser.ActualStream:
+		m["stream_type"] = data.String("stream")
+	case parser.UDSFStream:
+		m["stream_type"] = data.String("udsf")
+	case parser.SubqueryStream:
+		m["stream_type"] = data.String("subquery")
+	}
+	if rel.Slide.Unit != parser.UnspecifiedIntervalUnit {
+		m["slide"] = explainInterval(rel.Slide)
+	}
+	if rel.Lateness.Unit != parser.UnspecifiedIntervalUnit {
+		l := explainInterval(rel.Lateness.IntervalAST)
+		if rel.Lateness.Policy != parser.UnspecifiedLateTuplePolicy {
+			l["policy"] = data.String(rel.Lateness.Policy.String())
+		}
+		m["lateness"] = l
+	}
+	if rel.Capacity != parser.UnspecifiedCapacity {
+		m["capacity"] = data.Int(rel.Capacity)
+	}
+	if rel.Shedding != parser.UnspecifiedSheddingOption {
+		m["shedding"] = data.String(rel.Shedding.String())
+	}
+	return m
+}
+
+func explainInterval(i parser.IntervalAST) data.Map {
+	return data.Map{
+		"value": data.Float(i.Value),
+		"unit":  data.String(i.Unit.String()),
+	}
+}
+
+// explainUDFs returns UDFs used in the plan sorted by their names and arity.
+// Each UDF appears only once even if it's called several times.
+func explainUDFs(lp *LogicalPlan, reg udf.FunctionRegistry) (data.Array, error) {
+	var funcs []funcAppAST
+	for _, e := range lp.flatExpressions() {
+		funcs = collectFuncApps(e, funcs)
+	}
+	sort.Sort(funcAppsByName(funcs))
+
+	res := data.Array{}
+	for i, f := range funcs {
+		arity := len(f.Expressions)
+		if i > 0 && funcs[i-1].Function == f.Function && len(funcs[i-1].Expressions) == arity {
+			continue
+		}
+		u, err := reg.Lookup(string(f.Function), arity)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, data.Map{
+			"name":      data.String(f.Function),
+			"arity":     data.Int(arity),
+			"aggregate": data.Bool(isAggregateFunc(u, arity)),
+		})
+	}
+	return res, nil
+}
+
+// collectFuncApps appends all function applications in the expression to
+// funcs and returns the result.
+func collectFuncApps(e FlatExpression, funcs []funcAppAST) []funcAppAST {
+	walkFlatExpression(e, func(e FlatExpression) {
+		if f, ok := e.(funcAppAST); ok {
+			funcs = append(funcs, f)
+		}
+	})
+	return funcs
+}
+
+type funcAppsByName []funcAppAST
+
+func (f funcAppsByName) Len() int {
+	return len(f)
+}
+
+func (f funcAppsByName) Less(i, j int) bool {
+	if f[i].Function != f[j].Function {
+		return f[i].Function < f[j].Function
+	}
+	return len(f[i].Expressions) < len(f[j].Expressions)
+}
+
+func (f funcAppsByName) Swap(i, j int) {
+	f[i], f[j] = f[j], f[i]
+}
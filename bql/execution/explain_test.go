@@ -0,0 +1,148 @@
+package execution
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func explainSelect(s string) (data.Map, error) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	_stmt, _, err := parser.New().ParseStmt(s)
+	So(err, ShouldBeNil)
+	So(_stmt, ShouldHaveSameTypeAs, parser.SelectStmt{})
+	return Explain(_stmt.(parser.SelectStmt), reg)
+}
+
+func TestExplain(t *testing.T) {
+	Convey("Given a SELECT statement which can be processed by a filter plan", t, func() {
+		s := `SELECT RSTREAM abs(a) AS x FROM s [RANGE 1 TUPLES] WHERE abs(b) > 2`
+
+		Convey("When explaining it", func() {
+			e, err := explainSelect(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should use the filter plan", func() {
+				So(e["physical_plan"], ShouldEqual, data.String("filter"))
+				So(e, ShouldNotContainKey, "base_plan")
+				So(e["grouping"], ShouldEqual, data.False)
+			})
+
+			Convey("Then it should have the emitter", func() {
+				So(e["emitter"], ShouldResemble, data.Map{"type": data.String("RSTREAM")})
+			})
+
+			Convey("Then UDFs should appear only once", func() {
+				So(e["udfs"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("abs"), "arity": data.Int(1), "aggregate": data.False},
+				})
+			})
+
+			Convey("Then it should have the window", func() {
+				So(e["windows"], ShouldResemble, data.Array{
+					data.Map{
+						"stream":      data.String("s"),
+						"alias":       data.String("s"),
+						"stream_type": data.String("stream"),
+						"type":        data.String("RANGE"),
+						"range": data.Map{
+							"value": data.Float(1),
+							"unit":  data.String("TUPLES"),
+						},
+					},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement having emitter options and queue options", t, func() {
+		s := `SELECT ISTREAM [EVERY 2-ND TUPLE LIMIT 5] a FROM
+			s [RANGE 3 SECONDS, BUFFER SIZE 10, DROP OLDEST IF FULL] AS t`
+
+		Convey("When explaining it", func() {
+			e, err := explainSelect(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should use the default plan", func() {
+				So(e["physical_plan"], ShouldEqual, data.String("default"))
+				So(e["base_plan"], ShouldEqual, data.String("stream_relation_stream"))
+			})
+
+			Convey("Then it should have the emitter options", func() {
+				So(e["emitter"], ShouldResemble, data.Map{
+					"type":  data.String("ISTREAM"),
+					"limit": data.Int(5),
+					"sampling": data.Map{
+						"type":  data.String("EVERY k-TH TUPLE"),
+						"value": data.Float(2),
+					},
+				})
+			})
+
+			Convey("Then it should have the window with queue options", func() {
+				w := e["windows"].(data.Array)[0].(data.Map)
+				So(w["alias"], ShouldEqual, data.String("t"))
+				So(w["range"], ShouldResemble, data.Map{
+					"value": data.Float(3),
+					"unit":  data.String("SECONDS"),
+				})
+				So(w["capacity"], ShouldEqual, data.Int(10))
+				So(w["shedding"], ShouldEqual, data.String("DROP OLDEST"))
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement whose WHERE clause can be pushed down", t, func() {
+		s := `SELECT ISTREAM a FROM s [RANGE 3 SECONDS] WHERE b > 2`
+
+		Convey("When explaining it", func() {
+			e, err := explainSelect(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then the window should have the pushed down filter", func() {
+				w := e["windows"].(data.Array)[0].(data.Map)
+				So(w["filter"], ShouldEqual, data.String("(s:b)>(2)"))
+			})
+
+			Convey("Then the window should have the buffered columns", func() {
+				w := e["windows"].(data.Array)[0].(data.Map)
+				So(w["columns"], ShouldResemble, data.Array{data.String("a"), data.String("b")})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement having an aggregate", t, func() {
+		s := `SELECT ISTREAM b, count(a) FROM s [RANGE 2 TUPLES] GROUP BY b`
+
+		Convey("When explaining it", func() {
+			e, err := explainSelect(s)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should use the groupby plan", func() {
+				So(e["physical_plan"], ShouldEqual, data.String("groupby"))
+				So(e["grouping"], ShouldEqual, data.True)
+			})
+
+			Convey("Then the UDF should be an aggregate", func() {
+				So(e["udfs"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("count"), "arity": data.Int(1), "aggregate": data.True},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement having an undefined UDF", t, func() {
+		s := `SELECT ISTREAM no_such_udf(a) FROM s [RANGE 2 TUPLES]`
+
+		Convey("When explaining it", func() {
+			_, err := explainSelect(s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
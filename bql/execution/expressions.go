@@ -114,11 +114,12 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		// to work around this; we have chosen to use a special FlatExpr
 		// for the IS [NOT] MISSING expression:
 		if _, ok := obj.Right.(parser.Missing); ok {
-			if rv, ok := left.(rowValue); ok {
+			switch left.(type) {
+			case rowValue, lambdaParam:
 				if obj.Op == parser.Is {
-					return missing{rv, false}, nil
+					return missing{left, false}, nil
 				} else if obj.Op == parser.IsNot {
-					return missing{rv, true}, nil
+					return missing{left, true}, nil
 				}
 				// actually the parser should not allow
 				// any operators except IS and IS NOT
@@ -151,6 +152,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && len(obj.Ordering) == 0 {
 			return stmtMeta{parser.NowMeta}, nil
 		}
+		// exception for higher-order functions
+		if hasLambdaParam(obj) {
+			return higherOrderFuncAppToFlatExpr(obj, reg)
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -236,11 +241,77 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return caseAST{ref, c.Checks, c.Default}, nil
 	case parser.Wildcard:
 		return wildcardAST{obj.Relation}, nil
+	case parser.LambdaParam:
+		return lambdaParam{obj.Column}, nil
+	case parser.LambdaAST:
+		return nil, fmt.Errorf("lambda expression '%s' can only be used "+
+			"as a parameter of a higher-order function", obj)
 	}
 	err := fmt.Errorf("don't know how to convert type %#v", e)
 	return nil, err
 }
 
+// higherOrderFuncs lists the functions that receive a lambda expression
+// as their second parameter. They are not looked up in the function
+// registry but evaluated by higherOrderFuncApp.
+var higherOrderFuncs = map[string]bool{
+	"filter":    true,
+	"transform": true,
+}
+
+// hasLambdaParam returns whether any parameter of the function
+// application is a lambda expression.
+func hasLambdaParam(f parser.FuncAppAST) bool {
+	for _, e := range f.Expressions {
+		if _, ok := e.(parser.LambdaAST); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// higherOrderFuncAppToFlatExpr converts an application of a higher-order
+// function such as `transform(a, x -> x * 2)` to a FlatExpression.
+// Aggregate functions cannot be used in its parameters.
+func higherOrderFuncAppToFlatExpr(f parser.FuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	name, lambda, err := checkHigherOrderFuncApp(f)
+	if err != nil {
+		return nil, err
+	}
+	array, err := ParserExprToFlatExpr(f.Expressions[0], reg)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ParserExprToFlatExpr(lambda.Body, reg)
+	if err != nil {
+		return nil, err
+	}
+	return higherOrderFuncAppAST{parser.FuncName(name), array, lambda.Param, body}, nil
+}
+
+// checkHigherOrderFuncApp validates an application of a higher-order
+// function and returns the lower-cased function name and the lambda
+// expression passed as the second parameter.
+func checkHigherOrderFuncApp(f parser.FuncAppAST) (string, parser.LambdaAST, error) {
+	name := strings.ToLower(string(f.Function))
+	if !higherOrderFuncs[name] {
+		return "", parser.LambdaAST{}, fmt.Errorf("function '%s' does not accept lambda expressions", f.Function)
+	} else if len(f.Ordering) > 0 {
+		return "", parser.LambdaAST{}, fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
+			"function '%s'", f.Function)
+	}
+	var lambda parser.LambdaAST
+	ok := len(f.Expressions) == 2
+	if ok {
+		lambda, ok = f.Expressions[1].(parser.LambdaAST)
+	}
+	if !ok {
+		return "", parser.LambdaAST{}, fmt.Errorf("function '%s' requires an array and a lambda "+
+			"expression as parameters", f.Function)
+	}
+	return name, lambda, nil
+}
+
 // ParserExprToMaybeAggregate converts an expression obtained by the BQL
 // parser into a data structure where the aggregate and the non-aggregate
 // parts are separated.
@@ -265,11 +336,12 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		// to work around this; we have chosen to use a special FlatExpr
 		// for the IS [NOT] MISSING expression:
 		if _, ok := obj.Right.(parser.Missing); ok {
-			if rv, ok := left.(rowValue); ok {
+			switch left.(type) {
+			case rowValue, lambdaParam:
 				if obj.Op == parser.Is {
-					return missing{rv, false}, leftAgg, nil
+					return missing{left, false}, leftAgg, nil
 				} else if obj.Op == parser.IsNot {
-					return missing{rv, true}, leftAgg, nil
+					return missing{left, true}, leftAgg, nil
 				}
 				// actually the parser should not allow
 				// any operators except IS and IS NOT
@@ -311,6 +383,24 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 {
 			return stmtMeta{parser.NowMeta}, nil, nil
 		}
+		// exception for higher-order functions: the array parameter
+		// may contain aggregates as in `transform(array_agg(a), x -> x * 2)`
+		// but the lambda expression must not
+		if hasLambdaParam(obj) {
+			name, lambda, err := checkHigherOrderFuncApp(obj)
+			if err != nil {
+				return nil, nil, err
+			}
+			array, agg, err := ParserExprToMaybeAggregate(obj.Expressions[0], aggIdx, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			body, err := ParserExprToFlatExpr(lambda.Body, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			return higherOrderFuncAppAST{parser.FuncName(name), array, lambda.Param, body}, agg, nil
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -715,6 +805,38 @@ func (f funcAppAST) ContainsWildcard() bool {
 	return false
 }
 
+// higherOrderFuncAppAST is an application of a higher-order function
+// such as `transform(a, x -> x * 2)`, where Param and Body make up the
+// lambda expression.
+type higherOrderFuncAppAST struct {
+	Function parser.FuncName
+	Array    FlatExpression
+	Param    string
+	Body     FlatExpression
+}
+
+func (h higherOrderFuncAppAST) Repr() string {
+	return fmt.Sprintf("%s(%s,%s->%s)", h.Function, h.Array.Repr(), h.Param, h.Body.Repr())
+}
+
+func (h higherOrderFuncAppAST) Columns() []rowValue {
+	return append(h.Array.Columns(), h.Body.Columns()...)
+}
+
+func (h higherOrderFuncAppAST) Volatility() VolatilityType {
+	// the functions themselves are immutable
+	a := h.Array.Volatility()
+	b := h.Body.Volatility()
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func (h higherOrderFuncAppAST) ContainsWildcard() bool {
+	return h.Array.ContainsWildcard() || h.Body.ContainsWildcard()
+}
+
 type sortExpression struct {
 	Value     aggInputRef
 	Ascending bool
@@ -968,6 +1090,28 @@ func (rv rowValue) ContainsWildcard() bool {
 	return false
 }
 
+// lambdaParam is a reference to the parameter of an enclosing
+// lambda expression, possibly followed by a JSON Path.
+type lambdaParam struct {
+	Column string
+}
+
+func (lp lambdaParam) Repr() string {
+	return fmt.Sprintf("->%s", lp.Column)
+}
+
+func (lp lambdaParam) Columns() []rowValue {
+	return nil
+}
+
+func (lp lambdaParam) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (lp lambdaParam) ContainsWildcard() bool {
+	return false
+}
+
 type stmtMeta struct {
 	MetaType parser.MetaInformation
 }
@@ -1068,8 +1212,10 @@ func (l nullLiteral) ContainsWildcard() bool {
 	return false
 }
 
+// missing checks whether the path of a column or a lambda parameter
+// is missing. Expr is either a rowValue or a lambdaParam.
 type missing struct {
-	Expr rowValue
+	Expr FlatExpression
 	Not  bool
 }
 
@@ -21,6 +21,9 @@ func CanBuildFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
 	}
 	return !lp.GroupingStmt &&
 		lp.EmitterType == parser.Rstream &&
+		len(lp.Ordering) == 0 && lp.Limit < 0 && lp.Offset == 0 &&
+		lp.Relations[0].Window == parser.RangeWindow &&
+		lp.Relations[0].Lateness.Unit == parser.UnspecifiedIntervalUnit &&
 		lp.Relations[0].Unit == parser.Tuples &&
 		lp.Relations[0].Value == 1
 }
@@ -92,3 +95,13 @@ func (ep *filterPlan) Process(input *core.Tuple) ([]data.Map, error) {
 
 	return []data.Map{result}, nil
 }
+
+// WindowTuples returns nil because a filterPlan doesn't hold any tuples.
+func (ep *filterPlan) WindowTuples() ([]*core.Tuple, data.Map, error) {
+	return nil, nil, nil
+}
+
+// RestoreWindows does nothing because a filterPlan doesn't hold any tuples.
+func (ep *filterPlan) RestoreWindows(tuples []*core.Tuple, state data.Map) error {
+	return nil
+}
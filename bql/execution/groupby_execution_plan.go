@@ -5,10 +5,15 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
 )
 
 type groupbyExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// incremental holds the states of the aggregate functions
+	// if they are updated incrementally when rows enter and leave
+	// the window, or nil if all groups are recomputed in every run.
+	incremental *incrementalAggregation
 }
 
 // tmpGroupData is an intermediate data structure to represent
@@ -41,13 +46,33 @@ func CanBuildGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) boo
 // - perform a SELECT query on that data,
 // - compute the data that need to be emitted by comparison with
 //   the previous run's results.
+//
+// If all aggregate functions implement udf.UDAF and the window is
+// evaluated every time a tuple arrives, the aggregates are not computed
+// from all rows in the window, but updated with the rows that entered
+// and left the window.
 func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, error) {
 	underlying, err := newStreamRelationStreamExecutionPlan(lp, reg)
 	if err != nil {
 		return nil, err
 	}
+	var incremental *incrementalAggregation
+	// TUMBLING, HOPPING and SESSION windows as well as joins that
+	// are recomputed in every run replace the whole list of input
+	// rows, so there are no changes to apply
+	if underlying.window == nil && underlying.sessions == nil &&
+		(underlying.join == nil || !underlying.join.recompute) {
+		incremental, err = newIncrementalAggregation(lp, reg)
+		if err != nil {
+			return nil, err
+		}
+		if incremental != nil {
+			underlying.rowChanges = &inputRowChanges{}
+		}
+	}
 	return &groupbyExecutionPlan{
 		*underlying,
+		incremental,
 	}, nil
 }
 
@@ -59,6 +84,23 @@ func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
 	return ep.process(input, ep.performQueryOnBuffer)
 }
 
+// Tick closes timed out windows and returns the data to be emitted.
+func (ep *groupbyExecutionPlan) Tick(now time.Time) ([]data.Map, error) {
+	return ep.tick(now, ep.performQueryOnBuffer)
+}
+
+// Flush processes the tuples held in the reorder buffers of input
+// streams with a LATENESS and returns the data to be emitted.
+func (ep *groupbyExecutionPlan) Flush() ([]data.Map, error) {
+	return ep.flushReorderBuffers(ep.performQueryOnBuffer)
+}
+
+// RestoreWindows restores the windows returned by WindowTuples of
+// another instance of the same plan.
+func (ep *groupbyExecutionPlan) RestoreWindows(tuples []*core.Tuple, state data.Map) error {
+	return ep.restoreWindows(tuples, state, ep.performQueryOnBuffer)
+}
+
 // performQueryOnBuffer computes the projections of a SELECT query on the data
 // stored in `ep.filteredInputRows`. The query results (which is a set of
 // data.Value, not core.Tuple) is stored in ep.curResults. The data
@@ -71,6 +113,10 @@ func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
 // if no error had happened), but the contents of ep.curResults are
 // undefined.
 func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
+	if ep.incremental != nil {
+		return ep.performIncrementalQuery()
+	}
+
 	// reuse the allocated memory
 	output := ep.prevResults[0:0]
 	// remember the previous results
@@ -206,87 +252,30 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	}
 
 	evalGroup := func(group *tmpGroupData) error {
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		// collect input for aggregate functions into an array
 		// within each group
 		for key := range allAggEvaluators {
 			group.nonAggData[key] = data.Array(group.aggData[key])
 			delete(group.aggData, key)
 		}
-		// evaluate HAVING condition, if there is one
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				havingResult, err := proj.evaluator.Eval(group.nonAggData)
-				if err != nil {
-					return err
-				}
-				// a NULL value is definitely not "true", so since we
-				// have only a binary decision, we should drop tuples
-				// where the condition evaluates to NULL
-				havingResultBool := false
-				if havingResult.Type() != data.TypeNull {
-					havingResultBool, err = data.AsBool(havingResult)
-					if err != nil {
-						return err
-					}
-				}
-				// if it evaluated to false, do not further process this group
-				if !havingResultBool {
-					return nil
-				}
-				break
-			}
+		result, err := evalGroupProjections(ep.projections, group.nonAggData)
+		if err != nil {
+			return err
 		}
-		// now evaluate all other projections
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				continue
-			}
-			// now evaluate this projection on the flattened data
-			value, err := proj.evaluator.Eval(group.nonAggData)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
+		if result != nil {
+			output = append(output, *result)
 		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
 		return nil
 	}
 
 	evalNoGroup := func() error {
-		// if we have an empty group list *and* a GROUP BY clause,
-		// we have to return an empty result (because there are no
-		// rows with "the same values"). but if the list is empty and
-		// we *don't* have a GROUP BY clause, then we need to compute
-		// all foldables and aggregates with an empty input
-		if len(ep.groupList) > 0 {
-			return nil
+		result, err := ep.evalEmptyInput()
+		if err != nil {
+			return err
 		}
-		input := data.Map{}
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
-		for _, proj := range ep.projections {
-			// collect input for aggregate functions
-			if proj.hasAggregate {
-				for key := range proj.aggrEvals {
-					input[key] = data.Array{}
-				}
-			}
-			// now evaluate this projection on the flattened data.
-			// note that input has *only* the keys of the empty
-			// arrays, no other columns, but we cannot have other
-			// columns involved in the projection (since we know
-			// that GROUP BY is empty).
-			value, err := proj.evaluator.Eval(input)
-			if err != nil {
-				return err
-			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
-			}
+		if result != nil {
+			output = append(output, *result)
 		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
 		return nil
 	}
 
@@ -321,3 +310,81 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	ep.curResults = output
 	return nil
 }
+
+// evalGroupProjections evaluates the HAVING condition and the
+// projections on the data of a group, where the input of each
+// aggregate function is stored under its key. It returns nil if
+// the HAVING condition is not fulfilled.
+func evalGroupProjections(projections []aliasedEvaluator, input data.Map) (*resultRow, error) {
+	result := data.Map(make(map[string]data.Value, len(projections)))
+	var sortKey data.Array
+	// evaluate HAVING condition, if there is one
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			havingResult, err := proj.evaluator.Eval(input)
+			if err != nil {
+				return nil, err
+			}
+			// a NULL value is definitely not "true", so since we
+			// have only a binary decision, we should drop tuples
+			// where the condition evaluates to NULL
+			havingResultBool := false
+			if havingResult.Type() != data.TypeNull {
+				havingResultBool, err = data.AsBool(havingResult)
+				if err != nil {
+					return nil, err
+				}
+			}
+			// if it evaluated to false, do not further process this group
+			if !havingResultBool {
+				return nil, nil
+			}
+			break
+		}
+	}
+	// now evaluate all other projections
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			continue
+		}
+		// now evaluate this projection on the flattened data
+		value, err := proj.evaluator.Eval(input)
+		if err != nil {
+			return nil, err
+		}
+		if proj.isSortKey() {
+			sortKey = append(sortKey, value)
+			continue
+		}
+		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return &resultRow{row: result, hash: data.Hash(result), sortKey: sortKey}, nil
+}
+
+// evalEmptyInput computes the result of the statement if there are
+// no input rows at all. It returns nil if there is no result.
+func (ep *groupbyExecutionPlan) evalEmptyInput() (*resultRow, error) {
+	// if we have an empty group list *and* a GROUP BY clause,
+	// we have to return an empty result (because there are no
+	// rows with "the same values"). but if the list is empty and
+	// we *don't* have a GROUP BY clause, then we need to compute
+	// all foldables and aggregates with an empty input
+	if len(ep.groupList) > 0 {
+		return nil, nil
+	}
+	// collect input for aggregate functions. note that input
+	// has *only* the keys of the empty arrays, no other columns,
+	// but we cannot have other columns involved in the projections
+	// (since we know that GROUP BY is empty).
+	input := data.Map{}
+	for _, proj := range ep.projections {
+		if proj.hasAggregate {
+			for key := range proj.aggrEvals {
+				input[key] = data.Array{}
+			}
+		}
+	}
+	return evalGroupProjections(ep.projections, input)
+}
@@ -16,6 +16,8 @@ func createGroupbyPlan(s string, t *testing.T) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 	reg.Register("udaf", &dummyAggregate{})
+	reg.Register("weighted_sum", udf.AggregateFunc(udf.MustConvertGenericUDAF(newWeightedSum)))
+	reg.Register("max_state", udf.AggregateFunc(udf.MustConvertGenericUDAF(newMaxState)))
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -906,6 +908,35 @@ func TestAggregateFunctions(t *testing.T) {
 		})
 	})
 
+	Convey("Given a SELECT clause with order-sensitive aggregates and ORDER BY", t, func() {
+		tuples := getExtTuples()
+
+		s := `CREATE STREAM box AS SELECT RSTREAM first(int ORDER BY bar DESC) AS f,
+			last(int ORDER BY bar DESC) AS l, ewma(int, 0.5 ORDER BY bar DESC) AS e
+			FROM src [RANGE 3 TUPLES] WHERE int > 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+
+					if idx == 0 {
+						So(out[0], ShouldResemble, data.Map{"f": data.Null{},
+							"l": data.Null{}, "e": data.Null{}})
+					} else if idx == 3 {
+						So(out[0], ShouldResemble, data.Map{"f": data.Int(4),
+							"l": data.Int(2), "e": data.Float(2.75)})
+					}
+				})
+			}
+		})
+	})
+
 	Convey("Given a SELECT clause with array_agg and wildcard", t, func() {
 		tuples := getExtTuples()
 
@@ -1173,6 +1204,62 @@ func TestAggregateFunctions(t *testing.T) {
 			}
 		})
 	})
+
+	Convey("Given a SELECT clause with aggregates in the array of a higher-order function", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM
+			transform(array_agg(int), x -> x * 2) AS a
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := []data.Array{
+				{data.Int(2)},
+				{data.Int(2), data.Int(4)},
+				{data.Int(2), data.Int(4), data.Int(6)},
+				{data.Int(4), data.Int(6), data.Int(8)},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+					So(out[0], ShouldResemble, data.Map{"a": expected[idx]})
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with incremental aggregates in the array of a higher-order function", t, func() {
+		tuples := getTuples(4)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM
+			filter([sum(int), count(int)], x -> x > 2) AS a
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := []data.Array{
+				{},
+				{data.Int(3)},
+				{data.Int(6), data.Int(3)},
+				{data.Int(9), data.Int(3)},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then those values should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+					So(out[0], ShouldResemble, data.Map{"a": expected[idx]})
+				})
+			}
+		})
+	})
 }
 
 func createGroupbyPlan2(s string) (PhysicalPlan, error) {
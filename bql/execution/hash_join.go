@@ -0,0 +1,403 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// bufferRange specifies which tuples of an input buffer are used
+// when computing the rows of a join.
+type bufferRange int
+
+const (
+	wholeBuffer bufferRange = iota
+	withoutNewestTuple
+	onlyNewestTuple
+)
+
+// contains returns whether the given tuple lies in the range, given
+// the newest tuple of the buffer it belongs to.
+func (r bufferRange) contains(t, newest *tupleWithDerivedInputRows) bool {
+	switch r {
+	case withoutNewestTuple:
+		return t != newest
+	case onlyNewestTuple:
+		return t == newest
+	}
+	return true
+}
+
+// joinStep describes how the relation with the given alias is joined
+// with the relations that appear before it in the FROM clause.
+type joinStep struct {
+	alias    string
+	joinType parser.JoinType
+	// cond is the evaluator of the full ON condition.
+	cond Evaluator
+	// probeKeys compute the equi-join key from the rows of the preceding
+	// relations, indexKeys compute it from a row of the joined relation.
+	// Both are empty if the ON condition contains no suitable equality.
+	probeKeys []Evaluator
+	indexKeys []Evaluator
+	// nullRow is used as the joined relation's data if a LEFT JOIN
+	// does not find any matching row. It contains a NULL value for
+	// every column of the relation that is used in the statement.
+	nullRow data.Map
+}
+
+// hashJoin holds the state required to compute the input rows of
+// a statement with JOIN clauses. Instead of evaluating the ON condition
+// on every item of the cartesian product of the input buffers, it
+// maintains a hash index on the equi-join keys for each joined buffer
+// and only evaluates the ON condition for tuples with a matching key.
+type hashJoin struct {
+	steps []joinStep
+	// indexes maps the alias of a joined relation to the tuples in
+	// its buffer, keyed by the hash value of their join key. Relations
+	// without equi-join keys do not have an index.
+	indexes map[string]map[data.HashValue][]*tupleWithDerivedInputRows
+	// recompute is true if the joined rows cannot be maintained
+	// incrementally. This is the case with LEFT JOIN, where a new
+	// tuple can replace a NULL-extended row by a matching row (and
+	// an expired tuple vice versa).
+	recompute bool
+}
+
+func newHashJoin(lp *LogicalPlan, reg udf.FunctionRegistry) (*hashJoin, error) {
+	prepare := func(exprs []FlatExpression) ([]Evaluator, error) {
+		evals := make([]Evaluator, len(exprs))
+		for i, expr := range exprs {
+			eval, err := ExpressionToEvaluator(expr, reg)
+			if err != nil {
+				return nil, err
+			}
+			evals[i] = eval
+		}
+		return evals, nil
+	}
+
+	// collect all expressions in the statement so that we know which
+	// columns need to be NULL in a row that was not matched
+	usedExprs := []FlatExpression{}
+	for _, proj := range lp.Projections {
+		usedExprs = append(usedExprs, proj.expr)
+		for _, aggrInput := range proj.aggrInputs {
+			usedExprs = append(usedExprs, aggrInput)
+		}
+	}
+	if lp.Filter != nil {
+		usedExprs = append(usedExprs, lp.Filter)
+	}
+	usedExprs = append(usedExprs, lp.GroupList...)
+	usedExprs = append(usedExprs, lp.JoinConditions...)
+
+	j := &hashJoin{
+		steps:   make([]joinStep, len(lp.Joins)),
+		indexes: map[string]map[data.HashValue][]*tupleWithDerivedInputRows{},
+	}
+	preceding := map[string]bool{lp.Relations[0].Alias: true}
+	for i, join := range lp.Joins {
+		alias := lp.Relations[i+1].Alias
+		cond, err := ExpressionToEvaluator(lp.JoinConditions[i], reg)
+		if err != nil {
+			return nil, err
+		}
+		probeExprs, indexExprs := extractEquiJoinKeys(lp.JoinConditions[i], alias, preceding)
+		probeKeys, err := prepare(probeExprs)
+		if err != nil {
+			return nil, err
+		}
+		indexKeys, err := prepare(indexExprs)
+		if err != nil {
+			return nil, err
+		}
+		var nullRow data.Map
+		if join.Type == parser.LeftJoin {
+			nullRow, err = makeNullRow(alias, usedExprs)
+			if err != nil {
+				return nil, err
+			}
+			j.recompute = true
+		}
+		if len(indexKeys) > 0 {
+			j.indexes[alias] = map[data.HashValue][]*tupleWithDerivedInputRows{}
+		}
+		j.steps[i] = joinStep{alias, join.Type, cond, probeKeys, indexKeys, nullRow}
+		preceding[alias] = true
+	}
+	return j, nil
+}
+
+// splitConjunction returns the operands of a chain of AND operators,
+// e.g., [a, b, c] for `a AND (b AND c)`.
+func splitConjunction(e FlatExpression) []FlatExpression {
+	if b, ok := e.(binaryOpAST); ok && b.Op == parser.And {
+		return append(splitConjunction(b.Left), splitConjunction(b.Right)...)
+	}
+	return []FlatExpression{e}
+}
+
+// extractEquiJoinKeys looks for conditions of the form `l = r` in the
+// given ON condition, where l only refers to the preceding relations
+// and r only refers to the joined relation (or vice versa), and
+// returns the expressions for both sides. Only immutable expressions
+// are used, as the key of a buffered tuple is computed only once.
+func extractEquiJoinKeys(cond FlatExpression, alias string, preceding map[string]bool) ([]FlatExpression, []FlatExpression) {
+	refersOnlyTo := func(e FlatExpression, rels func(string) bool) bool {
+		cols := e.Columns()
+		if len(cols) == 0 || e.Volatility() != Immutable || e.ContainsWildcard() {
+			return false
+		}
+		for _, col := range cols {
+			if !rels(col.Relation) {
+				return false
+			}
+		}
+		return true
+	}
+	isPreceding := func(rel string) bool {
+		return preceding[rel]
+	}
+	isJoined := func(rel string) bool {
+		return rel == alias
+	}
+
+	var probe, index []FlatExpression
+	for _, c := range splitConjunction(cond) {
+		eq, ok := c.(binaryOpAST)
+		if !ok || eq.Op != parser.Equal {
+			continue
+		}
+		if refersOnlyTo(eq.Left, isPreceding) && refersOnlyTo(eq.Right, isJoined) {
+			probe = append(probe, eq.Left)
+			index = append(index, eq.Right)
+		} else if refersOnlyTo(eq.Right, isPreceding) && refersOnlyTo(eq.Left, isJoined) {
+			probe = append(probe, eq.Right)
+			index = append(index, eq.Left)
+		}
+	}
+	return probe, index
+}
+
+// makeNullRow creates a Map that holds a NULL value for every column
+// of the given relation used in the given expressions.
+func makeNullRow(alias string, exprs []FlatExpression) (data.Map, error) {
+	holder := data.Map{alias: data.Map{}}
+	for _, expr := range exprs {
+		for _, col := range expr.Columns() {
+			if col.Relation != alias {
+				continue
+			}
+			path, err := data.CompilePath(rowValuePath(col))
+			if err != nil {
+				return nil, err
+			}
+			if err := holder.Set(path, data.Null{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data.AsMap(holder[alias])
+}
+
+// joinKeyHash computes the hash value of the join key computed by
+// the given evaluators. If the key cannot be computed or contains
+// NULL, the second return value is false: such a row cannot fulfill
+// the equality condition with any other row.
+func joinKeyHash(keys []Evaluator, input data.Map) (data.HashValue, bool) {
+	key := make(data.Array, len(keys))
+	for i, k := range keys {
+		v, err := k.Eval(input)
+		if err != nil || v.Type() == data.TypeNull {
+			return 0, false
+		}
+		key[i] = v
+	}
+	return data.Hash(key), true
+}
+
+// step returns the joinStep for the relation with the given alias,
+// or nil if that relation is the first one in the FROM clause.
+func (j *hashJoin) step(alias string) *joinStep {
+	for i := range j.steps {
+		if j.steps[i].alias == alias {
+			return &j.steps[i]
+		}
+	}
+	return nil
+}
+
+// addToIndex adds the given tuple that was appended to the buffer with
+// the given alias to the index of that buffer, if there is one.
+func (j *hashJoin) addToIndex(alias string, t *tupleWithDerivedInputRows) {
+	index, exists := j.indexes[alias]
+	if !exists {
+		return
+	}
+	holder := data.Map{alias: t.tuple.Data[alias]}
+	setMetadata(holder, alias, t.tuple)
+	h, ok := joinKeyHash(j.step(alias).indexKeys, holder)
+	if !ok {
+		return
+	}
+	t.joinKey = h
+	t.joinKeyValid = true
+	index[h] = append(index[h], t)
+}
+
+// removeFromIndex removes the given tuple from the index of the buffer
+// with the given alias. It must be called when the tuple is removed
+// from that buffer.
+func (j *hashJoin) removeFromIndex(alias string, t *tupleWithDerivedInputRows) {
+	if !t.joinKeyValid {
+		return
+	}
+	index := j.indexes[alias]
+	bucket := index[t.joinKey]
+	for i, other := range bucket {
+		if other == t {
+			copy(bucket[i:], bucket[i+1:])
+			bucket[len(bucket)-1] = nil
+			bucket = bucket[:len(bucket)-1]
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(index, t.joinKey)
+	} else {
+		index[t.joinKey] = bucket
+	}
+	t.joinKeyValid = false
+}
+
+// joinInputTuples computes the rows of the join of all input buffers
+// and writes them to `ep.filteredInputRows`, like filterInputTuples
+// does for the cartesian product.
+func (ep *streamRelationStreamExecutionPlan) joinInputTuples() error {
+	dataHolder := data.Map{}
+	// the ON conditions may use the now() function
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+
+	// we append the joined results to a separate buffer so that
+	// we avoid having to rollback our actual buffer if something fails
+	ep.filteredInputRowsBuffer = list.New()
+
+	if ep.join.recompute {
+		// compute all rows from scratch. the rows are not linked
+		// to the tuples they originate from as the whole list is
+		// replaced in the next run anyway.
+		if err := ep.joinRelations(0, dataHolder, nil, nil); err != nil {
+			return err
+		}
+		ep.filteredInputRows = ep.filteredInputRowsBuffer
+		return nil
+	}
+
+	// as in filterInputTuples, we only compute the rows that use
+	// the newly added tuple, i.e., with buffers A, B, C all holding
+	// the new tuple t, we compute
+	//  ({t})×(B∪{t})×(C∪{t}) ∪ ( A )×( {t} )×(C∪{t}) ∪ ( A )×(  B  )×( {t} )
+	// (restricted to the rows that fulfill the ON conditions)
+	buffersWithNewTuple := make([]string, 0, len(ep.lastTupleBuffers))
+	for _, rel := range ep.relations {
+		if ep.lastTupleBuffers[rel.Alias] {
+			buffersWithNewTuple = append(buffersWithNewTuple, rel.Alias)
+		}
+	}
+	for i := range buffersWithNewTuple {
+		ranges := make(map[string]bufferRange, len(buffersWithNewTuple))
+		for j, key := range buffersWithNewTuple {
+			if j < i {
+				ranges[key] = withoutNewestTuple
+			} else if j == i {
+				ranges[key] = onlyNewestTuple
+			}
+		}
+		origin := map[string]*tupleWithDerivedInputRows{}
+		if err := ep.joinRelations(0, dataHolder, origin, ranges); err != nil {
+			return err
+		}
+	}
+	// (NB. the items appended here will be cleaned up in future
+	// runs by `removeOutdatedTuplesFromBuffer`)
+	ep.appendFilteredInputRows()
+	return nil
+}
+
+// joinRelations adds the rows of the k-th relation in the FROM clause
+// that match the rows of the preceding relations (held in dataHolder)
+// and recurses until all relations have been visited. If origin is
+// not nil, the resulting rows are linked to their originating tuples.
+func (ep *streamRelationStreamExecutionPlan) joinRelations(k int, dataHolder data.Map,
+	origin map[string]*tupleWithDerivedInputRows, ranges map[string]bufferRange) error {
+	if k == len(ep.relations) {
+		return ep.appendFilteredInputRow(dataHolder, origin)
+	}
+
+	alias := ep.relations[k].Alias
+	buffer := ep.buffers[alias]
+	rng := ranges[alias]
+	var newest *tupleWithDerivedInputRows
+	if back := buffer.tuples.Back(); back != nil {
+		newest = back.Value.(*tupleWithDerivedInputRows)
+	}
+	var step *joinStep
+	if k > 0 {
+		step = &ep.join.steps[k-1]
+	}
+
+	matched := false
+	visit := func(t *tupleWithDerivedInputRows) error {
+		if !rng.contains(t, newest) {
+			return nil
+		}
+		dataHolder[alias] = t.tuple.Data[alias]
+		setMetadata(dataHolder, alias, t.tuple)
+		if step != nil {
+			ok, err := evalCondition(step.cond, dataHolder)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+		}
+		matched = true
+		if origin != nil {
+			origin[alias] = t
+		}
+		return ep.joinRelations(k+1, dataHolder, origin, ranges)
+	}
+
+	if step != nil && len(step.probeKeys) > 0 {
+		// only look at the tuples with the same join key
+		if h, ok := joinKeyHash(step.probeKeys, dataHolder); ok {
+			for _, t := range ep.join.indexes[alias][h] {
+				if err := visit(t); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+			if err := visit(e.Value.(*tupleWithDerivedInputRows)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// a LEFT JOIN uses NULL values if no row matched
+	if !matched && step != nil && step.joinType == parser.LeftJoin && rng == wholeBuffer {
+		dataHolder[alias] = step.nullRow
+		// this key format is also used in setMetadata()
+		dataHolder[fmt.Sprintf("%s:meta:%s", alias, parser.TimestampMeta)] = data.Null{}
+		if origin != nil {
+			delete(origin, alias)
+		}
+		return ep.joinRelations(k+1, dataHolder, origin, ranges)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+	"time"
+)
+
+// getJoinTuples returns tuples that alternate between the inputs
+// "src1" and "src2", each with a key "k" from the given list.
+func getJoinTuples(keys ...int) []*core.Tuple {
+	tuples := make([]*core.Tuple, 0, len(keys))
+	for i, k := range keys {
+		tup := core.Tuple{
+			Data: data.Map{
+				"k":   data.Int(k),
+				"int": data.Int(i + 1),
+			},
+			InputName:     "src1",
+			Timestamp:     time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+			ProcTimestamp: time.Date(2015, time.April, 10, 10, 24, i, 0, time.UTC),
+			BatchID:       7,
+		}
+		if i%2 == 1 {
+			tup.InputName = "src2"
+		}
+		tuples = append(tuples, &tup)
+	}
+	return tuples
+}
+
+func TestHashJoin(t *testing.T) {
+	Convey("Given a JOIN with an equality in the ON clause", t, func() {
+		tuples := getJoinTuples(1, 2, 2, 1, 1, 3, 2, 2)
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:int AS l, src2:int AS r
+			FROM src1 [RANGE 2 TUPLES] JOIN src2 [RANGE 2 TUPLES] ON src1:k = src2:k`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then the plan should use a hash index", func() {
+			ep := plan.(*defaultSelectExecutionPlan)
+			So(ep.join, ShouldNotBeNil)
+			So(ep.join.indexes, ShouldContainKey, "src2")
+			So(len(ep.join.steps[0].probeKeys), ShouldEqual, 1)
+		})
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{},
+				{{"l": data.Int(3), "r": data.Int(2)}},
+				{{"l": data.Int(1), "r": data.Int(4)}},
+				{{"l": data.Int(5), "r": data.Int(4)}},
+				{},
+				{},
+				{{"l": data.Int(7), "r": data.Int(8)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then only matching rows should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+
+			Convey("Then expired tuples should be removed from the index", func() {
+				ep := plan.(*defaultSelectExecutionPlan)
+				numIndexed := 0
+				for _, bucket := range ep.join.indexes["src2"] {
+					numIndexed += len(bucket)
+				}
+				So(numIndexed, ShouldEqual, 2)
+			})
+		})
+	})
+
+	Convey("Given a JOIN and the equivalent cartesian product with WHERE", t, func() {
+		tuples := getJoinTuples(1, 2, 2, 1, 1, 3, 2, 2, 3, 3, 1, 1)
+		s1 := `CREATE STREAM box AS SELECT ISTREAM src1:int AS l, src2:int AS r
+			FROM src1 [RANGE 3 TUPLES] JOIN src2 [RANGE 4 TUPLES]
+			ON src2:k = src1:k AND src1:int < src2:int WHERE src2:int > 2`
+		s2 := `CREATE STREAM box AS SELECT ISTREAM src1:int AS l, src2:int AS r
+			FROM src1 [RANGE 3 TUPLES], src2 [RANGE 4 TUPLES]
+			WHERE src2:k = src1:k AND src1:int < src2:int AND src2:int > 2`
+		joinPlan, err := createDefaultSelectPlan(s1, t)
+		So(err, ShouldBeNil)
+		crossPlan, err := createDefaultSelectPlan(s2, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding them with tuples", func() {
+			for idx, inTup := range tuples {
+				joinOut, err := joinPlan.Process(inTup.Copy())
+				So(err, ShouldBeNil)
+				crossOut, err := crossPlan.Process(inTup.Copy())
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(joinOut))
+				sort.Sort(tupleList(crossOut))
+
+				Convey(fmt.Sprintf("Then both should emit the same rows in %v", idx), func() {
+					So(joinOut, ShouldResemble, crossOut)
+				})
+			}
+		})
+	})
+
+	Convey("Given a JOIN without an equality in the ON clause", t, func() {
+		tuples := getJoinTuples(1, 2, 3, 1)
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:k AS l, src2:k AS r
+			FROM src1 [RANGE 2 TUPLES] JOIN src2 [RANGE 2 TUPLES] ON src1:k < src2:k`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then the plan should not use a hash index", func() {
+			ep := plan.(*defaultSelectExecutionPlan)
+			So(ep.join.indexes, ShouldBeEmpty)
+		})
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{{"l": data.Int(1), "r": data.Int(2)}},
+				{},
+				{},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then only matching rows should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a LEFT JOIN", t, func() {
+		tuples := getJoinTuples(1, 2, 2, 1, 3, 2)
+		s := `CREATE STREAM box AS SELECT RSTREAM src1:int AS l, src2:int AS r, src2:ts() AS ts
+			FROM src1 [RANGE 2 TUPLES] LEFT JOIN src2 [RANGE 1 TUPLES] ON src1:k = src2:k`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			null := data.Null{}
+			ts := func(i int) data.Value {
+				return data.Timestamp(tuples[i].Timestamp)
+			}
+			expected := [][]data.Map{
+				{{"l": data.Int(1), "r": null, "ts": null}},
+				{{"l": data.Int(1), "r": null, "ts": null}},
+				{{"l": data.Int(1), "r": null, "ts": null},
+					{"l": data.Int(3), "r": data.Int(2), "ts": ts(1)}},
+				{{"l": data.Int(1), "r": data.Int(4), "ts": ts(3)},
+					{"l": data.Int(3), "r": null, "ts": null}},
+				{{"l": data.Int(3), "r": null, "ts": null},
+					{"l": data.Int(5), "r": null, "ts": null}},
+				{{"l": data.Int(3), "r": data.Int(6), "ts": ts(5)},
+					{"l": data.Int(5), "r": null, "ts": null}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then unmatched rows should be NULL-extended in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a self-JOIN with GROUP BY", t, func() {
+		tuples := getJoinTuples(1, 2, 1, 2)
+		for _, tup := range tuples {
+			tup.InputName = "src"
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM a:k, count(*) AS c
+			FROM src [RANGE 4 TUPLES] AS a JOIN src [RANGE 4 TUPLES] AS b
+			ON a:k = b:k GROUP BY a:k`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for _, inTup := range tuples[:3] {
+				_, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+			out, err := plan.Process(tuples[3])
+			So(err, ShouldBeNil)
+			sort.Sort(tupleList(out))
+
+			Convey("Then the joined rows should be grouped", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"k": data.Int(1), "c": data.Int(4)},
+					{"k": data.Int(2), "c": data.Int(4)},
+				})
+			})
+		})
+	})
+}
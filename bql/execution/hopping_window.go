@@ -0,0 +1,379 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
+)
+
+// windowedTuple holds an input tuple of a TUMBLING or HOPPING window
+// (together with the input rows derived from it) and its position in
+// the input stream.
+type windowedTuple struct {
+	tuple *tupleWithDerivedInputRows
+	seq   int64
+}
+
+// hoppingWindow holds the state of a TUMBLING or HOPPING window.
+// In contrast to a RANGE window, which is evaluated every time a
+// tuple arrives, these windows are evaluated only once when they
+// are closed. A TUMBLING window is treated as a HOPPING window
+// where the slide equals the size.
+//
+// Time-based windows are aligned to the Unix epoch, i.e., the k-th
+// window covers the half-open interval [k*slide, k*slide+size), and
+// a window is closed when a tuple with a timestamp at or after its
+// end arrives or, if no tuple arrives, when the wall-clock time that
+// has passed since the last arrival reaches its end. Windows that do
+// not contain any tuple are skipped.
+// Count-based windows are closed after every `slide` tuples and
+// cover the last `size` tuples at that point.
+type hoppingWindow struct {
+	timeBased bool
+	// size and slide are given in nanoseconds for time-based
+	// windows and in tuples for count-based windows
+	size  int64
+	slide int64
+	// tuples holds the *windowedTuple items that belong to
+	// windows that have not been closed yet (or are retained)
+	tuples *list.List
+	// count is the number of tuples processed so far
+	count int64
+	// nextEnd is the end of the oldest time-based window that
+	// has not been closed yet (valid if count > 0)
+	nextEnd int64
+	// maxTimestamp is the largest timestamp of the tuples processed
+	// so far and lastArrival is the wall-clock time when the last of
+	// them arrived (valid if count > 0)
+	maxTimestamp int64
+	lastArrival  time.Time
+	// retention is the time (in nanoseconds) for which closed
+	// time-based windows are kept after the next window has been
+	// closed so that they can be evaluated again when a late tuple
+	// arrives
+	retention int64
+}
+
+func newHoppingWindow(rel *parser.AliasedStreamWindowAST) *hoppingWindow {
+	slide := rel.Slide
+	if rel.Window == parser.TumblingWindow {
+		slide = rel.IntervalAST
+	}
+	w := &hoppingWindow{
+		tuples: list.New(),
+	}
+	if rel.Unit == parser.Tuples {
+		w.size = int64(rel.Value)
+		w.slide = int64(slide.Value)
+	} else {
+		w.timeBased = true
+		w.size = intervalNanoseconds(rel.IntervalAST)
+		w.slide = intervalNanoseconds(slide)
+		if rel.Lateness.Policy == parser.CorrectLate {
+			w.retention = intervalNanoseconds(rel.Lateness.IntervalAST)
+		}
+	}
+	return w
+}
+
+// save returns the tuples held in the window state in the order of
+// arrival and the rest of the state of the window.
+func (w *hoppingWindow) save() ([]*tupleWithDerivedInputRows, data.Map) {
+	conts := make([]*tupleWithDerivedInputRows, 0, w.tuples.Len())
+	seqs := make(data.Array, 0, w.tuples.Len())
+	for e := w.tuples.Front(); e != nil; e = e.Next() {
+		wt := e.Value.(*windowedTuple)
+		conts = append(conts, wt.tuple)
+		seqs = append(seqs, data.Int(wt.seq))
+	}
+	return conts, data.Map{
+		"count":         data.Int(w.count),
+		"next_end":      data.Int(w.nextEnd),
+		"max_timestamp": data.Int(w.maxTimestamp),
+		"seqs":          seqs,
+	}
+}
+
+// intervalNanoseconds returns the length of a time-based interval
+// in nanoseconds.
+func intervalNanoseconds(i parser.IntervalAST) int64 {
+	if i.Unit == parser.Milliseconds {
+		return int64(i.Value * float64(time.Millisecond))
+	}
+	return int64(i.Value * float64(time.Second))
+}
+
+// firstEnd returns the end of the first time-based window that
+// contains the given point in time.
+func (w *hoppingWindow) firstEnd(ts int64) int64 {
+	// we look for the smallest k with k*slide+size > ts
+	k := floorDiv(ts-w.size, w.slide) + 1
+	return k*w.slide + w.size
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// removeTuplesBefore removes all tuples from the window state
+// that are not contained in any window starting at or after
+// the given position.
+func (w *hoppingWindow) removeTuplesBefore(start int64) {
+	var next *list.Element
+	for e := w.tuples.Front(); e != nil; e = next {
+		next = e.Next()
+		if w.position(e.Value.(*windowedTuple)) < start {
+			w.tuples.Remove(e)
+		}
+	}
+}
+
+// retainedFrom returns the position of the oldest tuple that must
+// be kept in the window state.
+func (w *hoppingWindow) retainedFrom() int64 {
+	if w.retention == 0 {
+		return w.nextEnd - w.size
+	}
+	return w.nextEnd - w.slide - w.retention - w.size
+}
+
+// hasTuplesFrom returns true if there is a tuple at or after the
+// given position in the window state.
+func (w *hoppingWindow) hasTuplesFrom(start int64) bool {
+	for e := w.tuples.Front(); e != nil; e = e.Next() {
+		if w.position(e.Value.(*windowedTuple)) >= start {
+			return true
+		}
+	}
+	return false
+}
+
+// position returns the timestamp (for time-based windows) or
+// the sequence number (for count-based windows) of a tuple.
+func (w *hoppingWindow) position(t *windowedTuple) int64 {
+	if w.timeBased {
+		return t.tuple.tuple.Timestamp.UnixNano()
+	}
+	return t.seq
+}
+
+// prepareWindowedTuple wraps a tuple of the single relation of a
+// statement with a TUMBLING, HOPPING or SESSION window and evaluates
+// the filter condition on it. The input buffer is only used to
+// validate and wrap the tuple; the contents of these windows are
+// held by the respective window state.
+func (ep *streamRelationStreamExecutionPlan) prepareWindowedTuple(input *core.Tuple) (*tupleWithDerivedInputRows, error) {
+	if err := ep.addTupleToBuffer(input); err != nil {
+		return nil, err
+	}
+	alias := ep.relations[0].Alias
+	buffer := ep.buffers[alias]
+	tupCont := buffer.tuples.Remove(buffer.tuples.Back()).(*tupleWithDerivedInputRows)
+
+	// evaluate the filter condition on the new tuple
+	dataHolder := data.Map{alias: tupCont.tuple.Data[alias]}
+	setMetadata(dataHolder, alias, tupCont.tuple)
+	ep.filteredInputRowsBuffer = list.New()
+	if err := ep.appendFilteredInputRow(dataHolder,
+		map[string]*tupleWithDerivedInputRows{alias: tupCont}); err != nil {
+		return nil, err
+	}
+	return tupCont, nil
+}
+
+// processHoppingWindow is the counterpart of process for statements
+// with a TUMBLING or HOPPING window. It adds the given tuple to the
+// window state and evaluates all windows that are closed by it.
+func (ep *streamRelationStreamExecutionPlan) processHoppingWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.window
+	tupCont, err := ep.prepareWindowedTuple(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var output []data.Map
+	if w.timeBased {
+		ts := input.Timestamp.UnixNano()
+		if w.count == 0 {
+			w.nextEnd = w.firstEnd(ts)
+		} else if ts < w.nextEnd-w.size {
+			// the tuple only belongs to windows that have
+			// already been closed
+			return ep.correctHoppingWindows(tupCont, performQueryOnBuffer)
+		}
+		if w.count == 0 || ts > w.maxTimestamp {
+			w.maxTimestamp = ts
+		}
+		w.lastArrival = ep.now
+		// close all windows that end before the new tuple
+		output, err = ep.closeHoppingWindows(ts, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.count++
+	w.tuples.PushBack(&windowedTuple{tupCont, w.count})
+
+	if !w.timeBased && w.count%w.slide == 0 {
+		// the sequence numbers of the tuples in the window
+		// that is closed now are in (count-size, count]
+		out, err := ep.evaluateHoppingWindow(w.count-w.size+1, w.count+1, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, out...)
+		w.removeTuplesBefore(w.count + w.slide - w.size + 1)
+	}
+	return output, nil
+}
+
+// closeHoppingWindows evaluates all time-based windows that end at
+// or before the given position and returns the data to be emitted.
+func (ep *streamRelationStreamExecutionPlan) closeHoppingWindows(ts int64, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.window
+	var output []data.Map
+	for w.nextEnd <= ts {
+		end := w.nextEnd
+		out, err := ep.evaluateHoppingWindow(end-w.size, end, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, out...)
+		w.nextEnd += w.slide
+		w.removeTuplesBefore(w.retainedFrom())
+		if w.nextEnd <= ts && !w.hasTuplesFrom(w.nextEnd-w.size) {
+			// skip all empty windows in between
+			w.nextEnd = w.firstEnd(ts)
+		}
+	}
+	return output, nil
+}
+
+// restoreHoppingWindow restores the window state saved by
+// hoppingWindow.save without evaluating any window.
+func (ep *streamRelationStreamExecutionPlan) restoreHoppingWindow(tuples []*core.Tuple, v data.Value) error {
+	m, err := data.AsMap(v)
+	if err != nil {
+		return err
+	}
+	seqs, err := data.AsArray(m["seqs"])
+	if err != nil {
+		return err
+	}
+	if len(seqs) != len(tuples) {
+		return fmt.Errorf("the window state has %d tuples but %d are given",
+			len(seqs), len(tuples))
+	}
+	count, err := data.AsInt(m["count"])
+	if err != nil {
+		return err
+	}
+	nextEnd, err := data.AsInt(m["next_end"])
+	if err != nil {
+		return err
+	}
+	maxTimestamp, err := data.AsInt(m["max_timestamp"])
+	if err != nil {
+		return err
+	}
+
+	w := ep.window
+	for i, t := range tuples {
+		seq, err := data.AsInt(seqs[i])
+		if err != nil {
+			return err
+		}
+		tupCont, err := ep.prepareWindowedTuple(t)
+		if err != nil {
+			return err
+		}
+		w.tuples.PushBack(&windowedTuple{tupCont, seq})
+	}
+	w.count = count
+	w.nextEnd = nextEnd
+	w.maxTimestamp = maxTimestamp
+	w.lastArrival = ep.now
+	return nil
+}
+
+// tickHoppingWindow closes the time-based windows that have ended
+// while no tuple arrived. It assumes that the timestamps of the
+// input tuples advance with the wall clock, i.e., the position of
+// the stream is the largest timestamp seen so far plus the time
+// that has passed since the last tuple arrived.
+func (ep *streamRelationStreamExecutionPlan) tickHoppingWindow(now time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.window
+	if !w.timeBased || w.count == 0 || !w.hasTuplesFrom(w.nextEnd-w.size) {
+		return nil, nil
+	}
+	idle := now.Sub(w.lastArrival)
+	if idle <= 0 {
+		return nil, nil
+	}
+	return ep.closeHoppingWindows(w.maxTimestamp+int64(idle), performQueryOnBuffer)
+}
+
+// correctHoppingWindows adds a tuple that belongs to windows which
+// have already been closed to the window state and evaluates those
+// windows again, as far as their tuples have been retained. If no
+// tuples are retained, the tuple is ignored.
+func (ep *streamRelationStreamExecutionPlan) correctHoppingWindows(tupCont *tupleWithDerivedInputRows, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.window
+	ts := tupCont.tuple.Timestamp.UnixNano()
+	oldestStart := w.retainedFrom()
+	if w.retention == 0 || ts < oldestStart {
+		return nil, nil
+	}
+	w.count++
+	w.tuples.PushBack(&windowedTuple{tupCont, w.count})
+
+	var output []data.Map
+	for end := w.firstEnd(ts); end < w.nextEnd && end-w.size <= ts; end += w.slide {
+		if end-w.size < oldestStart {
+			// some tuples of this window may have been removed
+			continue
+		}
+		out, err := ep.evaluateHoppingWindow(end-w.size, end, performQueryOnBuffer)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, out...)
+	}
+	return output, nil
+}
+
+// evaluateHoppingWindow performs the query on all tuples whose
+// position lies in [start, end) and returns the data to be emitted.
+// If there is no such tuple, the window is not evaluated at all.
+func (ep *streamRelationStreamExecutionPlan) evaluateHoppingWindow(start, end int64, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.window
+	rows := list.New()
+	numTuples := 0
+	for e := w.tuples.Front(); e != nil; e = e.Next() {
+		t := e.Value.(*windowedTuple)
+		if pos := w.position(t); pos < start || pos >= end {
+			continue
+		}
+		numTuples++
+		for _, row := range t.tuple.rows {
+			rows.PushBack(row)
+		}
+	}
+	if numTuples == 0 {
+		return nil, nil
+	}
+
+	ep.filteredInputRows = rows
+	if err := performQueryOnBuffer(); err != nil {
+		return nil, err
+	}
+	return ep.computeResultTuples()
+}
@@ -0,0 +1,197 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestHoppingWindow(t *testing.T) {
+	Convey("Given a TUMBLING count-based window with an aggregate", t, func() {
+		tuples := getTuples(5)
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c, sum(int) AS s
+			FROM src [TUMBLING 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{{"c": data.Int(2), "s": data.Int(3)}},
+				{},
+				{{"c": data.Int(2), "s": data.Int(7)}},
+				{},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then results should be emitted once per window in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a HOPPING count-based window with an aggregate", t, func() {
+		tuples := getTuples(6)
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s
+			FROM src [HOPPING 3 TUPLES, SLIDE 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{{"s": data.Int(3)}},
+				{},
+				{{"s": data.Int(9)}},
+				{},
+				{{"s": data.Int(15)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then overlapping windows should be emitted in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a TUMBLING time-based window with a WHERE clause", t, func() {
+		tuples := getTuples(6)
+		// this tuple arrives after the window it belongs to
+		// has been closed and will be ignored
+		tuples[3].Timestamp = tuples[0].Timestamp
+		s := `CREATE STREAM box AS SELECT ISTREAM int
+			FROM src [TUMBLING 2 SECONDS] WHERE int != 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{},
+				{{"int": data.Int(1)}},
+				{},
+				{{"int": data.Int(3)}},
+				{},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then closed windows should be emitted in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+
+			Convey("Then the next window should contain the remaining tuples", func() {
+				later := getTuples(7)[6]
+				out, err := plan.Process(later)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(5)}, {"int": data.Int(6)}})
+			})
+
+			Convey("And when time passes without new tuples", func() {
+				tp := plan.(TimedPhysicalPlan)
+				out, err := tp.Tick(time.Now())
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+				out, err = tp.Tick(time.Now().Add(2 * time.Second))
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey("Then the last window should be emitted", func() {
+					So(out, ShouldResemble, []data.Map{{"int": data.Int(5)}, {"int": data.Int(6)}})
+				})
+
+				Convey("Then it shouldn't be emitted again", func() {
+					out, err := tp.Tick(time.Now().Add(4 * time.Second))
+					So(err, ShouldBeNil)
+					So(out, ShouldBeEmpty)
+				})
+			})
+		})
+
+		Convey("Then it should be called by a timer", func() {
+			tp, ok := plan.(TimedPhysicalPlan)
+			So(ok, ShouldBeTrue)
+			So(tp.TickInterval(), ShouldEqual, 200*time.Millisecond)
+		})
+	})
+
+	Convey("Given a HOPPING time-based window with an aggregate", t, func() {
+		tuples := getTuples(5)
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [HOPPING 2 SECONDS, SLIDE 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{{"c": data.Int(1)}},
+				{{"c": data.Int(2)}},
+				{{"c": data.Int(2)}},
+				{{"c": data.Int(2)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then every slide should emit a window in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a TUMBLING time-based window and a gap in the input", t, func() {
+		tuples := getTuples(3)
+		tuples[1].Timestamp = tuples[0].Timestamp.Add(time.Hour)
+		tuples[2].Timestamp = tuples[1].Timestamp.Add(time.Second)
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [TUMBLING 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{},
+				{{"c": data.Int(1)}},
+				{{"c": data.Int(1)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then empty windows should be skipped in %v", idx), func() {
+					So(len(out), ShouldEqual, len(expected[idx]))
+					for i, row := range expected[idx] {
+						So(out[i], ShouldResemble, row)
+					}
+				})
+			}
+		})
+	})
+}
@@ -0,0 +1,503 @@
+package execution
+
+import (
+	"container/list"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"strings"
+)
+
+// inputRowChanges holds the input rows that have been added to or
+// removed from `filteredInputRows` since they were last consumed by
+// a plan that maintains its state incrementally.
+type inputRowChanges struct {
+	added   []*inputRowWithCachedResult
+	removed []*inputRowWithCachedResult
+}
+
+// incrementalAggregate represents a call of an udf.UDAF in the
+// projections of a statement.
+type incrementalAggregate struct {
+	// key is the key under which the result of the call is stored
+	// in the input of the rewritten projections
+	key string
+	// refs are the keys of the aggregated parameters (see aggInputRef)
+	refs []string
+	// inputs are the indexes of the aggregated parameters in
+	// incrementalAggregation.inputs
+	inputs   []int
+	newState func(*core.Context) (udf.UDAFState, error)
+}
+
+// aggregationGroup holds the states of all aggregates for the
+// rows that have the same values for the GROUP BY expressions.
+type aggregationGroup struct {
+	key  data.Array
+	hash data.HashValue
+	// rows holds the *aggregatedRow items of this group in the
+	// order in which they were added
+	rows *list.List
+	// states holds one state per item in
+	// incrementalAggregation.aggregates
+	states []udf.UDAFState
+}
+
+// aggregatedRow holds the aggregate inputs of an input row that has
+// been added to the states of a group so that the same values
+// can be retracted later.
+type aggregatedRow struct {
+	row    *inputRowWithCachedResult
+	group  *aggregationGroup
+	elem   *list.Element
+	values []data.Value
+	// seq is increased for every added row and is used to emit
+	// groups in the same order as in a full recomputation
+	seq int64
+}
+
+// incrementalAggregation maintains the results of the aggregate
+// functions of a statement while rows enter and leave the window,
+// instead of recomputing them from all rows in the window for every
+// input tuple. It can only be used if all aggregate functions in the
+// statement implement udf.UDAF.
+type incrementalAggregation struct {
+	ctx        *core.Context
+	aggregates []incrementalAggregate
+	// inputs holds the evaluators of the aggregated parameters
+	inputs []Evaluator
+	// projections are the projections of the statement where every
+	// aggregate call is replaced by a reference to its result
+	projections []aliasedEvaluator
+	groups      map[data.HashValue][]*aggregationGroup
+	rows        map[*inputRowWithCachedResult]*aggregatedRow
+	seq         int64
+	// valid is false if an error occurred while updating the
+	// states. they are then computed from all rows in
+	// the window in the next run.
+	valid bool
+}
+
+// newIncrementalAggregation returns nil if the aggregates in the
+// given plan's projections cannot be computed incrementally.
+func newIncrementalAggregation(lp *LogicalPlan, reg udf.FunctionRegistry) (*incrementalAggregation, error) {
+	aggs := map[string]*incrementalAggregate{}
+	projs := make([]aliasedExpression, len(lp.Projections))
+	for i, proj := range lp.Projections {
+		expr, ok := rewriteIncrementalAggregates(proj.expr, reg, aggs)
+		if !ok {
+			return nil, nil
+		}
+		projs[i] = aliasedExpression{proj.alias, expr, nil}
+	}
+	if len(aggs) == 0 {
+		return nil, nil
+	}
+	projections, err := prepareProjections(projs, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the aggregated parameters, evaluating each of
+	// them only once per row
+	inputExprs := map[string]FlatExpression{}
+	for _, proj := range lp.Projections {
+		for key, expr := range proj.aggrInputs {
+			inputExprs[key] = expr
+		}
+	}
+	inc := &incrementalAggregation{
+		ctx:         reg.Context(),
+		projections: projections,
+		groups:      map[data.HashValue][]*aggregationGroup{},
+		rows:        map[*inputRowWithCachedResult]*aggregatedRow{},
+		valid:       true,
+	}
+	inputIdx := map[string]int{}
+	for _, key := range sortedKeys(aggs) {
+		agg := aggs[key]
+		agg.inputs = make([]int, len(agg.refs))
+		for i, ref := range agg.refs {
+			idx, ok := inputIdx[ref]
+			if !ok {
+				expr, ok := inputExprs[ref]
+				if !ok {
+					return nil, fmt.Errorf("aggregate input '%s' not found", ref)
+				}
+				eval, err := ExpressionToEvaluator(expr, reg)
+				if err != nil {
+					return nil, err
+				}
+				idx = len(inc.inputs)
+				inputIdx[ref] = idx
+				inc.inputs = append(inc.inputs, eval)
+			}
+			agg.inputs[i] = idx
+		}
+		inc.aggregates = append(inc.aggregates, *agg)
+	}
+	return inc, nil
+}
+
+func sortedKeys(aggs map[string]*incrementalAggregate) []string {
+	keys := make([]string, 0, len(aggs))
+	for key := range aggs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// rewriteIncrementalAggregates replaces all calls of aggregate functions
+// in the given expression by references to their results, which are
+// added to aggs. It returns false if the expression contains an
+// aggregate function that cannot be computed incrementally.
+func rewriteIncrementalAggregates(expr FlatExpression, reg udf.FunctionRegistry, aggs map[string]*incrementalAggregate) (FlatExpression, bool) {
+	rewriteAll := func(exprs []FlatExpression) ([]FlatExpression, bool) {
+		output := make([]FlatExpression, len(exprs))
+		for i, e := range exprs {
+			var ok bool
+			if output[i], ok = rewriteIncrementalAggregates(e, reg, aggs); !ok {
+				return nil, false
+			}
+		}
+		return output, true
+	}
+
+	switch obj := expr.(type) {
+	case funcAppAST:
+		f, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
+		if err != nil {
+			return nil, false
+		}
+		if !isAggregateFunc(f, len(obj.Expressions)) {
+			exprs, ok := rewriteAll(obj.Expressions)
+			if !ok {
+				return nil, false
+			}
+			return funcAppAST{obj.Function, exprs}, true
+		}
+		agg, ok := f.(udf.UDAF)
+		if !ok {
+			return nil, false
+		}
+		refs := make([]string, len(obj.Expressions))
+		for i, e := range obj.Expressions {
+			ref, ok := e.(aggInputRef)
+			if !ok {
+				return nil, false
+			}
+			refs[i] = ref.Ref
+		}
+		key := fmt.Sprintf("r_%s_%s", obj.Function, strings.Join(refs, "_"))
+		aggs[key] = &incrementalAggregate{key: key, refs: refs, newState: agg.Init}
+		return aggInputRef{key}, true
+	case aggregateInputSorter:
+		// the accumulators do not know about the order of rows
+		return nil, false
+	case binaryOpAST:
+		exprs, ok := rewriteAll([]FlatExpression{obj.Left, obj.Right})
+		if !ok {
+			return nil, false
+		}
+		return binaryOpAST{obj.Op, exprs[0], exprs[1]}, true
+	case unaryOpAST:
+		e, ok := rewriteIncrementalAggregates(obj.Expr, reg, aggs)
+		if !ok {
+			return nil, false
+		}
+		return unaryOpAST{obj.Op, e}, true
+	case typeCastAST:
+		e, ok := rewriteIncrementalAggregates(obj.Expr, reg, aggs)
+		if !ok {
+			return nil, false
+		}
+		return typeCastAST{e, obj.Target}, true
+	case higherOrderFuncAppAST:
+		// the lambda expression cannot contain aggregate calls
+		e, ok := rewriteIncrementalAggregates(obj.Array, reg, aggs)
+		if !ok {
+			return nil, false
+		}
+		return higherOrderFuncAppAST{obj.Function, e, obj.Param, obj.Body}, true
+	case arrayAST:
+		exprs, ok := rewriteAll(obj.Expressions)
+		if !ok {
+			return nil, false
+		}
+		return arrayAST{exprs}, true
+	case mapAST:
+		entries := make([]keyValuePair, len(obj.Entries))
+		for i, pair := range obj.Entries {
+			e, ok := rewriteIncrementalAggregates(pair.Value, reg, aggs)
+			if !ok {
+				return nil, false
+			}
+			entries[i] = keyValuePair{pair.Key, e}
+		}
+		return mapAST{entries}, true
+	case caseAST:
+		ref, ok := rewriteIncrementalAggregates(obj.Reference, reg, aggs)
+		if !ok {
+			return nil, false
+		}
+		checks := make([]whenThenPair, len(obj.Checks))
+		for i, pair := range obj.Checks {
+			exprs, ok := rewriteAll([]FlatExpression{pair.When, pair.Then})
+			if !ok {
+				return nil, false
+			}
+			checks[i] = whenThenPair{exprs[0], exprs[1]}
+		}
+		def, ok := rewriteIncrementalAggregates(obj.Default, reg, aggs)
+		if !ok {
+			return nil, false
+		}
+		return caseAST{ref, checks, def}, true
+	}
+	// all other expressions cannot contain aggregate calls
+	return expr, true
+}
+
+// updateAggregates applies the changes of the input rows since the
+// last run to the states. If the states are not valid,
+// they are computed from all rows in `ep.filteredInputRows`.
+func (ep *groupbyExecutionPlan) updateAggregates() error {
+	inc := ep.incremental
+	changes := ep.rowChanges
+	if !inc.valid {
+		changes.added = changes.added[:0]
+		changes.removed = changes.removed[:0]
+		inc.groups = map[data.HashValue][]*aggregationGroup{}
+		inc.rows = map[*inputRowWithCachedResult]*aggregatedRow{}
+		for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+			if err := ep.addAggregatedRow(e.Value.(*inputRowWithCachedResult)); err != nil {
+				return err
+			}
+		}
+		inc.valid = true
+		return nil
+	}
+
+	// rows that were added and removed again since the last run
+	// are added first so that they can be retracted normally
+	inc.valid = false
+	for _, row := range changes.added {
+		if err := ep.addAggregatedRow(row); err != nil {
+			return err
+		}
+	}
+	for _, row := range changes.removed {
+		if err := ep.retractAggregatedRow(row); err != nil {
+			return err
+		}
+	}
+	changes.added = changes.added[:0]
+	changes.removed = changes.removed[:0]
+	inc.valid = true
+	return nil
+}
+
+// addAggregatedRow adds the aggregate inputs of the given row to
+// the states of its group.
+func (ep *groupbyExecutionPlan) addAggregatedRow(row *inputRowWithCachedResult) error {
+	inc := ep.incremental
+	key, hash, err := ep.groupKey(row)
+	if err != nil {
+		return err
+	}
+	values := make([]data.Value, len(inc.inputs))
+	for i, eval := range inc.inputs {
+		if values[i], err = eval.Eval(*row.input); err != nil {
+			return err
+		}
+	}
+
+	var group *aggregationGroup
+	for _, g := range inc.groups[hash] {
+		if data.Equal(g.key, key) {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		group = &aggregationGroup{
+			key:    key,
+			hash:   hash,
+			rows:   list.New(),
+			states: make([]udf.UDAFState, len(inc.aggregates)),
+		}
+		for i, agg := range inc.aggregates {
+			if group.states[i], err = agg.newState(inc.ctx); err != nil {
+				return err
+			}
+		}
+		inc.groups[hash] = append(inc.groups[hash], group)
+	}
+	for i, agg := range inc.aggregates {
+		if err := group.states[i].Accumulate(inc.ctx, agg.args(values)...); err != nil {
+			return err
+		}
+	}
+
+	inc.seq++
+	r := &aggregatedRow{row: row, group: group, values: values, seq: inc.seq}
+	r.elem = group.rows.PushBack(r)
+	inc.rows[row] = r
+	return nil
+}
+
+// args returns the arguments of the aggregate from the values of
+// all aggregated parameters of a row.
+func (agg *incrementalAggregate) args(values []data.Value) []data.Value {
+	args := make([]data.Value, len(agg.inputs))
+	for i, idx := range agg.inputs {
+		args[i] = values[idx]
+	}
+	return args
+}
+
+// retractAggregatedRow removes the aggregate inputs of the given row
+// from the states of its group. States that do not support retraction
+// are rebuilt from the remaining rows of the group.
+func (ep *groupbyExecutionPlan) retractAggregatedRow(row *inputRowWithCachedResult) error {
+	inc := ep.incremental
+	r, ok := inc.rows[row]
+	if !ok {
+		// the row was never added
+		return nil
+	}
+	delete(inc.rows, row)
+	group := r.group
+	group.rows.Remove(r.elem)
+	for i, agg := range inc.aggregates {
+		err := group.states[i].Retract(inc.ctx, agg.args(r.values)...)
+		if err == udf.ErrRetractionNotSupported {
+			err = inc.rebuildState(group, i)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if group.rows.Len() > 0 {
+		return nil
+	}
+	// remove the empty group
+	groups := inc.groups[group.hash]
+	for i, g := range groups {
+		if g == group {
+			groups = append(groups[:i], groups[i+1:]...)
+			break
+		}
+	}
+	if len(groups) == 0 {
+		delete(inc.groups, group.hash)
+	} else {
+		inc.groups[group.hash] = groups
+	}
+	return nil
+}
+
+// rebuildState replaces the state of the i-th aggregate of the group
+// by a new one that has accumulated all rows in the group.
+func (inc *incrementalAggregation) rebuildState(group *aggregationGroup, i int) error {
+	agg := &inc.aggregates[i]
+	s, err := agg.newState(inc.ctx)
+	if err != nil {
+		return err
+	}
+	for e := group.rows.Front(); e != nil; e = e.Next() {
+		r := e.Value.(*aggregatedRow)
+		if err := s.Accumulate(inc.ctx, agg.args(r.values)...); err != nil {
+			return err
+		}
+	}
+	group.states[i] = s
+	return nil
+}
+
+// aggregationGroupList sorts groups by the first row that they
+// contain, i.e., in the order a full recomputation would use.
+type aggregationGroupList []*aggregationGroup
+
+func (l aggregationGroupList) Len() int {
+	return len(l)
+}
+
+func (l aggregationGroupList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (l aggregationGroupList) Less(i, j int) bool {
+	return l[i].first().seq < l[j].first().seq
+}
+
+func (g *aggregationGroup) first() *aggregatedRow {
+	return g.rows.Front().Value.(*aggregatedRow)
+}
+
+// performIncrementalQuery is the counterpart of performQueryOnBuffer
+// for plans with incrementally computed aggregates. It updates the
+// states and evaluates the projections for every group.
+func (ep *groupbyExecutionPlan) performIncrementalQuery() error {
+	// reuse the allocated memory
+	output := ep.prevResults[0:0]
+	// remember the previous results
+	ep.prevResults = ep.curResults
+
+	// see performQueryOnBuffer
+	rollback := func() {
+		ep.prevResults = output
+	}
+
+	if err := ep.updateAggregates(); err != nil {
+		rollback()
+		return err
+	}
+
+	inc := ep.incremental
+	groups := make(aggregationGroupList, 0, len(inc.groups))
+	for _, gs := range inc.groups {
+		groups = append(groups, gs...)
+	}
+	sort.Sort(groups)
+
+	for _, group := range groups {
+		rep := *group.first().row.input
+		input := make(data.Map, len(rep)+len(inc.aggregates))
+		for key, value := range rep {
+			input[key] = value
+		}
+		for i, agg := range inc.aggregates {
+			result, err := group.states[i].Finalize(inc.ctx)
+			if err != nil {
+				rollback()
+				return err
+			}
+			input[agg.key] = result
+		}
+		result, err := evalGroupProjections(inc.projections, input)
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, *result)
+		}
+	}
+	if len(groups) == 0 {
+		result, err := ep.evalEmptyInput()
+		if err != nil {
+			rollback()
+			return err
+		}
+		if result != nil {
+			output = append(output, *result)
+		}
+	}
+
+	ep.curResults = output
+	return nil
+}
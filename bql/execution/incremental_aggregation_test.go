@@ -0,0 +1,157 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// weightedSum is a UDAF whose state supports retraction.
+type weightedSum struct {
+	sum int64
+}
+
+func newWeightedSum() *weightedSum {
+	return &weightedSum{}
+}
+
+func (s *weightedSum) Accumulate(v, w int64) {
+	s.sum += v * w
+}
+
+func (s *weightedSum) Retract(v, w int64) {
+	s.sum -= v * w
+}
+
+func (s *weightedSum) Finalize() int64 {
+	return s.sum
+}
+
+// maxState is a UDAF whose state doesn't support retraction.
+type maxState struct {
+	max int64
+}
+
+func newMaxState() *maxState {
+	return &maxState{}
+}
+
+func (s *maxState) Accumulate(v int64) {
+	if v > s.max {
+		s.max = v
+	}
+}
+
+func (s *maxState) Finalize() int64 {
+	return s.max
+}
+
+func TestIncrementalAggregation(t *testing.T) {
+	Convey("Given statements with aggregate functions", t, func() {
+		cases := []struct {
+			stmt        string
+			incremental bool
+		}{
+			{"SELECT RSTREAM count(*) AS c FROM src [RANGE 2 TUPLES]", true},
+			{"SELECT RSTREAM foo, sum(int) / count(int) AS x FROM src [RANGE 2 TUPLES] GROUP BY foo", true},
+			{"SELECT RSTREAM foo, max(int) FROM src [RANGE 2 TUPLES] GROUP BY foo HAVING min(int) > 1", true},
+			{"SELECT RSTREAM foo FROM src [RANGE 2 TUPLES] GROUP BY foo", false},
+			{"SELECT RSTREAM median(int) FROM src [RANGE 2 TUPLES]", false},
+			{"SELECT RSTREAM count(*), median(int) FROM src [RANGE 2 TUPLES]", false},
+			{"SELECT RSTREAM array_agg(int ORDER BY foo) FROM src [RANGE 2 TUPLES]", false},
+			{"SELECT RSTREAM string_agg(foo, \",\") FROM src [RANGE 2 TUPLES]", false},
+			{"SELECT RSTREAM count(*) FROM src [TUMBLING 2 TUPLES]", false},
+			{"SELECT RSTREAM weighted_sum(int, 2) FROM src [RANGE 2 TUPLES]", true},
+			{"SELECT RSTREAM max_state(int), count(*) FROM src [RANGE 2 TUPLES]", true},
+			{"SELECT RSTREAM stddev(int), corr(int, foo) FROM src [RANGE 2 TUPLES]", true},
+			{"SELECT RSTREAM ewma(int, 0.5 ORDER BY foo) FROM src [RANGE 2 TUPLES]", false},
+		}
+
+		for _, c := range cases {
+			c := c
+			Convey(fmt.Sprintf("When creating a plan for %s", c.stmt), func() {
+				plan, err := createGroupbyPlan("CREATE STREAM box AS "+c.stmt, t)
+				So(err, ShouldBeNil)
+				ep := plan.(*groupbyExecutionPlan)
+
+				Convey(fmt.Sprintf("Then the aggregates should be incremental: %v", c.incremental), func() {
+					So(ep.incremental != nil, ShouldEqual, c.incremental)
+					So(ep.rowChanges != nil, ShouldEqual, c.incremental)
+				})
+			})
+		}
+	})
+
+	Convey("Given a grouping statement with incremental aggregates", t, func() {
+		stmts := []string{
+			`SELECT RSTREAM foo, count(*) AS c, sum(int) AS s, avg(int) AS a,
+				max(int) AS mx, min(int) AS mn FROM src [RANGE 3 TUPLES] GROUP BY foo`,
+			`SELECT ISTREAM foo, sum(int) + 1 AS s FROM src [RANGE 2 SECONDS]
+				WHERE int != 3 GROUP BY foo HAVING count(*) > 1`,
+			`SELECT DSTREAM max(int) AS mx FROM src [RANGE 2 TUPLES]`,
+			`SELECT RSTREAM foo, weighted_sum(int, foo + 1) AS w, max_state(int) AS m,
+				count(*) AS c FROM src [RANGE 3 TUPLES] GROUP BY foo`,
+			`SELECT RSTREAM approx_count_distinct(foo) AS d, approx_percentile(int, 0.5) AS p,
+				approx_most_frequent(foo, 1) AS f FROM src [RANGE 4 TUPLES]`,
+		}
+		for _, stmt := range stmts {
+			stmt := stmt
+			s := "CREATE STREAM box AS " + stmt
+
+			Convey(fmt.Sprintf("When feeding %s with tuples", stmt), func() {
+				plan, err := createGroupbyPlan(s, t)
+				So(err, ShouldBeNil)
+				So(plan.(*groupbyExecutionPlan).incremental, ShouldNotBeNil)
+				// a plan that recomputes all groups in every run
+				full, err := createGroupbyPlan(s, t)
+				So(err, ShouldBeNil)
+				full.(*groupbyExecutionPlan).incremental = nil
+				full.(*groupbyExecutionPlan).rowChanges = nil
+
+				tuples := getTuples(8)
+				for i, tup := range tuples {
+					tup.Data["foo"] = data.Int(i % 3 % 2)
+				}
+				for idx, inTup := range tuples {
+					out, err := plan.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+					expected, err := full.Process(inTup.Copy())
+					So(err, ShouldBeNil)
+
+					Convey(fmt.Sprintf("Then the results should be the same as for a full recomputation in %v", idx), func() {
+						So(out, ShouldResemble, expected)
+					})
+				}
+			})
+		}
+	})
+
+	Convey("Given an incremental aggregate with an invalid value in the window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [RANGE 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getTuples(5)
+			tuples[1].Data["int"] = data.String("invalid")
+			var errs []error
+			var outs [][]data.Map
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				errs = append(errs, err)
+				outs = append(outs, out)
+			}
+
+			Convey("Then it should fail as long as the value is in the window", func() {
+				So(errs[0], ShouldBeNil)
+				So(errs[1], ShouldNotBeNil)
+				So(errs[2], ShouldNotBeNil)
+				So(errs[3], ShouldBeNil)
+				So(errs[4], ShouldBeNil)
+				So(outs[3], ShouldResemble, []data.Map{{"s": data.Int(7)}})
+				So(outs[4], ShouldResemble, []data.Map{{"s": data.Int(9)}})
+			})
+		})
+	})
+}
@@ -0,0 +1,415 @@
+package execution
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	plainKeyRe        = regexp.MustCompile(`^[^.\[]+`)
+	singleQuotedKeyRe = regexp.MustCompile(`^\['((?:''|[^'])*)'\]`)
+	doubleQuotedKeyRe = regexp.MustCompile(`^\["((?:""|[^"])*)"\]`)
+)
+
+// foldConstants returns the expression with all subexpressions that
+// only consist of literals, operators, and type casts replaced by the
+// literal they evaluate to. Function calls are never folded because
+// UDFs are not assumed to be immutable. A subexpression that cannot
+// be evaluated (e.g., `1 / 0`) or whose value cannot be represented
+// by a literal is left as it is so that it behaves exactly like it
+// did before.
+func foldConstants(e FlatExpression) FlatExpression {
+	switch e := e.(type) {
+	case binaryOpAST:
+		e.Left = foldConstants(e.Left)
+		e.Right = foldConstants(e.Right)
+		if isLiteral(e.Left) && isLiteral(e.Right) {
+			return foldLiteral(e)
+		}
+		return e
+	case unaryOpAST:
+		e.Expr = foldConstants(e.Expr)
+		if isLiteral(e.Expr) {
+			return foldLiteral(e)
+		}
+		return e
+	case typeCastAST:
+		e.Expr = foldConstants(e.Expr)
+		if isLiteral(e.Expr) {
+			return foldLiteral(e)
+		}
+		return e
+	case funcAppAST:
+		e.Expressions = foldConstantsAll(e.Expressions)
+		return e
+	case higherOrderFuncAppAST:
+		e.Array = foldConstants(e.Array)
+		e.Body = foldConstants(e.Body)
+		return e
+	case arrayAST:
+		e.Expressions = foldConstantsAll(e.Expressions)
+		return e
+	case mapAST:
+		entries := make([]keyValuePair, len(e.Entries))
+		for i, p := range e.Entries {
+			entries[i] = keyValuePair{p.Key, foldConstants(p.Value)}
+		}
+		e.Entries = entries
+		return e
+	case caseAST:
+		checks := make([]whenThenPair, len(e.Checks))
+		for i, p := range e.Checks {
+			checks[i] = whenThenPair{foldConstants(p.When), foldConstants(p.Then)}
+		}
+		e.Reference = foldConstants(e.Reference)
+		e.Checks = checks
+		e.Default = foldConstants(e.Default)
+		return e
+	}
+	return e
+}
+
+// foldConstantsAll applies foldConstants to all given expressions
+// and returns the results in a new slice.
+func foldConstantsAll(exprs []FlatExpression) []FlatExpression {
+	if exprs == nil {
+		return nil
+	}
+	folded := make([]FlatExpression, len(exprs))
+	for i, e := range exprs {
+		folded[i] = foldConstants(e)
+	}
+	return folded
+}
+
+func isLiteral(e FlatExpression) bool {
+	switch e.(type) {
+	case nullLiteral, numericLiteral, floatLiteral, boolLiteral, stringLiteral:
+		return true
+	}
+	return false
+}
+
+// foldLiteral evaluates an expression whose operands are literals
+// and returns the literal representing its value, or the expression
+// itself if that is not possible.
+func foldLiteral(e FlatExpression) FlatExpression {
+	// operators and type casts don't need a function registry
+	v, err := evaluateFlatFoldable(e, nil)
+	if err != nil {
+		return e
+	}
+	switch v.Type() {
+	case data.TypeNull:
+		return nullLiteral{}
+	case data.TypeBool:
+		b, _ := data.AsBool(v)
+		return boolLiteral{b}
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return numericLiteral{i}
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return floatLiteral{f}
+	case data.TypeString:
+		s, _ := data.AsString(v)
+		return stringLiteral{s}
+	}
+	return e
+}
+
+// walkFlatExpression calls f for the expression and all of its
+// subexpressions in depth-first order.
+func walkFlatExpression(e FlatExpression, f func(FlatExpression)) {
+	if e == nil {
+		return
+	}
+	f(e)
+	switch e := e.(type) {
+	case binaryOpAST:
+		walkFlatExpression(e.Left, f)
+		walkFlatExpression(e.Right, f)
+	case unaryOpAST:
+		walkFlatExpression(e.Expr, f)
+	case typeCastAST:
+		walkFlatExpression(e.Expr, f)
+	case funcAppAST:
+		for _, p := range e.Expressions {
+			walkFlatExpression(p, f)
+		}
+	case higherOrderFuncAppAST:
+		walkFlatExpression(e.Array, f)
+		walkFlatExpression(e.Body, f)
+	case aggregateInputSorter:
+		walkFlatExpression(e.funcAppAST, f)
+	case arrayAST:
+		for _, p := range e.Expressions {
+			walkFlatExpression(p, f)
+		}
+	case mapAST:
+		for _, p := range e.Entries {
+			walkFlatExpression(p.Value, f)
+		}
+	case caseAST:
+		walkFlatExpression(e.Reference, f)
+		for _, p := range e.Checks {
+			walkFlatExpression(p.When, f)
+			walkFlatExpression(p.Then, f)
+		}
+		walkFlatExpression(e.Default, f)
+	case missing:
+		walkFlatExpression(e.Expr, f)
+	}
+}
+
+// referencedRelations returns the aliases of all relations whose
+// columns or metadata are used in the expression.
+func referencedRelations(e FlatExpression) map[string]bool {
+	rels := map[string]bool{}
+	walkFlatExpression(e, func(e FlatExpression) {
+		switch e := e.(type) {
+		case rowValue:
+			rels[e.Relation] = true
+		case rowMeta:
+			rels[e.Relation] = true
+		}
+	})
+	return rels
+}
+
+// joinConjunction is the inverse of splitConjunction. It returns nil
+// when no expression is given.
+func joinConjunction(exprs []FlatExpression) FlatExpression {
+	if len(exprs) == 0 {
+		return nil
+	}
+	e := exprs[0]
+	for _, c := range exprs[1:] {
+		e = binaryOpAST{parser.And, e, c}
+	}
+	return e
+}
+
+// flatExpressions returns all expressions of the plan that are
+// evaluated on input rows.
+func (lp *LogicalPlan) flatExpressions() []FlatExpression {
+	var exprs []FlatExpression
+	for _, p := range lp.Projections {
+		exprs = append(exprs, p.expr)
+		for _, e := range p.aggrInputs {
+			exprs = append(exprs, e)
+		}
+	}
+	exprs = append(exprs, lp.JoinConditions...)
+	if lp.Filter != nil {
+		exprs = append(exprs, lp.Filter)
+	}
+	for _, alias := range lp.pushedFilterAliases() {
+		exprs = append(exprs, lp.PushedFilters[alias])
+	}
+	return append(exprs, lp.GroupList...)
+}
+
+// pushedFilterAliases returns the keys of lp.PushedFilters in the
+// order of the relations in the FROM clause.
+func (lp *LogicalPlan) pushedFilterAliases() []string {
+	var aliases []string
+	for _, rel := range lp.Relations {
+		if _, ok := lp.PushedFilters[rel.Alias]; ok {
+			aliases = append(aliases, rel.Alias)
+		}
+	}
+	return aliases
+}
+
+// foldConstants folds constant subexpressions in all expressions of
+// the plan. A WHERE clause that is always true is removed.
+func (lp *LogicalPlan) foldConstants() {
+	projs := make([]aliasedExpression, len(lp.Projections))
+	for i, p := range lp.Projections {
+		var aggrInputs map[string]FlatExpression
+		if p.aggrInputs != nil {
+			aggrInputs = make(map[string]FlatExpression, len(p.aggrInputs))
+			for k, e := range p.aggrInputs {
+				aggrInputs[k] = foldConstants(e)
+			}
+		}
+		projs[i] = aliasedExpression{p.alias, foldConstants(p.expr), aggrInputs}
+	}
+	lp.Projections = projs
+	lp.JoinConditions = foldConstantsAll(lp.JoinConditions)
+	if lp.Filter != nil {
+		lp.Filter = foldConstants(lp.Filter)
+		if lp.Filter == (boolLiteral{true}) {
+			lp.Filter = nil
+		}
+	}
+}
+
+// canPushDownTo returns whether a predicate can be evaluated before
+// a tuple is added to the buffer of the i-th relation. This is only
+// the case for a RANGE window that is based on time: with a tuple-based
+// window, discarding a tuple would change the contents of the window,
+// and TUMBLING, HOPPING, and SESSION windows have their own buffers.
+// Moreover, the right side of a LEFT JOIN must not be filtered because
+// the WHERE clause is also evaluated on rows where it is NULL.
+func (lp *LogicalPlan) canPushDownTo(i int) bool {
+	rel := lp.Relations[i]
+	switch rel.Window {
+	case parser.TumblingWindow, parser.HoppingWindow, parser.SessionWindow:
+		return false
+	}
+	if rel.Unit != parser.Seconds && rel.Unit != parser.Milliseconds {
+		return false
+	}
+	if i > 0 && i <= len(lp.Joins) && lp.Joins[i-1].Type == parser.LeftJoin {
+		return false
+	}
+	return true
+}
+
+// pushDownPredicates moves the conjuncts of the WHERE clause that
+// only refer to a single relation to lp.PushedFilters so that tuples
+// that don't fulfill them are never added to the window buffer of that
+// relation. Only immutable conjuncts are moved because a pushed down
+// predicate is evaluated once per tuple rather than once per row. A
+// pushed down predicate may fail on tuples that the original WHERE
+// clause would never have evaluated it on (e.g., `b.ok AND a.x::int > 0`
+// if b.ok is false), so the original clause is kept in
+// lp.OriginalFilter for the tuples on which it fails.
+func (lp *LogicalPlan) pushDownPredicates() {
+	if lp.Filter == nil {
+		return
+	}
+	pushable := map[string]bool{}
+	for i, rel := range lp.Relations {
+		pushable[rel.Alias] = lp.canPushDownTo(i)
+	}
+
+	remaining := []FlatExpression{}
+	for _, c := range splitConjunction(lp.Filter) {
+		rels := referencedRelations(c)
+		if len(rels) != 1 || c.Volatility() != Immutable {
+			remaining = append(remaining, c)
+			continue
+		}
+		var alias string
+		for a := range rels {
+			alias = a
+		}
+		if !pushable[alias] {
+			remaining = append(remaining, c)
+			continue
+		}
+		if lp.PushedFilters == nil {
+			lp.PushedFilters = map[string]FlatExpression{}
+		}
+		if f, ok := lp.PushedFilters[alias]; ok {
+			lp.PushedFilters[alias] = binaryOpAST{parser.And, f, c}
+		} else {
+			lp.PushedFilters[alias] = c
+		}
+	}
+	if lp.PushedFilters != nil {
+		lp.OriginalFilter = lp.Filter
+	}
+	lp.Filter = joinConjunction(remaining)
+}
+
+// pruneColumns computes the top-level keys of the input tuples that
+// are used by the statement and stores them in lp.RequiredColumns.
+// Relations whose tuples are used as a whole (i.e., by a wildcard)
+// or that are accessed by a path whose first key cannot be determined
+// are not pruned.
+func (lp *LogicalPlan) pruneColumns() {
+	keys := map[string]map[string]bool{}
+	for _, rel := range lp.Relations {
+		keys[rel.Alias] = map[string]bool{}
+	}
+	for _, e := range lp.flatExpressions() {
+		walkFlatExpression(e, func(e FlatExpression) {
+			w, ok := e.(wildcardAST)
+			if !ok {
+				return
+			}
+			if w.Relation == "" {
+				for alias := range keys {
+					keys[alias] = nil
+				}
+			} else {
+				keys[w.Relation] = nil
+			}
+		})
+		for _, col := range e.Columns() {
+			if keys[col.Relation] == nil {
+				continue
+			}
+			key, ok := topLevelKey(col.Column)
+			if !ok {
+				keys[col.Relation] = nil
+				continue
+			}
+			keys[col.Relation][key] = true
+		}
+	}
+
+	lp.RequiredColumns = nil
+	for _, rel := range lp.Relations {
+		k := keys[rel.Alias]
+		if k == nil {
+			continue
+		}
+		cols := make([]string, 0, len(k))
+		for key := range k {
+			cols = append(cols, key)
+		}
+		sort.Strings(cols)
+		if lp.RequiredColumns == nil {
+			lp.RequiredColumns = map[string][]string{}
+		}
+		lp.RequiredColumns[rel.Alias] = cols
+	}
+}
+
+// topLevelKey returns the first key of a JSON Path such as "a.b[0]"
+// or `["a b"].c`. The second return value is false if the path doesn't
+// start with a map access.
+func topLevelKey(path string) (string, bool) {
+	if key, n := quotedMapKey(path); n > 0 {
+		return key, true
+	}
+	if strings.HasPrefix(path, "[") {
+		return "", false
+	}
+	if key := plainKeyRe.FindString(path); key != "" {
+		return key, true
+	}
+	return "", false
+}
+
+// quotedMapKey parses a map access such as `["a b"]` or `['a b']` at
+// the beginning of a JSON Path and returns the key and the length of
+// the map access, or 0 if the path doesn't start with one.
+func quotedMapKey(path string) (string, int) {
+	if m := singleQuotedKeyRe.FindStringSubmatch(path); m != nil {
+		return strings.Replace(m[1], "''", "'", -1), len(m[0])
+	}
+	if m := doubleQuotedKeyRe.FindStringSubmatch(path); m != nil {
+		return strings.Replace(m[1], `""`, `"`, -1), len(m[0])
+	}
+	return "", 0
+}
+
+// pruneTupleData returns a copy of m that only contains the given keys.
+func pruneTupleData(m data.Map, keys []string) data.Map {
+	pruned := make(data.Map, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			pruned[k] = v
+		}
+	}
+	return pruned
+}
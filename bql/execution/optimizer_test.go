@@ -0,0 +1,256 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"testing"
+	"time"
+)
+
+func optimizeSelect(s string) *LogicalPlan {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	_stmt, _, err := parser.New().ParseStmt(s)
+	So(err, ShouldBeNil)
+	So(_stmt, ShouldHaveSameTypeAs, parser.SelectStmt{})
+	lp, err := Analyze(_stmt.(parser.SelectStmt), reg)
+	So(err, ShouldBeNil)
+	lp, err = lp.LogicalOptimize()
+	So(err, ShouldBeNil)
+	return lp
+}
+
+// createOptimizedPlans returns the plan of the statement with and
+// without the logical optimizations.
+func createOptimizedPlans(s string) (*defaultSelectExecutionPlan, *defaultSelectExecutionPlan) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	_stmt, _, err := parser.New().ParseStmt(s)
+	So(err, ShouldBeNil)
+	stmt := _stmt.(parser.SelectStmt)
+
+	refLp, err := Analyze(stmt, reg)
+	So(err, ShouldBeNil)
+	refPlan, err := NewDefaultSelectExecutionPlan(refLp, reg)
+	So(err, ShouldBeNil)
+
+	lp, err := Analyze(stmt, reg)
+	So(err, ShouldBeNil)
+	lp, err = lp.LogicalOptimize()
+	So(err, ShouldBeNil)
+	plan, err := NewDefaultSelectExecutionPlan(lp, reg)
+	So(err, ShouldBeNil)
+	return plan.(*defaultSelectExecutionPlan), refPlan.(*defaultSelectExecutionPlan)
+}
+
+func TestFoldConstants(t *testing.T) {
+	Convey("Given a SELECT statement with constant expressions", t, func() {
+		s := `SELECT ISTREAM a + 2 * 3 AS x, 1 / 0 AS y, "a" || "b" AS z,
+			abs(-4 + 2) AS w, [1 + 1, a] AS v, 2::string AS u
+			FROM s [RANGE 2 SECONDS] WHERE 1 = 1`
+
+		Convey("When optimizing it", func() {
+			lp := optimizeSelect(s)
+			a := rowValue{"s", "a"}
+
+			Convey("Then constant operands should be folded", func() {
+				So(lp.Projections[0].expr, ShouldResemble,
+					binaryOpAST{parser.Plus, a, numericLiteral{6}})
+				So(lp.Projections[2].expr, ShouldResemble, stringLiteral{"ab"})
+				So(lp.Projections[5].expr, ShouldResemble, stringLiteral{"2"})
+			})
+
+			Convey("Then an expression that fails should not be folded", func() {
+				So(lp.Projections[1].expr, ShouldResemble,
+					binaryOpAST{parser.Divide, numericLiteral{1}, numericLiteral{0}})
+			})
+
+			Convey("Then arguments of functions and arrays should be folded", func() {
+				So(lp.Projections[3].expr, ShouldResemble,
+					funcAppAST{"abs", []FlatExpression{numericLiteral{-2}}})
+				So(lp.Projections[4].expr, ShouldResemble,
+					arrayAST{[]FlatExpression{numericLiteral{2}, a}})
+			})
+
+			Convey("Then a WHERE clause that is always true should be removed", func() {
+				So(lp.Filter, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestPushDownPredicates(t *testing.T) {
+	Convey("Given a SELECT statement over two relations with time-based windows", t, func() {
+		s := `SELECT ISTREAM s:a, t:b FROM s [RANGE 2 SECONDS], t [RANGE 2 SECONDS]
+			WHERE s:a > 1 AND s:a < t:c AND t:b = "x" AND s:ts() IS NOT NULL`
+
+		Convey("When optimizing it", func() {
+			lp := optimizeSelect(s)
+
+			Convey("Then single-relation predicates should be pushed down", func() {
+				So(lp.PushedFilters, ShouldResemble, map[string]FlatExpression{
+					"s": binaryOpAST{parser.And,
+						binaryOpAST{parser.Greater, rowValue{"s", "a"}, numericLiteral{1}},
+						binaryOpAST{parser.IsNot, rowMeta{"s", parser.TimestampMeta}, nullLiteral{}}},
+					"t": binaryOpAST{parser.Equal, rowValue{"t", "b"}, stringLiteral{"x"}},
+				})
+			})
+
+			Convey("Then the other predicates should remain in the filter", func() {
+				So(lp.Filter, ShouldResemble,
+					binaryOpAST{parser.Less, rowValue{"s", "a"}, rowValue{"t", "c"}})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with predicates that cannot be pushed down", t, func() {
+		s := `SELECT ISTREAM s:a FROM s [RANGE 2 TUPLES]
+			JOIN t [RANGE 2 SECONDS] ON s:k = t:k
+			LEFT JOIN u [RANGE 2 SECONDS] ON t:k = u:k
+			WHERE s:a > 1 AND abs(t:a) > 1 AND u:a > 1`
+
+		Convey("When optimizing it", func() {
+			lp := optimizeSelect(s)
+
+			Convey("Then no predicate should be pushed down", func() {
+				So(lp.PushedFilters, ShouldBeNil)
+				So(splitConjunction(lp.Filter), ShouldHaveLength, 3)
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a pushed down predicate", t, func() {
+		s := `SELECT ISTREAM s:a, t:b FROM s [RANGE 3 SECONDS], t [RANGE 3 SECONDS]
+			WHERE s:a % 4 = 0 AND t:b > 5`
+		plan, refPlan := createOptimizedPlans(s)
+
+		Convey("When feeding it with tuples", func() {
+			for i := 0; i < 8; i++ {
+				input := "s"
+				if i%2 == 1 {
+					input = "t"
+				}
+				tup := &core.Tuple{
+					Data:      data.Map{"a": data.Int(i), "b": data.Int(i)},
+					InputName: input,
+					Timestamp: time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+				}
+				out, err := plan.Process(tup.Copy())
+				So(err, ShouldBeNil)
+				refOut, err := refPlan.Process(tup.Copy())
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+				sort.Sort(tupleList(refOut))
+
+				Convey(fmt.Sprintf("Then the result should match the reference in %v", i), func() {
+					So(out, ShouldResemble, refOut)
+				})
+			}
+
+			Convey("Then tuples not matching the predicates should not be buffered", func() {
+				So(plan.buffers["s"].tuples.Len(), ShouldBeLessThan, refPlan.buffers["s"].tuples.Len())
+				So(plan.buffers["t"].tuples.Len(), ShouldBeLessThan, refPlan.buffers["t"].tuples.Len())
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a pushed down predicate that can fail", t, func() {
+		s := `SELECT ISTREAM s:x, t:ok FROM s [RANGE 3 SECONDS], t [RANGE 3 SECONDS]
+			WHERE t:ok AND s:x::int > 0`
+		plan, refPlan := createOptimizedPlans(s)
+
+		Convey("When feeding it with tuples the predicate fails on", func() {
+			inputs := []struct {
+				input string
+				data  data.Map
+			}{
+				{"t", data.Map{"ok": data.False}},
+				{"s", data.Map{"x": data.String("a")}},
+				{"s", data.Map{"x": data.String("1")}},
+				{"t", data.Map{"ok": data.True}},
+				{"s", data.Map{"x": data.String("2")}},
+			}
+			for i, in := range inputs {
+				tup := &core.Tuple{
+					Data:      in.data,
+					InputName: in.input,
+					Timestamp: time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+				}
+				out, err := plan.Process(tup.Copy())
+				refOut, refErr := refPlan.Process(tup.Copy())
+
+				Convey(fmt.Sprintf("Then the result should match the reference in %v", i), func() {
+					So(err == nil, ShouldEqual, refErr == nil)
+					So(out, ShouldResemble, refOut)
+				})
+			}
+		})
+	})
+}
+
+func TestPruneColumns(t *testing.T) {
+	Convey("Given a SELECT statement using some columns of two relations", t, func() {
+		s := `SELECT ISTREAM s:a.x, s:b[0], t:c FROM s [RANGE 2 SECONDS], t [RANGE 2 SECONDS]
+			WHERE s:d = t:e`
+
+		Convey("When optimizing it", func() {
+			lp := optimizeSelect(s)
+
+			Convey("Then the required columns should be computed", func() {
+				So(lp.RequiredColumns, ShouldResemble, map[string][]string{
+					"s": {"a", "b", "d"},
+					"t": {"c", "e"},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a wildcard for one relation", t, func() {
+		s := `SELECT ISTREAM s:*, t:c FROM s [RANGE 2 SECONDS], t [RANGE 2 SECONDS]`
+
+		Convey("When optimizing it", func() {
+			lp := optimizeSelect(s)
+
+			Convey("Then only the other relation should be pruned", func() {
+				So(lp.RequiredColumns, ShouldResemble, map[string][]string{
+					"t": {"c"},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT statement with a quoted column", t, func() {
+		s := `SELECT ISTREAM ["a b"], c + 1 AS x FROM s [RANGE 2 SECONDS]`
+
+		Convey("When executing it", func() {
+			plan, refPlan := createOptimizedPlans(s)
+			tup := &core.Tuple{
+				Data: data.Map{
+					"a b": data.Int(1),
+					"c":   data.Int(2),
+					"d":   data.Int(3),
+				},
+				InputName: "s",
+				Timestamp: time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC),
+			}
+			out, err := plan.Process(tup.Copy())
+			So(err, ShouldBeNil)
+			refOut, err := refPlan.Process(tup.Copy())
+			So(err, ShouldBeNil)
+
+			Convey("Then the result should match the reference", func() {
+				So(out, ShouldResemble, refOut)
+			})
+
+			Convey("Then the unused column should not be buffered", func() {
+				buffered := plan.buffers["s"].tuples.Front().Value.(*tupleWithDerivedInputRows)
+				So(buffered.tuple.Data, ShouldResemble, data.Map{
+					"s": data.Map{"a b": data.Int(1), "c": data.Int(2)},
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,161 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+// getScoreTuples returns tuples with the given scores and a
+// "name" column holding the position of each tuple.
+func getScoreTuples(scores ...int) []*core.Tuple {
+	tuples := make([]*core.Tuple, len(scores))
+	for i, score := range scores {
+		tuples[i] = &core.Tuple{
+			Data: data.Map{
+				"name":  data.String(fmt.Sprintf("n%d", i)),
+				"score": data.Int(score),
+			},
+			InputName:     "src",
+			Timestamp:     time.Date(2015, time.April, 10, 10, 23, i, 0, time.UTC),
+			ProcTimestamp: time.Date(2015, time.April, 10, 10, 24, i, 0, time.UTC),
+			BatchID:       7,
+		}
+	}
+	return tuples
+}
+
+func TestOrderByExecution(t *testing.T) {
+	Convey("Given an RSTREAM statement with ORDER BY and LIMIT", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM name, score FROM src [RANGE 4 TUPLES]
+			ORDER BY score DESC, name LIMIT 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getScoreTuples(3, 7, 5, 7, 1, 2)
+			expected := [][]data.Map{
+				{{"name": data.String("n0"), "score": data.Int(3)}},
+				{{"name": data.String("n1"), "score": data.Int(7)},
+					{"name": data.String("n0"), "score": data.Int(3)}},
+				{{"name": data.String("n1"), "score": data.Int(7)},
+					{"name": data.String("n2"), "score": data.Int(5)}},
+				{{"name": data.String("n1"), "score": data.Int(7)},
+					{"name": data.String("n3"), "score": data.Int(7)}},
+				{{"name": data.String("n1"), "score": data.Int(7)},
+					{"name": data.String("n3"), "score": data.Int(7)}},
+				{{"name": data.String("n3"), "score": data.Int(7)},
+					{"name": data.String("n2"), "score": data.Int(5)}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the top 2 rows should be emitted in order in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+
+	Convey("Given an ISTREAM statement with ORDER BY and LIMIT", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM name FROM src [RANGE 3 TUPLES]
+			ORDER BY score DESC LIMIT 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getScoreTuples(3, 1, 2, 0, 5, 4)
+			// the top row changes when it leaves the window or
+			// when a better row arrives
+			expected := []string{"n0", "", "", "n2", "n4", ""}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only rows entering the top 1 should be emitted in %v", idx), func() {
+					if expected[idx] == "" {
+						So(out, ShouldBeEmpty)
+					} else {
+						So(out, ShouldResemble, []data.Map{{"name": data.String(expected[idx])}})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a DSTREAM statement with ORDER BY and LIMIT", t, func() {
+		s := `CREATE STREAM box AS SELECT DSTREAM name FROM src [RANGE 3 TUPLES]
+			ORDER BY score DESC LIMIT 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getScoreTuples(3, 1, 2, 0, 5, 4)
+			expected := []string{"", "", "", "n0", "n2", ""}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only rows leaving the top 1 should be emitted in %v", idx), func() {
+					if expected[idx] == "" {
+						So(out, ShouldBeEmpty)
+					} else {
+						So(out, ShouldResemble, []data.Map{{"name": data.String(expected[idx])}})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a grouping statement ordered by an aggregate", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM score % 2 AS parity, count(*) AS c
+			FROM src [RANGE 5 TUPLES] GROUP BY score ORDER BY c DESC, score OFFSET 1`
+
+		for _, incremental := range []bool{true, false} {
+			incremental := incremental
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+			if !incremental {
+				ep := plan.(*groupbyExecutionPlan)
+				ep.incremental = nil
+				ep.rowChanges = nil
+			}
+
+			Convey(fmt.Sprintf("When feeding it with tuples (incremental: %v)", incremental), func() {
+				tuples := getScoreTuples(2, 3, 3, 4, 2)
+				var out []data.Map
+				for _, inTup := range tuples {
+					out, err = plan.Process(inTup)
+					So(err, ShouldBeNil)
+				}
+
+				Convey("Then all but the largest group should be emitted in order", func() {
+					So(out, ShouldResemble, []data.Map{
+						{"parity": data.Int(1), "c": data.Int(2)},
+						{"parity": data.Int(0), "c": data.Int(1)},
+					})
+				})
+			})
+		}
+	})
+
+	Convey("Given a grouping statement without input ordered by an aggregate", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [RANGE 2 TUPLES] WHERE score > 10 ORDER BY c LIMIT 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with a tuple", func() {
+			out, err := plan.Process(getScoreTuples(1)[0])
+
+			Convey("Then the aggregate over the empty input should be emitted", func() {
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"c": data.Int(0)}})
+			})
+		})
+	})
+}
@@ -0,0 +1,47 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"time"
+)
+
+// NewPartitionKeyFunc returns a function that computes the values of
+// the GROUP BY expressions of the given statement for an input tuple.
+// Tuples with the same key always belong to the same group, so it can
+// be used as core.BoxConfig.PartitionKey to process the groups of a
+// statement in parallel. The statement must have a GROUP BY clause
+// and a single relation, since the group of a joined row cannot be
+// computed from one of the input tuples alone.
+func NewPartitionKeyFunc(lp *LogicalPlan, reg udf.FunctionRegistry) (func(t *core.Tuple) (data.Value, error), error) {
+	if len(lp.GroupList) == 0 {
+		return nil, fmt.Errorf("a statement without a GROUP BY clause cannot be partitioned")
+	}
+	if len(lp.Relations) != 1 {
+		return nil, fmt.Errorf("a statement with more than one relation cannot be partitioned")
+	}
+	groupList, err := prepareGroupList(lp.GroupList, reg)
+	if err != nil {
+		return nil, err
+	}
+	alias := lp.Relations[0].Alias
+
+	return func(t *core.Tuple) (data.Value, error) {
+		// nest the data in the same way as the execution plans do
+		d := data.Map{alias: t.Data}
+		setMetadata(d, alias, t)
+		d[":meta:NOW"] = data.Timestamp(time.Now().In(time.UTC))
+
+		key := make(data.Array, len(groupList))
+		for i, eval := range groupList {
+			value, err := eval.Eval(d)
+			if err != nil {
+				return nil, err
+			}
+			key[i] = value
+		}
+		return key, nil
+	}, nil
+}
@@ -0,0 +1,82 @@
+package execution
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func createPartitionKeyFunc(s string) (func(t *core.Tuple) (data.Value, error), error) {
+	p := parser.New()
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	_stmt, _, err := p.ParseStmt(s)
+	So(err, ShouldBeNil)
+	So(_stmt, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+	stmt := _stmt.(parser.CreateStreamAsSelectStmt).Select
+	logicalPlan, err := Analyze(stmt, reg)
+	So(err, ShouldBeNil)
+	return NewPartitionKeyFunc(logicalPlan, reg)
+}
+
+func TestPartitionKeyFunc(t *testing.T) {
+	Convey("Given a statement with a GROUP BY clause", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM int, x, count(*)
+			FROM src [RANGE 2 TUPLES] GROUP BY int, x`
+		key, err := createPartitionKeyFunc(s)
+		So(err, ShouldBeNil)
+
+		Convey("When computing the key of tuples", func() {
+			tuples := getTuples(3)
+			for i, t := range tuples {
+				t.Data["x"] = data.String([]string{"a", "b", "a"}[i])
+			}
+			keys := make([]data.Value, len(tuples))
+			for i, t := range tuples {
+				keys[i], err = key(t)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then it should hold the values of the GROUP BY expressions", func() {
+				So(keys[0], ShouldResemble, data.Array{data.Int(1), data.String("a")})
+				So(keys[1], ShouldResemble, data.Array{data.Int(2), data.String("b")})
+				So(keys[2], ShouldResemble, data.Array{data.Int(3), data.String("a")})
+			})
+		})
+
+		Convey("When computing the key of a tuple without a grouping column", func() {
+			_, err := key(getTuples(1)[0])
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a statement without a GROUP BY clause", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM count(*) FROM src [RANGE 2 TUPLES]`
+
+		Convey("When creating a partition key function", func() {
+			_, err := createPartitionKeyFunc(s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a statement with a GROUP BY clause and a JOIN", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM a:int, count(*)
+			FROM src [RANGE 2 TUPLES] AS a, src [RANGE 2 TUPLES] AS b GROUP BY a:int`
+
+		Convey("When creating a partition key function", func() {
+			_, err := createPartitionKeyFunc(s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
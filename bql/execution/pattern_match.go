@@ -0,0 +1,164 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"regexp"
+	"strings"
+)
+
+// likeToRegexp converts a pattern of LIKE to an equivalent regular
+// expression. In the pattern, "%" matches any sequence of characters,
+// "_" matches any single character, and a backslash makes the following
+// character match itself.
+func likeToRegexp(pattern string) (string, error) {
+	var b []rune
+	escaped := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			b = append(b, []rune(regexp.QuoteMeta(string(c)))...)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '%':
+			b = append(b, '.', '*')
+		case c == '_':
+			b = append(b, '.')
+		default:
+			b = append(b, []rune(regexp.QuoteMeta(string(c)))...)
+		}
+	}
+	if escaped {
+		return "", fmt.Errorf("LIKE pattern must not end with escape character: %v", pattern)
+	}
+	return `(?s)\A` + string(b) + `\z`, nil
+}
+
+// similarToRegexp converts a pattern of SIMILAR TO to an equivalent
+// regular expression. In addition to "%" and "_" of LIKE, the pattern can
+// contain "|", "*", "+", "?", "{m,n}", "(...)", and bracket expressions
+// as in regular expressions, whereas "." and other metacharacters match
+// themselves.
+func similarToRegexp(pattern string) (string, error) {
+	var b []rune
+	escaped := false
+	inBracket := false
+	for _, c := range pattern {
+		switch {
+		case escaped:
+			b = append(b, []rune(regexp.QuoteMeta(string(c)))...)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case inBracket:
+			if c == ']' {
+				inBracket = false
+			}
+			b = append(b, c)
+		case c == '[':
+			inBracket = true
+			b = append(b, c)
+		case c == '%':
+			b = append(b, '.', '*')
+		case c == '_':
+			b = append(b, '.')
+		case c == '(':
+			// groups are not capturing
+			b = append(b, '(', '?', ':')
+		case strings.ContainsRune("|*+?{}),", c):
+			b = append(b, c)
+		default:
+			b = append(b, []rune(regexp.QuoteMeta(string(c)))...)
+		}
+	}
+	if escaped {
+		return "", fmt.Errorf("SIMILAR TO pattern must not end with escape character: %v", pattern)
+	}
+	return `(?s)\A(?:` + string(b) + `)\z`, nil
+}
+
+// compilePattern compiles a pattern of the given pattern matching
+// operator to a regular expression.
+func compilePattern(op parser.Operator, pattern string) (*regexp.Regexp, error) {
+	var expr string
+	var err error
+	switch op {
+	case parser.Like, parser.NotLike:
+		expr, err = likeToRegexp(pattern)
+	case parser.ILike, parser.NotILike:
+		expr, err = likeToRegexp(pattern)
+		expr = "(?i)" + expr
+	case parser.SimilarTo, parser.NotSimilarTo:
+		expr, err = similarToRegexp(pattern)
+	default:
+		return nil, fmt.Errorf("%v is not a pattern matching operator", op)
+	}
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern for %v: %v", op, pattern)
+	}
+	return re, nil
+}
+
+// patternMatch evaluates LIKE, ILIKE, and SIMILAR TO. Negated operators
+// are evaluated by wrapping it with not.
+type patternMatch struct {
+	binOp
+	op parser.Operator
+	// re is the compiled pattern if the pattern is a constant,
+	// which is then only compiled once
+	re *regexp.Regexp
+}
+
+func newPatternMatch(op parser.Operator, bo binOp, pattern FlatExpression) (Evaluator, error) {
+	pm := &patternMatch{bo, op, nil}
+	if isLiteral(pattern) {
+		v, err := bo.right.Eval(nil)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == data.TypeString {
+			s, _ := data.AsString(v)
+			if pm.re, err = compilePattern(op, s); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	switch op {
+	case parser.NotLike, parser.NotILike, parser.NotSimilarTo:
+		return newNot(pm), nil
+	}
+	return pm, nil
+}
+
+func (pm *patternMatch) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := pm.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	s, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of %v must be string: %v", pm.op, leftVal)
+	}
+	re := pm.re
+	if re == nil {
+		pattern, err := data.AsString(rightVal)
+		if err != nil {
+			return nil, fmt.Errorf("right operand of %v must be string: %v", pm.op, rightVal)
+		}
+		if re, err = compilePattern(pm.op, pattern); err != nil {
+			return nil, err
+		}
+	}
+	return data.Bool(re.MatchString(s)), nil
+}
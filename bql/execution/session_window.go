@@ -0,0 +1,266 @@
+package execution
+
+import (
+	"container/list"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"time"
+)
+
+// session holds the input rows of a single session of a SESSION window.
+type session struct {
+	// key holds the values of the GROUP BY expressions that
+	// are shared by all rows of this session
+	key  data.Array
+	hash data.HashValue
+	rows []*inputRowWithCachedResult
+	// tuples holds the input tuples the rows were derived from
+	tuples []*tupleWithDerivedInputRows
+	// last is the timestamp of the latest tuple in this session
+	last time.Time
+	// lastArrival is the wall-clock time when the last tuple of
+	// this session arrived
+	lastArrival time.Time
+}
+
+// sessionList is used to close sessions in a deterministic order.
+type sessionList []*session
+
+func (l sessionList) Len() int {
+	return len(l)
+}
+
+func (l sessionList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (l sessionList) Less(i, j int) bool {
+	if !l[i].last.Equal(l[j].last) {
+		return l[i].last.Before(l[j].last)
+	}
+	return l[i].hash < l[j].hash
+}
+
+// sessionWindow holds the state of a SESSION window. There is one
+// session per distinct value of the GROUP BY expressions (or only a
+// single one if there is no GROUP BY clause). A session is closed and
+// evaluated when no tuple belonging to it has arrived for the gap,
+// either when a later tuple whose timestamp is at least the gap after
+// the latest timestamp of the session arrives or when the plan's Tick
+// method is called at least the gap after the last tuple of the
+// session arrived.
+type sessionWindow struct {
+	gap time.Duration
+	// sessions holds the open sessions, keyed by the hash
+	// of their key
+	sessions map[data.HashValue][]*session
+}
+
+func newSessionWindow(rel *parser.AliasedStreamWindowAST) *sessionWindow {
+	return &sessionWindow{
+		gap:      time.Duration(intervalNanoseconds(rel.IntervalAST)),
+		sessions: map[data.HashValue][]*session{},
+	}
+}
+
+// find returns the open session with the given key, creating
+// it if necessary.
+func (w *sessionWindow) find(key data.Array, hash data.HashValue) *session {
+	for _, s := range w.sessions[hash] {
+		if data.Equal(s.key, key) {
+			return s
+		}
+	}
+	s := &session{key: key, hash: hash}
+	w.sessions[hash] = append(w.sessions[hash], s)
+	return s
+}
+
+// timedOut returns all sessions for which the given function returns
+// true in the order of their latest tuple. The sessions are not
+// removed from the window.
+func (w *sessionWindow) timedOut(isTimedOut func(s *session) bool) []*session {
+	var timedOut sessionList
+	for _, sessions := range w.sessions {
+		for _, s := range sessions {
+			if isTimedOut(s) {
+				timedOut = append(timedOut, s)
+			}
+		}
+	}
+	sort.Sort(timedOut)
+	return timedOut
+}
+
+// remove removes the given session from the window.
+func (w *sessionWindow) remove(s *session) {
+	sessions := w.sessions[s.hash]
+	for i, other := range sessions {
+		if other != s {
+			continue
+		}
+		if len(sessions) == 1 {
+			delete(w.sessions, s.hash)
+		} else {
+			w.sessions[s.hash] = append(sessions[:i], sessions[i+1:]...)
+		}
+		return
+	}
+}
+
+// windowTuples returns the input tuples of all open sessions in the
+// order of arrival.
+func (w *sessionWindow) windowTuples() []*tupleWithDerivedInputRows {
+	seen := map[int64]bool{}
+	var conts []*tupleWithDerivedInputRows
+	for _, sessions := range w.sessions {
+		for _, s := range sessions {
+			for _, tupCont := range s.tuples {
+				if seen[tupCont.seq] {
+					continue
+				}
+				seen[tupCont.seq] = true
+				conts = append(conts, tupCont)
+			}
+		}
+	}
+	sort.Sort(tuplesBySeq(conts))
+	return conts
+}
+
+// groupKey returns the values of the GROUP BY expressions for
+// the given input row. The values are cached in the row in the
+// same way as the groupbyExecutionPlan does.
+func (ep *streamRelationStreamExecutionPlan) groupKey(row *inputRowWithCachedResult) (data.Array, data.HashValue, error) {
+	if len(ep.groupList) == 0 {
+		return nil, 0, nil
+	}
+	key := make(data.Array, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		value, err := eval.Eval(*row.input)
+		if err != nil {
+			return nil, 0, err
+		}
+		key[i] = value
+	}
+	row.cache = key
+	row.hash = data.Hash(key)
+	return key, row.hash, nil
+}
+
+// processSessionWindow is the counterpart of process for statements
+// with a SESSION window. It closes all sessions that have timed out
+// before the given tuple arrived and then adds the tuple to the
+// session it belongs to.
+func (ep *streamRelationStreamExecutionPlan) processSessionWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	w := ep.sessions
+	tupCont, err := ep.prepareWindowedTuple(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// compute the keys first so that an error does not leave
+	// the window state half-updated
+	keys := make([]data.Array, len(tupCont.rows))
+	hashes := make([]data.HashValue, len(tupCont.rows))
+	for i, row := range tupCont.rows {
+		keys[i], hashes[i], err = ep.groupKey(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	output, err := ep.closeSessions(func(s *session) bool {
+		return !s.last.Add(w.gap).After(input.Timestamp)
+	}, performQueryOnBuffer)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, row := range tupCont.rows {
+		s := w.find(keys[i], hashes[i])
+		s.rows = append(s.rows, row)
+		if n := len(s.tuples); n == 0 || s.tuples[n-1] != tupCont {
+			s.tuples = append(s.tuples, tupCont)
+		}
+		if input.Timestamp.After(s.last) {
+			s.last = input.Timestamp
+		}
+		s.lastArrival = ep.now
+	}
+	return output, nil
+}
+
+// closeSessions evaluates all sessions for which the given function
+// returns true and returns the data to be emitted. A session is only
+// removed from the window after it has been evaluated, so the session
+// that caused an error and all sessions after it are kept open and
+// evaluated again later.
+func (ep *streamRelationStreamExecutionPlan) closeSessions(isTimedOut func(s *session) bool, performQueryOnBuffer func() error) ([]data.Map, error) {
+	var output []data.Map
+	for _, s := range ep.sessions.timedOut(isTimedOut) {
+		rows := list.New()
+		for _, row := range s.rows {
+			rows.PushBack(row)
+		}
+		ep.filteredInputRows = rows
+		if err := performQueryOnBuffer(); err != nil {
+			return nil, err
+		}
+		out, err := ep.computeResultTuples()
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, out...)
+		ep.sessions.remove(s)
+	}
+	return output, nil
+}
+
+// TickInterval returns how often Tick should be called. It returns
+// zero unless the statement has a SESSION window or a time-based
+// TUMBLING or HOPPING window that is processed in the order of
+// arrival. (In event time, the tuple timestamps are not related to
+// the wall clock, so windows are only closed when later tuples
+// arrive or the input ends.)
+func (ep *streamRelationStreamExecutionPlan) TickInterval() time.Duration {
+	if len(ep.reorderBuffers) > 0 {
+		return 0
+	}
+	var d time.Duration
+	switch {
+	case ep.sessions != nil:
+		d = ep.sessions.gap
+	case ep.window != nil && ep.window.timeBased:
+		d = time.Duration(ep.window.slide)
+	default:
+		return 0
+	}
+	interval := d / 10
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	} else if interval > time.Second {
+		interval = time.Second
+	}
+	return interval
+}
+
+// tick closes all windows that have timed out at the given time.
+func (ep *streamRelationStreamExecutionPlan) tick(now time.Time, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.TickInterval() == 0 {
+		return nil, nil
+	}
+	ep.now = now.In(time.UTC)
+	if ep.window != nil {
+		return ep.tickHoppingWindow(now, performQueryOnBuffer)
+	}
+	// the timestamps of the tuples aren't necessarily related to the
+	// wall clock, so sessions time out when no tuple has arrived for
+	// the gap
+	gap := ep.sessions.gap
+	return ep.closeSessions(func(s *session) bool {
+		return !s.lastArrival.Add(gap).After(now)
+	}, performQueryOnBuffer)
+}
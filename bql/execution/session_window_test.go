@@ -0,0 +1,169 @@
+package execution
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+// getSessionTuples returns tuples with the given device ids and
+// timestamps (in milliseconds relative to a fixed point in time).
+func getSessionTuples(devices []string, offsets []int) []*core.Tuple {
+	base := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+	tuples := make([]*core.Tuple, 0, len(devices))
+	for i, d := range devices {
+		tup := core.Tuple{
+			Data: data.Map{
+				"device": data.String(d),
+				"int":    data.Int(i + 1),
+			},
+			InputName: "src",
+			Timestamp: base.Add(time.Duration(offsets[i]) * time.Millisecond),
+			BatchID:   7,
+		}
+		tuples = append(tuples, &tup)
+	}
+	return tuples
+}
+
+func TestSessionWindow(t *testing.T) {
+	Convey("Given a SESSION window with GROUP BY", t, func() {
+		tuples := getSessionTuples([]string{"a", "a", "b", "a", "b"},
+			[]int{0, 1000, 1500, 5000, 5500})
+		s := `CREATE STREAM box AS SELECT RSTREAM device, count(*) AS c, sum(int) AS s
+			FROM src [SESSION GAP 2 SECONDS] GROUP BY device`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then it should be called by a timer", func() {
+			tp, ok := plan.(TimedPhysicalPlan)
+			So(ok, ShouldBeTrue)
+			So(tp.TickInterval(), ShouldEqual, 200*time.Millisecond)
+		})
+
+		Convey("When feeding it with tuples", func() {
+			var outs [][]data.Map
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then sessions should be emitted after the gap in the order they ended", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldBeEmpty)
+				So(outs[3], ShouldResemble, []data.Map{
+					{"device": data.String("a"), "c": data.Int(2), "s": data.Int(3)},
+					{"device": data.String("b"), "c": data.Int(1), "s": data.Int(3)},
+				})
+				So(outs[4], ShouldBeEmpty)
+			})
+
+			Convey("And when time passes", func() {
+				tp := plan.(TimedPhysicalPlan)
+				out, err := tp.Tick(time.Now().Add(time.Second))
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+				out, err = tp.Tick(time.Now().Add(2 * time.Second))
+				So(err, ShouldBeNil)
+
+				Convey("Then timed out sessions should be emitted", func() {
+					So(out, ShouldResemble, []data.Map{
+						{"device": data.String("a"), "c": data.Int(1), "s": data.Int(4)},
+						{"device": data.String("b"), "c": data.Int(1), "s": data.Int(5)},
+					})
+				})
+			})
+
+			Convey("And when ticking at the timestamps of the tuples", func() {
+				tp := plan.(TimedPhysicalPlan)
+				out, err := tp.Tick(tuples[4].Timestamp.Add(2 * time.Second))
+				So(err, ShouldBeNil)
+
+				Convey("Then no session should be closed because the gap hasn't passed since the last arrival", func() {
+					So(out, ShouldBeEmpty)
+				})
+			})
+		})
+	})
+
+	Convey("Given a SESSION window without GROUP BY", t, func() {
+		tuples := getSessionTuples([]string{"a", "b", "c"}, []int{0, 500, 3000})
+		s := `CREATE STREAM box AS SELECT ISTREAM device
+			FROM src [SESSION GAP 1 SECONDS] WHERE int != 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var outs [][]data.Map
+			for _, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				outs = append(outs, out)
+			}
+
+			Convey("Then all rows of a session should be emitted together", func() {
+				So(outs[0], ShouldBeEmpty)
+				So(outs[1], ShouldBeEmpty)
+				So(outs[2], ShouldResemble, []data.Map{{"device": data.String("a")}})
+			})
+		})
+	})
+
+	Convey("Given a SESSION window whose evaluation fails", t, func() {
+		tuples := getSessionTuples([]string{"a", "a", "b"}, []int{0, 1000, 1500})
+		s := `CREATE STREAM box AS SELECT RSTREAM device, 6 / (sum(int) - 3) AS q
+			FROM src [SESSION GAP 2 SECONDS] GROUP BY device`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		for _, inTup := range tuples {
+			out, err := plan.Process(inTup)
+			So(err, ShouldBeNil)
+			So(out, ShouldBeEmpty)
+		}
+
+		Convey("When the sessions time out", func() {
+			_, err := plan.(TimedPhysicalPlan).Tick(time.Now().Add(time.Minute))
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the sessions should be kept open", func() {
+				ts, _, err := plan.(CheckpointablePhysicalPlan).WindowTuples()
+				So(err, ShouldBeNil)
+				So(ts, ShouldResemble, tuples)
+			})
+		})
+	})
+
+	Convey("Given a SESSION window with a LATENESS", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [SESSION GAP 2 SECONDS, LATENESS 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then it should not be called by a timer", func() {
+			tp, ok := plan.(TimedPhysicalPlan)
+			So(ok, ShouldBeTrue)
+			So(tp.TickInterval(), ShouldEqual, 0)
+		})
+
+		Convey("When the input ends", func() {
+			tuples := getTuples(5)
+			for _, inTup := range []*core.Tuple{tuples[0], tuples[1], tuples[4]} {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+
+			Convey("Then flushing it should close the sessions before the last tuple", func() {
+				fp, ok := plan.(FlushablePhysicalPlan)
+				So(ok, ShouldBeTrue)
+				out, err := fp.Flush()
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+			})
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 	"time"
 )
 
@@ -19,6 +20,17 @@ type inputBuffer struct {
 type tupleWithDerivedInputRows struct {
 	tuple *core.Tuple
 	rows  []*inputRowWithCachedResult
+	// joinKey holds the hash of the equi-join key of this tuple
+	// if it is stored in a hashJoin index (see joinKeyValid)
+	joinKey      data.HashValue
+	joinKeyValid bool
+	// seq is the number of tuples that were added to the buffers
+	// before this tuple. Copies of the same input tuple appended to
+	// several buffers on self-join share the same value.
+	seq int64
+	// pushedFilterFailed is true if evaluating the pushed down
+	// predicate of the relation on this tuple failed.
+	pushedFilterFailed bool
 }
 
 func (i *inputBuffer) isTimeBased() bool {
@@ -32,6 +44,9 @@ type inputRowWithCachedResult struct {
 	input *data.Map
 	cache data.Value
 	hash  data.HashValue
+	// sortKey caches the values of the ORDER BY expressions
+	// along with a cached projection result
+	sortKey data.Array
 }
 
 // resultRow holds data for a tuple to be emitted (sooner or later)
@@ -41,6 +56,9 @@ type inputRowWithCachedResult struct {
 type resultRow struct {
 	row  data.Map
 	hash data.HashValue
+	// sortKey holds the values of the ORDER BY expressions,
+	// which are not part of row
+	sortKey data.Array
 }
 
 // resultRowCount stores a count for a particular data item. This is
@@ -82,6 +100,12 @@ type streamRelationStreamExecutionPlan struct {
 	buffers map[string]*inputBuffer
 	// emitter configuration
 	emitterType parser.Emitter
+	// ordering holds the sort directions of the ORDER BY
+	// expressions, limit the maximum number of result rows
+	// (or -1), and offset the number of skipped result rows.
+	ordering []bool
+	limit    int64
+	offset   int64
 	// curResults holds results of a query over the buffer.
 	curResults []resultRow
 	// prevResults holds results of a query over the buffer
@@ -104,6 +128,40 @@ type streamRelationStreamExecutionPlan struct {
 	// the last tuple was appended to. this is valid after
 	// `addTupleToBuffer` has returned.
 	lastTupleBuffers map[string]bool
+	// join holds the state for computing the input rows of a
+	// statement with JOIN clauses, or nil if there are none.
+	join *hashJoin
+	// window holds the state of a TUMBLING or HOPPING window,
+	// or nil if the statement uses a different window.
+	window *hoppingWindow
+	// sessions holds the state of a SESSION window, or nil if
+	// the statement uses a different window.
+	sessions *sessionWindow
+	// reorderBuffers holds the tuples of all input streams with
+	// an allowed lateness, keyed by the input name, until they
+	// can be processed in timestamp order.
+	reorderBuffers map[string]*reorderBuffer
+	// rowChanges records the rows added to and removed from
+	// filteredInputRows if the plan maintains its results
+	// incrementally, or is nil otherwise.
+	rowChanges *inputRowChanges
+	// numAddedTuples is the number of tuples that have been
+	// added to the buffers so far.
+	numAddedTuples int64
+	// pushedFilters holds the evaluators of the predicates that
+	// a tuple must fulfill to be added to the buffer of a relation,
+	// keyed by the alias of the relation.
+	pushedFilters map[string]Evaluator
+	// originalFilter is the evaluator of the WHERE clause before
+	// predicates were pushed down. It is used instead of filter
+	// on rows derived from a tuple for which a pushed down predicate
+	// failed.
+	originalFilter Evaluator
+	// requiredColumns holds the keys of the tuples that are kept
+	// when they are added to the buffer of a relation, keyed by the
+	// alias of the relation. Tuples of relations that are not
+	// contained are buffered as they are.
+	requiredColumns map[string][]string
 }
 
 func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (*streamRelationStreamExecutionPlan, error) {
@@ -122,6 +180,23 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 	if err != nil {
 		return nil, err
 	}
+	// compute evaluators for the predicates evaluated before
+	// a tuple is added to a buffer
+	var pushedFilters map[string]Evaluator
+	for alias, expr := range lp.PushedFilters {
+		eval, err := ExpressionToEvaluator(expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		if pushedFilters == nil {
+			pushedFilters = map[string]Evaluator{}
+		}
+		pushedFilters[alias] = eval
+	}
+	originalFilter, err := prepareFilter(lp.OriginalFilter, reg)
+	if err != nil {
+		return nil, err
+	}
 	// for compatibility with the old syntax, take the last RANGE
 	// specification as valid for all buffers
 
@@ -137,20 +212,62 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		}
 	}
 
-	return &streamRelationStreamExecutionPlan{
+	// prepare hash indexes and join conditions
+	var join *hashJoin
+	if len(lp.Joins) > 0 {
+		join, err = newHashJoin(lp, reg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// TUMBLING, HOPPING and SESSION windows are only allowed
+	// with a single relation
+	var window *hoppingWindow
+	var sessions *sessionWindow
+	if len(lp.Relations) == 1 {
+		switch lp.Relations[0].Window {
+		case parser.TumblingWindow, parser.HoppingWindow:
+			window = newHoppingWindow(&lp.Relations[0])
+		case parser.SessionWindow:
+			sessions = newSessionWindow(&lp.Relations[0])
+		}
+	}
+
+	ep := &streamRelationStreamExecutionPlan{
 		commonExecutionPlan: commonExecutionPlan{
 			projections: projs,
 			groupList:   groupList,
 			filter:      filter,
 		},
+		join:                 join,
+		window:               window,
+		sessions:             sessions,
 		relations:            lp.Relations,
 		buffers:              buffers,
 		emitterType:          lp.EmitterType,
+		ordering:             lp.Ordering,
+		limit:                lp.Limit,
+		offset:               lp.Offset,
 		curResults:           []resultRow{},
 		prevResults:          []resultRow{},
 		prevHashesForIstream: map[data.HashValue][]resultRowCount{},
+		pushedFilters:        pushedFilters,
+		originalFilter:       originalFilter,
+		requiredColumns:      lp.RequiredColumns,
 		filteredInputRows:    list.New(),
-	}, nil
+		reorderBuffers:       map[string]*reorderBuffer{},
+	}
+
+	// relations with a LATENESS are processed in event time
+	// (Analyze makes sure that all relations reading from the
+	// same stream use the same LATENESS)
+	for _, rel := range lp.Relations {
+		if rel.Lateness.Unit != parser.UnspecifiedIntervalUnit {
+			ep.reorderBuffers[ep.relationKey(&rel)] = newReorderBuffer(rel.Lateness)
+		}
+	}
+	return ep, nil
 }
 
 // relationKey computes the InputName that belongs to a relation.
@@ -196,29 +313,144 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 	ep.lastTupleBuffers = make(map[string]bool, numAppends)
 	for _, rel := range ep.relations {
 		if t.InputName == ep.relationKey(&rel) {
+			// a tuple that doesn't fulfill the part of the WHERE
+			// clause referring to this relation would never be
+			// part of a result row, so we don't buffer it at all
+			// (if evaluating that part fails, the tuple is buffered
+			// and the original WHERE clause decides)
+			filterFailed := false
+			if filter, ok := ep.pushedFilters[rel.Alias]; ok {
+				dataHolder := data.Map{rel.Alias: t.Data}
+				setMetadata(dataHolder, rel.Alias, t)
+				matched, err := evalCondition(filter, dataHolder)
+				if err != nil {
+					filterFailed = true
+				} else if !matched {
+					continue
+				}
+			}
 			// because the tuple is always cached, ShallowCopy is required here.
 			editTuple := t.ShallowCopy()
+			// remove the keys that are not used by the statement
+			// (a new map is created, the original data is unchanged)
+			if cols, ok := ep.requiredColumns[rel.Alias]; ok {
+				editTuple.Data = pruneTupleData(editTuple.Data, cols)
+			}
 			// nest the data in a one-element map using the alias as the key
 			editTuple.Data = data.Map{rel.Alias: editTuple.Data}
 			// wrap this in a container struct
 			editTupleCont := tupleWithDerivedInputRows{
-				tuple: editTuple,
+				tuple:              editTuple,
+				seq:                ep.numAddedTuples,
+				pushedFilterFailed: filterFailed,
 			}
 			buffer := ep.buffers[rel.Alias]
 			buffer.tuples.PushBack(&editTupleCont)
 			ep.lastTupleBuffers[rel.Alias] = true
+			if ep.join != nil {
+				ep.join.addToIndex(rel.Alias, &editTupleCont)
+			}
 		}
 	}
+	ep.numAddedTuples++
 
 	return nil
 }
 
+// WindowTuples returns the input tuples held in the windows of the
+// plan in the order they were processed, followed by the tuples held
+// back in the reorder buffers. Each tuple is only returned once even
+// if it is held in more than one buffer on self-join. Processing the
+// tuples of RANGE and SESSION windows with a new plan fills its
+// windows with the same tuples because a tuple that is still held in
+// a window is never removed by a later tuple of the same window. The
+// positions of TUMBLING and HOPPING windows and the watermarks of the
+// reorder buffers are returned as the state.
+func (ep *streamRelationStreamExecutionPlan) WindowTuples() ([]*core.Tuple, data.Map, error) {
+	state := data.Map{}
+	var conts []*tupleWithDerivedInputRows
+	switch {
+	case ep.window != nil:
+		conts, state["window"] = ep.window.save()
+	case ep.sessions != nil:
+		conts = ep.sessions.windowTuples()
+	default:
+		seen := map[int64]bool{}
+		for _, buffer := range ep.buffers {
+			for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+				tupCont := e.Value.(*tupleWithDerivedInputRows)
+				if seen[tupCont.seq] {
+					continue
+				}
+				seen[tupCont.seq] = true
+				conts = append(conts, tupCont)
+			}
+		}
+		sort.Sort(tuplesBySeq(conts))
+	}
+
+	tuples := make([]*core.Tuple, 0, len(conts))
+	for _, tupCont := range conts {
+		// undo the nesting done by addTupleToBuffer
+		for _, d := range tupCont.tuple.Data {
+			m, err := data.AsMap(d)
+			if err != nil {
+				return nil, nil, err
+			}
+			t := tupCont.tuple.ShallowCopy()
+			t.Data = m
+			tuples = append(tuples, t)
+		}
+	}
+
+	if len(ep.reorderBuffers) > 0 {
+		var held []*core.Tuple
+		held, state["reorder_buffers"] = ep.saveReorderBuffers()
+		tuples = append(tuples, held...)
+	}
+	if len(state) == 0 {
+		return tuples, nil, nil
+	}
+	return tuples, state, nil
+}
+
+// restoreWindows is the counterpart of WindowTuples. The tuples held
+// in RANGE and SESSION windows are processed again in the order of
+// arrival while the other windows and the reorder buffers are
+// restored directly.
+func (ep *streamRelationStreamExecutionPlan) restoreWindows(tuples []*core.Tuple, state data.Map, performQueryOnBuffer func() error) error {
+	ep.now = time.Now().In(time.UTC)
+	if len(ep.reorderBuffers) > 0 {
+		n, err := ep.restoreReorderBuffers(tuples, state["reorder_buffers"])
+		if err != nil {
+			return err
+		}
+		tuples = tuples[:len(tuples)-n]
+	}
+	if ep.window != nil {
+		return ep.restoreHoppingWindow(tuples, state["window"])
+	}
+	for _, t := range tuples {
+		if _, err := ep.processTuple(t, performQueryOnBuffer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tuplesBySeq sorts tuples in the order they were added to the buffers.
+type tuplesBySeq []*tupleWithDerivedInputRows
+
+func (s tuplesBySeq) Len() int           { return len(s) }
+func (s tuplesBySeq) Less(i, j int) bool { return s[i].seq < s[j].seq }
+func (s tuplesBySeq) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
 // removeOutdatedTuplesFromBuffer removes tuples from the buffer that
 // lie outside the current window as per the statement's window
 // specification.
 func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curTupTime time.Time) error {
 	expiredInputRows := map[*inputRowWithCachedResult]bool{}
-	for _, buffer := range ep.buffers {
+	for alias, buffer := range ep.buffers {
 		curBufSize := int64(buffer.tuples.Len())
 		if buffer.windowType == parser.Tuples { // tuple-based window
 			windowSizeInt := int64(buffer.windowSize)
@@ -237,6 +469,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						ep.join.removeFromIndex(alias, tupCont)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -259,6 +494,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if ep.join != nil {
+						ep.join.removeFromIndex(alias, tupCont)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -273,6 +511,9 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 		itemPtr := e.Value.(*inputRowWithCachedResult)
 		if toDelete := expiredInputRows[itemPtr]; toDelete {
 			ep.filteredInputRows.Remove(e)
+			if ep.rowChanges != nil {
+				ep.rowChanges.removed = append(ep.rowChanges.removed, itemPtr)
+			}
 		}
 	}
 
@@ -330,11 +571,54 @@ func (ep *streamRelationStreamExecutionPlan) incrAndGetMultiplicity(r *resultRow
 	return 1
 }
 
+// orderResults sorts the results of this run's query by the values
+// of the ORDER BY expressions and applies the OFFSET and LIMIT
+// clauses. Rows with equal sort keys keep their relative order.
+func (ep *streamRelationStreamExecutionPlan) orderResults() {
+	results := ep.curResults
+	if len(ep.ordering) > 0 {
+		s := &indexSlice{
+			indexes:  make([]int, len(results)),
+			ordering: make([]sortArray, len(ep.ordering)),
+		}
+		for i := range s.indexes {
+			s.indexes[i] = i
+		}
+		for k, ascending := range ep.ordering {
+			values := make(data.Array, len(results))
+			for i, res := range results {
+				values[i] = res.sortKey[k]
+			}
+			s.ordering[k] = sortArray{values, ascending}
+		}
+		sort.Stable(s)
+		sorted := make([]resultRow, len(results))
+		for i, idx := range s.indexes {
+			sorted[i] = results[idx]
+		}
+		results = sorted
+	}
+	if ep.offset >= int64(len(results)) {
+		results = results[:0]
+	} else if ep.offset > 0 {
+		results = results[ep.offset:]
+	}
+	if ep.limit >= 0 && int64(len(results)) > ep.limit {
+		results = results[:ep.limit]
+	}
+	ep.curResults = results
+}
+
 // computeResultTuples compares the results of this run's query with
 // the results of the previous run's query and returns the data to
 // be emitted as per the Emitter specification (Rstream = new,
-// Istream = new-old, Dstream = old-new).
+// Istream = new-old, Dstream = old-new). If the statement has an
+// ORDER BY, LIMIT or OFFSET clause, the results of both runs are
+// the ordered and limited ones, so that, e.g., ISTREAM emits the
+// rows that entered the top N.
 func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map, error) {
+	ep.orderResults()
+
 	// TODO turn this into an iterator/generator pattern
 	var output []data.Map
 	if ep.emitterType == parser.Rstream {
@@ -408,7 +692,25 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 // to the results of the query represented by this execution plan. Note that the
 // order of items in the returned slice is undefined and cannot be relied on.
 func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	// now() returns the same time for all tuples processed by this
+	// call, including tuples released from reorder buffers
 	ep.now = time.Now().In(time.UTC)
+	if len(ep.reorderBuffers) > 0 {
+		return ep.processInEventTime(input, performQueryOnBuffer)
+	}
+	return ep.processTuple(input, performQueryOnBuffer)
+}
+
+// processTuple processes a single tuple in the order of arrival.
+func (ep *streamRelationStreamExecutionPlan) processTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	// TUMBLING, HOPPING and SESSION windows are only evaluated
+	// when a window is closed
+	if ep.window != nil {
+		return ep.processHoppingWindow(input, performQueryOnBuffer)
+	}
+	if ep.sessions != nil {
+		return ep.processSessionWindow(input, performQueryOnBuffer)
+	}
 
 	// stream-to-relation:
 	// updates the internal buffer with correct window data
@@ -435,6 +737,12 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 }
 
 func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
+	// statements with JOIN clauses use hash indexes instead of
+	// iterating over the full cartesian product
+	if ep.join != nil {
+		return ep.joinInputTuples()
+	}
+
 	// we need to make a cross product of the data in all buffers,
 	// combine it to get an input like
 	//  {"streamA": {data}, "streamB": {data}, "streamC": {data}}
@@ -520,10 +828,21 @@ func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	// write only the items matching the filter to ep.filteredInputRows
 	// (NB. the items appended here will be cleaned up in future
 	// runs by `removeOutdatedTuplesFromBuffer`)
-	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	ep.appendFilteredInputRows()
 	return nil
 }
 
+// appendFilteredInputRows appends the rows computed for the newly added
+// tuple to `ep.filteredInputRows`.
+func (ep *streamRelationStreamExecutionPlan) appendFilteredInputRows() {
+	ep.filteredInputRows.PushBackList(ep.filteredInputRowsBuffer)
+	if ep.rowChanges != nil {
+		for e := ep.filteredInputRowsBuffer.Front(); e != nil; e = e.Next() {
+			ep.rowChanges.added = append(ep.rowChanges.added, e.Value.(*inputRowWithCachedResult))
+		}
+	}
+}
+
 // preprocessCartesianProduct computes the cartesian product,
 // applies this plan's filter/join condition to each item and
 // appends it to `ep.filteredInputRows`
@@ -563,49 +882,69 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 	} else {
 		// all tuples have been visited and we should now have the data
 		// of one cartesian product item in dataHolder
+		return ep.appendFilteredInputRow(dataHolder, origin)
+	}
+	return nil
+}
 
-		// add the information accessed by the now() function
-		// to each item
-		dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
-
-		// evaluate filter condition
-		if ep.filter != nil {
-			filterResult, err := ep.filter.Eval(dataHolder)
-			if err != nil {
-				return err
-			}
-			// a NULL value is definitely not "true", so since we
-			// have only a binary decision, we should drop tuples
-			// where the filter condition evaluates to NULL
-			filterResultBool := false
-			if filterResult.Type() != data.TypeNull {
-				filterResultBool, err = data.AsBool(filterResult)
-				if err != nil {
-					return err
-				}
-			}
-			// if it evaluated to false, do not further process this tuple
-			if !filterResultBool {
-				return nil
-			}
-		}
-
-		// if we arrive here, this item of the cartesian product fulfills
-		// the filter/join condition, so we make a shallow copy (that should
-		// be fine) and add it to the list of input items
-		item := make(data.Map, len(dataHolder))
-		for key, val := range dataHolder {
-			item[key] = val
+// appendFilteredInputRow evaluates this plan's filter condition on
+// the given item and, if it matches, appends a copy of it to
+// `ep.filteredInputRowsBuffer`.
+func (ep *streamRelationStreamExecutionPlan) appendFilteredInputRow(dataHolder data.Map, origin map[string]*tupleWithDerivedInputRows) error {
+	// add the information accessed by the now() function
+	// to each item
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+
+	// evaluate filter condition
+	filter := ep.filter
+	for _, tupHolder := range origin {
+		if tupHolder.pushedFilterFailed {
+			filter = ep.originalFilter
+			break
 		}
-		itemWithCachedResult := &inputRowWithCachedResult{
-			input: &item,
+	}
+	if filter != nil {
+		filterResultBool, err := evalCondition(filter, dataHolder)
+		if err != nil {
+			return err
 		}
-		// also write the address of this item to all tuples
-		// it originates from
-		for _, tupHolder := range origin {
-			tupHolder.rows = append(tupHolder.rows, itemWithCachedResult)
+		// if it evaluated to false, do not further process this tuple
+		if !filterResultBool {
+			return nil
 		}
-		ep.filteredInputRowsBuffer.PushBack(itemWithCachedResult)
 	}
+
+	// if we arrive here, this item of the cartesian product fulfills
+	// the filter/join condition, so we make a shallow copy (that should
+	// be fine) and add it to the list of input items
+	item := make(data.Map, len(dataHolder))
+	for key, val := range dataHolder {
+		item[key] = val
+	}
+	itemWithCachedResult := &inputRowWithCachedResult{
+		input: &item,
+	}
+	// also write the address of this item to all tuples
+	// it originates from
+	for _, tupHolder := range origin {
+		tupHolder.rows = append(tupHolder.rows, itemWithCachedResult)
+	}
+	ep.filteredInputRowsBuffer.PushBack(itemWithCachedResult)
 	return nil
 }
+
+// evalCondition evaluates the given condition on the given input
+// and returns whether it is fulfilled.
+func evalCondition(cond Evaluator, input data.Map) (bool, error) {
+	result, err := cond.Eval(input)
+	if err != nil {
+		return false, err
+	}
+	// a NULL value is definitely not "true", so since we
+	// have only a binary decision, we should drop tuples
+	// where the condition evaluates to NULL
+	if result.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(result)
+}
@@ -2,8 +2,10 @@ package execution
 
 import (
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
+	"time"
 )
 
 func TestMultiplicityHandling(t *testing.T) {
@@ -15,14 +17,17 @@ func TestMultiplicityHandling(t *testing.T) {
 			a := resultRow{
 				data.Map{"a": data.Int(5)},
 				data.HashValue(17),
+				nil,
 			}
 			b := resultRow{
 				data.Map{"a": data.Int(6)},
 				data.HashValue(17),
+				nil,
 			}
 			c := resultRow{
 				data.Map{"a": data.Int(7)},
 				data.HashValue(18),
+				nil,
 			}
 
 			Convey("Then adding and counting should work correctly", func() {
@@ -49,3 +54,186 @@ func TestMultiplicityHandling(t *testing.T) {
 		})
 	})
 }
+
+func TestWindowTuples(t *testing.T) {
+	tuples := getTuples(4)
+
+	Convey("Given a plan with a self-join of windows of different sizes", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM a:int AS l, b:int AS r
+			FROM src [RANGE 3 TUPLES] AS a, src [RANGE 2 TUPLES] AS b
+			WHERE a:int + 1 = b:int`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+		for _, tup := range tuples {
+			_, err := plan.Process(tup)
+			So(err, ShouldBeNil)
+		}
+
+		Convey("When getting the tuples held in the windows", func() {
+			held, state, err := plan.(CheckpointablePhysicalPlan).WindowTuples()
+			So(err, ShouldBeNil)
+			So(state, ShouldBeNil)
+
+			Convey("Then each tuple should be returned once in the order of arrival", func() {
+				So(len(held), ShouldEqual, 3)
+				for i, tup := range held {
+					So(tup.Data, ShouldResemble, tuples[i+1].Data)
+					So(tup.InputName, ShouldEqual, "src")
+					So(tup.Timestamp, ShouldResemble, tuples[i+1].Timestamp)
+				}
+			})
+
+			Convey("Then restoring them in a new plan should give the same results", func() {
+				restored, err := createDefaultSelectPlan(s, t)
+				So(err, ShouldBeNil)
+				So(restored.(CheckpointablePhysicalPlan).RestoreWindows(held, state), ShouldBeNil)
+
+				next := getTuples(5)[4]
+				expected, err := plan.Process(next)
+				So(err, ShouldBeNil)
+				actual, err := restored.Process(next.Copy())
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, expected)
+				So(actual, ShouldResemble, []data.Map{{"l": data.Int(4), "r": data.Int(5)}})
+			})
+		})
+	})
+
+	// restored returns a new plan whose windows are restored from
+	// the given plan after it has processed the given tuples.
+	restored := func(s string, plan PhysicalPlan, tuples []*core.Tuple) PhysicalPlan {
+		for _, tup := range tuples {
+			_, err := plan.Process(tup)
+			So(err, ShouldBeNil)
+		}
+		held, state, err := plan.(CheckpointablePhysicalPlan).WindowTuples()
+		So(err, ShouldBeNil)
+		restored, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		So(restored.(CheckpointablePhysicalPlan).RestoreWindows(held, state), ShouldBeNil)
+		return restored
+	}
+
+	Convey("Given a plan with a count-based HOPPING window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s
+			FROM src [HOPPING 3 TUPLES, SLIDE 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When restoring its windows in a new plan", func() {
+			// the first window has been closed after the second
+			// tuple and the third tuple is held for the next one
+			tuples := getTuples(6)
+			r := restored(s, plan, tuples[:3])
+
+			Convey("Then the new plan should close the next window at the same position", func() {
+				for _, tup := range tuples[3:] {
+					expected, err := plan.Process(tup)
+					So(err, ShouldBeNil)
+					actual, err := r.Process(tup.Copy())
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, expected)
+				}
+			})
+		})
+	})
+
+	Convey("Given a plan with a time-based HOPPING window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [HOPPING 2 SECONDS, SLIDE 1 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When restoring its windows in a new plan", func() {
+			tuples := getTuples(5)
+			r := restored(s, plan, tuples[:3])
+
+			Convey("Then the new plan should not emit closed windows again", func() {
+				for _, tup := range tuples[3:] {
+					expected, err := plan.Process(tup)
+					So(err, ShouldBeNil)
+					actual, err := r.Process(tup.Copy())
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, expected)
+				}
+			})
+		})
+	})
+
+	Convey("Given a plan with a SESSION window", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [SESSION GAP 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When restoring its windows in a new plan", func() {
+			tuples := getTuples(3)
+			tuples[2].Timestamp = tuples[2].Timestamp.Add(5 * time.Second)
+			r := restored(s, plan, tuples[:2])
+
+			Convey("Then the new plan should close the same session", func() {
+				expected, err := plan.Process(tuples[2])
+				So(err, ShouldBeNil)
+				So(expected, ShouldResemble, []data.Map{{"c": data.Int(2)}})
+				actual, err := r.Process(tuples[2].Copy())
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, expected)
+			})
+		})
+	})
+
+	Convey("Given a plan with a TUMBLING window with a LATENESS", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM count(*) AS c
+			FROM src [TUMBLING 2 SECONDS, LATENESS 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When restoring its windows in a new plan", func() {
+			tuples := getTuples(8)
+			r := restored(s, plan, tuples[:4])
+
+			Convey("Then the new plan should have the same reorder buffer", func() {
+				held, state, err := plan.(CheckpointablePhysicalPlan).WindowTuples()
+				So(err, ShouldBeNil)
+				rHeld, rState, err := r.(CheckpointablePhysicalPlan).WindowTuples()
+				So(err, ShouldBeNil)
+				So(len(rHeld), ShouldEqual, len(held))
+				So(rState, ShouldResemble, state)
+			})
+
+			Convey("Then the new plan should give the same results", func() {
+				for _, tup := range tuples[4:] {
+					expected, err := plan.Process(tup)
+					So(err, ShouldBeNil)
+					actual, err := r.Process(tup.Copy())
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, expected)
+				}
+				expected, err := plan.(FlushablePhysicalPlan).Flush()
+				So(err, ShouldBeNil)
+				So(expected, ShouldNotBeEmpty)
+				actual, err := r.(FlushablePhysicalPlan).Flush()
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, expected)
+			})
+		})
+	})
+
+	Convey("Given a filter plan", t, func() {
+		plan, err := createFilterPlan2(`CREATE STREAM box AS SELECT RSTREAM int
+			FROM src [RANGE 1 TUPLES]`)
+		So(err, ShouldBeNil)
+		_, err = plan.Process(tuples[0])
+		So(err, ShouldBeNil)
+
+		Convey("When getting the tuples held in the windows", func() {
+			held, state, err := plan.(CheckpointablePhysicalPlan).WindowTuples()
+
+			Convey("Then it should hold no tuples", func() {
+				So(state, ShouldBeNil)
+				So(err, ShouldBeNil)
+				So(held, ShouldBeEmpty)
+			})
+		})
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"math"
 	"regexp"
 	"strings"
+	"time"
 )
 
 const (
@@ -44,9 +45,41 @@ type LogicalPlan struct {
 	EmitterSamplingType parser.EmitterSamplingType
 	Projections         []aliasedExpression
 	parser.WindowedFromAST
-	Filter    FlatExpression
-	GroupList []FlatExpression
+	// JoinConditions holds the flattened ON conditions of the
+	// JOIN clauses, in the same order as WindowedFromAST.Joins.
+	JoinConditions []FlatExpression
+	Filter         FlatExpression
+	GroupList      []FlatExpression
 	parser.HavingAST
+	// Ordering holds the sort directions (true for ascending) of
+	// the ORDER BY expressions. The expressions themselves are
+	// appended to Projections with the aliases ":order:0",
+	// ":order:1", etc.
+	Ordering []bool
+	// Limit is the maximum number of result rows of a single
+	// evaluation, or -1 if there is no LIMIT clause. Offset is
+	// the number of leading result rows that are skipped.
+	Limit  int64
+	Offset int64
+	// PushedFilters holds the conjuncts of the WHERE clause that
+	// were moved out of Filter by LogicalOptimize because they only
+	// refer to a single relation, keyed by the alias of the relation.
+	// A tuple that doesn't fulfill the predicate is not added to
+	// the window buffer of the relation at all. A tuple for which
+	// evaluating the predicate fails is added, and OriginalFilter
+	// is evaluated on the rows derived from it instead of Filter.
+	PushedFilters map[string]FlatExpression
+	// OriginalFilter holds the WHERE clause as it was before its
+	// conjuncts were moved to PushedFilters, or nil if no conjunct
+	// was moved. Since the conjuncts are evaluated in their original
+	// order, an error is only reported if it would also have been
+	// reported without the optimization.
+	OriginalFilter FlatExpression
+	// RequiredColumns holds the top-level keys of the input tuples
+	// used by the statement, keyed by the alias of the relation.
+	// Other keys are removed from a tuple before it is added to the
+	// window buffer. Relations that are not contained are not pruned.
+	RequiredColumns map[string][]string
 }
 
 // PhysicalPlan is a physical interface that is capable of
@@ -70,6 +103,59 @@ type PhysicalPlan interface {
 	Process(input *core.Tuple) ([]data.Map, error)
 }
 
+// TimedPhysicalPlan is a PhysicalPlan whose results can also change
+// when time passes without new tuples arriving, e.g., because a
+// session of a SESSION window has timed out.
+type TimedPhysicalPlan interface {
+	PhysicalPlan
+
+	// TickInterval returns how often Tick should be called. If it
+	// returns zero, Tick does not need to be called at all.
+	TickInterval() time.Duration
+
+	// Tick must be called regularly with the current time. It
+	// returns a list of data.Map items to be emitted in the same
+	// way as Process does.
+	//
+	// NB. Tick must not be called concurrently with Process.
+	Tick(now time.Time) ([]data.Map, error)
+}
+
+// FlushablePhysicalPlan is a PhysicalPlan that holds back input tuples,
+// e.g., to process them in the order of their timestamps.
+type FlushablePhysicalPlan interface {
+	PhysicalPlan
+
+	// Flush processes all tuples held back by the plan as if no more
+	// tuples would arrive. It returns a list of data.Map items to be
+	// emitted in the same way as Process does.
+	//
+	// NB. Flush must not be called concurrently with Process.
+	Flush() ([]data.Map, error)
+}
+
+// CheckpointablePhysicalPlan is a PhysicalPlan whose state can be
+// saved and restored in a new instance of the same plan.
+type CheckpointablePhysicalPlan interface {
+	PhysicalPlan
+
+	// WindowTuples returns the input tuples currently held in the
+	// windows of the plan in the order they were processed, followed
+	// by the tuples held back in its reorder buffers. It also returns
+	// the state of the windows and reorder buffers that cannot be
+	// derived from these tuples, which is nil if there is none.
+	//
+	// NB. WindowTuples must not be called concurrently with Process.
+	WindowTuples() ([]*core.Tuple, data.Map, error)
+
+	// RestoreWindows restores the state returned by WindowTuples of
+	// another instance of the same plan. It must be called before
+	// any tuple is processed. The results computed while restoring
+	// the windows are discarded because they have already been
+	// emitted by the other instance.
+	RestoreWindows(tuples []*core.Tuple, state data.Map) error
+}
+
 // Analyze checks the given SELECT statement for logical errors
 // (references to unknown tables etc.) and creates a LogicalPlan
 // that is internally consistent.
@@ -89,6 +175,8 @@ func Analyze(s parser.SelectStmt, reg udf.FunctionRegistry) (*LogicalPlan, error
 	   >   compatible types.
 	*/
 
+	resolveOrderByAliases(&s)
+
 	if err := makeRelationAliases(&s); err != nil {
 		return nil, err
 	}
@@ -135,47 +223,14 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			groupingMode = true
 		}
 		// compute column name
-		colHeader := fmt.Sprintf("col_%v", i)
-		switch projType := expr.(type) {
-		case parser.RowMeta:
-			if projType.MetaType == parser.TimestampMeta {
-				colHeader = "ts"
-			}
-		case parser.RowValue:
-			// We can only use the column name as an alias if it is not
-			// a complex JSON Path. For example, `SELECT a` will be treated
-			// like `SELECT a AS a`, but for `SELECT a..b` we will have to
-			// use the col_N form.
-			if simpleColumnNameRe.MatchString(projType.Column) {
-				colHeader = projType.Column
-			}
-		case parser.AliasAST:
-			colHeader = projType.Alias
-		case parser.FuncAppAST:
-			colHeader = string(projType.Function)
-		case parser.Wildcard:
-			// The wildcard projection (without AS) is very special in that
-			// it is the only case where the BQL user does not determine
-			// the output key names (implicitly or explicitly). The
-			// Evaluator interface is designed such that Evaluator
-			// has 100% control over the returned value, but 0% control
-			// over how it is named, therefore the wildcard evaluation
-			// requires handling in multiple locations.
-			// As a workaround, we will return the complete Map from
-			// the wildcard Evaluator, nest it under a hard-coded key
-			// called "*" and flatten them later (this is done correctly
-			// by the assignOutputValue function).
-			// Note that if it is desired at some point that there are
-			// more evaluators with that behavior, we should change the
-			// Evaluator.Eval interface.
-			colHeader = "*"
-		}
+		colHeader := projectionColumnName(expr, i)
 		flatProjExprs[i] = aliasedExpression{colHeader, flatExpr, aggrs}
 	}
 
 	if s.Having != nil {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, aggrs, err := ParserExprToMaybeAggregate(s.Having, numAggParams, reg)
+		numAggParams += len(aggrs)
 		if err != nil {
 			return nil, err
 		}
@@ -187,6 +242,26 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		groupingMode = true
 	}
 
+	// the ORDER BY expressions are evaluated like projections
+	// whose values are not part of the output
+	var ordering []bool
+	for i, item := range s.Ordering {
+		// convert the parser Expression to a FlatExpression
+		flatExpr, aggrs, err := ParserExprToMaybeAggregate(item.Expr, numAggParams, reg)
+		numAggParams += len(aggrs)
+		if err != nil {
+			return nil, err
+		}
+		if len(aggrs) > 0 {
+			groupingMode = true
+		}
+		// use a special column name
+		colHeader := fmt.Sprintf(":order:%d", i)
+		flatProjExprs = append(flatProjExprs,
+			aliasedExpression{colHeader, flatExpr, aggrs})
+		ordering = append(ordering, item.Ascending != parser.No)
+	}
+
 	var filterExpr FlatExpression
 	if s.Filter != nil {
 		filterFlatExpr, err := ParserExprToFlatExpr(s.Filter, reg)
@@ -200,6 +275,19 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		filterExpr = filterFlatExpr
 	}
 
+	joinExprs := make([]FlatExpression, len(s.Joins))
+	for i, join := range s.Joins {
+		joinFlatExpr, err := ParserExprToFlatExpr(join.On, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in ON clause")
+			}
+			return nil, err
+		}
+		joinExprs[i] = joinFlatExpr
+	}
+
 	groupCols := make([]rowValue, len(s.GroupList))
 	flatGroupExprs := make([]FlatExpression, len(s.GroupList))
 	for i, expr := range s.GroupList {
@@ -307,6 +395,20 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		}
 	}
 
+	// validate the LIMIT and OFFSET clauses
+	limit := int64(-1)
+	if s.HasLimit {
+		if s.Limit < 0 {
+			return nil, fmt.Errorf("LIMIT clause must not have a "+
+				"negative value, not %d", s.Limit)
+		}
+		limit = s.Limit
+	}
+	if s.Offset < 0 {
+		return nil, fmt.Errorf("OFFSET clause must not have a "+
+			"negative value, not %d", s.Offset)
+	}
+
 	return &LogicalPlan{
 		groupingMode,
 		s.EmitterAST.EmitterType,
@@ -315,12 +417,91 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		emitSamplingType,
 		flatProjExprs,
 		s.WindowedFromAST,
+		joinExprs,
 		filterExpr,
 		flatGroupExprs,
 		s.HavingAST,
+		ordering,
+		limit,
+		s.Offset,
+		nil,
+		nil,
+		nil,
 	}, nil
 }
 
+// projectionColumnName computes the name of the output column of
+// the i-th projection of a statement.
+func projectionColumnName(expr parser.Expression, i int) string {
+	colHeader := fmt.Sprintf("col_%v", i)
+	switch projType := expr.(type) {
+	case parser.RowMeta:
+		if projType.MetaType == parser.TimestampMeta {
+			colHeader = "ts"
+		}
+	case parser.RowValue:
+		// We can only use the column name as an alias if it is not
+		// a complex JSON Path. For example, `SELECT a` will be treated
+		// like `SELECT a AS a`, but for `SELECT a..b` we will have to
+		// use the col_N form.
+		if simpleColumnNameRe.MatchString(projType.Column) {
+			colHeader = projType.Column
+		}
+	case parser.AliasAST:
+		colHeader = projType.Alias
+	case parser.FuncAppAST:
+		colHeader = string(projType.Function)
+	case parser.Wildcard:
+		// The wildcard projection (without AS) is very special in that
+		// it is the only case where the BQL user does not determine
+		// the output key names (implicitly or explicitly). The
+		// Evaluator interface is designed such that Evaluator
+		// has 100% control over the returned value, but 0% control
+		// over how it is named, therefore the wildcard evaluation
+		// requires handling in multiple locations.
+		// As a workaround, we will return the complete Map from
+		// the wildcard Evaluator, nest it under a hard-coded key
+		// called "*" and flatten them later (this is done correctly
+		// by the assignOutputValue function).
+		// Note that if it is desired at some point that there are
+		// more evaluators with that behavior, we should change the
+		// Evaluator.Eval interface.
+		colHeader = "*"
+	}
+	return colHeader
+}
+
+// resolveOrderByAliases replaces every ORDER BY expression that is
+// a plain column name matching the name of an output column (as in
+// `SELECT a + 1 AS x ... ORDER BY x`) by the expression computing
+// that column. All other ORDER BY expressions refer to the input
+// rows in the same way as projections do.
+func resolveOrderByAliases(s *parser.SelectStmt) {
+	if len(s.Ordering) == 0 {
+		return
+	}
+	// do not modify the caller's statement
+	ordering := make([]parser.SortedExpressionAST, len(s.Ordering))
+	for i, item := range s.Ordering {
+		ordering[i] = item
+		rv, ok := item.Expr.(parser.RowValue)
+		if !ok || rv.Relation != "" {
+			continue
+		}
+		for j, proj := range s.Projections {
+			if projectionColumnName(proj, j) != rv.Column {
+				continue
+			}
+			if alias, ok := proj.(parser.AliasAST); ok {
+				proj = alias.Expr
+			}
+			ordering[i].Expr = proj
+			break
+		}
+	}
+	s.Ordering = ordering
+}
+
 // makeRelationAliases will assign an internal alias to every relation
 // does not yet have one (given by the user). It will also detect if
 // there is a conflict between aliases.
@@ -346,8 +527,8 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 }
 
 // validateReferences checks if the references to input relations
-// in SELECT, WHERE, GROUP BY and HAVING clauses of the given
-// statement are matching the relations mentioned in the FROM
+// in SELECT, WHERE, GROUP BY, HAVING and ORDER BY clauses of the
+// given statement are matching the relations mentioned in the FROM
 // clause.
 func validateReferences(s *parser.SelectStmt) error {
 
@@ -373,6 +554,11 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for _, join := range s.Joins {
+		for rel := range join.On.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
 	for _, group := range s.GroupList {
 		for rel := range group.ReferencedRelations() {
 			refRels[rel] = true
@@ -383,6 +569,11 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for _, item := range s.Ordering {
+		for rel := range item.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
@@ -422,6 +613,11 @@ func validateReferences(s *parser.SelectStmt) error {
 			if s.Having != nil {
 				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
+			newOrdering := make([]parser.SortedExpressionAST, len(s.Ordering))
+			for i, item := range s.Ordering {
+				newOrdering[i] = item.RenameReferencedRelation("", inputRel).(parser.SortedExpressionAST)
+			}
+			s.Ordering = newOrdering
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
@@ -464,45 +660,141 @@ func validateReferences(s *parser.SelectStmt) error {
 		// FROM clause -> OK
 	}
 
+	// an ON clause may only refer to the joined relation and
+	// the relations that appear before it
+	if len(s.Joins) > 0 {
+		if len(s.Joins) != len(s.Relations)-1 {
+			return fmt.Errorf("%d relations cannot be combined using %d JOIN clauses",
+				len(s.Relations), len(s.Joins))
+		}
+		for i, join := range s.Joins {
+			joinedRel := s.Relations[i+1].Alias
+			for rel := range join.On.ReferencedRelations() {
+				visible := false
+				for _, inputRel := range s.Relations[:i+2] {
+					if rel == inputRel.Alias {
+						visible = true
+						break
+					}
+				}
+				if !visible {
+					err := fmt.Errorf("cannot reference relation '%s' "+
+						"in the ON clause of '%s'", rel, joinedRel)
+					return err
+				}
+			}
+		}
+	}
+
 	for _, rel := range s.Relations {
-		if rel.Value <= 0 {
-			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
+		window := rel.Window.String()
+		if err := validateWindowInterval(window, rel.IntervalAST); err != nil {
 			return err
 		}
-		if rel.Unit == parser.Tuples && math.Trunc(rel.Value) != rel.Value {
-			// actually the parser should not allow fractional numbers,
-			// but we check anyway
-			err := fmt.Errorf("number in RANGE clause must be integral "+
-				"for TUPLES, not %v", rel.Value)
-			return err
+		if rel.Window == parser.SessionWindow && rel.Unit == parser.Tuples {
+			return fmt.Errorf("SESSION GAP must be given in SECONDS or MILLISECONDS")
 		}
-		switch rel.Unit {
-		case parser.Tuples:
-			if rel.Value > MaxRangeTuples {
-				err := fmt.Errorf("RANGE value %d is too large for TUPLES (must be at most %d)",
-					int64(rel.Value), int64(MaxRangeTuples))
-				return err
+		if rel.Window != parser.HoppingWindow {
+			if rel.Slide.Unit != parser.UnspecifiedIntervalUnit {
+				return fmt.Errorf("SLIDE can only be used with HOPPING windows")
 			}
-		case parser.Seconds:
-			if rel.Value > MaxRangeSec {
-				err := fmt.Errorf("RANGE value %v is too large for SECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeSec))
+		} else {
+			if rel.Slide.Unit == parser.UnspecifiedIntervalUnit {
+				return fmt.Errorf("HOPPING windows require a SLIDE")
+			}
+			if (rel.Slide.Unit == parser.Tuples) != (rel.Unit == parser.Tuples) {
+				return fmt.Errorf("SLIDE must be time-based for time-based " +
+					"windows and count-based for count-based windows")
+			}
+			if err := validateWindowInterval("SLIDE", rel.Slide); err != nil {
 				return err
 			}
-		case parser.Milliseconds:
-			if rel.Value > MaxRangeMillisec {
-				err := fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeMillisec))
+			size, slide := rel.Value, rel.Slide.Value
+			if rel.Unit != parser.Tuples {
+				size = float64(intervalNanoseconds(rel.IntervalAST))
+				slide = float64(intervalNanoseconds(rel.Slide))
+			}
+			if slide > size {
+				return fmt.Errorf("SLIDE of a HOPPING window must not be " +
+					"larger than the window size")
+			}
+		}
+		if rel.Lateness.Unit != parser.UnspecifiedIntervalUnit {
+			if rel.Lateness.Unit == parser.Tuples {
+				return fmt.Errorf("LATENESS must be given in SECONDS or MILLISECONDS")
+			}
+			if err := validateWindowInterval("LATENESS", rel.Lateness.IntervalAST); err != nil {
 				return err
 			}
 		}
+		// tuples of a stream are reordered before they are added
+		// to the buffers of the relations reading from it, so all
+		// of these relations must agree on the LATENESS
+		for _, other := range s.Relations {
+			if rel.Type == parser.ActualStream && other.Type == parser.ActualStream &&
+				rel.Name == other.Name && rel.Lateness != other.Lateness {
+				return fmt.Errorf("all relations reading from stream '%s' "+
+					"must use the same LATENESS", rel.Name)
+			}
+		}
+		if rel.Window != parser.RangeWindow && len(s.Relations) > 1 {
+			return fmt.Errorf("%s windows can only be used with a single relation",
+				window)
+		}
 	}
 
 	return nil
 }
 
-// LogicalOptimize does nothing at the moment. In the future, logical
-// optimizations (evaluation of foldable terms etc.) can be added here.
+// validateWindowInterval checks that the size of an interval is in
+// the allowed range for its unit. `clause` is used in error messages.
+func validateWindowInterval(clause string, i parser.IntervalAST) error {
+	if i.Value <= 0 {
+		err := fmt.Errorf("number in %s clause must be positive, not %v", clause, i.Value)
+		return err
+	}
+	if i.Unit == parser.Tuples && math.Trunc(i.Value) != i.Value {
+		// actually the parser should not allow fractional numbers,
+		// but we check anyway
+		err := fmt.Errorf("number in %s clause must be integral "+
+			"for TUPLES, not %v", clause, i.Value)
+		return err
+	}
+	switch i.Unit {
+	case parser.Tuples:
+		if i.Value > MaxRangeTuples {
+			err := fmt.Errorf("%s value %d is too large for TUPLES (must be at most %d)",
+				clause, int64(i.Value), int64(MaxRangeTuples))
+			return err
+		}
+	case parser.Seconds:
+		if i.Value > MaxRangeSec {
+			err := fmt.Errorf("%s value %v is too large for SECONDS (must be at most %d)",
+				clause, i.Value, int64(MaxRangeSec))
+			return err
+		}
+	case parser.Milliseconds:
+		if i.Value > MaxRangeMillisec {
+			err := fmt.Errorf("%s value %v is too large for MILLISECONDS (must be at most %d)",
+				clause, i.Value, int64(MaxRangeMillisec))
+			return err
+		}
+	}
+	return nil
+}
+
+// LogicalOptimize applies logical optimizations to the plan. At the
+// moment, these are
+//
+//	* constant folding: subexpressions without columns or function
+//	  calls such as `2 * 60` are replaced by their value
+//	* predicate pushdown: immutable conjuncts of the WHERE clause that
+//	  refer to a single relation with a time-based RANGE window are
+//	  evaluated before a tuple is added to the window buffer
+//	* projection pruning: keys of input tuples that are not used by
+//	  the statement are removed before a tuple is buffered
+//
+// The results of the statement are not changed by these optimizations.
 func (lp *LogicalPlan) LogicalOptimize() (*LogicalPlan, error) {
 	/*
 	   In Spark, this does the following:
@@ -511,6 +803,9 @@ func (lp *LogicalPlan) LogicalOptimize() (*LogicalPlan, error) {
 	   > pruning, null propagation, Boolean expression simplification,
 	   > and other rules.
 	*/
+	lp.foldConstants()
+	lp.pushDownPredicates()
+	lp.pruneColumns()
 	return lp, nil
 }
 
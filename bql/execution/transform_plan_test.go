@@ -47,13 +47,13 @@ func TestRelationChecker(t *testing.T) {
 	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
 	singleFrom := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait}, ""},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+		}, nil,
 	}
 	singleFromAlias := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait}, "t"},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "t"},
+		}, nil,
 	}
 	two := parser.NumericLiteral{2}
 	a := parser.RowValue{"", "a"}
@@ -533,52 +533,52 @@ func TestRelationAliasing(t *testing.T) {
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+				}, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b         -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "b"},
+				}, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, a      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+				}, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, c AS a -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "a"},
+				}, nil},
 		}, ""},
 		// SELECT 2 FROM a, a           -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+				}, nil},
 		}, "cannot use relations"},
 		// SELECT 2 FROM a, b AS a      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil, nil}, parser.RangeWindow, r, parser.IntervalAST{}, parser.LatenessAST{}, 0, parser.Wait}, "a"},
+				}, nil},
 		}, "cannot use relations"},
 	}
 
@@ -928,6 +928,46 @@ func TestRangeChecker(t *testing.T) {
 		{"a FROM x [RANGE 86400000 MILLISECONDS]", ""},
 		{"a FROM x [RANGE 86400000.01 MILLISECONDS]",
 			"RANGE value 8.640000001e+07 is too large for MILLISECONDS (must be at most 86400000)"},
+		// TUMBLING
+		{"a FROM x [TUMBLING 10 TUPLES]", ""},
+		{"a FROM x [TUMBLING 1 SECONDS]", ""},
+		{"a FROM x [TUMBLING 86400.01 SECONDS]",
+			"TUMBLING value 86400.01 is too large for SECONDS (must be at most 86400)"},
+		{"a FROM x [TUMBLING 10 SECONDS, SLIDE 1 SECONDS]",
+			"SLIDE can only be used with HOPPING windows"},
+		{"a FROM x [RANGE 10 SECONDS, SLIDE 1 SECONDS]",
+			"SLIDE can only be used with HOPPING windows"},
+		{"x:a FROM x [TUMBLING 1 SECONDS], y [RANGE 1 SECONDS]",
+			"TUMBLING windows can only be used with a single relation"},
+		// HOPPING
+		{"a FROM x [HOPPING 10 TUPLES, SLIDE 2 TUPLES]", ""},
+		{"a FROM x [HOPPING 10 SECONDS, SLIDE 500 MILLISECONDS]", ""},
+		{"a FROM x [HOPPING 1 SECONDS, SLIDE 1000 MILLISECONDS]", ""},
+		{"a FROM x [HOPPING 10 SECONDS]",
+			"HOPPING windows require a SLIDE"},
+		{"a FROM x [HOPPING 10 SECONDS, SLIDE 2 TUPLES]",
+			"SLIDE must be time-based for time-based windows"},
+		{"a FROM x [HOPPING 10 TUPLES, SLIDE 1048576 TUPLES]",
+			"SLIDE value 1048576 is too large for TUPLES (must be at most 1048575)"},
+		{"a FROM x [HOPPING 1 SECONDS, SLIDE 1001 MILLISECONDS]",
+			"SLIDE of a HOPPING window must not be larger than the window size"},
+		{"x:a FROM x [HOPPING 2 TUPLES, SLIDE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON x:a = y:a",
+			"HOPPING windows can only be used with a single relation"},
+		// SESSION GAP
+		{"a FROM x [SESSION GAP 30 SECONDS]", ""},
+		{"a FROM x [SESSION GAP 30 TUPLES]",
+			"SESSION GAP must be given in SECONDS or MILLISECONDS"},
+		{"x:a FROM x [SESSION GAP 1 SECONDS], y [RANGE 1 SECONDS]",
+			"SESSION GAP windows can only be used with a single relation"},
+		// LATENESS
+		{"a FROM x [RANGE 10 TUPLES, LATENESS 2 SECONDS]", ""},
+		{"a FROM x [TUMBLING 10 SECONDS, LATENESS 200 MILLISECONDS REPORT LATE]", ""},
+		{"a FROM x [RANGE 10 SECONDS, LATENESS 2 TUPLES]",
+			"LATENESS must be given in SECONDS or MILLISECONDS"},
+		{"a FROM x [RANGE 10 SECONDS, LATENESS 86401 SECONDS]",
+			"LATENESS value 86401 is too large for SECONDS (must be at most 86400)"},
+		{"p:a FROM x [RANGE 1 SECONDS, LATENESS 1 SECONDS] AS p JOIN x [RANGE 1 SECONDS] AS q ON p:a = q:a",
+			"all relations reading from stream 'x' must use the same LATENESS"},
 	}
 
 	for _, testCase := range testCases {
@@ -959,6 +999,134 @@ func TestRangeChecker(t *testing.T) {
 	}
 }
 
+func TestJoinChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON x:a = y:a", ""},
+		{"x:a FROM x [RANGE 1 TUPLES] LEFT JOIN y [RANGE 1 TUPLES] ON x:a = y:a " +
+			"JOIN z [RANGE 1 TUPLES] ON x:a = z:a AND y:b = z:b", ""},
+		{"p:a FROM x [RANGE 1 TUPLES] AS p JOIN x [RANGE 1 TUPLES] AS q ON p:a = q:b", ""},
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON a = y:a",
+			"cannot reference relation ''"},
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON x:a = w:a",
+			"cannot reference relation 'w'"},
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON x:a = z:a " +
+			"JOIN z [RANGE 1 TUPLES] ON x:a = z:a",
+			"cannot reference relation 'z' in the ON clause of 'y'"},
+		{"x:a FROM x [RANGE 1 TUPLES] JOIN y [RANGE 1 TUPLES] ON count(x:a) = 1",
+			"aggregates not allowed in ON clause"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				lp, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(len(lp.JoinConditions), ShouldEqual, len(lp.Joins))
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestOrderByChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		ordering      []FlatExpression
+		ascending     []bool
+		limit         int64
+		offset        int64
+	}{
+		{"a FROM x [RANGE 1 TUPLES]", "", nil, nil, -1, 0},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY b DESC, a", "",
+			[]FlatExpression{rowValue{"x", "b"}, rowValue{"x", "a"}},
+			[]bool{false, true}, -1, 0},
+		// output column names refer to the projected expression
+		{"a + 1 AS b FROM x [RANGE 1 TUPLES] ORDER BY b", "",
+			[]FlatExpression{binaryOpAST{parser.Plus, rowValue{"x", "a"}, numericLiteral{1}}},
+			[]bool{true}, -1, 0},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY a ASC LIMIT 3 OFFSET 2", "",
+			[]FlatExpression{rowValue{"x", "a"}},
+			[]bool{true}, 3, 2},
+		{"a FROM x [RANGE 1 TUPLES] LIMIT 0", "", nil, nil, 0, 0},
+		{"a, count(b) AS c FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY c DESC", "",
+			[]FlatExpression{funcAppAST{"count", []FlatExpression{aggInputRef{"g_77d2dd39"}}}},
+			[]bool{false}, -1, 0},
+		{"a FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY b",
+			"column \"x:b\" must appear in the GROUP BY clause or be used in an aggregate function",
+			nil, nil, 0, 0},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY y:a",
+			"cannot refer to relations", nil, nil, 0, 0},
+		{"a FROM x [RANGE 1 TUPLES] LIMIT -1",
+			"LIMIT clause must not have a negative value", nil, nil, 0, 0},
+		{"a FROM x [RANGE 1 TUPLES] OFFSET -1",
+			"OFFSET clause must not have a negative value", nil, nil, 0, 0},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				lp, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(lp.Ordering, ShouldResemble, testCase.ascending)
+						So(lp.Limit, ShouldEqual, testCase.limit)
+						So(lp.Offset, ShouldEqual, testCase.offset)
+						numProjs := len(ast.Projections)
+						So(len(lp.Projections), ShouldEqual, numProjs+len(testCase.ordering))
+						for i, expr := range testCase.ordering {
+							proj := lp.Projections[numProjs+i]
+							So(proj.alias, ShouldEqual, fmt.Sprintf(":order:%d", i))
+							So(proj.expr, ShouldResemble, expr)
+						}
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
 func TestVolatileAggregateChecker(t *testing.T) {
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 
@@ -0,0 +1,559 @@
+package execution
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"regexp"
+)
+
+/*
+The generic Evaluators in evaluators.go box every intermediate result
+into a data.Value and check its type on every call because the type of
+a column is only known when a row is evaluated. However, the types of
+some expressions, such as literals, type casts, and arithmetic
+operations on them, are known when the Evaluator is built. For those
+expressions, the functions in this file build specialized Evaluators
+that pass int64 and float64 values between each other without boxing
+them and without checking their types again. For example,
+`x::int * 2 + 1 > 10` is evaluated by an intComparison whose operands
+are an intArithOp and an intConstant, and only the result of the
+comparison is boxed.
+*/
+
+var (
+	identifierKeyRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*`)
+
+	errIntegerDivideByZero = errors.New("runtime error: integer divide by zero")
+)
+
+// staticType is the type of the values an expression evaluates to as
+// far as it is known before the expression is evaluated. A known type
+// other than nullType means that the expression evaluates either to
+// a value of that type or to NULL (unless the evaluation fails).
+type staticType int
+
+const (
+	unknownType staticType = iota
+	nullType
+	boolType
+	intType
+	floatType
+	stringType
+	timestampType
+)
+
+func (t staticType) isNumeric() bool {
+	return t == intType || t == floatType
+}
+
+// inferType computes the staticType of the given expression. Columns
+// and function calls have an unknown type because their values are
+// only known at runtime.
+func inferType(e FlatExpression) staticType {
+	switch e := e.(type) {
+	case nullLiteral:
+		return nullType
+	case numericLiteral:
+		return intType
+	case floatLiteral:
+		return floatType
+	case boolLiteral:
+		return boolType
+	case stringLiteral:
+		return stringType
+	case rowMeta:
+		if e.MetaType == parser.TimestampMeta {
+			return timestampType
+		}
+	case stmtMeta:
+		if e.MetaType == parser.NowMeta {
+			return timestampType
+		}
+	case missing:
+		return boolType
+	case typeCastAST:
+		switch e.Target {
+		case parser.Bool:
+			return boolType
+		case parser.Int:
+			return intType
+		case parser.Float:
+			return floatType
+		case parser.String:
+			return stringType
+		case parser.Timestamp:
+			return timestampType
+		}
+	case unaryOpAST:
+		switch e.Op {
+		case parser.Not:
+			return boolType
+		case parser.UnaryMinus:
+			return arithmeticType(inferType(e.Expr), intType)
+		}
+	case binaryOpAST:
+		switch e.Op {
+		case parser.Or, parser.And, parser.Equal, parser.NotEqual,
+			parser.Less, parser.LessOrEqual, parser.Greater,
+			parser.GreaterOrEqual, parser.Is, parser.IsNot, parser.Like,
+			parser.NotLike, parser.ILike, parser.NotILike, parser.SimilarTo,
+			parser.NotSimilarTo:
+			return boolType
+		case parser.Concat:
+			return stringType
+		case parser.Plus, parser.Minus, parser.Multiply, parser.Divide,
+			parser.Modulo:
+			return arithmeticType(inferType(e.Left), inferType(e.Right))
+		}
+	}
+	return unknownType
+}
+
+// arithmeticType returns the type of the result of an arithmetic
+// operation on operands of the given types.
+func arithmeticType(l, r staticType) staticType {
+	if l == intType && r == intType {
+		return intType
+	}
+	if l.isNumeric() && r.isNumeric() {
+		return floatType
+	}
+	return unknownType
+}
+
+// intEvaluator is implemented by Evaluators whose result is known to
+// be an Int or NULL. evalInt returns the result without boxing it; the
+// second return value is true if the result is NULL.
+type intEvaluator interface {
+	Evaluator
+	evalInt(input data.Value) (int64, bool, error)
+}
+
+// floatEvaluator is the counterpart of intEvaluator for Float results.
+type floatEvaluator interface {
+	Evaluator
+	evalFloat(input data.Value) (float64, bool, error)
+}
+
+func boxInt(v int64, null bool, err error) (data.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	if null {
+		return data.Null{}, nil
+	}
+	return data.Int(v), nil
+}
+
+func boxFloat(v float64, null bool, err error) (data.Value, error) {
+	if err != nil {
+		return nil, err
+	}
+	if null {
+		return data.Null{}, nil
+	}
+	return data.Float(v), nil
+}
+
+func (i *intConstant) evalInt(input data.Value) (int64, bool, error) {
+	return i.value, false, nil
+}
+
+func (f *floatConstant) evalFloat(input data.Value) (float64, bool, error) {
+	return f.value, false, nil
+}
+
+// asIntEvaluator returns an intEvaluator for an Evaluator whose
+// staticType is intType.
+func asIntEvaluator(e Evaluator) intEvaluator {
+	if ie, ok := e.(intEvaluator); ok {
+		return ie
+	}
+	return &intResult{e}
+}
+
+// asFloatEvaluator returns a floatEvaluator for an Evaluator whose
+// staticType is t, which must be either intType or floatType. An Int
+// result is converted to a Float in the same way as the generic
+// numeric operators do.
+func asFloatEvaluator(e Evaluator, t staticType) floatEvaluator {
+	if t == intType {
+		return &intToFloat{asIntEvaluator(e)}
+	}
+	if fe, ok := e.(floatEvaluator); ok {
+		return fe
+	}
+	return &floatResult{e}
+}
+
+// intResult adapts an Evaluator that returns Int values, but doesn't
+// implement intEvaluator itself.
+type intResult struct {
+	underlying Evaluator
+}
+
+func (i *intResult) Eval(input data.Value) (data.Value, error) {
+	return i.underlying.Eval(input)
+}
+
+func (i *intResult) evalInt(input data.Value) (int64, bool, error) {
+	v, err := i.underlying.Eval(input)
+	if err != nil {
+		return 0, false, err
+	}
+	switch v := v.(type) {
+	case data.Int:
+		return int64(v), false, nil
+	case data.Null:
+		return 0, true, nil
+	}
+	return 0, false, fmt.Errorf("expected an int, not %T", v)
+}
+
+// floatResult adapts an Evaluator that returns Float values, but
+// doesn't implement floatEvaluator itself.
+type floatResult struct {
+	underlying Evaluator
+}
+
+func (f *floatResult) Eval(input data.Value) (data.Value, error) {
+	return f.underlying.Eval(input)
+}
+
+func (f *floatResult) evalFloat(input data.Value) (float64, bool, error) {
+	v, err := f.underlying.Eval(input)
+	if err != nil {
+		return 0, false, err
+	}
+	switch v := v.(type) {
+	case data.Float:
+		return float64(v), false, nil
+	case data.Int:
+		return float64(v), false, nil
+	case data.Null:
+		return 0, true, nil
+	}
+	return 0, false, fmt.Errorf("expected a float, not %T", v)
+}
+
+// intToFloat converts the result of an intEvaluator to a Float.
+type intToFloat struct {
+	underlying intEvaluator
+}
+
+func (i *intToFloat) Eval(input data.Value) (data.Value, error) {
+	return boxFloat(i.evalFloat(input))
+}
+
+func (i *intToFloat) evalFloat(input data.Value) (float64, bool, error) {
+	v, null, err := i.underlying.evalInt(input)
+	return float64(v), null, err
+}
+
+// intCast is the typeCast to INT.
+type intCast struct {
+	underlying Evaluator
+}
+
+func (c *intCast) Eval(input data.Value) (data.Value, error) {
+	return boxInt(c.evalInt(input))
+}
+
+func (c *intCast) evalInt(input data.Value) (int64, bool, error) {
+	v, err := c.underlying.Eval(input)
+	if err != nil {
+		return 0, false, err
+	}
+	// null propagation
+	if v.Type() == data.TypeNull {
+		return 0, true, nil
+	}
+	x, err := data.ToInt(v)
+	return x, false, err
+}
+
+// floatCast is the typeCast to FLOAT.
+type floatCast struct {
+	underlying Evaluator
+}
+
+func (c *floatCast) Eval(input data.Value) (data.Value, error) {
+	return boxFloat(c.evalFloat(input))
+}
+
+func (c *floatCast) evalFloat(input data.Value) (float64, bool, error) {
+	v, err := c.underlying.Eval(input)
+	if err != nil {
+		return 0, false, err
+	}
+	// null propagation
+	if v.Type() == data.TypeNull {
+		return 0, true, nil
+	}
+	x, err := data.ToFloat(v)
+	return x, false, err
+}
+
+// newTypedBinOp returns an Evaluator for a binary operation that is
+// specialized for operands of the given types, or nil if there is no
+// such Evaluator. The specialized Evaluators return the same results
+// (and errors) as the generic ones.
+func newTypedBinOp(op parser.Operator, left, right Evaluator, lt, rt staticType) Evaluator {
+	if lt == intType && rt == intType {
+		l, r := asIntEvaluator(left), asIntEvaluator(right)
+		switch op {
+		case parser.Plus, parser.Minus, parser.Multiply, parser.Divide, parser.Modulo:
+			return &intArithOp{op, l, r}
+		case parser.Equal, parser.NotEqual, parser.Less, parser.LessOrEqual,
+			parser.Greater, parser.GreaterOrEqual:
+			return &intComparison{op, l, r}
+		}
+	} else if lt.isNumeric() && rt.isNumeric() {
+		l, r := asFloatEvaluator(left, lt), asFloatEvaluator(right, rt)
+		switch op {
+		case parser.Plus, parser.Minus, parser.Multiply, parser.Divide, parser.Modulo:
+			return &floatArithOp{op, l, r}
+		case parser.Equal, parser.NotEqual, parser.Less, parser.LessOrEqual,
+			parser.Greater, parser.GreaterOrEqual:
+			return &floatComparison{op, l, r}
+		}
+	}
+	return nil
+}
+
+// intArithOp is a numBinOp on two Int operands.
+type intArithOp struct {
+	op    parser.Operator
+	left  intEvaluator
+	right intEvaluator
+}
+
+func (a *intArithOp) Eval(input data.Value) (data.Value, error) {
+	return boxInt(a.evalInt(input))
+}
+
+func (a *intArithOp) evalInt(input data.Value) (int64, bool, error) {
+	l, lnull, err := a.left.evalInt(input)
+	if err != nil {
+		return 0, false, err
+	}
+	r, rnull, err := a.right.evalInt(input)
+	if err != nil {
+		return 0, false, err
+	}
+	// NULL propagation
+	if lnull || rnull {
+		return 0, true, nil
+	}
+	// we do not check for overflows
+	switch a.op {
+	case parser.Plus:
+		return l + r, false, nil
+	case parser.Minus:
+		return l - r, false, nil
+	case parser.Multiply:
+		return l * r, false, nil
+	case parser.Divide:
+		if r == 0 {
+			return 0, false, errIntegerDivideByZero
+		}
+		return l / r, false, nil
+	case parser.Modulo:
+		if r == 0 {
+			return 0, false, errIntegerDivideByZero
+		}
+		return l % r, false, nil
+	}
+	return 0, false, fmt.Errorf("don't know how to evaluate binary operation %v", a.op)
+}
+
+// floatArithOp is a numBinOp on two operands at least one of which
+// is a Float.
+type floatArithOp struct {
+	op    parser.Operator
+	left  floatEvaluator
+	right floatEvaluator
+}
+
+func (a *floatArithOp) Eval(input data.Value) (data.Value, error) {
+	return boxFloat(a.evalFloat(input))
+}
+
+func (a *floatArithOp) evalFloat(input data.Value) (float64, bool, error) {
+	l, lnull, err := a.left.evalFloat(input)
+	if err != nil {
+		return 0, false, err
+	}
+	r, rnull, err := a.right.evalFloat(input)
+	if err != nil {
+		return 0, false, err
+	}
+	// NULL propagation
+	if lnull || rnull {
+		return 0, true, nil
+	}
+	switch a.op {
+	case parser.Plus:
+		return l + r, false, nil
+	case parser.Minus:
+		return l - r, false, nil
+	case parser.Multiply:
+		return l * r, false, nil
+	case parser.Divide:
+		return l / r, false, nil
+	case parser.Modulo:
+		return math.Mod(l, r), false, nil
+	}
+	return 0, false, fmt.Errorf("don't know how to evaluate binary operation %v", a.op)
+}
+
+// intComparison compares two Int operands.
+type intComparison struct {
+	op    parser.Operator
+	left  intEvaluator
+	right intEvaluator
+}
+
+func (c *intComparison) Eval(input data.Value) (data.Value, error) {
+	l, lnull, err := c.left.evalInt(input)
+	if err != nil {
+		return nil, err
+	}
+	r, rnull, err := c.right.evalInt(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if lnull || rnull {
+		return data.Null{}, nil
+	}
+	switch c.op {
+	case parser.Equal:
+		return data.Bool(l == r), nil
+	case parser.NotEqual:
+		return data.Bool(l != r), nil
+	case parser.Less:
+		return data.Bool(l < r), nil
+	case parser.LessOrEqual:
+		return data.Bool(l <= r), nil
+	case parser.Greater:
+		return data.Bool(l > r), nil
+	case parser.GreaterOrEqual:
+		return data.Bool(l >= r), nil
+	}
+	return nil, fmt.Errorf("don't know how to evaluate binary operation %v", c.op)
+}
+
+// floatComparison compares two operands at least one of which is a
+// Float.
+type floatComparison struct {
+	op    parser.Operator
+	left  floatEvaluator
+	right floatEvaluator
+}
+
+func (c *floatComparison) Eval(input data.Value) (data.Value, error) {
+	l, lnull, err := c.left.evalFloat(input)
+	if err != nil {
+		return nil, err
+	}
+	r, rnull, err := c.right.evalFloat(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if lnull || rnull {
+		return data.Null{}, nil
+	}
+	// the generic operators are defined in terms of `<` and `=`,
+	// which makes a difference when one of the operands is NaN
+	switch c.op {
+	case parser.Equal:
+		return data.Bool(l == r), nil
+	case parser.NotEqual:
+		return data.Bool(!(l == r)), nil
+	case parser.Less:
+		return data.Bool(l < r), nil
+	case parser.LessOrEqual:
+		return data.Bool(l < r || l == r), nil
+	case parser.Greater:
+		return data.Bool(!(l < r || l == r)), nil
+	case parser.GreaterOrEqual:
+		return data.Bool(!(l < r)), nil
+	}
+	return nil, fmt.Errorf("don't know how to evaluate binary operation %v", c.op)
+}
+
+// mapKeyAccess is a pathAccess for JSON Paths that only consist of map
+// keys such as `s.a` or `s["a b"]`, which is the case for most columns.
+// It looks up the keys directly instead of interpreting the path.
+type mapKeyAccess struct {
+	keys []string
+}
+
+func (m *mapKeyAccess) Eval(input data.Value) (data.Value, error) {
+	cur, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	last := len(m.keys) - 1
+	for i, k := range m.keys {
+		v, ok := cur[k]
+		if !ok {
+			return nil, fmt.Errorf("key '%s' was not found in map", k)
+		}
+		if i == last {
+			return v, nil
+		}
+		cur, err = data.AsMap(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return cur, nil
+}
+
+// newColumnAccess returns an Evaluator that reads the value at the given
+// JSON Path from the input row.
+func newColumnAccess(s string) (Evaluator, error) {
+	pa, err := newPathAccess(s)
+	if err != nil {
+		return nil, err
+	}
+	if keys, ok := splitMapKeyPath(s); ok {
+		return &mapKeyAccess{keys}, nil
+	}
+	return pa, nil
+}
+
+// splitMapKeyPath returns the keys of a JSON Path that only consists
+// of map keys. The second return value is false if the path contains
+// array accesses, slices, or recursive descents.
+func splitMapKeyPath(path string) ([]string, bool) {
+	var keys []string
+	for len(path) > 0 {
+		if len(keys) == 0 || path[0] == '.' {
+			if len(keys) > 0 {
+				path = path[1:]
+			}
+			if key := identifierKeyRe.FindString(path); key != "" {
+				keys = append(keys, key)
+				path = path[len(key):]
+				continue
+			}
+			if len(keys) > 0 {
+				return nil, false
+			}
+		}
+		key, n := quotedMapKey(path)
+		if n == 0 {
+			return nil, false
+		}
+		keys = append(keys, key)
+		path = path[n:]
+	}
+	return keys, len(keys) > 0
+}
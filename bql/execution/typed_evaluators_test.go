@@ -0,0 +1,295 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestInferType(t *testing.T) {
+	a := rowValue{"", "a"}
+	testCases := []struct {
+		expr     FlatExpression
+		expected staticType
+	}{
+		{nullLiteral{}, nullType},
+		{numericLiteral{1}, intType},
+		{floatLiteral{1.5}, floatType},
+		{stringLiteral{"a"}, stringType},
+		{a, unknownType},
+		{funcAppAST{"f", []FlatExpression{numericLiteral{1}}}, unknownType},
+		{typeCastAST{a, parser.Int}, intType},
+		{typeCastAST{a, parser.Float}, floatType},
+		{typeCastAST{a, parser.Blob}, unknownType},
+		{binaryOpAST{parser.Plus, typeCastAST{a, parser.Int}, numericLiteral{1}}, intType},
+		{binaryOpAST{parser.Divide, numericLiteral{1}, floatLiteral{2}}, floatType},
+		{binaryOpAST{parser.Plus, a, numericLiteral{1}}, unknownType},
+		{binaryOpAST{parser.Plus, nullLiteral{}, numericLiteral{1}}, unknownType},
+		{binaryOpAST{parser.Less, a, numericLiteral{1}}, boolType},
+		{binaryOpAST{parser.Concat, a, stringLiteral{"a"}}, stringType},
+		{unaryOpAST{parser.UnaryMinus, floatLiteral{1}}, floatType},
+		{unaryOpAST{parser.UnaryMinus, a}, unknownType},
+		{unaryOpAST{parser.Not, a}, boolType},
+		{rowMeta{"", parser.TimestampMeta}, timestampType},
+	}
+
+	Convey("Given expressions", t, func() {
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("Then the type of %s should be inferred", tc.expr.Repr()), func() {
+				So(inferType(tc.expr), ShouldEqual, tc.expected)
+			})
+		}
+	})
+}
+
+// genericBinOp returns the Evaluator ExpressionToEvaluator would return
+// for the operator if the types of the operands were unknown.
+func genericBinOp(op parser.Operator, bo binOp) Evaluator {
+	switch op {
+	case parser.Plus:
+		return newPlus(bo)
+	case parser.Minus:
+		return newMinus(bo)
+	case parser.Multiply:
+		return newMultiply(bo)
+	case parser.Divide:
+		return newDivide(bo)
+	case parser.Modulo:
+		return newModulo(bo)
+	case parser.Equal:
+		return newEqual(bo)
+	case parser.NotEqual:
+		return newNotEqual(bo)
+	case parser.Less:
+		return newLess(bo)
+	case parser.LessOrEqual:
+		return newLessOrEqual(bo)
+	case parser.Greater:
+		return newGreater(bo)
+	case parser.GreaterOrEqual:
+		return newGreaterOrnewEqual(bo)
+	}
+	panic(fmt.Sprintf("unknown operator %v", op))
+}
+
+func TestTypedBinOp(t *testing.T) {
+	ops := []parser.Operator{parser.Plus, parser.Minus, parser.Multiply,
+		parser.Divide, parser.Modulo, parser.Equal, parser.NotEqual,
+		parser.Less, parser.LessOrEqual, parser.Greater, parser.GreaterOrEqual}
+	operands := []struct {
+		target parser.Type
+		value  data.Value
+	}{
+		{parser.Int, data.Int(7)},
+		{parser.Int, data.Int(-2)},
+		{parser.Int, data.Int(0)},
+		{parser.Int, data.Null{}},
+		{parser.Float, data.Float(2.5)},
+		{parser.Float, data.Float(0)},
+		{parser.Float, data.Float(math.NaN())},
+		{parser.Float, data.Null{}},
+	}
+	l := rowValue{"", "l"}
+	r := rowValue{"", "r"}
+
+	Convey("Given binary operations on operands of known types", t, func() {
+		generic := func(op parser.Operator) Evaluator {
+			lEval, err := newPathAccess("l")
+			So(err, ShouldBeNil)
+			rEval, err := newPathAccess("r")
+			So(err, ShouldBeNil)
+			return genericBinOp(op, binOp{lEval, rEval})
+		}
+
+		for _, op := range ops {
+			op := op
+			Convey(fmt.Sprintf("When evaluating %v with a specialized Evaluator", op), func() {
+				Convey("Then the results should be the same as the generic ones", func() {
+					for _, lo := range operands {
+						for _, ro := range operands {
+							expr := binaryOpAST{op, typeCastAST{l, lo.target}, typeCastAST{r, ro.target}}
+							eval, err := ExpressionToEvaluator(expr, nil)
+							So(err, ShouldBeNil)
+							input := data.Map{"l": lo.value, "r": ro.value}
+							res, err := eval.Eval(input)
+							ref, refErr := generic(op).Eval(input)
+							if refErr != nil {
+								So(err, ShouldNotBeNil)
+								So(err.Error(), ShouldEqual, refErr.Error())
+							} else {
+								So(err, ShouldBeNil)
+								So(res.Type(), ShouldEqual, ref.Type())
+								So(res.String(), ShouldEqual, ref.String())
+							}
+						}
+					}
+				})
+			})
+		}
+	})
+
+	Convey("Given an arithmetic operation on ints", t, func() {
+		expr := binaryOpAST{parser.Plus, typeCastAST{l, parser.Int}, numericLiteral{1}}
+
+		Convey("When creating its Evaluator", func() {
+			eval, err := ExpressionToEvaluator(expr, nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be specialized for ints", func() {
+				So(eval, ShouldHaveSameTypeAs, &intArithOp{})
+			})
+		})
+	})
+
+	Convey("Given a comparison of an int and a float", t, func() {
+		expr := binaryOpAST{parser.Less, numericLiteral{1}, unaryOpAST{parser.UnaryMinus, floatLiteral{2}}}
+
+		Convey("When creating its Evaluator", func() {
+			eval, err := ExpressionToEvaluator(expr, nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be specialized for floats", func() {
+				So(eval, ShouldHaveSameTypeAs, &floatComparison{})
+				So(eval.(*floatComparison).right, ShouldHaveSameTypeAs, &floatArithOp{})
+			})
+		})
+	})
+
+	Convey("Given an arithmetic operation on a column", t, func() {
+		expr := binaryOpAST{parser.Plus, l, numericLiteral{1}}
+
+		Convey("When creating its Evaluator", func() {
+			eval, err := ExpressionToEvaluator(expr, nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be the generic one", func() {
+				So(eval, ShouldHaveSameTypeAs, &numBinOp{})
+			})
+		})
+	})
+}
+
+func TestMapKeyAccess(t *testing.T) {
+	Convey("Given JSON Paths", t, func() {
+		testCases := map[string][]string{
+			"a":             {"a"},
+			"a.b_1.C":       {"a", "b_1", "C"},
+			`a["b c"].d`:    {"a", "b c", "d"},
+			`['a''b']["c"]`: {"a'b", "c"},
+			"a[0]":          nil,
+			"a..b":          nil,
+			"a[1:2].b":      nil,
+		}
+		for path, keys := range testCases {
+			path, keys := path, keys
+			Convey(fmt.Sprintf("Then %s should be split correctly", path), func() {
+				k, ok := splitMapKeyPath(path)
+				So(ok, ShouldEqual, keys != nil)
+				So(k, ShouldResemble, keys)
+			})
+		}
+	})
+
+	Convey("Given a column consisting of map keys", t, func() {
+		eval, err := newColumnAccess(`s.a["b"]`)
+		So(err, ShouldBeNil)
+		So(eval, ShouldHaveSameTypeAs, &mapKeyAccess{})
+		ref, err := newPathAccess(`s.a["b"]`)
+		So(err, ShouldBeNil)
+
+		inputs := []data.Value{
+			data.Map{"s": data.Map{"a": data.Map{"b": data.Int(1)}}},
+			data.Map{"s": data.Map{"a": data.Map{"c": data.Int(1)}}},
+			data.Map{"s": data.Map{"a": data.Int(1)}},
+			data.Map{"t": data.Map{}},
+			data.Int(1),
+		}
+		for i, input := range inputs {
+			input := input
+			Convey(fmt.Sprintf("Then the result on input %v should be the same as the generic one", i), func() {
+				res, err := eval.Eval(input)
+				refRes, refErr := ref.Eval(input)
+				if refErr != nil {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, refErr.Error())
+				} else {
+					So(err, ShouldBeNil)
+					So(res, ShouldResemble, refRes)
+				}
+			})
+		}
+	})
+
+	Convey("Given a column with an array access", t, func() {
+		eval, err := newColumnAccess("s.a[0]")
+		So(err, ShouldBeNil)
+
+		Convey("Then the generic path access should be used", func() {
+			So(eval, ShouldHaveSameTypeAs, &pathAccess{})
+		})
+	})
+}
+
+func benchmarkEvaluator(b *testing.B, eval Evaluator, input data.Value) {
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := eval.Eval(input); err != nil {
+			panic(err.Error())
+		}
+	}
+}
+
+func BenchmarkIntArithmeticGeneric(b *testing.B) {
+	// a::int * 2 + 1 with the generic numeric operators
+	pa, _ := newPathAccess("a")
+	eval := newPlus(binOp{newMultiply(binOp{&intCast{pa}, &intConstant{2}}), &intConstant{1}})
+	benchmarkEvaluator(b, eval, data.Map{"a": data.Int(7)})
+}
+
+func BenchmarkIntArithmeticTyped(b *testing.B) {
+	expr := binaryOpAST{parser.Plus,
+		binaryOpAST{parser.Multiply, typeCastAST{rowValue{"", "a"}, parser.Int}, numericLiteral{2}},
+		numericLiteral{1}}
+	eval, err := ExpressionToEvaluator(expr, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	benchmarkEvaluator(b, eval, data.Map{"a": data.Int(7)})
+}
+
+func BenchmarkFloatComparisonGeneric(b *testing.B) {
+	// a::float * 1.5 > 10.0 with the generic operators
+	pa, _ := newPathAccess("a")
+	eval := newGreater(binOp{newMultiply(binOp{&floatCast{pa}, &floatConstant{1.5}}), &floatConstant{10}})
+	benchmarkEvaluator(b, eval, data.Map{"a": data.Float(7)})
+}
+
+func BenchmarkFloatComparisonTyped(b *testing.B) {
+	expr := binaryOpAST{parser.Greater,
+		binaryOpAST{parser.Multiply, typeCastAST{rowValue{"", "a"}, parser.Float}, floatLiteral{1.5}},
+		floatLiteral{10}}
+	eval, err := ExpressionToEvaluator(expr, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	benchmarkEvaluator(b, eval, data.Map{"a": data.Float(7)})
+}
+
+func BenchmarkMapPathAccessGeneric(b *testing.B) {
+	eval, _ := newPathAccess("s.a.b")
+	input := data.Map{"s": data.Map{"a": data.Map{"b": data.Int(1)}}}
+	benchmarkEvaluator(b, eval, input)
+}
+
+func BenchmarkMapPathAccessTyped(b *testing.B) {
+	eval, err := ExpressionToEvaluator(rowValue{"s", "a.b"}, nil)
+	if err != nil {
+		panic(err.Error())
+	}
+	input := data.Map{"s": data.Map{"a": data.Map{"b": data.Int(1)}}}
+	benchmarkEvaluator(b, eval, input)
+}
@@ -0,0 +1,502 @@
+package bql
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+// fileFormat is the format of records in a file read by the file source or
+// written by the file sink. It's specified by the following parameters in the
+// WITH clause:
+//
+//	* format: "jsonl" (default), "csv", or "msgpack"
+//	* compression: "none" (default) or "gzip"
+//
+// The following parameters are only used by the "csv" format:
+//
+//	* header: true (default) if the first row of the file has column names
+//	* columns: an array of column names, required when header is false
+//	* column_types: a map from a column name to its type, which is one of
+//	  "string" (default), "int", "float", "bool", and "timestamp"
+//	  (only used by the source)
+//	* delimiter: a one-character string separating fields (default ",")
+type fileFormat struct {
+	format      string
+	compression string
+
+	header      bool
+	columns     []string
+	columnTypes map[string]data.TypeID
+	delimiter   rune
+}
+
+func parseFileFormat(params data.Map) (*fileFormat, error) {
+	f := &fileFormat{
+		format:      "jsonl",
+		compression: "none",
+		header:      true,
+		delimiter:   ',',
+	}
+
+	if v, ok := params["format"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'format' parameter must be a string: %v", err)
+		}
+		switch s {
+		case "jsonl", "csv", "msgpack":
+		default:
+			return nil, fmt.Errorf("unsupported format: %v", s)
+		}
+		f.format = s
+	}
+
+	if v, ok := params["compression"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'compression' parameter must be a string: %v", err)
+		}
+		switch s {
+		case "none", "gzip":
+		default:
+			return nil, fmt.Errorf("unsupported compression: %v", s)
+		}
+		f.compression = s
+	}
+
+	if f.format != "csv" {
+		return f, nil
+	}
+
+	if v, ok := params["header"]; ok {
+		h, err := data.AsBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("'header' parameter must be bool: %v", err)
+		}
+		f.header = h
+	}
+
+	if v, ok := params["columns"]; ok {
+		a, err := data.AsArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("'columns' parameter must be an array: %v", err)
+		}
+		for _, c := range a {
+			s, err := data.AsString(c)
+			if err != nil {
+				return nil, fmt.Errorf("a column name must be a string: %v", err)
+			}
+			f.columns = append(f.columns, s)
+		}
+	} else if !f.header {
+		return nil, errors.New("'columns' parameter is required when 'header' is false")
+	}
+
+	if v, ok := params["column_types"]; ok {
+		m, err := data.AsMap(v)
+		if err != nil {
+			return nil, fmt.Errorf("'column_types' parameter must be a map: %v", err)
+		}
+		f.columnTypes = make(map[string]data.TypeID, len(m))
+		for c, t := range m {
+			s, err := data.AsString(t)
+			if err != nil {
+				return nil, fmt.Errorf("the type of column '%v' must be a string: %v", c, err)
+			}
+			switch s {
+			case "string":
+				f.columnTypes[c] = data.TypeString
+			case "int":
+				f.columnTypes[c] = data.TypeInt
+			case "float":
+				f.columnTypes[c] = data.TypeFloat
+			case "bool":
+				f.columnTypes[c] = data.TypeBool
+			case "timestamp":
+				f.columnTypes[c] = data.TypeTimestamp
+			default:
+				return nil, fmt.Errorf("unsupported type of column '%v': %v", c, s)
+			}
+		}
+	}
+
+	if v, ok := params["delimiter"]; ok {
+		s, err := data.AsString(v)
+		if err != nil {
+			return nil, fmt.Errorf("'delimiter' parameter must be a string: %v", err)
+		}
+		if utf8.RuneCountInString(s) != 1 {
+			return nil, fmt.Errorf("'delimiter' parameter must be one character: %v", s)
+		}
+		f.delimiter, _ = utf8.DecodeRuneInString(s)
+	}
+	return f, nil
+}
+
+// openReader returns a reader of records in the given file. The returned
+// io.Closer closes the file and all readers wrapping it.
+func (f *fileFormat) openReader(path string) (io.Reader, io.Closer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if f.compression != "gzip" {
+		return file, file, nil
+	}
+
+	r, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	return r, multiCloser{r, file}, nil
+}
+
+// newDecoder creates a tupleDecoder reading records from r.
+func (f *fileFormat) newDecoder(r io.Reader) tupleDecoder {
+	switch f.format {
+	case "csv":
+		cr := csv.NewReader(r)
+		cr.Comma = f.delimiter
+		cr.FieldsPerRecord = -1 // checked by csvDecoder
+		return &csvDecoder{
+			f:       f,
+			r:       cr,
+			columns: f.columns,
+		}
+	case "msgpack":
+		return &msgpackDecoder{
+			dec: data.NewMsgpackDecoder(r),
+		}
+	default:
+		return &jsonlDecoder{
+			r:          bufio.NewReader(r),
+			lineNumber: -1,
+		}
+	}
+}
+
+// newEncoder creates a tupleEncoder writing records to w. The header of a
+// CSV file is only written when writeHeader is true.
+func (f *fileFormat) newEncoder(w io.Writer, writeHeader bool) tupleEncoder {
+	switch f.format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Comma = f.delimiter
+		return &csvEncoder{
+			w:           cw,
+			columns:     f.columns,
+			writeHeader: writeHeader && f.header,
+		}
+	case "msgpack":
+		return data.NewMsgpackEncoder(w)
+	default:
+		return &jsonlEncoder{
+			w: w,
+		}
+	}
+}
+
+// tupleDecoder reads records from a file.
+type tupleDecoder interface {
+	// Decode returns the next record. It returns io.EOF when there's no more
+	// record. When a record cannot be decoded but the following records can
+	// still be read, it returns a *recordError.
+	Decode() (data.Map, error)
+
+	// Position returns the name and the value of the position of the record
+	// lastly returned from Decode. It's used for logging.
+	Position() (string, int)
+}
+
+// recordError is returned from tupleDecoder.Decode when a record is
+// invalid and has to be skipped.
+type recordError struct {
+	err  error
+	body string
+}
+
+func (e *recordError) Error() string {
+	return e.err.Error()
+}
+
+type jsonlDecoder struct {
+	r          *bufio.Reader
+	lineNumber int
+}
+
+func (d *jsonlDecoder) Decode() (data.Map, error) {
+	for {
+		line, err := d.r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		d.lineNumber++
+
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			if err == io.EOF {
+				return nil, io.EOF
+			}
+			continue
+		}
+
+		m := data.Map{}
+		if err := json.Unmarshal(line, &m); err != nil {
+			return nil, &recordError{err, string(line)}
+		}
+		return m, nil
+	}
+}
+
+func (d *jsonlDecoder) Position() (string, int) {
+	return "jsonl_line_number", d.lineNumber
+}
+
+type csvDecoder struct {
+	f            *fileFormat
+	r            *csv.Reader
+	columns      []string
+	recordNumber int
+}
+
+func (d *csvDecoder) Decode() (data.Map, error) {
+	for {
+		rec, err := d.r.Read()
+		if err != nil {
+			if err == io.EOF {
+				return nil, err
+			}
+			if _, ok := err.(*csv.ParseError); ok {
+				d.recordNumber++
+				return nil, &recordError{err, ""}
+			}
+			return nil, err
+		}
+		d.recordNumber++
+
+		if d.f.header && d.recordNumber == 1 {
+			if d.columns == nil {
+				d.columns = rec
+			}
+			continue
+		}
+
+		if len(rec) != len(d.columns) {
+			return nil, &recordError{fmt.Errorf("the record has %v fields but %v columns are defined",
+				len(rec), len(d.columns)), d.join(rec)}
+		}
+		m := make(data.Map, len(rec))
+		for i, c := range d.columns {
+			v, err := d.convert(c, rec[i])
+			if err != nil {
+				return nil, &recordError{fmt.Errorf("cannot convert column '%v': %v", c, err), d.join(rec)}
+			}
+			m[c] = v
+		}
+		return m, nil
+	}
+}
+
+func (d *csvDecoder) convert(column, field string) (data.Value, error) {
+	t, ok := d.f.columnTypes[column]
+	if !ok || t == data.TypeString {
+		return data.String(field), nil
+	}
+	if field == "" {
+		return data.Null{}, nil
+	}
+
+	switch t {
+	case data.TypeInt:
+		i, err := strconv.ParseInt(field, 0, 64)
+		if err != nil {
+			return nil, err
+		}
+		return data.Int(i), nil
+	case data.TypeFloat:
+		f, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, err
+		}
+		return data.Float(f), nil
+	case data.TypeBool:
+		b, err := strconv.ParseBool(field)
+		if err != nil {
+			return nil, err
+		}
+		return data.Bool(b), nil
+	case data.TypeTimestamp:
+		ts, err := data.ToTimestamp(data.String(field))
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(ts), nil
+	}
+	return nil, fmt.Errorf("unsupported type: %v", t)
+}
+
+func (d *csvDecoder) join(rec []string) string {
+	buf := bytes.NewBuffer(nil)
+	w := csv.NewWriter(buf)
+	w.Comma = d.f.delimiter
+	w.Write(rec)
+	w.Flush()
+	return string(bytes.TrimSpace(buf.Bytes()))
+}
+
+func (d *csvDecoder) Position() (string, int) {
+	return "csv_record_number", d.recordNumber
+}
+
+type msgpackDecoder struct {
+	dec          *data.MsgpackDecoder
+	recordNumber int
+}
+
+func (d *msgpackDecoder) Decode() (data.Map, error) {
+	m, err := d.dec.Decode()
+	if err != nil {
+		// A broken msgpack stream cannot be read any further.
+		return nil, err
+	}
+	d.recordNumber++
+	return m, nil
+}
+
+func (d *msgpackDecoder) Position() (string, int) {
+	return "msgpack_record_number", d.recordNumber
+}
+
+// tupleEncoder writes records to a file. Encode isn't thread-safe.
+type tupleEncoder interface {
+	Encode(m data.Map) error
+}
+
+type jsonlEncoder struct {
+	w io.Writer
+}
+
+func (e *jsonlEncoder) Encode(m data.Map) error {
+	_, err := fmt.Fprintln(e.w, m.String())
+	return err
+}
+
+type csvEncoder struct {
+	w           *csv.Writer
+	columns     []string
+	writeHeader bool
+}
+
+func (e *csvEncoder) Encode(m data.Map) error {
+	if e.columns == nil {
+		// When columns aren't given, all fields of the first tuple are
+		// written in alphabetical order.
+		for k := range m {
+			e.columns = append(e.columns, k)
+		}
+		sort.Strings(e.columns)
+	}
+	if e.writeHeader {
+		if err := e.w.Write(e.columns); err != nil {
+			return err
+		}
+		e.writeHeader = false
+	}
+
+	rec := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		v, ok := m[c]
+		if !ok {
+			continue
+		}
+		s, err := data.ToString(v)
+		if err != nil {
+			return err
+		}
+		rec[i] = s
+	}
+	if err := e.w.Write(rec); err != nil {
+		return err
+	}
+	e.w.Flush()
+	return e.w.Error()
+}
+
+// multiCloser closes all closers in order and returns the first error.
+type multiCloser []io.Closer
+
+func (m multiCloser) Close() error {
+	var err error
+	for _, c := range m {
+		if e := c.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+const (
+	// gzipFlushRecords is the number of records after which
+	// gzipFileWriter flushes compressed data to the file.
+	gzipFlushRecords = 100
+
+	// gzipFlushInterval is the interval at which the file sink flushes
+	// compressed data of records written since the last flush.
+	gzipFlushInterval = time.Second
+)
+
+// gzipFileWriter compresses data written to a file. Compressed data is
+// flushed to the file every gzipFlushRecords records and, by the file sink,
+// every gzipFlushInterval, so that records can be read from the file before
+// it's closed and aren't lost on a crash.
+type gzipFileWriter struct {
+	*gzip.Writer
+	closer     io.Closer
+	numRecords int
+}
+
+func newGzipFileWriter(file *os.File) *gzipFileWriter {
+	gw := gzip.NewWriter(file)
+	return &gzipFileWriter{
+		Writer: gw,
+		closer: multiCloser{gw, file},
+	}
+}
+
+// endRecord must be called after each record is written. It flushes
+// compressed data when gzipFlushRecords records have been written since
+// the last flush.
+func (g *gzipFileWriter) endRecord() error {
+	g.numRecords++
+	if g.numRecords < gzipFlushRecords {
+		return nil
+	}
+	return g.flushRecords()
+}
+
+// flushRecords flushes compressed data if a record has been written since
+// the last flush.
+func (g *gzipFileWriter) flushRecords() error {
+	if g.numRecords == 0 {
+		return nil
+	}
+	g.numRecords = 0
+	return g.Flush()
+}
+
+func (g *gzipFileWriter) Close() error {
+	return g.closer.Close()
+}
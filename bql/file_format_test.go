@@ -0,0 +1,273 @@
+package bql
+
+import (
+	"compress/gzip"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readFileSource reads all tuples from a file source created with params.
+func readFileSource(params data.Map) ([]data.Map, error) {
+	ctx := core.NewContext(nil)
+	s, err := createFileSource(ctx, &IOParams{}, params)
+	if err != nil {
+		return nil, err
+	}
+	defer s.Stop(ctx)
+
+	var res []data.Map
+	err = s.GenerateStream(ctx, core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+		res = append(res, t.Data)
+		return nil
+	}))
+	return res, err
+}
+
+// writeFileSink writes all maps to a file sink created with params.
+func writeFileSink(params data.Map, maps []data.Map) error {
+	ctx := core.NewContext(nil)
+	s, err := createFileSink(ctx, &IOParams{}, params)
+	if err != nil {
+		return err
+	}
+	for _, m := range maps {
+		if err := s.Write(ctx, core.NewTuple(m)); err != nil {
+			s.Close(ctx)
+			return err
+		}
+	}
+	return s.Close(ctx)
+}
+
+func TestFileFormat(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sbtest_bql_file_format")
+	if err != nil {
+		t.Fatal("Cannot create a temp directory:", err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "data")
+
+	ts := time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC)
+	maps := []data.Map{
+		{"int": data.Int(1), "float": data.Float(1.5), "str": data.String("a,b"), "ts": data.Timestamp(ts)},
+		{"int": data.Int(2), "float": data.Float(2.5), "str": data.String(`"c"`), "ts": data.Timestamp(ts.Add(time.Second))},
+	}
+
+	Convey("Given a file sink and a file source", t, func() {
+		Reset(func() {
+			os.Remove(path)
+		})
+		params := data.Map{"path": data.String(path)}
+
+		for _, compression := range []string{"none", "gzip"} {
+			compression := compression
+			params["compression"] = data.String(compression)
+
+			Convey("When writing and reading msgpack with compression "+compression, func() {
+				params["format"] = data.String("msgpack")
+				So(writeFileSink(params, maps), ShouldBeNil)
+				So(writeFileSink(params, maps[:1]), ShouldBeNil) // append
+				res, err := readFileSource(params)
+				So(err, ShouldBeNil)
+
+				Convey("Then the source should read all tuples", func() {
+					So(res, ShouldHaveLength, 3)
+					for i, m := range res {
+						So(m["int"], ShouldEqual, maps[i%2]["int"])
+						So(m["float"], ShouldEqual, maps[i%2]["float"])
+						So(m["str"], ShouldEqual, maps[i%2]["str"])
+					}
+				})
+			})
+
+			Convey("When writing and reading CSV with compression "+compression, func() {
+				params["format"] = data.String("csv")
+				params["column_types"] = data.Map{
+					"int":   data.String("int"),
+					"float": data.String("float"),
+					"ts":    data.String("timestamp"),
+				}
+				So(writeFileSink(params, maps), ShouldBeNil)
+				So(writeFileSink(params, maps[:1]), ShouldBeNil) // append
+				res, err := readFileSource(params)
+				So(err, ShouldBeNil)
+
+				Convey("Then the source should read all tuples with typed columns", func() {
+					So(res, ShouldHaveLength, 3)
+					for i, m := range res {
+						So(m, ShouldResemble, maps[i%2])
+					}
+				})
+			})
+
+			Convey("When writing and reading JSON lines with compression "+compression, func() {
+				So(writeFileSink(params, maps), ShouldBeNil)
+				res, err := readFileSource(params)
+				So(err, ShouldBeNil)
+
+				Convey("Then the source should read all tuples", func() {
+					So(res, ShouldHaveLength, 2)
+					So(res[1]["str"], ShouldEqual, data.String(`"c"`))
+				})
+			})
+		}
+		delete(params, "compression")
+
+		Convey("When writing gzip compressed data", func() {
+			params["compression"] = data.String("gzip")
+			So(writeFileSink(params, maps), ShouldBeNil)
+
+			Convey("Then the file should be a gzip file", func() {
+				f, err := os.Open(path)
+				So(err, ShouldBeNil)
+				defer f.Close()
+				_, err = gzip.NewReader(f)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When writing gzip compressed data without closing the sink", func() {
+			params["compression"] = data.String("gzip")
+			ctx := core.NewContext(nil)
+			s, err := createFileSink(ctx, &IOParams{}, params)
+			So(err, ShouldBeNil)
+			Reset(func() {
+				s.Close(ctx)
+			})
+			for i := 0; i < gzipFlushRecords; i++ {
+				So(s.Write(ctx, core.NewTuple(data.Map{"int": data.Int(i)})), ShouldBeNil)
+			}
+
+			Convey("Then the flushed records should be readable", func() {
+				f, err := os.Open(path)
+				So(err, ShouldBeNil)
+				defer f.Close()
+				r, err := gzip.NewReader(f)
+				So(err, ShouldBeNil)
+				// the gzip footer hasn't been written yet
+				b, _ := ioutil.ReadAll(r)
+				So(strings.Count(string(b), "\n"), ShouldEqual, gzipFlushRecords)
+			})
+
+			Convey("And writing another record", func() {
+				So(s.Write(ctx, core.NewTuple(data.Map{"int": data.Int(-1)})), ShouldBeNil)
+
+				Convey("Then it should be readable after the flush interval", func() {
+					n := 0
+					for i := 0; i < 30 && n <= gzipFlushRecords; i++ {
+						time.Sleep(gzipFlushInterval / 10)
+						f, err := os.Open(path)
+						So(err, ShouldBeNil)
+						r, err := gzip.NewReader(f)
+						So(err, ShouldBeNil)
+						b, _ := ioutil.ReadAll(r)
+						f.Close()
+						n = strings.Count(string(b), "\n")
+					}
+					So(n, ShouldEqual, gzipFlushRecords+1)
+				})
+			})
+		})
+
+		Convey("When writing CSV with given columns", func() {
+			params["format"] = data.String("csv")
+			params["columns"] = data.Array{data.String("str"), data.String("int"), data.String("none")}
+			params["delimiter"] = data.String("\t")
+			So(writeFileSink(params, maps), ShouldBeNil)
+
+			Convey("Then the file should only have the columns", func() {
+				b, err := ioutil.ReadFile(path)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, "str\tint\tnone\na,b\t1\t\n\"\"\"c\"\"\"\t2\t\n")
+			})
+		})
+	})
+
+	Convey("Given a CSV file", t, func() {
+		Reset(func() {
+			os.Remove(path)
+		})
+		So(ioutil.WriteFile(path, []byte(`1,true
+x,false
+3
+4,no
+5,
+`), 0644), ShouldBeNil)
+		params := data.Map{
+			"path":    data.String(path),
+			"format":  data.String("csv"),
+			"header":  data.False,
+			"columns": data.Array{data.String("a"), data.String("b")},
+			"column_types": data.Map{
+				"a": data.String("int"),
+				"b": data.String("bool"),
+			},
+		}
+
+		Convey("When reading it by the file source", func() {
+			res, err := readFileSource(params)
+			So(err, ShouldBeNil)
+
+			Convey("Then invalid records should be skipped", func() {
+				So(res, ShouldResemble, []data.Map{
+					{"a": data.Int(1), "b": data.True},
+					{"a": data.Int(5), "b": data.Null{}},
+				})
+			})
+		})
+
+		Convey("When reading it as a gzip file", func() {
+			params["compression"] = data.String("gzip")
+			_, err := readFileSource(params)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid format parameters", t, func() {
+		params := data.Map{"path": data.String(path)}
+		cases := map[string]data.Map{
+			"an unknown format":               {"format": data.String("xml")},
+			"a non-string format":             {"format": data.Int(1)},
+			"an unknown compression":          {"compression": data.String("zip")},
+			"a non-bool header":               {"format": data.String("csv"), "header": data.String("yes")},
+			"no columns without a header":     {"format": data.String("csv"), "header": data.False},
+			"non-string columns":              {"format": data.String("csv"), "columns": data.Array{data.Int(1)}},
+			"an unknown column type":          {"format": data.String("csv"), "column_types": data.Map{"a": data.String("blob")}},
+			"a delimiter having two runes":    {"format": data.String("csv"), "delimiter": data.String(",,")},
+			"a delimiter having no character": {"format": data.String("csv"), "delimiter": data.String("")},
+		}
+
+		for name, c := range cases {
+			c := c
+			for k, v := range params {
+				c[k] = v
+			}
+
+			Convey("When creating a file source with "+name, func() {
+				_, err := createFileSource(core.NewContext(nil), &IOParams{}, c)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When creating a file sink with "+name, func() {
+				_, err := createFileSink(core.NewContext(nil), &IOParams{}, c)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
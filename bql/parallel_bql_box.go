@@ -0,0 +1,231 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync/atomic"
+)
+
+// parallelBQLBox executes a SELECT statement with several bqlBoxes, each
+// having its own execution plan, so that tuples can be processed by
+// multiple goroutines at the same time. It is used for statements created
+// with a WITH PARALLELISM clause.
+//
+// For a statement with a GROUP BY clause, all tuples of a group are
+// processed by the same bqlBox, selected by the partition which the
+// topology assigns the tuple to based on its partition key. Because
+// windows are then maintained per bqlBox, such a statement must not
+// depend on the tuples of other groups (see isPartitionable).
+// Statements without a GROUP BY clause must be stateless and tuples are
+// distributed among the bqlBoxes in turn.
+type parallelBQLBox struct {
+	boxes []*bqlBox
+	// partitionKey computes the values of the GROUP BY expressions.
+	// It is nil if the statement doesn't have a GROUP BY clause.
+	partitionKey func(t *core.Tuple) (data.Value, error)
+	// next is the number of tuples processed without a partition key
+	next uint64
+}
+
+func newParallelBQLBox(stmt *parser.SelectStmt, reg udf.FunctionRegistry, parallelism int) (*parallelBQLBox, error) {
+	lp, err := execution.Analyze(*stmt, reg)
+	if err != nil {
+		return nil, err
+	}
+	if lp.EmitterLimit >= 0 || lp.EmitterSamplingType != parser.UnspecifiedSamplingType {
+		return nil, fmt.Errorf("PARALLELISM cannot be used with emitter options")
+	}
+
+	b := &parallelBQLBox{
+		boxes: make([]*bqlBox, parallelism),
+	}
+	if len(lp.GroupList) > 0 && isPartitionable(lp) {
+		b.partitionKey, err = execution.NewPartitionKeyFunc(lp, reg)
+		if err != nil {
+			return nil, err
+		}
+	} else if !execution.CanBuildFilterPlan(lp, reg) {
+		return nil, fmt.Errorf("PARALLELISM can only be used with a stateless " +
+			"statement or an RSTREAM statement with a GROUP BY clause over " +
+			"a SESSION window of a single stream")
+	}
+	for i := range b.boxes {
+		b.boxes[i] = NewBQLBox(stmt, reg)
+	}
+	return b, nil
+}
+
+// isPartitionable returns true if processing the groups of the statement
+// by different bqlBoxes gives the same results as processing them with a
+// single one. This is the case for an RSTREAM statement over a SESSION window
+// of a single stream because there is one session per group and each session
+// is evaluated on its own. Other windows hold the tuples of all groups (e.g.
+// the last n tuples), the watermark of a LATENESS depends on the tuples of all
+// groups, and ISTREAM and DSTREAM compare the results with those of the
+// previous evaluation, which can belong to another group.
+func isPartitionable(lp *execution.LogicalPlan) bool {
+	if len(lp.Relations) != 1 || lp.EmitterType != parser.Rstream {
+		return false
+	}
+	rel := lp.Relations[0]
+	return rel.Window == parser.SessionWindow &&
+		rel.Lateness.Unit == parser.UnspecifiedIntervalUnit
+}
+
+// config returns the core.BoxConfig that the box has to be added
+// to a topology with.
+func (b *parallelBQLBox) config() *core.BoxConfig {
+	return &core.BoxConfig{
+		Parallelism:  len(b.boxes),
+		PartitionKey: b.partitionKey,
+	}
+}
+
+func (b *parallelBQLBox) Init(ctx *core.Context) error {
+	for i, box := range b.boxes {
+		if err := box.Init(ctx); err != nil {
+			for _, initialized := range b.boxes[:i] {
+				initialized.Terminate(ctx)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *parallelBQLBox) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	box, err := b.route(t)
+	if err != nil {
+		return err
+	}
+	return box.Process(ctx, t, w)
+}
+
+// ProcessPartition processes a tuple with the bqlBox of the partition
+// that the topology computed from the partition key of the tuple.
+func (b *parallelBQLBox) ProcessPartition(ctx *core.Context, t *core.Tuple, w core.Writer, partition int) error {
+	return b.boxes[partition].Process(ctx, t, w)
+}
+
+// route returns the bqlBox which processes the given tuple when the
+// topology doesn't tell its partition.
+func (b *parallelBQLBox) route(t *core.Tuple) (*bqlBox, error) {
+	if b.partitionKey != nil {
+		k, err := b.partitionKey(t)
+		if err != nil {
+			return nil, err
+		}
+		return b.boxes[core.PartitionIndex(k, len(b.boxes))], nil
+	}
+	i := atomic.AddUint64(&b.next, 1)
+	return b.boxes[i%uint64(len(b.boxes))], nil
+}
+
+// SaveCheckpoint returns the tuples held in the windows of each bqlBox
+// along with the state of the windows.
+func (b *parallelBQLBox) SaveCheckpoint(ctx *core.Context) (data.Value, error) {
+	boxes := make(data.Array, len(b.boxes))
+	for i, box := range b.boxes {
+		box.mutex.Lock()
+		m, err := box.saveWindows()
+		box.mutex.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		boxes[i] = m
+	}
+	return data.Map{"boxes": boxes}, nil
+}
+
+// LoadCheckpoint restores the windows of each bqlBox from the checkpoint
+// returned from SaveCheckpoint. When the parallelism of the statement has
+// been changed, the tuples are distributed to the bqlBoxes in the same way
+// as Process does. This is not possible when the windows have a state that
+// cannot be derived from their tuples (e.g. TUMBLING windows).
+func (b *parallelBQLBox) LoadCheckpoint(ctx *core.Context, v data.Value) error {
+	m, err := data.AsMap(v)
+	if err != nil {
+		return err
+	}
+	boxes, err := data.AsArray(m["boxes"])
+	if err != nil {
+		return err
+	}
+	saved := make([]data.Map, len(boxes))
+	for i, a := range boxes {
+		if saved[i], err = data.AsMap(a); err != nil {
+			return err
+		}
+	}
+
+	if len(saved) == len(b.boxes) {
+		for i, box := range b.boxes {
+			box.mutex.Lock()
+			err := box.restoreWindows(saved[i])
+			box.mutex.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	routed := map[*bqlBox][]*core.Tuple{}
+	for _, m := range saved {
+		if _, ok := m["windows"]; ok {
+			return fmt.Errorf("the windows of the statement cannot be restored "+
+				"after the parallelism has been changed from %d to %d",
+				len(saved), len(b.boxes))
+		}
+		tuples, err := arrayToTuples(m["tuples"])
+		if err != nil {
+			return err
+		}
+		for _, t := range tuples {
+			box, err := b.route(t)
+			if err != nil {
+				return err
+			}
+			routed[box] = append(routed[box], t)
+		}
+	}
+	for _, box := range b.boxes {
+		box.mutex.Lock()
+		err := box.restoreWindows(data.Map{"tuples": tuplesToArray(routed[box])})
+		box.mutex.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status returns the sum of the numbers of errors returned from each UDF
+// in all bqlBoxes.
+func (b *parallelBQLBox) Status() data.Map {
+	errs := map[string]int64{}
+	for _, box := range b.boxes {
+		box.mutex.Lock()
+		for name, n := range box.numUDFErrors {
+			errs[name] += n
+		}
+		box.mutex.Unlock()
+	}
+	return data.Map{
+		"num_udf_errors": udfErrorsToMap(errs),
+	}
+}
+
+func (b *parallelBQLBox) Terminate(ctx *core.Context) error {
+	var err error
+	for _, box := range b.boxes {
+		if e := box.Terminate(ctx); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
@@ -0,0 +1,163 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestParallelBQLBox(t *testing.T) {
+	Convey("Given a stateless BQL statement with parallelism", t, func() {
+		s := `CREATE STREAM box WITH PARALLELISM 3 AS SELECT RSTREAM int
+			FROM source [RANGE 1 TUPLES] WHERE int % 2 = 0`
+		tb, err := setupTopology(s, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			si.Wait(2)
+
+			Convey("Then the sink receives the 2 matching tuples", func() {
+				So(si.len(), ShouldEqual, 2)
+				ints := []data.Value{}
+				si.forEachTuple(func(t *core.Tuple) {
+					ints = append(ints, t.Data["int"])
+				})
+				So(ints, ShouldContain, data.Int(2))
+				So(ints, ShouldContain, data.Int(4))
+			})
+		})
+	})
+
+	Convey("Given a BQL statement with a GROUP BY clause and parallelism", t, func() {
+		s := `CREATE STREAM box WITH PARALLELISM 2 AS SELECT RSTREAM x:p, count(*) AS c
+			FROM (SELECT RSTREAM int / 3 AS p FROM source [RANGE 1 TUPLES])
+				[SESSION GAP 2 SECONDS] AS x
+			GROUP BY x:p`
+		tb, err := setupTopology(s, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			// the session of each group is closed by the timer
+			si.Wait(2)
+
+			Convey("Then the sink receives the count of the session of each group", func() {
+				So(si.len(), ShouldEqual, 2)
+				counts := map[int64][]int64{}
+				si.forEachTuple(func(t *core.Tuple) {
+					p, _ := data.AsInt(t.Data["p"])
+					c, _ := data.AsInt(t.Data["c"])
+					counts[p] = append(counts[p], c)
+				})
+				So(counts, ShouldResemble, map[int64][]int64{
+					0: {2},
+					1: {2},
+				})
+			})
+		})
+	})
+
+	Convey("Given a BQL TopologyBuilder with a source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy`), ShouldBeNil)
+		numNodes := len(dt.Nodes())
+
+		Convey("When creating a stream with a stateful statement and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT ISTREAM count(*) FROM s [RANGE 2 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "stateless")
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with emitter options and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT RSTREAM [LIMIT 1] int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "emitter options")
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with a grouped RANGE window and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT ISTREAM int, count(*) FROM s [RANGE 2 TUPLES] GROUP BY int`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "SESSION")
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with ISTREAM over a grouped SESSION window and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT ISTREAM int, count(*) FROM s [SESSION GAP 2 SECONDS] GROUP BY int`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with a grouped SESSION window with a LATENESS and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT RSTREAM int, count(*) FROM s [SESSION GAP 2 SECONDS, LATENESS 1 SECONDS]
+				GROUP BY int`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with a grouped join and parallelism", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 2 AS
+				SELECT RSTREAM a:int, count(*) FROM s [SESSION GAP 2 SECONDS] AS a,
+				s [RANGE 2 TUPLES] AS b GROUP BY a:int`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(len(dt.Nodes()), ShouldEqual, numNodes)
+			})
+		})
+
+		Convey("When creating a stream with a parallelism of 1", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t WITH PARALLELISM 1 AS
+				SELECT ISTREAM count(*) FROM s [RANGE 2 TUPLES]`)
+
+			Convey("Then it should be created as usual", func() {
+				So(err, ShouldBeNil)
+				bn, err := dt.Box("t")
+				So(err, ShouldBeNil)
+				So(bn.Box(), ShouldHaveSameTypeAs, &bqlBox{})
+			})
+		})
+	})
+}
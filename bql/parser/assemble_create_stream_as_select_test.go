@@ -10,6 +10,7 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct CREATE STREAM items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleParallelism(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
@@ -18,18 +19,24 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.PushComponent(8, 9, Identifier("y"))
 			ps.AssembleAlias()
 			ps.AssembleProjections(6, 9)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 11, RangeWindow)
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 15, RangeWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -44,6 +51,9 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrderBy(24, 24)
+			ps.AssembleLimit(24, 24)
+			ps.AssembleOffset(24, 24)
 			ps.AssembleSelect()
 			ps.AssembleCreateStreamAsSelect()
 
@@ -60,6 +70,7 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 					Convey("And it contains the previously pushed data", func() {
 						cssComp := top.comp.(CreateStreamAsSelectStmt)
 						So(cssComp.Name, ShouldEqual, "x")
+						So(cssComp.Parallelism, ShouldEqual, 0)
 						comp := cssComp.Select
 						So(comp.EmitterType, ShouldEqual, Istream)
 						So(len(comp.Projections), ShouldEqual, 2)
@@ -155,5 +166,33 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When creating a stream with parallelism", func() {
+			p.Buffer = `CREATE STREAM x WITH PARALLELISM 4 AS SELECT RSTREAM a FROM c [RANGE 1 TUPLES] WHERE e`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				cssComp := top.(CreateStreamAsSelectStmt)
+
+				So(cssComp.Name, ShouldEqual, "x")
+				So(cssComp.Parallelism, ShouldEqual, 4)
+				comp := cssComp.Select
+				So(comp.EmitterType, ShouldEqual, Rstream)
+				So(comp.Projections, ShouldResemble, []Expression{RowValue{"", "a"}})
+				So(comp.Filter, ShouldResemble, RowValue{"", "e"})
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
 	})
 }
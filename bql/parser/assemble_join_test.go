@@ -0,0 +1,180 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleJoin(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains the correct JOIN items", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 10, LeftJoin)
+			ps.PushComponent(15, 20, AliasedStreamWindowAST{
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, RangeWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, LatenessAST{},
+					UnspecifiedCapacity, UnspecifiedSheddingOption}, "",
+			})
+			ps.PushComponent(24, 30, BinaryOpAST{Equal, RowValue{"a", "x"}, RowValue{"b", "x"}})
+			ps.AssembleJoin()
+
+			Convey("Then AssembleJoin replaces them with two items", func() {
+				So(ps.Len(), ShouldEqual, 3)
+
+				Convey("And the top item is a JoinAST", func() {
+					top := ps.Pop()
+					So(top.begin, ShouldEqual, 20)
+					So(top.end, ShouldEqual, 30)
+					So(top.comp, ShouldHaveSameTypeAs, JoinAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(JoinAST)
+						So(comp.Type, ShouldEqual, LeftJoin)
+						So(comp.On, ShouldResemble, BinaryOpAST{Equal, RowValue{"a", "x"}, RowValue{"b", "x"}})
+					})
+
+					Convey("And the item below is the joined relation", func() {
+						rel := ps.Peek()
+						So(rel.begin, ShouldEqual, 15)
+						So(rel.end, ShouldEqual, 20)
+						So(rel.comp, ShouldHaveSameTypeAs, AliasedStreamWindowAST{})
+						So(rel.comp.(AliasedStreamWindowAST).Name, ShouldEqual, "b")
+					})
+				})
+			})
+		})
+
+		Convey("When the stack does not contain the correct JOIN items", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, Raw{"PRE"})
+			ps.PushComponent(7, 8, Raw{"PRE"})
+			f := func() {
+				ps.AssembleJoin()
+			}
+
+			Convey("Then AssembleJoin panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack contains too few items", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			f := func() {
+				ps.AssembleJoin()
+			}
+
+			Convey("Then AssembleJoin panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a JOIN", func() {
+			p.Buffer = "SELECT ISTREAM a:x, b:y FROM a [RANGE 3 TUPLES] JOIN b [RANGE 2 SECONDS] ON a:id = b:id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[0].Name, ShouldEqual, "a")
+				So(comp.Relations[1].Name, ShouldEqual, "b")
+				So(len(comp.Joins), ShouldEqual, 1)
+				So(comp.Joins[0].Type, ShouldEqual, InnerJoin)
+				So(comp.Joins[0].On, ShouldResemble,
+					BinaryOpAST{Equal, RowValue{"a", "id"}, RowValue{"b", "id"}})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an INNER JOIN and a WHERE clause", func() {
+			p.Buffer = "SELECT ISTREAM * FROM a [RANGE 3 TUPLES] AS x INNER JOIN b [RANGE 2 SECONDS] AS y ON x:id = y:id WHERE x:v > 2"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Relations[0].Alias, ShouldEqual, "x")
+				So(comp.Relations[1].Alias, ShouldEqual, "y")
+				So(len(comp.Joins), ShouldEqual, 1)
+				So(comp.Joins[0].Type, ShouldEqual, InnerJoin)
+				So(comp.Filter, ShouldResemble,
+					BinaryOpAST{Greater, RowValue{"x", "v"}, NumericLiteral{2}})
+			})
+		})
+
+		Convey("When selecting with several LEFT JOINs", func() {
+			p.Buffer = "SELECT ISTREAM * FROM a [RANGE 3 TUPLES] LEFT JOIN b [RANGE 2 SECONDS] ON a:id = b:id " +
+				"LEFT OUTER JOIN c [RANGE 2 TUPLES] ON b:k = c:k AND a:v > c:v"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				comp := top.(SelectStmt)
+				So(len(comp.Relations), ShouldEqual, 3)
+				So(comp.Relations[2].Name, ShouldEqual, "c")
+				So(len(comp.Joins), ShouldEqual, 2)
+				So(comp.Joins[0].Type, ShouldEqual, LeftJoin)
+				So(comp.Joins[1].Type, ShouldEqual, LeftJoin)
+				So(comp.Joins[1].On, ShouldResemble, BinaryOpAST{And,
+					BinaryOpAST{Equal, RowValue{"b", "k"}, RowValue{"c", "k"}},
+					BinaryOpAST{Greater, RowValue{"a", "v"}, RowValue{"c", "v"}}})
+
+				Convey("And String() should return the normalized statement", func() {
+					So(comp.String(), ShouldEqual, "SELECT ISTREAM * FROM a [RANGE 3 TUPLES] "+
+						"LEFT JOIN b [RANGE 2 SECONDS] ON a:id = b:id "+
+						"LEFT JOIN c [RANGE 2 TUPLES] ON b:k = c:k AND a:v > c:v")
+				})
+			})
+		})
+
+		Convey("When using JOIN without ON", func() {
+			p.Buffer = "SELECT ISTREAM * FROM a [RANGE 3 TUPLES] JOIN b [RANGE 2 SECONDS]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When mixing JOIN and comma-separated relations", func() {
+			p.Buffer = "SELECT ISTREAM * FROM a [RANGE 3 TUPLES] JOIN b [RANGE 2 SECONDS] ON a:id = b:id, c [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
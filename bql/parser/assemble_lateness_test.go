@@ -0,0 +1,133 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleLateness(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains two correct items", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.PushComponent(9, 12, ReportLate)
+			ps.EnsureLateTuplePolicy(8, 12)
+			ps.AssembleLateness()
+
+			Convey("Then AssembleLateness replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a LatenessAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 12)
+					So(top.comp, ShouldHaveSameTypeAs, LatenessAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(LatenessAST)
+						So(comp.Value, ShouldEqual, 2)
+						So(comp.Unit, ShouldEqual, Seconds)
+						So(comp.Policy, ShouldEqual, ReportLate)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains no policy", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureLateTuplePolicy(8, 8)
+			ps.AssembleLateness()
+
+			Convey("Then AssembleLateness uses an unspecified policy", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.comp, ShouldResemble, LatenessAST{IntervalAST{FloatLiteral{2}, Seconds},
+					UnspecifiedLateTuplePolicy})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, Raw{"PRE"})
+
+			Convey("Then AssembleLateness panics", func() {
+				So(ps.AssembleLateness, ShouldPanic)
+			})
+		})
+
+		Convey("When the stack is empty", func() {
+			Convey("Then AssembleLateness panics", func() {
+				So(ps.AssembleLateness, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a LATENESS", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 10 SECONDS, LATENESS 2 SECONDS]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Lateness, ShouldResemble, LatenessAST{
+					IntervalAST{FloatLiteral{2}, Seconds}, UnspecifiedLateTuplePolicy})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a LATENESS and a policy", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a FROM c " +
+				"[TUMBLING 10 SECONDS, LATENESS 500 MILLISECONDS CORRECT LATE, BUFFER SIZE 5, DROP OLDEST IF FULL]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Lateness, ShouldResemble, LatenessAST{
+					IntervalAST{FloatLiteral{500}, Milliseconds}, CorrectLate})
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+				So(comp.Relations[0].Shedding, ShouldEqual, DropOldest)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When using an unknown policy", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 10 SECONDS, LATENESS 2 SECONDS KEEP LATE]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
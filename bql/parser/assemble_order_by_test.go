@@ -0,0 +1,233 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleOrderBy(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains two items in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, SortedExpressionAST{RowValue{"", "a"}, No})
+			ps.PushComponent(7, 8, SortedExpressionAST{RowValue{"", "b"}, UnspecifiedKeyword})
+			ps.AssembleOrderBy(6, 8)
+
+			Convey("Then AssembleOrderBy replaces them with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an OrderByAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 8)
+					So(top.comp, ShouldHaveSameTypeAs, OrderByAST{})
+
+					Convey("And it contains the previous data", func() {
+						comp := top.comp.(OrderByAST)
+						So(comp.Ordering, ShouldResemble, []SortedExpressionAST{
+							{RowValue{"", "a"}, No},
+							{RowValue{"", "b"}, UnspecifiedKeyword},
+						})
+					})
+				})
+			})
+		})
+
+		Convey("When the given range is empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleOrderBy(6, 6)
+
+			Convey("Then AssembleOrderBy pushes one item onto the stack", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an empty OrderByAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 6)
+					So(top.comp, ShouldResemble, OrderByAST{})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, RowValue{"", "a"})
+			f := func() {
+				ps.AssembleOrderBy(6, 7)
+			}
+			Convey("Then AssembleOrderBy panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting without an ORDER BY", func() {
+			p.Buffer = "SELECT ISTREAM a, b"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.Ordering, ShouldBeNil)
+				So(s.LimitAST, ShouldResemble, LimitAST{})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an ORDER BY", func() {
+			p.Buffer = "SELECT RSTREAM a, b FROM c [RANGE 1 TUPLES] ORDER BY b DESC, a + 1 ASC, c"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.Ordering, ShouldResemble, []SortedExpressionAST{
+					{RowValue{"", "b"}, No},
+					{BinaryOpAST{Plus, RowValue{"", "a"}, NumericLiteral{1}}, Yes},
+					{RowValue{"", "c"}, UnspecifiedKeyword},
+				})
+				So(s.LimitAST, ShouldResemble, LimitAST{})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
+
+func TestAssembleLimit(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a LIMIT and an OFFSET", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, NumericLiteral{10})
+			ps.AssembleLimit(6, 7)
+			ps.PushComponent(8, 9, NumericLiteral{3})
+			ps.AssembleOffset(8, 9)
+
+			Convey("Then they are replaced by a single LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top, ShouldNotBeNil)
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 9)
+				So(top.comp, ShouldResemble, LimitAST{true, 10, 3})
+			})
+		})
+
+		Convey("When the stack contains only an OFFSET", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleLimit(6, 6)
+			ps.PushComponent(6, 7, NumericLiteral{3})
+			ps.AssembleOffset(6, 7)
+
+			Convey("Then there is a LimitAST without a limit", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 7)
+				So(top.comp, ShouldResemble, LimitAST{false, 0, 3})
+			})
+		})
+
+		Convey("When the given ranges are empty", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.AssembleLimit(6, 6)
+			ps.AssembleOffset(6, 6)
+
+			Convey("Then there is an empty LimitAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 6)
+				So(top.end, ShouldEqual, 6)
+				So(top.comp, ShouldResemble, LimitAST{})
+			})
+		})
+
+		Convey("When the stack contains one item not in the given range", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 7, NumericLiteral{10})
+			f := func() {
+				ps.AssembleLimit(5, 6)
+			}
+			Convey("Then AssembleLimit panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When selecting with a LIMIT and an OFFSET", func() {
+			p.Buffer = "SELECT RSTREAM a FROM c [RANGE 1 TUPLES] ORDER BY a LIMIT 10 OFFSET 20"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.LimitAST, ShouldResemble, LimitAST{true, 10, 20})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a LIMIT but no ORDER BY", func() {
+			p.Buffer = "SELECT RSTREAM a FROM c [RANGE 1 TUPLES] LIMIT 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(s.Ordering, ShouldBeNil)
+				So(s.LimitAST, ShouldResemble, LimitAST{true, 1, 0})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
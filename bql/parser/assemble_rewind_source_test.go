@@ -10,6 +10,7 @@ func TestAssembleRewindSource(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct REWIND SOURCE items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("a"))
+			ps.PushComponent(4, 4, RewindPositionAST{})
 			ps.AssembleRewindSource()
 
 			Convey("Then AssembleRewindSource transforms them into one item", func() {
@@ -25,6 +26,7 @@ func TestAssembleRewindSource(t *testing.T) {
 					Convey("And it contains the previously pushed data", func() {
 						comp := top.comp.(RewindSourceStmt)
 						So(comp.Source, ShouldEqual, "a")
+						So(comp.Type, ShouldEqual, UnspecifiedRewindPosition)
 					})
 				})
 			})
@@ -32,6 +34,7 @@ func TestAssembleRewindSource(t *testing.T) {
 
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(2, 4, Raw{"a"}) // must be StreamIdentifier
+			ps.PushComponent(4, 4, RewindPositionAST{})
 
 			Convey("Then AssembleRewindSource panics", func() {
 				So(ps.AssembleRewindSource, ShouldPanic)
@@ -64,5 +67,64 @@ func TestAssembleRewindSource(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When doing a full REWIND SOURCE with an offset", func() {
+			p.Buffer = "REWIND SOURCE a_1 TO OFFSET 10"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, RewindSourceStmt{})
+				comp := top.(RewindSourceStmt)
+
+				So(comp.Source, ShouldEqual, "a_1")
+				So(comp.Type, ShouldEqual, OffsetRewindPosition)
+				So(comp.Offset, ShouldEqual, 10)
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a full REWIND SOURCE with a timestamp", func() {
+			p.Buffer = `REWIND SOURCE a_1 TO TIMESTAMP "2015-04-10T10:23:00Z"`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, RewindSourceStmt{})
+				comp := top.(RewindSourceStmt)
+
+				So(comp.Source, ShouldEqual, "a_1")
+				So(comp.Type, ShouldEqual, TimestampRewindPosition)
+				So(comp.Timestamp, ShouldEqual, "2015-04-10T10:23:00Z")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a REWIND SOURCE with a negative offset", func() {
+			p.Buffer = "REWIND SOURCE a_1 TO OFFSET -1"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
 	})
 }
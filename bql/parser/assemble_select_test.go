@@ -15,18 +15,24 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 11, RangeWindow)
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 15, RangeWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -41,6 +47,9 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleHaving(28, 30)
+			ps.AssembleOrderBy(30, 30)
+			ps.AssembleLimit(30, 30)
+			ps.AssembleOffset(30, 30)
 			ps.AssembleSelect()
 
 			Convey("Then AssembleSelect transforms them into one item", func() {
@@ -98,18 +107,24 @@ func TestAssembleSelect(t *testing.T) {
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.AssembleProjections(6, 8)
-			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
+			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil, nil})
+			ps.PushComponent(11, 11, RangeWindow)
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
 			ps.EnsureSheddingSpec(13, 14)
 			ps.AssembleStreamWindow()
 			ps.EnsureAliasedStreamWindow()
-			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil})
+			ps.PushComponent(14, 15, Stream{ActualStream, "d", nil, nil})
+			ps.PushComponent(15, 15, RangeWindow)
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -124,6 +139,9 @@ func TestAssembleSelect(t *testing.T) {
 			ps.AssembleGrouping(24, 28)
 			ps.PushComponent(28, 30, RowValue{"", "h"})
 			ps.AssembleFilter(28, 30) // must be HAVING in correct stmt
+			ps.AssembleOrderBy(30, 30)
+			ps.AssembleLimit(30, 30)
+			ps.AssembleOffset(30, 30)
 			Convey("Then AssembleSelect panics", func() {
 				So(ps.AssembleSelect, ShouldPanic)
 			})
@@ -134,7 +152,7 @@ func TestAssembleSelect(t *testing.T) {
 		p := &bqlPeg{}
 
 		Convey("When doing a full SELECT", func() {
-			p.Buffer = `SELECT ISTREAM "日本語", b FROM c [RANGE 3 TUPLES, BUFFER SIZE 2, DROP OLDEST IF FULL], d("state", 7) [RANGE 2 SECONDS] AS x WHERE e GROUP BY f, g HAVING h`
+			p.Buffer = `SELECT ISTREAM "日本語", b FROM c [RANGE 3 TUPLES, BUFFER SIZE 2, DROP OLDEST IF FULL], d("state", 7) [RANGE 2 SECONDS] AS x WHERE e GROUP BY f, g HAVING h ORDER BY h DESC, b LIMIT 10 OFFSET 5`
 			p.Init()
 
 			Convey("Then the statement should be parsed correctly", func() {
@@ -177,6 +195,11 @@ func TestAssembleSelect(t *testing.T) {
 				So(comp.GroupList[0], ShouldResemble, RowValue{"", "f"})
 				So(comp.GroupList[1], ShouldResemble, RowValue{"", "g"})
 				So(comp.Having, ShouldResemble, RowValue{"", "h"})
+				So(comp.Ordering, ShouldResemble, []SortedExpressionAST{
+					{RowValue{"", "h"}, No},
+					{RowValue{"", "b"}, UnspecifiedKeyword},
+				})
+				So(comp.LimitAST, ShouldResemble, LimitAST{true, 10, 5})
 
 				Convey("And String() should return the original statement", func() {
 					So(comp.String(), ShouldEqual, p.Buffer)
@@ -0,0 +1,114 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleSubquery(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a SelectStmt", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(7, 20, SelectStmt{
+				ProjectionsAST: ProjectionsAST{[]Expression{RowValue{"", "a"}}},
+			})
+			ps.AssembleSubquery(6, 21)
+
+			Convey("Then AssembleSubquery replaces it with a new item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a Stream", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 21)
+					So(top.comp, ShouldHaveSameTypeAs, Stream{})
+
+					Convey("And it contains the subquery", func() {
+						comp := top.comp.(Stream)
+						So(comp.Type, ShouldEqual, SubqueryStream)
+						So(comp.Subquery, ShouldNotBeNil)
+						So(comp.Subquery.Projections, ShouldResemble,
+							[]Expression{RowValue{"", "a"}})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+
+			Convey("Then AssembleSubquery panics", func() {
+				So(func() { ps.AssembleSubquery(0, 6) }, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When parsing a SELECT statement with a subquery", func() {
+			p.Buffer = "SELECT ISTREAM x:a FROM (SELECT RSTREAM a FROM b [RANGE 1 TUPLES] WHERE a > 2) [RANGE 2 TUPLES] AS x"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Relations), ShouldEqual, 1)
+				rel := s.Relations[0]
+				So(rel.Type, ShouldEqual, SubqueryStream)
+				So(rel.Alias, ShouldEqual, "x")
+				So(rel.Value, ShouldEqual, 2)
+				So(rel.Unit, ShouldEqual, Tuples)
+
+				sub := rel.Subquery
+				So(sub, ShouldNotBeNil)
+				So(sub.EmitterType, ShouldEqual, Rstream)
+				So(sub.Projections, ShouldResemble, []Expression{RowValue{"", "a"}})
+				So(len(sub.Relations), ShouldEqual, 1)
+				So(sub.Relations[0].Name, ShouldEqual, "b")
+				So(sub.Filter, ShouldResemble, BinaryOpAST{Greater, RowValue{"", "a"}, NumericLiteral{2}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When parsing nested subqueries joined with a stream", func() {
+			p.Buffer = "SELECT ISTREAM x:a, c:a FROM (SELECT ISTREAM y:a FROM (SELECT ISTREAM a FROM b [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS y) [RANGE 2 SECONDS] AS x JOIN c [RANGE 2 SECONDS] ON x:a = c:a"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SelectStmt{})
+				s := top.(SelectStmt)
+				So(len(s.Relations), ShouldEqual, 2)
+				So(s.Relations[0].Type, ShouldEqual, SubqueryStream)
+				So(s.Relations[1].Type, ShouldEqual, ActualStream)
+				inner := s.Relations[0].Subquery.Relations[0]
+				So(inner.Type, ShouldEqual, SubqueryStream)
+				So(inner.Alias, ShouldEqual, "y")
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
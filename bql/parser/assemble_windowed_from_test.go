@@ -12,11 +12,11 @@ func TestAssembleWindowedFrom(t *testing.T) {
 		Convey("When the stack contains only AliasedStreamWindows in the given range", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "a", nil}, IntervalAST{FloatLiteral{3}, Tuples},
+				StreamWindowAST{Stream{ActualStream, "a", nil, nil}, RangeWindow, IntervalAST{FloatLiteral{3}, Tuples}, IntervalAST{}, LatenessAST{},
 					2, UnspecifiedSheddingOption}, "",
 			})
 			ps.PushComponent(8, 10, AliasedStreamWindowAST{
-				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{2}, Seconds},
+				StreamWindowAST{Stream{ActualStream, "b", nil, nil}, RangeWindow, IntervalAST{FloatLiteral{2}, Seconds}, IntervalAST{}, LatenessAST{},
 					UnspecifiedCapacity, Wait}, "",
 			})
 			ps.AssembleWindowedFrom(6, 10)
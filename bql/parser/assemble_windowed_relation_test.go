@@ -11,8 +11,11 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 8, RangeWindow)
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropOldest)
@@ -32,8 +35,10 @@ func TestAssembleStreamWindow(t *testing.T) {
 					Convey("And it contains the previously pushed data", func() {
 						comp := top.comp.(StreamWindowAST)
 						So(comp.Name, ShouldEqual, "a")
+						So(comp.Window, ShouldEqual, RangeWindow)
 						So(comp.Value, ShouldEqual, 2)
 						So(comp.Unit, ShouldEqual, Seconds)
+						So(comp.Slide.Unit, ShouldEqual, UnspecifiedIntervalUnit)
 						So(comp.Capacity, ShouldEqual, 2)
 						So(comp.Shedding, ShouldEqual, DropOldest)
 					})
@@ -43,8 +48,11 @@ func TestAssembleStreamWindow(t *testing.T) {
 
 		Convey("When the stack contains two correct items (float)", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 8, RangeWindow)
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{0.2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropNewest)
@@ -73,9 +81,44 @@ func TestAssembleStreamWindow(t *testing.T) {
 			})
 		})
 
+		Convey("When the stack contains a HOPPING window with a SLIDE", func() {
+			ps.PushComponent(0, 6, Raw{"PRE"})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
+			ps.PushComponent(8, 9, HoppingWindow)
+			ps.PushComponent(9, 10, IntervalAST{FloatLiteral{10}, Seconds})
+			ps.PushComponent(10, 11, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureSlideSpec(9, 11)
+			ps.EnsureLatenessSpec(11, 11)
+			ps.EnsureCapacitySpec(11, 11)
+			ps.EnsureSheddingSpec(11, 11)
+			ps.AssembleStreamWindow()
+
+			Convey("Then AssembleStreamWindow transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a StreamWindowAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 6)
+					So(top.end, ShouldEqual, 11)
+					So(top.comp, ShouldHaveSameTypeAs, StreamWindowAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(StreamWindowAST)
+						So(comp.Name, ShouldEqual, "a")
+						So(comp.Window, ShouldEqual, HoppingWindow)
+						So(comp.IntervalAST, ShouldResemble, IntervalAST{FloatLiteral{10}, Seconds})
+						So(comp.Slide, ShouldResemble, IntervalAST{FloatLiteral{2}, Seconds})
+						So(comp.Capacity, ShouldEqual, UnspecifiedCapacity)
+						So(comp.Shedding, ShouldEqual, UnspecifiedSheddingOption)
+					})
+				})
+			})
+		})
+
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
-			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
+			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil, nil})
 
 			Convey("Then AssembleStreamWindow panics", func() {
 				So(ps.AssembleStreamWindow, ShouldPanic)
@@ -185,5 +228,86 @@ func TestAssembleStreamWindow(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When selecting with a FROM (TUMBLING)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [TUMBLING 5 SECONDS]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, TumblingWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 5)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, UnspecifiedIntervalUnit)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (HOPPING)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [HOPPING 10 TUPLES, SLIDE 2 TUPLES, BUFFER SIZE 5]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, HoppingWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 10)
+				So(comp.Relations[0].Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Slide, ShouldResemble, IntervalAST{FloatLiteral{2}, Tuples})
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (SESSION GAP)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM d, count(*) FROM c [SESSION GAP 30 SECONDS] GROUP BY d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Window, ShouldEqual, SessionWindow)
+				So(comp.Relations[0].Value, ShouldEqual, 30)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
 	})
 }
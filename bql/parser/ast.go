@@ -28,6 +28,8 @@ type SelectStmt struct {
 	FilterAST
 	GroupingAST
 	HavingAST
+	OrderByAST
+	LimitAST
 }
 
 func (s SelectStmt) String() string {
@@ -37,6 +39,8 @@ func (s SelectStmt) String() string {
 	str = append(str, s.FilterAST.string())
 	str = append(str, s.GroupingAST.string())
 	str = append(str, s.HavingAST.string())
+	str = append(str, s.OrderByAST.string())
+	str = append(str, s.LimitAST.string())
 
 	st := []string{}
 	for _, s := range str {
@@ -60,15 +64,34 @@ func (s SelectUnionStmt) String() string {
 }
 
 type CreateStreamAsSelectStmt struct {
-	Name   StreamIdentifier
+	Name StreamIdentifier
+	ParallelismAST
 	Select SelectStmt
 }
 
 func (s CreateStreamAsSelectStmt) String() string {
-	str := []string{"CREATE", "STREAM", string(s.Name), "AS", s.Select.String()}
+	str := []string{"CREATE", "STREAM", string(s.Name)}
+	if p := s.ParallelismAST.string(); p != "" {
+		str = append(str, p)
+	}
+	str = append(str, "AS", s.Select.String())
 	return strings.Join(str, " ")
 }
 
+// ParallelismAST holds the number of goroutines processing the tuples
+// of a stream as given in a WITH PARALLELISM clause. It is 0 if there
+// is no such clause.
+type ParallelismAST struct {
+	Parallelism int64
+}
+
+func (p ParallelismAST) string() string {
+	if p.Parallelism == 0 {
+		return ""
+	}
+	return fmt.Sprintf("WITH PARALLELISM %v", p.Parallelism)
+}
+
 type CreateStreamAsSelectUnionStmt struct {
 	Name StreamIdentifier
 	SelectUnionStmt
@@ -201,13 +224,36 @@ func (s ResumeSourceStmt) String() string {
 
 type RewindSourceStmt struct {
 	Source StreamIdentifier
+	RewindPositionAST
 }
 
 func (s RewindSourceStmt) String() string {
 	str := []string{"REWIND", "SOURCE", string(s.Source)}
+	if pos := s.RewindPositionAST.string(); pos != "" {
+		str = append(str, pos)
+	}
 	return strings.Join(str, " ")
 }
 
+// RewindPositionAST holds the TO clause of a REWIND SOURCE statement.
+// If there is no TO clause, Type is UnspecifiedRewindPosition and the
+// source is rewound to the beginning of its stream.
+type RewindPositionAST struct {
+	Type      RewindPositionType
+	Offset    int64
+	Timestamp string
+}
+
+func (a RewindPositionAST) string() string {
+	switch a.Type {
+	case OffsetRewindPosition:
+		return fmt.Sprintf("TO OFFSET %v", a.Offset)
+	case TimestampRewindPosition:
+		return "TO TIMESTAMP " + StringLiteral{a.Timestamp}.String()
+	}
+	return ""
+}
+
 type DropSourceStmt struct {
 	Source StreamIdentifier
 }
@@ -316,6 +362,17 @@ func (s EvalStmt) String() string {
 	return strings.Join(str, " ")
 }
 
+// ExplainStmt is a statement to show how the given statement is executed.
+// Stmt is one of SelectStmt, SelectUnionStmt, CreateStreamAsSelectStmt, and
+// CreateStreamAsSelectUnionStmt.
+type ExplainStmt struct {
+	Stmt interface{}
+}
+
+func (s ExplainStmt) String() string {
+	return "EXPLAIN " + fmt.Sprint(s.Stmt)
+}
+
 type EmitterAST struct {
 	EmitterType    Emitter
 	EmitterOptions []interface{}
@@ -403,8 +460,13 @@ func (a AliasAST) String() string {
 	return a.Expr.String() + " AS " + a.Alias
 }
 
+// WindowedFromAST holds the relations of a FROM clause. If the
+// relations are combined using JOIN, Joins[i] holds the join type
+// and condition that is used to join Relations[i+1] with the
+// relations before it; otherwise Joins is empty.
 type WindowedFromAST struct {
 	Relations []AliasedStreamWindowAST
+	Joins     []JoinAST
 }
 
 func (a WindowedFromAST) string() string {
@@ -412,6 +474,14 @@ func (a WindowedFromAST) string() string {
 		return ""
 	}
 
+	if len(a.Joins) > 0 {
+		str := a.Relations[0].string()
+		for i, j := range a.Joins {
+			str += " " + j.string(a.Relations[i+1])
+		}
+		return "FROM " + str
+	}
+
 	str := []string{}
 	for _, r := range a.Relations {
 		str = append(str, r.string())
@@ -419,6 +489,19 @@ func (a WindowedFromAST) string() string {
 	return "FROM " + strings.Join(str, ", ")
 }
 
+type JoinAST struct {
+	Type JoinType
+	On   Expression
+}
+
+func (a JoinAST) string(rel AliasedStreamWindowAST) string {
+	str := "JOIN " + rel.string() + " ON " + a.On.String()
+	if a.Type == LeftJoin {
+		str = "LEFT " + str
+	}
+	return str
+}
+
 type AliasedStreamWindowAST struct {
 	StreamWindowAST
 	Alias string
@@ -434,15 +517,29 @@ func (a AliasedStreamWindowAST) string() string {
 
 const UnspecifiedCapacity int64 = -1
 
+// StreamWindowAST describes a window over a stream. IntervalAST holds
+// the size of the window and Slide holds the distance between the
+// starts of two consecutive windows, which is only used for HOPPING
+// windows. If Lateness is specified, tuples are processed in the
+// order of their timestamps instead of their arrival order.
 type StreamWindowAST struct {
 	Stream
+	Window WindowType
 	IntervalAST
+	Slide    IntervalAST
+	Lateness LatenessAST
 	Capacity int64
 	Shedding SheddingOption
 }
 
 func (a StreamWindowAST) string() string {
-	interval := a.IntervalAST.string()
+	interval := a.Window.String() + " " + a.IntervalAST.string()
+	if a.Slide.Unit != UnspecifiedIntervalUnit {
+		interval += ", SLIDE " + a.Slide.string()
+	}
+	if a.Lateness.Unit != UnspecifiedIntervalUnit {
+		interval += ", " + a.Lateness.string()
+	}
 	capacity := ""
 	if a.Capacity != UnspecifiedCapacity {
 		capacity = fmt.Sprintf(", BUFFER SIZE %d", a.Capacity)
@@ -463,6 +560,9 @@ func (a StreamWindowAST) string() string {
 			ps = append(ps, p.String())
 		}
 		return a.Stream.Name + "(" + strings.Join(ps, ", ") + ") " + suffix
+
+	case SubqueryStream:
+		return "(" + a.Stream.Subquery.String() + ") " + suffix
 	}
 
 	return "UnknownStreamType"
@@ -474,7 +574,7 @@ type IntervalAST struct {
 }
 
 func (a IntervalAST) string() string {
-	return "RANGE " + a.FloatLiteral.String() + " " + a.Unit.String()
+	return a.FloatLiteral.String() + " " + a.Unit.String()
 }
 
 type FilterAST struct {
@@ -515,6 +615,40 @@ func (a HavingAST) string() string {
 	return "HAVING " + a.Having.String()
 }
 
+type OrderByAST struct {
+	Ordering []SortedExpressionAST
+}
+
+func (a OrderByAST) string() string {
+	if len(a.Ordering) == 0 {
+		return ""
+	}
+	str := make([]string, len(a.Ordering))
+	for i, e := range a.Ordering {
+		str[i] = e.String()
+	}
+	return "ORDER BY " + strings.Join(str, ", ")
+}
+
+// LimitAST holds the LIMIT and OFFSET clauses of a SELECT statement.
+// If there is no LIMIT clause, HasLimit is false.
+type LimitAST struct {
+	HasLimit bool
+	Limit    int64
+	Offset   int64
+}
+
+func (a LimitAST) string() string {
+	str := []string{}
+	if a.HasLimit {
+		str = append(str, fmt.Sprintf("LIMIT %v", a.Limit))
+	}
+	if a.Offset != 0 {
+		str = append(str, fmt.Sprintf("OFFSET %v", a.Offset))
+	}
+	return strings.Join(str, " ")
+}
+
 type SourceSinkSpecsAST struct {
 	Params []SourceSinkParamAST
 }
@@ -795,6 +929,117 @@ func (s SortedExpressionAST) String() string {
 	return ret
 }
 
+// LambdaAST is an anonymous function such as `x -> x + 1` that can
+// be passed to higher-order functions like transform. References to
+// Param in Body are represented by LambdaParam.
+type LambdaAST struct {
+	Param string
+	Body  Expression
+}
+
+func (l LambdaAST) ReferencedRelations() map[string]bool {
+	return l.Body.ReferencedRelations()
+}
+
+func (l LambdaAST) RenameReferencedRelation(from, to string) Expression {
+	return LambdaAST{l.Param, l.Body.RenameReferencedRelation(from, to)}
+}
+
+func (l LambdaAST) Foldable() bool {
+	return false
+}
+
+func (l LambdaAST) String() string {
+	return l.Param + " -> " + l.Body.String()
+}
+
+// LambdaParam is a reference to the parameter of an enclosing
+// LambdaAST. Like the Column of a RowValue, Column starts with the
+// name of the parameter and may be followed by a JSON Path such as
+// `x.a[0]`.
+type LambdaParam struct {
+	Column string
+}
+
+func (lp LambdaParam) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (lp LambdaParam) RenameReferencedRelation(from, to string) Expression {
+	return lp
+}
+
+func (lp LambdaParam) Foldable() bool {
+	return false
+}
+
+func (lp LambdaParam) String() string {
+	return lp.Column
+}
+
+// bindLambdaParam replaces all RowValues in expr that refer to param
+// without a relation name by LambdaParams.
+func bindLambdaParam(expr Expression, param string) Expression {
+	bind := func(e Expression) Expression {
+		return bindLambdaParam(e, param)
+	}
+	switch e := expr.(type) {
+	case RowValue:
+		if e.Relation == "" && strings.HasPrefix(e.Column, param) {
+			if rest := e.Column[len(param):]; rest == "" ||
+				rest[0] == '.' || rest[0] == '[' {
+				return LambdaParam{e.Column}
+			}
+		}
+	case BinaryOpAST:
+		return BinaryOpAST{e.Op, bind(e.Left), bind(e.Right)}
+	case UnaryOpAST:
+		return UnaryOpAST{e.Op, bind(e.Expr)}
+	case TypeCastAST:
+		return TypeCastAST{bind(e.Expr), e.Target}
+	case FuncAppAST:
+		exprs := make([]Expression, len(e.Expressions))
+		for i, p := range e.Expressions {
+			exprs[i] = bind(p)
+		}
+		var ordering []SortedExpressionAST
+		for _, s := range e.Ordering {
+			ordering = append(ordering, SortedExpressionAST{bind(s.Expr), s.Ascending})
+		}
+		return FuncAppAST{e.Function, ExpressionsAST{exprs}, ordering}
+	case ArrayAST:
+		exprs := make([]Expression, len(e.Expressions))
+		for i, p := range e.Expressions {
+			exprs[i] = bind(p)
+		}
+		return ArrayAST{ExpressionsAST{exprs}}
+	case MapAST:
+		entries := make([]KeyValuePairAST, len(e.Entries))
+		for i, pair := range e.Entries {
+			entries[i] = KeyValuePairAST{pair.Key, bind(pair.Value)}
+		}
+		return MapAST{entries}
+	case ConditionCaseAST:
+		checks := make([]WhenThenPairAST, len(e.Checks))
+		for i, pair := range e.Checks {
+			checks[i] = WhenThenPairAST{bind(pair.When), bind(pair.Then)}
+		}
+		if e.Else != nil {
+			return ConditionCaseAST{checks, bind(e.Else)}
+		}
+		return ConditionCaseAST{checks, nil}
+	case ExpressionCaseAST:
+		return ExpressionCaseAST{bind(e.Expr),
+			bind(e.ConditionCaseAST).(ConditionCaseAST)}
+	case LambdaAST:
+		// the parameter is shadowed by an inner lambda with the same name
+		if e.Param != param {
+			return LambdaAST{e.Param, bind(e.Body)}
+		}
+	}
+	return expr
+}
+
 type ArrayAST struct {
 	ExpressionsAST
 }
@@ -905,16 +1150,18 @@ func (k KeyValuePairAST) string() string {
 
 // It seems not possible in Go to have a variable that says "this is
 // either struct A or struct B or struct C", so we build one struct
-// that serves both for "real" streams (as in `FROM x`) and stream-
-// generating functions (as in `FROM series(1, 5)`).
+// that serves for "real" streams (as in `FROM x`), stream-generating
+// functions (as in `FROM series(1, 5)`) and subqueries (as in
+// `FROM (SELECT ISTREAM ...)`). Subquery is only set for the latter.
 type Stream struct {
-	Type   StreamType
-	Name   string
-	Params []Expression
+	Type     StreamType
+	Name     string
+	Params   []Expression
+	Subquery *SelectStmt
 }
 
 func NewStream(s string) Stream {
-	return Stream{ActualStream, s, nil}
+	return Stream{ActualStream, s, nil, nil}
 }
 
 type Wildcard struct {
@@ -1370,6 +1617,7 @@ const (
 	UnknownStreamType StreamType = iota
 	ActualStream
 	UDSFStream
+	SubqueryStream
 )
 
 func (st StreamType) String() string {
@@ -1379,6 +1627,59 @@ func (st StreamType) String() string {
 		s = "ActualStream"
 	case UDSFStream:
 		s = "UDSFStream"
+	case SubqueryStream:
+		s = "SubqueryStream"
+	}
+	return s
+}
+
+// LatenessAST describes how long a tuple may arrive after tuples
+// with a later timestamp and what to do with tuples arriving even
+// later than that.
+type LatenessAST struct {
+	IntervalAST
+	Policy LateTuplePolicy
+}
+
+func (a LatenessAST) string() string {
+	s := "LATENESS " + a.IntervalAST.string()
+	if a.Policy != UnspecifiedLateTuplePolicy {
+		s += " " + a.Policy.String()
+	}
+	return s
+}
+
+type WindowType int
+
+const (
+	UnspecifiedWindowType WindowType = iota
+	// RangeWindow is a sliding window that is evaluated every time
+	// a tuple arrives.
+	RangeWindow
+	// TumblingWindow is a fixed-size window that does not overlap
+	// with the previous one and is evaluated once when it is closed.
+	TumblingWindow
+	// HoppingWindow is a fixed-size window that starts in fixed
+	// intervals (possibly overlapping with the previous one) and is
+	// evaluated once when it is closed.
+	HoppingWindow
+	// SessionWindow collects tuples (per group) until no tuple
+	// has arrived for a certain gap and is evaluated once when it
+	// is closed.
+	SessionWindow
+)
+
+func (w WindowType) String() string {
+	s := "UNSPECIFIED"
+	switch w {
+	case RangeWindow:
+		s = "RANGE"
+	case TumblingWindow:
+		s = "TUMBLING"
+	case HoppingWindow:
+		s = "HOPPING"
+	case SessionWindow:
+		s = "SESSION GAP"
 	}
 	return s
 }
@@ -1405,6 +1706,25 @@ func (i IntervalUnit) String() string {
 	return s
 }
 
+type RewindPositionType int
+
+const (
+	UnspecifiedRewindPosition RewindPositionType = iota
+	OffsetRewindPosition
+	TimestampRewindPosition
+)
+
+func (r RewindPositionType) String() string {
+	s := "UNSPECIFIED"
+	switch r {
+	case OffsetRewindPosition:
+		s = "OFFSET"
+	case TimestampRewindPosition:
+		s = "TIMESTAMP"
+	}
+	return s
+}
+
 type MetaInformation int
 
 const (
@@ -1464,6 +1784,25 @@ func (k BinaryKeyword) string(yes, no string) string {
 	return ""
 }
 
+type JoinType int
+
+const (
+	UnspecifiedJoinType JoinType = iota
+	InnerJoin
+	LeftJoin
+)
+
+func (t JoinType) String() string {
+	s := "UnspecifiedJoinType"
+	switch t {
+	case InnerJoin:
+		s = "INNER JOIN"
+	case LeftJoin:
+		s = "LEFT JOIN"
+	}
+	return s
+}
+
 type SheddingOption int
 
 const (
@@ -1486,6 +1825,32 @@ func (t SheddingOption) String() string {
 	return s
 }
 
+type LateTuplePolicy int
+
+const (
+	UnspecifiedLateTuplePolicy LateTuplePolicy = iota
+	// DropLate silently drops late tuples.
+	DropLate
+	// CorrectLate processes late tuples immediately, i.e., out
+	// of order, and emits the corrected results.
+	CorrectLate
+	// ReportLate reports late tuples as dropped tuples.
+	ReportLate
+)
+
+func (p LateTuplePolicy) String() string {
+	s := "UnspecifiedLateTuplePolicy"
+	switch p {
+	case DropLate:
+		s = "DROP LATE"
+	case CorrectLate:
+		s = "CORRECT LATE"
+	case ReportLate:
+		s = "REPORT LATE"
+	}
+	return s
+}
+
 type Type int
 
 const (
@@ -1498,6 +1863,7 @@ const (
 	Timestamp
 	Array
 	Map
+	Interval
 )
 
 func (t Type) String() string {
@@ -1519,6 +1885,8 @@ func (t Type) String() string {
 		s = "ARRAY"
 	case Map:
 		s = "MAP"
+	case Interval:
+		s = "INTERVAL"
 	}
 	return s
 }
@@ -1538,6 +1906,12 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	Like
+	NotLike
+	ILike
+	NotILike
+	SimilarTo
+	NotSimilarTo
 	Concat
 	Is
 	IsNot
@@ -1557,6 +1931,9 @@ func (op Operator) hasSamePrecedenceAs(rhs Operator) bool {
 	if Less <= op && op <= GreaterOrEqual && Less <= rhs && rhs <= GreaterOrEqual {
 		return true
 	}
+	if Like <= op && op <= NotSimilarTo && Like <= rhs && rhs <= NotSimilarTo {
+		return true
+	}
 	if Is <= op && op <= IsNot && Is <= rhs && rhs <= IsNot {
 		return true
 	}
@@ -1599,6 +1976,18 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case Like:
+		s = "LIKE"
+	case NotLike:
+		s = "NOT LIKE"
+	case ILike:
+		s = "ILIKE"
+	case NotILike:
+		s = "NOT ILIKE"
+	case SimilarTo:
+		s = "SIMILAR TO"
+	case NotSimilarTo:
+		s = "NOT SIMILAR TO"
 	case Concat:
 		s = "||"
 	case Is:
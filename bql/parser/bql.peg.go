@@ -26,6 +26,7 @@ const (
 	ruleSelectUnionStmt
 	ruleCreateStreamAsSelectStmt
 	ruleCreateStreamAsSelectUnionStmt
+	ruleParallelism
 	ruleCreateSourceStmt
 	ruleCreateSinkStmt
 	ruleCreateStateStmt
@@ -36,6 +37,9 @@ const (
 	rulePauseSourceStmt
 	ruleResumeSourceStmt
 	ruleRewindSourceStmt
+	ruleRewindPositionOpt
+	ruleRewindToOffset
+	ruleRewindToTimestamp
 	ruleDropSourceStmt
 	ruleDropStreamStmt
 	ruleDropSinkStmt
@@ -44,6 +48,7 @@ const (
 	ruleLoadStateOrCreateStmt
 	ruleSaveStateStmt
 	ruleEvalStmt
+	ruleExplainStmt
 	ruleEmitter
 	ruleEmitterOptions
 	ruleEmitterOptionCombinations
@@ -62,15 +67,28 @@ const (
 	ruleTimeInterval
 	ruleTuplesInterval
 	ruleRelations
+	ruleJoinedRelations
+	ruleJoinedRelation
+	ruleJoinTypeOpt
 	ruleFilter
 	ruleGrouping
 	ruleGroupList
 	ruleHaving
+	ruleOrderBy
+	ruleLimit
+	ruleOffset
 	ruleRelationLike
 	ruleAliasedStreamWindow
 	ruleStreamWindow
+	ruleWindowType
 	ruleStreamLike
+	ruleSubquery
 	ruleUDSFFuncApp
+	ruleSlideSpecOpt
+	ruleLatenessSpecOpt
+	ruleLatenessSpec
+	ruleLateTuplePolicyOpt
+	ruleLateTuplePolicy
 	ruleCapacitySpecOpt
 	ruleSheddingSpecOpt
 	ruleSheddingOption
@@ -103,6 +121,8 @@ const (
 	ruleFuncAppWithOrderBy
 	ruleFuncAppWithoutOrderBy
 	ruleFuncParams
+	ruleFuncParam
+	ruleLambda
 	ruleParamsOrder
 	ruleSortedExpression
 	ruleOrderDirectionOpt
@@ -115,6 +135,7 @@ const (
 	ruleWhenThenPair
 	ruleLiteral
 	ruleComparisonOp
+	rulePatternOp
 	ruleOtherOp
 	ruleIsOp
 	rulePlusMinusOp
@@ -137,10 +158,19 @@ const (
 	ruleISTREAM
 	ruleDSTREAM
 	ruleRSTREAM
+	ruleRANGE
+	ruleTUMBLING
+	ruleHOPPING
+	ruleSESSION
 	ruleTUPLES
 	ruleSECONDS
 	ruleMILLISECONDS
+	ruleINNER
+	ruleLEFT
 	ruleWait
+	ruleDropLate
+	ruleCorrectLate
+	ruleReportLate
 	ruleDropOldest
 	ruleDropNewest
 	ruleStreamIdentifier
@@ -157,6 +187,7 @@ const (
 	ruleString
 	ruleBlob
 	ruleTimestamp
+	ruleIntervalType
 	ruleArray
 	ruleMap
 	ruleOr
@@ -168,6 +199,12 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleLike
+	ruleNotLike
+	ruleILike
+	ruleNotILike
+	ruleSimilarTo
+	ruleNotSimilarTo
 	ruleConcat
 	ruleIs
 	ruleIsNot
@@ -335,6 +372,38 @@ const (
 	ruleAction131
 	ruleAction132
 	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
 
 	rulePre
 	ruleIn
@@ -355,6 +424,7 @@ var rul3s = [...]string{
 	"SelectUnionStmt",
 	"CreateStreamAsSelectStmt",
 	"CreateStreamAsSelectUnionStmt",
+	"Parallelism",
 	"CreateSourceStmt",
 	"CreateSinkStmt",
 	"CreateStateStmt",
@@ -365,6 +435,9 @@ var rul3s = [...]string{
 	"PauseSourceStmt",
 	"ResumeSourceStmt",
 	"RewindSourceStmt",
+	"RewindPositionOpt",
+	"RewindToOffset",
+	"RewindToTimestamp",
 	"DropSourceStmt",
 	"DropStreamStmt",
 	"DropSinkStmt",
@@ -373,6 +446,7 @@ var rul3s = [...]string{
 	"LoadStateOrCreateStmt",
 	"SaveStateStmt",
 	"EvalStmt",
+	"ExplainStmt",
 	"Emitter",
 	"EmitterOptions",
 	"EmitterOptionCombinations",
@@ -391,15 +465,28 @@ var rul3s = [...]string{
 	"TimeInterval",
 	"TuplesInterval",
 	"Relations",
+	"JoinedRelations",
+	"JoinedRelation",
+	"JoinTypeOpt",
 	"Filter",
 	"Grouping",
 	"GroupList",
 	"Having",
+	"OrderBy",
+	"Limit",
+	"Offset",
 	"RelationLike",
 	"AliasedStreamWindow",
 	"StreamWindow",
+	"WindowType",
 	"StreamLike",
+	"Subquery",
 	"UDSFFuncApp",
+	"SlideSpecOpt",
+	"LatenessSpecOpt",
+	"LatenessSpec",
+	"LateTuplePolicyOpt",
+	"LateTuplePolicy",
 	"CapacitySpecOpt",
 	"SheddingSpecOpt",
 	"SheddingOption",
@@ -432,6 +519,8 @@ var rul3s = [...]string{
 	"FuncAppWithOrderBy",
 	"FuncAppWithoutOrderBy",
 	"FuncParams",
+	"FuncParam",
+	"Lambda",
 	"ParamsOrder",
 	"SortedExpression",
 	"OrderDirectionOpt",
@@ -444,6 +533,7 @@ var rul3s = [...]string{
 	"WhenThenPair",
 	"Literal",
 	"ComparisonOp",
+	"PatternOp",
 	"OtherOp",
 	"IsOp",
 	"PlusMinusOp",
@@ -466,10 +556,19 @@ var rul3s = [...]string{
 	"ISTREAM",
 	"DSTREAM",
 	"RSTREAM",
+	"RANGE",
+	"TUMBLING",
+	"HOPPING",
+	"SESSION",
 	"TUPLES",
 	"SECONDS",
 	"MILLISECONDS",
+	"INNER",
+	"LEFT",
 	"Wait",
+	"DropLate",
+	"CorrectLate",
+	"ReportLate",
 	"DropOldest",
 	"DropNewest",
 	"StreamIdentifier",
@@ -486,6 +585,7 @@ var rul3s = [...]string{
 	"String",
 	"Blob",
 	"Timestamp",
+	"IntervalType",
 	"Array",
 	"Map",
 	"Or",
@@ -497,6 +597,12 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"Like",
+	"NotLike",
+	"ILike",
+	"NotILike",
+	"SimilarTo",
+	"NotSimilarTo",
 	"Concat",
 	"Is",
 	"IsNot",
@@ -664,6 +770,38 @@ var rul3s = [...]string{
 	"Action131",
 	"Action132",
 	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
 
 	"Pre_",
 	"_In_",
@@ -984,7 +1122,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [322]func() bool
+	rules  [391]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -1094,537 +1232,669 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction6:
 
-			p.AssembleCreateSource()
+			p.AssembleParallelism(begin, end)
 
 		case ruleAction7:
 
-			p.AssembleCreateSink()
+			p.AssembleCreateSource()
 
 		case ruleAction8:
 
-			p.AssembleCreateState()
+			p.AssembleCreateSink()
 
 		case ruleAction9:
 
-			p.AssembleUpdateState()
+			p.AssembleCreateState()
 
 		case ruleAction10:
 
-			p.AssembleUpdateSource()
+			p.AssembleUpdateState()
 
 		case ruleAction11:
 
-			p.AssembleUpdateSink()
+			p.AssembleUpdateSource()
 
 		case ruleAction12:
 
-			p.AssembleInsertIntoFrom()
+			p.AssembleUpdateSink()
 
 		case ruleAction13:
 
-			p.AssemblePauseSource()
+			p.AssembleInsertIntoFrom()
 
 		case ruleAction14:
 
-			p.AssembleResumeSource()
+			p.AssemblePauseSource()
 
 		case ruleAction15:
 
-			p.AssembleRewindSource()
+			p.AssembleResumeSource()
 
 		case ruleAction16:
 
-			p.AssembleDropSource()
+			p.AssembleRewindSource()
 
 		case ruleAction17:
 
-			p.AssembleDropStream()
+			p.EnsureRewindPosition(begin, end)
 
 		case ruleAction18:
 
-			p.AssembleDropSink()
+			p.AssembleRewindToOffset(begin, end)
 
 		case ruleAction19:
 
-			p.AssembleDropState()
+			p.AssembleRewindToTimestamp(begin, end)
 
 		case ruleAction20:
 
-			p.AssembleLoadState()
+			p.AssembleDropSource()
 
 		case ruleAction21:
 
-			p.AssembleLoadStateOrCreate()
+			p.AssembleDropStream()
 
 		case ruleAction22:
 
-			p.AssembleSaveState()
+			p.AssembleDropSink()
 
 		case ruleAction23:
 
-			p.AssembleEval(begin, end)
+			p.AssembleDropState()
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			p.AssembleLoadState()
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleLoadStateOrCreate()
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleSaveState()
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.AssembleEval(begin, end)
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.AssembleExplain()
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.AssembleEmitter()
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleEmitterLimit()
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction33:
 
-			// This is *always* executed, even if there is no
-			// FROM clause present in the statement.
-			p.AssembleWindowedFrom(begin, end)
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction34:
 
-			p.AssembleInterval()
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction35:
 
-			p.AssembleInterval()
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction36:
 
-			// This is *always* executed, even if there is no
-			// WHERE clause present in the statement.
-			p.AssembleFilter(begin, end)
+			p.AssembleProjections(begin, end)
 
 		case ruleAction37:
 
-			// This is *always* executed, even if there is no
-			// GROUP BY clause present in the statement.
-			p.AssembleGrouping(begin, end)
+			p.AssembleAlias()
 
 		case ruleAction38:
 
 			// This is *always* executed, even if there is no
-			// HAVING clause present in the statement.
-			p.AssembleHaving(begin, end)
+			// FROM clause present in the statement.
+			p.AssembleWindowedFrom(begin, end)
 
 		case ruleAction39:
 
-			p.EnsureAliasedStreamWindow()
+			p.AssembleInterval()
 
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			p.AssembleInterval()
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			p.AssembleJoin()
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			p.EnsureJoinType(begin, end)
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			// This is *always* executed, even if there is no
+			// WHERE clause present in the statement.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			// This is *always* executed, even if there is no
+			// GROUP BY clause present in the statement.
+			p.AssembleGrouping(begin, end)
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			// This is *always* executed, even if there is no
+			// HAVING clause present in the statement.
+			p.AssembleHaving(begin, end)
 
 		case ruleAction46:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			// This is *always* executed, even if there is no
+			// ORDER BY clause present in the statement.
+			p.AssembleOrderBy(begin, end)
 
 		case ruleAction47:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			// This is *always* executed, even if there is no
+			// LIMIT clause present in the statement.
+			p.AssembleLimit(begin, end)
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.AssembleOffset(begin, end)
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			p.AssembleStreamWindow()
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			p.AssembleSubquery(begin, end)
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureSlideSpec(begin, end)
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureLatenessSpec(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleLateness()
 
 		case ruleAction57:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureLateTuplePolicy(begin, end)
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction59:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction61:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSourceSinkParam()
 
 		case ruleAction65:
 
-			p.AssembleFuncApp()
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction66:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.AssembleMap(begin, end)
 
 		case ruleAction67:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleKeyValuePair()
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction69:
 
-			p.AssembleSortedExpression()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction70:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction71:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction72:
 
-			p.AssembleMap(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction73:
 
-			p.AssembleKeyValuePair()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction74:
 
-			p.AssembleConditionCase(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction75:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction76:
 
-			p.AssembleWhenThenPair()
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction77:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction78:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleFuncApp()
 
 		case ruleAction81:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			p.AssembleLambda()
 
 		case ruleAction84:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction85:
 
-			p.PushComponent(begin, end, NewMissing())
+			p.AssembleSortedExpression()
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction88:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.AssembleMap(begin, end)
 
 		case ruleAction89:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.AssembleKeyValuePair()
 
 		case ruleAction90:
 
-			p.PushComponent(begin, end, Istream)
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction91:
 
-			p.PushComponent(begin, end, Dstream)
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Rstream)
+			p.AssembleWhenThenPair()
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Tuples)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Seconds)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Milliseconds)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowValue(substr))
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Wait)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, DropOldest)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, DropNewest)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction99:
 
 			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction100:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction101:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction102:
 
-			p.PushComponent(begin, end, Yes)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction103:
 
-			p.PushComponent(begin, end, No)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Bool)
+			p.PushComponent(begin, end, Istream)
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, Int)
+			p.PushComponent(begin, end, Dstream)
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Float)
+			p.PushComponent(begin, end, Rstream)
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, String)
+			p.PushComponent(begin, end, RangeWindow)
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Blob)
+			p.PushComponent(begin, end, TumblingWindow)
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, Timestamp)
+			p.PushComponent(begin, end, HoppingWindow)
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Array)
+			p.PushComponent(begin, end, SessionWindow)
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Map)
+			p.PushComponent(begin, end, Tuples)
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Or)
+			p.PushComponent(begin, end, Seconds)
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, And)
+			p.PushComponent(begin, end, Milliseconds)
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Not)
+			p.PushComponent(begin, end, InnerJoin)
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, Equal)
+			p.PushComponent(begin, end, LeftJoin)
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Less)
+			p.PushComponent(begin, end, Wait)
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			p.PushComponent(begin, end, DropLate)
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Greater)
+			p.PushComponent(begin, end, CorrectLate)
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			p.PushComponent(begin, end, ReportLate)
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, NotEqual)
+			p.PushComponent(begin, end, DropOldest)
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, Concat)
+			p.PushComponent(begin, end, DropNewest)
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, Is)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, StreamIdentifier(substr))
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, IsNot)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Plus)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, Minus)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Multiply)
+			p.PushComponent(begin, end, No)
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Divide)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, No)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, Bool)
 
 		case ruleAction132:
 
+			p.PushComponent(begin, end, Int)
+
+		case ruleAction133:
+
+			p.PushComponent(begin, end, Float)
+
+		case ruleAction134:
+
+			p.PushComponent(begin, end, String)
+
+		case ruleAction135:
+
+			p.PushComponent(begin, end, Blob)
+
+		case ruleAction136:
+
+			p.PushComponent(begin, end, Timestamp)
+
+		case ruleAction137:
+
+			p.PushComponent(begin, end, Interval)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, Array)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, Map)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, Or)
+
+		case ruleAction141:
+
+			p.PushComponent(begin, end, And)
+
+		case ruleAction142:
+
+			p.PushComponent(begin, end, Not)
+
+		case ruleAction143:
+
+			p.PushComponent(begin, end, Equal)
+
+		case ruleAction144:
+
+			p.PushComponent(begin, end, Less)
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, LessOrEqual)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, Greater)
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, GreaterOrEqual)
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, NotEqual)
+
+		case ruleAction149:
+
+			p.PushComponent(begin, end, Like)
+
+		case ruleAction150:
+
+			p.PushComponent(begin, end, NotLike)
+
+		case ruleAction151:
+
+			p.PushComponent(begin, end, ILike)
+
+		case ruleAction152:
+
+			p.PushComponent(begin, end, NotILike)
+
+		case ruleAction153:
+
+			p.PushComponent(begin, end, SimilarTo)
+
+		case ruleAction154:
+
+			p.PushComponent(begin, end, NotSimilarTo)
+
+		case ruleAction155:
+
+			p.PushComponent(begin, end, Concat)
+
+		case ruleAction156:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction157:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction158:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction159:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction160:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction161:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction162:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction163:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction164:
+
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
 
-		case ruleAction133:
+		case ruleAction165:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
@@ -1813,7 +2083,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex, depth = position10, tokenIndex10, depth10
 			return false
 		},
-		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt / ExplainStmt)> */
 		func() bool {
 			position13, tokenIndex13, depth13 := position, tokenIndex, depth
 			{
@@ -1858,6 +2128,12 @@ func (p *bqlPegBackend) Init() {
 				l21:
 					position, tokenIndex, depth = position15, tokenIndex15, depth15
 					if !_rules[ruleEvalStmt]() {
+						goto l22
+					}
+					goto l15
+				l22:
+					position, tokenIndex, depth = position15, tokenIndex15, depth15
+					if !_rules[ruleExplainStmt]() {
 						goto l13
 					}
 				}
@@ -1872,1696 +2148,1682 @@ func (p *bqlPegBackend) Init() {
 		},
 		/* 4 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
 		func() bool {
-			position22, tokenIndex22, depth22 := position, tokenIndex, depth
+			position23, tokenIndex23, depth23 := position, tokenIndex, depth
 			{
-				position23 := position
+				position24 := position
 				depth++
 				{
-					position24, tokenIndex24, depth24 := position, tokenIndex, depth
+					position25, tokenIndex25, depth25 := position, tokenIndex, depth
 					if !_rules[ruleCreateSourceStmt]() {
-						goto l25
-					}
-					goto l24
-				l25:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleUpdateSourceStmt]() {
 						goto l26
 					}
-					goto l24
+					goto l25
 				l26:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleDropSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleUpdateSourceStmt]() {
 						goto l27
 					}
-					goto l24
+					goto l25
 				l27:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[rulePauseSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleDropSourceStmt]() {
 						goto l28
 					}
-					goto l24
+					goto l25
 				l28:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
-					if !_rules[ruleResumeSourceStmt]() {
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[rulePauseSourceStmt]() {
 						goto l29
 					}
-					goto l24
+					goto l25
 				l29:
-					position, tokenIndex, depth = position24, tokenIndex24, depth24
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
+					if !_rules[ruleResumeSourceStmt]() {
+						goto l30
+					}
+					goto l25
+				l30:
+					position, tokenIndex, depth = position25, tokenIndex25, depth25
 					if !_rules[ruleRewindSourceStmt]() {
-						goto l22
+						goto l23
 					}
 				}
-			l24:
+			l25:
 				depth--
-				add(ruleSourceStmt, position23)
+				add(ruleSourceStmt, position24)
 			}
 			return true
-		l22:
-			position, tokenIndex, depth = position22, tokenIndex22, depth22
+		l23:
+			position, tokenIndex, depth = position23, tokenIndex23, depth23
 			return false
 		},
 		/* 5 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
 		func() bool {
-			position30, tokenIndex30, depth30 := position, tokenIndex, depth
+			position31, tokenIndex31, depth31 := position, tokenIndex, depth
 			{
-				position31 := position
+				position32 := position
 				depth++
 				{
-					position32, tokenIndex32, depth32 := position, tokenIndex, depth
+					position33, tokenIndex33, depth33 := position, tokenIndex, depth
 					if !_rules[ruleCreateSinkStmt]() {
-						goto l33
-					}
-					goto l32
-				l33:
-					position, tokenIndex, depth = position32, tokenIndex32, depth32
-					if !_rules[ruleUpdateSinkStmt]() {
 						goto l34
 					}
-					goto l32
+					goto l33
 				l34:
-					position, tokenIndex, depth = position32, tokenIndex32, depth32
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
+					if !_rules[ruleUpdateSinkStmt]() {
+						goto l35
+					}
+					goto l33
+				l35:
+					position, tokenIndex, depth = position33, tokenIndex33, depth33
 					if !_rules[ruleDropSinkStmt]() {
-						goto l30
+						goto l31
 					}
 				}
-			l32:
+			l33:
 				depth--
-				add(ruleSinkStmt, position31)
+				add(ruleSinkStmt, position32)
 			}
 			return true
-		l30:
-			position, tokenIndex, depth = position30, tokenIndex30, depth30
+		l31:
+			position, tokenIndex, depth = position31, tokenIndex31, depth31
 			return false
 		},
 		/* 6 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
 		func() bool {
-			position35, tokenIndex35, depth35 := position, tokenIndex, depth
+			position36, tokenIndex36, depth36 := position, tokenIndex, depth
 			{
-				position36 := position
+				position37 := position
 				depth++
 				{
-					position37, tokenIndex37, depth37 := position, tokenIndex, depth
+					position38, tokenIndex38, depth38 := position, tokenIndex, depth
 					if !_rules[ruleCreateStateStmt]() {
-						goto l38
-					}
-					goto l37
-				l38:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleUpdateStateStmt]() {
 						goto l39
 					}
-					goto l37
+					goto l38
 				l39:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleDropStateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleUpdateStateStmt]() {
 						goto l40
 					}
-					goto l37
+					goto l38
 				l40:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleLoadStateOrCreateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleDropStateStmt]() {
 						goto l41
 					}
-					goto l37
+					goto l38
 				l41:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
-					if !_rules[ruleLoadStateStmt]() {
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleLoadStateOrCreateStmt]() {
 						goto l42
 					}
-					goto l37
+					goto l38
 				l42:
-					position, tokenIndex, depth = position37, tokenIndex37, depth37
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
+					if !_rules[ruleLoadStateStmt]() {
+						goto l43
+					}
+					goto l38
+				l43:
+					position, tokenIndex, depth = position38, tokenIndex38, depth38
 					if !_rules[ruleSaveStateStmt]() {
-						goto l35
+						goto l36
 					}
 				}
-			l37:
+			l38:
 				depth--
-				add(ruleStateStmt, position36)
+				add(ruleStateStmt, position37)
 			}
 			return true
-		l35:
-			position, tokenIndex, depth = position35, tokenIndex35, depth35
+		l36:
+			position, tokenIndex, depth = position36, tokenIndex36, depth36
 			return false
 		},
 		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
 		func() bool {
-			position43, tokenIndex43, depth43 := position, tokenIndex, depth
+			position44, tokenIndex44, depth44 := position, tokenIndex, depth
 			{
-				position44 := position
+				position45 := position
 				depth++
 				{
-					position45, tokenIndex45, depth45 := position, tokenIndex, depth
+					position46, tokenIndex46, depth46 := position, tokenIndex, depth
 					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
-						goto l46
-					}
-					goto l45
-				l46:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
-					if !_rules[ruleCreateStreamAsSelectStmt]() {
 						goto l47
 					}
-					goto l45
+					goto l46
 				l47:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
-					if !_rules[ruleDropStreamStmt]() {
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
 						goto l48
 					}
-					goto l45
+					goto l46
 				l48:
-					position, tokenIndex, depth = position45, tokenIndex45, depth45
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
+					if !_rules[ruleDropStreamStmt]() {
+						goto l49
+					}
+					goto l46
+				l49:
+					position, tokenIndex, depth = position46, tokenIndex46, depth46
 					if !_rules[ruleInsertIntoFromStmt]() {
-						goto l43
+						goto l44
 					}
 				}
-			l45:
+			l46:
 				depth--
-				add(ruleStreamStmt, position44)
+				add(ruleStreamStmt, position45)
 			}
 			return true
-		l43:
-			position, tokenIndex, depth = position43, tokenIndex43, depth43
+		l44:
+			position, tokenIndex, depth = position44, tokenIndex44, depth44
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having OrderBy Limit Offset Action2)> */
 		func() bool {
-			position49, tokenIndex49, depth49 := position, tokenIndex, depth
+			position50, tokenIndex50, depth50 := position, tokenIndex, depth
 			{
-				position50 := position
+				position51 := position
 				depth++
 				{
-					position51, tokenIndex51, depth51 := position, tokenIndex, depth
+					position52, tokenIndex52, depth52 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l52
+						goto l53
 					}
 					position++
-					goto l51
-				l52:
-					position, tokenIndex, depth = position51, tokenIndex51, depth51
+					goto l52
+				l53:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
 					if buffer[position] != rune('S') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l51:
+			l52:
 				{
-					position53, tokenIndex53, depth53 := position, tokenIndex, depth
+					position54, tokenIndex54, depth54 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l54
+						goto l55
 					}
 					position++
-					goto l53
-				l54:
-					position, tokenIndex, depth = position53, tokenIndex53, depth53
+					goto l54
+				l55:
+					position, tokenIndex, depth = position54, tokenIndex54, depth54
 					if buffer[position] != rune('E') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l53:
+			l54:
 				{
-					position55, tokenIndex55, depth55 := position, tokenIndex, depth
+					position56, tokenIndex56, depth56 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l56
+						goto l57
 					}
 					position++
-					goto l55
-				l56:
-					position, tokenIndex, depth = position55, tokenIndex55, depth55
+					goto l56
+				l57:
+					position, tokenIndex, depth = position56, tokenIndex56, depth56
 					if buffer[position] != rune('L') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l55:
+			l56:
 				{
-					position57, tokenIndex57, depth57 := position, tokenIndex, depth
+					position58, tokenIndex58, depth58 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l58
+						goto l59
 					}
 					position++
-					goto l57
-				l58:
-					position, tokenIndex, depth = position57, tokenIndex57, depth57
+					goto l58
+				l59:
+					position, tokenIndex, depth = position58, tokenIndex58, depth58
 					if buffer[position] != rune('E') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l57:
+			l58:
 				{
-					position59, tokenIndex59, depth59 := position, tokenIndex, depth
+					position60, tokenIndex60, depth60 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l60
+						goto l61
 					}
 					position++
-					goto l59
-				l60:
-					position, tokenIndex, depth = position59, tokenIndex59, depth59
+					goto l60
+				l61:
+					position, tokenIndex, depth = position60, tokenIndex60, depth60
 					if buffer[position] != rune('C') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l59:
+			l60:
 				{
-					position61, tokenIndex61, depth61 := position, tokenIndex, depth
+					position62, tokenIndex62, depth62 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l62
+						goto l63
 					}
 					position++
-					goto l61
-				l62:
-					position, tokenIndex, depth = position61, tokenIndex61, depth61
+					goto l62
+				l63:
+					position, tokenIndex, depth = position62, tokenIndex62, depth62
 					if buffer[position] != rune('T') {
-						goto l49
+						goto l50
 					}
 					position++
 				}
-			l61:
+			l62:
 				if !_rules[ruleEmitter]() {
-					goto l49
+					goto l50
 				}
 				if !_rules[ruleProjections]() {
-					goto l49
+					goto l50
 				}
 				if !_rules[ruleWindowedFrom]() {
-					goto l49
+					goto l50
 				}
 				if !_rules[ruleFilter]() {
-					goto l49
+					goto l50
 				}
 				if !_rules[ruleGrouping]() {
-					goto l49
+					goto l50
 				}
 				if !_rules[ruleHaving]() {
-					goto l49
+					goto l50
+				}
+				if !_rules[ruleOrderBy]() {
+					goto l50
+				}
+				if !_rules[ruleLimit]() {
+					goto l50
+				}
+				if !_rules[ruleOffset]() {
+					goto l50
 				}
 				if !_rules[ruleAction2]() {
-					goto l49
+					goto l50
 				}
 				depth--
-				add(ruleSelectStmt, position50)
+				add(ruleSelectStmt, position51)
 			}
 			return true
-		l49:
-			position, tokenIndex, depth = position49, tokenIndex49, depth49
+		l50:
+			position, tokenIndex, depth = position50, tokenIndex50, depth50
 			return false
 		},
 		/* 9 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
 		func() bool {
-			position63, tokenIndex63, depth63 := position, tokenIndex, depth
+			position64, tokenIndex64, depth64 := position, tokenIndex, depth
 			{
-				position64 := position
+				position65 := position
 				depth++
 				{
-					position65 := position
+					position66 := position
 					depth++
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l64
 					}
 					if !_rules[rulesp]() {
-						goto l63
+						goto l64
 					}
 					{
-						position68, tokenIndex68, depth68 := position, tokenIndex, depth
+						position69, tokenIndex69, depth69 := position, tokenIndex, depth
 						if buffer[position] != rune('u') {
-							goto l69
+							goto l70
 						}
 						position++
-						goto l68
-					l69:
-						position, tokenIndex, depth = position68, tokenIndex68, depth68
+						goto l69
+					l70:
+						position, tokenIndex, depth = position69, tokenIndex69, depth69
 						if buffer[position] != rune('U') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l68:
+				l69:
 					{
-						position70, tokenIndex70, depth70 := position, tokenIndex, depth
+						position71, tokenIndex71, depth71 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l71
+							goto l72
 						}
 						position++
-						goto l70
-					l71:
-						position, tokenIndex, depth = position70, tokenIndex70, depth70
+						goto l71
+					l72:
+						position, tokenIndex, depth = position71, tokenIndex71, depth71
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l70:
+				l71:
 					{
-						position72, tokenIndex72, depth72 := position, tokenIndex, depth
+						position73, tokenIndex73, depth73 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l73
+							goto l74
 						}
 						position++
-						goto l72
-					l73:
-						position, tokenIndex, depth = position72, tokenIndex72, depth72
+						goto l73
+					l74:
+						position, tokenIndex, depth = position73, tokenIndex73, depth73
 						if buffer[position] != rune('I') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l72:
+				l73:
 					{
-						position74, tokenIndex74, depth74 := position, tokenIndex, depth
+						position75, tokenIndex75, depth75 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l75
+							goto l76
 						}
 						position++
-						goto l74
-					l75:
-						position, tokenIndex, depth = position74, tokenIndex74, depth74
+						goto l75
+					l76:
+						position, tokenIndex, depth = position75, tokenIndex75, depth75
 						if buffer[position] != rune('O') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l74:
+				l75:
 					{
-						position76, tokenIndex76, depth76 := position, tokenIndex, depth
+						position77, tokenIndex77, depth77 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l77
+							goto l78
 						}
 						position++
-						goto l76
-					l77:
-						position, tokenIndex, depth = position76, tokenIndex76, depth76
+						goto l77
+					l78:
+						position, tokenIndex, depth = position77, tokenIndex77, depth77
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l76:
+				l77:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l64
 					}
 					{
-						position78, tokenIndex78, depth78 := position, tokenIndex, depth
+						position79, tokenIndex79, depth79 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l79
+							goto l80
 						}
 						position++
-						goto l78
-					l79:
-						position, tokenIndex, depth = position78, tokenIndex78, depth78
+						goto l79
+					l80:
+						position, tokenIndex, depth = position79, tokenIndex79, depth79
 						if buffer[position] != rune('A') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l78:
+				l79:
 					{
-						position80, tokenIndex80, depth80 := position, tokenIndex, depth
+						position81, tokenIndex81, depth81 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l81
+							goto l82
 						}
 						position++
-						goto l80
-					l81:
-						position, tokenIndex, depth = position80, tokenIndex80, depth80
+						goto l81
+					l82:
+						position, tokenIndex, depth = position81, tokenIndex81, depth81
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l80:
+				l81:
 					{
-						position82, tokenIndex82, depth82 := position, tokenIndex, depth
+						position83, tokenIndex83, depth83 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l83
+							goto l84
 						}
 						position++
-						goto l82
-					l83:
-						position, tokenIndex, depth = position82, tokenIndex82, depth82
+						goto l83
+					l84:
+						position, tokenIndex, depth = position83, tokenIndex83, depth83
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l64
 						}
 						position++
 					}
-				l82:
+				l83:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l64
 					}
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l64
 					}
-				l66:
+				l67:
 					{
-						position67, tokenIndex67, depth67 := position, tokenIndex, depth
+						position68, tokenIndex68, depth68 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l67
+							goto l68
 						}
 						{
-							position84, tokenIndex84, depth84 := position, tokenIndex, depth
+							position85, tokenIndex85, depth85 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l85
+								goto l86
 							}
 							position++
-							goto l84
-						l85:
-							position, tokenIndex, depth = position84, tokenIndex84, depth84
+							goto l85
+						l86:
+							position, tokenIndex, depth = position85, tokenIndex85, depth85
 							if buffer[position] != rune('U') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l84:
+					l85:
 						{
-							position86, tokenIndex86, depth86 := position, tokenIndex, depth
+							position87, tokenIndex87, depth87 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l87
+								goto l88
 							}
 							position++
-							goto l86
-						l87:
-							position, tokenIndex, depth = position86, tokenIndex86, depth86
+							goto l87
+						l88:
+							position, tokenIndex, depth = position87, tokenIndex87, depth87
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l86:
+					l87:
 						{
-							position88, tokenIndex88, depth88 := position, tokenIndex, depth
+							position89, tokenIndex89, depth89 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l89
+								goto l90
 							}
 							position++
-							goto l88
-						l89:
-							position, tokenIndex, depth = position88, tokenIndex88, depth88
+							goto l89
+						l90:
+							position, tokenIndex, depth = position89, tokenIndex89, depth89
 							if buffer[position] != rune('I') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l88:
+					l89:
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position91, tokenIndex91, depth91 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l91
+								goto l92
 							}
 							position++
-							goto l90
-						l91:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+							goto l91
+						l92:
+							position, tokenIndex, depth = position91, tokenIndex91, depth91
 							if buffer[position] != rune('O') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l90:
+					l91:
 						{
-							position92, tokenIndex92, depth92 := position, tokenIndex, depth
+							position93, tokenIndex93, depth93 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l93
+								goto l94
 							}
 							position++
-							goto l92
-						l93:
-							position, tokenIndex, depth = position92, tokenIndex92, depth92
+							goto l93
+						l94:
+							position, tokenIndex, depth = position93, tokenIndex93, depth93
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l92:
+					l93:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l68
 						}
 						{
-							position94, tokenIndex94, depth94 := position, tokenIndex, depth
+							position95, tokenIndex95, depth95 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l95
+								goto l96
 							}
 							position++
-							goto l94
-						l95:
-							position, tokenIndex, depth = position94, tokenIndex94, depth94
+							goto l95
+						l96:
+							position, tokenIndex, depth = position95, tokenIndex95, depth95
 							if buffer[position] != rune('A') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l94:
+					l95:
 						{
-							position96, tokenIndex96, depth96 := position, tokenIndex, depth
+							position97, tokenIndex97, depth97 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l97
+								goto l98
 							}
 							position++
-							goto l96
-						l97:
-							position, tokenIndex, depth = position96, tokenIndex96, depth96
+							goto l97
+						l98:
+							position, tokenIndex, depth = position97, tokenIndex97, depth97
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l96:
+					l97:
 						{
-							position98, tokenIndex98, depth98 := position, tokenIndex, depth
+							position99, tokenIndex99, depth99 := position, tokenIndex, depth
 							if buffer[position] != rune('l') {
-								goto l99
+								goto l100
 							}
 							position++
-							goto l98
-						l99:
-							position, tokenIndex, depth = position98, tokenIndex98, depth98
+							goto l99
+						l100:
+							position, tokenIndex, depth = position99, tokenIndex99, depth99
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l68
 							}
 							position++
 						}
-					l98:
+					l99:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l68
 						}
 						if !_rules[ruleSelectStmt]() {
-							goto l67
+							goto l68
 						}
-						goto l66
-					l67:
-						position, tokenIndex, depth = position67, tokenIndex67, depth67
+						goto l67
+					l68:
+						position, tokenIndex, depth = position68, tokenIndex68, depth68
 					}
 					depth--
-					add(rulePegText, position65)
+					add(rulePegText, position66)
 				}
 				if !_rules[ruleAction3]() {
-					goto l63
+					goto l64
 				}
 				depth--
-				add(ruleSelectUnionStmt, position64)
+				add(ruleSelectUnionStmt, position65)
 			}
 			return true
-		l63:
-			position, tokenIndex, depth = position63, tokenIndex63, depth63
+		l64:
+			position, tokenIndex, depth = position64, tokenIndex64, depth64
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp Parallelism (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
 		func() bool {
-			position100, tokenIndex100, depth100 := position, tokenIndex, depth
+			position101, tokenIndex101, depth101 := position, tokenIndex, depth
 			{
-				position101 := position
+				position102 := position
 				depth++
 				{
-					position102, tokenIndex102, depth102 := position, tokenIndex, depth
+					position103, tokenIndex103, depth103 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l103
+						goto l104
 					}
 					position++
-					goto l102
-				l103:
-					position, tokenIndex, depth = position102, tokenIndex102, depth102
+					goto l103
+				l104:
+					position, tokenIndex, depth = position103, tokenIndex103, depth103
 					if buffer[position] != rune('C') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l102:
+			l103:
 				{
-					position104, tokenIndex104, depth104 := position, tokenIndex, depth
+					position105, tokenIndex105, depth105 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l105
+						goto l106
 					}
 					position++
-					goto l104
-				l105:
-					position, tokenIndex, depth = position104, tokenIndex104, depth104
+					goto l105
+				l106:
+					position, tokenIndex, depth = position105, tokenIndex105, depth105
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l104:
+			l105:
 				{
-					position106, tokenIndex106, depth106 := position, tokenIndex, depth
+					position107, tokenIndex107, depth107 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l107
+						goto l108
 					}
 					position++
-					goto l106
-				l107:
-					position, tokenIndex, depth = position106, tokenIndex106, depth106
+					goto l107
+				l108:
+					position, tokenIndex, depth = position107, tokenIndex107, depth107
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l106:
+			l107:
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position109, tokenIndex109, depth109 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l109
+						goto l110
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l109
+				l110:
+					position, tokenIndex, depth = position109, tokenIndex109, depth109
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l108:
+			l109:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position111, tokenIndex111, depth111 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l111
+						goto l112
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l111
+				l112:
+					position, tokenIndex, depth = position111, tokenIndex111, depth111
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l110:
+			l111:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position113, tokenIndex113, depth113 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l113
+						goto l114
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l113
+				l114:
+					position, tokenIndex, depth = position113, tokenIndex113, depth113
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l112:
+			l113:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l101
 				}
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position115, tokenIndex115, depth115 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l115
+						goto l116
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l115
+				l116:
+					position, tokenIndex, depth = position115, tokenIndex115, depth115
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l114:
+			l115:
 				{
-					position116, tokenIndex116, depth116 := position, tokenIndex, depth
+					position117, tokenIndex117, depth117 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l117
+						goto l118
 					}
 					position++
-					goto l116
-				l117:
-					position, tokenIndex, depth = position116, tokenIndex116, depth116
+					goto l117
+				l118:
+					position, tokenIndex, depth = position117, tokenIndex117, depth117
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l116:
+			l117:
 				{
-					position118, tokenIndex118, depth118 := position, tokenIndex, depth
+					position119, tokenIndex119, depth119 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l119
+						goto l120
 					}
 					position++
-					goto l118
-				l119:
-					position, tokenIndex, depth = position118, tokenIndex118, depth118
+					goto l119
+				l120:
+					position, tokenIndex, depth = position119, tokenIndex119, depth119
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l118:
+			l119:
 				{
-					position120, tokenIndex120, depth120 := position, tokenIndex, depth
+					position121, tokenIndex121, depth121 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l121
+						goto l122
 					}
 					position++
-					goto l120
-				l121:
-					position, tokenIndex, depth = position120, tokenIndex120, depth120
+					goto l121
+				l122:
+					position, tokenIndex, depth = position121, tokenIndex121, depth121
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l120:
+			l121:
 				{
-					position122, tokenIndex122, depth122 := position, tokenIndex, depth
+					position123, tokenIndex123, depth123 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l123
+						goto l124
 					}
 					position++
-					goto l122
-				l123:
-					position, tokenIndex, depth = position122, tokenIndex122, depth122
+					goto l123
+				l124:
+					position, tokenIndex, depth = position123, tokenIndex123, depth123
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l122:
+			l123:
 				{
-					position124, tokenIndex124, depth124 := position, tokenIndex, depth
+					position125, tokenIndex125, depth125 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l125
+						goto l126
 					}
 					position++
-					goto l124
-				l125:
-					position, tokenIndex, depth = position124, tokenIndex124, depth124
+					goto l125
+				l126:
+					position, tokenIndex, depth = position125, tokenIndex125, depth125
 					if buffer[position] != rune('M') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l124:
+			l125:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l101
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l100
+					goto l101
 				}
 				if !_rules[rulesp]() {
-					goto l100
+					goto l101
+				}
+				if !_rules[ruleParallelism]() {
+					goto l101
 				}
 				{
-					position126, tokenIndex126, depth126 := position, tokenIndex, depth
+					position127, tokenIndex127, depth127 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l127
+						goto l128
 					}
 					position++
-					goto l126
-				l127:
-					position, tokenIndex, depth = position126, tokenIndex126, depth126
+					goto l127
+				l128:
+					position, tokenIndex, depth = position127, tokenIndex127, depth127
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l126:
+			l127:
 				{
-					position128, tokenIndex128, depth128 := position, tokenIndex, depth
+					position129, tokenIndex129, depth129 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l129
+						goto l130
 					}
 					position++
-					goto l128
-				l129:
-					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					goto l129
+				l130:
+					position, tokenIndex, depth = position129, tokenIndex129, depth129
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l101
 					}
 					position++
 				}
-			l128:
+			l129:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l101
 				}
 				if !_rules[ruleSelectStmt]() {
-					goto l100
+					goto l101
 				}
 				if !_rules[ruleAction4]() {
-					goto l100
+					goto l101
 				}
 				depth--
-				add(ruleCreateStreamAsSelectStmt, position101)
+				add(ruleCreateStreamAsSelectStmt, position102)
 			}
 			return true
-		l100:
-			position, tokenIndex, depth = position100, tokenIndex100, depth100
+		l101:
+			position, tokenIndex, depth = position101, tokenIndex101, depth101
 			return false
 		},
 		/* 11 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
 		func() bool {
-			position130, tokenIndex130, depth130 := position, tokenIndex, depth
+			position131, tokenIndex131, depth131 := position, tokenIndex, depth
 			{
-				position131 := position
+				position132 := position
 				depth++
 				{
-					position132, tokenIndex132, depth132 := position, tokenIndex, depth
+					position133, tokenIndex133, depth133 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l133
+						goto l134
 					}
 					position++
-					goto l132
-				l133:
-					position, tokenIndex, depth = position132, tokenIndex132, depth132
+					goto l133
+				l134:
+					position, tokenIndex, depth = position133, tokenIndex133, depth133
 					if buffer[position] != rune('C') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l132:
+			l133:
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position135, tokenIndex135, depth135 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l135
+						goto l136
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l135
+				l136:
+					position, tokenIndex, depth = position135, tokenIndex135, depth135
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l134:
+			l135:
 				{
-					position136, tokenIndex136, depth136 := position, tokenIndex, depth
+					position137, tokenIndex137, depth137 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l137
+						goto l138
 					}
 					position++
-					goto l136
-				l137:
-					position, tokenIndex, depth = position136, tokenIndex136, depth136
+					goto l137
+				l138:
+					position, tokenIndex, depth = position137, tokenIndex137, depth137
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l136:
+			l137:
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position139, tokenIndex139, depth139 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l139
+						goto l140
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l139
+				l140:
+					position, tokenIndex, depth = position139, tokenIndex139, depth139
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l138:
+			l139:
 				{
-					position140, tokenIndex140, depth140 := position, tokenIndex, depth
+					position141, tokenIndex141, depth141 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l141
+						goto l142
 					}
 					position++
-					goto l140
-				l141:
-					position, tokenIndex, depth = position140, tokenIndex140, depth140
+					goto l141
+				l142:
+					position, tokenIndex, depth = position141, tokenIndex141, depth141
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l140:
+			l141:
 				{
-					position142, tokenIndex142, depth142 := position, tokenIndex, depth
+					position143, tokenIndex143, depth143 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l143
+						goto l144
 					}
 					position++
-					goto l142
-				l143:
-					position, tokenIndex, depth = position142, tokenIndex142, depth142
+					goto l143
+				l144:
+					position, tokenIndex, depth = position143, tokenIndex143, depth143
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l142:
+			l143:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l131
 				}
 				{
-					position144, tokenIndex144, depth144 := position, tokenIndex, depth
+					position145, tokenIndex145, depth145 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l145
+						goto l146
 					}
 					position++
-					goto l144
-				l145:
-					position, tokenIndex, depth = position144, tokenIndex144, depth144
+					goto l145
+				l146:
+					position, tokenIndex, depth = position145, tokenIndex145, depth145
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l144:
+			l145:
 				{
-					position146, tokenIndex146, depth146 := position, tokenIndex, depth
+					position147, tokenIndex147, depth147 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l147
+						goto l148
 					}
 					position++
-					goto l146
-				l147:
-					position, tokenIndex, depth = position146, tokenIndex146, depth146
+					goto l147
+				l148:
+					position, tokenIndex, depth = position147, tokenIndex147, depth147
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l146:
+			l147:
 				{
-					position148, tokenIndex148, depth148 := position, tokenIndex, depth
+					position149, tokenIndex149, depth149 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l149
+						goto l150
 					}
 					position++
-					goto l148
-				l149:
-					position, tokenIndex, depth = position148, tokenIndex148, depth148
+					goto l149
+				l150:
+					position, tokenIndex, depth = position149, tokenIndex149, depth149
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l148:
+			l149:
 				{
-					position150, tokenIndex150, depth150 := position, tokenIndex, depth
+					position151, tokenIndex151, depth151 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l151
+						goto l152
 					}
 					position++
-					goto l150
-				l151:
-					position, tokenIndex, depth = position150, tokenIndex150, depth150
+					goto l151
+				l152:
+					position, tokenIndex, depth = position151, tokenIndex151, depth151
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l150:
+			l151:
 				{
-					position152, tokenIndex152, depth152 := position, tokenIndex, depth
+					position153, tokenIndex153, depth153 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l153
+						goto l154
 					}
 					position++
-					goto l152
-				l153:
-					position, tokenIndex, depth = position152, tokenIndex152, depth152
+					goto l153
+				l154:
+					position, tokenIndex, depth = position153, tokenIndex153, depth153
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l152:
+			l153:
 				{
-					position154, tokenIndex154, depth154 := position, tokenIndex, depth
+					position155, tokenIndex155, depth155 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l155
+						goto l156
 					}
 					position++
-					goto l154
-				l155:
-					position, tokenIndex, depth = position154, tokenIndex154, depth154
+					goto l155
+				l156:
+					position, tokenIndex, depth = position155, tokenIndex155, depth155
 					if buffer[position] != rune('M') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l154:
+			l155:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l131
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l130
+					goto l131
 				}
 				if !_rules[rulesp]() {
-					goto l130
+					goto l131
 				}
 				{
-					position156, tokenIndex156, depth156 := position, tokenIndex, depth
+					position157, tokenIndex157, depth157 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l157
+						goto l158
 					}
 					position++
-					goto l156
-				l157:
-					position, tokenIndex, depth = position156, tokenIndex156, depth156
+					goto l157
+				l158:
+					position, tokenIndex, depth = position157, tokenIndex157, depth157
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l156:
+			l157:
 				{
-					position158, tokenIndex158, depth158 := position, tokenIndex, depth
+					position159, tokenIndex159, depth159 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l159
+						goto l160
 					}
 					position++
-					goto l158
-				l159:
-					position, tokenIndex, depth = position158, tokenIndex158, depth158
+					goto l159
+				l160:
+					position, tokenIndex, depth = position159, tokenIndex159, depth159
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l131
 					}
 					position++
 				}
-			l158:
+			l159:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l131
 				}
 				if !_rules[ruleSelectUnionStmt]() {
-					goto l130
+					goto l131
 				}
 				if !_rules[ruleAction5]() {
-					goto l130
+					goto l131
 				}
 				depth--
-				add(ruleCreateStreamAsSelectUnionStmt, position131)
+				add(ruleCreateStreamAsSelectUnionStmt, position132)
 			}
 			return true
-		l130:
-			position, tokenIndex, depth = position130, tokenIndex130, depth130
+		l131:
+			position, tokenIndex, depth = position131, tokenIndex131, depth131
 			return false
 		},
-		/* 12 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
+		/* 12 Parallelism <- <(<(('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') sp (('p' / 'P') ('a' / 'A') ('r' / 'R') ('a' / 'A') ('l' / 'L') ('l' / 'L') ('e' / 'E') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('m' / 'M')) sp NumericLiteral sp)?> Action6)> */
 		func() bool {
-			position160, tokenIndex160, depth160 := position, tokenIndex, depth
+			position161, tokenIndex161, depth161 := position, tokenIndex, depth
 			{
-				position161 := position
+				position162 := position
 				depth++
 				{
-					position162, tokenIndex162, depth162 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l163
-					}
-					position++
-					goto l162
-				l163:
-					position, tokenIndex, depth = position162, tokenIndex162, depth162
-					if buffer[position] != rune('C') {
-						goto l160
-					}
-					position++
-				}
-			l162:
-				{
-					position164, tokenIndex164, depth164 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l165
-					}
-					position++
-					goto l164
-				l165:
-					position, tokenIndex, depth = position164, tokenIndex164, depth164
-					if buffer[position] != rune('R') {
-						goto l160
-					}
-					position++
-				}
-			l164:
-				{
-					position166, tokenIndex166, depth166 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l167
-					}
-					position++
-					goto l166
-				l167:
-					position, tokenIndex, depth = position166, tokenIndex166, depth166
-					if buffer[position] != rune('E') {
-						goto l160
-					}
-					position++
-				}
-			l166:
-				{
-					position168, tokenIndex168, depth168 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l169
-					}
-					position++
-					goto l168
-				l169:
-					position, tokenIndex, depth = position168, tokenIndex168, depth168
-					if buffer[position] != rune('A') {
-						goto l160
-					}
-					position++
-				}
-			l168:
-				{
-					position170, tokenIndex170, depth170 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l171
-					}
-					position++
-					goto l170
-				l171:
-					position, tokenIndex, depth = position170, tokenIndex170, depth170
-					if buffer[position] != rune('T') {
-						goto l160
-					}
-					position++
-				}
-			l170:
-				{
-					position172, tokenIndex172, depth172 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l173
-					}
-					position++
-					goto l172
-				l173:
-					position, tokenIndex, depth = position172, tokenIndex172, depth172
-					if buffer[position] != rune('E') {
-						goto l160
-					}
-					position++
-				}
-			l172:
-				if !_rules[rulePausedOpt]() {
-					goto l160
-				}
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				{
-					position174, tokenIndex174, depth174 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l175
-					}
-					position++
-					goto l174
-				l175:
-					position, tokenIndex, depth = position174, tokenIndex174, depth174
-					if buffer[position] != rune('S') {
-						goto l160
-					}
-					position++
-				}
-			l174:
-				{
-					position176, tokenIndex176, depth176 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
-						goto l177
-					}
-					position++
-					goto l176
-				l177:
-					position, tokenIndex, depth = position176, tokenIndex176, depth176
-					if buffer[position] != rune('O') {
-						goto l160
-					}
-					position++
-				}
-			l176:
-				{
-					position178, tokenIndex178, depth178 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
-						goto l179
-					}
-					position++
-					goto l178
-				l179:
-					position, tokenIndex, depth = position178, tokenIndex178, depth178
-					if buffer[position] != rune('U') {
-						goto l160
-					}
-					position++
-				}
-			l178:
-				{
-					position180, tokenIndex180, depth180 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l181
-					}
-					position++
-					goto l180
-				l181:
-					position, tokenIndex, depth = position180, tokenIndex180, depth180
-					if buffer[position] != rune('R') {
-						goto l160
-					}
-					position++
-				}
-			l180:
-				{
-					position182, tokenIndex182, depth182 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l183
-					}
-					position++
-					goto l182
-				l183:
-					position, tokenIndex, depth = position182, tokenIndex182, depth182
-					if buffer[position] != rune('C') {
-						goto l160
-					}
-					position++
-				}
-			l182:
-				{
-					position184, tokenIndex184, depth184 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l185
-					}
-					position++
-					goto l184
-				l185:
-					position, tokenIndex, depth = position184, tokenIndex184, depth184
-					if buffer[position] != rune('E') {
-						goto l160
-					}
-					position++
-				}
-			l184:
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l160
-				}
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				{
-					position186, tokenIndex186, depth186 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l187
-					}
-					position++
-					goto l186
-				l187:
-					position, tokenIndex, depth = position186, tokenIndex186, depth186
-					if buffer[position] != rune('T') {
-						goto l160
-					}
-					position++
-				}
-			l186:
-				{
-					position188, tokenIndex188, depth188 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
-						goto l189
-					}
-					position++
-					goto l188
-				l189:
-					position, tokenIndex, depth = position188, tokenIndex188, depth188
-					if buffer[position] != rune('Y') {
-						goto l160
-					}
-					position++
-				}
-			l188:
-				{
-					position190, tokenIndex190, depth190 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
-						goto l191
-					}
-					position++
-					goto l190
-				l191:
-					position, tokenIndex, depth = position190, tokenIndex190, depth190
-					if buffer[position] != rune('P') {
-						goto l160
-					}
-					position++
-				}
-			l190:
-				{
-					position192, tokenIndex192, depth192 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l193
-					}
-					position++
-					goto l192
-				l193:
-					position, tokenIndex, depth = position192, tokenIndex192, depth192
-					if buffer[position] != rune('E') {
-						goto l160
+					position163 := position
+					depth++
+					{
+						position164, tokenIndex164, depth164 := position, tokenIndex, depth
+						{
+							position166, tokenIndex166, depth166 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l167
+							}
+							position++
+							goto l166
+						l167:
+							position, tokenIndex, depth = position166, tokenIndex166, depth166
+							if buffer[position] != rune('W') {
+								goto l164
+							}
+							position++
+						}
+					l166:
+						{
+							position168, tokenIndex168, depth168 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l169
+							}
+							position++
+							goto l168
+						l169:
+							position, tokenIndex, depth = position168, tokenIndex168, depth168
+							if buffer[position] != rune('I') {
+								goto l164
+							}
+							position++
+						}
+					l168:
+						{
+							position170, tokenIndex170, depth170 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l171
+							}
+							position++
+							goto l170
+						l171:
+							position, tokenIndex, depth = position170, tokenIndex170, depth170
+							if buffer[position] != rune('T') {
+								goto l164
+							}
+							position++
+						}
+					l170:
+						{
+							position172, tokenIndex172, depth172 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l173
+							}
+							position++
+							goto l172
+						l173:
+							position, tokenIndex, depth = position172, tokenIndex172, depth172
+							if buffer[position] != rune('H') {
+								goto l164
+							}
+							position++
+						}
+					l172:
+						if !_rules[rulesp]() {
+							goto l164
+						}
+						{
+							position174, tokenIndex174, depth174 := position, tokenIndex, depth
+							if buffer[position] != rune('p') {
+								goto l175
+							}
+							position++
+							goto l174
+						l175:
+							position, tokenIndex, depth = position174, tokenIndex174, depth174
+							if buffer[position] != rune('P') {
+								goto l164
+							}
+							position++
+						}
+					l174:
+						{
+							position176, tokenIndex176, depth176 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l177
+							}
+							position++
+							goto l176
+						l177:
+							position, tokenIndex, depth = position176, tokenIndex176, depth176
+							if buffer[position] != rune('A') {
+								goto l164
+							}
+							position++
+						}
+					l176:
+						{
+							position178, tokenIndex178, depth178 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l179
+							}
+							position++
+							goto l178
+						l179:
+							position, tokenIndex, depth = position178, tokenIndex178, depth178
+							if buffer[position] != rune('R') {
+								goto l164
+							}
+							position++
+						}
+					l178:
+						{
+							position180, tokenIndex180, depth180 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l181
+							}
+							position++
+							goto l180
+						l181:
+							position, tokenIndex, depth = position180, tokenIndex180, depth180
+							if buffer[position] != rune('A') {
+								goto l164
+							}
+							position++
+						}
+					l180:
+						{
+							position182, tokenIndex182, depth182 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l183
+							}
+							position++
+							goto l182
+						l183:
+							position, tokenIndex, depth = position182, tokenIndex182, depth182
+							if buffer[position] != rune('L') {
+								goto l164
+							}
+							position++
+						}
+					l182:
+						{
+							position184, tokenIndex184, depth184 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l185
+							}
+							position++
+							goto l184
+						l185:
+							position, tokenIndex, depth = position184, tokenIndex184, depth184
+							if buffer[position] != rune('L') {
+								goto l164
+							}
+							position++
+						}
+					l184:
+						{
+							position186, tokenIndex186, depth186 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l187
+							}
+							position++
+							goto l186
+						l187:
+							position, tokenIndex, depth = position186, tokenIndex186, depth186
+							if buffer[position] != rune('E') {
+								goto l164
+							}
+							position++
+						}
+					l186:
+						{
+							position188, tokenIndex188, depth188 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l189
+							}
+							position++
+							goto l188
+						l189:
+							position, tokenIndex, depth = position188, tokenIndex188, depth188
+							if buffer[position] != rune('L') {
+								goto l164
+							}
+							position++
+						}
+					l188:
+						{
+							position190, tokenIndex190, depth190 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l191
+							}
+							position++
+							goto l190
+						l191:
+							position, tokenIndex, depth = position190, tokenIndex190, depth190
+							if buffer[position] != rune('I') {
+								goto l164
+							}
+							position++
+						}
+					l190:
+						{
+							position192, tokenIndex192, depth192 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l193
+							}
+							position++
+							goto l192
+						l193:
+							position, tokenIndex, depth = position192, tokenIndex192, depth192
+							if buffer[position] != rune('S') {
+								goto l164
+							}
+							position++
+						}
+					l192:
+						{
+							position194, tokenIndex194, depth194 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l195
+							}
+							position++
+							goto l194
+						l195:
+							position, tokenIndex, depth = position194, tokenIndex194, depth194
+							if buffer[position] != rune('M') {
+								goto l164
+							}
+							position++
+						}
+					l194:
+						if !_rules[rulesp]() {
+							goto l164
+						}
+						if !_rules[ruleNumericLiteral]() {
+							goto l164
+						}
+						if !_rules[rulesp]() {
+							goto l164
+						}
+						goto l165
+					l164:
+						position, tokenIndex, depth = position164, tokenIndex164, depth164
 					}
-					position++
-				}
-			l192:
-				if !_rules[rulesp]() {
-					goto l160
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l160
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l160
+				l165:
+					depth--
+					add(rulePegText, position163)
 				}
 				if !_rules[ruleAction6]() {
-					goto l160
+					goto l161
 				}
 				depth--
-				add(ruleCreateSourceStmt, position161)
+				add(ruleParallelism, position162)
 			}
 			return true
-		l160:
-			position, tokenIndex, depth = position160, tokenIndex160, depth160
+		l161:
+			position, tokenIndex, depth = position161, tokenIndex161, depth161
 			return false
 		},
-		/* 13 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		/* 13 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
 		func() bool {
-			position194, tokenIndex194, depth194 := position, tokenIndex, depth
+			position196, tokenIndex196, depth196 := position, tokenIndex, depth
 			{
-				position195 := position
+				position197 := position
 				depth++
-				{
-					position196, tokenIndex196, depth196 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l197
-					}
-					position++
-					goto l196
-				l197:
-					position, tokenIndex, depth = position196, tokenIndex196, depth196
-					if buffer[position] != rune('C') {
-						goto l194
-					}
-					position++
-				}
-			l196:
 				{
 					position198, tokenIndex198, depth198 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('c') {
 						goto l199
 					}
 					position++
 					goto l198
 				l199:
 					position, tokenIndex, depth = position198, tokenIndex198, depth198
-					if buffer[position] != rune('R') {
-						goto l194
+					if buffer[position] != rune('C') {
+						goto l196
 					}
 					position++
 				}
 			l198:
 				{
 					position200, tokenIndex200, depth200 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('r') {
 						goto l201
 					}
 					position++
 					goto l200
 				l201:
 					position, tokenIndex, depth = position200, tokenIndex200, depth200
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('R') {
+						goto l196
 					}
 					position++
 				}
 			l200:
 				{
 					position202, tokenIndex202, depth202 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('e') {
 						goto l203
 					}
 					position++
 					goto l202
 				l203:
 					position, tokenIndex, depth = position202, tokenIndex202, depth202
-					if buffer[position] != rune('A') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l196
 					}
 					position++
 				}
 			l202:
 				{
 					position204, tokenIndex204, depth204 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('a') {
 						goto l205
 					}
 					position++
 					goto l204
 				l205:
 					position, tokenIndex, depth = position204, tokenIndex204, depth204
-					if buffer[position] != rune('T') {
-						goto l194
+					if buffer[position] != rune('A') {
+						goto l196
 					}
 					position++
 				}
 			l204:
 				{
 					position206, tokenIndex206, depth206 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l207
 					}
 					position++
 					goto l206
 				l207:
 					position, tokenIndex, depth = position206, tokenIndex206, depth206
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('T') {
+						goto l196
 					}
 					position++
 				}
 			l206:
-				if !_rules[rulesp]() {
-					goto l194
-				}
 				{
 					position208, tokenIndex208, depth208 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('e') {
 						goto l209
 					}
 					position++
 					goto l208
 				l209:
 					position, tokenIndex, depth = position208, tokenIndex208, depth208
-					if buffer[position] != rune('S') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l196
 					}
 					position++
 				}
 			l208:
+				if !_rules[rulePausedOpt]() {
+					goto l196
+				}
+				if !_rules[rulesp]() {
+					goto l196
+				}
 				{
 					position210, tokenIndex210, depth210 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('s') {
 						goto l211
 					}
 					position++
 					goto l210
 				l211:
 					position, tokenIndex, depth = position210, tokenIndex210, depth210
-					if buffer[position] != rune('I') {
-						goto l194
+					if buffer[position] != rune('S') {
+						goto l196
 					}
 					position++
 				}
 			l210:
 				{
 					position212, tokenIndex212, depth212 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('o') {
 						goto l213
 					}
 					position++
 					goto l212
 				l213:
 					position, tokenIndex, depth = position212, tokenIndex212, depth212
-					if buffer[position] != rune('N') {
-						goto l194
+					if buffer[position] != rune('O') {
+						goto l196
 					}
 					position++
 				}
 			l212:
 				{
 					position214, tokenIndex214, depth214 := position, tokenIndex, depth
-					if buffer[position] != rune('k') {
+					if buffer[position] != rune('u') {
 						goto l215
 					}
 					position++
 					goto l214
 				l215:
 					position, tokenIndex, depth = position214, tokenIndex214, depth214
-					if buffer[position] != rune('K') {
-						goto l194
+					if buffer[position] != rune('U') {
+						goto l196
 					}
 					position++
 				}
 			l214:
-				if !_rules[rulesp]() {
-					goto l194
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l194
-				}
-				if !_rules[rulesp]() {
-					goto l194
-				}
 				{
 					position216, tokenIndex216, depth216 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('r') {
 						goto l217
 					}
 					position++
 					goto l216
 				l217:
 					position, tokenIndex, depth = position216, tokenIndex216, depth216
-					if buffer[position] != rune('T') {
-						goto l194
+					if buffer[position] != rune('R') {
+						goto l196
 					}
 					position++
 				}
 			l216:
 				{
 					position218, tokenIndex218, depth218 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('c') {
 						goto l219
 					}
 					position++
 					goto l218
 				l219:
 					position, tokenIndex, depth = position218, tokenIndex218, depth218
-					if buffer[position] != rune('Y') {
-						goto l194
+					if buffer[position] != rune('C') {
+						goto l196
 					}
 					position++
 				}
 			l218:
 				{
 					position220, tokenIndex220, depth220 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('e') {
 						goto l221
 					}
 					position++
 					goto l220
 				l221:
 					position, tokenIndex, depth = position220, tokenIndex220, depth220
-					if buffer[position] != rune('P') {
-						goto l194
+					if buffer[position] != rune('E') {
+						goto l196
 					}
 					position++
 				}
 			l220:
+				if !_rules[rulesp]() {
+					goto l196
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l196
+				}
+				if !_rules[rulesp]() {
+					goto l196
+				}
 				{
 					position222, tokenIndex222, depth222 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('t') {
 						goto l223
 					}
 					position++
 					goto l222
 				l223:
 					position, tokenIndex, depth = position222, tokenIndex222, depth222
-					if buffer[position] != rune('E') {
-						goto l194
+					if buffer[position] != rune('T') {
+						goto l196
 					}
 					position++
 				}
 			l222:
-				if !_rules[rulesp]() {
-					goto l194
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l194
-				}
-				if !_rules[ruleSourceSinkSpecs]() {
-					goto l194
-				}
-				if !_rules[ruleAction7]() {
-					goto l194
+				{
+					position224, tokenIndex224, depth224 := position, tokenIndex, depth
+					if buffer[position] != rune('y') {
+						goto l225
+					}
+					position++
+					goto l224
+				l225:
+					position, tokenIndex, depth = position224, tokenIndex224, depth224
+					if buffer[position] != rune('Y') {
+						goto l196
+					}
+					position++
 				}
-				depth--
-				add(ruleCreateSinkStmt, position195)
-			}
-			return true
-		l194:
-			position, tokenIndex, depth = position194, tokenIndex194, depth194
-			return false
-		},
-		/* 14 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
-		func() bool {
-			position224, tokenIndex224, depth224 := position, tokenIndex, depth
-			{
-				position225 := position
-				depth++
+			l224:
 				{
 					position226, tokenIndex226, depth226 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('p') {
 						goto l227
 					}
 					position++
 					goto l226
 				l227:
 					position, tokenIndex, depth = position226, tokenIndex226, depth226
-					if buffer[position] != rune('C') {
-						goto l224
+					if buffer[position] != rune('P') {
+						goto l196
 					}
 					position++
 				}
 			l226:
 				{
 					position228, tokenIndex228, depth228 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('e') {
 						goto l229
 					}
 					position++
 					goto l228
 				l229:
 					position, tokenIndex, depth = position228, tokenIndex228, depth228
-					if buffer[position] != rune('R') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l196
 					}
 					position++
 				}
 			l228:
-				{
-					position230, tokenIndex230, depth230 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l231
-					}
-					position++
-					goto l230
-				l231:
-					position, tokenIndex, depth = position230, tokenIndex230, depth230
-					if buffer[position] != rune('E') {
-						goto l224
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l196
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l196
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l196
+				}
+				if !_rules[ruleAction7]() {
+					goto l196
 				}
-			l230:
+				depth--
+				add(ruleCreateSourceStmt, position197)
+			}
+			return true
+		l196:
+			position, tokenIndex, depth = position196, tokenIndex196, depth196
+			return false
+		},
+		/* 14 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
+		func() bool {
+			position230, tokenIndex230, depth230 := position, tokenIndex, depth
+			{
+				position231 := position
+				depth++
 				{
 					position232, tokenIndex232, depth232 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('c') {
 						goto l233
 					}
 					position++
 					goto l232
 				l233:
 					position, tokenIndex, depth = position232, tokenIndex232, depth232
-					if buffer[position] != rune('A') {
-						goto l224
+					if buffer[position] != rune('C') {
+						goto l230
 					}
 					position++
 				}
 			l232:
 				{
 					position234, tokenIndex234, depth234 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('r') {
 						goto l235
 					}
 					position++
 					goto l234
 				l235:
 					position, tokenIndex, depth = position234, tokenIndex234, depth234
-					if buffer[position] != rune('T') {
-						goto l224
+					if buffer[position] != rune('R') {
+						goto l230
 					}
 					position++
 				}
@@ -3576,25 +3838,22 @@ func (p *bqlPegBackend) Init() {
 				l237:
 					position, tokenIndex, depth = position236, tokenIndex236, depth236
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l230
 					}
 					position++
 				}
 			l236:
-				if !_rules[rulesp]() {
-					goto l224
-				}
 				{
 					position238, tokenIndex238, depth238 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('a') {
 						goto l239
 					}
 					position++
 					goto l238
 				l239:
 					position, tokenIndex, depth = position238, tokenIndex238, depth238
-					if buffer[position] != rune('S') {
-						goto l224
+					if buffer[position] != rune('A') {
+						goto l230
 					}
 					position++
 				}
@@ -3609,285 +3868,288 @@ func (p *bqlPegBackend) Init() {
 				l241:
 					position, tokenIndex, depth = position240, tokenIndex240, depth240
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l230
 					}
 					position++
 				}
 			l240:
 				{
 					position242, tokenIndex242, depth242 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('e') {
 						goto l243
 					}
 					position++
 					goto l242
 				l243:
 					position, tokenIndex, depth = position242, tokenIndex242, depth242
-					if buffer[position] != rune('A') {
-						goto l224
+					if buffer[position] != rune('E') {
+						goto l230
 					}
 					position++
 				}
 			l242:
+				if !_rules[rulesp]() {
+					goto l230
+				}
 				{
 					position244, tokenIndex244, depth244 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('s') {
 						goto l245
 					}
 					position++
 					goto l244
 				l245:
 					position, tokenIndex, depth = position244, tokenIndex244, depth244
-					if buffer[position] != rune('T') {
-						goto l224
+					if buffer[position] != rune('S') {
+						goto l230
 					}
 					position++
 				}
 			l244:
 				{
 					position246, tokenIndex246, depth246 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('i') {
 						goto l247
 					}
 					position++
 					goto l246
 				l247:
 					position, tokenIndex, depth = position246, tokenIndex246, depth246
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('I') {
+						goto l230
 					}
 					position++
 				}
 			l246:
-				if !_rules[rulesp]() {
-					goto l224
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l224
-				}
-				if !_rules[rulesp]() {
-					goto l224
-				}
 				{
 					position248, tokenIndex248, depth248 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('n') {
 						goto l249
 					}
 					position++
 					goto l248
 				l249:
 					position, tokenIndex, depth = position248, tokenIndex248, depth248
-					if buffer[position] != rune('T') {
-						goto l224
+					if buffer[position] != rune('N') {
+						goto l230
 					}
 					position++
 				}
 			l248:
 				{
 					position250, tokenIndex250, depth250 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
+					if buffer[position] != rune('k') {
 						goto l251
 					}
 					position++
 					goto l250
 				l251:
 					position, tokenIndex, depth = position250, tokenIndex250, depth250
-					if buffer[position] != rune('Y') {
-						goto l224
+					if buffer[position] != rune('K') {
+						goto l230
 					}
 					position++
 				}
 			l250:
+				if !_rules[rulesp]() {
+					goto l230
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l230
+				}
+				if !_rules[rulesp]() {
+					goto l230
+				}
 				{
 					position252, tokenIndex252, depth252 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('t') {
 						goto l253
 					}
 					position++
 					goto l252
 				l253:
 					position, tokenIndex, depth = position252, tokenIndex252, depth252
-					if buffer[position] != rune('P') {
-						goto l224
+					if buffer[position] != rune('T') {
+						goto l230
 					}
 					position++
 				}
 			l252:
 				{
 					position254, tokenIndex254, depth254 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('y') {
 						goto l255
 					}
 					position++
 					goto l254
 				l255:
 					position, tokenIndex, depth = position254, tokenIndex254, depth254
-					if buffer[position] != rune('E') {
-						goto l224
+					if buffer[position] != rune('Y') {
+						goto l230
 					}
 					position++
 				}
 			l254:
+				{
+					position256, tokenIndex256, depth256 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l257
+					}
+					position++
+					goto l256
+				l257:
+					position, tokenIndex, depth = position256, tokenIndex256, depth256
+					if buffer[position] != rune('P') {
+						goto l230
+					}
+					position++
+				}
+			l256:
+				{
+					position258, tokenIndex258, depth258 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l259
+					}
+					position++
+					goto l258
+				l259:
+					position, tokenIndex, depth = position258, tokenIndex258, depth258
+					if buffer[position] != rune('E') {
+						goto l230
+					}
+					position++
+				}
+			l258:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l230
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l224
+					goto l230
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l224
+					goto l230
 				}
 				if !_rules[ruleAction8]() {
-					goto l224
+					goto l230
 				}
 				depth--
-				add(ruleCreateStateStmt, position225)
+				add(ruleCreateSinkStmt, position231)
 			}
 			return true
-		l224:
-			position, tokenIndex, depth = position224, tokenIndex224, depth224
+		l230:
+			position, tokenIndex, depth = position230, tokenIndex230, depth230
 			return false
 		},
-		/* 15 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action9)> */
+		/* 15 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action9)> */
 		func() bool {
-			position256, tokenIndex256, depth256 := position, tokenIndex, depth
+			position260, tokenIndex260, depth260 := position, tokenIndex, depth
 			{
-				position257 := position
+				position261 := position
 				depth++
-				{
-					position258, tokenIndex258, depth258 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
-						goto l259
-					}
-					position++
-					goto l258
-				l259:
-					position, tokenIndex, depth = position258, tokenIndex258, depth258
-					if buffer[position] != rune('U') {
-						goto l256
-					}
-					position++
-				}
-			l258:
-				{
-					position260, tokenIndex260, depth260 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
-						goto l261
-					}
-					position++
-					goto l260
-				l261:
-					position, tokenIndex, depth = position260, tokenIndex260, depth260
-					if buffer[position] != rune('P') {
-						goto l256
-					}
-					position++
-				}
-			l260:
 				{
 					position262, tokenIndex262, depth262 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('c') {
 						goto l263
 					}
 					position++
 					goto l262
 				l263:
 					position, tokenIndex, depth = position262, tokenIndex262, depth262
-					if buffer[position] != rune('D') {
-						goto l256
+					if buffer[position] != rune('C') {
+						goto l260
 					}
 					position++
 				}
 			l262:
 				{
 					position264, tokenIndex264, depth264 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('r') {
 						goto l265
 					}
 					position++
 					goto l264
 				l265:
 					position, tokenIndex, depth = position264, tokenIndex264, depth264
-					if buffer[position] != rune('A') {
-						goto l256
+					if buffer[position] != rune('R') {
+						goto l260
 					}
 					position++
 				}
 			l264:
 				{
 					position266, tokenIndex266, depth266 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l267
 					}
 					position++
 					goto l266
 				l267:
 					position, tokenIndex, depth = position266, tokenIndex266, depth266
-					if buffer[position] != rune('T') {
-						goto l256
+					if buffer[position] != rune('E') {
+						goto l260
 					}
 					position++
 				}
 			l266:
 				{
 					position268, tokenIndex268, depth268 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l269
 					}
 					position++
 					goto l268
 				l269:
 					position, tokenIndex, depth = position268, tokenIndex268, depth268
-					if buffer[position] != rune('E') {
-						goto l256
+					if buffer[position] != rune('A') {
+						goto l260
 					}
 					position++
 				}
 			l268:
-				if !_rules[rulesp]() {
-					goto l256
-				}
 				{
 					position270, tokenIndex270, depth270 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('t') {
 						goto l271
 					}
 					position++
 					goto l270
 				l271:
 					position, tokenIndex, depth = position270, tokenIndex270, depth270
-					if buffer[position] != rune('S') {
-						goto l256
+					if buffer[position] != rune('T') {
+						goto l260
 					}
 					position++
 				}
 			l270:
 				{
 					position272, tokenIndex272, depth272 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l273
 					}
 					position++
 					goto l272
 				l273:
 					position, tokenIndex, depth = position272, tokenIndex272, depth272
-					if buffer[position] != rune('T') {
-						goto l256
+					if buffer[position] != rune('E') {
+						goto l260
 					}
 					position++
 				}
 			l272:
-				{
-					position274, tokenIndex274, depth274 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+				if !_rules[rulesp]() {
+					goto l260
+				}
+				{
+					position274, tokenIndex274, depth274 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
 						goto l275
 					}
 					position++
 					goto l274
 				l275:
 					position, tokenIndex, depth = position274, tokenIndex274, depth274
-					if buffer[position] != rune('A') {
-						goto l256
+					if buffer[position] != rune('S') {
+						goto l260
 					}
 					position++
 				}
@@ -3902,216 +4164,222 @@ func (p *bqlPegBackend) Init() {
 				l277:
 					position, tokenIndex, depth = position276, tokenIndex276, depth276
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l260
 					}
 					position++
 				}
 			l276:
 				{
 					position278, tokenIndex278, depth278 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('a') {
 						goto l279
 					}
 					position++
 					goto l278
 				l279:
 					position, tokenIndex, depth = position278, tokenIndex278, depth278
-					if buffer[position] != rune('E') {
-						goto l256
+					if buffer[position] != rune('A') {
+						goto l260
 					}
 					position++
 				}
 			l278:
-				if !_rules[rulesp]() {
-					goto l256
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l256
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l256
-				}
-				if !_rules[ruleAction9]() {
-					goto l256
+				{
+					position280, tokenIndex280, depth280 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l281
+					}
+					position++
+					goto l280
+				l281:
+					position, tokenIndex, depth = position280, tokenIndex280, depth280
+					if buffer[position] != rune('T') {
+						goto l260
+					}
+					position++
 				}
-				depth--
-				add(ruleUpdateStateStmt, position257)
-			}
-			return true
-		l256:
-			position, tokenIndex, depth = position256, tokenIndex256, depth256
-			return false
-		},
-		/* 16 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
-		func() bool {
-			position280, tokenIndex280, depth280 := position, tokenIndex, depth
-			{
-				position281 := position
-				depth++
+			l280:
 				{
 					position282, tokenIndex282, depth282 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l283
 					}
 					position++
 					goto l282
 				l283:
 					position, tokenIndex, depth = position282, tokenIndex282, depth282
-					if buffer[position] != rune('U') {
-						goto l280
+					if buffer[position] != rune('E') {
+						goto l260
 					}
 					position++
 				}
 			l282:
+				if !_rules[rulesp]() {
+					goto l260
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l260
+				}
+				if !_rules[rulesp]() {
+					goto l260
+				}
 				{
 					position284, tokenIndex284, depth284 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('t') {
 						goto l285
 					}
 					position++
 					goto l284
 				l285:
 					position, tokenIndex, depth = position284, tokenIndex284, depth284
-					if buffer[position] != rune('P') {
-						goto l280
+					if buffer[position] != rune('T') {
+						goto l260
 					}
 					position++
 				}
 			l284:
 				{
 					position286, tokenIndex286, depth286 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('y') {
 						goto l287
 					}
 					position++
 					goto l286
 				l287:
 					position, tokenIndex, depth = position286, tokenIndex286, depth286
-					if buffer[position] != rune('D') {
-						goto l280
+					if buffer[position] != rune('Y') {
+						goto l260
 					}
 					position++
 				}
 			l286:
 				{
 					position288, tokenIndex288, depth288 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('p') {
 						goto l289
 					}
 					position++
 					goto l288
 				l289:
 					position, tokenIndex, depth = position288, tokenIndex288, depth288
-					if buffer[position] != rune('A') {
-						goto l280
+					if buffer[position] != rune('P') {
+						goto l260
 					}
 					position++
 				}
 			l288:
 				{
 					position290, tokenIndex290, depth290 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l291
 					}
 					position++
 					goto l290
 				l291:
 					position, tokenIndex, depth = position290, tokenIndex290, depth290
-					if buffer[position] != rune('T') {
-						goto l280
-					}
-					position++
-				}
-			l290:
-				{
-					position292, tokenIndex292, depth292 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l293
-					}
-					position++
-					goto l292
-				l293:
-					position, tokenIndex, depth = position292, tokenIndex292, depth292
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l260
 					}
 					position++
 				}
-			l292:
+			l290:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l260
 				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l260
+				}
+				if !_rules[ruleSourceSinkSpecs]() {
+					goto l260
+				}
+				if !_rules[ruleAction9]() {
+					goto l260
+				}
+				depth--
+				add(ruleCreateStateStmt, position261)
+			}
+			return true
+		l260:
+			position, tokenIndex, depth = position260, tokenIndex260, depth260
+			return false
+		},
+		/* 16 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
+		func() bool {
+			position292, tokenIndex292, depth292 := position, tokenIndex, depth
+			{
+				position293 := position
+				depth++
 				{
 					position294, tokenIndex294, depth294 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('u') {
 						goto l295
 					}
 					position++
 					goto l294
 				l295:
 					position, tokenIndex, depth = position294, tokenIndex294, depth294
-					if buffer[position] != rune('S') {
-						goto l280
+					if buffer[position] != rune('U') {
+						goto l292
 					}
 					position++
 				}
 			l294:
 				{
 					position296, tokenIndex296, depth296 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('p') {
 						goto l297
 					}
 					position++
 					goto l296
 				l297:
 					position, tokenIndex, depth = position296, tokenIndex296, depth296
-					if buffer[position] != rune('O') {
-						goto l280
+					if buffer[position] != rune('P') {
+						goto l292
 					}
 					position++
 				}
 			l296:
 				{
 					position298, tokenIndex298, depth298 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('d') {
 						goto l299
 					}
 					position++
 					goto l298
 				l299:
 					position, tokenIndex, depth = position298, tokenIndex298, depth298
-					if buffer[position] != rune('U') {
-						goto l280
+					if buffer[position] != rune('D') {
+						goto l292
 					}
 					position++
 				}
 			l298:
 				{
 					position300, tokenIndex300, depth300 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('a') {
 						goto l301
 					}
 					position++
 					goto l300
 				l301:
 					position, tokenIndex, depth = position300, tokenIndex300, depth300
-					if buffer[position] != rune('R') {
-						goto l280
+					if buffer[position] != rune('A') {
+						goto l292
 					}
 					position++
 				}
 			l300:
 				{
 					position302, tokenIndex302, depth302 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('t') {
 						goto l303
 					}
 					position++
 					goto l302
 				l303:
 					position, tokenIndex, depth = position302, tokenIndex302, depth302
-					if buffer[position] != rune('C') {
-						goto l280
+					if buffer[position] != rune('T') {
+						goto l292
 					}
 					position++
 				}
@@ -4126,539 +4394,533 @@ func (p *bqlPegBackend) Init() {
 				l305:
 					position, tokenIndex, depth = position304, tokenIndex304, depth304
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l292
 					}
 					position++
 				}
 			l304:
 				if !_rules[rulesp]() {
-					goto l280
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l280
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l280
+					goto l292
 				}
-				if !_rules[ruleAction10]() {
-					goto l280
+				{
+					position306, tokenIndex306, depth306 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l307
+					}
+					position++
+					goto l306
+				l307:
+					position, tokenIndex, depth = position306, tokenIndex306, depth306
+					if buffer[position] != rune('S') {
+						goto l292
+					}
+					position++
 				}
-				depth--
-				add(ruleUpdateSourceStmt, position281)
-			}
-			return true
-		l280:
-			position, tokenIndex, depth = position280, tokenIndex280, depth280
-			return false
-		},
-		/* 17 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
-		func() bool {
-			position306, tokenIndex306, depth306 := position, tokenIndex, depth
-			{
-				position307 := position
-				depth++
+			l306:
 				{
 					position308, tokenIndex308, depth308 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('t') {
 						goto l309
 					}
 					position++
 					goto l308
 				l309:
 					position, tokenIndex, depth = position308, tokenIndex308, depth308
-					if buffer[position] != rune('U') {
-						goto l306
+					if buffer[position] != rune('T') {
+						goto l292
 					}
 					position++
 				}
 			l308:
 				{
 					position310, tokenIndex310, depth310 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('a') {
 						goto l311
 					}
 					position++
 					goto l310
 				l311:
 					position, tokenIndex, depth = position310, tokenIndex310, depth310
-					if buffer[position] != rune('P') {
-						goto l306
+					if buffer[position] != rune('A') {
+						goto l292
 					}
 					position++
 				}
 			l310:
 				{
 					position312, tokenIndex312, depth312 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('t') {
 						goto l313
 					}
 					position++
 					goto l312
 				l313:
 					position, tokenIndex, depth = position312, tokenIndex312, depth312
-					if buffer[position] != rune('D') {
-						goto l306
+					if buffer[position] != rune('T') {
+						goto l292
 					}
 					position++
 				}
 			l312:
 				{
 					position314, tokenIndex314, depth314 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('e') {
 						goto l315
 					}
 					position++
 					goto l314
 				l315:
 					position, tokenIndex, depth = position314, tokenIndex314, depth314
-					if buffer[position] != rune('A') {
-						goto l306
+					if buffer[position] != rune('E') {
+						goto l292
 					}
 					position++
 				}
 			l314:
-				{
-					position316, tokenIndex316, depth316 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l317
-					}
-					position++
-					goto l316
-				l317:
-					position, tokenIndex, depth = position316, tokenIndex316, depth316
-					if buffer[position] != rune('T') {
-						goto l306
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l292
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l292
+				}
+				if !_rules[ruleUpdateSourceSinkSpecs]() {
+					goto l292
+				}
+				if !_rules[ruleAction10]() {
+					goto l292
 				}
-			l316:
+				depth--
+				add(ruleUpdateStateStmt, position293)
+			}
+			return true
+		l292:
+			position, tokenIndex, depth = position292, tokenIndex292, depth292
+			return false
+		},
+		/* 17 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
+		func() bool {
+			position316, tokenIndex316, depth316 := position, tokenIndex, depth
+			{
+				position317 := position
+				depth++
 				{
 					position318, tokenIndex318, depth318 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('u') {
 						goto l319
 					}
 					position++
 					goto l318
 				l319:
 					position, tokenIndex, depth = position318, tokenIndex318, depth318
-					if buffer[position] != rune('E') {
-						goto l306
+					if buffer[position] != rune('U') {
+						goto l316
 					}
 					position++
 				}
 			l318:
-				if !_rules[rulesp]() {
-					goto l306
-				}
 				{
 					position320, tokenIndex320, depth320 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('p') {
 						goto l321
 					}
 					position++
 					goto l320
 				l321:
 					position, tokenIndex, depth = position320, tokenIndex320, depth320
-					if buffer[position] != rune('S') {
-						goto l306
+					if buffer[position] != rune('P') {
+						goto l316
 					}
 					position++
 				}
 			l320:
 				{
 					position322, tokenIndex322, depth322 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('d') {
 						goto l323
 					}
 					position++
 					goto l322
 				l323:
 					position, tokenIndex, depth = position322, tokenIndex322, depth322
-					if buffer[position] != rune('I') {
-						goto l306
+					if buffer[position] != rune('D') {
+						goto l316
 					}
 					position++
 				}
 			l322:
 				{
 					position324, tokenIndex324, depth324 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('a') {
 						goto l325
 					}
 					position++
 					goto l324
 				l325:
 					position, tokenIndex, depth = position324, tokenIndex324, depth324
-					if buffer[position] != rune('N') {
-						goto l306
+					if buffer[position] != rune('A') {
+						goto l316
 					}
 					position++
 				}
 			l324:
 				{
 					position326, tokenIndex326, depth326 := position, tokenIndex, depth
-					if buffer[position] != rune('k') {
+					if buffer[position] != rune('t') {
 						goto l327
 					}
 					position++
 					goto l326
 				l327:
 					position, tokenIndex, depth = position326, tokenIndex326, depth326
-					if buffer[position] != rune('K') {
-						goto l306
+					if buffer[position] != rune('T') {
+						goto l316
 					}
 					position++
 				}
 			l326:
-				if !_rules[rulesp]() {
-					goto l306
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l306
-				}
-				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l306
+				{
+					position328, tokenIndex328, depth328 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l329
+					}
+					position++
+					goto l328
+				l329:
+					position, tokenIndex, depth = position328, tokenIndex328, depth328
+					if buffer[position] != rune('E') {
+						goto l316
+					}
+					position++
 				}
-				if !_rules[ruleAction11]() {
-					goto l306
+			l328:
+				if !_rules[rulesp]() {
+					goto l316
 				}
-				depth--
-				add(ruleUpdateSinkStmt, position307)
-			}
-			return true
-		l306:
-			position, tokenIndex, depth = position306, tokenIndex306, depth306
-			return false
-		},
-		/* 18 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
-		func() bool {
-			position328, tokenIndex328, depth328 := position, tokenIndex, depth
-			{
-				position329 := position
-				depth++
 				{
 					position330, tokenIndex330, depth330 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('s') {
 						goto l331
 					}
 					position++
 					goto l330
 				l331:
 					position, tokenIndex, depth = position330, tokenIndex330, depth330
-					if buffer[position] != rune('I') {
-						goto l328
+					if buffer[position] != rune('S') {
+						goto l316
 					}
 					position++
 				}
 			l330:
 				{
 					position332, tokenIndex332, depth332 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('o') {
 						goto l333
 					}
 					position++
 					goto l332
 				l333:
 					position, tokenIndex, depth = position332, tokenIndex332, depth332
-					if buffer[position] != rune('N') {
-						goto l328
+					if buffer[position] != rune('O') {
+						goto l316
 					}
 					position++
 				}
 			l332:
 				{
 					position334, tokenIndex334, depth334 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('u') {
 						goto l335
 					}
 					position++
 					goto l334
 				l335:
 					position, tokenIndex, depth = position334, tokenIndex334, depth334
-					if buffer[position] != rune('S') {
-						goto l328
+					if buffer[position] != rune('U') {
+						goto l316
 					}
 					position++
 				}
 			l334:
 				{
 					position336, tokenIndex336, depth336 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('r') {
 						goto l337
 					}
 					position++
 					goto l336
 				l337:
 					position, tokenIndex, depth = position336, tokenIndex336, depth336
-					if buffer[position] != rune('E') {
-						goto l328
+					if buffer[position] != rune('R') {
+						goto l316
 					}
 					position++
 				}
 			l336:
 				{
 					position338, tokenIndex338, depth338 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('c') {
 						goto l339
 					}
 					position++
 					goto l338
 				l339:
 					position, tokenIndex, depth = position338, tokenIndex338, depth338
-					if buffer[position] != rune('R') {
-						goto l328
+					if buffer[position] != rune('C') {
+						goto l316
 					}
 					position++
 				}
 			l338:
 				{
 					position340, tokenIndex340, depth340 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l341
 					}
 					position++
 					goto l340
 				l341:
 					position, tokenIndex, depth = position340, tokenIndex340, depth340
-					if buffer[position] != rune('T') {
-						goto l328
+					if buffer[position] != rune('E') {
+						goto l316
 					}
 					position++
 				}
 			l340:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l316
 				}
-				{
-					position342, tokenIndex342, depth342 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
-						goto l343
-					}
-					position++
-					goto l342
-				l343:
-					position, tokenIndex, depth = position342, tokenIndex342, depth342
-					if buffer[position] != rune('I') {
-						goto l328
-					}
-					position++
+				if !_rules[ruleStreamIdentifier]() {
+					goto l316
+				}
+				if !_rules[ruleUpdateSourceSinkSpecs]() {
+					goto l316
 				}
-			l342:
+				if !_rules[ruleAction11]() {
+					goto l316
+				}
+				depth--
+				add(ruleUpdateSourceStmt, position317)
+			}
+			return true
+		l316:
+			position, tokenIndex, depth = position316, tokenIndex316, depth316
+			return false
+		},
+		/* 18 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action12)> */
+		func() bool {
+			position342, tokenIndex342, depth342 := position, tokenIndex, depth
+			{
+				position343 := position
+				depth++
 				{
 					position344, tokenIndex344, depth344 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('u') {
 						goto l345
 					}
 					position++
 					goto l344
 				l345:
 					position, tokenIndex, depth = position344, tokenIndex344, depth344
-					if buffer[position] != rune('N') {
-						goto l328
+					if buffer[position] != rune('U') {
+						goto l342
 					}
 					position++
 				}
 			l344:
 				{
 					position346, tokenIndex346, depth346 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('p') {
 						goto l347
 					}
 					position++
 					goto l346
 				l347:
 					position, tokenIndex, depth = position346, tokenIndex346, depth346
-					if buffer[position] != rune('T') {
-						goto l328
+					if buffer[position] != rune('P') {
+						goto l342
 					}
 					position++
 				}
 			l346:
 				{
 					position348, tokenIndex348, depth348 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('d') {
 						goto l349
 					}
 					position++
 					goto l348
 				l349:
 					position, tokenIndex, depth = position348, tokenIndex348, depth348
-					if buffer[position] != rune('O') {
-						goto l328
+					if buffer[position] != rune('D') {
+						goto l342
 					}
 					position++
 				}
 			l348:
-				if !_rules[rulesp]() {
-					goto l328
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l328
-				}
-				if !_rules[rulesp]() {
-					goto l328
-				}
 				{
 					position350, tokenIndex350, depth350 := position, tokenIndex, depth
-					if buffer[position] != rune('f') {
+					if buffer[position] != rune('a') {
 						goto l351
 					}
 					position++
 					goto l350
 				l351:
 					position, tokenIndex, depth = position350, tokenIndex350, depth350
-					if buffer[position] != rune('F') {
-						goto l328
+					if buffer[position] != rune('A') {
+						goto l342
 					}
 					position++
 				}
 			l350:
 				{
 					position352, tokenIndex352, depth352 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('t') {
 						goto l353
 					}
 					position++
 					goto l352
 				l353:
 					position, tokenIndex, depth = position352, tokenIndex352, depth352
-					if buffer[position] != rune('R') {
-						goto l328
+					if buffer[position] != rune('T') {
+						goto l342
 					}
 					position++
 				}
 			l352:
 				{
 					position354, tokenIndex354, depth354 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('e') {
 						goto l355
 					}
 					position++
 					goto l354
 				l355:
 					position, tokenIndex, depth = position354, tokenIndex354, depth354
-					if buffer[position] != rune('O') {
-						goto l328
+					if buffer[position] != rune('E') {
+						goto l342
 					}
 					position++
 				}
 			l354:
+				if !_rules[rulesp]() {
+					goto l342
+				}
 				{
 					position356, tokenIndex356, depth356 := position, tokenIndex, depth
-					if buffer[position] != rune('m') {
+					if buffer[position] != rune('s') {
 						goto l357
 					}
 					position++
 					goto l356
 				l357:
 					position, tokenIndex, depth = position356, tokenIndex356, depth356
-					if buffer[position] != rune('M') {
-						goto l328
+					if buffer[position] != rune('S') {
+						goto l342
 					}
 					position++
 				}
 			l356:
-				if !_rules[rulesp]() {
-					goto l328
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l328
-				}
-				if !_rules[ruleAction12]() {
-					goto l328
+				{
+					position358, tokenIndex358, depth358 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l359
+					}
+					position++
+					goto l358
+				l359:
+					position, tokenIndex, depth = position358, tokenIndex358, depth358
+					if buffer[position] != rune('I') {
+						goto l342
+					}
+					position++
 				}
-				depth--
-				add(ruleInsertIntoFromStmt, position329)
-			}
-			return true
-		l328:
-			position, tokenIndex, depth = position328, tokenIndex328, depth328
-			return false
-		},
-		/* 19 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
-		func() bool {
-			position358, tokenIndex358, depth358 := position, tokenIndex, depth
-			{
-				position359 := position
-				depth++
+			l358:
 				{
 					position360, tokenIndex360, depth360 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('n') {
 						goto l361
 					}
 					position++
 					goto l360
 				l361:
 					position, tokenIndex, depth = position360, tokenIndex360, depth360
-					if buffer[position] != rune('P') {
-						goto l358
+					if buffer[position] != rune('N') {
+						goto l342
 					}
 					position++
 				}
 			l360:
 				{
 					position362, tokenIndex362, depth362 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('k') {
 						goto l363
 					}
 					position++
 					goto l362
 				l363:
 					position, tokenIndex, depth = position362, tokenIndex362, depth362
-					if buffer[position] != rune('A') {
-						goto l358
+					if buffer[position] != rune('K') {
+						goto l342
 					}
 					position++
 				}
 			l362:
-				{
-					position364, tokenIndex364, depth364 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
-						goto l365
-					}
-					position++
-					goto l364
-				l365:
-					position, tokenIndex, depth = position364, tokenIndex364, depth364
-					if buffer[position] != rune('U') {
-						goto l358
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l342
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l342
+				}
+				if !_rules[ruleUpdateSourceSinkSpecs]() {
+					goto l342
+				}
+				if !_rules[ruleAction12]() {
+					goto l342
 				}
-			l364:
+				depth--
+				add(ruleUpdateSinkStmt, position343)
+			}
+			return true
+		l342:
+			position, tokenIndex, depth = position342, tokenIndex342, depth342
+			return false
+		},
+		/* 19 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action13)> */
+		func() bool {
+			position364, tokenIndex364, depth364 := position, tokenIndex, depth
+			{
+				position365 := position
+				depth++
 				{
 					position366, tokenIndex366, depth366 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('i') {
 						goto l367
 					}
 					position++
 					goto l366
 				l367:
 					position, tokenIndex, depth = position366, tokenIndex366, depth366
-					if buffer[position] != rune('S') {
-						goto l358
+					if buffer[position] != rune('I') {
+						goto l364
 					}
 					position++
 				}
 			l366:
 				{
 					position368, tokenIndex368, depth368 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('n') {
 						goto l369
 					}
 					position++
 					goto l368
 				l369:
 					position, tokenIndex, depth = position368, tokenIndex368, depth368
-					if buffer[position] != rune('E') {
-						goto l358
+					if buffer[position] != rune('N') {
+						goto l364
 					}
 					position++
 				}
 			l368:
-				if !_rules[rulesp]() {
-					goto l358
-				}
 				{
 					position370, tokenIndex370, depth370 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
@@ -4669,165 +4931,169 @@ func (p *bqlPegBackend) Init() {
 				l371:
 					position, tokenIndex, depth = position370, tokenIndex370, depth370
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l364
 					}
 					position++
 				}
 			l370:
 				{
 					position372, tokenIndex372, depth372 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('e') {
 						goto l373
 					}
 					position++
 					goto l372
 				l373:
 					position, tokenIndex, depth = position372, tokenIndex372, depth372
-					if buffer[position] != rune('O') {
-						goto l358
+					if buffer[position] != rune('E') {
+						goto l364
 					}
 					position++
 				}
 			l372:
 				{
 					position374, tokenIndex374, depth374 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('r') {
 						goto l375
 					}
 					position++
 					goto l374
 				l375:
 					position, tokenIndex, depth = position374, tokenIndex374, depth374
-					if buffer[position] != rune('U') {
-						goto l358
+					if buffer[position] != rune('R') {
+						goto l364
 					}
 					position++
 				}
 			l374:
 				{
 					position376, tokenIndex376, depth376 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('t') {
 						goto l377
 					}
 					position++
 					goto l376
 				l377:
 					position, tokenIndex, depth = position376, tokenIndex376, depth376
-					if buffer[position] != rune('R') {
-						goto l358
+					if buffer[position] != rune('T') {
+						goto l364
 					}
 					position++
 				}
 			l376:
+				if !_rules[rulesp]() {
+					goto l364
+				}
 				{
 					position378, tokenIndex378, depth378 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('i') {
 						goto l379
 					}
 					position++
 					goto l378
 				l379:
 					position, tokenIndex, depth = position378, tokenIndex378, depth378
-					if buffer[position] != rune('C') {
-						goto l358
+					if buffer[position] != rune('I') {
+						goto l364
 					}
 					position++
 				}
 			l378:
 				{
 					position380, tokenIndex380, depth380 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('n') {
 						goto l381
 					}
 					position++
 					goto l380
 				l381:
 					position, tokenIndex, depth = position380, tokenIndex380, depth380
-					if buffer[position] != rune('E') {
-						goto l358
+					if buffer[position] != rune('N') {
+						goto l364
 					}
 					position++
 				}
 			l380:
-				if !_rules[rulesp]() {
-					goto l358
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l358
-				}
-				if !_rules[ruleAction13]() {
-					goto l358
+				{
+					position382, tokenIndex382, depth382 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l383
+					}
+					position++
+					goto l382
+				l383:
+					position, tokenIndex, depth = position382, tokenIndex382, depth382
+					if buffer[position] != rune('T') {
+						goto l364
+					}
+					position++
 				}
-				depth--
-				add(rulePauseSourceStmt, position359)
-			}
-			return true
-		l358:
-			position, tokenIndex, depth = position358, tokenIndex358, depth358
-			return false
-		},
-		/* 20 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
-		func() bool {
-			position382, tokenIndex382, depth382 := position, tokenIndex, depth
-			{
-				position383 := position
-				depth++
+			l382:
 				{
 					position384, tokenIndex384, depth384 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('o') {
 						goto l385
 					}
 					position++
 					goto l384
 				l385:
 					position, tokenIndex, depth = position384, tokenIndex384, depth384
-					if buffer[position] != rune('R') {
-						goto l382
+					if buffer[position] != rune('O') {
+						goto l364
 					}
 					position++
 				}
 			l384:
+				if !_rules[rulesp]() {
+					goto l364
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l364
+				}
+				if !_rules[rulesp]() {
+					goto l364
+				}
 				{
 					position386, tokenIndex386, depth386 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('f') {
 						goto l387
 					}
 					position++
 					goto l386
 				l387:
 					position, tokenIndex, depth = position386, tokenIndex386, depth386
-					if buffer[position] != rune('E') {
-						goto l382
+					if buffer[position] != rune('F') {
+						goto l364
 					}
 					position++
 				}
 			l386:
 				{
 					position388, tokenIndex388, depth388 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('r') {
 						goto l389
 					}
 					position++
 					goto l388
 				l389:
 					position, tokenIndex, depth = position388, tokenIndex388, depth388
-					if buffer[position] != rune('S') {
-						goto l382
+					if buffer[position] != rune('R') {
+						goto l364
 					}
 					position++
 				}
 			l388:
 				{
 					position390, tokenIndex390, depth390 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('o') {
 						goto l391
 					}
 					position++
 					goto l390
 				l391:
 					position, tokenIndex, depth = position390, tokenIndex390, depth390
-					if buffer[position] != rune('U') {
-						goto l382
+					if buffer[position] != rune('O') {
+						goto l364
 					}
 					position++
 				}
@@ -4842,55 +5108,60 @@ func (p *bqlPegBackend) Init() {
 				l393:
 					position, tokenIndex, depth = position392, tokenIndex392, depth392
 					if buffer[position] != rune('M') {
-						goto l382
+						goto l364
 					}
 					position++
 				}
 			l392:
-				{
-					position394, tokenIndex394, depth394 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l395
-					}
-					position++
-					goto l394
-				l395:
-					position, tokenIndex, depth = position394, tokenIndex394, depth394
-					if buffer[position] != rune('E') {
-						goto l382
-					}
-					position++
-				}
-			l394:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l364
 				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l364
+				}
+				if !_rules[ruleAction13]() {
+					goto l364
+				}
+				depth--
+				add(ruleInsertIntoFromStmt, position365)
+			}
+			return true
+		l364:
+			position, tokenIndex, depth = position364, tokenIndex364, depth364
+			return false
+		},
+		/* 20 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
+		func() bool {
+			position394, tokenIndex394, depth394 := position, tokenIndex, depth
+			{
+				position395 := position
+				depth++
 				{
 					position396, tokenIndex396, depth396 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('p') {
 						goto l397
 					}
 					position++
 					goto l396
 				l397:
 					position, tokenIndex, depth = position396, tokenIndex396, depth396
-					if buffer[position] != rune('S') {
-						goto l382
+					if buffer[position] != rune('P') {
+						goto l394
 					}
 					position++
 				}
 			l396:
 				{
 					position398, tokenIndex398, depth398 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('a') {
 						goto l399
 					}
 					position++
 					goto l398
 				l399:
 					position, tokenIndex, depth = position398, tokenIndex398, depth398
-					if buffer[position] != rune('O') {
-						goto l382
+					if buffer[position] != rune('A') {
+						goto l394
 					}
 					position++
 				}
@@ -4905,198 +5176,198 @@ func (p *bqlPegBackend) Init() {
 				l401:
 					position, tokenIndex, depth = position400, tokenIndex400, depth400
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l394
 					}
 					position++
 				}
 			l400:
 				{
 					position402, tokenIndex402, depth402 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('s') {
 						goto l403
 					}
 					position++
 					goto l402
 				l403:
 					position, tokenIndex, depth = position402, tokenIndex402, depth402
-					if buffer[position] != rune('R') {
-						goto l382
+					if buffer[position] != rune('S') {
+						goto l394
 					}
 					position++
 				}
 			l402:
 				{
 					position404, tokenIndex404, depth404 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('e') {
 						goto l405
 					}
 					position++
 					goto l404
 				l405:
 					position, tokenIndex, depth = position404, tokenIndex404, depth404
-					if buffer[position] != rune('C') {
-						goto l382
+					if buffer[position] != rune('E') {
+						goto l394
 					}
 					position++
 				}
 			l404:
-				{
-					position406, tokenIndex406, depth406 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+				if !_rules[rulesp]() {
+					goto l394
+				}
+				{
+					position406, tokenIndex406, depth406 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
 						goto l407
 					}
 					position++
 					goto l406
 				l407:
 					position, tokenIndex, depth = position406, tokenIndex406, depth406
-					if buffer[position] != rune('E') {
-						goto l382
+					if buffer[position] != rune('S') {
+						goto l394
 					}
 					position++
 				}
 			l406:
-				if !_rules[rulesp]() {
-					goto l382
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l382
-				}
-				if !_rules[ruleAction14]() {
-					goto l382
+				{
+					position408, tokenIndex408, depth408 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l409
+					}
+					position++
+					goto l408
+				l409:
+					position, tokenIndex, depth = position408, tokenIndex408, depth408
+					if buffer[position] != rune('O') {
+						goto l394
+					}
+					position++
 				}
-				depth--
-				add(ruleResumeSourceStmt, position383)
-			}
-			return true
-		l382:
-			position, tokenIndex, depth = position382, tokenIndex382, depth382
-			return false
-		},
-		/* 21 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
-		func() bool {
-			position408, tokenIndex408, depth408 := position, tokenIndex, depth
-			{
-				position409 := position
-				depth++
+			l408:
 				{
 					position410, tokenIndex410, depth410 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('u') {
 						goto l411
 					}
 					position++
 					goto l410
 				l411:
 					position, tokenIndex, depth = position410, tokenIndex410, depth410
-					if buffer[position] != rune('R') {
-						goto l408
+					if buffer[position] != rune('U') {
+						goto l394
 					}
 					position++
 				}
 			l410:
 				{
 					position412, tokenIndex412, depth412 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('r') {
 						goto l413
 					}
 					position++
 					goto l412
 				l413:
 					position, tokenIndex, depth = position412, tokenIndex412, depth412
-					if buffer[position] != rune('E') {
-						goto l408
+					if buffer[position] != rune('R') {
+						goto l394
 					}
 					position++
 				}
 			l412:
 				{
 					position414, tokenIndex414, depth414 := position, tokenIndex, depth
-					if buffer[position] != rune('w') {
+					if buffer[position] != rune('c') {
 						goto l415
 					}
 					position++
 					goto l414
 				l415:
 					position, tokenIndex, depth = position414, tokenIndex414, depth414
-					if buffer[position] != rune('W') {
-						goto l408
+					if buffer[position] != rune('C') {
+						goto l394
 					}
 					position++
 				}
 			l414:
 				{
 					position416, tokenIndex416, depth416 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
+					if buffer[position] != rune('e') {
 						goto l417
 					}
 					position++
 					goto l416
 				l417:
 					position, tokenIndex, depth = position416, tokenIndex416, depth416
-					if buffer[position] != rune('I') {
-						goto l408
+					if buffer[position] != rune('E') {
+						goto l394
 					}
 					position++
 				}
 			l416:
-				{
-					position418, tokenIndex418, depth418 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l419
-					}
-					position++
-					goto l418
-				l419:
-					position, tokenIndex, depth = position418, tokenIndex418, depth418
-					if buffer[position] != rune('N') {
-						goto l408
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l394
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l394
+				}
+				if !_rules[ruleAction14]() {
+					goto l394
 				}
-			l418:
+				depth--
+				add(rulePauseSourceStmt, position395)
+			}
+			return true
+		l394:
+			position, tokenIndex, depth = position394, tokenIndex394, depth394
+			return false
+		},
+		/* 21 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
+		func() bool {
+			position418, tokenIndex418, depth418 := position, tokenIndex, depth
+			{
+				position419 := position
+				depth++
 				{
 					position420, tokenIndex420, depth420 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('r') {
 						goto l421
 					}
 					position++
 					goto l420
 				l421:
 					position, tokenIndex, depth = position420, tokenIndex420, depth420
-					if buffer[position] != rune('D') {
-						goto l408
+					if buffer[position] != rune('R') {
+						goto l418
 					}
 					position++
 				}
 			l420:
-				if !_rules[rulesp]() {
-					goto l408
-				}
 				{
 					position422, tokenIndex422, depth422 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('e') {
 						goto l423
 					}
 					position++
 					goto l422
 				l423:
 					position, tokenIndex, depth = position422, tokenIndex422, depth422
-					if buffer[position] != rune('S') {
-						goto l408
+					if buffer[position] != rune('E') {
+						goto l418
 					}
 					position++
 				}
 			l422:
 				{
 					position424, tokenIndex424, depth424 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('s') {
 						goto l425
 					}
 					position++
 					goto l424
 				l425:
 					position, tokenIndex, depth = position424, tokenIndex424, depth424
-					if buffer[position] != rune('O') {
-						goto l408
+					if buffer[position] != rune('S') {
+						goto l418
 					}
 					position++
 				}
@@ -5111,90 +5382,85 @@ func (p *bqlPegBackend) Init() {
 				l427:
 					position, tokenIndex, depth = position426, tokenIndex426, depth426
 					if buffer[position] != rune('U') {
-						goto l408
+						goto l418
 					}
 					position++
 				}
 			l426:
 				{
 					position428, tokenIndex428, depth428 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('m') {
 						goto l429
 					}
 					position++
 					goto l428
 				l429:
 					position, tokenIndex, depth = position428, tokenIndex428, depth428
-					if buffer[position] != rune('R') {
-						goto l408
+					if buffer[position] != rune('M') {
+						goto l418
 					}
 					position++
 				}
 			l428:
 				{
 					position430, tokenIndex430, depth430 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('e') {
 						goto l431
 					}
 					position++
 					goto l430
 				l431:
 					position, tokenIndex, depth = position430, tokenIndex430, depth430
-					if buffer[position] != rune('C') {
-						goto l408
+					if buffer[position] != rune('E') {
+						goto l418
 					}
 					position++
 				}
 			l430:
+				if !_rules[rulesp]() {
+					goto l418
+				}
 				{
 					position432, tokenIndex432, depth432 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('s') {
 						goto l433
 					}
 					position++
 					goto l432
 				l433:
 					position, tokenIndex, depth = position432, tokenIndex432, depth432
-					if buffer[position] != rune('E') {
-						goto l408
+					if buffer[position] != rune('S') {
+						goto l418
 					}
 					position++
 				}
 			l432:
-				if !_rules[rulesp]() {
-					goto l408
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l408
-				}
-				if !_rules[ruleAction15]() {
-					goto l408
+				{
+					position434, tokenIndex434, depth434 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l435
+					}
+					position++
+					goto l434
+				l435:
+					position, tokenIndex, depth = position434, tokenIndex434, depth434
+					if buffer[position] != rune('O') {
+						goto l418
+					}
+					position++
 				}
-				depth--
-				add(ruleRewindSourceStmt, position409)
-			}
-			return true
-		l408:
-			position, tokenIndex, depth = position408, tokenIndex408, depth408
-			return false
-		},
-		/* 22 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
-		func() bool {
-			position434, tokenIndex434, depth434 := position, tokenIndex, depth
-			{
-				position435 := position
-				depth++
+			l434:
 				{
 					position436, tokenIndex436, depth436 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('u') {
 						goto l437
 					}
 					position++
 					goto l436
 				l437:
 					position, tokenIndex, depth = position436, tokenIndex436, depth436
-					if buffer[position] != rune('D') {
-						goto l434
+					if buffer[position] != rune('U') {
+						goto l418
 					}
 					position++
 				}
@@ -5209,12396 +5475,16657 @@ func (p *bqlPegBackend) Init() {
 				l439:
 					position, tokenIndex, depth = position438, tokenIndex438, depth438
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l418
 					}
 					position++
 				}
 			l438:
 				{
 					position440, tokenIndex440, depth440 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('c') {
 						goto l441
 					}
 					position++
 					goto l440
 				l441:
 					position, tokenIndex, depth = position440, tokenIndex440, depth440
-					if buffer[position] != rune('O') {
-						goto l434
+					if buffer[position] != rune('C') {
+						goto l418
 					}
 					position++
 				}
 			l440:
 				{
 					position442, tokenIndex442, depth442 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('e') {
 						goto l443
 					}
 					position++
 					goto l442
 				l443:
 					position, tokenIndex, depth = position442, tokenIndex442, depth442
-					if buffer[position] != rune('P') {
-						goto l434
+					if buffer[position] != rune('E') {
+						goto l418
 					}
 					position++
 				}
 			l442:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l418
 				}
-				{
-					position444, tokenIndex444, depth444 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l445
-					}
-					position++
-					goto l444
-				l445:
-					position, tokenIndex, depth = position444, tokenIndex444, depth444
-					if buffer[position] != rune('S') {
-						goto l434
-					}
-					position++
+				if !_rules[ruleStreamIdentifier]() {
+					goto l418
+				}
+				if !_rules[ruleAction15]() {
+					goto l418
 				}
-			l444:
+				depth--
+				add(ruleResumeSourceStmt, position419)
+			}
+			return true
+		l418:
+			position, tokenIndex, depth = position418, tokenIndex418, depth418
+			return false
+		},
+		/* 22 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier RewindPositionOpt Action16)> */
+		func() bool {
+			position444, tokenIndex444, depth444 := position, tokenIndex, depth
+			{
+				position445 := position
+				depth++
 				{
 					position446, tokenIndex446, depth446 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('r') {
 						goto l447
 					}
 					position++
 					goto l446
 				l447:
 					position, tokenIndex, depth = position446, tokenIndex446, depth446
-					if buffer[position] != rune('O') {
-						goto l434
+					if buffer[position] != rune('R') {
+						goto l444
 					}
 					position++
 				}
 			l446:
 				{
 					position448, tokenIndex448, depth448 := position, tokenIndex, depth
-					if buffer[position] != rune('u') {
+					if buffer[position] != rune('e') {
 						goto l449
 					}
 					position++
 					goto l448
 				l449:
 					position, tokenIndex, depth = position448, tokenIndex448, depth448
-					if buffer[position] != rune('U') {
-						goto l434
+					if buffer[position] != rune('E') {
+						goto l444
 					}
 					position++
 				}
 			l448:
 				{
 					position450, tokenIndex450, depth450 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('w') {
 						goto l451
 					}
 					position++
 					goto l450
 				l451:
 					position, tokenIndex, depth = position450, tokenIndex450, depth450
-					if buffer[position] != rune('R') {
-						goto l434
+					if buffer[position] != rune('W') {
+						goto l444
 					}
 					position++
 				}
 			l450:
 				{
 					position452, tokenIndex452, depth452 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
+					if buffer[position] != rune('i') {
 						goto l453
 					}
 					position++
 					goto l452
 				l453:
 					position, tokenIndex, depth = position452, tokenIndex452, depth452
-					if buffer[position] != rune('C') {
-						goto l434
+					if buffer[position] != rune('I') {
+						goto l444
 					}
 					position++
 				}
 			l452:
 				{
 					position454, tokenIndex454, depth454 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
+					if buffer[position] != rune('n') {
 						goto l455
 					}
 					position++
 					goto l454
 				l455:
 					position, tokenIndex, depth = position454, tokenIndex454, depth454
-					if buffer[position] != rune('E') {
-						goto l434
+					if buffer[position] != rune('N') {
+						goto l444
 					}
 					position++
 				}
 			l454:
-				if !_rules[rulesp]() {
-					goto l434
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l434
+				{
+					position456, tokenIndex456, depth456 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l457
+					}
+					position++
+					goto l456
+				l457:
+					position, tokenIndex, depth = position456, tokenIndex456, depth456
+					if buffer[position] != rune('D') {
+						goto l444
+					}
+					position++
 				}
-				if !_rules[ruleAction16]() {
-					goto l434
+			l456:
+				if !_rules[rulesp]() {
+					goto l444
 				}
-				depth--
-				add(ruleDropSourceStmt, position435)
-			}
-			return true
-		l434:
-			position, tokenIndex, depth = position434, tokenIndex434, depth434
-			return false
-		},
-		/* 23 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
-		func() bool {
-			position456, tokenIndex456, depth456 := position, tokenIndex, depth
-			{
-				position457 := position
-				depth++
 				{
 					position458, tokenIndex458, depth458 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
+					if buffer[position] != rune('s') {
 						goto l459
 					}
 					position++
 					goto l458
 				l459:
 					position, tokenIndex, depth = position458, tokenIndex458, depth458
-					if buffer[position] != rune('D') {
-						goto l456
+					if buffer[position] != rune('S') {
+						goto l444
 					}
 					position++
 				}
 			l458:
 				{
 					position460, tokenIndex460, depth460 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
+					if buffer[position] != rune('o') {
 						goto l461
 					}
 					position++
 					goto l460
 				l461:
 					position, tokenIndex, depth = position460, tokenIndex460, depth460
-					if buffer[position] != rune('R') {
-						goto l456
+					if buffer[position] != rune('O') {
+						goto l444
 					}
 					position++
 				}
 			l460:
 				{
 					position462, tokenIndex462, depth462 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
+					if buffer[position] != rune('u') {
 						goto l463
 					}
 					position++
 					goto l462
 				l463:
 					position, tokenIndex, depth = position462, tokenIndex462, depth462
-					if buffer[position] != rune('O') {
-						goto l456
+					if buffer[position] != rune('U') {
+						goto l444
 					}
 					position++
 				}
 			l462:
 				{
 					position464, tokenIndex464, depth464 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
+					if buffer[position] != rune('r') {
 						goto l465
 					}
 					position++
 					goto l464
 				l465:
 					position, tokenIndex, depth = position464, tokenIndex464, depth464
-					if buffer[position] != rune('P') {
-						goto l456
+					if buffer[position] != rune('R') {
+						goto l444
 					}
 					position++
 				}
 			l464:
-				if !_rules[rulesp]() {
-					goto l456
-				}
 				{
 					position466, tokenIndex466, depth466 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
+					if buffer[position] != rune('c') {
 						goto l467
 					}
 					position++
 					goto l466
 				l467:
 					position, tokenIndex, depth = position466, tokenIndex466, depth466
-					if buffer[position] != rune('S') {
-						goto l456
+					if buffer[position] != rune('C') {
+						goto l444
 					}
 					position++
 				}
 			l466:
 				{
 					position468, tokenIndex468, depth468 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
+					if buffer[position] != rune('e') {
 						goto l469
 					}
 					position++
 					goto l468
 				l469:
 					position, tokenIndex, depth = position468, tokenIndex468, depth468
-					if buffer[position] != rune('T') {
-						goto l456
+					if buffer[position] != rune('E') {
+						goto l444
 					}
 					position++
 				}
 			l468:
-				{
-					position470, tokenIndex470, depth470 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l471
-					}
-					position++
-					goto l470
-				l471:
-					position, tokenIndex, depth = position470, tokenIndex470, depth470
-					if buffer[position] != rune('R') {
-						goto l456
-					}
-					position++
+				if !_rules[rulesp]() {
+					goto l444
 				}
-			l470:
-				{
-					position472, tokenIndex472, depth472 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l473
-					}
-					position++
-					goto l472
-				l473:
-					position, tokenIndex, depth = position472, tokenIndex472, depth472
-					if buffer[position] != rune('E') {
-						goto l456
-					}
-					position++
+				if !_rules[ruleStreamIdentifier]() {
+					goto l444
 				}
-			l472:
-				{
-					position474, tokenIndex474, depth474 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l475
-					}
-					position++
-					goto l474
-				l475:
-					position, tokenIndex, depth = position474, tokenIndex474, depth474
-					if buffer[position] != rune('A') {
-						goto l456
+				if !_rules[ruleRewindPositionOpt]() {
+					goto l444
+				}
+				if !_rules[ruleAction16]() {
+					goto l444
+				}
+				depth--
+				add(ruleRewindSourceStmt, position445)
+			}
+			return true
+		l444:
+			position, tokenIndex, depth = position444, tokenIndex444, depth444
+			return false
+		},
+		/* 23 RewindPositionOpt <- <(<(sp (('t' / 'T') ('o' / 'O')) sp (RewindToOffset / RewindToTimestamp))?> Action17)> */
+		func() bool {
+			position470, tokenIndex470, depth470 := position, tokenIndex, depth
+			{
+				position471 := position
+				depth++
+				{
+					position472 := position
+					depth++
+					{
+						position473, tokenIndex473, depth473 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l473
+						}
+						{
+							position475, tokenIndex475, depth475 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l476
+							}
+							position++
+							goto l475
+						l476:
+							position, tokenIndex, depth = position475, tokenIndex475, depth475
+							if buffer[position] != rune('T') {
+								goto l473
+							}
+							position++
+						}
+					l475:
+						{
+							position477, tokenIndex477, depth477 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l478
+							}
+							position++
+							goto l477
+						l478:
+							position, tokenIndex, depth = position477, tokenIndex477, depth477
+							if buffer[position] != rune('O') {
+								goto l473
+							}
+							position++
+						}
+					l477:
+						if !_rules[rulesp]() {
+							goto l473
+						}
+						{
+							position479, tokenIndex479, depth479 := position, tokenIndex, depth
+							if !_rules[ruleRewindToOffset]() {
+								goto l480
+							}
+							goto l479
+						l480:
+							position, tokenIndex, depth = position479, tokenIndex479, depth479
+							if !_rules[ruleRewindToTimestamp]() {
+								goto l473
+							}
+						}
+					l479:
+						goto l474
+					l473:
+						position, tokenIndex, depth = position473, tokenIndex473, depth473
 					}
-					position++
+				l474:
+					depth--
+					add(rulePegText, position472)
 				}
-			l474:
+				if !_rules[ruleAction17]() {
+					goto l470
+				}
+				depth--
+				add(ruleRewindPositionOpt, position471)
+			}
+			return true
+		l470:
+			position, tokenIndex, depth = position470, tokenIndex470, depth470
+			return false
+		},
+		/* 24 RewindToOffset <- <(<(('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T') sp NonNegativeNumericLiteral)> Action18)> */
+		func() bool {
+			position481, tokenIndex481, depth481 := position, tokenIndex, depth
+			{
+				position482 := position
+				depth++
 				{
-					position476, tokenIndex476, depth476 := position, tokenIndex, depth
-					if buffer[position] != rune('m') {
-						goto l477
+					position483 := position
+					depth++
+					{
+						position484, tokenIndex484, depth484 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l485
+						}
+						position++
+						goto l484
+					l485:
+						position, tokenIndex, depth = position484, tokenIndex484, depth484
+						if buffer[position] != rune('O') {
+							goto l481
+						}
+						position++
 					}
-					position++
-					goto l476
-				l477:
-					position, tokenIndex, depth = position476, tokenIndex476, depth476
-					if buffer[position] != rune('M') {
-						goto l456
+				l484:
+					{
+						position486, tokenIndex486, depth486 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l487
+						}
+						position++
+						goto l486
+					l487:
+						position, tokenIndex, depth = position486, tokenIndex486, depth486
+						if buffer[position] != rune('F') {
+							goto l481
+						}
+						position++
 					}
-					position++
+				l486:
+					{
+						position488, tokenIndex488, depth488 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l489
+						}
+						position++
+						goto l488
+					l489:
+						position, tokenIndex, depth = position488, tokenIndex488, depth488
+						if buffer[position] != rune('F') {
+							goto l481
+						}
+						position++
+					}
+				l488:
+					{
+						position490, tokenIndex490, depth490 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l491
+						}
+						position++
+						goto l490
+					l491:
+						position, tokenIndex, depth = position490, tokenIndex490, depth490
+						if buffer[position] != rune('S') {
+							goto l481
+						}
+						position++
+					}
+				l490:
+					{
+						position492, tokenIndex492, depth492 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l493
+						}
+						position++
+						goto l492
+					l493:
+						position, tokenIndex, depth = position492, tokenIndex492, depth492
+						if buffer[position] != rune('E') {
+							goto l481
+						}
+						position++
+					}
+				l492:
+					{
+						position494, tokenIndex494, depth494 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l495
+						}
+						position++
+						goto l494
+					l495:
+						position, tokenIndex, depth = position494, tokenIndex494, depth494
+						if buffer[position] != rune('T') {
+							goto l481
+						}
+						position++
+					}
+				l494:
+					if !_rules[rulesp]() {
+						goto l481
+					}
+					if !_rules[ruleNonNegativeNumericLiteral]() {
+						goto l481
+					}
+					depth--
+					add(rulePegText, position483)
 				}
-			l476:
-				if !_rules[rulesp]() {
-					goto l456
+				if !_rules[ruleAction18]() {
+					goto l481
 				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l456
+				depth--
+				add(ruleRewindToOffset, position482)
+			}
+			return true
+		l481:
+			position, tokenIndex, depth = position481, tokenIndex481, depth481
+			return false
+		},
+		/* 25 RewindToTimestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P') sp StringLiteral)> Action19)> */
+		func() bool {
+			position496, tokenIndex496, depth496 := position, tokenIndex, depth
+			{
+				position497 := position
+				depth++
+				{
+					position498 := position
+					depth++
+					{
+						position499, tokenIndex499, depth499 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l500
+						}
+						position++
+						goto l499
+					l500:
+						position, tokenIndex, depth = position499, tokenIndex499, depth499
+						if buffer[position] != rune('T') {
+							goto l496
+						}
+						position++
+					}
+				l499:
+					{
+						position501, tokenIndex501, depth501 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l502
+						}
+						position++
+						goto l501
+					l502:
+						position, tokenIndex, depth = position501, tokenIndex501, depth501
+						if buffer[position] != rune('I') {
+							goto l496
+						}
+						position++
+					}
+				l501:
+					{
+						position503, tokenIndex503, depth503 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l504
+						}
+						position++
+						goto l503
+					l504:
+						position, tokenIndex, depth = position503, tokenIndex503, depth503
+						if buffer[position] != rune('M') {
+							goto l496
+						}
+						position++
+					}
+				l503:
+					{
+						position505, tokenIndex505, depth505 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l506
+						}
+						position++
+						goto l505
+					l506:
+						position, tokenIndex, depth = position505, tokenIndex505, depth505
+						if buffer[position] != rune('E') {
+							goto l496
+						}
+						position++
+					}
+				l505:
+					{
+						position507, tokenIndex507, depth507 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l508
+						}
+						position++
+						goto l507
+					l508:
+						position, tokenIndex, depth = position507, tokenIndex507, depth507
+						if buffer[position] != rune('S') {
+							goto l496
+						}
+						position++
+					}
+				l507:
+					{
+						position509, tokenIndex509, depth509 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l510
+						}
+						position++
+						goto l509
+					l510:
+						position, tokenIndex, depth = position509, tokenIndex509, depth509
+						if buffer[position] != rune('T') {
+							goto l496
+						}
+						position++
+					}
+				l509:
+					{
+						position511, tokenIndex511, depth511 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l512
+						}
+						position++
+						goto l511
+					l512:
+						position, tokenIndex, depth = position511, tokenIndex511, depth511
+						if buffer[position] != rune('A') {
+							goto l496
+						}
+						position++
+					}
+				l511:
+					{
+						position513, tokenIndex513, depth513 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l514
+						}
+						position++
+						goto l513
+					l514:
+						position, tokenIndex, depth = position513, tokenIndex513, depth513
+						if buffer[position] != rune('M') {
+							goto l496
+						}
+						position++
+					}
+				l513:
+					{
+						position515, tokenIndex515, depth515 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l516
+						}
+						position++
+						goto l515
+					l516:
+						position, tokenIndex, depth = position515, tokenIndex515, depth515
+						if buffer[position] != rune('P') {
+							goto l496
+						}
+						position++
+					}
+				l515:
+					if !_rules[rulesp]() {
+						goto l496
+					}
+					if !_rules[ruleStringLiteral]() {
+						goto l496
+					}
+					depth--
+					add(rulePegText, position498)
 				}
-				if !_rules[ruleAction17]() {
-					goto l456
+				if !_rules[ruleAction19]() {
+					goto l496
 				}
 				depth--
-				add(ruleDropStreamStmt, position457)
+				add(ruleRewindToTimestamp, position497)
 			}
 			return true
-		l456:
-			position, tokenIndex, depth = position456, tokenIndex456, depth456
+		l496:
+			position, tokenIndex, depth = position496, tokenIndex496, depth496
 			return false
 		},
-		/* 24 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
+		/* 26 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action20)> */
 		func() bool {
-			position478, tokenIndex478, depth478 := position, tokenIndex, depth
+			position517, tokenIndex517, depth517 := position, tokenIndex, depth
 			{
-				position479 := position
+				position518 := position
 				depth++
 				{
-					position480, tokenIndex480, depth480 := position, tokenIndex, depth
+					position519, tokenIndex519, depth519 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l481
+						goto l520
 					}
 					position++
-					goto l480
-				l481:
-					position, tokenIndex, depth = position480, tokenIndex480, depth480
+					goto l519
+				l520:
+					position, tokenIndex, depth = position519, tokenIndex519, depth519
 					if buffer[position] != rune('D') {
-						goto l478
+						goto l517
 					}
 					position++
 				}
-			l480:
+			l519:
 				{
-					position482, tokenIndex482, depth482 := position, tokenIndex, depth
+					position521, tokenIndex521, depth521 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l483
+						goto l522
 					}
 					position++
-					goto l482
-				l483:
-					position, tokenIndex, depth = position482, tokenIndex482, depth482
+					goto l521
+				l522:
+					position, tokenIndex, depth = position521, tokenIndex521, depth521
 					if buffer[position] != rune('R') {
-						goto l478
+						goto l517
 					}
 					position++
 				}
-			l482:
+			l521:
 				{
-					position484, tokenIndex484, depth484 := position, tokenIndex, depth
+					position523, tokenIndex523, depth523 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l485
+						goto l524
 					}
 					position++
-					goto l484
-				l485:
-					position, tokenIndex, depth = position484, tokenIndex484, depth484
+					goto l523
+				l524:
+					position, tokenIndex, depth = position523, tokenIndex523, depth523
 					if buffer[position] != rune('O') {
-						goto l478
+						goto l517
 					}
 					position++
 				}
-			l484:
+			l523:
 				{
-					position486, tokenIndex486, depth486 := position, tokenIndex, depth
+					position525, tokenIndex525, depth525 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l487
+						goto l526
 					}
 					position++
-					goto l486
-				l487:
-					position, tokenIndex, depth = position486, tokenIndex486, depth486
+					goto l525
+				l526:
+					position, tokenIndex, depth = position525, tokenIndex525, depth525
 					if buffer[position] != rune('P') {
-						goto l478
+						goto l517
 					}
 					position++
 				}
-			l486:
+			l525:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l517
 				}
 				{
-					position488, tokenIndex488, depth488 := position, tokenIndex, depth
+					position527, tokenIndex527, depth527 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l489
+						goto l528
 					}
 					position++
-					goto l488
-				l489:
-					position, tokenIndex, depth = position488, tokenIndex488, depth488
+					goto l527
+				l528:
+					position, tokenIndex, depth = position527, tokenIndex527, depth527
 					if buffer[position] != rune('S') {
-						goto l478
+						goto l517
 					}
 					position++
 				}
-			l488:
+			l527:
 				{
-					position490, tokenIndex490, depth490 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
-						goto l491
+					position529, tokenIndex529, depth529 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l530
 					}
 					position++
-					goto l490
-				l491:
-					position, tokenIndex, depth = position490, tokenIndex490, depth490
-					if buffer[position] != rune('I') {
-						goto l478
+					goto l529
+				l530:
+					position, tokenIndex, depth = position529, tokenIndex529, depth529
+					if buffer[position] != rune('O') {
+						goto l517
 					}
 					position++
 				}
-			l490:
+			l529:
 				{
-					position492, tokenIndex492, depth492 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l493
+					position531, tokenIndex531, depth531 := position, tokenIndex, depth
+					if buffer[position] != rune('u') {
+						goto l532
 					}
 					position++
-					goto l492
-				l493:
-					position, tokenIndex, depth = position492, tokenIndex492, depth492
-					if buffer[position] != rune('N') {
-						goto l478
+					goto l531
+				l532:
+					position, tokenIndex, depth = position531, tokenIndex531, depth531
+					if buffer[position] != rune('U') {
+						goto l517
 					}
 					position++
 				}
-			l492:
+			l531:
 				{
-					position494, tokenIndex494, depth494 := position, tokenIndex, depth
-					if buffer[position] != rune('k') {
-						goto l495
+					position533, tokenIndex533, depth533 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l534
 					}
 					position++
-					goto l494
-				l495:
-					position, tokenIndex, depth = position494, tokenIndex494, depth494
-					if buffer[position] != rune('K') {
-						goto l478
+					goto l533
+				l534:
+					position, tokenIndex, depth = position533, tokenIndex533, depth533
+					if buffer[position] != rune('R') {
+						goto l517
 					}
 					position++
 				}
-			l494:
-				if !_rules[rulesp]() {
-					goto l478
-				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l478
+			l533:
+				{
+					position535, tokenIndex535, depth535 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l536
+					}
+					position++
+					goto l535
+				l536:
+					position, tokenIndex, depth = position535, tokenIndex535, depth535
+					if buffer[position] != rune('C') {
+						goto l517
+					}
+					position++
 				}
-				if !_rules[ruleAction18]() {
-					goto l478
+			l535:
+				{
+					position537, tokenIndex537, depth537 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l538
+					}
+					position++
+					goto l537
+				l538:
+					position, tokenIndex, depth = position537, tokenIndex537, depth537
+					if buffer[position] != rune('E') {
+						goto l517
+					}
+					position++
+				}
+			l537:
+				if !_rules[rulesp]() {
+					goto l517
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l517
+				}
+				if !_rules[ruleAction20]() {
+					goto l517
 				}
 				depth--
-				add(ruleDropSinkStmt, position479)
+				add(ruleDropSourceStmt, position518)
 			}
 			return true
-		l478:
-			position, tokenIndex, depth = position478, tokenIndex478, depth478
+		l517:
+			position, tokenIndex, depth = position517, tokenIndex517, depth517
 			return false
 		},
-		/* 25 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
+		/* 27 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action21)> */
 		func() bool {
-			position496, tokenIndex496, depth496 := position, tokenIndex, depth
+			position539, tokenIndex539, depth539 := position, tokenIndex, depth
 			{
-				position497 := position
+				position540 := position
 				depth++
 				{
-					position498, tokenIndex498, depth498 := position, tokenIndex, depth
+					position541, tokenIndex541, depth541 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l499
+						goto l542
 					}
 					position++
-					goto l498
-				l499:
-					position, tokenIndex, depth = position498, tokenIndex498, depth498
+					goto l541
+				l542:
+					position, tokenIndex, depth = position541, tokenIndex541, depth541
 					if buffer[position] != rune('D') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l498:
+			l541:
 				{
-					position500, tokenIndex500, depth500 := position, tokenIndex, depth
+					position543, tokenIndex543, depth543 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l501
+						goto l544
 					}
 					position++
-					goto l500
-				l501:
-					position, tokenIndex, depth = position500, tokenIndex500, depth500
+					goto l543
+				l544:
+					position, tokenIndex, depth = position543, tokenIndex543, depth543
 					if buffer[position] != rune('R') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l500:
+			l543:
 				{
-					position502, tokenIndex502, depth502 := position, tokenIndex, depth
+					position545, tokenIndex545, depth545 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l503
+						goto l546
 					}
 					position++
-					goto l502
-				l503:
-					position, tokenIndex, depth = position502, tokenIndex502, depth502
+					goto l545
+				l546:
+					position, tokenIndex, depth = position545, tokenIndex545, depth545
 					if buffer[position] != rune('O') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l502:
+			l545:
 				{
-					position504, tokenIndex504, depth504 := position, tokenIndex, depth
+					position547, tokenIndex547, depth547 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l505
+						goto l548
 					}
 					position++
-					goto l504
-				l505:
-					position, tokenIndex, depth = position504, tokenIndex504, depth504
+					goto l547
+				l548:
+					position, tokenIndex, depth = position547, tokenIndex547, depth547
 					if buffer[position] != rune('P') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l504:
+			l547:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l539
 				}
 				{
-					position506, tokenIndex506, depth506 := position, tokenIndex, depth
+					position549, tokenIndex549, depth549 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l507
+						goto l550
 					}
 					position++
-					goto l506
-				l507:
-					position, tokenIndex, depth = position506, tokenIndex506, depth506
+					goto l549
+				l550:
+					position, tokenIndex, depth = position549, tokenIndex549, depth549
 					if buffer[position] != rune('S') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l506:
+			l549:
 				{
-					position508, tokenIndex508, depth508 := position, tokenIndex, depth
+					position551, tokenIndex551, depth551 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l509
+						goto l552
 					}
 					position++
-					goto l508
-				l509:
-					position, tokenIndex, depth = position508, tokenIndex508, depth508
+					goto l551
+				l552:
+					position, tokenIndex, depth = position551, tokenIndex551, depth551
 					if buffer[position] != rune('T') {
-						goto l496
+						goto l539
 					}
 					position++
 				}
-			l508:
+			l551:
 				{
-					position510, tokenIndex510, depth510 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l511
+					position553, tokenIndex553, depth553 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l554
 					}
 					position++
-					goto l510
-				l511:
-					position, tokenIndex, depth = position510, tokenIndex510, depth510
-					if buffer[position] != rune('A') {
-						goto l496
+					goto l553
+				l554:
+					position, tokenIndex, depth = position553, tokenIndex553, depth553
+					if buffer[position] != rune('R') {
+						goto l539
 					}
 					position++
 				}
-			l510:
+			l553:
 				{
-					position512, tokenIndex512, depth512 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l513
+					position555, tokenIndex555, depth555 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l556
 					}
 					position++
-					goto l512
-				l513:
-					position, tokenIndex, depth = position512, tokenIndex512, depth512
-					if buffer[position] != rune('T') {
-						goto l496
+					goto l555
+				l556:
+					position, tokenIndex, depth = position555, tokenIndex555, depth555
+					if buffer[position] != rune('E') {
+						goto l539
 					}
 					position++
 				}
-			l512:
+			l555:
 				{
-					position514, tokenIndex514, depth514 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l515
+					position557, tokenIndex557, depth557 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l558
 					}
 					position++
-					goto l514
-				l515:
-					position, tokenIndex, depth = position514, tokenIndex514, depth514
-					if buffer[position] != rune('E') {
-						goto l496
+					goto l557
+				l558:
+					position, tokenIndex, depth = position557, tokenIndex557, depth557
+					if buffer[position] != rune('A') {
+						goto l539
+					}
+					position++
+				}
+			l557:
+				{
+					position559, tokenIndex559, depth559 := position, tokenIndex, depth
+					if buffer[position] != rune('m') {
+						goto l560
+					}
+					position++
+					goto l559
+				l560:
+					position, tokenIndex, depth = position559, tokenIndex559, depth559
+					if buffer[position] != rune('M') {
+						goto l539
 					}
 					position++
 				}
-			l514:
+			l559:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l539
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l496
+					goto l539
 				}
-				if !_rules[ruleAction19]() {
-					goto l496
+				if !_rules[ruleAction21]() {
+					goto l539
 				}
 				depth--
-				add(ruleDropStateStmt, position497)
+				add(ruleDropStreamStmt, position540)
 			}
 			return true
-		l496:
-			position, tokenIndex, depth = position496, tokenIndex496, depth496
+		l539:
+			position, tokenIndex, depth = position539, tokenIndex539, depth539
 			return false
 		},
-		/* 26 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
+		/* 28 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action22)> */
 		func() bool {
-			position516, tokenIndex516, depth516 := position, tokenIndex, depth
+			position561, tokenIndex561, depth561 := position, tokenIndex, depth
 			{
-				position517 := position
+				position562 := position
 				depth++
 				{
-					position518, tokenIndex518, depth518 := position, tokenIndex, depth
-					if buffer[position] != rune('l') {
-						goto l519
+					position563, tokenIndex563, depth563 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l564
 					}
 					position++
-					goto l518
-				l519:
-					position, tokenIndex, depth = position518, tokenIndex518, depth518
-					if buffer[position] != rune('L') {
-						goto l516
+					goto l563
+				l564:
+					position, tokenIndex, depth = position563, tokenIndex563, depth563
+					if buffer[position] != rune('D') {
+						goto l561
 					}
 					position++
 				}
-			l518:
+			l563:
 				{
-					position520, tokenIndex520, depth520 := position, tokenIndex, depth
-					if buffer[position] != rune('o') {
-						goto l521
+					position565, tokenIndex565, depth565 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l566
 					}
 					position++
-					goto l520
-				l521:
-					position, tokenIndex, depth = position520, tokenIndex520, depth520
-					if buffer[position] != rune('O') {
-						goto l516
+					goto l565
+				l566:
+					position, tokenIndex, depth = position565, tokenIndex565, depth565
+					if buffer[position] != rune('R') {
+						goto l561
 					}
 					position++
 				}
-			l520:
+			l565:
 				{
-					position522, tokenIndex522, depth522 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l523
+					position567, tokenIndex567, depth567 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l568
 					}
 					position++
-					goto l522
-				l523:
-					position, tokenIndex, depth = position522, tokenIndex522, depth522
-					if buffer[position] != rune('A') {
-						goto l516
+					goto l567
+				l568:
+					position, tokenIndex, depth = position567, tokenIndex567, depth567
+					if buffer[position] != rune('O') {
+						goto l561
 					}
 					position++
 				}
-			l522:
+			l567:
 				{
-					position524, tokenIndex524, depth524 := position, tokenIndex, depth
-					if buffer[position] != rune('d') {
-						goto l525
+					position569, tokenIndex569, depth569 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l570
 					}
 					position++
-					goto l524
-				l525:
-					position, tokenIndex, depth = position524, tokenIndex524, depth524
-					if buffer[position] != rune('D') {
-						goto l516
+					goto l569
+				l570:
+					position, tokenIndex, depth = position569, tokenIndex569, depth569
+					if buffer[position] != rune('P') {
+						goto l561
 					}
 					position++
 				}
-			l524:
+			l569:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l561
 				}
 				{
-					position526, tokenIndex526, depth526 := position, tokenIndex, depth
+					position571, tokenIndex571, depth571 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l527
+						goto l572
 					}
 					position++
-					goto l526
-				l527:
-					position, tokenIndex, depth = position526, tokenIndex526, depth526
+					goto l571
+				l572:
+					position, tokenIndex, depth = position571, tokenIndex571, depth571
 					if buffer[position] != rune('S') {
-						goto l516
+						goto l561
 					}
 					position++
 				}
-			l526:
+			l571:
 				{
-					position528, tokenIndex528, depth528 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l529
+					position573, tokenIndex573, depth573 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l574
 					}
 					position++
-					goto l528
-				l529:
-					position, tokenIndex, depth = position528, tokenIndex528, depth528
-					if buffer[position] != rune('T') {
-						goto l516
+					goto l573
+				l574:
+					position, tokenIndex, depth = position573, tokenIndex573, depth573
+					if buffer[position] != rune('I') {
+						goto l561
 					}
 					position++
 				}
-			l528:
+			l573:
 				{
-					position530, tokenIndex530, depth530 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l531
+					position575, tokenIndex575, depth575 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l576
 					}
 					position++
-					goto l530
-				l531:
-					position, tokenIndex, depth = position530, tokenIndex530, depth530
-					if buffer[position] != rune('A') {
-						goto l516
+					goto l575
+				l576:
+					position, tokenIndex, depth = position575, tokenIndex575, depth575
+					if buffer[position] != rune('N') {
+						goto l561
 					}
 					position++
 				}
-			l530:
+			l575:
 				{
-					position532, tokenIndex532, depth532 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l533
+					position577, tokenIndex577, depth577 := position, tokenIndex, depth
+					if buffer[position] != rune('k') {
+						goto l578
 					}
 					position++
-					goto l532
-				l533:
-					position, tokenIndex, depth = position532, tokenIndex532, depth532
-					if buffer[position] != rune('T') {
-						goto l516
+					goto l577
+				l578:
+					position, tokenIndex, depth = position577, tokenIndex577, depth577
+					if buffer[position] != rune('K') {
+						goto l561
+					}
+					position++
+				}
+			l577:
+				if !_rules[rulesp]() {
+					goto l561
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l561
+				}
+				if !_rules[ruleAction22]() {
+					goto l561
+				}
+				depth--
+				add(ruleDropSinkStmt, position562)
+			}
+			return true
+		l561:
+			position, tokenIndex, depth = position561, tokenIndex561, depth561
+			return false
+		},
+		/* 29 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action23)> */
+		func() bool {
+			position579, tokenIndex579, depth579 := position, tokenIndex, depth
+			{
+				position580 := position
+				depth++
+				{
+					position581, tokenIndex581, depth581 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l582
+					}
+					position++
+					goto l581
+				l582:
+					position, tokenIndex, depth = position581, tokenIndex581, depth581
+					if buffer[position] != rune('D') {
+						goto l579
 					}
 					position++
 				}
-			l532:
+			l581:
 				{
-					position534, tokenIndex534, depth534 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l535
+					position583, tokenIndex583, depth583 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l584
 					}
 					position++
-					goto l534
-				l535:
-					position, tokenIndex, depth = position534, tokenIndex534, depth534
-					if buffer[position] != rune('E') {
-						goto l516
+					goto l583
+				l584:
+					position, tokenIndex, depth = position583, tokenIndex583, depth583
+					if buffer[position] != rune('R') {
+						goto l579
 					}
 					position++
 				}
-			l534:
-				if !_rules[rulesp]() {
-					goto l516
+			l583:
+				{
+					position585, tokenIndex585, depth585 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l586
+					}
+					position++
+					goto l585
+				l586:
+					position, tokenIndex, depth = position585, tokenIndex585, depth585
+					if buffer[position] != rune('O') {
+						goto l579
+					}
+					position++
 				}
-				if !_rules[ruleStreamIdentifier]() {
-					goto l516
+			l585:
+				{
+					position587, tokenIndex587, depth587 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l588
+					}
+					position++
+					goto l587
+				l588:
+					position, tokenIndex, depth = position587, tokenIndex587, depth587
+					if buffer[position] != rune('P') {
+						goto l579
+					}
+					position++
 				}
+			l587:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l579
+				}
+				{
+					position589, tokenIndex589, depth589 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l590
+					}
+					position++
+					goto l589
+				l590:
+					position, tokenIndex, depth = position589, tokenIndex589, depth589
+					if buffer[position] != rune('S') {
+						goto l579
+					}
+					position++
 				}
+			l589:
 				{
-					position536, tokenIndex536, depth536 := position, tokenIndex, depth
+					position591, tokenIndex591, depth591 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l537
+						goto l592
 					}
 					position++
-					goto l536
-				l537:
-					position, tokenIndex, depth = position536, tokenIndex536, depth536
+					goto l591
+				l592:
+					position, tokenIndex, depth = position591, tokenIndex591, depth591
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l579
 					}
 					position++
 				}
-			l536:
+			l591:
 				{
-					position538, tokenIndex538, depth538 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
-						goto l539
+					position593, tokenIndex593, depth593 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l594
 					}
 					position++
-					goto l538
-				l539:
-					position, tokenIndex, depth = position538, tokenIndex538, depth538
-					if buffer[position] != rune('Y') {
-						goto l516
+					goto l593
+				l594:
+					position, tokenIndex, depth = position593, tokenIndex593, depth593
+					if buffer[position] != rune('A') {
+						goto l579
 					}
 					position++
 				}
-			l538:
+			l593:
 				{
-					position540, tokenIndex540, depth540 := position, tokenIndex, depth
-					if buffer[position] != rune('p') {
-						goto l541
+					position595, tokenIndex595, depth595 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l596
 					}
 					position++
-					goto l540
-				l541:
-					position, tokenIndex, depth = position540, tokenIndex540, depth540
-					if buffer[position] != rune('P') {
-						goto l516
+					goto l595
+				l596:
+					position, tokenIndex, depth = position595, tokenIndex595, depth595
+					if buffer[position] != rune('T') {
+						goto l579
 					}
 					position++
 				}
-			l540:
+			l595:
 				{
-					position542, tokenIndex542, depth542 := position, tokenIndex, depth
+					position597, tokenIndex597, depth597 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l543
+						goto l598
 					}
 					position++
-					goto l542
-				l543:
-					position, tokenIndex, depth = position542, tokenIndex542, depth542
+					goto l597
+				l598:
+					position, tokenIndex, depth = position597, tokenIndex597, depth597
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l579
 					}
 					position++
 				}
-			l542:
+			l597:
 				if !_rules[rulesp]() {
-					goto l516
-				}
-				if !_rules[ruleSourceSinkType]() {
-					goto l516
-				}
-				if !_rules[ruleStateTagOpt]() {
-					goto l516
+					goto l579
 				}
-				if !_rules[ruleSetOptSpecs]() {
-					goto l516
+				if !_rules[ruleStreamIdentifier]() {
+					goto l579
 				}
-				if !_rules[ruleAction20]() {
-					goto l516
+				if !_rules[ruleAction23]() {
+					goto l579
 				}
 				depth--
-				add(ruleLoadStateStmt, position517)
+				add(ruleDropStateStmt, position580)
 			}
 			return true
-		l516:
-			position, tokenIndex, depth = position516, tokenIndex516, depth516
+		l579:
+			position, tokenIndex, depth = position579, tokenIndex579, depth579
 			return false
 		},
-		/* 27 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
+		/* 30 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action24)> */
 		func() bool {
-			position544, tokenIndex544, depth544 := position, tokenIndex, depth
+			position599, tokenIndex599, depth599 := position, tokenIndex, depth
 			{
-				position545 := position
+				position600 := position
 				depth++
-				if !_rules[ruleLoadStateStmt]() {
-					goto l544
-				}
-				if !_rules[rulesp]() {
-					goto l544
+				{
+					position601, tokenIndex601, depth601 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l602
+					}
+					position++
+					goto l601
+				l602:
+					position, tokenIndex, depth = position601, tokenIndex601, depth601
+					if buffer[position] != rune('L') {
+						goto l599
+					}
+					position++
 				}
+			l601:
 				{
-					position546, tokenIndex546, depth546 := position, tokenIndex, depth
+					position603, tokenIndex603, depth603 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l547
+						goto l604
 					}
 					position++
-					goto l546
-				l547:
-					position, tokenIndex, depth = position546, tokenIndex546, depth546
+					goto l603
+				l604:
+					position, tokenIndex, depth = position603, tokenIndex603, depth603
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l599
 					}
 					position++
 				}
-			l546:
+			l603:
 				{
-					position548, tokenIndex548, depth548 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l549
+					position605, tokenIndex605, depth605 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l606
 					}
 					position++
-					goto l548
-				l549:
-					position, tokenIndex, depth = position548, tokenIndex548, depth548
-					if buffer[position] != rune('R') {
-						goto l544
+					goto l605
+				l606:
+					position, tokenIndex, depth = position605, tokenIndex605, depth605
+					if buffer[position] != rune('A') {
+						goto l599
 					}
 					position++
 				}
-			l548:
-				if !_rules[rulesp]() {
-					goto l544
-				}
+			l605:
 				{
-					position550, tokenIndex550, depth550 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l551
+					position607, tokenIndex607, depth607 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l608
 					}
 					position++
-					goto l550
-				l551:
-					position, tokenIndex, depth = position550, tokenIndex550, depth550
-					if buffer[position] != rune('C') {
-						goto l544
+					goto l607
+				l608:
+					position, tokenIndex, depth = position607, tokenIndex607, depth607
+					if buffer[position] != rune('D') {
+						goto l599
 					}
 					position++
 				}
-			l550:
+			l607:
+				if !_rules[rulesp]() {
+					goto l599
+				}
 				{
-					position552, tokenIndex552, depth552 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l553
+					position609, tokenIndex609, depth609 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l610
 					}
 					position++
-					goto l552
-				l553:
-					position, tokenIndex, depth = position552, tokenIndex552, depth552
-					if buffer[position] != rune('R') {
-						goto l544
+					goto l609
+				l610:
+					position, tokenIndex, depth = position609, tokenIndex609, depth609
+					if buffer[position] != rune('S') {
+						goto l599
 					}
 					position++
 				}
-			l552:
+			l609:
 				{
-					position554, tokenIndex554, depth554 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l555
+					position611, tokenIndex611, depth611 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l612
 					}
 					position++
-					goto l554
-				l555:
-					position, tokenIndex, depth = position554, tokenIndex554, depth554
-					if buffer[position] != rune('E') {
-						goto l544
+					goto l611
+				l612:
+					position, tokenIndex, depth = position611, tokenIndex611, depth611
+					if buffer[position] != rune('T') {
+						goto l599
 					}
 					position++
 				}
-			l554:
+			l611:
 				{
-					position556, tokenIndex556, depth556 := position, tokenIndex, depth
+					position613, tokenIndex613, depth613 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l557
+						goto l614
 					}
 					position++
-					goto l556
-				l557:
-					position, tokenIndex, depth = position556, tokenIndex556, depth556
+					goto l613
+				l614:
+					position, tokenIndex, depth = position613, tokenIndex613, depth613
 					if buffer[position] != rune('A') {
-						goto l544
+						goto l599
 					}
 					position++
 				}
-			l556:
+			l613:
 				{
-					position558, tokenIndex558, depth558 := position, tokenIndex, depth
+					position615, tokenIndex615, depth615 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l559
+						goto l616
 					}
 					position++
-					goto l558
-				l559:
-					position, tokenIndex, depth = position558, tokenIndex558, depth558
+					goto l615
+				l616:
+					position, tokenIndex, depth = position615, tokenIndex615, depth615
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l599
 					}
 					position++
 				}
-			l558:
+			l615:
 				{
-					position560, tokenIndex560, depth560 := position, tokenIndex, depth
+					position617, tokenIndex617, depth617 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l561
+						goto l618
 					}
 					position++
-					goto l560
-				l561:
-					position, tokenIndex, depth = position560, tokenIndex560, depth560
+					goto l617
+				l618:
+					position, tokenIndex, depth = position617, tokenIndex617, depth617
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l599
 					}
 					position++
 				}
-			l560:
+			l617:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l599
 				}
-				{
-					position562, tokenIndex562, depth562 := position, tokenIndex, depth
-					if buffer[position] != rune('i') {
-						goto l563
+				if !_rules[ruleStreamIdentifier]() {
+					goto l599
+				}
+				if !_rules[rulesp]() {
+					goto l599
+				}
+				{
+					position619, tokenIndex619, depth619 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l620
+					}
+					position++
+					goto l619
+				l620:
+					position, tokenIndex, depth = position619, tokenIndex619, depth619
+					if buffer[position] != rune('T') {
+						goto l599
+					}
+					position++
+				}
+			l619:
+				{
+					position621, tokenIndex621, depth621 := position, tokenIndex, depth
+					if buffer[position] != rune('y') {
+						goto l622
+					}
+					position++
+					goto l621
+				l622:
+					position, tokenIndex, depth = position621, tokenIndex621, depth621
+					if buffer[position] != rune('Y') {
+						goto l599
+					}
+					position++
+				}
+			l621:
+				{
+					position623, tokenIndex623, depth623 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l624
+					}
+					position++
+					goto l623
+				l624:
+					position, tokenIndex, depth = position623, tokenIndex623, depth623
+					if buffer[position] != rune('P') {
+						goto l599
+					}
+					position++
+				}
+			l623:
+				{
+					position625, tokenIndex625, depth625 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l626
+					}
+					position++
+					goto l625
+				l626:
+					position, tokenIndex, depth = position625, tokenIndex625, depth625
+					if buffer[position] != rune('E') {
+						goto l599
+					}
+					position++
+				}
+			l625:
+				if !_rules[rulesp]() {
+					goto l599
+				}
+				if !_rules[ruleSourceSinkType]() {
+					goto l599
+				}
+				if !_rules[ruleStateTagOpt]() {
+					goto l599
+				}
+				if !_rules[ruleSetOptSpecs]() {
+					goto l599
+				}
+				if !_rules[ruleAction24]() {
+					goto l599
+				}
+				depth--
+				add(ruleLoadStateStmt, position600)
+			}
+			return true
+		l599:
+			position, tokenIndex, depth = position599, tokenIndex599, depth599
+			return false
+		},
+		/* 31 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action25)> */
+		func() bool {
+			position627, tokenIndex627, depth627 := position, tokenIndex, depth
+			{
+				position628 := position
+				depth++
+				if !_rules[ruleLoadStateStmt]() {
+					goto l627
+				}
+				if !_rules[rulesp]() {
+					goto l627
+				}
+				{
+					position629, tokenIndex629, depth629 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l630
+					}
+					position++
+					goto l629
+				l630:
+					position, tokenIndex, depth = position629, tokenIndex629, depth629
+					if buffer[position] != rune('O') {
+						goto l627
+					}
+					position++
+				}
+			l629:
+				{
+					position631, tokenIndex631, depth631 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l632
+					}
+					position++
+					goto l631
+				l632:
+					position, tokenIndex, depth = position631, tokenIndex631, depth631
+					if buffer[position] != rune('R') {
+						goto l627
+					}
+					position++
+				}
+			l631:
+				if !_rules[rulesp]() {
+					goto l627
+				}
+				{
+					position633, tokenIndex633, depth633 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l634
+					}
+					position++
+					goto l633
+				l634:
+					position, tokenIndex, depth = position633, tokenIndex633, depth633
+					if buffer[position] != rune('C') {
+						goto l627
+					}
+					position++
+				}
+			l633:
+				{
+					position635, tokenIndex635, depth635 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l636
+					}
+					position++
+					goto l635
+				l636:
+					position, tokenIndex, depth = position635, tokenIndex635, depth635
+					if buffer[position] != rune('R') {
+						goto l627
+					}
+					position++
+				}
+			l635:
+				{
+					position637, tokenIndex637, depth637 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l638
+					}
+					position++
+					goto l637
+				l638:
+					position, tokenIndex, depth = position637, tokenIndex637, depth637
+					if buffer[position] != rune('E') {
+						goto l627
+					}
+					position++
+				}
+			l637:
+				{
+					position639, tokenIndex639, depth639 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l640
+					}
+					position++
+					goto l639
+				l640:
+					position, tokenIndex, depth = position639, tokenIndex639, depth639
+					if buffer[position] != rune('A') {
+						goto l627
+					}
+					position++
+				}
+			l639:
+				{
+					position641, tokenIndex641, depth641 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l642
+					}
+					position++
+					goto l641
+				l642:
+					position, tokenIndex, depth = position641, tokenIndex641, depth641
+					if buffer[position] != rune('T') {
+						goto l627
+					}
+					position++
+				}
+			l641:
+				{
+					position643, tokenIndex643, depth643 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l644
+					}
+					position++
+					goto l643
+				l644:
+					position, tokenIndex, depth = position643, tokenIndex643, depth643
+					if buffer[position] != rune('E') {
+						goto l627
+					}
+					position++
+				}
+			l643:
+				if !_rules[rulesp]() {
+					goto l627
+				}
+				{
+					position645, tokenIndex645, depth645 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l646
 					}
 					position++
-					goto l562
-				l563:
-					position, tokenIndex, depth = position562, tokenIndex562, depth562
+					goto l645
+				l646:
+					position, tokenIndex, depth = position645, tokenIndex645, depth645
 					if buffer[position] != rune('I') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l562:
+			l645:
 				{
-					position564, tokenIndex564, depth564 := position, tokenIndex, depth
+					position647, tokenIndex647, depth647 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l565
+						goto l648
 					}
 					position++
-					goto l564
-				l565:
-					position, tokenIndex, depth = position564, tokenIndex564, depth564
+					goto l647
+				l648:
+					position, tokenIndex, depth = position647, tokenIndex647, depth647
 					if buffer[position] != rune('F') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l564:
+			l647:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position566, tokenIndex566, depth566 := position, tokenIndex, depth
+					position649, tokenIndex649, depth649 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l567
+						goto l650
 					}
 					position++
-					goto l566
-				l567:
-					position, tokenIndex, depth = position566, tokenIndex566, depth566
+					goto l649
+				l650:
+					position, tokenIndex, depth = position649, tokenIndex649, depth649
 					if buffer[position] != rune('N') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l566:
+			l649:
 				{
-					position568, tokenIndex568, depth568 := position, tokenIndex, depth
+					position651, tokenIndex651, depth651 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l569
+						goto l652
 					}
 					position++
-					goto l568
-				l569:
-					position, tokenIndex, depth = position568, tokenIndex568, depth568
+					goto l651
+				l652:
+					position, tokenIndex, depth = position651, tokenIndex651, depth651
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l568:
+			l651:
 				{
-					position570, tokenIndex570, depth570 := position, tokenIndex, depth
+					position653, tokenIndex653, depth653 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l571
+						goto l654
 					}
 					position++
-					goto l570
-				l571:
-					position, tokenIndex, depth = position570, tokenIndex570, depth570
+					goto l653
+				l654:
+					position, tokenIndex, depth = position653, tokenIndex653, depth653
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l570:
+			l653:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position572, tokenIndex572, depth572 := position, tokenIndex, depth
+					position655, tokenIndex655, depth655 := position, tokenIndex, depth
 					{
-						position574, tokenIndex574, depth574 := position, tokenIndex, depth
+						position657, tokenIndex657, depth657 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l575
+							goto l658
 						}
 						position++
-						goto l574
-					l575:
-						position, tokenIndex, depth = position574, tokenIndex574, depth574
+						goto l657
+					l658:
+						position, tokenIndex, depth = position657, tokenIndex657, depth657
 						if buffer[position] != rune('S') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l574:
+				l657:
 					{
-						position576, tokenIndex576, depth576 := position, tokenIndex, depth
+						position659, tokenIndex659, depth659 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l577
+							goto l660
 						}
 						position++
-						goto l576
-					l577:
-						position, tokenIndex, depth = position576, tokenIndex576, depth576
+						goto l659
+					l660:
+						position, tokenIndex, depth = position659, tokenIndex659, depth659
 						if buffer[position] != rune('A') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l576:
+				l659:
 					{
-						position578, tokenIndex578, depth578 := position, tokenIndex, depth
+						position661, tokenIndex661, depth661 := position, tokenIndex, depth
 						if buffer[position] != rune('v') {
-							goto l579
+							goto l662
 						}
 						position++
-						goto l578
-					l579:
-						position, tokenIndex, depth = position578, tokenIndex578, depth578
+						goto l661
+					l662:
+						position, tokenIndex, depth = position661, tokenIndex661, depth661
 						if buffer[position] != rune('V') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l578:
+				l661:
 					{
-						position580, tokenIndex580, depth580 := position, tokenIndex, depth
+						position663, tokenIndex663, depth663 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l581
+							goto l664
 						}
 						position++
-						goto l580
-					l581:
-						position, tokenIndex, depth = position580, tokenIndex580, depth580
+						goto l663
+					l664:
+						position, tokenIndex, depth = position663, tokenIndex663, depth663
 						if buffer[position] != rune('E') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l580:
+				l663:
 					{
-						position582, tokenIndex582, depth582 := position, tokenIndex, depth
+						position665, tokenIndex665, depth665 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l583
+							goto l666
 						}
 						position++
-						goto l582
-					l583:
-						position, tokenIndex, depth = position582, tokenIndex582, depth582
+						goto l665
+					l666:
+						position, tokenIndex, depth = position665, tokenIndex665, depth665
 						if buffer[position] != rune('D') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l582:
-					goto l572
-				l573:
-					position, tokenIndex, depth = position572, tokenIndex572, depth572
+				l665:
+					goto l655
+				l656:
+					position, tokenIndex, depth = position655, tokenIndex655, depth655
 					{
-						position584, tokenIndex584, depth584 := position, tokenIndex, depth
+						position667, tokenIndex667, depth667 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l585
+							goto l668
 						}
 						position++
-						goto l584
-					l585:
-						position, tokenIndex, depth = position584, tokenIndex584, depth584
+						goto l667
+					l668:
+						position, tokenIndex, depth = position667, tokenIndex667, depth667
 						if buffer[position] != rune('E') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l584:
+				l667:
 					{
-						position586, tokenIndex586, depth586 := position, tokenIndex, depth
+						position669, tokenIndex669, depth669 := position, tokenIndex, depth
 						if buffer[position] != rune('x') {
-							goto l587
+							goto l670
 						}
 						position++
-						goto l586
-					l587:
-						position, tokenIndex, depth = position586, tokenIndex586, depth586
+						goto l669
+					l670:
+						position, tokenIndex, depth = position669, tokenIndex669, depth669
 						if buffer[position] != rune('X') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l586:
+				l669:
 					{
-						position588, tokenIndex588, depth588 := position, tokenIndex, depth
+						position671, tokenIndex671, depth671 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l589
+							goto l672
 						}
 						position++
-						goto l588
-					l589:
-						position, tokenIndex, depth = position588, tokenIndex588, depth588
+						goto l671
+					l672:
+						position, tokenIndex, depth = position671, tokenIndex671, depth671
 						if buffer[position] != rune('I') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l588:
+				l671:
 					{
-						position590, tokenIndex590, depth590 := position, tokenIndex, depth
+						position673, tokenIndex673, depth673 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l591
+							goto l674
 						}
 						position++
-						goto l590
-					l591:
-						position, tokenIndex, depth = position590, tokenIndex590, depth590
+						goto l673
+					l674:
+						position, tokenIndex, depth = position673, tokenIndex673, depth673
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l590:
+				l673:
 					{
-						position592, tokenIndex592, depth592 := position, tokenIndex, depth
+						position675, tokenIndex675, depth675 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l593
+							goto l676
 						}
 						position++
-						goto l592
-					l593:
-						position, tokenIndex, depth = position592, tokenIndex592, depth592
+						goto l675
+					l676:
+						position, tokenIndex, depth = position675, tokenIndex675, depth675
 						if buffer[position] != rune('T') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l592:
+				l675:
 					{
-						position594, tokenIndex594, depth594 := position, tokenIndex, depth
+						position677, tokenIndex677, depth677 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l595
+							goto l678
 						}
 						position++
-						goto l594
-					l595:
-						position, tokenIndex, depth = position594, tokenIndex594, depth594
+						goto l677
+					l678:
+						position, tokenIndex, depth = position677, tokenIndex677, depth677
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l594:
+				l677:
 				}
-			l572:
+			l655:
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l544
+					goto l627
 				}
-				if !_rules[ruleAction21]() {
-					goto l544
+				if !_rules[ruleAction25]() {
+					goto l627
 				}
 				depth--
-				add(ruleLoadStateOrCreateStmt, position545)
+				add(ruleLoadStateOrCreateStmt, position628)
 			}
 			return true
-		l544:
-			position, tokenIndex, depth = position544, tokenIndex544, depth544
+		l627:
+			position, tokenIndex, depth = position627, tokenIndex627, depth627
 			return false
 		},
-		/* 28 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
+		/* 32 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action26)> */
 		func() bool {
-			position596, tokenIndex596, depth596 := position, tokenIndex, depth
+			position679, tokenIndex679, depth679 := position, tokenIndex, depth
 			{
-				position597 := position
+				position680 := position
 				depth++
 				{
-					position598, tokenIndex598, depth598 := position, tokenIndex, depth
+					position681, tokenIndex681, depth681 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l599
+						goto l682
 					}
 					position++
-					goto l598
-				l599:
-					position, tokenIndex, depth = position598, tokenIndex598, depth598
+					goto l681
+				l682:
+					position, tokenIndex, depth = position681, tokenIndex681, depth681
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l598:
+			l681:
 				{
-					position600, tokenIndex600, depth600 := position, tokenIndex, depth
+					position683, tokenIndex683, depth683 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l601
+						goto l684
 					}
 					position++
-					goto l600
-				l601:
-					position, tokenIndex, depth = position600, tokenIndex600, depth600
+					goto l683
+				l684:
+					position, tokenIndex, depth = position683, tokenIndex683, depth683
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l600:
+			l683:
 				{
-					position602, tokenIndex602, depth602 := position, tokenIndex, depth
+					position685, tokenIndex685, depth685 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l603
+						goto l686
 					}
 					position++
-					goto l602
-				l603:
-					position, tokenIndex, depth = position602, tokenIndex602, depth602
+					goto l685
+				l686:
+					position, tokenIndex, depth = position685, tokenIndex685, depth685
 					if buffer[position] != rune('V') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l602:
+			l685:
 				{
-					position604, tokenIndex604, depth604 := position, tokenIndex, depth
+					position687, tokenIndex687, depth687 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l605
+						goto l688
 					}
 					position++
-					goto l604
-				l605:
-					position, tokenIndex, depth = position604, tokenIndex604, depth604
+					goto l687
+				l688:
+					position, tokenIndex, depth = position687, tokenIndex687, depth687
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l604:
+			l687:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l679
 				}
 				{
-					position606, tokenIndex606, depth606 := position, tokenIndex, depth
+					position689, tokenIndex689, depth689 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l607
+						goto l690
 					}
 					position++
-					goto l606
-				l607:
-					position, tokenIndex, depth = position606, tokenIndex606, depth606
+					goto l689
+				l690:
+					position, tokenIndex, depth = position689, tokenIndex689, depth689
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l606:
+			l689:
 				{
-					position608, tokenIndex608, depth608 := position, tokenIndex, depth
+					position691, tokenIndex691, depth691 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l609
+						goto l692
 					}
 					position++
-					goto l608
-				l609:
-					position, tokenIndex, depth = position608, tokenIndex608, depth608
+					goto l691
+				l692:
+					position, tokenIndex, depth = position691, tokenIndex691, depth691
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l608:
+			l691:
 				{
-					position610, tokenIndex610, depth610 := position, tokenIndex, depth
+					position693, tokenIndex693, depth693 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l611
+						goto l694
 					}
 					position++
-					goto l610
-				l611:
-					position, tokenIndex, depth = position610, tokenIndex610, depth610
+					goto l693
+				l694:
+					position, tokenIndex, depth = position693, tokenIndex693, depth693
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l610:
+			l693:
 				{
-					position612, tokenIndex612, depth612 := position, tokenIndex, depth
+					position695, tokenIndex695, depth695 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l613
+						goto l696
 					}
 					position++
-					goto l612
-				l613:
-					position, tokenIndex, depth = position612, tokenIndex612, depth612
+					goto l695
+				l696:
+					position, tokenIndex, depth = position695, tokenIndex695, depth695
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l612:
+			l695:
 				{
-					position614, tokenIndex614, depth614 := position, tokenIndex, depth
+					position697, tokenIndex697, depth697 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l615
+						goto l698
 					}
 					position++
-					goto l614
-				l615:
-					position, tokenIndex, depth = position614, tokenIndex614, depth614
+					goto l697
+				l698:
+					position, tokenIndex, depth = position697, tokenIndex697, depth697
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l614:
+			l697:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l679
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l596
+					goto l679
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l596
+					goto l679
 				}
-				if !_rules[ruleAction22]() {
-					goto l596
+				if !_rules[ruleAction26]() {
+					goto l679
 				}
 				depth--
-				add(ruleSaveStateStmt, position597)
+				add(ruleSaveStateStmt, position680)
 			}
 			return true
-		l596:
-			position, tokenIndex, depth = position596, tokenIndex596, depth596
+		l679:
+			position, tokenIndex, depth = position679, tokenIndex679, depth679
 			return false
 		},
-		/* 29 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
+		/* 33 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action27)> */
 		func() bool {
-			position616, tokenIndex616, depth616 := position, tokenIndex, depth
+			position699, tokenIndex699, depth699 := position, tokenIndex, depth
 			{
-				position617 := position
+				position700 := position
 				depth++
 				{
-					position618, tokenIndex618, depth618 := position, tokenIndex, depth
+					position701, tokenIndex701, depth701 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l619
+						goto l702
 					}
 					position++
-					goto l618
-				l619:
-					position, tokenIndex, depth = position618, tokenIndex618, depth618
+					goto l701
+				l702:
+					position, tokenIndex, depth = position701, tokenIndex701, depth701
 					if buffer[position] != rune('E') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l618:
+			l701:
 				{
-					position620, tokenIndex620, depth620 := position, tokenIndex, depth
+					position703, tokenIndex703, depth703 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l621
+						goto l704
 					}
 					position++
-					goto l620
-				l621:
-					position, tokenIndex, depth = position620, tokenIndex620, depth620
+					goto l703
+				l704:
+					position, tokenIndex, depth = position703, tokenIndex703, depth703
 					if buffer[position] != rune('V') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l620:
+			l703:
 				{
-					position622, tokenIndex622, depth622 := position, tokenIndex, depth
+					position705, tokenIndex705, depth705 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l623
+						goto l706
 					}
 					position++
-					goto l622
-				l623:
-					position, tokenIndex, depth = position622, tokenIndex622, depth622
+					goto l705
+				l706:
+					position, tokenIndex, depth = position705, tokenIndex705, depth705
 					if buffer[position] != rune('A') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l622:
+			l705:
 				{
-					position624, tokenIndex624, depth624 := position, tokenIndex, depth
+					position707, tokenIndex707, depth707 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l625
+						goto l708
 					}
 					position++
-					goto l624
-				l625:
-					position, tokenIndex, depth = position624, tokenIndex624, depth624
+					goto l707
+				l708:
+					position, tokenIndex, depth = position707, tokenIndex707, depth707
 					if buffer[position] != rune('L') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l624:
+			l707:
 				if !_rules[rulesp]() {
-					goto l616
+					goto l699
 				}
 				if !_rules[ruleExpression]() {
-					goto l616
+					goto l699
 				}
 				{
-					position626 := position
+					position709 := position
 					depth++
 					{
-						position627, tokenIndex627, depth627 := position, tokenIndex, depth
+						position710, tokenIndex710, depth710 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l627
+							goto l710
 						}
 						{
-							position629, tokenIndex629, depth629 := position, tokenIndex, depth
+							position712, tokenIndex712, depth712 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l630
+								goto l713
 							}
 							position++
-							goto l629
-						l630:
-							position, tokenIndex, depth = position629, tokenIndex629, depth629
+							goto l712
+						l713:
+							position, tokenIndex, depth = position712, tokenIndex712, depth712
 							if buffer[position] != rune('O') {
-								goto l627
+								goto l710
 							}
 							position++
 						}
-					l629:
+					l712:
 						{
-							position631, tokenIndex631, depth631 := position, tokenIndex, depth
+							position714, tokenIndex714, depth714 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l632
+								goto l715
 							}
 							position++
-							goto l631
-						l632:
-							position, tokenIndex, depth = position631, tokenIndex631, depth631
+							goto l714
+						l715:
+							position, tokenIndex, depth = position714, tokenIndex714, depth714
 							if buffer[position] != rune('N') {
-								goto l627
+								goto l710
 							}
 							position++
 						}
-					l631:
+					l714:
 						if !_rules[rulesp]() {
-							goto l627
+							goto l710
 						}
 						if !_rules[ruleMapExpr]() {
-							goto l627
+							goto l710
 						}
-						goto l628
-					l627:
-						position, tokenIndex, depth = position627, tokenIndex627, depth627
+						goto l711
+					l710:
+						position, tokenIndex, depth = position710, tokenIndex710, depth710
 					}
-				l628:
+				l711:
 					depth--
-					add(rulePegText, position626)
+					add(rulePegText, position709)
 				}
-				if !_rules[ruleAction23]() {
-					goto l616
+				if !_rules[ruleAction27]() {
+					goto l699
 				}
 				depth--
-				add(ruleEvalStmt, position617)
+				add(ruleEvalStmt, position700)
 			}
 			return true
-		l616:
-			position, tokenIndex, depth = position616, tokenIndex616, depth616
+		l699:
+			position, tokenIndex, depth = position699, tokenIndex699, depth699
 			return false
 		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
+		/* 34 ExplainStmt <- <(('e' / 'E') ('x' / 'X') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('i' / 'I') ('n' / 'N') sp (CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / SelectUnionStmt / SelectStmt) Action28)> */
 		func() bool {
-			position633, tokenIndex633, depth633 := position, tokenIndex, depth
+			position716, tokenIndex716, depth716 := position, tokenIndex, depth
 			{
-				position634 := position
+				position717 := position
 				depth++
-				if !_rules[rulesp]() {
-					goto l633
-				}
 				{
-					position635, tokenIndex635, depth635 := position, tokenIndex, depth
-					if !_rules[ruleISTREAM]() {
-						goto l636
+					position718, tokenIndex718, depth718 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l719
 					}
-					goto l635
-				l636:
-					position, tokenIndex, depth = position635, tokenIndex635, depth635
+					position++
+					goto l718
+				l719:
+					position, tokenIndex, depth = position718, tokenIndex718, depth718
+					if buffer[position] != rune('E') {
+						goto l716
+					}
+					position++
+				}
+			l718:
+				{
+					position720, tokenIndex720, depth720 := position, tokenIndex, depth
+					if buffer[position] != rune('x') {
+						goto l721
+					}
+					position++
+					goto l720
+				l721:
+					position, tokenIndex, depth = position720, tokenIndex720, depth720
+					if buffer[position] != rune('X') {
+						goto l716
+					}
+					position++
+				}
+			l720:
+				{
+					position722, tokenIndex722, depth722 := position, tokenIndex, depth
+					if buffer[position] != rune('p') {
+						goto l723
+					}
+					position++
+					goto l722
+				l723:
+					position, tokenIndex, depth = position722, tokenIndex722, depth722
+					if buffer[position] != rune('P') {
+						goto l716
+					}
+					position++
+				}
+			l722:
+				{
+					position724, tokenIndex724, depth724 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l725
+					}
+					position++
+					goto l724
+				l725:
+					position, tokenIndex, depth = position724, tokenIndex724, depth724
+					if buffer[position] != rune('L') {
+						goto l716
+					}
+					position++
+				}
+			l724:
+				{
+					position726, tokenIndex726, depth726 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l727
+					}
+					position++
+					goto l726
+				l727:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
+					if buffer[position] != rune('A') {
+						goto l716
+					}
+					position++
+				}
+			l726:
+				{
+					position728, tokenIndex728, depth728 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l729
+					}
+					position++
+					goto l728
+				l729:
+					position, tokenIndex, depth = position728, tokenIndex728, depth728
+					if buffer[position] != rune('I') {
+						goto l716
+					}
+					position++
+				}
+			l728:
+				{
+					position730, tokenIndex730, depth730 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l731
+					}
+					position++
+					goto l730
+				l731:
+					position, tokenIndex, depth = position730, tokenIndex730, depth730
+					if buffer[position] != rune('N') {
+						goto l716
+					}
+					position++
+				}
+			l730:
+				if !_rules[rulesp]() {
+					goto l716
+				}
+				{
+					position732, tokenIndex732, depth732 := position, tokenIndex, depth
+					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
+						goto l733
+					}
+					goto l732
+				l733:
+					position, tokenIndex, depth = position732, tokenIndex732, depth732
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
+						goto l734
+					}
+					goto l732
+				l734:
+					position, tokenIndex, depth = position732, tokenIndex732, depth732
+					if !_rules[ruleSelectUnionStmt]() {
+						goto l735
+					}
+					goto l732
+				l735:
+					position, tokenIndex, depth = position732, tokenIndex732, depth732
+					if !_rules[ruleSelectStmt]() {
+						goto l716
+					}
+				}
+			l732:
+				if !_rules[ruleAction28]() {
+					goto l716
+				}
+				depth--
+				add(ruleExplainStmt, position717)
+			}
+			return true
+		l716:
+			position, tokenIndex, depth = position716, tokenIndex716, depth716
+			return false
+		},
+		/* 35 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action29)> */
+		func() bool {
+			position736, tokenIndex736, depth736 := position, tokenIndex, depth
+			{
+				position737 := position
+				depth++
+				if !_rules[rulesp]() {
+					goto l736
+				}
+				{
+					position738, tokenIndex738, depth738 := position, tokenIndex, depth
+					if !_rules[ruleISTREAM]() {
+						goto l739
+					}
+					goto l738
+				l739:
+					position, tokenIndex, depth = position738, tokenIndex738, depth738
 					if !_rules[ruleDSTREAM]() {
-						goto l637
+						goto l740
 					}
-					goto l635
-				l637:
-					position, tokenIndex, depth = position635, tokenIndex635, depth635
+					goto l738
+				l740:
+					position, tokenIndex, depth = position738, tokenIndex738, depth738
 					if !_rules[ruleRSTREAM]() {
-						goto l633
+						goto l736
 					}
 				}
-			l635:
+			l738:
 				if !_rules[ruleEmitterOptions]() {
-					goto l633
+					goto l736
 				}
-				if !_rules[ruleAction24]() {
-					goto l633
+				if !_rules[ruleAction29]() {
+					goto l736
 				}
 				depth--
-				add(ruleEmitter, position634)
+				add(ruleEmitter, position737)
 			}
 			return true
-		l633:
-			position, tokenIndex, depth = position633, tokenIndex633, depth633
+		l736:
+			position, tokenIndex, depth = position736, tokenIndex736, depth736
 			return false
 		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
+		/* 36 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action30)> */
 		func() bool {
-			position638, tokenIndex638, depth638 := position, tokenIndex, depth
+			position741, tokenIndex741, depth741 := position, tokenIndex, depth
 			{
-				position639 := position
+				position742 := position
 				depth++
 				{
-					position640 := position
+					position743 := position
 					depth++
 					{
-						position641, tokenIndex641, depth641 := position, tokenIndex, depth
+						position744, tokenIndex744, depth744 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l744
 						}
 						if buffer[position] != rune('[') {
-							goto l641
+							goto l744
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l744
 						}
 						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
+							goto l744
 						}
 						if !_rules[rulespOpt]() {
-							goto l641
+							goto l744
 						}
 						if buffer[position] != rune(']') {
-							goto l641
+							goto l744
 						}
 						position++
-						goto l642
-					l641:
-						position, tokenIndex, depth = position641, tokenIndex641, depth641
+						goto l745
+					l744:
+						position, tokenIndex, depth = position744, tokenIndex744, depth744
 					}
-				l642:
+				l745:
 					depth--
-					add(rulePegText, position640)
+					add(rulePegText, position743)
 				}
-				if !_rules[ruleAction25]() {
-					goto l638
+				if !_rules[ruleAction30]() {
+					goto l741
 				}
 				depth--
-				add(ruleEmitterOptions, position639)
+				add(ruleEmitterOptions, position742)
 			}
 			return true
-		l638:
-			position, tokenIndex, depth = position638, tokenIndex638, depth638
+		l741:
+			position, tokenIndex, depth = position741, tokenIndex741, depth741
 			return false
 		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		/* 37 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
 		func() bool {
-			position643, tokenIndex643, depth643 := position, tokenIndex, depth
+			position746, tokenIndex746, depth746 := position, tokenIndex, depth
 			{
-				position644 := position
+				position747 := position
 				depth++
 				{
-					position645, tokenIndex645, depth645 := position, tokenIndex, depth
+					position748, tokenIndex748, depth748 := position, tokenIndex, depth
 					if !_rules[ruleEmitterLimit]() {
-						goto l646
+						goto l749
 					}
-					goto l645
-				l646:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l748
+				l749:
+					position, tokenIndex, depth = position748, tokenIndex748, depth748
 					if !_rules[ruleEmitterSample]() {
-						goto l647
+						goto l750
 					}
 					if !_rules[rulesp]() {
-						goto l647
+						goto l750
 					}
 					if !_rules[ruleEmitterLimit]() {
-						goto l647
+						goto l750
 					}
-					goto l645
-				l647:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l748
+				l750:
+					position, tokenIndex, depth = position748, tokenIndex748, depth748
 					if !_rules[ruleEmitterSample]() {
-						goto l643
+						goto l746
 					}
 				}
-			l645:
+			l748:
 				depth--
-				add(ruleEmitterOptionCombinations, position644)
+				add(ruleEmitterOptionCombinations, position747)
 			}
 			return true
-		l643:
-			position, tokenIndex, depth = position643, tokenIndex643, depth643
+		l746:
+			position, tokenIndex, depth = position746, tokenIndex746, depth746
 			return false
 		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
+		/* 38 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action31)> */
 		func() bool {
-			position648, tokenIndex648, depth648 := position, tokenIndex, depth
+			position751, tokenIndex751, depth751 := position, tokenIndex, depth
 			{
-				position649 := position
+				position752 := position
 				depth++
 				{
-					position650, tokenIndex650, depth650 := position, tokenIndex, depth
+					position753, tokenIndex753, depth753 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l651
+						goto l754
 					}
 					position++
-					goto l650
-				l651:
-					position, tokenIndex, depth = position650, tokenIndex650, depth650
+					goto l753
+				l754:
+					position, tokenIndex, depth = position753, tokenIndex753, depth753
 					if buffer[position] != rune('L') {
-						goto l648
+						goto l751
 					}
 					position++
 				}
-			l650:
+			l753:
 				{
-					position652, tokenIndex652, depth652 := position, tokenIndex, depth
+					position755, tokenIndex755, depth755 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l653
+						goto l756
 					}
 					position++
-					goto l652
-				l653:
-					position, tokenIndex, depth = position652, tokenIndex652, depth652
+					goto l755
+				l756:
+					position, tokenIndex, depth = position755, tokenIndex755, depth755
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l751
 					}
 					position++
 				}
-			l652:
+			l755:
 				{
-					position654, tokenIndex654, depth654 := position, tokenIndex, depth
+					position757, tokenIndex757, depth757 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l655
+						goto l758
 					}
 					position++
-					goto l654
-				l655:
-					position, tokenIndex, depth = position654, tokenIndex654, depth654
+					goto l757
+				l758:
+					position, tokenIndex, depth = position757, tokenIndex757, depth757
 					if buffer[position] != rune('M') {
-						goto l648
+						goto l751
 					}
 					position++
 				}
-			l654:
+			l757:
 				{
-					position656, tokenIndex656, depth656 := position, tokenIndex, depth
+					position759, tokenIndex759, depth759 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l657
+						goto l760
 					}
 					position++
-					goto l656
-				l657:
-					position, tokenIndex, depth = position656, tokenIndex656, depth656
+					goto l759
+				l760:
+					position, tokenIndex, depth = position759, tokenIndex759, depth759
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l751
 					}
 					position++
 				}
-			l656:
+			l759:
 				{
-					position658, tokenIndex658, depth658 := position, tokenIndex, depth
+					position761, tokenIndex761, depth761 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l659
+						goto l762
 					}
 					position++
-					goto l658
-				l659:
-					position, tokenIndex, depth = position658, tokenIndex658, depth658
+					goto l761
+				l762:
+					position, tokenIndex, depth = position761, tokenIndex761, depth761
 					if buffer[position] != rune('T') {
-						goto l648
+						goto l751
 					}
 					position++
 				}
-			l658:
+			l761:
 				if !_rules[rulesp]() {
-					goto l648
+					goto l751
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l648
+					goto l751
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
+				if !_rules[ruleAction31]() {
+					goto l751
 				}
 				depth--
-				add(ruleEmitterLimit, position649)
+				add(ruleEmitterLimit, position752)
 			}
 			return true
-		l648:
-			position, tokenIndex, depth = position648, tokenIndex648, depth648
+		l751:
+			position, tokenIndex, depth = position751, tokenIndex751, depth751
 			return false
 		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		/* 39 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
 		func() bool {
-			position660, tokenIndex660, depth660 := position, tokenIndex, depth
+			position763, tokenIndex763, depth763 := position, tokenIndex, depth
 			{
-				position661 := position
+				position764 := position
 				depth++
 				{
-					position662, tokenIndex662, depth662 := position, tokenIndex, depth
+					position765, tokenIndex765, depth765 := position, tokenIndex, depth
 					if !_rules[ruleCountBasedSampling]() {
-						goto l663
+						goto l766
 					}
-					goto l662
-				l663:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l765
+				l766:
+					position, tokenIndex, depth = position765, tokenIndex765, depth765
 					if !_rules[ruleRandomizedSampling]() {
-						goto l664
+						goto l767
 					}
-					goto l662
-				l664:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l765
+				l767:
+					position, tokenIndex, depth = position765, tokenIndex765, depth765
 					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+						goto l763
 					}
 				}
-			l662:
+			l765:
 				depth--
-				add(ruleEmitterSample, position661)
+				add(ruleEmitterSample, position764)
 			}
 			return true
-		l660:
-			position, tokenIndex, depth = position660, tokenIndex660, depth660
+		l763:
+			position, tokenIndex, depth = position763, tokenIndex763, depth763
 			return false
 		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
+		/* 40 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action32)> */
 		func() bool {
-			position665, tokenIndex665, depth665 := position, tokenIndex, depth
+			position768, tokenIndex768, depth768 := position, tokenIndex, depth
 			{
-				position666 := position
+				position769 := position
 				depth++
 				{
-					position667, tokenIndex667, depth667 := position, tokenIndex, depth
+					position770, tokenIndex770, depth770 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l668
+						goto l771
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex, depth = position667, tokenIndex667, depth667
+					goto l770
+				l771:
+					position, tokenIndex, depth = position770, tokenIndex770, depth770
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l667:
+			l770:
 				{
-					position669, tokenIndex669, depth669 := position, tokenIndex, depth
+					position772, tokenIndex772, depth772 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l670
+						goto l773
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex, depth = position669, tokenIndex669, depth669
+					goto l772
+				l773:
+					position, tokenIndex, depth = position772, tokenIndex772, depth772
 					if buffer[position] != rune('V') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l669:
+			l772:
 				{
-					position671, tokenIndex671, depth671 := position, tokenIndex, depth
+					position774, tokenIndex774, depth774 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l672
+						goto l775
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex, depth = position671, tokenIndex671, depth671
+					goto l774
+				l775:
+					position, tokenIndex, depth = position774, tokenIndex774, depth774
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l671:
+			l774:
 				{
-					position673, tokenIndex673, depth673 := position, tokenIndex, depth
+					position776, tokenIndex776, depth776 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l674
+						goto l777
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex, depth = position673, tokenIndex673, depth673
+					goto l776
+				l777:
+					position, tokenIndex, depth = position776, tokenIndex776, depth776
 					if buffer[position] != rune('R') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l673:
+			l776:
 				{
-					position675, tokenIndex675, depth675 := position, tokenIndex, depth
+					position778, tokenIndex778, depth778 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l676
+						goto l779
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex, depth = position675, tokenIndex675, depth675
+					goto l778
+				l779:
+					position, tokenIndex, depth = position778, tokenIndex778, depth778
 					if buffer[position] != rune('Y') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l675:
+			l778:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l768
 				}
 				if !_rules[ruleNumericLiteral]() {
-					goto l665
+					goto l768
 				}
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l768
 				}
 				{
-					position677, tokenIndex677, depth677 := position, tokenIndex, depth
+					position780, tokenIndex780, depth780 := position, tokenIndex, depth
 					if buffer[position] != rune('-') {
-						goto l677
+						goto l780
 					}
 					position++
-					goto l678
-				l677:
-					position, tokenIndex, depth = position677, tokenIndex677, depth677
+					goto l781
+				l780:
+					position, tokenIndex, depth = position780, tokenIndex780, depth780
 				}
-			l678:
+			l781:
 				if !_rules[rulespOpt]() {
-					goto l665
+					goto l768
 				}
 				{
-					position679, tokenIndex679, depth679 := position, tokenIndex, depth
+					position782, tokenIndex782, depth782 := position, tokenIndex, depth
 					{
-						position681, tokenIndex681, depth681 := position, tokenIndex, depth
+						position784, tokenIndex784, depth784 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l682
+							goto l785
 						}
 						position++
-						goto l681
-					l682:
-						position, tokenIndex, depth = position681, tokenIndex681, depth681
+						goto l784
+					l785:
+						position, tokenIndex, depth = position784, tokenIndex784, depth784
 						if buffer[position] != rune('S') {
-							goto l680
+							goto l783
 						}
 						position++
 					}
-				l681:
+				l784:
 					{
-						position683, tokenIndex683, depth683 := position, tokenIndex, depth
+						position786, tokenIndex786, depth786 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l684
+							goto l787
 						}
 						position++
-						goto l683
-					l684:
-						position, tokenIndex, depth = position683, tokenIndex683, depth683
+						goto l786
+					l787:
+						position, tokenIndex, depth = position786, tokenIndex786, depth786
 						if buffer[position] != rune('T') {
-							goto l680
+							goto l783
 						}
 						position++
 					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l786:
+					goto l782
+				l783:
+					position, tokenIndex, depth = position782, tokenIndex782, depth782
 					{
-						position686, tokenIndex686, depth686 := position, tokenIndex, depth
+						position789, tokenIndex789, depth789 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l687
+							goto l790
 						}
 						position++
-						goto l686
-					l687:
-						position, tokenIndex, depth = position686, tokenIndex686, depth686
+						goto l789
+					l790:
+						position, tokenIndex, depth = position789, tokenIndex789, depth789
 						if buffer[position] != rune('N') {
-							goto l685
+							goto l788
 						}
 						position++
 					}
-				l686:
+				l789:
 					{
-						position688, tokenIndex688, depth688 := position, tokenIndex, depth
+						position791, tokenIndex791, depth791 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l689
+							goto l792
 						}
 						position++
-						goto l688
-					l689:
-						position, tokenIndex, depth = position688, tokenIndex688, depth688
+						goto l791
+					l792:
+						position, tokenIndex, depth = position791, tokenIndex791, depth791
 						if buffer[position] != rune('D') {
-							goto l685
+							goto l788
 						}
 						position++
 					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l791:
+					goto l782
+				l788:
+					position, tokenIndex, depth = position782, tokenIndex782, depth782
 					{
-						position691, tokenIndex691, depth691 := position, tokenIndex, depth
+						position794, tokenIndex794, depth794 := position, tokenIndex, depth
 						if buffer[position] != rune('r') {
-							goto l692
+							goto l795
 						}
 						position++
-						goto l691
-					l692:
-						position, tokenIndex, depth = position691, tokenIndex691, depth691
+						goto l794
+					l795:
+						position, tokenIndex, depth = position794, tokenIndex794, depth794
 						if buffer[position] != rune('R') {
-							goto l690
+							goto l793
 						}
 						position++
 					}
-				l691:
+				l794:
 					{
-						position693, tokenIndex693, depth693 := position, tokenIndex, depth
+						position796, tokenIndex796, depth796 := position, tokenIndex, depth
 						if buffer[position] != rune('d') {
-							goto l694
+							goto l797
 						}
 						position++
-						goto l693
-					l694:
-						position, tokenIndex, depth = position693, tokenIndex693, depth693
+						goto l796
+					l797:
+						position, tokenIndex, depth = position796, tokenIndex796, depth796
 						if buffer[position] != rune('D') {
-							goto l690
+							goto l793
 						}
 						position++
 					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex, depth = position679, tokenIndex679, depth679
+				l796:
+					goto l782
+				l793:
+					position, tokenIndex, depth = position782, tokenIndex782, depth782
 					{
-						position695, tokenIndex695, depth695 := position, tokenIndex, depth
+						position798, tokenIndex798, depth798 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l696
+							goto l799
 						}
 						position++
-						goto l695
-					l696:
-						position, tokenIndex, depth = position695, tokenIndex695, depth695
+						goto l798
+					l799:
+						position, tokenIndex, depth = position798, tokenIndex798, depth798
 						if buffer[position] != rune('T') {
-							goto l665
+							goto l768
 						}
 						position++
 					}
-				l695:
+				l798:
 					{
-						position697, tokenIndex697, depth697 := position, tokenIndex, depth
+						position800, tokenIndex800, depth800 := position, tokenIndex, depth
 						if buffer[position] != rune('h') {
-							goto l698
+							goto l801
 						}
 						position++
-						goto l697
-					l698:
-						position, tokenIndex, depth = position697, tokenIndex697, depth697
+						goto l800
+					l801:
+						position, tokenIndex, depth = position800, tokenIndex800, depth800
 						if buffer[position] != rune('H') {
-							goto l665
+							goto l768
 						}
 						position++
 					}
-				l697:
+				l800:
 				}
-			l679:
+			l782:
 				if !_rules[rulesp]() {
-					goto l665
+					goto l768
 				}
 				{
-					position699, tokenIndex699, depth699 := position, tokenIndex, depth
+					position802, tokenIndex802, depth802 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l700
+						goto l803
 					}
 					position++
-					goto l699
-				l700:
-					position, tokenIndex, depth = position699, tokenIndex699, depth699
+					goto l802
+				l803:
+					position, tokenIndex, depth = position802, tokenIndex802, depth802
 					if buffer[position] != rune('T') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l699:
+			l802:
 				{
-					position701, tokenIndex701, depth701 := position, tokenIndex, depth
+					position804, tokenIndex804, depth804 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l702
+						goto l805
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex, depth = position701, tokenIndex701, depth701
+					goto l804
+				l805:
+					position, tokenIndex, depth = position804, tokenIndex804, depth804
 					if buffer[position] != rune('U') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l701:
+			l804:
 				{
-					position703, tokenIndex703, depth703 := position, tokenIndex, depth
+					position806, tokenIndex806, depth806 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l704
+						goto l807
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex, depth = position703, tokenIndex703, depth703
+					goto l806
+				l807:
+					position, tokenIndex, depth = position806, tokenIndex806, depth806
 					if buffer[position] != rune('P') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l703:
+			l806:
 				{
-					position705, tokenIndex705, depth705 := position, tokenIndex, depth
+					position808, tokenIndex808, depth808 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l706
+						goto l809
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex, depth = position705, tokenIndex705, depth705
+					goto l808
+				l809:
+					position, tokenIndex, depth = position808, tokenIndex808, depth808
 					if buffer[position] != rune('L') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l705:
+			l808:
 				{
-					position707, tokenIndex707, depth707 := position, tokenIndex, depth
+					position810, tokenIndex810, depth810 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l811
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex, depth = position707, tokenIndex707, depth707
+					goto l810
+				l811:
+					position, tokenIndex, depth = position810, tokenIndex810, depth810
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l768
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l810:
+				if !_rules[ruleAction32]() {
+					goto l768
 				}
 				depth--
-				add(ruleCountBasedSampling, position666)
+				add(ruleCountBasedSampling, position769)
 			}
 			return true
-		l665:
-			position, tokenIndex, depth = position665, tokenIndex665, depth665
+		l768:
+			position, tokenIndex, depth = position768, tokenIndex768, depth768
 			return false
 		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
+		/* 41 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action33)> */
 		func() bool {
-			position709, tokenIndex709, depth709 := position, tokenIndex, depth
+			position812, tokenIndex812, depth812 := position, tokenIndex, depth
 			{
-				position710 := position
+				position813 := position
 				depth++
 				{
-					position711, tokenIndex711, depth711 := position, tokenIndex, depth
+					position814, tokenIndex814, depth814 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l712
+						goto l815
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex, depth = position711, tokenIndex711, depth711
+					goto l814
+				l815:
+					position, tokenIndex, depth = position814, tokenIndex814, depth814
 					if buffer[position] != rune('S') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l711:
+			l814:
 				{
-					position713, tokenIndex713, depth713 := position, tokenIndex, depth
+					position816, tokenIndex816, depth816 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l714
+						goto l817
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex, depth = position713, tokenIndex713, depth713
+					goto l816
+				l817:
+					position, tokenIndex, depth = position816, tokenIndex816, depth816
 					if buffer[position] != rune('A') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l713:
+			l816:
 				{
-					position715, tokenIndex715, depth715 := position, tokenIndex, depth
+					position818, tokenIndex818, depth818 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l716
+						goto l819
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex, depth = position715, tokenIndex715, depth715
+					goto l818
+				l819:
+					position, tokenIndex, depth = position818, tokenIndex818, depth818
 					if buffer[position] != rune('M') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l715:
+			l818:
 				{
-					position717, tokenIndex717, depth717 := position, tokenIndex, depth
+					position820, tokenIndex820, depth820 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l718
+						goto l821
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex, depth = position717, tokenIndex717, depth717
+					goto l820
+				l821:
+					position, tokenIndex, depth = position820, tokenIndex820, depth820
 					if buffer[position] != rune('P') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l717:
+			l820:
 				{
-					position719, tokenIndex719, depth719 := position, tokenIndex, depth
+					position822, tokenIndex822, depth822 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l720
+						goto l823
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex, depth = position719, tokenIndex719, depth719
+					goto l822
+				l823:
+					position, tokenIndex, depth = position822, tokenIndex822, depth822
 					if buffer[position] != rune('L') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l719:
+			l822:
 				{
-					position721, tokenIndex721, depth721 := position, tokenIndex, depth
+					position824, tokenIndex824, depth824 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l825
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex, depth = position721, tokenIndex721, depth721
+					goto l824
+				l825:
+					position, tokenIndex, depth = position824, tokenIndex824, depth824
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l812
 					}
 					position++
 				}
-			l721:
+			l824:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l812
 				}
 				{
-					position723, tokenIndex723, depth723 := position, tokenIndex, depth
+					position826, tokenIndex826, depth826 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l724
+						goto l827
 					}
-					goto l723
-				l724:
-					position, tokenIndex, depth = position723, tokenIndex723, depth723
+					goto l826
+				l827:
+					position, tokenIndex, depth = position826, tokenIndex826, depth826
 					if !_rules[ruleNumericLiteral]() {
-						goto l709
+						goto l812
 					}
 				}
-			l723:
+			l826:
 				if !_rules[rulespOpt]() {
-					goto l709
+					goto l812
 				}
 				if buffer[position] != rune('%') {
-					goto l709
+					goto l812
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l709
+				if !_rules[ruleAction33]() {
+					goto l812
 				}
 				depth--
-				add(ruleRandomizedSampling, position710)
+				add(ruleRandomizedSampling, position813)
 			}
 			return true
-		l709:
-			position, tokenIndex, depth = position709, tokenIndex709, depth709
+		l812:
+			position, tokenIndex, depth = position812, tokenIndex812, depth812
 			return false
 		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
+		/* 42 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
 		func() bool {
-			position725, tokenIndex725, depth725 := position, tokenIndex, depth
+			position828, tokenIndex828, depth828 := position, tokenIndex, depth
 			{
-				position726 := position
+				position829 := position
 				depth++
 				{
-					position727, tokenIndex727, depth727 := position, tokenIndex, depth
+					position830, tokenIndex830, depth830 := position, tokenIndex, depth
 					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+						goto l831
 					}
-					goto l727
-				l728:
-					position, tokenIndex, depth = position727, tokenIndex727, depth727
+					goto l830
+				l831:
+					position, tokenIndex, depth = position830, tokenIndex830, depth830
 					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+						goto l828
 					}
 				}
-			l727:
+			l830:
 				depth--
-				add(ruleTimeBasedSampling, position726)
+				add(ruleTimeBasedSampling, position829)
 			}
 			return true
-		l725:
-			position, tokenIndex, depth = position725, tokenIndex725, depth725
+		l828:
+			position, tokenIndex, depth = position828, tokenIndex828, depth828
 			return false
 		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
+		/* 43 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action34)> */
 		func() bool {
-			position729, tokenIndex729, depth729 := position, tokenIndex, depth
+			position832, tokenIndex832, depth832 := position, tokenIndex, depth
 			{
-				position730 := position
+				position833 := position
 				depth++
 				{
-					position731, tokenIndex731, depth731 := position, tokenIndex, depth
+					position834, tokenIndex834, depth834 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l732
+						goto l835
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex, depth = position731, tokenIndex731, depth731
+					goto l834
+				l835:
+					position, tokenIndex, depth = position834, tokenIndex834, depth834
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l731:
+			l834:
 				{
-					position733, tokenIndex733, depth733 := position, tokenIndex, depth
+					position836, tokenIndex836, depth836 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l734
+						goto l837
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex, depth = position733, tokenIndex733, depth733
+					goto l836
+				l837:
+					position, tokenIndex, depth = position836, tokenIndex836, depth836
 					if buffer[position] != rune('V') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l733:
+			l836:
 				{
-					position735, tokenIndex735, depth735 := position, tokenIndex, depth
+					position838, tokenIndex838, depth838 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l736
+						goto l839
 					}
 					position++
-					goto l735
-				l736:
-					position, tokenIndex, depth = position735, tokenIndex735, depth735
+					goto l838
+				l839:
+					position, tokenIndex, depth = position838, tokenIndex838, depth838
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l735:
+			l838:
 				{
-					position737, tokenIndex737, depth737 := position, tokenIndex, depth
+					position840, tokenIndex840, depth840 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l738
+						goto l841
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex, depth = position737, tokenIndex737, depth737
+					goto l840
+				l841:
+					position, tokenIndex, depth = position840, tokenIndex840, depth840
 					if buffer[position] != rune('R') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l737:
+			l840:
 				{
-					position739, tokenIndex739, depth739 := position, tokenIndex, depth
+					position842, tokenIndex842, depth842 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l740
+						goto l843
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex, depth = position739, tokenIndex739, depth739
+					goto l842
+				l843:
+					position, tokenIndex, depth = position842, tokenIndex842, depth842
 					if buffer[position] != rune('Y') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l739:
+			l842:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l832
 				}
 				{
-					position741, tokenIndex741, depth741 := position, tokenIndex, depth
+					position844, tokenIndex844, depth844 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l742
+						goto l845
 					}
-					goto l741
-				l742:
-					position, tokenIndex, depth = position741, tokenIndex741, depth741
+					goto l844
+				l845:
+					position, tokenIndex, depth = position844, tokenIndex844, depth844
 					if !_rules[ruleNumericLiteral]() {
-						goto l729
+						goto l832
 					}
 				}
-			l741:
+			l844:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l832
 				}
 				{
-					position743, tokenIndex743, depth743 := position, tokenIndex, depth
+					position846, tokenIndex846, depth846 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l744
+						goto l847
 					}
 					position++
-					goto l743
-				l744:
-					position, tokenIndex, depth = position743, tokenIndex743, depth743
+					goto l846
+				l847:
+					position, tokenIndex, depth = position846, tokenIndex846, depth846
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l743:
+			l846:
 				{
-					position745, tokenIndex745, depth745 := position, tokenIndex, depth
+					position848, tokenIndex848, depth848 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l746
+						goto l849
 					}
 					position++
-					goto l745
-				l746:
-					position, tokenIndex, depth = position745, tokenIndex745, depth745
+					goto l848
+				l849:
+					position, tokenIndex, depth = position848, tokenIndex848, depth848
 					if buffer[position] != rune('E') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l745:
+			l848:
 				{
-					position747, tokenIndex747, depth747 := position, tokenIndex, depth
+					position850, tokenIndex850, depth850 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l748
+						goto l851
 					}
 					position++
-					goto l747
-				l748:
-					position, tokenIndex, depth = position747, tokenIndex747, depth747
+					goto l850
+				l851:
+					position, tokenIndex, depth = position850, tokenIndex850, depth850
 					if buffer[position] != rune('C') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l747:
+			l850:
 				{
-					position749, tokenIndex749, depth749 := position, tokenIndex, depth
+					position852, tokenIndex852, depth852 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l750
+						goto l853
 					}
 					position++
-					goto l749
-				l750:
-					position, tokenIndex, depth = position749, tokenIndex749, depth749
+					goto l852
+				l853:
+					position, tokenIndex, depth = position852, tokenIndex852, depth852
 					if buffer[position] != rune('O') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l749:
+			l852:
 				{
-					position751, tokenIndex751, depth751 := position, tokenIndex, depth
+					position854, tokenIndex854, depth854 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l752
+						goto l855
 					}
 					position++
-					goto l751
-				l752:
-					position, tokenIndex, depth = position751, tokenIndex751, depth751
+					goto l854
+				l855:
+					position, tokenIndex, depth = position854, tokenIndex854, depth854
 					if buffer[position] != rune('N') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l751:
+			l854:
 				{
-					position753, tokenIndex753, depth753 := position, tokenIndex, depth
+					position856, tokenIndex856, depth856 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l754
+						goto l857
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex, depth = position753, tokenIndex753, depth753
+					goto l856
+				l857:
+					position, tokenIndex, depth = position856, tokenIndex856, depth856
 					if buffer[position] != rune('D') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l753:
+			l856:
 				{
-					position755, tokenIndex755, depth755 := position, tokenIndex, depth
+					position858, tokenIndex858, depth858 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l756
+						goto l859
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex, depth = position755, tokenIndex755, depth755
+					goto l858
+				l859:
+					position, tokenIndex, depth = position858, tokenIndex858, depth858
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l832
 					}
 					position++
 				}
-			l755:
-				if !_rules[ruleAction29]() {
-					goto l729
+			l858:
+				if !_rules[ruleAction34]() {
+					goto l832
 				}
 				depth--
-				add(ruleTimeBasedSamplingSeconds, position730)
+				add(ruleTimeBasedSamplingSeconds, position833)
 			}
 			return true
-		l729:
-			position, tokenIndex, depth = position729, tokenIndex729, depth729
+		l832:
+			position, tokenIndex, depth = position832, tokenIndex832, depth832
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 44 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action35)> */
 		func() bool {
-			position757, tokenIndex757, depth757 := position, tokenIndex, depth
+			position860, tokenIndex860, depth860 := position, tokenIndex, depth
 			{
-				position758 := position
+				position861 := position
 				depth++
 				{
-					position759, tokenIndex759, depth759 := position, tokenIndex, depth
+					position862, tokenIndex862, depth862 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l760
+						goto l863
 					}
 					position++
-					goto l759
-				l760:
-					position, tokenIndex, depth = position759, tokenIndex759, depth759
+					goto l862
+				l863:
+					position, tokenIndex, depth = position862, tokenIndex862, depth862
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l759:
+			l862:
 				{
-					position761, tokenIndex761, depth761 := position, tokenIndex, depth
+					position864, tokenIndex864, depth864 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l762
+						goto l865
 					}
 					position++
-					goto l761
-				l762:
-					position, tokenIndex, depth = position761, tokenIndex761, depth761
+					goto l864
+				l865:
+					position, tokenIndex, depth = position864, tokenIndex864, depth864
 					if buffer[position] != rune('V') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l761:
+			l864:
 				{
-					position763, tokenIndex763, depth763 := position, tokenIndex, depth
+					position866, tokenIndex866, depth866 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l764
+						goto l867
 					}
 					position++
-					goto l763
-				l764:
-					position, tokenIndex, depth = position763, tokenIndex763, depth763
+					goto l866
+				l867:
+					position, tokenIndex, depth = position866, tokenIndex866, depth866
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l763:
+			l866:
 				{
-					position765, tokenIndex765, depth765 := position, tokenIndex, depth
+					position868, tokenIndex868, depth868 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l766
+						goto l869
 					}
 					position++
-					goto l765
-				l766:
-					position, tokenIndex, depth = position765, tokenIndex765, depth765
+					goto l868
+				l869:
+					position, tokenIndex, depth = position868, tokenIndex868, depth868
 					if buffer[position] != rune('R') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l765:
+			l868:
 				{
-					position767, tokenIndex767, depth767 := position, tokenIndex, depth
+					position870, tokenIndex870, depth870 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l768
+						goto l871
 					}
 					position++
-					goto l767
-				l768:
-					position, tokenIndex, depth = position767, tokenIndex767, depth767
+					goto l870
+				l871:
+					position, tokenIndex, depth = position870, tokenIndex870, depth870
 					if buffer[position] != rune('Y') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l767:
+			l870:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l860
 				}
 				{
-					position769, tokenIndex769, depth769 := position, tokenIndex, depth
+					position872, tokenIndex872, depth872 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l770
+						goto l873
 					}
-					goto l769
-				l770:
-					position, tokenIndex, depth = position769, tokenIndex769, depth769
+					goto l872
+				l873:
+					position, tokenIndex, depth = position872, tokenIndex872, depth872
 					if !_rules[ruleNumericLiteral]() {
-						goto l757
+						goto l860
 					}
 				}
-			l769:
+			l872:
 				if !_rules[rulesp]() {
-					goto l757
+					goto l860
 				}
 				{
-					position771, tokenIndex771, depth771 := position, tokenIndex, depth
+					position874, tokenIndex874, depth874 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l772
+						goto l875
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex, depth = position771, tokenIndex771, depth771
+					goto l874
+				l875:
+					position, tokenIndex, depth = position874, tokenIndex874, depth874
 					if buffer[position] != rune('M') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l771:
+			l874:
 				{
-					position773, tokenIndex773, depth773 := position, tokenIndex, depth
+					position876, tokenIndex876, depth876 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l877
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex, depth = position773, tokenIndex773, depth773
+					goto l876
+				l877:
+					position, tokenIndex, depth = position876, tokenIndex876, depth876
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l773:
+			l876:
 				{
-					position775, tokenIndex775, depth775 := position, tokenIndex, depth
+					position878, tokenIndex878, depth878 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l776
+						goto l879
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex, depth = position775, tokenIndex775, depth775
+					goto l878
+				l879:
+					position, tokenIndex, depth = position878, tokenIndex878, depth878
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l775:
+			l878:
 				{
-					position777, tokenIndex777, depth777 := position, tokenIndex, depth
+					position880, tokenIndex880, depth880 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l778
+						goto l881
 					}
 					position++
-					goto l777
-				l778:
-					position, tokenIndex, depth = position777, tokenIndex777, depth777
+					goto l880
+				l881:
+					position, tokenIndex, depth = position880, tokenIndex880, depth880
 					if buffer[position] != rune('L') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l777:
+			l880:
 				{
-					position779, tokenIndex779, depth779 := position, tokenIndex, depth
+					position882, tokenIndex882, depth882 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l780
+						goto l883
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex, depth = position779, tokenIndex779, depth779
+					goto l882
+				l883:
+					position, tokenIndex, depth = position882, tokenIndex882, depth882
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l779:
+			l882:
 				{
-					position781, tokenIndex781, depth781 := position, tokenIndex, depth
+					position884, tokenIndex884, depth884 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l782
+						goto l885
 					}
 					position++
-					goto l781
-				l782:
-					position, tokenIndex, depth = position781, tokenIndex781, depth781
+					goto l884
+				l885:
+					position, tokenIndex, depth = position884, tokenIndex884, depth884
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l781:
+			l884:
 				{
-					position783, tokenIndex783, depth783 := position, tokenIndex, depth
+					position886, tokenIndex886, depth886 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l887
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex, depth = position783, tokenIndex783, depth783
+					goto l886
+				l887:
+					position, tokenIndex, depth = position886, tokenIndex886, depth886
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l783:
+			l886:
 				{
-					position785, tokenIndex785, depth785 := position, tokenIndex, depth
+					position888, tokenIndex888, depth888 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l786
+						goto l889
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex, depth = position785, tokenIndex785, depth785
+					goto l888
+				l889:
+					position, tokenIndex, depth = position888, tokenIndex888, depth888
 					if buffer[position] != rune('C') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l785:
+			l888:
 				{
-					position787, tokenIndex787, depth787 := position, tokenIndex, depth
+					position890, tokenIndex890, depth890 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l788
+						goto l891
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex, depth = position787, tokenIndex787, depth787
+					goto l890
+				l891:
+					position, tokenIndex, depth = position890, tokenIndex890, depth890
 					if buffer[position] != rune('O') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l787:
+			l890:
 				{
-					position789, tokenIndex789, depth789 := position, tokenIndex, depth
+					position892, tokenIndex892, depth892 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l790
+						goto l893
 					}
 					position++
-					goto l789
-				l790:
-					position, tokenIndex, depth = position789, tokenIndex789, depth789
+					goto l892
+				l893:
+					position, tokenIndex, depth = position892, tokenIndex892, depth892
 					if buffer[position] != rune('N') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l789:
+			l892:
 				{
-					position791, tokenIndex791, depth791 := position, tokenIndex, depth
+					position894, tokenIndex894, depth894 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l792
+						goto l895
 					}
 					position++
-					goto l791
-				l792:
-					position, tokenIndex, depth = position791, tokenIndex791, depth791
+					goto l894
+				l895:
+					position, tokenIndex, depth = position894, tokenIndex894, depth894
 					if buffer[position] != rune('D') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l791:
+			l894:
 				{
-					position793, tokenIndex793, depth793 := position, tokenIndex, depth
+					position896, tokenIndex896, depth896 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l794
+						goto l897
 					}
 					position++
-					goto l793
-				l794:
-					position, tokenIndex, depth = position793, tokenIndex793, depth793
+					goto l896
+				l897:
+					position, tokenIndex, depth = position896, tokenIndex896, depth896
 					if buffer[position] != rune('S') {
-						goto l757
+						goto l860
 					}
 					position++
 				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
+			l896:
+				if !_rules[ruleAction35]() {
+					goto l860
 				}
 				depth--
-				add(ruleTimeBasedSamplingMilliseconds, position758)
+				add(ruleTimeBasedSamplingMilliseconds, position861)
 			}
 			return true
-		l757:
-			position, tokenIndex, depth = position757, tokenIndex757, depth757
+		l860:
+			position, tokenIndex, depth = position860, tokenIndex860, depth860
 			return false
 		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
+		/* 45 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action36)> */
 		func() bool {
-			position795, tokenIndex795, depth795 := position, tokenIndex, depth
+			position898, tokenIndex898, depth898 := position, tokenIndex, depth
 			{
-				position796 := position
+				position899 := position
 				depth++
 				{
-					position797 := position
+					position900 := position
 					depth++
 					if !_rules[rulesp]() {
-						goto l795
+						goto l898
 					}
 					if !_rules[ruleProjection]() {
-						goto l795
+						goto l898
 					}
-				l798:
+				l901:
 					{
-						position799, tokenIndex799, depth799 := position, tokenIndex, depth
+						position902, tokenIndex902, depth902 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l902
 						}
 						if buffer[position] != rune(',') {
-							goto l799
+							goto l902
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l799
+							goto l902
 						}
 						if !_rules[ruleProjection]() {
-							goto l799
+							goto l902
 						}
-						goto l798
-					l799:
-						position, tokenIndex, depth = position799, tokenIndex799, depth799
+						goto l901
+					l902:
+						position, tokenIndex, depth = position902, tokenIndex902, depth902
 					}
 					depth--
-					add(rulePegText, position797)
+					add(rulePegText, position900)
 				}
-				if !_rules[ruleAction31]() {
-					goto l795
+				if !_rules[ruleAction36]() {
+					goto l898
 				}
 				depth--
-				add(ruleProjections, position796)
+				add(ruleProjections, position899)
 			}
 			return true
-		l795:
-			position, tokenIndex, depth = position795, tokenIndex795, depth795
+		l898:
+			position, tokenIndex, depth = position898, tokenIndex898, depth898
 			return false
 		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		/* 46 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
 		func() bool {
-			position800, tokenIndex800, depth800 := position, tokenIndex, depth
+			position903, tokenIndex903, depth903 := position, tokenIndex, depth
 			{
-				position801 := position
+				position904 := position
 				depth++
 				{
-					position802, tokenIndex802, depth802 := position, tokenIndex, depth
+					position905, tokenIndex905, depth905 := position, tokenIndex, depth
 					if !_rules[ruleAliasExpression]() {
-						goto l803
+						goto l906
 					}
-					goto l802
-				l803:
-					position, tokenIndex, depth = position802, tokenIndex802, depth802
+					goto l905
+				l906:
+					position, tokenIndex, depth = position905, tokenIndex905, depth905
 					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+						goto l903
 					}
 				}
-			l802:
+			l905:
 				depth--
-				add(ruleProjection, position801)
+				add(ruleProjection, position904)
 			}
 			return true
-		l800:
-			position, tokenIndex, depth = position800, tokenIndex800, depth800
+		l903:
+			position, tokenIndex, depth = position903, tokenIndex903, depth903
 			return false
 		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
+		/* 47 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action37)> */
 		func() bool {
-			position804, tokenIndex804, depth804 := position, tokenIndex, depth
+			position907, tokenIndex907, depth907 := position, tokenIndex, depth
 			{
-				position805 := position
+				position908 := position
 				depth++
 				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
+					goto l907
 				}
 				if !_rules[rulesp]() {
-					goto l804
+					goto l907
 				}
 				{
-					position806, tokenIndex806, depth806 := position, tokenIndex, depth
+					position909, tokenIndex909, depth909 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l807
+						goto l910
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex, depth = position806, tokenIndex806, depth806
+					goto l909
+				l910:
+					position, tokenIndex, depth = position909, tokenIndex909, depth909
 					if buffer[position] != rune('A') {
-						goto l804
+						goto l907
 					}
 					position++
 				}
-			l806:
+			l909:
 				{
-					position808, tokenIndex808, depth808 := position, tokenIndex, depth
+					position911, tokenIndex911, depth911 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l809
+						goto l912
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex, depth = position808, tokenIndex808, depth808
+					goto l911
+				l912:
+					position, tokenIndex, depth = position911, tokenIndex911, depth911
 					if buffer[position] != rune('S') {
-						goto l804
+						goto l907
 					}
 					position++
 				}
-			l808:
+			l911:
 				if !_rules[rulesp]() {
-					goto l804
+					goto l907
 				}
 				if !_rules[ruleTargetIdentifier]() {
-					goto l804
+					goto l907
 				}
-				if !_rules[ruleAction32]() {
-					goto l804
+				if !_rules[ruleAction37]() {
+					goto l907
 				}
 				depth--
-				add(ruleAliasExpression, position805)
+				add(ruleAliasExpression, position908)
 			}
 			return true
-		l804:
-			position, tokenIndex, depth = position804, tokenIndex804, depth804
+		l907:
+			position, tokenIndex, depth = position907, tokenIndex907, depth907
 			return false
 		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
+		/* 48 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action38)> */
 		func() bool {
-			position810, tokenIndex810, depth810 := position, tokenIndex, depth
+			position913, tokenIndex913, depth913 := position, tokenIndex, depth
 			{
-				position811 := position
+				position914 := position
 				depth++
 				{
-					position812 := position
+					position915 := position
 					depth++
 					{
-						position813, tokenIndex813, depth813 := position, tokenIndex, depth
+						position916, tokenIndex916, depth916 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l813
+							goto l916
 						}
 						{
-							position815, tokenIndex815, depth815 := position, tokenIndex, depth
+							position918, tokenIndex918, depth918 := position, tokenIndex, depth
 							if buffer[position] != rune('f') {
-								goto l816
+								goto l919
 							}
 							position++
-							goto l815
-						l816:
-							position, tokenIndex, depth = position815, tokenIndex815, depth815
+							goto l918
+						l919:
+							position, tokenIndex, depth = position918, tokenIndex918, depth918
 							if buffer[position] != rune('F') {
-								goto l813
+								goto l916
 							}
 							position++
 						}
-					l815:
+					l918:
 						{
-							position817, tokenIndex817, depth817 := position, tokenIndex, depth
+							position920, tokenIndex920, depth920 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l818
+								goto l921
 							}
 							position++
-							goto l817
-						l818:
-							position, tokenIndex, depth = position817, tokenIndex817, depth817
+							goto l920
+						l921:
+							position, tokenIndex, depth = position920, tokenIndex920, depth920
 							if buffer[position] != rune('R') {
-								goto l813
+								goto l916
 							}
 							position++
 						}
-					l817:
+					l920:
 						{
-							position819, tokenIndex819, depth819 := position, tokenIndex, depth
+							position922, tokenIndex922, depth922 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l820
+								goto l923
 							}
 							position++
-							goto l819
-						l820:
-							position, tokenIndex, depth = position819, tokenIndex819, depth819
+							goto l922
+						l923:
+							position, tokenIndex, depth = position922, tokenIndex922, depth922
 							if buffer[position] != rune('O') {
-								goto l813
+								goto l916
 							}
 							position++
 						}
-					l819:
+					l922:
 						{
-							position821, tokenIndex821, depth821 := position, tokenIndex, depth
+							position924, tokenIndex924, depth924 := position, tokenIndex, depth
 							if buffer[position] != rune('m') {
-								goto l822
+								goto l925
 							}
 							position++
-							goto l821
-						l822:
-							position, tokenIndex, depth = position821, tokenIndex821, depth821
+							goto l924
+						l925:
+							position, tokenIndex, depth = position924, tokenIndex924, depth924
 							if buffer[position] != rune('M') {
-								goto l813
+								goto l916
 							}
 							position++
 						}
-					l821:
+					l924:
 						if !_rules[rulesp]() {
-							goto l813
+							goto l916
 						}
 						if !_rules[ruleRelations]() {
-							goto l813
+							goto l916
 						}
-						goto l814
-					l813:
-						position, tokenIndex, depth = position813, tokenIndex813, depth813
+						goto l917
+					l916:
+						position, tokenIndex, depth = position916, tokenIndex916, depth916
 					}
-				l814:
+				l917:
 					depth--
-					add(rulePegText, position812)
+					add(rulePegText, position915)
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+				if !_rules[ruleAction38]() {
+					goto l913
 				}
 				depth--
-				add(ruleWindowedFrom, position811)
+				add(ruleWindowedFrom, position914)
 			}
 			return true
-		l810:
-			position, tokenIndex, depth = position810, tokenIndex810, depth810
+		l913:
+			position, tokenIndex, depth = position913, tokenIndex913, depth913
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 49 Interval <- <(TimeInterval / TuplesInterval)> */
 		func() bool {
-			position823, tokenIndex823, depth823 := position, tokenIndex, depth
+			position926, tokenIndex926, depth926 := position, tokenIndex, depth
 			{
-				position824 := position
+				position927 := position
 				depth++
 				{
-					position825, tokenIndex825, depth825 := position, tokenIndex, depth
+					position928, tokenIndex928, depth928 := position, tokenIndex, depth
 					if !_rules[ruleTimeInterval]() {
-						goto l826
+						goto l929
 					}
-					goto l825
-				l826:
-					position, tokenIndex, depth = position825, tokenIndex825, depth825
+					goto l928
+				l929:
+					position, tokenIndex, depth = position928, tokenIndex928, depth928
 					if !_rules[ruleTuplesInterval]() {
-						goto l823
+						goto l926
 					}
 				}
-			l825:
+			l928:
 				depth--
-				add(ruleInterval, position824)
+				add(ruleInterval, position927)
 			}
 			return true
-		l823:
-			position, tokenIndex, depth = position823, tokenIndex823, depth823
+		l926:
+			position, tokenIndex, depth = position926, tokenIndex926, depth926
 			return false
 		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
+		/* 50 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action39)> */
 		func() bool {
-			position827, tokenIndex827, depth827 := position, tokenIndex, depth
+			position930, tokenIndex930, depth930 := position, tokenIndex, depth
 			{
-				position828 := position
+				position931 := position
 				depth++
 				{
-					position829, tokenIndex829, depth829 := position, tokenIndex, depth
+					position932, tokenIndex932, depth932 := position, tokenIndex, depth
 					if !_rules[ruleFloatLiteral]() {
-						goto l830
+						goto l933
 					}
-					goto l829
-				l830:
-					position, tokenIndex, depth = position829, tokenIndex829, depth829
+					goto l932
+				l933:
+					position, tokenIndex, depth = position932, tokenIndex932, depth932
 					if !_rules[ruleNumericLiteral]() {
-						goto l827
+						goto l930
 					}
 				}
-			l829:
+			l932:
 				if !_rules[rulesp]() {
-					goto l827
+					goto l930
 				}
 				{
-					position831, tokenIndex831, depth831 := position, tokenIndex, depth
+					position934, tokenIndex934, depth934 := position, tokenIndex, depth
 					if !_rules[ruleSECONDS]() {
-						goto l832
+						goto l935
 					}
-					goto l831
-				l832:
-					position, tokenIndex, depth = position831, tokenIndex831, depth831
+					goto l934
+				l935:
+					position, tokenIndex, depth = position934, tokenIndex934, depth934
 					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+						goto l930
 					}
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
+			l934:
+				if !_rules[ruleAction39]() {
+					goto l930
 				}
 				depth--
-				add(ruleTimeInterval, position828)
+				add(ruleTimeInterval, position931)
 			}
 			return true
-		l827:
-			position, tokenIndex, depth = position827, tokenIndex827, depth827
+		l930:
+			position, tokenIndex, depth = position930, tokenIndex930, depth930
 			return false
 		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
+		/* 51 TuplesInterval <- <(NumericLiteral sp TUPLES Action40)> */
 		func() bool {
-			position833, tokenIndex833, depth833 := position, tokenIndex, depth
+			position936, tokenIndex936, depth936 := position, tokenIndex, depth
 			{
-				position834 := position
+				position937 := position
 				depth++
 				if !_rules[ruleNumericLiteral]() {
-					goto l833
+					goto l936
 				}
 				if !_rules[rulesp]() {
-					goto l833
+					goto l936
 				}
 				if !_rules[ruleTUPLES]() {
-					goto l833
+					goto l936
 				}
-				if !_rules[ruleAction35]() {
-					goto l833
+				if !_rules[ruleAction40]() {
+					goto l936
 				}
 				depth--
-				add(ruleTuplesInterval, position834)
+				add(ruleTuplesInterval, position937)
 			}
 			return true
-		l833:
-			position, tokenIndex, depth = position833, tokenIndex833, depth833
+		l936:
+			position, tokenIndex, depth = position936, tokenIndex936, depth936
 			return false
 		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
+		/* 52 Relations <- <(RelationLike (JoinedRelations / (spOpt ',' spOpt RelationLike)*))> */
 		func() bool {
-			position835, tokenIndex835, depth835 := position, tokenIndex, depth
+			position938, tokenIndex938, depth938 := position, tokenIndex, depth
 			{
-				position836 := position
+				position939 := position
 				depth++
 				if !_rules[ruleRelationLike]() {
-					goto l835
+					goto l938
 				}
-			l837:
 				{
-					position838, tokenIndex838, depth838 := position, tokenIndex, depth
-					if !_rules[rulespOpt]() {
-						goto l838
-					}
-					if buffer[position] != rune(',') {
-						goto l838
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l838
+					position940, tokenIndex940, depth940 := position, tokenIndex, depth
+					if !_rules[ruleJoinedRelations]() {
+						goto l941
 					}
-					if !_rules[ruleRelationLike]() {
-						goto l838
+					goto l940
+				l941:
+					position, tokenIndex, depth = position940, tokenIndex940, depth940
+				l942:
+					{
+						position943, tokenIndex943, depth943 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l943
+						}
+						if buffer[position] != rune(',') {
+							goto l943
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l943
+						}
+						if !_rules[ruleRelationLike]() {
+							goto l943
+						}
+						goto l942
+					l943:
+						position, tokenIndex, depth = position943, tokenIndex943, depth943
 					}
-					goto l837
-				l838:
-					position, tokenIndex, depth = position838, tokenIndex838, depth838
 				}
+			l940:
 				depth--
-				add(ruleRelations, position836)
+				add(ruleRelations, position939)
 			}
 			return true
-		l835:
-			position, tokenIndex, depth = position835, tokenIndex835, depth835
+		l938:
+			position, tokenIndex, depth = position938, tokenIndex938, depth938
 			return false
 		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
+		/* 53 JoinedRelations <- <(sp JoinedRelation)+> */
 		func() bool {
-			position839, tokenIndex839, depth839 := position, tokenIndex, depth
+			position944, tokenIndex944, depth944 := position, tokenIndex, depth
 			{
-				position840 := position
+				position945 := position
 				depth++
+				if !_rules[rulesp]() {
+					goto l944
+				}
+				if !_rules[ruleJoinedRelation]() {
+					goto l944
+				}
+			l946:
 				{
-					position841 := position
-					depth++
-					{
-						position842, tokenIndex842, depth842 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						{
-							position844, tokenIndex844, depth844 := position, tokenIndex, depth
-							if buffer[position] != rune('w') {
-								goto l845
-							}
-							position++
-							goto l844
-						l845:
-							position, tokenIndex, depth = position844, tokenIndex844, depth844
-							if buffer[position] != rune('W') {
-								goto l842
-							}
-							position++
-						}
-					l844:
-						{
-							position846, tokenIndex846, depth846 := position, tokenIndex, depth
-							if buffer[position] != rune('h') {
-								goto l847
-							}
-							position++
-							goto l846
-						l847:
-							position, tokenIndex, depth = position846, tokenIndex846, depth846
-							if buffer[position] != rune('H') {
-								goto l842
-							}
-							position++
-						}
-					l846:
-						{
-							position848, tokenIndex848, depth848 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l849
-							}
-							position++
-							goto l848
-						l849:
-							position, tokenIndex, depth = position848, tokenIndex848, depth848
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
-						}
-					l848:
-						{
-							position850, tokenIndex850, depth850 := position, tokenIndex, depth
-							if buffer[position] != rune('r') {
-								goto l851
-							}
-							position++
-							goto l850
-						l851:
-							position, tokenIndex, depth = position850, tokenIndex850, depth850
-							if buffer[position] != rune('R') {
-								goto l842
-							}
-							position++
-						}
-					l850:
-						{
-							position852, tokenIndex852, depth852 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l853
-							}
-							position++
-							goto l852
-						l853:
-							position, tokenIndex, depth = position852, tokenIndex852, depth852
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
-						}
-					l852:
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						if !_rules[ruleExpression]() {
-							goto l842
-						}
-						goto l843
-					l842:
-						position, tokenIndex, depth = position842, tokenIndex842, depth842
+					position947, tokenIndex947, depth947 := position, tokenIndex, depth
+					if !_rules[rulesp]() {
+						goto l947
 					}
-				l843:
-					depth--
-					add(rulePegText, position841)
-				}
-				if !_rules[ruleAction36]() {
-					goto l839
+					if !_rules[ruleJoinedRelation]() {
+						goto l947
+					}
+					goto l946
+				l947:
+					position, tokenIndex, depth = position947, tokenIndex947, depth947
 				}
 				depth--
-				add(ruleFilter, position840)
+				add(ruleJoinedRelations, position945)
 			}
 			return true
-		l839:
-			position, tokenIndex, depth = position839, tokenIndex839, depth839
+		l944:
+			position, tokenIndex, depth = position944, tokenIndex944, depth944
 			return false
 		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
+		/* 54 JoinedRelation <- <(JoinTypeOpt (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')) sp RelationLike sp (('o' / 'O') ('n' / 'N')) sp Expression Action41)> */
 		func() bool {
-			position854, tokenIndex854, depth854 := position, tokenIndex, depth
+			position948, tokenIndex948, depth948 := position, tokenIndex, depth
 			{
-				position855 := position
+				position949 := position
 				depth++
+				if !_rules[ruleJoinTypeOpt]() {
+					goto l948
+				}
 				{
-					position856 := position
-					depth++
-					{
-						position857, tokenIndex857, depth857 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l857
-						}
+					position950, tokenIndex950, depth950 := position, tokenIndex, depth
+					if buffer[position] != rune('j') {
+						goto l951
+					}
+					position++
+					goto l950
+				l951:
+					position, tokenIndex, depth = position950, tokenIndex950, depth950
+					if buffer[position] != rune('J') {
+						goto l948
+					}
+					position++
+				}
+			l950:
+				{
+					position952, tokenIndex952, depth952 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l953
+					}
+					position++
+					goto l952
+				l953:
+					position, tokenIndex, depth = position952, tokenIndex952, depth952
+					if buffer[position] != rune('O') {
+						goto l948
+					}
+					position++
+				}
+			l952:
+				{
+					position954, tokenIndex954, depth954 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l955
+					}
+					position++
+					goto l954
+				l955:
+					position, tokenIndex, depth = position954, tokenIndex954, depth954
+					if buffer[position] != rune('I') {
+						goto l948
+					}
+					position++
+				}
+			l954:
+				{
+					position956, tokenIndex956, depth956 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l957
+					}
+					position++
+					goto l956
+				l957:
+					position, tokenIndex, depth = position956, tokenIndex956, depth956
+					if buffer[position] != rune('N') {
+						goto l948
+					}
+					position++
+				}
+			l956:
+				if !_rules[rulesp]() {
+					goto l948
+				}
+				if !_rules[ruleRelationLike]() {
+					goto l948
+				}
+				if !_rules[rulesp]() {
+					goto l948
+				}
+				{
+					position958, tokenIndex958, depth958 := position, tokenIndex, depth
+					if buffer[position] != rune('o') {
+						goto l959
+					}
+					position++
+					goto l958
+				l959:
+					position, tokenIndex, depth = position958, tokenIndex958, depth958
+					if buffer[position] != rune('O') {
+						goto l948
+					}
+					position++
+				}
+			l958:
+				{
+					position960, tokenIndex960, depth960 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l961
+					}
+					position++
+					goto l960
+				l961:
+					position, tokenIndex, depth = position960, tokenIndex960, depth960
+					if buffer[position] != rune('N') {
+						goto l948
+					}
+					position++
+				}
+			l960:
+				if !_rules[rulesp]() {
+					goto l948
+				}
+				if !_rules[ruleExpression]() {
+					goto l948
+				}
+				if !_rules[ruleAction41]() {
+					goto l948
+				}
+				depth--
+				add(ruleJoinedRelation, position949)
+			}
+			return true
+		l948:
+			position, tokenIndex, depth = position948, tokenIndex948, depth948
+			return false
+		},
+		/* 55 JoinTypeOpt <- <(<((INNER / LEFT) sp)?> Action42)> */
+		func() bool {
+			position962, tokenIndex962, depth962 := position, tokenIndex, depth
+			{
+				position963 := position
+				depth++
+				{
+					position964 := position
+					depth++
+					{
+						position965, tokenIndex965, depth965 := position, tokenIndex, depth
+						{
+							position967, tokenIndex967, depth967 := position, tokenIndex, depth
+							if !_rules[ruleINNER]() {
+								goto l968
+							}
+							goto l967
+						l968:
+							position, tokenIndex, depth = position967, tokenIndex967, depth967
+							if !_rules[ruleLEFT]() {
+								goto l965
+							}
+						}
+					l967:
+						if !_rules[rulesp]() {
+							goto l965
+						}
+						goto l966
+					l965:
+						position, tokenIndex, depth = position965, tokenIndex965, depth965
+					}
+				l966:
+					depth--
+					add(rulePegText, position964)
+				}
+				if !_rules[ruleAction42]() {
+					goto l962
+				}
+				depth--
+				add(ruleJoinTypeOpt, position963)
+			}
+			return true
+		l962:
+			position, tokenIndex, depth = position962, tokenIndex962, depth962
+			return false
+		},
+		/* 56 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action43)> */
+		func() bool {
+			position969, tokenIndex969, depth969 := position, tokenIndex, depth
+			{
+				position970 := position
+				depth++
+				{
+					position971 := position
+					depth++
+					{
+						position972, tokenIndex972, depth972 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l972
+						}
+						{
+							position974, tokenIndex974, depth974 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l975
+							}
+							position++
+							goto l974
+						l975:
+							position, tokenIndex, depth = position974, tokenIndex974, depth974
+							if buffer[position] != rune('W') {
+								goto l972
+							}
+							position++
+						}
+					l974:
+						{
+							position976, tokenIndex976, depth976 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l977
+							}
+							position++
+							goto l976
+						l977:
+							position, tokenIndex, depth = position976, tokenIndex976, depth976
+							if buffer[position] != rune('H') {
+								goto l972
+							}
+							position++
+						}
+					l976:
+						{
+							position978, tokenIndex978, depth978 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l979
+							}
+							position++
+							goto l978
+						l979:
+							position, tokenIndex, depth = position978, tokenIndex978, depth978
+							if buffer[position] != rune('E') {
+								goto l972
+							}
+							position++
+						}
+					l978:
+						{
+							position980, tokenIndex980, depth980 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l981
+							}
+							position++
+							goto l980
+						l981:
+							position, tokenIndex, depth = position980, tokenIndex980, depth980
+							if buffer[position] != rune('R') {
+								goto l972
+							}
+							position++
+						}
+					l980:
+						{
+							position982, tokenIndex982, depth982 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l983
+							}
+							position++
+							goto l982
+						l983:
+							position, tokenIndex, depth = position982, tokenIndex982, depth982
+							if buffer[position] != rune('E') {
+								goto l972
+							}
+							position++
+						}
+					l982:
+						if !_rules[rulesp]() {
+							goto l972
+						}
+						if !_rules[ruleExpression]() {
+							goto l972
+						}
+						goto l973
+					l972:
+						position, tokenIndex, depth = position972, tokenIndex972, depth972
+					}
+				l973:
+					depth--
+					add(rulePegText, position971)
+				}
+				if !_rules[ruleAction43]() {
+					goto l969
+				}
+				depth--
+				add(ruleFilter, position970)
+			}
+			return true
+		l969:
+			position, tokenIndex, depth = position969, tokenIndex969, depth969
+			return false
+		},
+		/* 57 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action44)> */
+		func() bool {
+			position984, tokenIndex984, depth984 := position, tokenIndex, depth
+			{
+				position985 := position
+				depth++
+				{
+					position986 := position
+					depth++
+					{
+						position987, tokenIndex987, depth987 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l987
+						}
 						{
-							position859, tokenIndex859, depth859 := position, tokenIndex, depth
+							position989, tokenIndex989, depth989 := position, tokenIndex, depth
 							if buffer[position] != rune('g') {
-								goto l860
+								goto l990
 							}
 							position++
-							goto l859
-						l860:
-							position, tokenIndex, depth = position859, tokenIndex859, depth859
+							goto l989
+						l990:
+							position, tokenIndex, depth = position989, tokenIndex989, depth989
 							if buffer[position] != rune('G') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l859:
+					l989:
 						{
-							position861, tokenIndex861, depth861 := position, tokenIndex, depth
+							position991, tokenIndex991, depth991 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l862
+								goto l992
 							}
 							position++
-							goto l861
-						l862:
-							position, tokenIndex, depth = position861, tokenIndex861, depth861
+							goto l991
+						l992:
+							position, tokenIndex, depth = position991, tokenIndex991, depth991
 							if buffer[position] != rune('R') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l861:
+					l991:
 						{
-							position863, tokenIndex863, depth863 := position, tokenIndex, depth
+							position993, tokenIndex993, depth993 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l864
+								goto l994
 							}
 							position++
-							goto l863
-						l864:
-							position, tokenIndex, depth = position863, tokenIndex863, depth863
+							goto l993
+						l994:
+							position, tokenIndex, depth = position993, tokenIndex993, depth993
 							if buffer[position] != rune('O') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l863:
+					l993:
 						{
-							position865, tokenIndex865, depth865 := position, tokenIndex, depth
+							position995, tokenIndex995, depth995 := position, tokenIndex, depth
 							if buffer[position] != rune('u') {
-								goto l866
+								goto l996
 							}
 							position++
-							goto l865
-						l866:
-							position, tokenIndex, depth = position865, tokenIndex865, depth865
+							goto l995
+						l996:
+							position, tokenIndex, depth = position995, tokenIndex995, depth995
 							if buffer[position] != rune('U') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l865:
+					l995:
 						{
-							position867, tokenIndex867, depth867 := position, tokenIndex, depth
+							position997, tokenIndex997, depth997 := position, tokenIndex, depth
 							if buffer[position] != rune('p') {
-								goto l868
+								goto l998
 							}
 							position++
-							goto l867
-						l868:
-							position, tokenIndex, depth = position867, tokenIndex867, depth867
+							goto l997
+						l998:
+							position, tokenIndex, depth = position997, tokenIndex997, depth997
 							if buffer[position] != rune('P') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l867:
+					l997:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l987
 						}
 						{
-							position869, tokenIndex869, depth869 := position, tokenIndex, depth
+							position999, tokenIndex999, depth999 := position, tokenIndex, depth
 							if buffer[position] != rune('b') {
-								goto l870
+								goto l1000
 							}
 							position++
-							goto l869
-						l870:
-							position, tokenIndex, depth = position869, tokenIndex869, depth869
+							goto l999
+						l1000:
+							position, tokenIndex, depth = position999, tokenIndex999, depth999
 							if buffer[position] != rune('B') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l869:
+					l999:
 						{
-							position871, tokenIndex871, depth871 := position, tokenIndex, depth
+							position1001, tokenIndex1001, depth1001 := position, tokenIndex, depth
 							if buffer[position] != rune('y') {
-								goto l872
+								goto l1002
 							}
 							position++
-							goto l871
-						l872:
-							position, tokenIndex, depth = position871, tokenIndex871, depth871
+							goto l1001
+						l1002:
+							position, tokenIndex, depth = position1001, tokenIndex1001, depth1001
 							if buffer[position] != rune('Y') {
-								goto l857
+								goto l987
 							}
 							position++
 						}
-					l871:
+					l1001:
 						if !_rules[rulesp]() {
-							goto l857
+							goto l987
 						}
 						if !_rules[ruleGroupList]() {
-							goto l857
+							goto l987
 						}
-						goto l858
-					l857:
-						position, tokenIndex, depth = position857, tokenIndex857, depth857
+						goto l988
+					l987:
+						position, tokenIndex, depth = position987, tokenIndex987, depth987
 					}
-				l858:
+				l988:
 					depth--
-					add(rulePegText, position856)
+					add(rulePegText, position986)
 				}
-				if !_rules[ruleAction37]() {
-					goto l854
+				if !_rules[ruleAction44]() {
+					goto l984
 				}
 				depth--
-				add(ruleGrouping, position855)
+				add(ruleGrouping, position985)
 			}
 			return true
-		l854:
-			position, tokenIndex, depth = position854, tokenIndex854, depth854
+		l984:
+			position, tokenIndex, depth = position984, tokenIndex984, depth984
 			return false
 		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		/* 58 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
 		func() bool {
-			position873, tokenIndex873, depth873 := position, tokenIndex, depth
+			position1003, tokenIndex1003, depth1003 := position, tokenIndex, depth
 			{
-				position874 := position
+				position1004 := position
 				depth++
 				if !_rules[ruleExpression]() {
-					goto l873
+					goto l1003
 				}
-			l875:
+			l1005:
 				{
-					position876, tokenIndex876, depth876 := position, tokenIndex, depth
+					position1006, tokenIndex1006, depth1006 := position, tokenIndex, depth
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l1006
 					}
 					if buffer[position] != rune(',') {
-						goto l876
+						goto l1006
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l876
+						goto l1006
 					}
 					if !_rules[ruleExpression]() {
-						goto l876
+						goto l1006
 					}
-					goto l875
-				l876:
-					position, tokenIndex, depth = position876, tokenIndex876, depth876
+					goto l1005
+				l1006:
+					position, tokenIndex, depth = position1006, tokenIndex1006, depth1006
 				}
 				depth--
-				add(ruleGroupList, position874)
+				add(ruleGroupList, position1004)
 			}
 			return true
-		l873:
-			position, tokenIndex, depth = position873, tokenIndex873, depth873
+		l1003:
+			position, tokenIndex, depth = position1003, tokenIndex1003, depth1003
 			return false
 		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
+		/* 59 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action45)> */
 		func() bool {
-			position877, tokenIndex877, depth877 := position, tokenIndex, depth
+			position1007, tokenIndex1007, depth1007 := position, tokenIndex, depth
 			{
-				position878 := position
+				position1008 := position
 				depth++
 				{
-					position879 := position
+					position1009 := position
 					depth++
 					{
-						position880, tokenIndex880, depth880 := position, tokenIndex, depth
+						position1010, tokenIndex1010, depth1010 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l880
+							goto l1010
 						}
 						{
-							position882, tokenIndex882, depth882 := position, tokenIndex, depth
+							position1012, tokenIndex1012, depth1012 := position, tokenIndex, depth
 							if buffer[position] != rune('h') {
-								goto l883
+								goto l1013
 							}
 							position++
-							goto l882
-						l883:
-							position, tokenIndex, depth = position882, tokenIndex882, depth882
+							goto l1012
+						l1013:
+							position, tokenIndex, depth = position1012, tokenIndex1012, depth1012
 							if buffer[position] != rune('H') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l882:
+					l1012:
 						{
-							position884, tokenIndex884, depth884 := position, tokenIndex, depth
+							position1014, tokenIndex1014, depth1014 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l885
+								goto l1015
 							}
 							position++
-							goto l884
-						l885:
-							position, tokenIndex, depth = position884, tokenIndex884, depth884
+							goto l1014
+						l1015:
+							position, tokenIndex, depth = position1014, tokenIndex1014, depth1014
 							if buffer[position] != rune('A') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l884:
+					l1014:
 						{
-							position886, tokenIndex886, depth886 := position, tokenIndex, depth
+							position1016, tokenIndex1016, depth1016 := position, tokenIndex, depth
 							if buffer[position] != rune('v') {
-								goto l887
+								goto l1017
 							}
 							position++
-							goto l886
-						l887:
-							position, tokenIndex, depth = position886, tokenIndex886, depth886
+							goto l1016
+						l1017:
+							position, tokenIndex, depth = position1016, tokenIndex1016, depth1016
 							if buffer[position] != rune('V') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l886:
+					l1016:
 						{
-							position888, tokenIndex888, depth888 := position, tokenIndex, depth
+							position1018, tokenIndex1018, depth1018 := position, tokenIndex, depth
 							if buffer[position] != rune('i') {
-								goto l889
+								goto l1019
 							}
 							position++
-							goto l888
-						l889:
-							position, tokenIndex, depth = position888, tokenIndex888, depth888
+							goto l1018
+						l1019:
+							position, tokenIndex, depth = position1018, tokenIndex1018, depth1018
 							if buffer[position] != rune('I') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l888:
+					l1018:
 						{
-							position890, tokenIndex890, depth890 := position, tokenIndex, depth
+							position1020, tokenIndex1020, depth1020 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l891
+								goto l1021
 							}
 							position++
-							goto l890
-						l891:
-							position, tokenIndex, depth = position890, tokenIndex890, depth890
+							goto l1020
+						l1021:
+							position, tokenIndex, depth = position1020, tokenIndex1020, depth1020
 							if buffer[position] != rune('N') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l890:
+					l1020:
 						{
-							position892, tokenIndex892, depth892 := position, tokenIndex, depth
+							position1022, tokenIndex1022, depth1022 := position, tokenIndex, depth
 							if buffer[position] != rune('g') {
-								goto l893
+								goto l1023
 							}
 							position++
-							goto l892
-						l893:
-							position, tokenIndex, depth = position892, tokenIndex892, depth892
+							goto l1022
+						l1023:
+							position, tokenIndex, depth = position1022, tokenIndex1022, depth1022
 							if buffer[position] != rune('G') {
-								goto l880
+								goto l1010
 							}
 							position++
 						}
-					l892:
+					l1022:
 						if !_rules[rulesp]() {
-							goto l880
+							goto l1010
 						}
 						if !_rules[ruleExpression]() {
-							goto l880
+							goto l1010
 						}
-						goto l881
-					l880:
-						position, tokenIndex, depth = position880, tokenIndex880, depth880
+						goto l1011
+					l1010:
+						position, tokenIndex, depth = position1010, tokenIndex1010, depth1010
 					}
-				l881:
+				l1011:
 					depth--
-					add(rulePegText, position879)
-				}
-				if !_rules[ruleAction38]() {
-					goto l877
+					add(rulePegText, position1009)
 				}
-				depth--
-				add(ruleHaving, position878)
-			}
-			return true
-		l877:
-			position, tokenIndex, depth = position877, tokenIndex877, depth877
-			return false
-		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
-		func() bool {
-			position894, tokenIndex894, depth894 := position, tokenIndex, depth
-			{
-				position895 := position
-				depth++
-				{
-					position896, tokenIndex896, depth896 := position, tokenIndex, depth
-					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
-					}
-					goto l896
-				l897:
-					position, tokenIndex, depth = position896, tokenIndex896, depth896
-					if !_rules[ruleStreamWindow]() {
-						goto l894
-					}
-					if !_rules[ruleAction39]() {
-						goto l894
-					}
+				if !_rules[ruleAction45]() {
+					goto l1007
 				}
-			l896:
 				depth--
-				add(ruleRelationLike, position895)
+				add(ruleHaving, position1008)
 			}
 			return true
-		l894:
-			position, tokenIndex, depth = position894, tokenIndex894, depth894
+		l1007:
+			position, tokenIndex, depth = position1007, tokenIndex1007, depth1007
 			return false
 		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
+		/* 60 OrderBy <- <(<(sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action46)> */
 		func() bool {
-			position898, tokenIndex898, depth898 := position, tokenIndex, depth
+			position1024, tokenIndex1024, depth1024 := position, tokenIndex, depth
 			{
-				position899 := position
+				position1025 := position
 				depth++
-				if !_rules[ruleStreamWindow]() {
-					goto l898
-				}
-				if !_rules[rulesp]() {
-					goto l898
-				}
 				{
-					position900, tokenIndex900, depth900 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l901
-					}
-					position++
-					goto l900
-				l901:
-					position, tokenIndex, depth = position900, tokenIndex900, depth900
-					if buffer[position] != rune('A') {
-						goto l898
-					}
-					position++
-				}
-			l900:
-				{
-					position902, tokenIndex902, depth902 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l903
-					}
-					position++
-					goto l902
-				l903:
-					position, tokenIndex, depth = position902, tokenIndex902, depth902
-					if buffer[position] != rune('S') {
-						goto l898
-					}
-					position++
-				}
-			l902:
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				if !_rules[ruleIdentifier]() {
-					goto l898
-				}
-				if !_rules[ruleAction40]() {
-					goto l898
-				}
-				depth--
-				add(ruleAliasedStreamWindow, position899)
-			}
-			return true
-		l898:
-			position, tokenIndex, depth = position898, tokenIndex898, depth898
-			return false
-		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
-		func() bool {
-			position904, tokenIndex904, depth904 := position, tokenIndex, depth
-			{
-				position905 := position
-				depth++
-				if !_rules[ruleStreamLike]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune('[') {
-					goto l904
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				{
-					position906, tokenIndex906, depth906 := position, tokenIndex, depth
-					if buffer[position] != rune('r') {
-						goto l907
-					}
-					position++
-					goto l906
-				l907:
-					position, tokenIndex, depth = position906, tokenIndex906, depth906
-					if buffer[position] != rune('R') {
-						goto l904
-					}
-					position++
-				}
-			l906:
-				{
-					position908, tokenIndex908, depth908 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l909
-					}
-					position++
-					goto l908
-				l909:
-					position, tokenIndex, depth = position908, tokenIndex908, depth908
-					if buffer[position] != rune('A') {
-						goto l904
-					}
-					position++
-				}
-			l908:
-				{
-					position910, tokenIndex910, depth910 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l911
-					}
-					position++
-					goto l910
-				l911:
-					position, tokenIndex, depth = position910, tokenIndex910, depth910
-					if buffer[position] != rune('N') {
-						goto l904
-					}
-					position++
-				}
-			l910:
-				{
-					position912, tokenIndex912, depth912 := position, tokenIndex, depth
-					if buffer[position] != rune('g') {
-						goto l913
-					}
-					position++
-					goto l912
-				l913:
-					position, tokenIndex, depth = position912, tokenIndex912, depth912
-					if buffer[position] != rune('G') {
-						goto l904
-					}
-					position++
-				}
-			l912:
-				{
-					position914, tokenIndex914, depth914 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l915
-					}
-					position++
-					goto l914
-				l915:
-					position, tokenIndex, depth = position914, tokenIndex914, depth914
-					if buffer[position] != rune('E') {
-						goto l904
-					}
-					position++
-				}
-			l914:
-				if !_rules[rulesp]() {
-					goto l904
-				}
-				if !_rules[ruleInterval]() {
-					goto l904
-				}
-				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
-				}
-				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune(']') {
-					goto l904
-				}
-				position++
-				if !_rules[ruleAction41]() {
-					goto l904
-				}
-				depth--
-				add(ruleStreamWindow, position905)
-			}
-			return true
-		l904:
-			position, tokenIndex, depth = position904, tokenIndex904, depth904
-			return false
-		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
-		func() bool {
-			position916, tokenIndex916, depth916 := position, tokenIndex, depth
-			{
-				position917 := position
-				depth++
-				{
-					position918, tokenIndex918, depth918 := position, tokenIndex, depth
-					if !_rules[ruleUDSFFuncApp]() {
-						goto l919
-					}
-					goto l918
-				l919:
-					position, tokenIndex, depth = position918, tokenIndex918, depth918
-					if !_rules[ruleStream]() {
-						goto l916
-					}
-				}
-			l918:
-				depth--
-				add(ruleStreamLike, position917)
-			}
-			return true
-		l916:
-			position, tokenIndex, depth = position916, tokenIndex916, depth916
-			return false
-		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
-		func() bool {
-			position920, tokenIndex920, depth920 := position, tokenIndex, depth
-			{
-				position921 := position
-				depth++
-				if !_rules[ruleFuncAppWithoutOrderBy]() {
-					goto l920
-				}
-				if !_rules[ruleAction42]() {
-					goto l920
-				}
-				depth--
-				add(ruleUDSFFuncApp, position921)
-			}
-			return true
-		l920:
-			position, tokenIndex, depth = position920, tokenIndex920, depth920
-			return false
-		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
-		func() bool {
-			position922, tokenIndex922, depth922 := position, tokenIndex, depth
-			{
-				position923 := position
-				depth++
-				{
-					position924 := position
+					position1026 := position
 					depth++
 					{
-						position925, tokenIndex925, depth925 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						if buffer[position] != rune(',') {
-							goto l925
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						{
-							position927, tokenIndex927, depth927 := position, tokenIndex, depth
-							if buffer[position] != rune('b') {
-								goto l928
-							}
-							position++
-							goto l927
-						l928:
-							position, tokenIndex, depth = position927, tokenIndex927, depth927
-							if buffer[position] != rune('B') {
-								goto l925
-							}
-							position++
+						position1027, tokenIndex1027, depth1027 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1027
 						}
-					l927:
 						{
-							position929, tokenIndex929, depth929 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l930
+							position1029, tokenIndex1029, depth1029 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1030
 							}
 							position++
-							goto l929
-						l930:
-							position, tokenIndex, depth = position929, tokenIndex929, depth929
-							if buffer[position] != rune('U') {
-								goto l925
+							goto l1029
+						l1030:
+							position, tokenIndex, depth = position1029, tokenIndex1029, depth1029
+							if buffer[position] != rune('O') {
+								goto l1027
 							}
 							position++
 						}
-					l929:
+					l1029:
 						{
-							position931, tokenIndex931, depth931 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l932
+							position1031, tokenIndex1031, depth1031 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1032
 							}
 							position++
-							goto l931
-						l932:
-							position, tokenIndex, depth = position931, tokenIndex931, depth931
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l1031
+						l1032:
+							position, tokenIndex, depth = position1031, tokenIndex1031, depth1031
+							if buffer[position] != rune('R') {
+								goto l1027
 							}
 							position++
 						}
-					l931:
+					l1031:
 						{
-							position933, tokenIndex933, depth933 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l934
+							position1033, tokenIndex1033, depth1033 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1034
 							}
 							position++
-							goto l933
-						l934:
-							position, tokenIndex, depth = position933, tokenIndex933, depth933
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l1033
+						l1034:
+							position, tokenIndex, depth = position1033, tokenIndex1033, depth1033
+							if buffer[position] != rune('D') {
+								goto l1027
 							}
 							position++
 						}
-					l933:
+					l1033:
 						{
-							position935, tokenIndex935, depth935 := position, tokenIndex, depth
+							position1035, tokenIndex1035, depth1035 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l936
+								goto l1036
 							}
 							position++
-							goto l935
-						l936:
-							position, tokenIndex, depth = position935, tokenIndex935, depth935
+							goto l1035
+						l1036:
+							position, tokenIndex, depth = position1035, tokenIndex1035, depth1035
 							if buffer[position] != rune('E') {
-								goto l925
+								goto l1027
 							}
 							position++
 						}
-					l935:
+					l1035:
 						{
-							position937, tokenIndex937, depth937 := position, tokenIndex, depth
+							position1037, tokenIndex1037, depth1037 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l938
+								goto l1038
 							}
 							position++
-							goto l937
-						l938:
-							position, tokenIndex, depth = position937, tokenIndex937, depth937
+							goto l1037
+						l1038:
+							position, tokenIndex, depth = position1037, tokenIndex1037, depth1037
 							if buffer[position] != rune('R') {
-								goto l925
+								goto l1027
 							}
 							position++
 						}
-					l937:
+					l1037:
 						if !_rules[rulesp]() {
-							goto l925
+							goto l1027
 						}
 						{
-							position939, tokenIndex939, depth939 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l940
+							position1039, tokenIndex1039, depth1039 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1040
 							}
 							position++
-							goto l939
-						l940:
-							position, tokenIndex, depth = position939, tokenIndex939, depth939
-							if buffer[position] != rune('S') {
-								goto l925
+							goto l1039
+						l1040:
+							position, tokenIndex, depth = position1039, tokenIndex1039, depth1039
+							if buffer[position] != rune('B') {
+								goto l1027
 							}
 							position++
 						}
-					l939:
+					l1039:
 						{
-							position941, tokenIndex941, depth941 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l942
+							position1041, tokenIndex1041, depth1041 := position, tokenIndex, depth
+							if buffer[position] != rune('y') {
+								goto l1042
 							}
 							position++
-							goto l941
-						l942:
-							position, tokenIndex, depth = position941, tokenIndex941, depth941
-							if buffer[position] != rune('I') {
-								goto l925
+							goto l1041
+						l1042:
+							position, tokenIndex, depth = position1041, tokenIndex1041, depth1041
+							if buffer[position] != rune('Y') {
+								goto l1027
 							}
 							position++
 						}
-					l941:
+					l1041:
+						if !_rules[rulesp]() {
+							goto l1027
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1027
+						}
+					l1043:
 						{
-							position943, tokenIndex943, depth943 := position, tokenIndex, depth
-							if buffer[position] != rune('z') {
-								goto l944
+							position1044, tokenIndex1044, depth1044 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1044
 							}
-							position++
-							goto l943
-						l944:
-							position, tokenIndex, depth = position943, tokenIndex943, depth943
-							if buffer[position] != rune('Z') {
-								goto l925
+							if buffer[position] != rune(',') {
+								goto l1044
 							}
 							position++
-						}
-					l943:
-						{
-							position945, tokenIndex945, depth945 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l946
+							if !_rules[rulespOpt]() {
+								goto l1044
 							}
-							position++
-							goto l945
-						l946:
-							position, tokenIndex, depth = position945, tokenIndex945, depth945
-							if buffer[position] != rune('E') {
-								goto l925
+							if !_rules[ruleSortedExpression]() {
+								goto l1044
 							}
-							position++
-						}
-					l945:
-						if !_rules[rulesp]() {
-							goto l925
+							goto l1043
+						l1044:
+							position, tokenIndex, depth = position1044, tokenIndex1044, depth1044
 						}
-						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
-						}
-						goto l926
-					l925:
-						position, tokenIndex, depth = position925, tokenIndex925, depth925
+						goto l1028
+					l1027:
+						position, tokenIndex, depth = position1027, tokenIndex1027, depth1027
 					}
-				l926:
+				l1028:
 					depth--
-					add(rulePegText, position924)
+					add(rulePegText, position1026)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction46]() {
+					goto l1024
 				}
 				depth--
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleOrderBy, position1025)
 			}
 			return true
-		l922:
-			position, tokenIndex, depth = position922, tokenIndex922, depth922
+		l1024:
+			position, tokenIndex, depth = position1024, tokenIndex1024, depth1024
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 61 Limit <- <(<(sp (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) sp NumericLiteral)?> Action47)> */
 		func() bool {
-			position947, tokenIndex947, depth947 := position, tokenIndex, depth
+			position1045, tokenIndex1045, depth1045 := position, tokenIndex, depth
 			{
-				position948 := position
+				position1046 := position
 				depth++
 				{
-					position949 := position
+					position1047 := position
 					depth++
 					{
-						position950, tokenIndex950, depth950 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if buffer[position] != rune(',') {
-							goto l950
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if !_rules[ruleSheddingOption]() {
-							goto l950
-						}
+						position1048, tokenIndex1048, depth1048 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l950
+							goto l1048
 						}
 						{
-							position952, tokenIndex952, depth952 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l953
+							position1050, tokenIndex1050, depth1050 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1051
 							}
 							position++
-							goto l952
-						l953:
-							position, tokenIndex, depth = position952, tokenIndex952, depth952
-							if buffer[position] != rune('I') {
-								goto l950
+							goto l1050
+						l1051:
+							position, tokenIndex, depth = position1050, tokenIndex1050, depth1050
+							if buffer[position] != rune('L') {
+								goto l1048
 							}
 							position++
 						}
-					l952:
+					l1050:
 						{
-							position954, tokenIndex954, depth954 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l955
+							position1052, tokenIndex1052, depth1052 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1053
 							}
 							position++
-							goto l954
-						l955:
-							position, tokenIndex, depth = position954, tokenIndex954, depth954
-							if buffer[position] != rune('F') {
-								goto l950
+							goto l1052
+						l1053:
+							position, tokenIndex, depth = position1052, tokenIndex1052, depth1052
+							if buffer[position] != rune('I') {
+								goto l1048
 							}
 							position++
 						}
-					l954:
-						if !_rules[rulesp]() {
-							goto l950
-						}
+					l1052:
 						{
-							position956, tokenIndex956, depth956 := position, tokenIndex, depth
-							if buffer[position] != rune('f') {
-								goto l957
+							position1054, tokenIndex1054, depth1054 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l1055
 							}
 							position++
-							goto l956
-						l957:
-							position, tokenIndex, depth = position956, tokenIndex956, depth956
-							if buffer[position] != rune('F') {
-								goto l950
+							goto l1054
+						l1055:
+							position, tokenIndex, depth = position1054, tokenIndex1054, depth1054
+							if buffer[position] != rune('M') {
+								goto l1048
 							}
 							position++
 						}
-					l956:
+					l1054:
 						{
-							position958, tokenIndex958, depth958 := position, tokenIndex, depth
-							if buffer[position] != rune('u') {
-								goto l959
+							position1056, tokenIndex1056, depth1056 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1057
 							}
 							position++
-							goto l958
-						l959:
-							position, tokenIndex, depth = position958, tokenIndex958, depth958
-							if buffer[position] != rune('U') {
-								goto l950
+							goto l1056
+						l1057:
+							position, tokenIndex, depth = position1056, tokenIndex1056, depth1056
+							if buffer[position] != rune('I') {
+								goto l1048
 							}
 							position++
 						}
-					l958:
+					l1056:
 						{
-							position960, tokenIndex960, depth960 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l961
+							position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1059
 							}
 							position++
-							goto l960
-						l961:
-							position, tokenIndex, depth = position960, tokenIndex960, depth960
-							if buffer[position] != rune('L') {
-								goto l950
+							goto l1058
+						l1059:
+							position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
+							if buffer[position] != rune('T') {
+								goto l1048
 							}
 							position++
 						}
-					l960:
-						{
-							position962, tokenIndex962, depth962 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l963
-							}
-							position++
-							goto l962
-						l963:
-							position, tokenIndex, depth = position962, tokenIndex962, depth962
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
+					l1058:
+						if !_rules[rulesp]() {
+							goto l1048
 						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex, depth = position950, tokenIndex950, depth950
+						if !_rules[ruleNumericLiteral]() {
+							goto l1048
+						}
+						goto l1049
+					l1048:
+						position, tokenIndex, depth = position1048, tokenIndex1048, depth1048
 					}
-				l951:
+				l1049:
 					depth--
-					add(rulePegText, position949)
-				}
-				if !_rules[ruleAction44]() {
-					goto l947
+					add(rulePegText, position1047)
 				}
-				depth--
-				add(ruleSheddingSpecOpt, position948)
-			}
-			return true
-		l947:
-			position, tokenIndex, depth = position947, tokenIndex947, depth947
-			return false
-		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
-		func() bool {
-			position964, tokenIndex964, depth964 := position, tokenIndex, depth
-			{
-				position965 := position
-				depth++
-				{
-					position966, tokenIndex966, depth966 := position, tokenIndex, depth
-					if !_rules[ruleWait]() {
-						goto l967
-					}
-					goto l966
-				l967:
-					position, tokenIndex, depth = position966, tokenIndex966, depth966
-					if !_rules[ruleDropOldest]() {
-						goto l968
-					}
-					goto l966
-				l968:
-					position, tokenIndex, depth = position966, tokenIndex966, depth966
-					if !_rules[ruleDropNewest]() {
-						goto l964
-					}
+				if !_rules[ruleAction47]() {
+					goto l1045
 				}
-			l966:
 				depth--
-				add(ruleSheddingOption, position965)
+				add(ruleLimit, position1046)
 			}
 			return true
-		l964:
-			position, tokenIndex, depth = position964, tokenIndex964, depth964
+		l1045:
+			position, tokenIndex, depth = position1045, tokenIndex1045, depth1045
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 62 Offset <- <(<(sp (('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T')) sp NumericLiteral)?> Action48)> */
 		func() bool {
-			position969, tokenIndex969, depth969 := position, tokenIndex, depth
+			position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
 			{
-				position970 := position
+				position1061 := position
 				depth++
 				{
-					position971 := position
+					position1062 := position
 					depth++
 					{
-						position972, tokenIndex972, depth972 := position, tokenIndex, depth
+						position1063, tokenIndex1063, depth1063 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l972
+							goto l1063
 						}
 						{
-							position974, tokenIndex974, depth974 := position, tokenIndex, depth
-							if buffer[position] != rune('w') {
-								goto l975
+							position1065, tokenIndex1065, depth1065 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1066
 							}
 							position++
-							goto l974
-						l975:
-							position, tokenIndex, depth = position974, tokenIndex974, depth974
-							if buffer[position] != rune('W') {
-								goto l972
+							goto l1065
+						l1066:
+							position, tokenIndex, depth = position1065, tokenIndex1065, depth1065
+							if buffer[position] != rune('O') {
+								goto l1063
 							}
 							position++
 						}
-					l974:
+					l1065:
 						{
-							position976, tokenIndex976, depth976 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l977
+							position1067, tokenIndex1067, depth1067 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1068
 							}
 							position++
-							goto l976
-						l977:
-							position, tokenIndex, depth = position976, tokenIndex976, depth976
-							if buffer[position] != rune('I') {
-								goto l972
+							goto l1067
+						l1068:
+							position, tokenIndex, depth = position1067, tokenIndex1067, depth1067
+							if buffer[position] != rune('F') {
+								goto l1063
 							}
 							position++
 						}
-					l976:
+					l1067:
 						{
-							position978, tokenIndex978, depth978 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l979
+							position1069, tokenIndex1069, depth1069 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1070
 							}
 							position++
-							goto l978
-						l979:
-							position, tokenIndex, depth = position978, tokenIndex978, depth978
-							if buffer[position] != rune('T') {
-								goto l972
+							goto l1069
+						l1070:
+							position, tokenIndex, depth = position1069, tokenIndex1069, depth1069
+							if buffer[position] != rune('F') {
+								goto l1063
 							}
 							position++
 						}
-					l978:
+					l1069:
 						{
-							position980, tokenIndex980, depth980 := position, tokenIndex, depth
-							if buffer[position] != rune('h') {
-								goto l981
+							position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1072
 							}
 							position++
-							goto l980
-						l981:
-							position, tokenIndex, depth = position980, tokenIndex980, depth980
-							if buffer[position] != rune('H') {
-								goto l972
+							goto l1071
+						l1072:
+							position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
+							if buffer[position] != rune('S') {
+								goto l1063
 							}
 							position++
 						}
-					l980:
-						if !_rules[rulesp]() {
-							goto l972
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l972
-						}
-					l982:
+					l1071:
 						{
-							position983, tokenIndex983, depth983 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l983
+							position1073, tokenIndex1073, depth1073 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1074
 							}
-							if buffer[position] != rune(',') {
-								goto l983
+							position++
+							goto l1073
+						l1074:
+							position, tokenIndex, depth = position1073, tokenIndex1073, depth1073
+							if buffer[position] != rune('E') {
+								goto l1063
 							}
 							position++
-							if !_rules[rulespOpt]() {
-								goto l983
+						}
+					l1073:
+						{
+							position1075, tokenIndex1075, depth1075 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1076
 							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l983
+							position++
+							goto l1075
+						l1076:
+							position, tokenIndex, depth = position1075, tokenIndex1075, depth1075
+							if buffer[position] != rune('T') {
+								goto l1063
 							}
-							goto l982
-						l983:
-							position, tokenIndex, depth = position983, tokenIndex983, depth983
+							position++
 						}
-						goto l973
-					l972:
-						position, tokenIndex, depth = position972, tokenIndex972, depth972
+					l1075:
+						if !_rules[rulesp]() {
+							goto l1063
+						}
+						if !_rules[ruleNumericLiteral]() {
+							goto l1063
+						}
+						goto l1064
+					l1063:
+						position, tokenIndex, depth = position1063, tokenIndex1063, depth1063
 					}
-				l973:
+				l1064:
 					depth--
-					add(rulePegText, position971)
+					add(rulePegText, position1062)
 				}
-				if !_rules[ruleAction45]() {
-					goto l969
+				if !_rules[ruleAction48]() {
+					goto l1060
 				}
 				depth--
-				add(ruleSourceSinkSpecs, position970)
+				add(ruleOffset, position1061)
 			}
 			return true
-		l969:
-			position, tokenIndex, depth = position969, tokenIndex969, depth969
+		l1060:
+			position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
 			return false
 		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
+		/* 63 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action49))> */
 		func() bool {
-			position984, tokenIndex984, depth984 := position, tokenIndex, depth
+			position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
 			{
-				position985 := position
+				position1078 := position
 				depth++
 				{
-					position986 := position
-					depth++
-					if !_rules[rulesp]() {
-						goto l984
-					}
-					{
-						position987, tokenIndex987, depth987 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l988
-						}
-						position++
-						goto l987
-					l988:
-						position, tokenIndex, depth = position987, tokenIndex987, depth987
-						if buffer[position] != rune('S') {
-							goto l984
-						}
-						position++
-					}
-				l987:
-					{
-						position989, tokenIndex989, depth989 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l990
-						}
-						position++
-						goto l989
-					l990:
-						position, tokenIndex, depth = position989, tokenIndex989, depth989
-						if buffer[position] != rune('E') {
-							goto l984
-						}
-						position++
-					}
-				l989:
-					{
-						position991, tokenIndex991, depth991 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l992
-						}
-						position++
-						goto l991
-					l992:
-						position, tokenIndex, depth = position991, tokenIndex991, depth991
-						if buffer[position] != rune('T') {
-							goto l984
-						}
-						position++
-					}
-				l991:
-					if !_rules[rulesp]() {
-						goto l984
+					position1079, tokenIndex1079, depth1079 := position, tokenIndex, depth
+					if !_rules[ruleAliasedStreamWindow]() {
+						goto l1080
 					}
-					if !_rules[ruleSourceSinkParam]() {
-						goto l984
+					goto l1079
+				l1080:
+					position, tokenIndex, depth = position1079, tokenIndex1079, depth1079
+					if !_rules[ruleStreamWindow]() {
+						goto l1077
 					}
-				l993:
-					{
-						position994, tokenIndex994, depth994 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if buffer[position] != rune(',') {
-							goto l994
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l994
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l994
-						}
-						goto l993
-					l994:
-						position, tokenIndex, depth = position994, tokenIndex994, depth994
+					if !_rules[ruleAction49]() {
+						goto l1077
 					}
-					depth--
-					add(rulePegText, position986)
-				}
-				if !_rules[ruleAction46]() {
-					goto l984
 				}
+			l1079:
 				depth--
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleRelationLike, position1078)
 			}
 			return true
-		l984:
-			position, tokenIndex, depth = position984, tokenIndex984, depth984
+		l1077:
+			position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 64 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action50)> */
 		func() bool {
-			position995, tokenIndex995, depth995 := position, tokenIndex, depth
+			position1081, tokenIndex1081, depth1081 := position, tokenIndex, depth
 			{
-				position996 := position
+				position1082 := position
 				depth++
+				if !_rules[ruleStreamWindow]() {
+					goto l1081
+				}
+				if !_rules[rulesp]() {
+					goto l1081
+				}
 				{
-					position997 := position
-					depth++
-					{
-						position998, tokenIndex998, depth998 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						{
-							position1000, tokenIndex1000, depth1000 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l1001
-							}
-							position++
-							goto l1000
-						l1001:
-							position, tokenIndex, depth = position1000, tokenIndex1000, depth1000
-							if buffer[position] != rune('S') {
-								goto l998
-							}
-							position++
-						}
-					l1000:
-						{
-							position1002, tokenIndex1002, depth1002 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1003
-							}
-							position++
-							goto l1002
-						l1003:
-							position, tokenIndex, depth = position1002, tokenIndex1002, depth1002
-							if buffer[position] != rune('E') {
-								goto l998
-							}
-							position++
-						}
-					l1002:
-						{
-							position1004, tokenIndex1004, depth1004 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l1005
-							}
-							position++
-							goto l1004
-						l1005:
-							position, tokenIndex, depth = position1004, tokenIndex1004, depth1004
-							if buffer[position] != rune('T') {
-								goto l998
-							}
-							position++
-						}
-					l1004:
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l998
-						}
-					l1006:
-						{
-							position1007, tokenIndex1007, depth1007 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if buffer[position] != rune(',') {
-								goto l1007
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
-							}
-							goto l1006
-						l1007:
-							position, tokenIndex, depth = position1007, tokenIndex1007, depth1007
-						}
-						goto l999
-					l998:
-						position, tokenIndex, depth = position998, tokenIndex998, depth998
+					position1083, tokenIndex1083, depth1083 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1084
 					}
-				l999:
-					depth--
-					add(rulePegText, position997)
+					position++
+					goto l1083
+				l1084:
+					position, tokenIndex, depth = position1083, tokenIndex1083, depth1083
+					if buffer[position] != rune('A') {
+						goto l1081
+					}
+					position++
 				}
-				if !_rules[ruleAction47]() {
-					goto l995
+			l1083:
+				{
+					position1085, tokenIndex1085, depth1085 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1086
+					}
+					position++
+					goto l1085
+				l1086:
+					position, tokenIndex, depth = position1085, tokenIndex1085, depth1085
+					if buffer[position] != rune('S') {
+						goto l1081
+					}
+					position++
+				}
+			l1085:
+				if !_rules[rulesp]() {
+					goto l1081
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l1081
+				}
+				if !_rules[ruleAction50]() {
+					goto l1081
 				}
 				depth--
-				add(ruleSetOptSpecs, position996)
+				add(ruleAliasedStreamWindow, position1082)
 			}
 			return true
-		l995:
-			position, tokenIndex, depth = position995, tokenIndex995, depth995
+		l1081:
+			position, tokenIndex, depth = position1081, tokenIndex1081, depth1081
 			return false
 		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
+		/* 65 StreamWindow <- <(StreamLike spOpt '[' spOpt WindowType sp Interval SlideSpecOpt LatenessSpecOpt CapacitySpecOpt SheddingSpecOpt spOpt ']' Action51)> */
 		func() bool {
-			position1008, tokenIndex1008, depth1008 := position, tokenIndex, depth
+			position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
 			{
-				position1009 := position
+				position1088 := position
 				depth++
-				{
-					position1010 := position
-					depth++
-					{
-						position1011, tokenIndex1011, depth1011 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						{
-							position1013, tokenIndex1013, depth1013 := position, tokenIndex, depth
-							if buffer[position] != rune('t') {
-								goto l1014
-							}
-							position++
-							goto l1013
-						l1014:
-							position, tokenIndex, depth = position1013, tokenIndex1013, depth1013
-							if buffer[position] != rune('T') {
-								goto l1011
-							}
-							position++
-						}
-					l1013:
-						{
-							position1015, tokenIndex1015, depth1015 := position, tokenIndex, depth
-							if buffer[position] != rune('a') {
-								goto l1016
-							}
-							position++
-							goto l1015
-						l1016:
-							position, tokenIndex, depth = position1015, tokenIndex1015, depth1015
-							if buffer[position] != rune('A') {
-								goto l1011
-							}
-							position++
-						}
-					l1015:
-						{
-							position1017, tokenIndex1017, depth1017 := position, tokenIndex, depth
-							if buffer[position] != rune('g') {
-								goto l1018
-							}
-							position++
-							goto l1017
-						l1018:
-							position, tokenIndex, depth = position1017, tokenIndex1017, depth1017
-							if buffer[position] != rune('G') {
-								goto l1011
-							}
-							position++
-						}
-					l1017:
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						if !_rules[ruleIdentifier]() {
-							goto l1011
-						}
-						goto l1012
-					l1011:
-						position, tokenIndex, depth = position1011, tokenIndex1011, depth1011
-					}
-				l1012:
-					depth--
-					add(rulePegText, position1010)
+				if !_rules[ruleStreamLike]() {
+					goto l1087
 				}
-				if !_rules[ruleAction48]() {
-					goto l1008
+				if !_rules[rulespOpt]() {
+					goto l1087
 				}
-				depth--
-				add(ruleStateTagOpt, position1009)
-			}
-			return true
-		l1008:
-			position, tokenIndex, depth = position1008, tokenIndex1008, depth1008
-			return false
-		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
-		func() bool {
-			position1019, tokenIndex1019, depth1019 := position, tokenIndex, depth
-			{
-				position1020 := position
-				depth++
-				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
+				if buffer[position] != rune('[') {
+					goto l1087
 				}
+				position++
 				if !_rules[rulespOpt]() {
-					goto l1019
+					goto l1087
 				}
-				if buffer[position] != rune('=') {
-					goto l1019
+				if !_rules[ruleWindowType]() {
+					goto l1087
+				}
+				if !_rules[rulesp]() {
+					goto l1087
+				}
+				if !_rules[ruleInterval]() {
+					goto l1087
+				}
+				if !_rules[ruleSlideSpecOpt]() {
+					goto l1087
+				}
+				if !_rules[ruleLatenessSpecOpt]() {
+					goto l1087
+				}
+				if !_rules[ruleCapacitySpecOpt]() {
+					goto l1087
+				}
+				if !_rules[ruleSheddingSpecOpt]() {
+					goto l1087
 				}
-				position++
 				if !_rules[rulespOpt]() {
-					goto l1019
+					goto l1087
 				}
-				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+				if buffer[position] != rune(']') {
+					goto l1087
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				position++
+				if !_rules[ruleAction51]() {
+					goto l1087
 				}
 				depth--
-				add(ruleSourceSinkParam, position1020)
+				add(ruleStreamWindow, position1088)
 			}
 			return true
-		l1019:
-			position, tokenIndex, depth = position1019, tokenIndex1019, depth1019
+		l1087:
+			position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
 			return false
 		},
-		/* 65 SourceSinkParamVal <- <(ParamLiteral / ParamArrayExpr / ParamMapExpr)> */
+		/* 66 WindowType <- <(RANGE / TUMBLING / HOPPING / SESSION)> */
 		func() bool {
-			position1021, tokenIndex1021, depth1021 := position, tokenIndex, depth
+			position1089, tokenIndex1089, depth1089 := position, tokenIndex, depth
 			{
-				position1022 := position
+				position1090 := position
 				depth++
 				{
-					position1023, tokenIndex1023, depth1023 := position, tokenIndex, depth
-					if !_rules[ruleParamLiteral]() {
-						goto l1024
+					position1091, tokenIndex1091, depth1091 := position, tokenIndex, depth
+					if !_rules[ruleRANGE]() {
+						goto l1092
 					}
-					goto l1023
-				l1024:
-					position, tokenIndex, depth = position1023, tokenIndex1023, depth1023
-					if !_rules[ruleParamArrayExpr]() {
-						goto l1025
+					goto l1091
+				l1092:
+					position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
+					if !_rules[ruleTUMBLING]() {
+						goto l1093
 					}
-					goto l1023
-				l1025:
-					position, tokenIndex, depth = position1023, tokenIndex1023, depth1023
-					if !_rules[ruleParamMapExpr]() {
-						goto l1021
+					goto l1091
+				l1093:
+					position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
+					if !_rules[ruleHOPPING]() {
+						goto l1094
+					}
+					goto l1091
+				l1094:
+					position, tokenIndex, depth = position1091, tokenIndex1091, depth1091
+					if !_rules[ruleSESSION]() {
+						goto l1089
 					}
 				}
-			l1023:
+			l1091:
 				depth--
-				add(ruleSourceSinkParamVal, position1022)
+				add(ruleWindowType, position1090)
 			}
 			return true
-		l1021:
-			position, tokenIndex, depth = position1021, tokenIndex1021, depth1021
+		l1089:
+			position, tokenIndex, depth = position1089, tokenIndex1089, depth1089
 			return false
 		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal)> */
+		/* 67 StreamLike <- <(Subquery / UDSFFuncApp / Stream)> */
 		func() bool {
-			position1026, tokenIndex1026, depth1026 := position, tokenIndex, depth
+			position1095, tokenIndex1095, depth1095 := position, tokenIndex, depth
 			{
-				position1027 := position
+				position1096 := position
 				depth++
 				{
-					position1028, tokenIndex1028, depth1028 := position, tokenIndex, depth
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1029
+					position1097, tokenIndex1097, depth1097 := position, tokenIndex, depth
+					if !_rules[ruleSubquery]() {
+						goto l1098
 					}
-					goto l1028
-				l1029:
-					position, tokenIndex, depth = position1028, tokenIndex1028, depth1028
-					if !_rules[ruleLiteral]() {
-						goto l1026
+					goto l1097
+				l1098:
+					position, tokenIndex, depth = position1097, tokenIndex1097, depth1097
+					if !_rules[ruleUDSFFuncApp]() {
+						goto l1099
+					}
+					goto l1097
+				l1099:
+					position, tokenIndex, depth = position1097, tokenIndex1097, depth1097
+					if !_rules[ruleStream]() {
+						goto l1095
 					}
 				}
-			l1028:
+			l1097:
 				depth--
-				add(ruleParamLiteral, position1027)
+				add(ruleStreamLike, position1096)
 			}
 			return true
-		l1026:
-			position, tokenIndex, depth = position1026, tokenIndex1026, depth1026
+		l1095:
+			position, tokenIndex, depth = position1095, tokenIndex1095, depth1095
 			return false
 		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
+		/* 68 Subquery <- <(<('(' spOpt SelectStmt spOpt ')')> Action52)> */
 		func() bool {
-			position1030, tokenIndex1030, depth1030 := position, tokenIndex, depth
+			position1100, tokenIndex1100, depth1100 := position, tokenIndex, depth
 			{
-				position1031 := position
+				position1101 := position
 				depth++
 				{
-					position1032 := position
+					position1102 := position
 					depth++
-					if buffer[position] != rune('[') {
-						goto l1030
+					if buffer[position] != rune('(') {
+						goto l1100
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1030
-					}
-					{
-						position1033, tokenIndex1033, depth1033 := position, tokenIndex, depth
-						if !_rules[ruleParamLiteral]() {
-							goto l1033
-						}
-					l1035:
-						{
-							position1036, tokenIndex1036, depth1036 := position, tokenIndex, depth
-							if buffer[position] != rune(',') {
-								goto l1036
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1036
-							}
-							if !_rules[ruleParamLiteral]() {
-								goto l1036
-							}
-							goto l1035
-						l1036:
-							position, tokenIndex, depth = position1036, tokenIndex1036, depth1036
-						}
-						goto l1034
-					l1033:
-						position, tokenIndex, depth = position1033, tokenIndex1033, depth1033
-					}
-				l1034:
-					if !_rules[rulespOpt]() {
-						goto l1030
+						goto l1100
 					}
-					{
-						position1037, tokenIndex1037, depth1037 := position, tokenIndex, depth
-						if buffer[position] != rune(',') {
-							goto l1037
-						}
-						position++
-						goto l1038
-					l1037:
-						position, tokenIndex, depth = position1037, tokenIndex1037, depth1037
+					if !_rules[ruleSelectStmt]() {
+						goto l1100
 					}
-				l1038:
 					if !_rules[rulespOpt]() {
-						goto l1030
+						goto l1100
 					}
-					if buffer[position] != rune(']') {
-						goto l1030
+					if buffer[position] != rune(')') {
+						goto l1100
 					}
 					position++
 					depth--
-					add(rulePegText, position1032)
+					add(rulePegText, position1102)
 				}
-				if !_rules[ruleAction50]() {
-					goto l1030
+				if !_rules[ruleAction52]() {
+					goto l1100
 				}
 				depth--
-				add(ruleParamArrayExpr, position1031)
+				add(ruleSubquery, position1101)
 			}
 			return true
-		l1030:
-			position, tokenIndex, depth = position1030, tokenIndex1030, depth1030
+		l1100:
+			position, tokenIndex, depth = position1100, tokenIndex1100, depth1100
 			return false
 		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
+		/* 69 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action53)> */
 		func() bool {
-			position1039, tokenIndex1039, depth1039 := position, tokenIndex, depth
+			position1103, tokenIndex1103, depth1103 := position, tokenIndex, depth
 			{
-				position1040 := position
+				position1104 := position
 				depth++
-				{
-					position1041 := position
-					depth++
-					if buffer[position] != rune('{') {
-						goto l1039
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1039
-					}
-					{
-						position1042, tokenIndex1042, depth1042 := position, tokenIndex, depth
-						if !_rules[ruleParamKeyValuePair]() {
-							goto l1042
-						}
-					l1044:
-						{
-							position1045, tokenIndex1045, depth1045 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1045
-							}
-							if buffer[position] != rune(',') {
-								goto l1045
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1045
-							}
-							if !_rules[ruleParamKeyValuePair]() {
-								goto l1045
-							}
-							goto l1044
-						l1045:
-							position, tokenIndex, depth = position1045, tokenIndex1045, depth1045
-						}
-						goto l1043
-					l1042:
-						position, tokenIndex, depth = position1042, tokenIndex1042, depth1042
-					}
-				l1043:
-					if !_rules[rulespOpt]() {
-						goto l1039
-					}
-					if buffer[position] != rune('}') {
-						goto l1039
-					}
-					position++
-					depth--
-					add(rulePegText, position1041)
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1103
 				}
-				if !_rules[ruleAction51]() {
-					goto l1039
+				if !_rules[ruleAction53]() {
+					goto l1103
 				}
 				depth--
-				add(ruleParamMapExpr, position1040)
+				add(ruleUDSFFuncApp, position1104)
 			}
 			return true
-		l1039:
-			position, tokenIndex, depth = position1039, tokenIndex1039, depth1039
+		l1103:
+			position, tokenIndex, depth = position1103, tokenIndex1103, depth1103
 			return false
 		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
+		/* 70 SlideSpecOpt <- <(<(spOpt ',' spOpt (('s' / 'S') ('l' / 'L') ('i' / 'I') ('d' / 'D') ('e' / 'E')) sp Interval)?> Action54)> */
 		func() bool {
-			position1046, tokenIndex1046, depth1046 := position, tokenIndex, depth
+			position1105, tokenIndex1105, depth1105 := position, tokenIndex, depth
 			{
-				position1047 := position
+				position1106 := position
 				depth++
 				{
-					position1048 := position
+					position1107 := position
 					depth++
-					if !_rules[ruleStringLiteral]() {
-						goto l1046
-					}
-					if !_rules[rulespOpt]() {
-						goto l1046
-					}
-					if buffer[position] != rune(':') {
-						goto l1046
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1046
-					}
-					if !_rules[ruleParamLiteral]() {
-						goto l1046
+					{
+						position1108, tokenIndex1108, depth1108 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1108
+						}
+						if buffer[position] != rune(',') {
+							goto l1108
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1108
+						}
+						{
+							position1110, tokenIndex1110, depth1110 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1111
+							}
+							position++
+							goto l1110
+						l1111:
+							position, tokenIndex, depth = position1110, tokenIndex1110, depth1110
+							if buffer[position] != rune('S') {
+								goto l1108
+							}
+							position++
+						}
+					l1110:
+						{
+							position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1113
+							}
+							position++
+							goto l1112
+						l1113:
+							position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
+							if buffer[position] != rune('L') {
+								goto l1108
+							}
+							position++
+						}
+					l1112:
+						{
+							position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1115
+							}
+							position++
+							goto l1114
+						l1115:
+							position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
+							if buffer[position] != rune('I') {
+								goto l1108
+							}
+							position++
+						}
+					l1114:
+						{
+							position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l1117
+							}
+							position++
+							goto l1116
+						l1117:
+							position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
+							if buffer[position] != rune('D') {
+								goto l1108
+							}
+							position++
+						}
+					l1116:
+						{
+							position1118, tokenIndex1118, depth1118 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1119
+							}
+							position++
+							goto l1118
+						l1119:
+							position, tokenIndex, depth = position1118, tokenIndex1118, depth1118
+							if buffer[position] != rune('E') {
+								goto l1108
+							}
+							position++
+						}
+					l1118:
+						if !_rules[rulesp]() {
+							goto l1108
+						}
+						if !_rules[ruleInterval]() {
+							goto l1108
+						}
+						goto l1109
+					l1108:
+						position, tokenIndex, depth = position1108, tokenIndex1108, depth1108
 					}
+				l1109:
 					depth--
-					add(rulePegText, position1048)
+					add(rulePegText, position1107)
 				}
-				if !_rules[ruleAction52]() {
-					goto l1046
+				if !_rules[ruleAction54]() {
+					goto l1105
 				}
 				depth--
-				add(ruleParamKeyValuePair, position1047)
+				add(ruleSlideSpecOpt, position1106)
 			}
 			return true
-		l1046:
-			position, tokenIndex, depth = position1046, tokenIndex1046, depth1046
+		l1105:
+			position, tokenIndex, depth = position1105, tokenIndex1105, depth1105
 			return false
 		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
+		/* 71 LatenessSpecOpt <- <(<(spOpt ',' spOpt LatenessSpec)?> Action55)> */
 		func() bool {
-			position1049, tokenIndex1049, depth1049 := position, tokenIndex, depth
+			position1120, tokenIndex1120, depth1120 := position, tokenIndex, depth
 			{
-				position1050 := position
+				position1121 := position
 				depth++
 				{
-					position1051 := position
+					position1122 := position
 					depth++
 					{
-						position1052, tokenIndex1052, depth1052 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1052
+						position1123, tokenIndex1123, depth1123 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1123
 						}
-						{
-							position1054, tokenIndex1054, depth1054 := position, tokenIndex, depth
-							if !_rules[rulePaused]() {
-								goto l1055
-							}
-							goto l1054
-						l1055:
-							position, tokenIndex, depth = position1054, tokenIndex1054, depth1054
-							if !_rules[ruleUnpaused]() {
-								goto l1052
-							}
+						if buffer[position] != rune(',') {
+							goto l1123
 						}
-					l1054:
-						goto l1053
-					l1052:
-						position, tokenIndex, depth = position1052, tokenIndex1052, depth1052
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1123
+						}
+						if !_rules[ruleLatenessSpec]() {
+							goto l1123
+						}
+						goto l1124
+					l1123:
+						position, tokenIndex, depth = position1123, tokenIndex1123, depth1123
 					}
-				l1053:
+				l1124:
 					depth--
-					add(rulePegText, position1051)
+					add(rulePegText, position1122)
 				}
-				if !_rules[ruleAction53]() {
-					goto l1049
+				if !_rules[ruleAction55]() {
+					goto l1120
 				}
 				depth--
-				add(rulePausedOpt, position1050)
+				add(ruleLatenessSpecOpt, position1121)
 			}
 			return true
-		l1049:
-			position, tokenIndex, depth = position1049, tokenIndex1049, depth1049
+		l1120:
+			position, tokenIndex, depth = position1120, tokenIndex1120, depth1120
 			return false
 		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
+		/* 72 LatenessSpec <- <(('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('n' / 'N') ('e' / 'E') ('s' / 'S') ('s' / 'S') sp Interval LateTuplePolicyOpt Action56)> */
 		func() bool {
-			position1056, tokenIndex1056, depth1056 := position, tokenIndex, depth
+			position1125, tokenIndex1125, depth1125 := position, tokenIndex, depth
 			{
-				position1057 := position
+				position1126 := position
 				depth++
 				{
-					position1058, tokenIndex1058, depth1058 := position, tokenIndex, depth
-					if !_rules[ruleWildcard]() {
-						goto l1059
+					position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l1128
 					}
-					goto l1058
-				l1059:
-					position, tokenIndex, depth = position1058, tokenIndex1058, depth1058
-					if !_rules[ruleExpression]() {
-						goto l1056
+					position++
+					goto l1127
+				l1128:
+					position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
+					if buffer[position] != rune('L') {
+						goto l1125
 					}
+					position++
 				}
-			l1058:
-				depth--
-				add(ruleExpressionOrWildcard, position1057)
-			}
-			return true
-		l1056:
-			position, tokenIndex, depth = position1056, tokenIndex1056, depth1056
-			return false
-		},
-		/* 72 Expression <- <orExpr> */
-		func() bool {
-			position1060, tokenIndex1060, depth1060 := position, tokenIndex, depth
-			{
-				position1061 := position
-				depth++
-				if !_rules[ruleorExpr]() {
-					goto l1060
-				}
-				depth--
-				add(ruleExpression, position1061)
-			}
-			return true
-		l1060:
-			position, tokenIndex, depth = position1060, tokenIndex1060, depth1060
-			return false
-		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
-		func() bool {
-			position1062, tokenIndex1062, depth1062 := position, tokenIndex, depth
-			{
-				position1063 := position
-				depth++
+			l1127:
 				{
-					position1064 := position
-					depth++
-					if !_rules[ruleandExpr]() {
-						goto l1062
+					position1129, tokenIndex1129, depth1129 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1130
 					}
-				l1065:
-					{
-						position1066, tokenIndex1066, depth1066 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1066
-						}
-						if !_rules[ruleOr]() {
-							goto l1066
-						}
-						if !_rules[rulesp]() {
-							goto l1066
-						}
-						if !_rules[ruleandExpr]() {
-							goto l1066
-						}
-						goto l1065
-					l1066:
-						position, tokenIndex, depth = position1066, tokenIndex1066, depth1066
+					position++
+					goto l1129
+				l1130:
+					position, tokenIndex, depth = position1129, tokenIndex1129, depth1129
+					if buffer[position] != rune('A') {
+						goto l1125
 					}
-					depth--
-					add(rulePegText, position1064)
-				}
-				if !_rules[ruleAction54]() {
-					goto l1062
+					position++
 				}
-				depth--
-				add(ruleorExpr, position1063)
-			}
-			return true
-		l1062:
-			position, tokenIndex, depth = position1062, tokenIndex1062, depth1062
-			return false
-		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
-		func() bool {
-			position1067, tokenIndex1067, depth1067 := position, tokenIndex, depth
-			{
-				position1068 := position
-				depth++
+			l1129:
 				{
-					position1069 := position
-					depth++
-					if !_rules[rulenotExpr]() {
-						goto l1067
+					position1131, tokenIndex1131, depth1131 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1132
 					}
-				l1070:
-					{
-						position1071, tokenIndex1071, depth1071 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1071
-						}
-						if !_rules[ruleAnd]() {
-							goto l1071
-						}
-						if !_rules[rulesp]() {
-							goto l1071
-						}
-						if !_rules[rulenotExpr]() {
-							goto l1071
-						}
-						goto l1070
-					l1071:
-						position, tokenIndex, depth = position1071, tokenIndex1071, depth1071
+					position++
+					goto l1131
+				l1132:
+					position, tokenIndex, depth = position1131, tokenIndex1131, depth1131
+					if buffer[position] != rune('T') {
+						goto l1125
 					}
-					depth--
-					add(rulePegText, position1069)
-				}
-				if !_rules[ruleAction55]() {
-					goto l1067
+					position++
 				}
-				depth--
-				add(ruleandExpr, position1068)
-			}
-			return true
-		l1067:
-			position, tokenIndex, depth = position1067, tokenIndex1067, depth1067
-			return false
-		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
-		func() bool {
-			position1072, tokenIndex1072, depth1072 := position, tokenIndex, depth
-			{
-				position1073 := position
-				depth++
+			l1131:
 				{
-					position1074 := position
-					depth++
-					{
-						position1075, tokenIndex1075, depth1075 := position, tokenIndex, depth
-						if !_rules[ruleNot]() {
-							goto l1075
-						}
-						if !_rules[rulesp]() {
-							goto l1075
-						}
-						goto l1076
-					l1075:
-						position, tokenIndex, depth = position1075, tokenIndex1075, depth1075
+					position1133, tokenIndex1133, depth1133 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1134
 					}
-				l1076:
-					if !_rules[rulecomparisonExpr]() {
-						goto l1072
+					position++
+					goto l1133
+				l1134:
+					position, tokenIndex, depth = position1133, tokenIndex1133, depth1133
+					if buffer[position] != rune('E') {
+						goto l1125
 					}
-					depth--
-					add(rulePegText, position1074)
+					position++
 				}
-				if !_rules[ruleAction56]() {
-					goto l1072
+			l1133:
+				{
+					position1135, tokenIndex1135, depth1135 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1136
+					}
+					position++
+					goto l1135
+				l1136:
+					position, tokenIndex, depth = position1135, tokenIndex1135, depth1135
+					if buffer[position] != rune('N') {
+						goto l1125
+					}
+					position++
 				}
-				depth--
-				add(rulenotExpr, position1073)
-			}
-			return true
-		l1072:
-			position, tokenIndex, depth = position1072, tokenIndex1072, depth1072
-			return false
-		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
-		func() bool {
-			position1077, tokenIndex1077, depth1077 := position, tokenIndex, depth
-			{
-				position1078 := position
-				depth++
+			l1135:
 				{
-					position1079 := position
-					depth++
-					if !_rules[ruleotherOpExpr]() {
-						goto l1077
+					position1137, tokenIndex1137, depth1137 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1138
 					}
-					{
-						position1080, tokenIndex1080, depth1080 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1080
-						}
-						if !_rules[ruleComparisonOp]() {
-							goto l1080
-						}
-						if !_rules[rulespOpt]() {
-							goto l1080
-						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1080
-						}
-						goto l1081
-					l1080:
-						position, tokenIndex, depth = position1080, tokenIndex1080, depth1080
+					position++
+					goto l1137
+				l1138:
+					position, tokenIndex, depth = position1137, tokenIndex1137, depth1137
+					if buffer[position] != rune('E') {
+						goto l1125
 					}
-				l1081:
-					depth--
-					add(rulePegText, position1079)
+					position++
 				}
-				if !_rules[ruleAction57]() {
-					goto l1077
+			l1137:
+				{
+					position1139, tokenIndex1139, depth1139 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1140
+					}
+					position++
+					goto l1139
+				l1140:
+					position, tokenIndex, depth = position1139, tokenIndex1139, depth1139
+					if buffer[position] != rune('S') {
+						goto l1125
+					}
+					position++
 				}
-				depth--
-				add(rulecomparisonExpr, position1078)
-			}
-			return true
-		l1077:
-			position, tokenIndex, depth = position1077, tokenIndex1077, depth1077
-			return false
-		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
-		func() bool {
-			position1082, tokenIndex1082, depth1082 := position, tokenIndex, depth
-			{
-				position1083 := position
-				depth++
+			l1139:
 				{
-					position1084 := position
-					depth++
-					if !_rules[ruleisExpr]() {
-						goto l1082
+					position1141, tokenIndex1141, depth1141 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1142
 					}
-				l1085:
-					{
-						position1086, tokenIndex1086, depth1086 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1086
-						}
-						if !_rules[ruleOtherOp]() {
-							goto l1086
-						}
-						if !_rules[rulespOpt]() {
-							goto l1086
-						}
-						if !_rules[ruleisExpr]() {
-							goto l1086
-						}
-						goto l1085
-					l1086:
-						position, tokenIndex, depth = position1086, tokenIndex1086, depth1086
+					position++
+					goto l1141
+				l1142:
+					position, tokenIndex, depth = position1141, tokenIndex1141, depth1141
+					if buffer[position] != rune('S') {
+						goto l1125
 					}
-					depth--
-					add(rulePegText, position1084)
+					position++
 				}
-				if !_rules[ruleAction58]() {
-					goto l1082
+			l1141:
+				if !_rules[rulesp]() {
+					goto l1125
+				}
+				if !_rules[ruleInterval]() {
+					goto l1125
+				}
+				if !_rules[ruleLateTuplePolicyOpt]() {
+					goto l1125
+				}
+				if !_rules[ruleAction56]() {
+					goto l1125
 				}
 				depth--
-				add(ruleotherOpExpr, position1083)
+				add(ruleLatenessSpec, position1126)
 			}
 			return true
-		l1082:
-			position, tokenIndex, depth = position1082, tokenIndex1082, depth1082
+		l1125:
+			position, tokenIndex, depth = position1125, tokenIndex1125, depth1125
 			return false
 		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
+		/* 73 LateTuplePolicyOpt <- <(<(sp LateTuplePolicy)?> Action57)> */
 		func() bool {
-			position1087, tokenIndex1087, depth1087 := position, tokenIndex, depth
+			position1143, tokenIndex1143, depth1143 := position, tokenIndex, depth
 			{
-				position1088 := position
+				position1144 := position
 				depth++
 				{
-					position1089 := position
+					position1145 := position
 					depth++
 					{
-						position1090, tokenIndex1090, depth1090 := position, tokenIndex, depth
-						if !_rules[ruleRowValue]() {
-							goto l1091
-						}
-						if !_rules[rulesp]() {
-							goto l1091
-						}
-						if !_rules[ruleIsOp]() {
-							goto l1091
-						}
+						position1146, tokenIndex1146, depth1146 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l1091
+							goto l1146
 						}
-						if !_rules[ruleMissing]() {
-							goto l1091
-						}
-						goto l1090
-					l1091:
-						position, tokenIndex, depth = position1090, tokenIndex1090, depth1090
-						if !_rules[ruletermExpr]() {
-							goto l1087
-						}
-						{
-							position1092, tokenIndex1092, depth1092 := position, tokenIndex, depth
-							if !_rules[rulesp]() {
-								goto l1092
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1092
-							}
-							if !_rules[rulesp]() {
-								goto l1092
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1092
-							}
-							goto l1093
-						l1092:
-							position, tokenIndex, depth = position1092, tokenIndex1092, depth1092
+						if !_rules[ruleLateTuplePolicy]() {
+							goto l1146
 						}
-					l1093:
+						goto l1147
+					l1146:
+						position, tokenIndex, depth = position1146, tokenIndex1146, depth1146
 					}
-				l1090:
+				l1147:
 					depth--
-					add(rulePegText, position1089)
+					add(rulePegText, position1145)
 				}
-				if !_rules[ruleAction59]() {
-					goto l1087
+				if !_rules[ruleAction57]() {
+					goto l1143
 				}
 				depth--
-				add(ruleisExpr, position1088)
+				add(ruleLateTuplePolicyOpt, position1144)
 			}
 			return true
-		l1087:
-			position, tokenIndex, depth = position1087, tokenIndex1087, depth1087
+		l1143:
+			position, tokenIndex, depth = position1143, tokenIndex1143, depth1143
 			return false
 		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
+		/* 74 LateTuplePolicy <- <(DropLate / CorrectLate / ReportLate)> */
 		func() bool {
-			position1094, tokenIndex1094, depth1094 := position, tokenIndex, depth
+			position1148, tokenIndex1148, depth1148 := position, tokenIndex, depth
 			{
-				position1095 := position
+				position1149 := position
 				depth++
 				{
-					position1096 := position
-					depth++
-					if !_rules[ruleproductExpr]() {
-						goto l1094
+					position1150, tokenIndex1150, depth1150 := position, tokenIndex, depth
+					if !_rules[ruleDropLate]() {
+						goto l1151
 					}
-				l1097:
-					{
-						position1098, tokenIndex1098, depth1098 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1098
-						}
-						if !_rules[rulePlusMinusOp]() {
-							goto l1098
-						}
-						if !_rules[rulespOpt]() {
-							goto l1098
-						}
-						if !_rules[ruleproductExpr]() {
-							goto l1098
-						}
-						goto l1097
-					l1098:
-						position, tokenIndex, depth = position1098, tokenIndex1098, depth1098
+					goto l1150
+				l1151:
+					position, tokenIndex, depth = position1150, tokenIndex1150, depth1150
+					if !_rules[ruleCorrectLate]() {
+						goto l1152
+					}
+					goto l1150
+				l1152:
+					position, tokenIndex, depth = position1150, tokenIndex1150, depth1150
+					if !_rules[ruleReportLate]() {
+						goto l1148
 					}
-					depth--
-					add(rulePegText, position1096)
-				}
-				if !_rules[ruleAction60]() {
-					goto l1094
 				}
+			l1150:
 				depth--
-				add(ruletermExpr, position1095)
+				add(ruleLateTuplePolicy, position1149)
 			}
 			return true
-		l1094:
-			position, tokenIndex, depth = position1094, tokenIndex1094, depth1094
+		l1148:
+			position, tokenIndex, depth = position1148, tokenIndex1148, depth1148
 			return false
 		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
+		/* 75 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action58)> */
 		func() bool {
-			position1099, tokenIndex1099, depth1099 := position, tokenIndex, depth
+			position1153, tokenIndex1153, depth1153 := position, tokenIndex, depth
 			{
-				position1100 := position
+				position1154 := position
 				depth++
 				{
-					position1101 := position
+					position1155 := position
 					depth++
-					if !_rules[ruleminusExpr]() {
-						goto l1099
-					}
-				l1102:
 					{
-						position1103, tokenIndex1103, depth1103 := position, tokenIndex, depth
+						position1156, tokenIndex1156, depth1156 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l1103
+							goto l1156
 						}
-						if !_rules[ruleMultDivOp]() {
-							goto l1103
+						if buffer[position] != rune(',') {
+							goto l1156
 						}
+						position++
 						if !_rules[rulespOpt]() {
-							goto l1103
+							goto l1156
 						}
-						if !_rules[ruleminusExpr]() {
-							goto l1103
+						{
+							position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l1159
+							}
+							position++
+							goto l1158
+						l1159:
+							position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
+							if buffer[position] != rune('B') {
+								goto l1156
+							}
+							position++
 						}
-						goto l1102
-					l1103:
-						position, tokenIndex, depth = position1103, tokenIndex1103, depth1103
-					}
-					depth--
-					add(rulePegText, position1101)
-				}
-				if !_rules[ruleAction61]() {
-					goto l1099
-				}
-				depth--
-				add(ruleproductExpr, position1100)
-			}
-			return true
-		l1099:
-			position, tokenIndex, depth = position1099, tokenIndex1099, depth1099
-			return false
-		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
-		func() bool {
-			position1104, tokenIndex1104, depth1104 := position, tokenIndex, depth
-			{
-				position1105 := position
-				depth++
-				{
-					position1106 := position
-					depth++
-					{
-						position1107, tokenIndex1107, depth1107 := position, tokenIndex, depth
-						if !_rules[ruleUnaryMinus]() {
-							goto l1107
+					l1158:
+						{
+							position1160, tokenIndex1160, depth1160 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1161
+							}
+							position++
+							goto l1160
+						l1161:
+							position, tokenIndex, depth = position1160, tokenIndex1160, depth1160
+							if buffer[position] != rune('U') {
+								goto l1156
+							}
+							position++
 						}
-						if !_rules[rulespOpt]() {
-							goto l1107
+					l1160:
+						{
+							position1162, tokenIndex1162, depth1162 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1163
+							}
+							position++
+							goto l1162
+						l1163:
+							position, tokenIndex, depth = position1162, tokenIndex1162, depth1162
+							if buffer[position] != rune('F') {
+								goto l1156
+							}
+							position++
 						}
-						goto l1108
-					l1107:
-						position, tokenIndex, depth = position1107, tokenIndex1107, depth1107
-					}
-				l1108:
-					if !_rules[rulecastExpr]() {
-						goto l1104
+					l1162:
+						{
+							position1164, tokenIndex1164, depth1164 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1165
+							}
+							position++
+							goto l1164
+						l1165:
+							position, tokenIndex, depth = position1164, tokenIndex1164, depth1164
+							if buffer[position] != rune('F') {
+								goto l1156
+							}
+							position++
+						}
+					l1164:
+						{
+							position1166, tokenIndex1166, depth1166 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1167
+							}
+							position++
+							goto l1166
+						l1167:
+							position, tokenIndex, depth = position1166, tokenIndex1166, depth1166
+							if buffer[position] != rune('E') {
+								goto l1156
+							}
+							position++
+						}
+					l1166:
+						{
+							position1168, tokenIndex1168, depth1168 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1169
+							}
+							position++
+							goto l1168
+						l1169:
+							position, tokenIndex, depth = position1168, tokenIndex1168, depth1168
+							if buffer[position] != rune('R') {
+								goto l1156
+							}
+							position++
+						}
+					l1168:
+						if !_rules[rulesp]() {
+							goto l1156
+						}
+						{
+							position1170, tokenIndex1170, depth1170 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1171
+							}
+							position++
+							goto l1170
+						l1171:
+							position, tokenIndex, depth = position1170, tokenIndex1170, depth1170
+							if buffer[position] != rune('S') {
+								goto l1156
+							}
+							position++
+						}
+					l1170:
+						{
+							position1172, tokenIndex1172, depth1172 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1173
+							}
+							position++
+							goto l1172
+						l1173:
+							position, tokenIndex, depth = position1172, tokenIndex1172, depth1172
+							if buffer[position] != rune('I') {
+								goto l1156
+							}
+							position++
+						}
+					l1172:
+						{
+							position1174, tokenIndex1174, depth1174 := position, tokenIndex, depth
+							if buffer[position] != rune('z') {
+								goto l1175
+							}
+							position++
+							goto l1174
+						l1175:
+							position, tokenIndex, depth = position1174, tokenIndex1174, depth1174
+							if buffer[position] != rune('Z') {
+								goto l1156
+							}
+							position++
+						}
+					l1174:
+						{
+							position1176, tokenIndex1176, depth1176 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1177
+							}
+							position++
+							goto l1176
+						l1177:
+							position, tokenIndex, depth = position1176, tokenIndex1176, depth1176
+							if buffer[position] != rune('E') {
+								goto l1156
+							}
+							position++
+						}
+					l1176:
+						if !_rules[rulesp]() {
+							goto l1156
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1156
+						}
+						goto l1157
+					l1156:
+						position, tokenIndex, depth = position1156, tokenIndex1156, depth1156
 					}
+				l1157:
 					depth--
-					add(rulePegText, position1106)
+					add(rulePegText, position1155)
 				}
-				if !_rules[ruleAction62]() {
-					goto l1104
+				if !_rules[ruleAction58]() {
+					goto l1153
 				}
 				depth--
-				add(ruleminusExpr, position1105)
+				add(ruleCapacitySpecOpt, position1154)
 			}
 			return true
-		l1104:
-			position, tokenIndex, depth = position1104, tokenIndex1104, depth1104
+		l1153:
+			position, tokenIndex, depth = position1153, tokenIndex1153, depth1153
 			return false
 		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
+		/* 76 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action59)> */
 		func() bool {
-			position1109, tokenIndex1109, depth1109 := position, tokenIndex, depth
+			position1178, tokenIndex1178, depth1178 := position, tokenIndex, depth
 			{
-				position1110 := position
+				position1179 := position
 				depth++
 				{
-					position1111 := position
+					position1180 := position
 					depth++
-					if !_rules[rulebaseExpr]() {
-						goto l1109
-					}
 					{
-						position1112, tokenIndex1112, depth1112 := position, tokenIndex, depth
+						position1181, tokenIndex1181, depth1181 := position, tokenIndex, depth
 						if !_rules[rulespOpt]() {
-							goto l1112
-						}
-						if buffer[position] != rune(':') {
-							goto l1112
+							goto l1181
 						}
-						position++
-						if buffer[position] != rune(':') {
-							goto l1112
+						if buffer[position] != rune(',') {
+							goto l1181
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l1112
+							goto l1181
 						}
-						if !_rules[ruleType]() {
-							goto l1112
+						if !_rules[ruleSheddingOption]() {
+							goto l1181
 						}
-						goto l1113
-					l1112:
-						position, tokenIndex, depth = position1112, tokenIndex1112, depth1112
+						if !_rules[rulesp]() {
+							goto l1181
+						}
+						{
+							position1183, tokenIndex1183, depth1183 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1184
+							}
+							position++
+							goto l1183
+						l1184:
+							position, tokenIndex, depth = position1183, tokenIndex1183, depth1183
+							if buffer[position] != rune('I') {
+								goto l1181
+							}
+							position++
+						}
+					l1183:
+						{
+							position1185, tokenIndex1185, depth1185 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1186
+							}
+							position++
+							goto l1185
+						l1186:
+							position, tokenIndex, depth = position1185, tokenIndex1185, depth1185
+							if buffer[position] != rune('F') {
+								goto l1181
+							}
+							position++
+						}
+					l1185:
+						if !_rules[rulesp]() {
+							goto l1181
+						}
+						{
+							position1187, tokenIndex1187, depth1187 := position, tokenIndex, depth
+							if buffer[position] != rune('f') {
+								goto l1188
+							}
+							position++
+							goto l1187
+						l1188:
+							position, tokenIndex, depth = position1187, tokenIndex1187, depth1187
+							if buffer[position] != rune('F') {
+								goto l1181
+							}
+							position++
+						}
+					l1187:
+						{
+							position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1190
+							}
+							position++
+							goto l1189
+						l1190:
+							position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
+							if buffer[position] != rune('U') {
+								goto l1181
+							}
+							position++
+						}
+					l1189:
+						{
+							position1191, tokenIndex1191, depth1191 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1192
+							}
+							position++
+							goto l1191
+						l1192:
+							position, tokenIndex, depth = position1191, tokenIndex1191, depth1191
+							if buffer[position] != rune('L') {
+								goto l1181
+							}
+							position++
+						}
+					l1191:
+						{
+							position1193, tokenIndex1193, depth1193 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1194
+							}
+							position++
+							goto l1193
+						l1194:
+							position, tokenIndex, depth = position1193, tokenIndex1193, depth1193
+							if buffer[position] != rune('L') {
+								goto l1181
+							}
+							position++
+						}
+					l1193:
+						goto l1182
+					l1181:
+						position, tokenIndex, depth = position1181, tokenIndex1181, depth1181
 					}
-				l1113:
+				l1182:
 					depth--
-					add(rulePegText, position1111)
+					add(rulePegText, position1180)
 				}
-				if !_rules[ruleAction63]() {
-					goto l1109
+				if !_rules[ruleAction59]() {
+					goto l1178
 				}
 				depth--
-				add(rulecastExpr, position1110)
+				add(ruleSheddingSpecOpt, position1179)
 			}
 			return true
-		l1109:
-			position, tokenIndex, depth = position1109, tokenIndex1109, depth1109
+		l1178:
+			position, tokenIndex, depth = position1178, tokenIndex1178, depth1178
 			return false
 		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncApp / RowValue / ArrayExpr / Literal)> */
+		/* 77 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
 		func() bool {
-			position1114, tokenIndex1114, depth1114 := position, tokenIndex, depth
+			position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
 			{
-				position1115 := position
+				position1196 := position
 				depth++
 				{
-					position1116, tokenIndex1116, depth1116 := position, tokenIndex, depth
-					if buffer[position] != rune('(') {
-						goto l1117
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1117
-					}
-					if !_rules[ruleExpression]() {
-						goto l1117
-					}
-					if !_rules[rulespOpt]() {
-						goto l1117
-					}
-					if buffer[position] != rune(')') {
-						goto l1117
-					}
-					position++
-					goto l1116
-				l1117:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleMapExpr]() {
-						goto l1118
-					}
-					goto l1116
-				l1118:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1119
-					}
-					goto l1116
-				l1119:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleNullLiteral]() {
-						goto l1120
-					}
-					goto l1116
-				l1120:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleCase]() {
-						goto l1121
-					}
-					goto l1116
-				l1121:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleRowMeta]() {
-						goto l1122
-					}
-					goto l1116
-				l1122:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleFuncTypeCast]() {
-						goto l1123
-					}
-					goto l1116
-				l1123:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleFuncApp]() {
-						goto l1124
-					}
-					goto l1116
-				l1124:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleRowValue]() {
-						goto l1125
+					position1197, tokenIndex1197, depth1197 := position, tokenIndex, depth
+					if !_rules[ruleWait]() {
+						goto l1198
 					}
-					goto l1116
-				l1125:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleArrayExpr]() {
-						goto l1126
+					goto l1197
+				l1198:
+					position, tokenIndex, depth = position1197, tokenIndex1197, depth1197
+					if !_rules[ruleDropOldest]() {
+						goto l1199
 					}
-					goto l1116
-				l1126:
-					position, tokenIndex, depth = position1116, tokenIndex1116, depth1116
-					if !_rules[ruleLiteral]() {
-						goto l1114
+					goto l1197
+				l1199:
+					position, tokenIndex, depth = position1197, tokenIndex1197, depth1197
+					if !_rules[ruleDropNewest]() {
+						goto l1195
 					}
 				}
-			l1116:
+			l1197:
 				depth--
-				add(rulebaseExpr, position1115)
+				add(ruleSheddingOption, position1196)
 			}
 			return true
-		l1114:
-			position, tokenIndex, depth = position1114, tokenIndex1114, depth1114
+		l1195:
+			position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 78 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action60)> */
 		func() bool {
-			position1127, tokenIndex1127, depth1127 := position, tokenIndex, depth
+			position1200, tokenIndex1200, depth1200 := position, tokenIndex, depth
 			{
-				position1128 := position
+				position1201 := position
 				depth++
 				{
-					position1129 := position
+					position1202 := position
 					depth++
 					{
-						position1130, tokenIndex1130, depth1130 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1131
+						position1203, tokenIndex1203, depth1203 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1203
 						}
-						position++
-						goto l1130
-					l1131:
-						position, tokenIndex, depth = position1130, tokenIndex1130, depth1130
-						if buffer[position] != rune('C') {
-							goto l1127
+						{
+							position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
+							if buffer[position] != rune('w') {
+								goto l1206
+							}
+							position++
+							goto l1205
+						l1206:
+							position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
+							if buffer[position] != rune('W') {
+								goto l1203
+							}
+							position++
 						}
-						position++
-					}
-				l1130:
-					{
-						position1132, tokenIndex1132, depth1132 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1133
+					l1205:
+						{
+							position1207, tokenIndex1207, depth1207 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l1208
+							}
+							position++
+							goto l1207
+						l1208:
+							position, tokenIndex, depth = position1207, tokenIndex1207, depth1207
+							if buffer[position] != rune('I') {
+								goto l1203
+							}
+							position++
 						}
-						position++
-						goto l1132
-					l1133:
-						position, tokenIndex, depth = position1132, tokenIndex1132, depth1132
-						if buffer[position] != rune('A') {
-							goto l1127
+					l1207:
+						{
+							position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1210
+							}
+							position++
+							goto l1209
+						l1210:
+							position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
+							if buffer[position] != rune('T') {
+								goto l1203
+							}
+							position++
 						}
-						position++
+					l1209:
+						{
+							position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l1212
+							}
+							position++
+							goto l1211
+						l1212:
+							position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
+							if buffer[position] != rune('H') {
+								goto l1203
+							}
+							position++
+						}
+					l1211:
+						if !_rules[rulesp]() {
+							goto l1203
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1203
+						}
+					l1213:
+						{
+							position1214, tokenIndex1214, depth1214 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1214
+							}
+							if buffer[position] != rune(',') {
+								goto l1214
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1214
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1214
+							}
+							goto l1213
+						l1214:
+							position, tokenIndex, depth = position1214, tokenIndex1214, depth1214
+						}
+						goto l1204
+					l1203:
+						position, tokenIndex, depth = position1203, tokenIndex1203, depth1203
+					}
+				l1204:
+					depth--
+					add(rulePegText, position1202)
+				}
+				if !_rules[ruleAction60]() {
+					goto l1200
+				}
+				depth--
+				add(ruleSourceSinkSpecs, position1201)
+			}
+			return true
+		l1200:
+			position, tokenIndex, depth = position1200, tokenIndex1200, depth1200
+			return false
+		},
+		/* 79 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action61)> */
+		func() bool {
+			position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
+			{
+				position1216 := position
+				depth++
+				{
+					position1217 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1215
 					}
-				l1132:
 					{
-						position1134, tokenIndex1134, depth1134 := position, tokenIndex, depth
+						position1218, tokenIndex1218, depth1218 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1135
+							goto l1219
 						}
 						position++
-						goto l1134
-					l1135:
-						position, tokenIndex, depth = position1134, tokenIndex1134, depth1134
+						goto l1218
+					l1219:
+						position, tokenIndex, depth = position1218, tokenIndex1218, depth1218
 						if buffer[position] != rune('S') {
-							goto l1127
+							goto l1215
 						}
 						position++
 					}
-				l1134:
+				l1218:
 					{
-						position1136, tokenIndex1136, depth1136 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1137
+						position1220, tokenIndex1220, depth1220 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1221
 						}
 						position++
-						goto l1136
-					l1137:
-						position, tokenIndex, depth = position1136, tokenIndex1136, depth1136
-						if buffer[position] != rune('T') {
-							goto l1127
+						goto l1220
+					l1221:
+						position, tokenIndex, depth = position1220, tokenIndex1220, depth1220
+						if buffer[position] != rune('E') {
+							goto l1215
 						}
 						position++
 					}
-				l1136:
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune('(') {
-						goto l1127
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if !_rules[ruleExpression]() {
-						goto l1127
-					}
-					if !_rules[rulesp]() {
-						goto l1127
-					}
+				l1220:
 					{
-						position1138, tokenIndex1138, depth1138 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1139
+						position1222, tokenIndex1222, depth1222 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1223
 						}
 						position++
-						goto l1138
-					l1139:
-						position, tokenIndex, depth = position1138, tokenIndex1138, depth1138
-						if buffer[position] != rune('A') {
-							goto l1127
+						goto l1222
+					l1223:
+						position, tokenIndex, depth = position1222, tokenIndex1222, depth1222
+						if buffer[position] != rune('T') {
+							goto l1215
 						}
 						position++
 					}
-				l1138:
+				l1222:
+					if !_rules[rulesp]() {
+						goto l1215
+					}
+					if !_rules[ruleSourceSinkParam]() {
+						goto l1215
+					}
+				l1224:
 					{
-						position1140, tokenIndex1140, depth1140 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1141
+						position1225, tokenIndex1225, depth1225 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1225
 						}
-						position++
-						goto l1140
-					l1141:
-						position, tokenIndex, depth = position1140, tokenIndex1140, depth1140
-						if buffer[position] != rune('S') {
-							goto l1127
+						if buffer[position] != rune(',') {
+							goto l1225
 						}
 						position++
+						if !_rules[rulespOpt]() {
+							goto l1225
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1225
+						}
+						goto l1224
+					l1225:
+						position, tokenIndex, depth = position1225, tokenIndex1225, depth1225
 					}
-				l1140:
-					if !_rules[rulesp]() {
-						goto l1127
-					}
-					if !_rules[ruleType]() {
-						goto l1127
-					}
-					if !_rules[rulespOpt]() {
-						goto l1127
-					}
-					if buffer[position] != rune(')') {
-						goto l1127
-					}
-					position++
 					depth--
-					add(rulePegText, position1129)
+					add(rulePegText, position1217)
 				}
-				if !_rules[ruleAction64]() {
-					goto l1127
+				if !_rules[ruleAction61]() {
+					goto l1215
 				}
 				depth--
-				add(ruleFuncTypeCast, position1128)
+				add(ruleUpdateSourceSinkSpecs, position1216)
 			}
 			return true
-		l1127:
-			position, tokenIndex, depth = position1127, tokenIndex1127, depth1127
+		l1215:
+			position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 80 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action62)> */
 		func() bool {
-			position1142, tokenIndex1142, depth1142 := position, tokenIndex, depth
+			position1226, tokenIndex1226, depth1226 := position, tokenIndex, depth
 			{
-				position1143 := position
+				position1227 := position
 				depth++
 				{
-					position1144, tokenIndex1144, depth1144 := position, tokenIndex, depth
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1145
-					}
-					goto l1144
-				l1145:
-					position, tokenIndex, depth = position1144, tokenIndex1144, depth1144
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1142
+					position1228 := position
+					depth++
+					{
+						position1229, tokenIndex1229, depth1229 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1229
+						}
+						{
+							position1231, tokenIndex1231, depth1231 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1232
+							}
+							position++
+							goto l1231
+						l1232:
+							position, tokenIndex, depth = position1231, tokenIndex1231, depth1231
+							if buffer[position] != rune('S') {
+								goto l1229
+							}
+							position++
+						}
+					l1231:
+						{
+							position1233, tokenIndex1233, depth1233 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1234
+							}
+							position++
+							goto l1233
+						l1234:
+							position, tokenIndex, depth = position1233, tokenIndex1233, depth1233
+							if buffer[position] != rune('E') {
+								goto l1229
+							}
+							position++
+						}
+					l1233:
+						{
+							position1235, tokenIndex1235, depth1235 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1236
+							}
+							position++
+							goto l1235
+						l1236:
+							position, tokenIndex, depth = position1235, tokenIndex1235, depth1235
+							if buffer[position] != rune('T') {
+								goto l1229
+							}
+							position++
+						}
+					l1235:
+						if !_rules[rulesp]() {
+							goto l1229
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1229
+						}
+					l1237:
+						{
+							position1238, tokenIndex1238, depth1238 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1238
+							}
+							if buffer[position] != rune(',') {
+								goto l1238
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1238
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1238
+							}
+							goto l1237
+						l1238:
+							position, tokenIndex, depth = position1238, tokenIndex1238, depth1238
+						}
+						goto l1230
+					l1229:
+						position, tokenIndex, depth = position1229, tokenIndex1229, depth1229
 					}
+				l1230:
+					depth--
+					add(rulePegText, position1228)
+				}
+				if !_rules[ruleAction62]() {
+					goto l1226
 				}
-			l1144:
 				depth--
-				add(ruleFuncApp, position1143)
+				add(ruleSetOptSpecs, position1227)
 			}
 			return true
-		l1142:
-			position, tokenIndex, depth = position1142, tokenIndex1142, depth1142
+		l1226:
+			position, tokenIndex, depth = position1226, tokenIndex1226, depth1226
 			return false
 		},
-		/* 86 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action65)> */
+		/* 81 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action63)> */
 		func() bool {
-			position1146, tokenIndex1146, depth1146 := position, tokenIndex, depth
+			position1239, tokenIndex1239, depth1239 := position, tokenIndex, depth
 			{
-				position1147 := position
+				position1240 := position
 				depth++
-				if !_rules[ruleFunction]() {
-					goto l1146
-				}
-				if !_rules[rulespOpt]() {
-					goto l1146
-				}
-				if buffer[position] != rune('(') {
-					goto l1146
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1146
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1146
-				}
-				if !_rules[rulesp]() {
-					goto l1146
-				}
-				if !_rules[ruleParamsOrder]() {
-					goto l1146
-				}
-				if !_rules[rulespOpt]() {
-					goto l1146
-				}
-				if buffer[position] != rune(')') {
-					goto l1146
+				{
+					position1241 := position
+					depth++
+					{
+						position1242, tokenIndex1242, depth1242 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1242
+						}
+						{
+							position1244, tokenIndex1244, depth1244 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1245
+							}
+							position++
+							goto l1244
+						l1245:
+							position, tokenIndex, depth = position1244, tokenIndex1244, depth1244
+							if buffer[position] != rune('T') {
+								goto l1242
+							}
+							position++
+						}
+					l1244:
+						{
+							position1246, tokenIndex1246, depth1246 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l1247
+							}
+							position++
+							goto l1246
+						l1247:
+							position, tokenIndex, depth = position1246, tokenIndex1246, depth1246
+							if buffer[position] != rune('A') {
+								goto l1242
+							}
+							position++
+						}
+					l1246:
+						{
+							position1248, tokenIndex1248, depth1248 := position, tokenIndex, depth
+							if buffer[position] != rune('g') {
+								goto l1249
+							}
+							position++
+							goto l1248
+						l1249:
+							position, tokenIndex, depth = position1248, tokenIndex1248, depth1248
+							if buffer[position] != rune('G') {
+								goto l1242
+							}
+							position++
+						}
+					l1248:
+						if !_rules[rulesp]() {
+							goto l1242
+						}
+						if !_rules[ruleIdentifier]() {
+							goto l1242
+						}
+						goto l1243
+					l1242:
+						position, tokenIndex, depth = position1242, tokenIndex1242, depth1242
+					}
+				l1243:
+					depth--
+					add(rulePegText, position1241)
 				}
-				position++
-				if !_rules[ruleAction65]() {
-					goto l1146
+				if !_rules[ruleAction63]() {
+					goto l1239
 				}
 				depth--
-				add(ruleFuncAppWithOrderBy, position1147)
+				add(ruleStateTagOpt, position1240)
 			}
 			return true
-		l1146:
-			position, tokenIndex, depth = position1146, tokenIndex1146, depth1146
+		l1239:
+			position, tokenIndex, depth = position1239, tokenIndex1239, depth1239
 			return false
 		},
-		/* 87 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action66)> */
+		/* 82 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action64)> */
 		func() bool {
-			position1148, tokenIndex1148, depth1148 := position, tokenIndex, depth
+			position1250, tokenIndex1250, depth1250 := position, tokenIndex, depth
 			{
-				position1149 := position
+				position1251 := position
 				depth++
-				if !_rules[ruleFunction]() {
-					goto l1148
+				if !_rules[ruleSourceSinkParamKey]() {
+					goto l1250
 				}
 				if !_rules[rulespOpt]() {
-					goto l1148
+					goto l1250
 				}
-				if buffer[position] != rune('(') {
-					goto l1148
+				if buffer[position] != rune('=') {
+					goto l1250
 				}
 				position++
 				if !_rules[rulespOpt]() {
-					goto l1148
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1148
-				}
-				{
-					position1150 := position
-					depth++
-					if !_rules[rulespOpt]() {
-						goto l1148
-					}
-					depth--
-					add(rulePegText, position1150)
+					goto l1250
 				}
-				if buffer[position] != rune(')') {
-					goto l1148
+				if !_rules[ruleSourceSinkParamVal]() {
+					goto l1250
 				}
-				position++
-				if !_rules[ruleAction66]() {
-					goto l1148
+				if !_rules[ruleAction64]() {
+					goto l1250
 				}
 				depth--
-				add(ruleFuncAppWithoutOrderBy, position1149)
+				add(ruleSourceSinkParam, position1251)
 			}
 			return true
-		l1148:
-			position, tokenIndex, depth = position1148, tokenIndex1148, depth1148
+		l1250:
+			position, tokenIndex, depth = position1250, tokenIndex1250, depth1250
 			return false
 		},
-		/* 88 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action67)> */
+		/* 83 SourceSinkParamVal <- <(ParamLiteral / ParamArrayExpr / ParamMapExpr)> */
 		func() bool {
-			position1151, tokenIndex1151, depth1151 := position, tokenIndex, depth
+			position1252, tokenIndex1252, depth1252 := position, tokenIndex, depth
 			{
-				position1152 := position
+				position1253 := position
 				depth++
 				{
-					position1153 := position
-					depth++
-					{
-						position1154, tokenIndex1154, depth1154 := position, tokenIndex, depth
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1154
-						}
-					l1156:
-						{
-							position1157, tokenIndex1157, depth1157 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1157
-							}
-							if buffer[position] != rune(',') {
-								goto l1157
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1157
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1157
-							}
-							goto l1156
-						l1157:
-							position, tokenIndex, depth = position1157, tokenIndex1157, depth1157
-						}
-						goto l1155
-					l1154:
-						position, tokenIndex, depth = position1154, tokenIndex1154, depth1154
-					}
-				l1155:
-					depth--
-					add(rulePegText, position1153)
-				}
-				if !_rules[ruleAction67]() {
-					goto l1151
-				}
-				depth--
-				add(ruleFuncParams, position1152)
-			}
-			return true
-		l1151:
-			position, tokenIndex, depth = position1151, tokenIndex1151, depth1151
-			return false
-		},
-		/* 89 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action68)> */
-		func() bool {
-			position1158, tokenIndex1158, depth1158 := position, tokenIndex, depth
-			{
-				position1159 := position
-				depth++
-				{
-					position1160 := position
-					depth++
-					{
-						position1161, tokenIndex1161, depth1161 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1162
-						}
-						position++
-						goto l1161
-					l1162:
-						position, tokenIndex, depth = position1161, tokenIndex1161, depth1161
-						if buffer[position] != rune('O') {
-							goto l1158
-						}
-						position++
-					}
-				l1161:
-					{
-						position1163, tokenIndex1163, depth1163 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1164
-						}
-						position++
-						goto l1163
-					l1164:
-						position, tokenIndex, depth = position1163, tokenIndex1163, depth1163
-						if buffer[position] != rune('R') {
-							goto l1158
-						}
-						position++
-					}
-				l1163:
-					{
-						position1165, tokenIndex1165, depth1165 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1166
-						}
-						position++
-						goto l1165
-					l1166:
-						position, tokenIndex, depth = position1165, tokenIndex1165, depth1165
-						if buffer[position] != rune('D') {
-							goto l1158
-						}
-						position++
-					}
-				l1165:
-					{
-						position1167, tokenIndex1167, depth1167 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1168
-						}
-						position++
-						goto l1167
-					l1168:
-						position, tokenIndex, depth = position1167, tokenIndex1167, depth1167
-						if buffer[position] != rune('E') {
-							goto l1158
-						}
-						position++
-					}
-				l1167:
-					{
-						position1169, tokenIndex1169, depth1169 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1170
-						}
-						position++
-						goto l1169
-					l1170:
-						position, tokenIndex, depth = position1169, tokenIndex1169, depth1169
-						if buffer[position] != rune('R') {
-							goto l1158
-						}
-						position++
-					}
-				l1169:
-					if !_rules[rulesp]() {
-						goto l1158
-					}
-					{
-						position1171, tokenIndex1171, depth1171 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1172
-						}
-						position++
-						goto l1171
-					l1172:
-						position, tokenIndex, depth = position1171, tokenIndex1171, depth1171
-						if buffer[position] != rune('B') {
-							goto l1158
-						}
-						position++
-					}
-				l1171:
-					{
-						position1173, tokenIndex1173, depth1173 := position, tokenIndex, depth
-						if buffer[position] != rune('y') {
-							goto l1174
-						}
-						position++
-						goto l1173
-					l1174:
-						position, tokenIndex, depth = position1173, tokenIndex1173, depth1173
-						if buffer[position] != rune('Y') {
-							goto l1158
-						}
-						position++
-					}
-				l1173:
-					if !_rules[rulesp]() {
-						goto l1158
+					position1254, tokenIndex1254, depth1254 := position, tokenIndex, depth
+					if !_rules[ruleParamLiteral]() {
+						goto l1255
 					}
-					if !_rules[ruleSortedExpression]() {
-						goto l1158
+					goto l1254
+				l1255:
+					position, tokenIndex, depth = position1254, tokenIndex1254, depth1254
+					if !_rules[ruleParamArrayExpr]() {
+						goto l1256
 					}
-				l1175:
-					{
-						position1176, tokenIndex1176, depth1176 := position, tokenIndex, depth
-						if !_rules[rulespOpt]() {
-							goto l1176
-						}
-						if buffer[position] != rune(',') {
-							goto l1176
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l1176
-						}
-						if !_rules[ruleSortedExpression]() {
-							goto l1176
-						}
-						goto l1175
-					l1176:
-						position, tokenIndex, depth = position1176, tokenIndex1176, depth1176
+					goto l1254
+				l1256:
+					position, tokenIndex, depth = position1254, tokenIndex1254, depth1254
+					if !_rules[ruleParamMapExpr]() {
+						goto l1252
 					}
-					depth--
-					add(rulePegText, position1160)
-				}
-				if !_rules[ruleAction68]() {
-					goto l1158
-				}
-				depth--
-				add(ruleParamsOrder, position1159)
-			}
-			return true
-		l1158:
-			position, tokenIndex, depth = position1158, tokenIndex1158, depth1158
-			return false
-		},
-		/* 90 SortedExpression <- <(Expression OrderDirectionOpt Action69)> */
-		func() bool {
-			position1177, tokenIndex1177, depth1177 := position, tokenIndex, depth
-			{
-				position1178 := position
-				depth++
-				if !_rules[ruleExpression]() {
-					goto l1177
-				}
-				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1177
-				}
-				if !_rules[ruleAction69]() {
-					goto l1177
 				}
+			l1254:
 				depth--
-				add(ruleSortedExpression, position1178)
+				add(ruleSourceSinkParamVal, position1253)
 			}
 			return true
-		l1177:
-			position, tokenIndex, depth = position1177, tokenIndex1177, depth1177
+		l1252:
+			position, tokenIndex, depth = position1252, tokenIndex1252, depth1252
 			return false
 		},
-		/* 91 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action70)> */
+		/* 84 ParamLiteral <- <(BooleanLiteral / Literal)> */
 		func() bool {
-			position1179, tokenIndex1179, depth1179 := position, tokenIndex, depth
+			position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
 			{
-				position1180 := position
+				position1258 := position
 				depth++
 				{
-					position1181 := position
-					depth++
-					{
-						position1182, tokenIndex1182, depth1182 := position, tokenIndex, depth
-						if !_rules[rulesp]() {
-							goto l1182
-						}
-						{
-							position1184, tokenIndex1184, depth1184 := position, tokenIndex, depth
-							if !_rules[ruleAscending]() {
-								goto l1185
-							}
-							goto l1184
-						l1185:
-							position, tokenIndex, depth = position1184, tokenIndex1184, depth1184
-							if !_rules[ruleDescending]() {
-								goto l1182
-							}
-						}
-					l1184:
-						goto l1183
-					l1182:
-						position, tokenIndex, depth = position1182, tokenIndex1182, depth1182
+					position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1260
+					}
+					goto l1259
+				l1260:
+					position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
+					if !_rules[ruleLiteral]() {
+						goto l1257
 					}
-				l1183:
-					depth--
-					add(rulePegText, position1181)
-				}
-				if !_rules[ruleAction70]() {
-					goto l1179
 				}
+			l1259:
 				depth--
-				add(ruleOrderDirectionOpt, position1180)
+				add(ruleParamLiteral, position1258)
 			}
 			return true
-		l1179:
-			position, tokenIndex, depth = position1179, tokenIndex1179, depth1179
+		l1257:
+			position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
 			return false
 		},
-		/* 92 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action71)> */
+		/* 85 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action65)> */
 		func() bool {
-			position1186, tokenIndex1186, depth1186 := position, tokenIndex, depth
+			position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
 			{
-				position1187 := position
+				position1262 := position
 				depth++
 				{
-					position1188 := position
+					position1263 := position
 					depth++
 					if buffer[position] != rune('[') {
-						goto l1186
+						goto l1261
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1186
+						goto l1261
 					}
 					{
-						position1189, tokenIndex1189, depth1189 := position, tokenIndex, depth
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1189
+						position1264, tokenIndex1264, depth1264 := position, tokenIndex, depth
+						if !_rules[ruleParamLiteral]() {
+							goto l1264
 						}
-					l1191:
+					l1266:
 						{
-							position1192, tokenIndex1192, depth1192 := position, tokenIndex, depth
-							if !_rules[rulespOpt]() {
-								goto l1192
-							}
+							position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l1192
+								goto l1267
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1192
+								goto l1267
 							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1192
+							if !_rules[ruleParamLiteral]() {
+								goto l1267
 							}
-							goto l1191
-						l1192:
-							position, tokenIndex, depth = position1192, tokenIndex1192, depth1192
+							goto l1266
+						l1267:
+							position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
 						}
-						goto l1190
-					l1189:
-						position, tokenIndex, depth = position1189, tokenIndex1189, depth1189
+						goto l1265
+					l1264:
+						position, tokenIndex, depth = position1264, tokenIndex1264, depth1264
 					}
-				l1190:
+				l1265:
 					if !_rules[rulespOpt]() {
-						goto l1186
+						goto l1261
 					}
 					{
-						position1193, tokenIndex1193, depth1193 := position, tokenIndex, depth
+						position1268, tokenIndex1268, depth1268 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l1193
+							goto l1268
 						}
 						position++
-						goto l1194
-					l1193:
-						position, tokenIndex, depth = position1193, tokenIndex1193, depth1193
+						goto l1269
+					l1268:
+						position, tokenIndex, depth = position1268, tokenIndex1268, depth1268
 					}
-				l1194:
+				l1269:
 					if !_rules[rulespOpt]() {
-						goto l1186
+						goto l1261
 					}
 					if buffer[position] != rune(']') {
-						goto l1186
+						goto l1261
 					}
 					position++
 					depth--
-					add(rulePegText, position1188)
+					add(rulePegText, position1263)
 				}
-				if !_rules[ruleAction71]() {
-					goto l1186
+				if !_rules[ruleAction65]() {
+					goto l1261
 				}
 				depth--
-				add(ruleArrayExpr, position1187)
+				add(ruleParamArrayExpr, position1262)
 			}
 			return true
-		l1186:
-			position, tokenIndex, depth = position1186, tokenIndex1186, depth1186
+		l1261:
+			position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
 			return false
 		},
-		/* 93 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action72)> */
+		/* 86 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action66)> */
 		func() bool {
-			position1195, tokenIndex1195, depth1195 := position, tokenIndex, depth
+			position1270, tokenIndex1270, depth1270 := position, tokenIndex, depth
 			{
-				position1196 := position
+				position1271 := position
 				depth++
 				{
-					position1197 := position
+					position1272 := position
 					depth++
 					if buffer[position] != rune('{') {
-						goto l1195
+						goto l1270
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1195
+						goto l1270
 					}
 					{
-						position1198, tokenIndex1198, depth1198 := position, tokenIndex, depth
-						if !_rules[ruleKeyValuePair]() {
-							goto l1198
+						position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
+						if !_rules[ruleParamKeyValuePair]() {
+							goto l1273
 						}
-					l1200:
+					l1275:
 						{
-							position1201, tokenIndex1201, depth1201 := position, tokenIndex, depth
+							position1276, tokenIndex1276, depth1276 := position, tokenIndex, depth
 							if !_rules[rulespOpt]() {
-								goto l1201
+								goto l1276
 							}
 							if buffer[position] != rune(',') {
-								goto l1201
+								goto l1276
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1201
+								goto l1276
 							}
-							if !_rules[ruleKeyValuePair]() {
-								goto l1201
+							if !_rules[ruleParamKeyValuePair]() {
+								goto l1276
 							}
-							goto l1200
-						l1201:
-							position, tokenIndex, depth = position1201, tokenIndex1201, depth1201
+							goto l1275
+						l1276:
+							position, tokenIndex, depth = position1276, tokenIndex1276, depth1276
 						}
-						goto l1199
-					l1198:
-						position, tokenIndex, depth = position1198, tokenIndex1198, depth1198
+						goto l1274
+					l1273:
+						position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
 					}
-				l1199:
+				l1274:
 					if !_rules[rulespOpt]() {
-						goto l1195
+						goto l1270
 					}
 					if buffer[position] != rune('}') {
-						goto l1195
+						goto l1270
 					}
 					position++
 					depth--
-					add(rulePegText, position1197)
+					add(rulePegText, position1272)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1195
+				if !_rules[ruleAction66]() {
+					goto l1270
 				}
 				depth--
-				add(ruleMapExpr, position1196)
+				add(ruleParamMapExpr, position1271)
 			}
 			return true
-		l1195:
-			position, tokenIndex, depth = position1195, tokenIndex1195, depth1195
+		l1270:
+			position, tokenIndex, depth = position1270, tokenIndex1270, depth1270
 			return false
 		},
-		/* 94 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action73)> */
+		/* 87 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action67)> */
 		func() bool {
-			position1202, tokenIndex1202, depth1202 := position, tokenIndex, depth
+			position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
 			{
-				position1203 := position
+				position1278 := position
 				depth++
 				{
-					position1204 := position
+					position1279 := position
 					depth++
 					if !_rules[ruleStringLiteral]() {
-						goto l1202
+						goto l1277
 					}
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1277
 					}
 					if buffer[position] != rune(':') {
-						goto l1202
+						goto l1277
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1202
+						goto l1277
 					}
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1202
+					if !_rules[ruleParamLiteral]() {
+						goto l1277
 					}
 					depth--
-					add(rulePegText, position1204)
+					add(rulePegText, position1279)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1202
+				if !_rules[ruleAction67]() {
+					goto l1277
 				}
 				depth--
-				add(ruleKeyValuePair, position1203)
+				add(ruleParamKeyValuePair, position1278)
 			}
 			return true
-		l1202:
-			position, tokenIndex, depth = position1202, tokenIndex1202, depth1202
+		l1277:
+			position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
 			return false
 		},
-		/* 95 Case <- <(ConditionCase / ExpressionCase)> */
+		/* 88 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action68)> */
 		func() bool {
-			position1205, tokenIndex1205, depth1205 := position, tokenIndex, depth
+			position1280, tokenIndex1280, depth1280 := position, tokenIndex, depth
 			{
-				position1206 := position
+				position1281 := position
 				depth++
 				{
-					position1207, tokenIndex1207, depth1207 := position, tokenIndex, depth
-					if !_rules[ruleConditionCase]() {
-						goto l1208
-					}
-					goto l1207
-				l1208:
-					position, tokenIndex, depth = position1207, tokenIndex1207, depth1207
-					if !_rules[ruleExpressionCase]() {
-						goto l1205
+					position1282 := position
+					depth++
+					{
+						position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1283
+						}
+						{
+							position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+							if !_rules[rulePaused]() {
+								goto l1286
+							}
+							goto l1285
+						l1286:
+							position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+							if !_rules[ruleUnpaused]() {
+								goto l1283
+							}
+						}
+					l1285:
+						goto l1284
+					l1283:
+						position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
 					}
+				l1284:
+					depth--
+					add(rulePegText, position1282)
+				}
+				if !_rules[ruleAction68]() {
+					goto l1280
 				}
-			l1207:
 				depth--
-				add(ruleCase, position1206)
+				add(rulePausedOpt, position1281)
 			}
 			return true
-		l1205:
-			position, tokenIndex, depth = position1205, tokenIndex1205, depth1205
+		l1280:
+			position, tokenIndex, depth = position1280, tokenIndex1280, depth1280
 			return false
 		},
-		/* 96 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action74)> */
+		/* 89 ExpressionOrWildcard <- <(Wildcard / Expression)> */
 		func() bool {
-			position1209, tokenIndex1209, depth1209 := position, tokenIndex, depth
+			position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
 			{
-				position1210 := position
+				position1288 := position
 				depth++
 				{
-					position1211, tokenIndex1211, depth1211 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l1212
-					}
-					position++
-					goto l1211
-				l1212:
-					position, tokenIndex, depth = position1211, tokenIndex1211, depth1211
-					if buffer[position] != rune('C') {
-						goto l1209
-					}
-					position++
-				}
-			l1211:
-				{
-					position1213, tokenIndex1213, depth1213 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l1214
+					position1289, tokenIndex1289, depth1289 := position, tokenIndex, depth
+					if !_rules[ruleWildcard]() {
+						goto l1290
 					}
-					position++
-					goto l1213
-				l1214:
-					position, tokenIndex, depth = position1213, tokenIndex1213, depth1213
-					if buffer[position] != rune('A') {
-						goto l1209
+					goto l1289
+				l1290:
+					position, tokenIndex, depth = position1289, tokenIndex1289, depth1289
+					if !_rules[ruleExpression]() {
+						goto l1287
 					}
-					position++
 				}
-			l1213:
-				{
-					position1215, tokenIndex1215, depth1215 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l1216
-					}
-					position++
-					goto l1215
-				l1216:
-					position, tokenIndex, depth = position1215, tokenIndex1215, depth1215
-					if buffer[position] != rune('S') {
-						goto l1209
-					}
-					position++
+			l1289:
+				depth--
+				add(ruleExpressionOrWildcard, position1288)
+			}
+			return true
+		l1287:
+			position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
+			return false
+		},
+		/* 90 Expression <- <orExpr> */
+		func() bool {
+			position1291, tokenIndex1291, depth1291 := position, tokenIndex, depth
+			{
+				position1292 := position
+				depth++
+				if !_rules[ruleorExpr]() {
+					goto l1291
 				}
-			l1215:
+				depth--
+				add(ruleExpression, position1292)
+			}
+			return true
+		l1291:
+			position, tokenIndex, depth = position1291, tokenIndex1291, depth1291
+			return false
+		},
+		/* 91 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action69)> */
+		func() bool {
+			position1293, tokenIndex1293, depth1293 := position, tokenIndex, depth
+			{
+				position1294 := position
+				depth++
 				{
-					position1217, tokenIndex1217, depth1217 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1218
+					position1295 := position
+					depth++
+					if !_rules[ruleandExpr]() {
+						goto l1293
 					}
-					position++
-					goto l1217
-				l1218:
-					position, tokenIndex, depth = position1217, tokenIndex1217, depth1217
-					if buffer[position] != rune('E') {
-						goto l1209
+				l1296:
+					{
+						position1297, tokenIndex1297, depth1297 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1297
+						}
+						if !_rules[ruleOr]() {
+							goto l1297
+						}
+						if !_rules[rulesp]() {
+							goto l1297
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1297
+						}
+						goto l1296
+					l1297:
+						position, tokenIndex, depth = position1297, tokenIndex1297, depth1297
 					}
-					position++
+					depth--
+					add(rulePegText, position1295)
 				}
-			l1217:
+				if !_rules[ruleAction69]() {
+					goto l1293
+				}
+				depth--
+				add(ruleorExpr, position1294)
+			}
+			return true
+		l1293:
+			position, tokenIndex, depth = position1293, tokenIndex1293, depth1293
+			return false
+		},
+		/* 92 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action70)> */
+		func() bool {
+			position1298, tokenIndex1298, depth1298 := position, tokenIndex, depth
+			{
+				position1299 := position
+				depth++
 				{
-					position1219 := position
+					position1300 := position
 					depth++
-					if !_rules[rulesp]() {
-						goto l1209
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1209
+					if !_rules[rulenotExpr]() {
+						goto l1298
 					}
-				l1220:
+				l1301:
 					{
-						position1221, tokenIndex1221, depth1221 := position, tokenIndex, depth
+						position1302, tokenIndex1302, depth1302 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l1221
+							goto l1302
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1221
+						if !_rules[ruleAnd]() {
+							goto l1302
 						}
-						goto l1220
-					l1221:
-						position, tokenIndex, depth = position1221, tokenIndex1221, depth1221
+						if !_rules[rulesp]() {
+							goto l1302
+						}
+						if !_rules[rulenotExpr]() {
+							goto l1302
+						}
+						goto l1301
+					l1302:
+						position, tokenIndex, depth = position1302, tokenIndex1302, depth1302
 					}
+					depth--
+					add(rulePegText, position1300)
+				}
+				if !_rules[ruleAction70]() {
+					goto l1298
+				}
+				depth--
+				add(ruleandExpr, position1299)
+			}
+			return true
+		l1298:
+			position, tokenIndex, depth = position1298, tokenIndex1298, depth1298
+			return false
+		},
+		/* 93 notExpr <- <(<((Not sp)? comparisonExpr)> Action71)> */
+		func() bool {
+			position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
+			{
+				position1304 := position
+				depth++
+				{
+					position1305 := position
+					depth++
 					{
-						position1222, tokenIndex1222, depth1222 := position, tokenIndex, depth
+						position1306, tokenIndex1306, depth1306 := position, tokenIndex, depth
+						if !_rules[ruleNot]() {
+							goto l1306
+						}
 						if !_rules[rulesp]() {
-							goto l1222
+							goto l1306
 						}
+						goto l1307
+					l1306:
+						position, tokenIndex, depth = position1306, tokenIndex1306, depth1306
+					}
+				l1307:
+					if !_rules[rulecomparisonExpr]() {
+						goto l1303
+					}
+					depth--
+					add(rulePegText, position1305)
+				}
+				if !_rules[ruleAction71]() {
+					goto l1303
+				}
+				depth--
+				add(rulenotExpr, position1304)
+			}
+			return true
+		l1303:
+			position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
+			return false
+		},
+		/* 94 comparisonExpr <- <(<(otherOpExpr ((spOpt ComparisonOp spOpt otherOpExpr) / (sp PatternOp sp otherOpExpr))?)> Action72)> */
+		func() bool {
+			position1308, tokenIndex1308, depth1308 := position, tokenIndex, depth
+			{
+				position1309 := position
+				depth++
+				{
+					position1310 := position
+					depth++
+					if !_rules[ruleotherOpExpr]() {
+						goto l1308
+					}
+					{
+						position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
 						{
-							position1224, tokenIndex1224, depth1224 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1225
+							position1313, tokenIndex1313, depth1313 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1314
 							}
-							position++
-							goto l1224
-						l1225:
-							position, tokenIndex, depth = position1224, tokenIndex1224, depth1224
-							if buffer[position] != rune('E') {
-								goto l1222
+							if !_rules[ruleComparisonOp]() {
+								goto l1314
 							}
-							position++
-						}
-					l1224:
-						{
-							position1226, tokenIndex1226, depth1226 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l1227
+							if !_rules[rulespOpt]() {
+								goto l1314
 							}
-							position++
-							goto l1226
-						l1227:
-							position, tokenIndex, depth = position1226, tokenIndex1226, depth1226
-							if buffer[position] != rune('L') {
-								goto l1222
+							if !_rules[ruleotherOpExpr]() {
+								goto l1314
 							}
-							position++
-						}
-					l1226:
-						{
-							position1228, tokenIndex1228, depth1228 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l1229
+							goto l1313
+						l1314:
+							position, tokenIndex, depth = position1313, tokenIndex1313, depth1313
+							if !_rules[rulesp]() {
+								goto l1311
 							}
-							position++
-							goto l1228
-						l1229:
-							position, tokenIndex, depth = position1228, tokenIndex1228, depth1228
-							if buffer[position] != rune('S') {
-								goto l1222
+							if !_rules[rulePatternOp]() {
+								goto l1311
 							}
-							position++
-						}
-					l1228:
-						{
-							position1230, tokenIndex1230, depth1230 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1231
+							if !_rules[rulesp]() {
+								goto l1311
 							}
-							position++
-							goto l1230
-						l1231:
-							position, tokenIndex, depth = position1230, tokenIndex1230, depth1230
-							if buffer[position] != rune('E') {
-								goto l1222
+							if !_rules[ruleotherOpExpr]() {
+								goto l1311
 							}
-							position++
-						}
-					l1230:
-						if !_rules[rulesp]() {
-							goto l1222
-						}
-						if !_rules[ruleExpression]() {
-							goto l1222
 						}
-						goto l1223
-					l1222:
-						position, tokenIndex, depth = position1222, tokenIndex1222, depth1222
-					}
-				l1223:
-					if !_rules[rulesp]() {
-						goto l1209
+					l1313:
+						goto l1312
+					l1311:
+						position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
 					}
-					{
-						position1232, tokenIndex1232, depth1232 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1233
-						}
-						position++
-						goto l1232
-					l1233:
-						position, tokenIndex, depth = position1232, tokenIndex1232, depth1232
-						if buffer[position] != rune('E') {
-							goto l1209
-						}
-						position++
+				l1312:
+					depth--
+					add(rulePegText, position1310)
+				}
+				if !_rules[ruleAction72]() {
+					goto l1308
+				}
+				depth--
+				add(rulecomparisonExpr, position1309)
+			}
+			return true
+		l1308:
+			position, tokenIndex, depth = position1308, tokenIndex1308, depth1308
+			return false
+		},
+		/* 95 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action73)> */
+		func() bool {
+			position1315, tokenIndex1315, depth1315 := position, tokenIndex, depth
+			{
+				position1316 := position
+				depth++
+				{
+					position1317 := position
+					depth++
+					if !_rules[ruleisExpr]() {
+						goto l1315
 					}
-				l1232:
+				l1318:
 					{
-						position1234, tokenIndex1234, depth1234 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1235
+						position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1319
 						}
-						position++
-						goto l1234
-					l1235:
-						position, tokenIndex, depth = position1234, tokenIndex1234, depth1234
-						if buffer[position] != rune('N') {
-							goto l1209
+						if !_rules[ruleOtherOp]() {
+							goto l1319
 						}
-						position++
-					}
-				l1234:
-					{
-						position1236, tokenIndex1236, depth1236 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1237
+						if !_rules[rulespOpt]() {
+							goto l1319
 						}
-						position++
-						goto l1236
-					l1237:
-						position, tokenIndex, depth = position1236, tokenIndex1236, depth1236
-						if buffer[position] != rune('D') {
-							goto l1209
+						if !_rules[ruleisExpr]() {
+							goto l1319
 						}
-						position++
+						goto l1318
+					l1319:
+						position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
 					}
-				l1236:
 					depth--
-					add(rulePegText, position1219)
+					add(rulePegText, position1317)
 				}
-				if !_rules[ruleAction74]() {
-					goto l1209
+				if !_rules[ruleAction73]() {
+					goto l1315
 				}
 				depth--
-				add(ruleConditionCase, position1210)
+				add(ruleotherOpExpr, position1316)
 			}
 			return true
-		l1209:
-			position, tokenIndex, depth = position1209, tokenIndex1209, depth1209
+		l1315:
+			position, tokenIndex, depth = position1315, tokenIndex1315, depth1315
 			return false
 		},
-		/* 97 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action75)> */
+		/* 96 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action74)> */
 		func() bool {
-			position1238, tokenIndex1238, depth1238 := position, tokenIndex, depth
+			position1320, tokenIndex1320, depth1320 := position, tokenIndex, depth
 			{
-				position1239 := position
+				position1321 := position
 				depth++
 				{
-					position1240, tokenIndex1240, depth1240 := position, tokenIndex, depth
-					if buffer[position] != rune('c') {
-						goto l1241
-					}
-					position++
-					goto l1240
-				l1241:
-					position, tokenIndex, depth = position1240, tokenIndex1240, depth1240
-					if buffer[position] != rune('C') {
-						goto l1238
-					}
-					position++
-				}
-			l1240:
-				{
-					position1242, tokenIndex1242, depth1242 := position, tokenIndex, depth
-					if buffer[position] != rune('a') {
-						goto l1243
-					}
-					position++
-					goto l1242
-				l1243:
-					position, tokenIndex, depth = position1242, tokenIndex1242, depth1242
-					if buffer[position] != rune('A') {
-						goto l1238
-					}
-					position++
-				}
-			l1242:
-				{
-					position1244, tokenIndex1244, depth1244 := position, tokenIndex, depth
-					if buffer[position] != rune('s') {
-						goto l1245
-					}
-					position++
-					goto l1244
-				l1245:
-					position, tokenIndex, depth = position1244, tokenIndex1244, depth1244
-					if buffer[position] != rune('S') {
-						goto l1238
-					}
-					position++
-				}
-			l1244:
-				{
-					position1246, tokenIndex1246, depth1246 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1247
-					}
-					position++
-					goto l1246
-				l1247:
-					position, tokenIndex, depth = position1246, tokenIndex1246, depth1246
-					if buffer[position] != rune('E') {
-						goto l1238
-					}
-					position++
-				}
-			l1246:
-				if !_rules[rulesp]() {
-					goto l1238
-				}
-				if !_rules[ruleExpression]() {
-					goto l1238
-				}
-				{
-					position1248 := position
+					position1322 := position
 					depth++
-					if !_rules[rulesp]() {
-						goto l1238
-					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1238
-					}
-				l1249:
 					{
-						position1250, tokenIndex1250, depth1250 := position, tokenIndex, depth
+						position1323, tokenIndex1323, depth1323 := position, tokenIndex, depth
+						if !_rules[ruleRowValue]() {
+							goto l1324
+						}
 						if !_rules[rulesp]() {
-							goto l1250
+							goto l1324
 						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1250
+						if !_rules[ruleIsOp]() {
+							goto l1324
 						}
-						goto l1249
-					l1250:
-						position, tokenIndex, depth = position1250, tokenIndex1250, depth1250
-					}
-					{
-						position1251, tokenIndex1251, depth1251 := position, tokenIndex, depth
 						if !_rules[rulesp]() {
-							goto l1251
+							goto l1324
+						}
+						if !_rules[ruleMissing]() {
+							goto l1324
+						}
+						goto l1323
+					l1324:
+						position, tokenIndex, depth = position1323, tokenIndex1323, depth1323
+						if !_rules[ruletermExpr]() {
+							goto l1320
 						}
 						{
-							position1253, tokenIndex1253, depth1253 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1254
+							position1325, tokenIndex1325, depth1325 := position, tokenIndex, depth
+							if !_rules[rulesp]() {
+								goto l1325
 							}
-							position++
-							goto l1253
-						l1254:
-							position, tokenIndex, depth = position1253, tokenIndex1253, depth1253
-							if buffer[position] != rune('E') {
-								goto l1251
+							if !_rules[ruleIsOp]() {
+								goto l1325
 							}
-							position++
-						}
-					l1253:
-						{
-							position1255, tokenIndex1255, depth1255 := position, tokenIndex, depth
-							if buffer[position] != rune('l') {
-								goto l1256
+							if !_rules[rulesp]() {
+								goto l1325
 							}
-							position++
-							goto l1255
-						l1256:
-							position, tokenIndex, depth = position1255, tokenIndex1255, depth1255
-							if buffer[position] != rune('L') {
-								goto l1251
+							if !_rules[ruleNullLiteral]() {
+								goto l1325
 							}
-							position++
+							goto l1326
+						l1325:
+							position, tokenIndex, depth = position1325, tokenIndex1325, depth1325
 						}
-					l1255:
-						{
-							position1257, tokenIndex1257, depth1257 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l1258
-							}
-							position++
-							goto l1257
-						l1258:
-							position, tokenIndex, depth = position1257, tokenIndex1257, depth1257
-							if buffer[position] != rune('S') {
-								goto l1251
-							}
-							position++
-						}
-					l1257:
-						{
-							position1259, tokenIndex1259, depth1259 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l1260
-							}
-							position++
-							goto l1259
-						l1260:
-							position, tokenIndex, depth = position1259, tokenIndex1259, depth1259
-							if buffer[position] != rune('E') {
-								goto l1251
-							}
-							position++
-						}
-					l1259:
-						if !_rules[rulesp]() {
-							goto l1251
-						}
-						if !_rules[ruleExpression]() {
-							goto l1251
-						}
-						goto l1252
-					l1251:
-						position, tokenIndex, depth = position1251, tokenIndex1251, depth1251
-					}
-				l1252:
-					if !_rules[rulesp]() {
-						goto l1238
+					l1326:
 					}
-					{
-						position1261, tokenIndex1261, depth1261 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1262
-						}
-						position++
-						goto l1261
-					l1262:
-						position, tokenIndex, depth = position1261, tokenIndex1261, depth1261
-						if buffer[position] != rune('E') {
-							goto l1238
-						}
-						position++
+				l1323:
+					depth--
+					add(rulePegText, position1322)
+				}
+				if !_rules[ruleAction74]() {
+					goto l1320
+				}
+				depth--
+				add(ruleisExpr, position1321)
+			}
+			return true
+		l1320:
+			position, tokenIndex, depth = position1320, tokenIndex1320, depth1320
+			return false
+		},
+		/* 97 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action75)> */
+		func() bool {
+			position1327, tokenIndex1327, depth1327 := position, tokenIndex, depth
+			{
+				position1328 := position
+				depth++
+				{
+					position1329 := position
+					depth++
+					if !_rules[ruleproductExpr]() {
+						goto l1327
 					}
-				l1261:
+				l1330:
 					{
-						position1263, tokenIndex1263, depth1263 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1264
+						position1331, tokenIndex1331, depth1331 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1331
 						}
-						position++
-						goto l1263
-					l1264:
-						position, tokenIndex, depth = position1263, tokenIndex1263, depth1263
-						if buffer[position] != rune('N') {
-							goto l1238
+						if !_rules[rulePlusMinusOp]() {
+							goto l1331
 						}
-						position++
-					}
-				l1263:
-					{
-						position1265, tokenIndex1265, depth1265 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1266
+						if !_rules[rulespOpt]() {
+							goto l1331
 						}
-						position++
-						goto l1265
-					l1266:
-						position, tokenIndex, depth = position1265, tokenIndex1265, depth1265
-						if buffer[position] != rune('D') {
-							goto l1238
+						if !_rules[ruleproductExpr]() {
+							goto l1331
 						}
-						position++
+						goto l1330
+					l1331:
+						position, tokenIndex, depth = position1331, tokenIndex1331, depth1331
 					}
-				l1265:
 					depth--
-					add(rulePegText, position1248)
+					add(rulePegText, position1329)
 				}
 				if !_rules[ruleAction75]() {
-					goto l1238
+					goto l1327
 				}
 				depth--
-				add(ruleExpressionCase, position1239)
+				add(ruletermExpr, position1328)
 			}
 			return true
-		l1238:
-			position, tokenIndex, depth = position1238, tokenIndex1238, depth1238
+		l1327:
+			position, tokenIndex, depth = position1327, tokenIndex1327, depth1327
 			return false
 		},
-		/* 98 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action76)> */
+		/* 98 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action76)> */
 		func() bool {
-			position1267, tokenIndex1267, depth1267 := position, tokenIndex, depth
+			position1332, tokenIndex1332, depth1332 := position, tokenIndex, depth
 			{
-				position1268 := position
+				position1333 := position
 				depth++
 				{
-					position1269, tokenIndex1269, depth1269 := position, tokenIndex, depth
-					if buffer[position] != rune('w') {
-						goto l1270
-					}
-					position++
-					goto l1269
-				l1270:
-					position, tokenIndex, depth = position1269, tokenIndex1269, depth1269
-					if buffer[position] != rune('W') {
-						goto l1267
-					}
-					position++
-				}
-			l1269:
-				{
-					position1271, tokenIndex1271, depth1271 := position, tokenIndex, depth
-					if buffer[position] != rune('h') {
-						goto l1272
-					}
-					position++
-					goto l1271
-				l1272:
-					position, tokenIndex, depth = position1271, tokenIndex1271, depth1271
-					if buffer[position] != rune('H') {
-						goto l1267
-					}
-					position++
-				}
-			l1271:
-				{
-					position1273, tokenIndex1273, depth1273 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1274
-					}
-					position++
-					goto l1273
-				l1274:
-					position, tokenIndex, depth = position1273, tokenIndex1273, depth1273
-					if buffer[position] != rune('E') {
-						goto l1267
-					}
-					position++
-				}
-			l1273:
-				{
-					position1275, tokenIndex1275, depth1275 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l1276
-					}
-					position++
-					goto l1275
-				l1276:
-					position, tokenIndex, depth = position1275, tokenIndex1275, depth1275
-					if buffer[position] != rune('N') {
-						goto l1267
-					}
-					position++
-				}
-			l1275:
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				if !_rules[ruleExpression]() {
-					goto l1267
-				}
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				{
-					position1277, tokenIndex1277, depth1277 := position, tokenIndex, depth
-					if buffer[position] != rune('t') {
-						goto l1278
-					}
-					position++
-					goto l1277
-				l1278:
-					position, tokenIndex, depth = position1277, tokenIndex1277, depth1277
-					if buffer[position] != rune('T') {
-						goto l1267
-					}
-					position++
-				}
-			l1277:
-				{
-					position1279, tokenIndex1279, depth1279 := position, tokenIndex, depth
-					if buffer[position] != rune('h') {
-						goto l1280
-					}
-					position++
-					goto l1279
-				l1280:
-					position, tokenIndex, depth = position1279, tokenIndex1279, depth1279
-					if buffer[position] != rune('H') {
-						goto l1267
-					}
-					position++
-				}
-			l1279:
-				{
-					position1281, tokenIndex1281, depth1281 := position, tokenIndex, depth
-					if buffer[position] != rune('e') {
-						goto l1282
-					}
-					position++
-					goto l1281
-				l1282:
-					position, tokenIndex, depth = position1281, tokenIndex1281, depth1281
-					if buffer[position] != rune('E') {
-						goto l1267
-					}
-					position++
-				}
-			l1281:
-				{
-					position1283, tokenIndex1283, depth1283 := position, tokenIndex, depth
-					if buffer[position] != rune('n') {
-						goto l1284
+					position1334 := position
+					depth++
+					if !_rules[ruleminusExpr]() {
+						goto l1332
 					}
-					position++
-					goto l1283
-				l1284:
-					position, tokenIndex, depth = position1283, tokenIndex1283, depth1283
-					if buffer[position] != rune('N') {
-						goto l1267
+				l1335:
+					{
+						position1336, tokenIndex1336, depth1336 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1336
+						}
+						if !_rules[ruleMultDivOp]() {
+							goto l1336
+						}
+						if !_rules[rulespOpt]() {
+							goto l1336
+						}
+						if !_rules[ruleminusExpr]() {
+							goto l1336
+						}
+						goto l1335
+					l1336:
+						position, tokenIndex, depth = position1336, tokenIndex1336, depth1336
 					}
-					position++
-				}
-			l1283:
-				if !_rules[rulesp]() {
-					goto l1267
-				}
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1267
+					depth--
+					add(rulePegText, position1334)
 				}
 				if !_rules[ruleAction76]() {
-					goto l1267
+					goto l1332
 				}
 				depth--
-				add(ruleWhenThenPair, position1268)
+				add(ruleproductExpr, position1333)
 			}
 			return true
-		l1267:
-			position, tokenIndex, depth = position1267, tokenIndex1267, depth1267
+		l1332:
+			position, tokenIndex, depth = position1332, tokenIndex1332, depth1332
 			return false
 		},
-		/* 99 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 99 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action77)> */
 		func() bool {
-			position1285, tokenIndex1285, depth1285 := position, tokenIndex, depth
+			position1337, tokenIndex1337, depth1337 := position, tokenIndex, depth
 			{
-				position1286 := position
+				position1338 := position
 				depth++
 				{
-					position1287, tokenIndex1287, depth1287 := position, tokenIndex, depth
-					if !_rules[ruleFloatLiteral]() {
-						goto l1288
-					}
-					goto l1287
-				l1288:
-					position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
-					if !_rules[ruleNumericLiteral]() {
-						goto l1289
+					position1339 := position
+					depth++
+					{
+						position1340, tokenIndex1340, depth1340 := position, tokenIndex, depth
+						if !_rules[ruleUnaryMinus]() {
+							goto l1340
+						}
+						if !_rules[rulespOpt]() {
+							goto l1340
+						}
+						goto l1341
+					l1340:
+						position, tokenIndex, depth = position1340, tokenIndex1340, depth1340
 					}
-					goto l1287
-				l1289:
-					position, tokenIndex, depth = position1287, tokenIndex1287, depth1287
-					if !_rules[ruleStringLiteral]() {
-						goto l1285
+				l1341:
+					if !_rules[rulecastExpr]() {
+						goto l1337
 					}
+					depth--
+					add(rulePegText, position1339)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1337
 				}
-			l1287:
 				depth--
-				add(ruleLiteral, position1286)
+				add(ruleminusExpr, position1338)
 			}
 			return true
-		l1285:
-			position, tokenIndex, depth = position1285, tokenIndex1285, depth1285
+		l1337:
+			position, tokenIndex, depth = position1337, tokenIndex1337, depth1337
 			return false
 		},
-		/* 100 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 100 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action78)> */
 		func() bool {
-			position1290, tokenIndex1290, depth1290 := position, tokenIndex, depth
+			position1342, tokenIndex1342, depth1342 := position, tokenIndex, depth
 			{
-				position1291 := position
+				position1343 := position
 				depth++
 				{
-					position1292, tokenIndex1292, depth1292 := position, tokenIndex, depth
-					if !_rules[ruleEqual]() {
-						goto l1293
-					}
-					goto l1292
-				l1293:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleNotEqual]() {
-						goto l1294
-					}
-					goto l1292
-				l1294:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleLessOrEqual]() {
-						goto l1295
-					}
-					goto l1292
-				l1295:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleLess]() {
-						goto l1296
-					}
-					goto l1292
-				l1296:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleGreaterOrEqual]() {
-						goto l1297
-					}
-					goto l1292
-				l1297:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleGreater]() {
-						goto l1298
+					position1344 := position
+					depth++
+					if !_rules[rulebaseExpr]() {
+						goto l1342
 					}
-					goto l1292
-				l1298:
-					position, tokenIndex, depth = position1292, tokenIndex1292, depth1292
-					if !_rules[ruleNotEqual]() {
-						goto l1290
+					{
+						position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1345
+						}
+						if buffer[position] != rune(':') {
+							goto l1345
+						}
+						position++
+						if buffer[position] != rune(':') {
+							goto l1345
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1345
+						}
+						if !_rules[ruleType]() {
+							goto l1345
+						}
+						goto l1346
+					l1345:
+						position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
 					}
+				l1346:
+					depth--
+					add(rulePegText, position1344)
 				}
-			l1292:
-				depth--
-				add(ruleComparisonOp, position1291)
-			}
-			return true
-		l1290:
-			position, tokenIndex, depth = position1290, tokenIndex1290, depth1290
-			return false
-		},
-		/* 101 OtherOp <- <Concat> */
-		func() bool {
-			position1299, tokenIndex1299, depth1299 := position, tokenIndex, depth
-			{
-				position1300 := position
-				depth++
-				if !_rules[ruleConcat]() {
-					goto l1299
+				if !_rules[ruleAction78]() {
+					goto l1342
 				}
 				depth--
-				add(ruleOtherOp, position1300)
+				add(rulecastExpr, position1343)
 			}
 			return true
-		l1299:
-			position, tokenIndex, depth = position1299, tokenIndex1299, depth1299
+		l1342:
+			position, tokenIndex, depth = position1342, tokenIndex1342, depth1342
 			return false
 		},
-		/* 102 IsOp <- <(IsNot / Is)> */
+		/* 101 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncApp / RowValue / ArrayExpr / Literal)> */
 		func() bool {
-			position1301, tokenIndex1301, depth1301 := position, tokenIndex, depth
+			position1347, tokenIndex1347, depth1347 := position, tokenIndex, depth
 			{
-				position1302 := position
+				position1348 := position
 				depth++
 				{
-					position1303, tokenIndex1303, depth1303 := position, tokenIndex, depth
-					if !_rules[ruleIsNot]() {
-						goto l1304
+					position1349, tokenIndex1349, depth1349 := position, tokenIndex, depth
+					if buffer[position] != rune('(') {
+						goto l1350
 					}
-					goto l1303
-				l1304:
-					position, tokenIndex, depth = position1303, tokenIndex1303, depth1303
-					if !_rules[ruleIs]() {
-						goto l1301
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1350
 					}
-				}
-			l1303:
+					if !_rules[ruleExpression]() {
+						goto l1350
+					}
+					if !_rules[rulespOpt]() {
+						goto l1350
+					}
+					if buffer[position] != rune(')') {
+						goto l1350
+					}
+					position++
+					goto l1349
+				l1350:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleMapExpr]() {
+						goto l1351
+					}
+					goto l1349
+				l1351:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1352
+					}
+					goto l1349
+				l1352:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleNullLiteral]() {
+						goto l1353
+					}
+					goto l1349
+				l1353:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleCase]() {
+						goto l1354
+					}
+					goto l1349
+				l1354:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleRowMeta]() {
+						goto l1355
+					}
+					goto l1349
+				l1355:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleFuncTypeCast]() {
+						goto l1356
+					}
+					goto l1349
+				l1356:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleFuncApp]() {
+						goto l1357
+					}
+					goto l1349
+				l1357:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleRowValue]() {
+						goto l1358
+					}
+					goto l1349
+				l1358:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleArrayExpr]() {
+						goto l1359
+					}
+					goto l1349
+				l1359:
+					position, tokenIndex, depth = position1349, tokenIndex1349, depth1349
+					if !_rules[ruleLiteral]() {
+						goto l1347
+					}
+				}
+			l1349:
+				depth--
+				add(rulebaseExpr, position1348)
+			}
+			return true
+		l1347:
+			position, tokenIndex, depth = position1347, tokenIndex1347, depth1347
+			return false
+		},
+		/* 102 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action79)> */
+		func() bool {
+			position1360, tokenIndex1360, depth1360 := position, tokenIndex, depth
+			{
+				position1361 := position
+				depth++
+				{
+					position1362 := position
+					depth++
+					{
+						position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1364
+						}
+						position++
+						goto l1363
+					l1364:
+						position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
+						if buffer[position] != rune('C') {
+							goto l1360
+						}
+						position++
+					}
+				l1363:
+					{
+						position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1366
+						}
+						position++
+						goto l1365
+					l1366:
+						position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
+						if buffer[position] != rune('A') {
+							goto l1360
+						}
+						position++
+					}
+				l1365:
+					{
+						position1367, tokenIndex1367, depth1367 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1368
+						}
+						position++
+						goto l1367
+					l1368:
+						position, tokenIndex, depth = position1367, tokenIndex1367, depth1367
+						if buffer[position] != rune('S') {
+							goto l1360
+						}
+						position++
+					}
+				l1367:
+					{
+						position1369, tokenIndex1369, depth1369 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1370
+						}
+						position++
+						goto l1369
+					l1370:
+						position, tokenIndex, depth = position1369, tokenIndex1369, depth1369
+						if buffer[position] != rune('T') {
+							goto l1360
+						}
+						position++
+					}
+				l1369:
+					if !_rules[rulespOpt]() {
+						goto l1360
+					}
+					if buffer[position] != rune('(') {
+						goto l1360
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1360
+					}
+					if !_rules[ruleExpression]() {
+						goto l1360
+					}
+					if !_rules[rulesp]() {
+						goto l1360
+					}
+					{
+						position1371, tokenIndex1371, depth1371 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1372
+						}
+						position++
+						goto l1371
+					l1372:
+						position, tokenIndex, depth = position1371, tokenIndex1371, depth1371
+						if buffer[position] != rune('A') {
+							goto l1360
+						}
+						position++
+					}
+				l1371:
+					{
+						position1373, tokenIndex1373, depth1373 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1374
+						}
+						position++
+						goto l1373
+					l1374:
+						position, tokenIndex, depth = position1373, tokenIndex1373, depth1373
+						if buffer[position] != rune('S') {
+							goto l1360
+						}
+						position++
+					}
+				l1373:
+					if !_rules[rulesp]() {
+						goto l1360
+					}
+					if !_rules[ruleType]() {
+						goto l1360
+					}
+					if !_rules[rulespOpt]() {
+						goto l1360
+					}
+					if buffer[position] != rune(')') {
+						goto l1360
+					}
+					position++
+					depth--
+					add(rulePegText, position1362)
+				}
+				if !_rules[ruleAction79]() {
+					goto l1360
+				}
+				depth--
+				add(ruleFuncTypeCast, position1361)
+			}
+			return true
+		l1360:
+			position, tokenIndex, depth = position1360, tokenIndex1360, depth1360
+			return false
+		},
+		/* 103 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		func() bool {
+			position1375, tokenIndex1375, depth1375 := position, tokenIndex, depth
+			{
+				position1376 := position
+				depth++
+				{
+					position1377, tokenIndex1377, depth1377 := position, tokenIndex, depth
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1378
+					}
+					goto l1377
+				l1378:
+					position, tokenIndex, depth = position1377, tokenIndex1377, depth1377
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1375
+					}
+				}
+			l1377:
+				depth--
+				add(ruleFuncApp, position1376)
+			}
+			return true
+		l1375:
+			position, tokenIndex, depth = position1375, tokenIndex1375, depth1375
+			return false
+		},
+		/* 104 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action80)> */
+		func() bool {
+			position1379, tokenIndex1379, depth1379 := position, tokenIndex, depth
+			{
+				position1380 := position
+				depth++
+				if !_rules[ruleFunction]() {
+					goto l1379
+				}
+				if !_rules[rulespOpt]() {
+					goto l1379
+				}
+				if buffer[position] != rune('(') {
+					goto l1379
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1379
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1379
+				}
+				if !_rules[rulesp]() {
+					goto l1379
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1379
+				}
+				if !_rules[rulespOpt]() {
+					goto l1379
+				}
+				if buffer[position] != rune(')') {
+					goto l1379
+				}
+				position++
+				if !_rules[ruleAction80]() {
+					goto l1379
+				}
+				depth--
+				add(ruleFuncAppWithOrderBy, position1380)
+			}
+			return true
+		l1379:
+			position, tokenIndex, depth = position1379, tokenIndex1379, depth1379
+			return false
+		},
+		/* 105 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action81)> */
+		func() bool {
+			position1381, tokenIndex1381, depth1381 := position, tokenIndex, depth
+			{
+				position1382 := position
+				depth++
+				if !_rules[ruleFunction]() {
+					goto l1381
+				}
+				if !_rules[rulespOpt]() {
+					goto l1381
+				}
+				if buffer[position] != rune('(') {
+					goto l1381
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1381
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1381
+				}
+				{
+					position1383 := position
+					depth++
+					if !_rules[rulespOpt]() {
+						goto l1381
+					}
+					depth--
+					add(rulePegText, position1383)
+				}
+				if buffer[position] != rune(')') {
+					goto l1381
+				}
+				position++
+				if !_rules[ruleAction81]() {
+					goto l1381
+				}
+				depth--
+				add(ruleFuncAppWithoutOrderBy, position1382)
+			}
+			return true
+		l1381:
+			position, tokenIndex, depth = position1381, tokenIndex1381, depth1381
+			return false
+		},
+		/* 106 FuncParams <- <(<(FuncParam (spOpt ',' spOpt FuncParam)*)?> Action82)> */
+		func() bool {
+			position1384, tokenIndex1384, depth1384 := position, tokenIndex, depth
+			{
+				position1385 := position
+				depth++
+				{
+					position1386 := position
+					depth++
+					{
+						position1387, tokenIndex1387, depth1387 := position, tokenIndex, depth
+						if !_rules[ruleFuncParam]() {
+							goto l1387
+						}
+					l1389:
+						{
+							position1390, tokenIndex1390, depth1390 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1390
+							}
+							if buffer[position] != rune(',') {
+								goto l1390
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1390
+							}
+							if !_rules[ruleFuncParam]() {
+								goto l1390
+							}
+							goto l1389
+						l1390:
+							position, tokenIndex, depth = position1390, tokenIndex1390, depth1390
+						}
+						goto l1388
+					l1387:
+						position, tokenIndex, depth = position1387, tokenIndex1387, depth1387
+					}
+				l1388:
+					depth--
+					add(rulePegText, position1386)
+				}
+				if !_rules[ruleAction82]() {
+					goto l1384
+				}
+				depth--
+				add(ruleFuncParams, position1385)
+			}
+			return true
+		l1384:
+			position, tokenIndex, depth = position1384, tokenIndex1384, depth1384
+			return false
+		},
+		/* 107 FuncParam <- <(Lambda / ExpressionOrWildcard)> */
+		func() bool {
+			position1391, tokenIndex1391, depth1391 := position, tokenIndex, depth
+			{
+				position1392 := position
+				depth++
+				{
+					position1393, tokenIndex1393, depth1393 := position, tokenIndex, depth
+					if !_rules[ruleLambda]() {
+						goto l1394
+					}
+					goto l1393
+				l1394:
+					position, tokenIndex, depth = position1393, tokenIndex1393, depth1393
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1391
+					}
+				}
+			l1393:
+				depth--
+				add(ruleFuncParam, position1392)
+			}
+			return true
+		l1391:
+			position, tokenIndex, depth = position1391, tokenIndex1391, depth1391
+			return false
+		},
+		/* 108 Lambda <- <(Identifier spOpt ('-' '>') spOpt Expression Action83)> */
+		func() bool {
+			position1395, tokenIndex1395, depth1395 := position, tokenIndex, depth
+			{
+				position1396 := position
+				depth++
+				if !_rules[ruleIdentifier]() {
+					goto l1395
+				}
+				if !_rules[rulespOpt]() {
+					goto l1395
+				}
+				if buffer[position] != rune('-') {
+					goto l1395
+				}
+				position++
+				if buffer[position] != rune('>') {
+					goto l1395
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1395
+				}
+				if !_rules[ruleExpression]() {
+					goto l1395
+				}
+				if !_rules[ruleAction83]() {
+					goto l1395
+				}
+				depth--
+				add(ruleLambda, position1396)
+			}
+			return true
+		l1395:
+			position, tokenIndex, depth = position1395, tokenIndex1395, depth1395
+			return false
+		},
+		/* 109 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action84)> */
+		func() bool {
+			position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
+			{
+				position1398 := position
+				depth++
+				{
+					position1399 := position
+					depth++
+					{
+						position1400, tokenIndex1400, depth1400 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1401
+						}
+						position++
+						goto l1400
+					l1401:
+						position, tokenIndex, depth = position1400, tokenIndex1400, depth1400
+						if buffer[position] != rune('O') {
+							goto l1397
+						}
+						position++
+					}
+				l1400:
+					{
+						position1402, tokenIndex1402, depth1402 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1403
+						}
+						position++
+						goto l1402
+					l1403:
+						position, tokenIndex, depth = position1402, tokenIndex1402, depth1402
+						if buffer[position] != rune('R') {
+							goto l1397
+						}
+						position++
+					}
+				l1402:
+					{
+						position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1405
+						}
+						position++
+						goto l1404
+					l1405:
+						position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
+						if buffer[position] != rune('D') {
+							goto l1397
+						}
+						position++
+					}
+				l1404:
+					{
+						position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1407
+						}
+						position++
+						goto l1406
+					l1407:
+						position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
+						if buffer[position] != rune('E') {
+							goto l1397
+						}
+						position++
+					}
+				l1406:
+					{
+						position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1409
+						}
+						position++
+						goto l1408
+					l1409:
+						position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
+						if buffer[position] != rune('R') {
+							goto l1397
+						}
+						position++
+					}
+				l1408:
+					if !_rules[rulesp]() {
+						goto l1397
+					}
+					{
+						position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l1411
+						}
+						position++
+						goto l1410
+					l1411:
+						position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
+						if buffer[position] != rune('B') {
+							goto l1397
+						}
+						position++
+					}
+				l1410:
+					{
+						position1412, tokenIndex1412, depth1412 := position, tokenIndex, depth
+						if buffer[position] != rune('y') {
+							goto l1413
+						}
+						position++
+						goto l1412
+					l1413:
+						position, tokenIndex, depth = position1412, tokenIndex1412, depth1412
+						if buffer[position] != rune('Y') {
+							goto l1397
+						}
+						position++
+					}
+				l1412:
+					if !_rules[rulesp]() {
+						goto l1397
+					}
+					if !_rules[ruleSortedExpression]() {
+						goto l1397
+					}
+				l1414:
+					{
+						position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
+						if !_rules[rulespOpt]() {
+							goto l1415
+						}
+						if buffer[position] != rune(',') {
+							goto l1415
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1415
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1415
+						}
+						goto l1414
+					l1415:
+						position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
+					}
+					depth--
+					add(rulePegText, position1399)
+				}
+				if !_rules[ruleAction84]() {
+					goto l1397
+				}
+				depth--
+				add(ruleParamsOrder, position1398)
+			}
+			return true
+		l1397:
+			position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
+			return false
+		},
+		/* 110 SortedExpression <- <(Expression OrderDirectionOpt Action85)> */
+		func() bool {
+			position1416, tokenIndex1416, depth1416 := position, tokenIndex, depth
+			{
+				position1417 := position
+				depth++
+				if !_rules[ruleExpression]() {
+					goto l1416
+				}
+				if !_rules[ruleOrderDirectionOpt]() {
+					goto l1416
+				}
+				if !_rules[ruleAction85]() {
+					goto l1416
+				}
+				depth--
+				add(ruleSortedExpression, position1417)
+			}
+			return true
+		l1416:
+			position, tokenIndex, depth = position1416, tokenIndex1416, depth1416
+			return false
+		},
+		/* 111 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action86)> */
+		func() bool {
+			position1418, tokenIndex1418, depth1418 := position, tokenIndex, depth
+			{
+				position1419 := position
+				depth++
+				{
+					position1420 := position
+					depth++
+					{
+						position1421, tokenIndex1421, depth1421 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1421
+						}
+						{
+							position1423, tokenIndex1423, depth1423 := position, tokenIndex, depth
+							if !_rules[ruleAscending]() {
+								goto l1424
+							}
+							goto l1423
+						l1424:
+							position, tokenIndex, depth = position1423, tokenIndex1423, depth1423
+							if !_rules[ruleDescending]() {
+								goto l1421
+							}
+						}
+					l1423:
+						goto l1422
+					l1421:
+						position, tokenIndex, depth = position1421, tokenIndex1421, depth1421
+					}
+				l1422:
+					depth--
+					add(rulePegText, position1420)
+				}
+				if !_rules[ruleAction86]() {
+					goto l1418
+				}
+				depth--
+				add(ruleOrderDirectionOpt, position1419)
+			}
+			return true
+		l1418:
+			position, tokenIndex, depth = position1418, tokenIndex1418, depth1418
+			return false
+		},
+		/* 112 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action87)> */
+		func() bool {
+			position1425, tokenIndex1425, depth1425 := position, tokenIndex, depth
+			{
+				position1426 := position
+				depth++
+				{
+					position1427 := position
+					depth++
+					if buffer[position] != rune('[') {
+						goto l1425
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1425
+					}
+					{
+						position1428, tokenIndex1428, depth1428 := position, tokenIndex, depth
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1428
+						}
+					l1430:
+						{
+							position1431, tokenIndex1431, depth1431 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1431
+							}
+							if buffer[position] != rune(',') {
+								goto l1431
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1431
+							}
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1431
+							}
+							goto l1430
+						l1431:
+							position, tokenIndex, depth = position1431, tokenIndex1431, depth1431
+						}
+						goto l1429
+					l1428:
+						position, tokenIndex, depth = position1428, tokenIndex1428, depth1428
+					}
+				l1429:
+					if !_rules[rulespOpt]() {
+						goto l1425
+					}
+					{
+						position1432, tokenIndex1432, depth1432 := position, tokenIndex, depth
+						if buffer[position] != rune(',') {
+							goto l1432
+						}
+						position++
+						goto l1433
+					l1432:
+						position, tokenIndex, depth = position1432, tokenIndex1432, depth1432
+					}
+				l1433:
+					if !_rules[rulespOpt]() {
+						goto l1425
+					}
+					if buffer[position] != rune(']') {
+						goto l1425
+					}
+					position++
+					depth--
+					add(rulePegText, position1427)
+				}
+				if !_rules[ruleAction87]() {
+					goto l1425
+				}
+				depth--
+				add(ruleArrayExpr, position1426)
+			}
+			return true
+		l1425:
+			position, tokenIndex, depth = position1425, tokenIndex1425, depth1425
+			return false
+		},
+		/* 113 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action88)> */
+		func() bool {
+			position1434, tokenIndex1434, depth1434 := position, tokenIndex, depth
+			{
+				position1435 := position
+				depth++
+				{
+					position1436 := position
+					depth++
+					if buffer[position] != rune('{') {
+						goto l1434
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1434
+					}
+					{
+						position1437, tokenIndex1437, depth1437 := position, tokenIndex, depth
+						if !_rules[ruleKeyValuePair]() {
+							goto l1437
+						}
+					l1439:
+						{
+							position1440, tokenIndex1440, depth1440 := position, tokenIndex, depth
+							if !_rules[rulespOpt]() {
+								goto l1440
+							}
+							if buffer[position] != rune(',') {
+								goto l1440
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1440
+							}
+							if !_rules[ruleKeyValuePair]() {
+								goto l1440
+							}
+							goto l1439
+						l1440:
+							position, tokenIndex, depth = position1440, tokenIndex1440, depth1440
+						}
+						goto l1438
+					l1437:
+						position, tokenIndex, depth = position1437, tokenIndex1437, depth1437
+					}
+				l1438:
+					if !_rules[rulespOpt]() {
+						goto l1434
+					}
+					if buffer[position] != rune('}') {
+						goto l1434
+					}
+					position++
+					depth--
+					add(rulePegText, position1436)
+				}
+				if !_rules[ruleAction88]() {
+					goto l1434
+				}
+				depth--
+				add(ruleMapExpr, position1435)
+			}
+			return true
+		l1434:
+			position, tokenIndex, depth = position1434, tokenIndex1434, depth1434
+			return false
+		},
+		/* 114 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action89)> */
+		func() bool {
+			position1441, tokenIndex1441, depth1441 := position, tokenIndex, depth
+			{
+				position1442 := position
+				depth++
+				{
+					position1443 := position
+					depth++
+					if !_rules[ruleStringLiteral]() {
+						goto l1441
+					}
+					if !_rules[rulespOpt]() {
+						goto l1441
+					}
+					if buffer[position] != rune(':') {
+						goto l1441
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1441
+					}
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1441
+					}
+					depth--
+					add(rulePegText, position1443)
+				}
+				if !_rules[ruleAction89]() {
+					goto l1441
+				}
+				depth--
+				add(ruleKeyValuePair, position1442)
+			}
+			return true
+		l1441:
+			position, tokenIndex, depth = position1441, tokenIndex1441, depth1441
+			return false
+		},
+		/* 115 Case <- <(ConditionCase / ExpressionCase)> */
+		func() bool {
+			position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
+			{
+				position1445 := position
+				depth++
+				{
+					position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
+					if !_rules[ruleConditionCase]() {
+						goto l1447
+					}
+					goto l1446
+				l1447:
+					position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
+					if !_rules[ruleExpressionCase]() {
+						goto l1444
+					}
+				}
+			l1446:
+				depth--
+				add(ruleCase, position1445)
+			}
+			return true
+		l1444:
+			position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
+			return false
+		},
+		/* 116 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action90)> */
+		func() bool {
+			position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
+			{
+				position1449 := position
+				depth++
+				{
+					position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l1451
+					}
+					position++
+					goto l1450
+				l1451:
+					position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
+					if buffer[position] != rune('C') {
+						goto l1448
+					}
+					position++
+				}
+			l1450:
+				{
+					position1452, tokenIndex1452, depth1452 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1453
+					}
+					position++
+					goto l1452
+				l1453:
+					position, tokenIndex, depth = position1452, tokenIndex1452, depth1452
+					if buffer[position] != rune('A') {
+						goto l1448
+					}
+					position++
+				}
+			l1452:
+				{
+					position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1455
+					}
+					position++
+					goto l1454
+				l1455:
+					position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
+					if buffer[position] != rune('S') {
+						goto l1448
+					}
+					position++
+				}
+			l1454:
+				{
+					position1456, tokenIndex1456, depth1456 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1457
+					}
+					position++
+					goto l1456
+				l1457:
+					position, tokenIndex, depth = position1456, tokenIndex1456, depth1456
+					if buffer[position] != rune('E') {
+						goto l1448
+					}
+					position++
+				}
+			l1456:
+				{
+					position1458 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1448
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1448
+					}
+				l1459:
+					{
+						position1460, tokenIndex1460, depth1460 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1460
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1460
+						}
+						goto l1459
+					l1460:
+						position, tokenIndex, depth = position1460, tokenIndex1460, depth1460
+					}
+					{
+						position1461, tokenIndex1461, depth1461 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1461
+						}
+						{
+							position1463, tokenIndex1463, depth1463 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1464
+							}
+							position++
+							goto l1463
+						l1464:
+							position, tokenIndex, depth = position1463, tokenIndex1463, depth1463
+							if buffer[position] != rune('E') {
+								goto l1461
+							}
+							position++
+						}
+					l1463:
+						{
+							position1465, tokenIndex1465, depth1465 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1466
+							}
+							position++
+							goto l1465
+						l1466:
+							position, tokenIndex, depth = position1465, tokenIndex1465, depth1465
+							if buffer[position] != rune('L') {
+								goto l1461
+							}
+							position++
+						}
+					l1465:
+						{
+							position1467, tokenIndex1467, depth1467 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1468
+							}
+							position++
+							goto l1467
+						l1468:
+							position, tokenIndex, depth = position1467, tokenIndex1467, depth1467
+							if buffer[position] != rune('S') {
+								goto l1461
+							}
+							position++
+						}
+					l1467:
+						{
+							position1469, tokenIndex1469, depth1469 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1470
+							}
+							position++
+							goto l1469
+						l1470:
+							position, tokenIndex, depth = position1469, tokenIndex1469, depth1469
+							if buffer[position] != rune('E') {
+								goto l1461
+							}
+							position++
+						}
+					l1469:
+						if !_rules[rulesp]() {
+							goto l1461
+						}
+						if !_rules[ruleExpression]() {
+							goto l1461
+						}
+						goto l1462
+					l1461:
+						position, tokenIndex, depth = position1461, tokenIndex1461, depth1461
+					}
+				l1462:
+					if !_rules[rulesp]() {
+						goto l1448
+					}
+					{
+						position1471, tokenIndex1471, depth1471 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1472
+						}
+						position++
+						goto l1471
+					l1472:
+						position, tokenIndex, depth = position1471, tokenIndex1471, depth1471
+						if buffer[position] != rune('E') {
+							goto l1448
+						}
+						position++
+					}
+				l1471:
+					{
+						position1473, tokenIndex1473, depth1473 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1474
+						}
+						position++
+						goto l1473
+					l1474:
+						position, tokenIndex, depth = position1473, tokenIndex1473, depth1473
+						if buffer[position] != rune('N') {
+							goto l1448
+						}
+						position++
+					}
+				l1473:
+					{
+						position1475, tokenIndex1475, depth1475 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1476
+						}
+						position++
+						goto l1475
+					l1476:
+						position, tokenIndex, depth = position1475, tokenIndex1475, depth1475
+						if buffer[position] != rune('D') {
+							goto l1448
+						}
+						position++
+					}
+				l1475:
+					depth--
+					add(rulePegText, position1458)
+				}
+				if !_rules[ruleAction90]() {
+					goto l1448
+				}
+				depth--
+				add(ruleConditionCase, position1449)
+			}
+			return true
+		l1448:
+			position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
+			return false
+		},
+		/* 117 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action91)> */
+		func() bool {
+			position1477, tokenIndex1477, depth1477 := position, tokenIndex, depth
+			{
+				position1478 := position
+				depth++
+				{
+					position1479, tokenIndex1479, depth1479 := position, tokenIndex, depth
+					if buffer[position] != rune('c') {
+						goto l1480
+					}
+					position++
+					goto l1479
+				l1480:
+					position, tokenIndex, depth = position1479, tokenIndex1479, depth1479
+					if buffer[position] != rune('C') {
+						goto l1477
+					}
+					position++
+				}
+			l1479:
+				{
+					position1481, tokenIndex1481, depth1481 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l1482
+					}
+					position++
+					goto l1481
+				l1482:
+					position, tokenIndex, depth = position1481, tokenIndex1481, depth1481
+					if buffer[position] != rune('A') {
+						goto l1477
+					}
+					position++
+				}
+			l1481:
+				{
+					position1483, tokenIndex1483, depth1483 := position, tokenIndex, depth
+					if buffer[position] != rune('s') {
+						goto l1484
+					}
+					position++
+					goto l1483
+				l1484:
+					position, tokenIndex, depth = position1483, tokenIndex1483, depth1483
+					if buffer[position] != rune('S') {
+						goto l1477
+					}
+					position++
+				}
+			l1483:
+				{
+					position1485, tokenIndex1485, depth1485 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1486
+					}
+					position++
+					goto l1485
+				l1486:
+					position, tokenIndex, depth = position1485, tokenIndex1485, depth1485
+					if buffer[position] != rune('E') {
+						goto l1477
+					}
+					position++
+				}
+			l1485:
+				if !_rules[rulesp]() {
+					goto l1477
+				}
+				if !_rules[ruleExpression]() {
+					goto l1477
+				}
+				{
+					position1487 := position
+					depth++
+					if !_rules[rulesp]() {
+						goto l1477
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1477
+					}
+				l1488:
+					{
+						position1489, tokenIndex1489, depth1489 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1489
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1489
+						}
+						goto l1488
+					l1489:
+						position, tokenIndex, depth = position1489, tokenIndex1489, depth1489
+					}
+					{
+						position1490, tokenIndex1490, depth1490 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1490
+						}
+						{
+							position1492, tokenIndex1492, depth1492 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1493
+							}
+							position++
+							goto l1492
+						l1493:
+							position, tokenIndex, depth = position1492, tokenIndex1492, depth1492
+							if buffer[position] != rune('E') {
+								goto l1490
+							}
+							position++
+						}
+					l1492:
+						{
+							position1494, tokenIndex1494, depth1494 := position, tokenIndex, depth
+							if buffer[position] != rune('l') {
+								goto l1495
+							}
+							position++
+							goto l1494
+						l1495:
+							position, tokenIndex, depth = position1494, tokenIndex1494, depth1494
+							if buffer[position] != rune('L') {
+								goto l1490
+							}
+							position++
+						}
+					l1494:
+						{
+							position1496, tokenIndex1496, depth1496 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l1497
+							}
+							position++
+							goto l1496
+						l1497:
+							position, tokenIndex, depth = position1496, tokenIndex1496, depth1496
+							if buffer[position] != rune('S') {
+								goto l1490
+							}
+							position++
+						}
+					l1496:
+						{
+							position1498, tokenIndex1498, depth1498 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1499
+							}
+							position++
+							goto l1498
+						l1499:
+							position, tokenIndex, depth = position1498, tokenIndex1498, depth1498
+							if buffer[position] != rune('E') {
+								goto l1490
+							}
+							position++
+						}
+					l1498:
+						if !_rules[rulesp]() {
+							goto l1490
+						}
+						if !_rules[ruleExpression]() {
+							goto l1490
+						}
+						goto l1491
+					l1490:
+						position, tokenIndex, depth = position1490, tokenIndex1490, depth1490
+					}
+				l1491:
+					if !_rules[rulesp]() {
+						goto l1477
+					}
+					{
+						position1500, tokenIndex1500, depth1500 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1501
+						}
+						position++
+						goto l1500
+					l1501:
+						position, tokenIndex, depth = position1500, tokenIndex1500, depth1500
+						if buffer[position] != rune('E') {
+							goto l1477
+						}
+						position++
+					}
+				l1500:
+					{
+						position1502, tokenIndex1502, depth1502 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1503
+						}
+						position++
+						goto l1502
+					l1503:
+						position, tokenIndex, depth = position1502, tokenIndex1502, depth1502
+						if buffer[position] != rune('N') {
+							goto l1477
+						}
+						position++
+					}
+				l1502:
+					{
+						position1504, tokenIndex1504, depth1504 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1505
+						}
+						position++
+						goto l1504
+					l1505:
+						position, tokenIndex, depth = position1504, tokenIndex1504, depth1504
+						if buffer[position] != rune('D') {
+							goto l1477
+						}
+						position++
+					}
+				l1504:
+					depth--
+					add(rulePegText, position1487)
+				}
+				if !_rules[ruleAction91]() {
+					goto l1477
+				}
+				depth--
+				add(ruleExpressionCase, position1478)
+			}
+			return true
+		l1477:
+			position, tokenIndex, depth = position1477, tokenIndex1477, depth1477
+			return false
+		},
+		/* 118 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action92)> */
+		func() bool {
+			position1506, tokenIndex1506, depth1506 := position, tokenIndex, depth
+			{
+				position1507 := position
+				depth++
+				{
+					position1508, tokenIndex1508, depth1508 := position, tokenIndex, depth
+					if buffer[position] != rune('w') {
+						goto l1509
+					}
+					position++
+					goto l1508
+				l1509:
+					position, tokenIndex, depth = position1508, tokenIndex1508, depth1508
+					if buffer[position] != rune('W') {
+						goto l1506
+					}
+					position++
+				}
+			l1508:
+				{
+					position1510, tokenIndex1510, depth1510 := position, tokenIndex, depth
+					if buffer[position] != rune('h') {
+						goto l1511
+					}
+					position++
+					goto l1510
+				l1511:
+					position, tokenIndex, depth = position1510, tokenIndex1510, depth1510
+					if buffer[position] != rune('H') {
+						goto l1506
+					}
+					position++
+				}
+			l1510:
+				{
+					position1512, tokenIndex1512, depth1512 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1513
+					}
+					position++
+					goto l1512
+				l1513:
+					position, tokenIndex, depth = position1512, tokenIndex1512, depth1512
+					if buffer[position] != rune('E') {
+						goto l1506
+					}
+					position++
+				}
+			l1512:
+				{
+					position1514, tokenIndex1514, depth1514 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1515
+					}
+					position++
+					goto l1514
+				l1515:
+					position, tokenIndex, depth = position1514, tokenIndex1514, depth1514
+					if buffer[position] != rune('N') {
+						goto l1506
+					}
+					position++
+				}
+			l1514:
+				if !_rules[rulesp]() {
+					goto l1506
+				}
+				if !_rules[ruleExpression]() {
+					goto l1506
+				}
+				if !_rules[rulesp]() {
+					goto l1506
+				}
+				{
+					position1516, tokenIndex1516, depth1516 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l1517
+					}
+					position++
+					goto l1516
+				l1517:
+					position, tokenIndex, depth = position1516, tokenIndex1516, depth1516
+					if buffer[position] != rune('T') {
+						goto l1506
+					}
+					position++
+				}
+			l1516:
+				{
+					position1518, tokenIndex1518, depth1518 := position, tokenIndex, depth
+					if buffer[position] != rune('h') {
+						goto l1519
+					}
+					position++
+					goto l1518
+				l1519:
+					position, tokenIndex, depth = position1518, tokenIndex1518, depth1518
+					if buffer[position] != rune('H') {
+						goto l1506
+					}
+					position++
+				}
+			l1518:
+				{
+					position1520, tokenIndex1520, depth1520 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l1521
+					}
+					position++
+					goto l1520
+				l1521:
+					position, tokenIndex, depth = position1520, tokenIndex1520, depth1520
+					if buffer[position] != rune('E') {
+						goto l1506
+					}
+					position++
+				}
+			l1520:
+				{
+					position1522, tokenIndex1522, depth1522 := position, tokenIndex, depth
+					if buffer[position] != rune('n') {
+						goto l1523
+					}
+					position++
+					goto l1522
+				l1523:
+					position, tokenIndex, depth = position1522, tokenIndex1522, depth1522
+					if buffer[position] != rune('N') {
+						goto l1506
+					}
+					position++
+				}
+			l1522:
+				if !_rules[rulesp]() {
+					goto l1506
+				}
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1506
+				}
+				if !_rules[ruleAction92]() {
+					goto l1506
+				}
+				depth--
+				add(ruleWhenThenPair, position1507)
+			}
+			return true
+		l1506:
+			position, tokenIndex, depth = position1506, tokenIndex1506, depth1506
+			return false
+		},
+		/* 119 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		func() bool {
+			position1524, tokenIndex1524, depth1524 := position, tokenIndex, depth
+			{
+				position1525 := position
+				depth++
+				{
+					position1526, tokenIndex1526, depth1526 := position, tokenIndex, depth
+					if !_rules[ruleFloatLiteral]() {
+						goto l1527
+					}
+					goto l1526
+				l1527:
+					position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
+					if !_rules[ruleNumericLiteral]() {
+						goto l1528
+					}
+					goto l1526
+				l1528:
+					position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
+					if !_rules[ruleStringLiteral]() {
+						goto l1524
+					}
+				}
+			l1526:
+				depth--
+				add(ruleLiteral, position1525)
+			}
+			return true
+		l1524:
+			position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
+			return false
+		},
+		/* 120 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		func() bool {
+			position1529, tokenIndex1529, depth1529 := position, tokenIndex, depth
+			{
+				position1530 := position
+				depth++
+				{
+					position1531, tokenIndex1531, depth1531 := position, tokenIndex, depth
+					if !_rules[ruleEqual]() {
+						goto l1532
+					}
+					goto l1531
+				l1532:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleNotEqual]() {
+						goto l1533
+					}
+					goto l1531
+				l1533:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleLessOrEqual]() {
+						goto l1534
+					}
+					goto l1531
+				l1534:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleLess]() {
+						goto l1535
+					}
+					goto l1531
+				l1535:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleGreaterOrEqual]() {
+						goto l1536
+					}
+					goto l1531
+				l1536:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleGreater]() {
+						goto l1537
+					}
+					goto l1531
+				l1537:
+					position, tokenIndex, depth = position1531, tokenIndex1531, depth1531
+					if !_rules[ruleNotEqual]() {
+						goto l1529
+					}
+				}
+			l1531:
+				depth--
+				add(ruleComparisonOp, position1530)
+			}
+			return true
+		l1529:
+			position, tokenIndex, depth = position1529, tokenIndex1529, depth1529
+			return false
+		},
+		/* 121 PatternOp <- <(NotLike / Like / NotILike / ILike / NotSimilarTo / SimilarTo)> */
+		func() bool {
+			position1538, tokenIndex1538, depth1538 := position, tokenIndex, depth
+			{
+				position1539 := position
+				depth++
+				{
+					position1540, tokenIndex1540, depth1540 := position, tokenIndex, depth
+					if !_rules[ruleNotLike]() {
+						goto l1541
+					}
+					goto l1540
+				l1541:
+					position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
+					if !_rules[ruleLike]() {
+						goto l1542
+					}
+					goto l1540
+				l1542:
+					position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
+					if !_rules[ruleNotILike]() {
+						goto l1543
+					}
+					goto l1540
+				l1543:
+					position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
+					if !_rules[ruleILike]() {
+						goto l1544
+					}
+					goto l1540
+				l1544:
+					position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
+					if !_rules[ruleNotSimilarTo]() {
+						goto l1545
+					}
+					goto l1540
+				l1545:
+					position, tokenIndex, depth = position1540, tokenIndex1540, depth1540
+					if !_rules[ruleSimilarTo]() {
+						goto l1538
+					}
+				}
+			l1540:
+				depth--
+				add(rulePatternOp, position1539)
+			}
+			return true
+		l1538:
+			position, tokenIndex, depth = position1538, tokenIndex1538, depth1538
+			return false
+		},
+		/* 122 OtherOp <- <Concat> */
+		func() bool {
+			position1546, tokenIndex1546, depth1546 := position, tokenIndex, depth
+			{
+				position1547 := position
+				depth++
+				if !_rules[ruleConcat]() {
+					goto l1546
+				}
+				depth--
+				add(ruleOtherOp, position1547)
+			}
+			return true
+		l1546:
+			position, tokenIndex, depth = position1546, tokenIndex1546, depth1546
+			return false
+		},
+		/* 123 IsOp <- <(IsNot / Is)> */
+		func() bool {
+			position1548, tokenIndex1548, depth1548 := position, tokenIndex, depth
+			{
+				position1549 := position
+				depth++
+				{
+					position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
+					if !_rules[ruleIsNot]() {
+						goto l1551
+					}
+					goto l1550
+				l1551:
+					position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
+					if !_rules[ruleIs]() {
+						goto l1548
+					}
+				}
+			l1550:
+				depth--
+				add(ruleIsOp, position1549)
+			}
+			return true
+		l1548:
+			position, tokenIndex, depth = position1548, tokenIndex1548, depth1548
+			return false
+		},
+		/* 124 PlusMinusOp <- <(Plus / Minus)> */
+		func() bool {
+			position1552, tokenIndex1552, depth1552 := position, tokenIndex, depth
+			{
+				position1553 := position
+				depth++
+				{
+					position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
+					if !_rules[rulePlus]() {
+						goto l1555
+					}
+					goto l1554
+				l1555:
+					position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
+					if !_rules[ruleMinus]() {
+						goto l1552
+					}
+				}
+			l1554:
+				depth--
+				add(rulePlusMinusOp, position1553)
+			}
+			return true
+		l1552:
+			position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
+			return false
+		},
+		/* 125 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		func() bool {
+			position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
+			{
+				position1557 := position
+				depth++
+				{
+					position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
+					if !_rules[ruleMultiply]() {
+						goto l1559
+					}
+					goto l1558
+				l1559:
+					position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
+					if !_rules[ruleDivide]() {
+						goto l1560
+					}
+					goto l1558
+				l1560:
+					position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
+					if !_rules[ruleModulo]() {
+						goto l1556
+					}
+				}
+			l1558:
+				depth--
+				add(ruleMultDivOp, position1557)
+			}
+			return true
+		l1556:
+			position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
+			return false
+		},
+		/* 126 Stream <- <(<ident> Action93)> */
+		func() bool {
+			position1561, tokenIndex1561, depth1561 := position, tokenIndex, depth
+			{
+				position1562 := position
+				depth++
+				{
+					position1563 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l1561
+					}
+					depth--
+					add(rulePegText, position1563)
+				}
+				if !_rules[ruleAction93]() {
+					goto l1561
+				}
+				depth--
+				add(ruleStream, position1562)
+			}
+			return true
+		l1561:
+			position, tokenIndex, depth = position1561, tokenIndex1561, depth1561
+			return false
+		},
+		/* 127 RowMeta <- <RowTimestamp> */
+		func() bool {
+			position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
+			{
+				position1565 := position
+				depth++
+				if !_rules[ruleRowTimestamp]() {
+					goto l1564
+				}
+				depth--
+				add(ruleRowMeta, position1565)
+			}
+			return true
+		l1564:
+			position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
+			return false
+		},
+		/* 128 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action94)> */
+		func() bool {
+			position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
+			{
+				position1567 := position
+				depth++
+				{
+					position1568 := position
+					depth++
+					{
+						position1569, tokenIndex1569, depth1569 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1569
+						}
+						if buffer[position] != rune(':') {
+							goto l1569
+						}
+						position++
+						goto l1570
+					l1569:
+						position, tokenIndex, depth = position1569, tokenIndex1569, depth1569
+					}
+				l1570:
+					if buffer[position] != rune('t') {
+						goto l1566
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l1566
+					}
+					position++
+					if buffer[position] != rune('(') {
+						goto l1566
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l1566
+					}
+					position++
+					depth--
+					add(rulePegText, position1568)
+				}
+				if !_rules[ruleAction94]() {
+					goto l1566
+				}
+				depth--
+				add(ruleRowTimestamp, position1567)
+			}
+			return true
+		l1566:
+			position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
+			return false
+		},
+		/* 129 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action95)> */
+		func() bool {
+			position1571, tokenIndex1571, depth1571 := position, tokenIndex, depth
+			{
+				position1572 := position
+				depth++
+				{
+					position1573 := position
+					depth++
+					{
+						position1574, tokenIndex1574, depth1574 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1574
+						}
+						if buffer[position] != rune(':') {
+							goto l1574
+						}
+						position++
+						{
+							position1576, tokenIndex1576, depth1576 := position, tokenIndex, depth
+							if buffer[position] != rune(':') {
+								goto l1576
+							}
+							position++
+							goto l1574
+						l1576:
+							position, tokenIndex, depth = position1576, tokenIndex1576, depth1576
+						}
+						goto l1575
+					l1574:
+						position, tokenIndex, depth = position1574, tokenIndex1574, depth1574
+					}
+				l1575:
+					if !_rules[rulejsonGetPath]() {
+						goto l1571
+					}
+					depth--
+					add(rulePegText, position1573)
+				}
+				if !_rules[ruleAction95]() {
+					goto l1571
+				}
+				depth--
+				add(ruleRowValue, position1572)
+			}
+			return true
+		l1571:
+			position, tokenIndex, depth = position1571, tokenIndex1571, depth1571
+			return false
+		},
+		/* 130 NumericLiteral <- <(<('-'? [0-9]+)> Action96)> */
+		func() bool {
+			position1577, tokenIndex1577, depth1577 := position, tokenIndex, depth
+			{
+				position1578 := position
+				depth++
+				{
+					position1579 := position
+					depth++
+					{
+						position1580, tokenIndex1580, depth1580 := position, tokenIndex, depth
+						if buffer[position] != rune('-') {
+							goto l1580
+						}
+						position++
+						goto l1581
+					l1580:
+						position, tokenIndex, depth = position1580, tokenIndex1580, depth1580
+					}
+				l1581:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1577
+					}
+					position++
+				l1582:
+					{
+						position1583, tokenIndex1583, depth1583 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1583
+						}
+						position++
+						goto l1582
+					l1583:
+						position, tokenIndex, depth = position1583, tokenIndex1583, depth1583
+					}
+					depth--
+					add(rulePegText, position1579)
+				}
+				if !_rules[ruleAction96]() {
+					goto l1577
+				}
+				depth--
+				add(ruleNumericLiteral, position1578)
+			}
+			return true
+		l1577:
+			position, tokenIndex, depth = position1577, tokenIndex1577, depth1577
+			return false
+		},
+		/* 131 NonNegativeNumericLiteral <- <(<[0-9]+> Action97)> */
+		func() bool {
+			position1584, tokenIndex1584, depth1584 := position, tokenIndex, depth
+			{
+				position1585 := position
+				depth++
+				{
+					position1586 := position
+					depth++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1584
+					}
+					position++
+				l1587:
+					{
+						position1588, tokenIndex1588, depth1588 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1588
+						}
+						position++
+						goto l1587
+					l1588:
+						position, tokenIndex, depth = position1588, tokenIndex1588, depth1588
+					}
+					depth--
+					add(rulePegText, position1586)
+				}
+				if !_rules[ruleAction97]() {
+					goto l1584
+				}
+				depth--
+				add(ruleNonNegativeNumericLiteral, position1585)
+			}
+			return true
+		l1584:
+			position, tokenIndex, depth = position1584, tokenIndex1584, depth1584
+			return false
+		},
+		/* 132 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action98)> */
+		func() bool {
+			position1589, tokenIndex1589, depth1589 := position, tokenIndex, depth
+			{
+				position1590 := position
+				depth++
+				{
+					position1591 := position
+					depth++
+					{
+						position1592, tokenIndex1592, depth1592 := position, tokenIndex, depth
+						if buffer[position] != rune('-') {
+							goto l1592
+						}
+						position++
+						goto l1593
+					l1592:
+						position, tokenIndex, depth = position1592, tokenIndex1592, depth1592
+					}
+				l1593:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1589
+					}
+					position++
+				l1594:
+					{
+						position1595, tokenIndex1595, depth1595 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1595
+						}
+						position++
+						goto l1594
+					l1595:
+						position, tokenIndex, depth = position1595, tokenIndex1595, depth1595
+					}
+					if buffer[position] != rune('.') {
+						goto l1589
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l1589
+					}
+					position++
+				l1596:
+					{
+						position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l1597
+						}
+						position++
+						goto l1596
+					l1597:
+						position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+					}
+					depth--
+					add(rulePegText, position1591)
+				}
+				if !_rules[ruleAction98]() {
+					goto l1589
+				}
+				depth--
+				add(ruleFloatLiteral, position1590)
+			}
+			return true
+		l1589:
+			position, tokenIndex, depth = position1589, tokenIndex1589, depth1589
+			return false
+		},
+		/* 133 Function <- <(<ident> Action99)> */
+		func() bool {
+			position1598, tokenIndex1598, depth1598 := position, tokenIndex, depth
+			{
+				position1599 := position
+				depth++
+				{
+					position1600 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l1598
+					}
+					depth--
+					add(rulePegText, position1600)
+				}
+				if !_rules[ruleAction99]() {
+					goto l1598
+				}
+				depth--
+				add(ruleFunction, position1599)
+			}
+			return true
+		l1598:
+			position, tokenIndex, depth = position1598, tokenIndex1598, depth1598
+			return false
+		},
+		/* 134 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action100)> */
+		func() bool {
+			position1601, tokenIndex1601, depth1601 := position, tokenIndex, depth
+			{
+				position1602 := position
+				depth++
+				{
+					position1603 := position
+					depth++
+					{
+						position1604, tokenIndex1604, depth1604 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1605
+						}
+						position++
+						goto l1604
+					l1605:
+						position, tokenIndex, depth = position1604, tokenIndex1604, depth1604
+						if buffer[position] != rune('N') {
+							goto l1601
+						}
+						position++
+					}
+				l1604:
+					{
+						position1606, tokenIndex1606, depth1606 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1607
+						}
+						position++
+						goto l1606
+					l1607:
+						position, tokenIndex, depth = position1606, tokenIndex1606, depth1606
+						if buffer[position] != rune('U') {
+							goto l1601
+						}
+						position++
+					}
+				l1606:
+					{
+						position1608, tokenIndex1608, depth1608 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1609
+						}
+						position++
+						goto l1608
+					l1609:
+						position, tokenIndex, depth = position1608, tokenIndex1608, depth1608
+						if buffer[position] != rune('L') {
+							goto l1601
+						}
+						position++
+					}
+				l1608:
+					{
+						position1610, tokenIndex1610, depth1610 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1611
+						}
+						position++
+						goto l1610
+					l1611:
+						position, tokenIndex, depth = position1610, tokenIndex1610, depth1610
+						if buffer[position] != rune('L') {
+							goto l1601
+						}
+						position++
+					}
+				l1610:
+					depth--
+					add(rulePegText, position1603)
+				}
+				if !_rules[ruleAction100]() {
+					goto l1601
+				}
+				depth--
+				add(ruleNullLiteral, position1602)
+			}
+			return true
+		l1601:
+			position, tokenIndex, depth = position1601, tokenIndex1601, depth1601
+			return false
+		},
+		/* 135 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action101)> */
+		func() bool {
+			position1612, tokenIndex1612, depth1612 := position, tokenIndex, depth
+			{
+				position1613 := position
+				depth++
+				{
+					position1614 := position
+					depth++
+					{
+						position1615, tokenIndex1615, depth1615 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1616
+						}
+						position++
+						goto l1615
+					l1616:
+						position, tokenIndex, depth = position1615, tokenIndex1615, depth1615
+						if buffer[position] != rune('M') {
+							goto l1612
+						}
+						position++
+					}
+				l1615:
+					{
+						position1617, tokenIndex1617, depth1617 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1618
+						}
+						position++
+						goto l1617
+					l1618:
+						position, tokenIndex, depth = position1617, tokenIndex1617, depth1617
+						if buffer[position] != rune('I') {
+							goto l1612
+						}
+						position++
+					}
+				l1617:
+					{
+						position1619, tokenIndex1619, depth1619 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1620
+						}
+						position++
+						goto l1619
+					l1620:
+						position, tokenIndex, depth = position1619, tokenIndex1619, depth1619
+						if buffer[position] != rune('S') {
+							goto l1612
+						}
+						position++
+					}
+				l1619:
+					{
+						position1621, tokenIndex1621, depth1621 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1622
+						}
+						position++
+						goto l1621
+					l1622:
+						position, tokenIndex, depth = position1621, tokenIndex1621, depth1621
+						if buffer[position] != rune('S') {
+							goto l1612
+						}
+						position++
+					}
+				l1621:
+					{
+						position1623, tokenIndex1623, depth1623 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1624
+						}
+						position++
+						goto l1623
+					l1624:
+						position, tokenIndex, depth = position1623, tokenIndex1623, depth1623
+						if buffer[position] != rune('I') {
+							goto l1612
+						}
+						position++
+					}
+				l1623:
+					{
+						position1625, tokenIndex1625, depth1625 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1626
+						}
+						position++
+						goto l1625
+					l1626:
+						position, tokenIndex, depth = position1625, tokenIndex1625, depth1625
+						if buffer[position] != rune('N') {
+							goto l1612
+						}
+						position++
+					}
+				l1625:
+					{
+						position1627, tokenIndex1627, depth1627 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1628
+						}
+						position++
+						goto l1627
+					l1628:
+						position, tokenIndex, depth = position1627, tokenIndex1627, depth1627
+						if buffer[position] != rune('G') {
+							goto l1612
+						}
+						position++
+					}
+				l1627:
+					depth--
+					add(rulePegText, position1614)
+				}
+				if !_rules[ruleAction101]() {
+					goto l1612
+				}
+				depth--
+				add(ruleMissing, position1613)
+			}
+			return true
+		l1612:
+			position, tokenIndex, depth = position1612, tokenIndex1612, depth1612
+			return false
+		},
+		/* 136 BooleanLiteral <- <(TRUE / FALSE)> */
+		func() bool {
+			position1629, tokenIndex1629, depth1629 := position, tokenIndex, depth
+			{
+				position1630 := position
+				depth++
+				{
+					position1631, tokenIndex1631, depth1631 := position, tokenIndex, depth
+					if !_rules[ruleTRUE]() {
+						goto l1632
+					}
+					goto l1631
+				l1632:
+					position, tokenIndex, depth = position1631, tokenIndex1631, depth1631
+					if !_rules[ruleFALSE]() {
+						goto l1629
+					}
+				}
+			l1631:
+				depth--
+				add(ruleBooleanLiteral, position1630)
+			}
+			return true
+		l1629:
+			position, tokenIndex, depth = position1629, tokenIndex1629, depth1629
+			return false
+		},
+		/* 137 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action102)> */
+		func() bool {
+			position1633, tokenIndex1633, depth1633 := position, tokenIndex, depth
+			{
+				position1634 := position
+				depth++
+				{
+					position1635 := position
+					depth++
+					{
+						position1636, tokenIndex1636, depth1636 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1637
+						}
+						position++
+						goto l1636
+					l1637:
+						position, tokenIndex, depth = position1636, tokenIndex1636, depth1636
+						if buffer[position] != rune('T') {
+							goto l1633
+						}
+						position++
+					}
+				l1636:
+					{
+						position1638, tokenIndex1638, depth1638 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1639
+						}
+						position++
+						goto l1638
+					l1639:
+						position, tokenIndex, depth = position1638, tokenIndex1638, depth1638
+						if buffer[position] != rune('R') {
+							goto l1633
+						}
+						position++
+					}
+				l1638:
+					{
+						position1640, tokenIndex1640, depth1640 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1641
+						}
+						position++
+						goto l1640
+					l1641:
+						position, tokenIndex, depth = position1640, tokenIndex1640, depth1640
+						if buffer[position] != rune('U') {
+							goto l1633
+						}
+						position++
+					}
+				l1640:
+					{
+						position1642, tokenIndex1642, depth1642 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1643
+						}
+						position++
+						goto l1642
+					l1643:
+						position, tokenIndex, depth = position1642, tokenIndex1642, depth1642
+						if buffer[position] != rune('E') {
+							goto l1633
+						}
+						position++
+					}
+				l1642:
+					depth--
+					add(rulePegText, position1635)
+				}
+				if !_rules[ruleAction102]() {
+					goto l1633
+				}
+				depth--
+				add(ruleTRUE, position1634)
+			}
+			return true
+		l1633:
+			position, tokenIndex, depth = position1633, tokenIndex1633, depth1633
+			return false
+		},
+		/* 138 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action103)> */
+		func() bool {
+			position1644, tokenIndex1644, depth1644 := position, tokenIndex, depth
+			{
+				position1645 := position
+				depth++
+				{
+					position1646 := position
+					depth++
+					{
+						position1647, tokenIndex1647, depth1647 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l1648
+						}
+						position++
+						goto l1647
+					l1648:
+						position, tokenIndex, depth = position1647, tokenIndex1647, depth1647
+						if buffer[position] != rune('F') {
+							goto l1644
+						}
+						position++
+					}
+				l1647:
+					{
+						position1649, tokenIndex1649, depth1649 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1650
+						}
+						position++
+						goto l1649
+					l1650:
+						position, tokenIndex, depth = position1649, tokenIndex1649, depth1649
+						if buffer[position] != rune('A') {
+							goto l1644
+						}
+						position++
+					}
+				l1649:
+					{
+						position1651, tokenIndex1651, depth1651 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1652
+						}
+						position++
+						goto l1651
+					l1652:
+						position, tokenIndex, depth = position1651, tokenIndex1651, depth1651
+						if buffer[position] != rune('L') {
+							goto l1644
+						}
+						position++
+					}
+				l1651:
+					{
+						position1653, tokenIndex1653, depth1653 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1654
+						}
+						position++
+						goto l1653
+					l1654:
+						position, tokenIndex, depth = position1653, tokenIndex1653, depth1653
+						if buffer[position] != rune('S') {
+							goto l1644
+						}
+						position++
+					}
+				l1653:
+					{
+						position1655, tokenIndex1655, depth1655 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1656
+						}
+						position++
+						goto l1655
+					l1656:
+						position, tokenIndex, depth = position1655, tokenIndex1655, depth1655
+						if buffer[position] != rune('E') {
+							goto l1644
+						}
+						position++
+					}
+				l1655:
+					depth--
+					add(rulePegText, position1646)
+				}
+				if !_rules[ruleAction103]() {
+					goto l1644
+				}
+				depth--
+				add(ruleFALSE, position1645)
+			}
+			return true
+		l1644:
+			position, tokenIndex, depth = position1644, tokenIndex1644, depth1644
+			return false
+		},
+		/* 139 Wildcard <- <(<((ident ':' !':')? '*')> Action104)> */
+		func() bool {
+			position1657, tokenIndex1657, depth1657 := position, tokenIndex, depth
+			{
+				position1658 := position
+				depth++
+				{
+					position1659 := position
+					depth++
+					{
+						position1660, tokenIndex1660, depth1660 := position, tokenIndex, depth
+						if !_rules[ruleident]() {
+							goto l1660
+						}
+						if buffer[position] != rune(':') {
+							goto l1660
+						}
+						position++
+						{
+							position1662, tokenIndex1662, depth1662 := position, tokenIndex, depth
+							if buffer[position] != rune(':') {
+								goto l1662
+							}
+							position++
+							goto l1660
+						l1662:
+							position, tokenIndex, depth = position1662, tokenIndex1662, depth1662
+						}
+						goto l1661
+					l1660:
+						position, tokenIndex, depth = position1660, tokenIndex1660, depth1660
+					}
+				l1661:
+					if buffer[position] != rune('*') {
+						goto l1657
+					}
+					position++
+					depth--
+					add(rulePegText, position1659)
+				}
+				if !_rules[ruleAction104]() {
+					goto l1657
+				}
+				depth--
+				add(ruleWildcard, position1658)
+			}
+			return true
+		l1657:
+			position, tokenIndex, depth = position1657, tokenIndex1657, depth1657
+			return false
+		},
+		/* 140 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action105)> */
+		func() bool {
+			position1663, tokenIndex1663, depth1663 := position, tokenIndex, depth
+			{
+				position1664 := position
+				depth++
+				{
+					position1665 := position
+					depth++
+					if buffer[position] != rune('"') {
+						goto l1663
+					}
+					position++
+				l1666:
+					{
+						position1667, tokenIndex1667, depth1667 := position, tokenIndex, depth
+						{
+							position1668, tokenIndex1668, depth1668 := position, tokenIndex, depth
+							if buffer[position] != rune('"') {
+								goto l1669
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l1669
+							}
+							position++
+							goto l1668
+						l1669:
+							position, tokenIndex, depth = position1668, tokenIndex1668, depth1668
+							{
+								position1670, tokenIndex1670, depth1670 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l1670
+								}
+								position++
+								goto l1667
+							l1670:
+								position, tokenIndex, depth = position1670, tokenIndex1670, depth1670
+							}
+							if !matchDot() {
+								goto l1667
+							}
+						}
+					l1668:
+						goto l1666
+					l1667:
+						position, tokenIndex, depth = position1667, tokenIndex1667, depth1667
+					}
+					if buffer[position] != rune('"') {
+						goto l1663
+					}
+					position++
+					depth--
+					add(rulePegText, position1665)
+				}
+				if !_rules[ruleAction105]() {
+					goto l1663
+				}
+				depth--
+				add(ruleStringLiteral, position1664)
+			}
+			return true
+		l1663:
+			position, tokenIndex, depth = position1663, tokenIndex1663, depth1663
+			return false
+		},
+		/* 141 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action106)> */
+		func() bool {
+			position1671, tokenIndex1671, depth1671 := position, tokenIndex, depth
+			{
+				position1672 := position
+				depth++
+				{
+					position1673 := position
+					depth++
+					{
+						position1674, tokenIndex1674, depth1674 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1675
+						}
+						position++
+						goto l1674
+					l1675:
+						position, tokenIndex, depth = position1674, tokenIndex1674, depth1674
+						if buffer[position] != rune('I') {
+							goto l1671
+						}
+						position++
+					}
+				l1674:
+					{
+						position1676, tokenIndex1676, depth1676 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1677
+						}
+						position++
+						goto l1676
+					l1677:
+						position, tokenIndex, depth = position1676, tokenIndex1676, depth1676
+						if buffer[position] != rune('S') {
+							goto l1671
+						}
+						position++
+					}
+				l1676:
+					{
+						position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1679
+						}
+						position++
+						goto l1678
+					l1679:
+						position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
+						if buffer[position] != rune('T') {
+							goto l1671
+						}
+						position++
+					}
+				l1678:
+					{
+						position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1681
+						}
+						position++
+						goto l1680
+					l1681:
+						position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
+						if buffer[position] != rune('R') {
+							goto l1671
+						}
+						position++
+					}
+				l1680:
+					{
+						position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1683
+						}
+						position++
+						goto l1682
+					l1683:
+						position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
+						if buffer[position] != rune('E') {
+							goto l1671
+						}
+						position++
+					}
+				l1682:
+					{
+						position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1685
+						}
+						position++
+						goto l1684
+					l1685:
+						position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+						if buffer[position] != rune('A') {
+							goto l1671
+						}
+						position++
+					}
+				l1684:
+					{
+						position1686, tokenIndex1686, depth1686 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1687
+						}
+						position++
+						goto l1686
+					l1687:
+						position, tokenIndex, depth = position1686, tokenIndex1686, depth1686
+						if buffer[position] != rune('M') {
+							goto l1671
+						}
+						position++
+					}
+				l1686:
+					depth--
+					add(rulePegText, position1673)
+				}
+				if !_rules[ruleAction106]() {
+					goto l1671
+				}
+				depth--
+				add(ruleISTREAM, position1672)
+			}
+			return true
+		l1671:
+			position, tokenIndex, depth = position1671, tokenIndex1671, depth1671
+			return false
+		},
+		/* 142 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action107)> */
+		func() bool {
+			position1688, tokenIndex1688, depth1688 := position, tokenIndex, depth
+			{
+				position1689 := position
+				depth++
+				{
+					position1690 := position
+					depth++
+					{
+						position1691, tokenIndex1691, depth1691 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1692
+						}
+						position++
+						goto l1691
+					l1692:
+						position, tokenIndex, depth = position1691, tokenIndex1691, depth1691
+						if buffer[position] != rune('D') {
+							goto l1688
+						}
+						position++
+					}
+				l1691:
+					{
+						position1693, tokenIndex1693, depth1693 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1694
+						}
+						position++
+						goto l1693
+					l1694:
+						position, tokenIndex, depth = position1693, tokenIndex1693, depth1693
+						if buffer[position] != rune('S') {
+							goto l1688
+						}
+						position++
+					}
+				l1693:
+					{
+						position1695, tokenIndex1695, depth1695 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1696
+						}
+						position++
+						goto l1695
+					l1696:
+						position, tokenIndex, depth = position1695, tokenIndex1695, depth1695
+						if buffer[position] != rune('T') {
+							goto l1688
+						}
+						position++
+					}
+				l1695:
+					{
+						position1697, tokenIndex1697, depth1697 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1698
+						}
+						position++
+						goto l1697
+					l1698:
+						position, tokenIndex, depth = position1697, tokenIndex1697, depth1697
+						if buffer[position] != rune('R') {
+							goto l1688
+						}
+						position++
+					}
+				l1697:
+					{
+						position1699, tokenIndex1699, depth1699 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1700
+						}
+						position++
+						goto l1699
+					l1700:
+						position, tokenIndex, depth = position1699, tokenIndex1699, depth1699
+						if buffer[position] != rune('E') {
+							goto l1688
+						}
+						position++
+					}
+				l1699:
+					{
+						position1701, tokenIndex1701, depth1701 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1702
+						}
+						position++
+						goto l1701
+					l1702:
+						position, tokenIndex, depth = position1701, tokenIndex1701, depth1701
+						if buffer[position] != rune('A') {
+							goto l1688
+						}
+						position++
+					}
+				l1701:
+					{
+						position1703, tokenIndex1703, depth1703 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1704
+						}
+						position++
+						goto l1703
+					l1704:
+						position, tokenIndex, depth = position1703, tokenIndex1703, depth1703
+						if buffer[position] != rune('M') {
+							goto l1688
+						}
+						position++
+					}
+				l1703:
+					depth--
+					add(rulePegText, position1690)
+				}
+				if !_rules[ruleAction107]() {
+					goto l1688
+				}
+				depth--
+				add(ruleDSTREAM, position1689)
+			}
+			return true
+		l1688:
+			position, tokenIndex, depth = position1688, tokenIndex1688, depth1688
+			return false
+		},
+		/* 143 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action108)> */
+		func() bool {
+			position1705, tokenIndex1705, depth1705 := position, tokenIndex, depth
+			{
+				position1706 := position
+				depth++
+				{
+					position1707 := position
+					depth++
+					{
+						position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1709
+						}
+						position++
+						goto l1708
+					l1709:
+						position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
+						if buffer[position] != rune('R') {
+							goto l1705
+						}
+						position++
+					}
+				l1708:
+					{
+						position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1711
+						}
+						position++
+						goto l1710
+					l1711:
+						position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
+						if buffer[position] != rune('S') {
+							goto l1705
+						}
+						position++
+					}
+				l1710:
+					{
+						position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1713
+						}
+						position++
+						goto l1712
+					l1713:
+						position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+						if buffer[position] != rune('T') {
+							goto l1705
+						}
+						position++
+					}
+				l1712:
+					{
+						position1714, tokenIndex1714, depth1714 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1715
+						}
+						position++
+						goto l1714
+					l1715:
+						position, tokenIndex, depth = position1714, tokenIndex1714, depth1714
+						if buffer[position] != rune('R') {
+							goto l1705
+						}
+						position++
+					}
+				l1714:
+					{
+						position1716, tokenIndex1716, depth1716 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1717
+						}
+						position++
+						goto l1716
+					l1717:
+						position, tokenIndex, depth = position1716, tokenIndex1716, depth1716
+						if buffer[position] != rune('E') {
+							goto l1705
+						}
+						position++
+					}
+				l1716:
+					{
+						position1718, tokenIndex1718, depth1718 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1719
+						}
+						position++
+						goto l1718
+					l1719:
+						position, tokenIndex, depth = position1718, tokenIndex1718, depth1718
+						if buffer[position] != rune('A') {
+							goto l1705
+						}
+						position++
+					}
+				l1718:
+					{
+						position1720, tokenIndex1720, depth1720 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1721
+						}
+						position++
+						goto l1720
+					l1721:
+						position, tokenIndex, depth = position1720, tokenIndex1720, depth1720
+						if buffer[position] != rune('M') {
+							goto l1705
+						}
+						position++
+					}
+				l1720:
+					depth--
+					add(rulePegText, position1707)
+				}
+				if !_rules[ruleAction108]() {
+					goto l1705
+				}
+				depth--
+				add(ruleRSTREAM, position1706)
+			}
+			return true
+		l1705:
+			position, tokenIndex, depth = position1705, tokenIndex1705, depth1705
+			return false
+		},
+		/* 144 RANGE <- <(<(('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E'))> Action109)> */
+		func() bool {
+			position1722, tokenIndex1722, depth1722 := position, tokenIndex, depth
+			{
+				position1723 := position
+				depth++
+				{
+					position1724 := position
+					depth++
+					{
+						position1725, tokenIndex1725, depth1725 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1726
+						}
+						position++
+						goto l1725
+					l1726:
+						position, tokenIndex, depth = position1725, tokenIndex1725, depth1725
+						if buffer[position] != rune('R') {
+							goto l1722
+						}
+						position++
+					}
+				l1725:
+					{
+						position1727, tokenIndex1727, depth1727 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1728
+						}
+						position++
+						goto l1727
+					l1728:
+						position, tokenIndex, depth = position1727, tokenIndex1727, depth1727
+						if buffer[position] != rune('A') {
+							goto l1722
+						}
+						position++
+					}
+				l1727:
+					{
+						position1729, tokenIndex1729, depth1729 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1730
+						}
+						position++
+						goto l1729
+					l1730:
+						position, tokenIndex, depth = position1729, tokenIndex1729, depth1729
+						if buffer[position] != rune('N') {
+							goto l1722
+						}
+						position++
+					}
+				l1729:
+					{
+						position1731, tokenIndex1731, depth1731 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1732
+						}
+						position++
+						goto l1731
+					l1732:
+						position, tokenIndex, depth = position1731, tokenIndex1731, depth1731
+						if buffer[position] != rune('G') {
+							goto l1722
+						}
+						position++
+					}
+				l1731:
+					{
+						position1733, tokenIndex1733, depth1733 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1734
+						}
+						position++
+						goto l1733
+					l1734:
+						position, tokenIndex, depth = position1733, tokenIndex1733, depth1733
+						if buffer[position] != rune('E') {
+							goto l1722
+						}
+						position++
+					}
+				l1733:
+					depth--
+					add(rulePegText, position1724)
+				}
+				if !_rules[ruleAction109]() {
+					goto l1722
+				}
+				depth--
+				add(ruleRANGE, position1723)
+			}
+			return true
+		l1722:
+			position, tokenIndex, depth = position1722, tokenIndex1722, depth1722
+			return false
+		},
+		/* 145 TUMBLING <- <(<(('t' / 'T') ('u' / 'U') ('m' / 'M') ('b' / 'B') ('l' / 'L') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action110)> */
+		func() bool {
+			position1735, tokenIndex1735, depth1735 := position, tokenIndex, depth
+			{
+				position1736 := position
+				depth++
+				{
+					position1737 := position
+					depth++
+					{
+						position1738, tokenIndex1738, depth1738 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1739
+						}
+						position++
+						goto l1738
+					l1739:
+						position, tokenIndex, depth = position1738, tokenIndex1738, depth1738
+						if buffer[position] != rune('T') {
+							goto l1735
+						}
+						position++
+					}
+				l1738:
+					{
+						position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1741
+						}
+						position++
+						goto l1740
+					l1741:
+						position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
+						if buffer[position] != rune('U') {
+							goto l1735
+						}
+						position++
+					}
+				l1740:
+					{
+						position1742, tokenIndex1742, depth1742 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1743
+						}
+						position++
+						goto l1742
+					l1743:
+						position, tokenIndex, depth = position1742, tokenIndex1742, depth1742
+						if buffer[position] != rune('M') {
+							goto l1735
+						}
+						position++
+					}
+				l1742:
+					{
+						position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l1745
+						}
+						position++
+						goto l1744
+					l1745:
+						position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
+						if buffer[position] != rune('B') {
+							goto l1735
+						}
+						position++
+					}
+				l1744:
+					{
+						position1746, tokenIndex1746, depth1746 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1747
+						}
+						position++
+						goto l1746
+					l1747:
+						position, tokenIndex, depth = position1746, tokenIndex1746, depth1746
+						if buffer[position] != rune('L') {
+							goto l1735
+						}
+						position++
+					}
+				l1746:
+					{
+						position1748, tokenIndex1748, depth1748 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1749
+						}
+						position++
+						goto l1748
+					l1749:
+						position, tokenIndex, depth = position1748, tokenIndex1748, depth1748
+						if buffer[position] != rune('I') {
+							goto l1735
+						}
+						position++
+					}
+				l1748:
+					{
+						position1750, tokenIndex1750, depth1750 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1751
+						}
+						position++
+						goto l1750
+					l1751:
+						position, tokenIndex, depth = position1750, tokenIndex1750, depth1750
+						if buffer[position] != rune('N') {
+							goto l1735
+						}
+						position++
+					}
+				l1750:
+					{
+						position1752, tokenIndex1752, depth1752 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1753
+						}
+						position++
+						goto l1752
+					l1753:
+						position, tokenIndex, depth = position1752, tokenIndex1752, depth1752
+						if buffer[position] != rune('G') {
+							goto l1735
+						}
+						position++
+					}
+				l1752:
+					depth--
+					add(rulePegText, position1737)
+				}
+				if !_rules[ruleAction110]() {
+					goto l1735
+				}
+				depth--
+				add(ruleTUMBLING, position1736)
+			}
+			return true
+		l1735:
+			position, tokenIndex, depth = position1735, tokenIndex1735, depth1735
+			return false
+		},
+		/* 146 HOPPING <- <(<(('h' / 'H') ('o' / 'O') ('p' / 'P') ('p' / 'P') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action111)> */
+		func() bool {
+			position1754, tokenIndex1754, depth1754 := position, tokenIndex, depth
+			{
+				position1755 := position
+				depth++
+				{
+					position1756 := position
+					depth++
+					{
+						position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
+						if buffer[position] != rune('h') {
+							goto l1758
+						}
+						position++
+						goto l1757
+					l1758:
+						position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
+						if buffer[position] != rune('H') {
+							goto l1754
+						}
+						position++
+					}
+				l1757:
+					{
+						position1759, tokenIndex1759, depth1759 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1760
+						}
+						position++
+						goto l1759
+					l1760:
+						position, tokenIndex, depth = position1759, tokenIndex1759, depth1759
+						if buffer[position] != rune('O') {
+							goto l1754
+						}
+						position++
+					}
+				l1759:
+					{
+						position1761, tokenIndex1761, depth1761 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1762
+						}
+						position++
+						goto l1761
+					l1762:
+						position, tokenIndex, depth = position1761, tokenIndex1761, depth1761
+						if buffer[position] != rune('P') {
+							goto l1754
+						}
+						position++
+					}
+				l1761:
+					{
+						position1763, tokenIndex1763, depth1763 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1764
+						}
+						position++
+						goto l1763
+					l1764:
+						position, tokenIndex, depth = position1763, tokenIndex1763, depth1763
+						if buffer[position] != rune('P') {
+							goto l1754
+						}
+						position++
+					}
+				l1763:
+					{
+						position1765, tokenIndex1765, depth1765 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1766
+						}
+						position++
+						goto l1765
+					l1766:
+						position, tokenIndex, depth = position1765, tokenIndex1765, depth1765
+						if buffer[position] != rune('I') {
+							goto l1754
+						}
+						position++
+					}
+				l1765:
+					{
+						position1767, tokenIndex1767, depth1767 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1768
+						}
+						position++
+						goto l1767
+					l1768:
+						position, tokenIndex, depth = position1767, tokenIndex1767, depth1767
+						if buffer[position] != rune('N') {
+							goto l1754
+						}
+						position++
+					}
+				l1767:
+					{
+						position1769, tokenIndex1769, depth1769 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1770
+						}
+						position++
+						goto l1769
+					l1770:
+						position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
+						if buffer[position] != rune('G') {
+							goto l1754
+						}
+						position++
+					}
+				l1769:
+					depth--
+					add(rulePegText, position1756)
+				}
+				if !_rules[ruleAction111]() {
+					goto l1754
+				}
+				depth--
+				add(ruleHOPPING, position1755)
+			}
+			return true
+		l1754:
+			position, tokenIndex, depth = position1754, tokenIndex1754, depth1754
+			return false
+		},
+		/* 147 SESSION <- <(<(('s' / 'S') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('g' / 'G') ('a' / 'A') ('p' / 'P')))> Action112)> */
+		func() bool {
+			position1771, tokenIndex1771, depth1771 := position, tokenIndex, depth
+			{
+				position1772 := position
+				depth++
+				{
+					position1773 := position
+					depth++
+					{
+						position1774, tokenIndex1774, depth1774 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1775
+						}
+						position++
+						goto l1774
+					l1775:
+						position, tokenIndex, depth = position1774, tokenIndex1774, depth1774
+						if buffer[position] != rune('S') {
+							goto l1771
+						}
+						position++
+					}
+				l1774:
+					{
+						position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1777
+						}
+						position++
+						goto l1776
+					l1777:
+						position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
+						if buffer[position] != rune('E') {
+							goto l1771
+						}
+						position++
+					}
+				l1776:
+					{
+						position1778, tokenIndex1778, depth1778 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1779
+						}
+						position++
+						goto l1778
+					l1779:
+						position, tokenIndex, depth = position1778, tokenIndex1778, depth1778
+						if buffer[position] != rune('S') {
+							goto l1771
+						}
+						position++
+					}
+				l1778:
+					{
+						position1780, tokenIndex1780, depth1780 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1781
+						}
+						position++
+						goto l1780
+					l1781:
+						position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
+						if buffer[position] != rune('S') {
+							goto l1771
+						}
+						position++
+					}
+				l1780:
+					{
+						position1782, tokenIndex1782, depth1782 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1783
+						}
+						position++
+						goto l1782
+					l1783:
+						position, tokenIndex, depth = position1782, tokenIndex1782, depth1782
+						if buffer[position] != rune('I') {
+							goto l1771
+						}
+						position++
+					}
+				l1782:
+					{
+						position1784, tokenIndex1784, depth1784 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1785
+						}
+						position++
+						goto l1784
+					l1785:
+						position, tokenIndex, depth = position1784, tokenIndex1784, depth1784
+						if buffer[position] != rune('O') {
+							goto l1771
+						}
+						position++
+					}
+				l1784:
+					{
+						position1786, tokenIndex1786, depth1786 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1787
+						}
+						position++
+						goto l1786
+					l1787:
+						position, tokenIndex, depth = position1786, tokenIndex1786, depth1786
+						if buffer[position] != rune('N') {
+							goto l1771
+						}
+						position++
+					}
+				l1786:
+					if !_rules[rulesp]() {
+						goto l1771
+					}
+					{
+						position1788, tokenIndex1788, depth1788 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l1789
+						}
+						position++
+						goto l1788
+					l1789:
+						position, tokenIndex, depth = position1788, tokenIndex1788, depth1788
+						if buffer[position] != rune('G') {
+							goto l1771
+						}
+						position++
+					}
+				l1788:
+					{
+						position1790, tokenIndex1790, depth1790 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1791
+						}
+						position++
+						goto l1790
+					l1791:
+						position, tokenIndex, depth = position1790, tokenIndex1790, depth1790
+						if buffer[position] != rune('A') {
+							goto l1771
+						}
+						position++
+					}
+				l1790:
+					{
+						position1792, tokenIndex1792, depth1792 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1793
+						}
+						position++
+						goto l1792
+					l1793:
+						position, tokenIndex, depth = position1792, tokenIndex1792, depth1792
+						if buffer[position] != rune('P') {
+							goto l1771
+						}
+						position++
+					}
+				l1792:
+					depth--
+					add(rulePegText, position1773)
+				}
+				if !_rules[ruleAction112]() {
+					goto l1771
+				}
+				depth--
+				add(ruleSESSION, position1772)
+			}
+			return true
+		l1771:
+			position, tokenIndex, depth = position1771, tokenIndex1771, depth1771
+			return false
+		},
+		/* 148 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action113)> */
+		func() bool {
+			position1794, tokenIndex1794, depth1794 := position, tokenIndex, depth
+			{
+				position1795 := position
+				depth++
+				{
+					position1796 := position
+					depth++
+					{
+						position1797, tokenIndex1797, depth1797 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1798
+						}
+						position++
+						goto l1797
+					l1798:
+						position, tokenIndex, depth = position1797, tokenIndex1797, depth1797
+						if buffer[position] != rune('T') {
+							goto l1794
+						}
+						position++
+					}
+				l1797:
+					{
+						position1799, tokenIndex1799, depth1799 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l1800
+						}
+						position++
+						goto l1799
+					l1800:
+						position, tokenIndex, depth = position1799, tokenIndex1799, depth1799
+						if buffer[position] != rune('U') {
+							goto l1794
+						}
+						position++
+					}
+				l1799:
+					{
+						position1801, tokenIndex1801, depth1801 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1802
+						}
+						position++
+						goto l1801
+					l1802:
+						position, tokenIndex, depth = position1801, tokenIndex1801, depth1801
+						if buffer[position] != rune('P') {
+							goto l1794
+						}
+						position++
+					}
+				l1801:
+					{
+						position1803, tokenIndex1803, depth1803 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1804
+						}
+						position++
+						goto l1803
+					l1804:
+						position, tokenIndex, depth = position1803, tokenIndex1803, depth1803
+						if buffer[position] != rune('L') {
+							goto l1794
+						}
+						position++
+					}
+				l1803:
+					{
+						position1805, tokenIndex1805, depth1805 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1806
+						}
+						position++
+						goto l1805
+					l1806:
+						position, tokenIndex, depth = position1805, tokenIndex1805, depth1805
+						if buffer[position] != rune('E') {
+							goto l1794
+						}
+						position++
+					}
+				l1805:
+					{
+						position1807, tokenIndex1807, depth1807 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1808
+						}
+						position++
+						goto l1807
+					l1808:
+						position, tokenIndex, depth = position1807, tokenIndex1807, depth1807
+						if buffer[position] != rune('S') {
+							goto l1794
+						}
+						position++
+					}
+				l1807:
+					depth--
+					add(rulePegText, position1796)
+				}
+				if !_rules[ruleAction113]() {
+					goto l1794
+				}
+				depth--
+				add(ruleTUPLES, position1795)
+			}
+			return true
+		l1794:
+			position, tokenIndex, depth = position1794, tokenIndex1794, depth1794
+			return false
+		},
+		/* 149 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action114)> */
+		func() bool {
+			position1809, tokenIndex1809, depth1809 := position, tokenIndex, depth
+			{
+				position1810 := position
+				depth++
+				{
+					position1811 := position
+					depth++
+					{
+						position1812, tokenIndex1812, depth1812 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1813
+						}
+						position++
+						goto l1812
+					l1813:
+						position, tokenIndex, depth = position1812, tokenIndex1812, depth1812
+						if buffer[position] != rune('S') {
+							goto l1809
+						}
+						position++
+					}
+				l1812:
+					{
+						position1814, tokenIndex1814, depth1814 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1815
+						}
+						position++
+						goto l1814
+					l1815:
+						position, tokenIndex, depth = position1814, tokenIndex1814, depth1814
+						if buffer[position] != rune('E') {
+							goto l1809
+						}
+						position++
+					}
+				l1814:
+					{
+						position1816, tokenIndex1816, depth1816 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1817
+						}
+						position++
+						goto l1816
+					l1817:
+						position, tokenIndex, depth = position1816, tokenIndex1816, depth1816
+						if buffer[position] != rune('C') {
+							goto l1809
+						}
+						position++
+					}
+				l1816:
+					{
+						position1818, tokenIndex1818, depth1818 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1819
+						}
+						position++
+						goto l1818
+					l1819:
+						position, tokenIndex, depth = position1818, tokenIndex1818, depth1818
+						if buffer[position] != rune('O') {
+							goto l1809
+						}
+						position++
+					}
+				l1818:
+					{
+						position1820, tokenIndex1820, depth1820 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1821
+						}
+						position++
+						goto l1820
+					l1821:
+						position, tokenIndex, depth = position1820, tokenIndex1820, depth1820
+						if buffer[position] != rune('N') {
+							goto l1809
+						}
+						position++
+					}
+				l1820:
+					{
+						position1822, tokenIndex1822, depth1822 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1823
+						}
+						position++
+						goto l1822
+					l1823:
+						position, tokenIndex, depth = position1822, tokenIndex1822, depth1822
+						if buffer[position] != rune('D') {
+							goto l1809
+						}
+						position++
+					}
+				l1822:
+					{
+						position1824, tokenIndex1824, depth1824 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1825
+						}
+						position++
+						goto l1824
+					l1825:
+						position, tokenIndex, depth = position1824, tokenIndex1824, depth1824
+						if buffer[position] != rune('S') {
+							goto l1809
+						}
+						position++
+					}
+				l1824:
+					depth--
+					add(rulePegText, position1811)
+				}
+				if !_rules[ruleAction114]() {
+					goto l1809
+				}
 				depth--
-				add(ruleIsOp, position1302)
+				add(ruleSECONDS, position1810)
 			}
 			return true
-		l1301:
-			position, tokenIndex, depth = position1301, tokenIndex1301, depth1301
+		l1809:
+			position, tokenIndex, depth = position1809, tokenIndex1809, depth1809
 			return false
 		},
-		/* 103 PlusMinusOp <- <(Plus / Minus)> */
+		/* 150 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action115)> */
 		func() bool {
-			position1305, tokenIndex1305, depth1305 := position, tokenIndex, depth
+			position1826, tokenIndex1826, depth1826 := position, tokenIndex, depth
 			{
-				position1306 := position
+				position1827 := position
 				depth++
 				{
-					position1307, tokenIndex1307, depth1307 := position, tokenIndex, depth
-					if !_rules[rulePlus]() {
-						goto l1308
+					position1828 := position
+					depth++
+					{
+						position1829, tokenIndex1829, depth1829 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l1830
+						}
+						position++
+						goto l1829
+					l1830:
+						position, tokenIndex, depth = position1829, tokenIndex1829, depth1829
+						if buffer[position] != rune('M') {
+							goto l1826
+						}
+						position++
 					}
-					goto l1307
-				l1308:
-					position, tokenIndex, depth = position1307, tokenIndex1307, depth1307
-					if !_rules[ruleMinus]() {
-						goto l1305
+				l1829:
+					{
+						position1831, tokenIndex1831, depth1831 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1832
+						}
+						position++
+						goto l1831
+					l1832:
+						position, tokenIndex, depth = position1831, tokenIndex1831, depth1831
+						if buffer[position] != rune('I') {
+							goto l1826
+						}
+						position++
+					}
+				l1831:
+					{
+						position1833, tokenIndex1833, depth1833 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1834
+						}
+						position++
+						goto l1833
+					l1834:
+						position, tokenIndex, depth = position1833, tokenIndex1833, depth1833
+						if buffer[position] != rune('L') {
+							goto l1826
+						}
+						position++
+					}
+				l1833:
+					{
+						position1835, tokenIndex1835, depth1835 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1836
+						}
+						position++
+						goto l1835
+					l1836:
+						position, tokenIndex, depth = position1835, tokenIndex1835, depth1835
+						if buffer[position] != rune('L') {
+							goto l1826
+						}
+						position++
+					}
+				l1835:
+					{
+						position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1838
+						}
+						position++
+						goto l1837
+					l1838:
+						position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+						if buffer[position] != rune('I') {
+							goto l1826
+						}
+						position++
+					}
+				l1837:
+					{
+						position1839, tokenIndex1839, depth1839 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1840
+						}
+						position++
+						goto l1839
+					l1840:
+						position, tokenIndex, depth = position1839, tokenIndex1839, depth1839
+						if buffer[position] != rune('S') {
+							goto l1826
+						}
+						position++
+					}
+				l1839:
+					{
+						position1841, tokenIndex1841, depth1841 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1842
+						}
+						position++
+						goto l1841
+					l1842:
+						position, tokenIndex, depth = position1841, tokenIndex1841, depth1841
+						if buffer[position] != rune('E') {
+							goto l1826
+						}
+						position++
+					}
+				l1841:
+					{
+						position1843, tokenIndex1843, depth1843 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1844
+						}
+						position++
+						goto l1843
+					l1844:
+						position, tokenIndex, depth = position1843, tokenIndex1843, depth1843
+						if buffer[position] != rune('C') {
+							goto l1826
+						}
+						position++
+					}
+				l1843:
+					{
+						position1845, tokenIndex1845, depth1845 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1846
+						}
+						position++
+						goto l1845
+					l1846:
+						position, tokenIndex, depth = position1845, tokenIndex1845, depth1845
+						if buffer[position] != rune('O') {
+							goto l1826
+						}
+						position++
+					}
+				l1845:
+					{
+						position1847, tokenIndex1847, depth1847 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1848
+						}
+						position++
+						goto l1847
+					l1848:
+						position, tokenIndex, depth = position1847, tokenIndex1847, depth1847
+						if buffer[position] != rune('N') {
+							goto l1826
+						}
+						position++
+					}
+				l1847:
+					{
+						position1849, tokenIndex1849, depth1849 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1850
+						}
+						position++
+						goto l1849
+					l1850:
+						position, tokenIndex, depth = position1849, tokenIndex1849, depth1849
+						if buffer[position] != rune('D') {
+							goto l1826
+						}
+						position++
+					}
+				l1849:
+					{
+						position1851, tokenIndex1851, depth1851 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1852
+						}
+						position++
+						goto l1851
+					l1852:
+						position, tokenIndex, depth = position1851, tokenIndex1851, depth1851
+						if buffer[position] != rune('S') {
+							goto l1826
+						}
+						position++
+					}
+				l1851:
+					depth--
+					add(rulePegText, position1828)
+				}
+				if !_rules[ruleAction115]() {
+					goto l1826
+				}
+				depth--
+				add(ruleMILLISECONDS, position1827)
+			}
+			return true
+		l1826:
+			position, tokenIndex, depth = position1826, tokenIndex1826, depth1826
+			return false
+		},
+		/* 151 INNER <- <(<(('i' / 'I') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('r' / 'R'))> Action116)> */
+		func() bool {
+			position1853, tokenIndex1853, depth1853 := position, tokenIndex, depth
+			{
+				position1854 := position
+				depth++
+				{
+					position1855 := position
+					depth++
+					{
+						position1856, tokenIndex1856, depth1856 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1857
+						}
+						position++
+						goto l1856
+					l1857:
+						position, tokenIndex, depth = position1856, tokenIndex1856, depth1856
+						if buffer[position] != rune('I') {
+							goto l1853
+						}
+						position++
+					}
+				l1856:
+					{
+						position1858, tokenIndex1858, depth1858 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1859
+						}
+						position++
+						goto l1858
+					l1859:
+						position, tokenIndex, depth = position1858, tokenIndex1858, depth1858
+						if buffer[position] != rune('N') {
+							goto l1853
+						}
+						position++
+					}
+				l1858:
+					{
+						position1860, tokenIndex1860, depth1860 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l1861
+						}
+						position++
+						goto l1860
+					l1861:
+						position, tokenIndex, depth = position1860, tokenIndex1860, depth1860
+						if buffer[position] != rune('N') {
+							goto l1853
+						}
+						position++
+					}
+				l1860:
+					{
+						position1862, tokenIndex1862, depth1862 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1863
+						}
+						position++
+						goto l1862
+					l1863:
+						position, tokenIndex, depth = position1862, tokenIndex1862, depth1862
+						if buffer[position] != rune('E') {
+							goto l1853
+						}
+						position++
+					}
+				l1862:
+					{
+						position1864, tokenIndex1864, depth1864 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1865
+						}
+						position++
+						goto l1864
+					l1865:
+						position, tokenIndex, depth = position1864, tokenIndex1864, depth1864
+						if buffer[position] != rune('R') {
+							goto l1853
+						}
+						position++
+					}
+				l1864:
+					depth--
+					add(rulePegText, position1855)
+				}
+				if !_rules[ruleAction116]() {
+					goto l1853
+				}
+				depth--
+				add(ruleINNER, position1854)
+			}
+			return true
+		l1853:
+			position, tokenIndex, depth = position1853, tokenIndex1853, depth1853
+			return false
+		},
+		/* 152 LEFT <- <(<(('l' / 'L') ('e' / 'E') ('f' / 'F') ('t' / 'T') (sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R')))?)> Action117)> */
+		func() bool {
+			position1866, tokenIndex1866, depth1866 := position, tokenIndex, depth
+			{
+				position1867 := position
+				depth++
+				{
+					position1868 := position
+					depth++
+					{
+						position1869, tokenIndex1869, depth1869 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1870
+						}
+						position++
+						goto l1869
+					l1870:
+						position, tokenIndex, depth = position1869, tokenIndex1869, depth1869
+						if buffer[position] != rune('L') {
+							goto l1866
+						}
+						position++
+					}
+				l1869:
+					{
+						position1871, tokenIndex1871, depth1871 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1872
+						}
+						position++
+						goto l1871
+					l1872:
+						position, tokenIndex, depth = position1871, tokenIndex1871, depth1871
+						if buffer[position] != rune('E') {
+							goto l1866
+						}
+						position++
+					}
+				l1871:
+					{
+						position1873, tokenIndex1873, depth1873 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l1874
+						}
+						position++
+						goto l1873
+					l1874:
+						position, tokenIndex, depth = position1873, tokenIndex1873, depth1873
+						if buffer[position] != rune('F') {
+							goto l1866
+						}
+						position++
+					}
+				l1873:
+					{
+						position1875, tokenIndex1875, depth1875 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1876
+						}
+						position++
+						goto l1875
+					l1876:
+						position, tokenIndex, depth = position1875, tokenIndex1875, depth1875
+						if buffer[position] != rune('T') {
+							goto l1866
+						}
+						position++
+					}
+				l1875:
+					{
+						position1877, tokenIndex1877, depth1877 := position, tokenIndex, depth
+						if !_rules[rulesp]() {
+							goto l1877
+						}
+						{
+							position1879, tokenIndex1879, depth1879 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l1880
+							}
+							position++
+							goto l1879
+						l1880:
+							position, tokenIndex, depth = position1879, tokenIndex1879, depth1879
+							if buffer[position] != rune('O') {
+								goto l1877
+							}
+							position++
+						}
+					l1879:
+						{
+							position1881, tokenIndex1881, depth1881 := position, tokenIndex, depth
+							if buffer[position] != rune('u') {
+								goto l1882
+							}
+							position++
+							goto l1881
+						l1882:
+							position, tokenIndex, depth = position1881, tokenIndex1881, depth1881
+							if buffer[position] != rune('U') {
+								goto l1877
+							}
+							position++
+						}
+					l1881:
+						{
+							position1883, tokenIndex1883, depth1883 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l1884
+							}
+							position++
+							goto l1883
+						l1884:
+							position, tokenIndex, depth = position1883, tokenIndex1883, depth1883
+							if buffer[position] != rune('T') {
+								goto l1877
+							}
+							position++
+						}
+					l1883:
+						{
+							position1885, tokenIndex1885, depth1885 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l1886
+							}
+							position++
+							goto l1885
+						l1886:
+							position, tokenIndex, depth = position1885, tokenIndex1885, depth1885
+							if buffer[position] != rune('E') {
+								goto l1877
+							}
+							position++
+						}
+					l1885:
+						{
+							position1887, tokenIndex1887, depth1887 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l1888
+							}
+							position++
+							goto l1887
+						l1888:
+							position, tokenIndex, depth = position1887, tokenIndex1887, depth1887
+							if buffer[position] != rune('R') {
+								goto l1877
+							}
+							position++
+						}
+					l1887:
+						goto l1878
+					l1877:
+						position, tokenIndex, depth = position1877, tokenIndex1877, depth1877
 					}
+				l1878:
+					depth--
+					add(rulePegText, position1868)
+				}
+				if !_rules[ruleAction117]() {
+					goto l1866
 				}
-			l1307:
 				depth--
-				add(rulePlusMinusOp, position1306)
+				add(ruleLEFT, position1867)
 			}
 			return true
-		l1305:
-			position, tokenIndex, depth = position1305, tokenIndex1305, depth1305
+		l1866:
+			position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
 			return false
 		},
-		/* 104 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		/* 153 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action118)> */
 		func() bool {
-			position1309, tokenIndex1309, depth1309 := position, tokenIndex, depth
+			position1889, tokenIndex1889, depth1889 := position, tokenIndex, depth
 			{
-				position1310 := position
+				position1890 := position
 				depth++
 				{
-					position1311, tokenIndex1311, depth1311 := position, tokenIndex, depth
-					if !_rules[ruleMultiply]() {
-						goto l1312
+					position1891 := position
+					depth++
+					{
+						position1892, tokenIndex1892, depth1892 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l1893
+						}
+						position++
+						goto l1892
+					l1893:
+						position, tokenIndex, depth = position1892, tokenIndex1892, depth1892
+						if buffer[position] != rune('W') {
+							goto l1889
+						}
+						position++
 					}
-					goto l1311
-				l1312:
-					position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-					if !_rules[ruleDivide]() {
-						goto l1313
+				l1892:
+					{
+						position1894, tokenIndex1894, depth1894 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1895
+						}
+						position++
+						goto l1894
+					l1895:
+						position, tokenIndex, depth = position1894, tokenIndex1894, depth1894
+						if buffer[position] != rune('A') {
+							goto l1889
+						}
+						position++
 					}
-					goto l1311
-				l1313:
-					position, tokenIndex, depth = position1311, tokenIndex1311, depth1311
-					if !_rules[ruleModulo]() {
-						goto l1309
+				l1894:
+					{
+						position1896, tokenIndex1896, depth1896 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l1897
+						}
+						position++
+						goto l1896
+					l1897:
+						position, tokenIndex, depth = position1896, tokenIndex1896, depth1896
+						if buffer[position] != rune('I') {
+							goto l1889
+						}
+						position++
 					}
-				}
-			l1311:
-				depth--
-				add(ruleMultDivOp, position1310)
-			}
-			return true
-		l1309:
-			position, tokenIndex, depth = position1309, tokenIndex1309, depth1309
-			return false
-		},
-		/* 105 Stream <- <(<ident> Action77)> */
-		func() bool {
-			position1314, tokenIndex1314, depth1314 := position, tokenIndex, depth
-			{
-				position1315 := position
-				depth++
-				{
-					position1316 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1314
+				l1896:
+					{
+						position1898, tokenIndex1898, depth1898 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1899
+						}
+						position++
+						goto l1898
+					l1899:
+						position, tokenIndex, depth = position1898, tokenIndex1898, depth1898
+						if buffer[position] != rune('T') {
+							goto l1889
+						}
+						position++
 					}
+				l1898:
 					depth--
-					add(rulePegText, position1316)
-				}
-				if !_rules[ruleAction77]() {
-					goto l1314
+					add(rulePegText, position1891)
 				}
-				depth--
-				add(ruleStream, position1315)
-			}
-			return true
-		l1314:
-			position, tokenIndex, depth = position1314, tokenIndex1314, depth1314
-			return false
-		},
-		/* 106 RowMeta <- <RowTimestamp> */
-		func() bool {
-			position1317, tokenIndex1317, depth1317 := position, tokenIndex, depth
-			{
-				position1318 := position
-				depth++
-				if !_rules[ruleRowTimestamp]() {
-					goto l1317
+				if !_rules[ruleAction118]() {
+					goto l1889
 				}
 				depth--
-				add(ruleRowMeta, position1318)
+				add(ruleWait, position1890)
 			}
 			return true
-		l1317:
-			position, tokenIndex, depth = position1317, tokenIndex1317, depth1317
+		l1889:
+			position, tokenIndex, depth = position1889, tokenIndex1889, depth1889
 			return false
 		},
-		/* 107 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action78)> */
+		/* 154 DropLate <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')))> Action119)> */
 		func() bool {
-			position1319, tokenIndex1319, depth1319 := position, tokenIndex, depth
+			position1900, tokenIndex1900, depth1900 := position, tokenIndex, depth
 			{
-				position1320 := position
+				position1901 := position
 				depth++
 				{
-					position1321 := position
+					position1902 := position
 					depth++
 					{
-						position1322, tokenIndex1322, depth1322 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1322
+						position1903, tokenIndex1903, depth1903 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1904
 						}
-						if buffer[position] != rune(':') {
-							goto l1322
+						position++
+						goto l1903
+					l1904:
+						position, tokenIndex, depth = position1903, tokenIndex1903, depth1903
+						if buffer[position] != rune('D') {
+							goto l1900
 						}
 						position++
-						goto l1323
-					l1322:
-						position, tokenIndex, depth = position1322, tokenIndex1322, depth1322
 					}
-				l1323:
-					if buffer[position] != rune('t') {
-						goto l1319
+				l1903:
+					{
+						position1905, tokenIndex1905, depth1905 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1906
+						}
+						position++
+						goto l1905
+					l1906:
+						position, tokenIndex, depth = position1905, tokenIndex1905, depth1905
+						if buffer[position] != rune('R') {
+							goto l1900
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l1319
+				l1905:
+					{
+						position1907, tokenIndex1907, depth1907 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1908
+						}
+						position++
+						goto l1907
+					l1908:
+						position, tokenIndex, depth = position1907, tokenIndex1907, depth1907
+						if buffer[position] != rune('O') {
+							goto l1900
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('(') {
-						goto l1319
+				l1907:
+					{
+						position1909, tokenIndex1909, depth1909 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1910
+						}
+						position++
+						goto l1909
+					l1910:
+						position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+						if buffer[position] != rune('P') {
+							goto l1900
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune(')') {
-						goto l1319
+				l1909:
+					if !_rules[rulesp]() {
+						goto l1900
 					}
-					position++
-					depth--
-					add(rulePegText, position1321)
-				}
-				if !_rules[ruleAction78]() {
-					goto l1319
-				}
-				depth--
-				add(ruleRowTimestamp, position1320)
-			}
-			return true
-		l1319:
-			position, tokenIndex, depth = position1319, tokenIndex1319, depth1319
-			return false
-		},
-		/* 108 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action79)> */
-		func() bool {
-			position1324, tokenIndex1324, depth1324 := position, tokenIndex, depth
-			{
-				position1325 := position
-				depth++
-				{
-					position1326 := position
-					depth++
 					{
-						position1327, tokenIndex1327, depth1327 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1327
+						position1911, tokenIndex1911, depth1911 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1912
 						}
-						if buffer[position] != rune(':') {
-							goto l1327
+						position++
+						goto l1911
+					l1912:
+						position, tokenIndex, depth = position1911, tokenIndex1911, depth1911
+						if buffer[position] != rune('L') {
+							goto l1900
 						}
 						position++
-						{
-							position1329, tokenIndex1329, depth1329 := position, tokenIndex, depth
-							if buffer[position] != rune(':') {
-								goto l1329
-							}
-							position++
-							goto l1327
-						l1329:
-							position, tokenIndex, depth = position1329, tokenIndex1329, depth1329
+					}
+				l1911:
+					{
+						position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1914
+						}
+						position++
+						goto l1913
+					l1914:
+						position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
+						if buffer[position] != rune('A') {
+							goto l1900
 						}
-						goto l1328
-					l1327:
-						position, tokenIndex, depth = position1327, tokenIndex1327, depth1327
+						position++
 					}
-				l1328:
-					if !_rules[rulejsonGetPath]() {
-						goto l1324
+				l1913:
+					{
+						position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1916
+						}
+						position++
+						goto l1915
+					l1916:
+						position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
+						if buffer[position] != rune('T') {
+							goto l1900
+						}
+						position++
+					}
+				l1915:
+					{
+						position1917, tokenIndex1917, depth1917 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1918
+						}
+						position++
+						goto l1917
+					l1918:
+						position, tokenIndex, depth = position1917, tokenIndex1917, depth1917
+						if buffer[position] != rune('E') {
+							goto l1900
+						}
+						position++
 					}
+				l1917:
 					depth--
-					add(rulePegText, position1326)
+					add(rulePegText, position1902)
 				}
-				if !_rules[ruleAction79]() {
-					goto l1324
+				if !_rules[ruleAction119]() {
+					goto l1900
 				}
 				depth--
-				add(ruleRowValue, position1325)
+				add(ruleDropLate, position1901)
 			}
 			return true
-		l1324:
-			position, tokenIndex, depth = position1324, tokenIndex1324, depth1324
+		l1900:
+			position, tokenIndex, depth = position1900, tokenIndex1900, depth1900
 			return false
 		},
-		/* 109 NumericLiteral <- <(<('-'? [0-9]+)> Action80)> */
+		/* 155 CorrectLate <- <(<(('c' / 'C') ('o' / 'O') ('r' / 'R') ('r' / 'R') ('e' / 'E') ('c' / 'C') ('t' / 'T') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')))> Action120)> */
 		func() bool {
-			position1330, tokenIndex1330, depth1330 := position, tokenIndex, depth
+			position1919, tokenIndex1919, depth1919 := position, tokenIndex, depth
 			{
-				position1331 := position
+				position1920 := position
 				depth++
 				{
-					position1332 := position
+					position1921 := position
 					depth++
 					{
-						position1333, tokenIndex1333, depth1333 := position, tokenIndex, depth
-						if buffer[position] != rune('-') {
-							goto l1333
+						position1922, tokenIndex1922, depth1922 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1923
+						}
+						position++
+						goto l1922
+					l1923:
+						position, tokenIndex, depth = position1922, tokenIndex1922, depth1922
+						if buffer[position] != rune('C') {
+							goto l1919
+						}
+						position++
+					}
+				l1922:
+					{
+						position1924, tokenIndex1924, depth1924 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1925
+						}
+						position++
+						goto l1924
+					l1925:
+						position, tokenIndex, depth = position1924, tokenIndex1924, depth1924
+						if buffer[position] != rune('O') {
+							goto l1919
+						}
+						position++
+					}
+				l1924:
+					{
+						position1926, tokenIndex1926, depth1926 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1927
+						}
+						position++
+						goto l1926
+					l1927:
+						position, tokenIndex, depth = position1926, tokenIndex1926, depth1926
+						if buffer[position] != rune('R') {
+							goto l1919
+						}
+						position++
+					}
+				l1926:
+					{
+						position1928, tokenIndex1928, depth1928 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1929
+						}
+						position++
+						goto l1928
+					l1929:
+						position, tokenIndex, depth = position1928, tokenIndex1928, depth1928
+						if buffer[position] != rune('R') {
+							goto l1919
+						}
+						position++
+					}
+				l1928:
+					{
+						position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1931
+						}
+						position++
+						goto l1930
+					l1931:
+						position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
+						if buffer[position] != rune('E') {
+							goto l1919
+						}
+						position++
+					}
+				l1930:
+					{
+						position1932, tokenIndex1932, depth1932 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l1933
+						}
+						position++
+						goto l1932
+					l1933:
+						position, tokenIndex, depth = position1932, tokenIndex1932, depth1932
+						if buffer[position] != rune('C') {
+							goto l1919
+						}
+						position++
+					}
+				l1932:
+					{
+						position1934, tokenIndex1934, depth1934 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1935
+						}
+						position++
+						goto l1934
+					l1935:
+						position, tokenIndex, depth = position1934, tokenIndex1934, depth1934
+						if buffer[position] != rune('T') {
+							goto l1919
+						}
+						position++
+					}
+				l1934:
+					if !_rules[rulesp]() {
+						goto l1919
+					}
+					{
+						position1936, tokenIndex1936, depth1936 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1937
+						}
+						position++
+						goto l1936
+					l1937:
+						position, tokenIndex, depth = position1936, tokenIndex1936, depth1936
+						if buffer[position] != rune('L') {
+							goto l1919
+						}
+						position++
+					}
+				l1936:
+					{
+						position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1939
+						}
+						position++
+						goto l1938
+					l1939:
+						position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+						if buffer[position] != rune('A') {
+							goto l1919
+						}
+						position++
+					}
+				l1938:
+					{
+						position1940, tokenIndex1940, depth1940 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1941
+						}
+						position++
+						goto l1940
+					l1941:
+						position, tokenIndex, depth = position1940, tokenIndex1940, depth1940
+						if buffer[position] != rune('T') {
+							goto l1919
 						}
 						position++
-						goto l1334
-					l1333:
-						position, tokenIndex, depth = position1333, tokenIndex1333, depth1333
-					}
-				l1334:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1330
 					}
-					position++
-				l1335:
+				l1940:
 					{
-						position1336, tokenIndex1336, depth1336 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1336
+						position1942, tokenIndex1942, depth1942 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1943
+						}
+						position++
+						goto l1942
+					l1943:
+						position, tokenIndex, depth = position1942, tokenIndex1942, depth1942
+						if buffer[position] != rune('E') {
+							goto l1919
 						}
 						position++
-						goto l1335
-					l1336:
-						position, tokenIndex, depth = position1336, tokenIndex1336, depth1336
 					}
+				l1942:
 					depth--
-					add(rulePegText, position1332)
+					add(rulePegText, position1921)
 				}
-				if !_rules[ruleAction80]() {
-					goto l1330
+				if !_rules[ruleAction120]() {
+					goto l1919
 				}
 				depth--
-				add(ruleNumericLiteral, position1331)
+				add(ruleCorrectLate, position1920)
 			}
 			return true
-		l1330:
-			position, tokenIndex, depth = position1330, tokenIndex1330, depth1330
+		l1919:
+			position, tokenIndex, depth = position1919, tokenIndex1919, depth1919
 			return false
 		},
-		/* 110 NonNegativeNumericLiteral <- <(<[0-9]+> Action81)> */
+		/* 156 ReportLate <- <(<(('r' / 'R') ('e' / 'E') ('p' / 'P') ('o' / 'O') ('r' / 'R') ('t' / 'T') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')))> Action121)> */
 		func() bool {
-			position1337, tokenIndex1337, depth1337 := position, tokenIndex, depth
+			position1944, tokenIndex1944, depth1944 := position, tokenIndex, depth
 			{
-				position1338 := position
+				position1945 := position
 				depth++
 				{
-					position1339 := position
+					position1946 := position
 					depth++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1337
-					}
-					position++
-				l1340:
 					{
-						position1341, tokenIndex1341, depth1341 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1341
+						position1947, tokenIndex1947, depth1947 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1948
+						}
+						position++
+						goto l1947
+					l1948:
+						position, tokenIndex, depth = position1947, tokenIndex1947, depth1947
+						if buffer[position] != rune('R') {
+							goto l1944
 						}
 						position++
-						goto l1340
-					l1341:
-						position, tokenIndex, depth = position1341, tokenIndex1341, depth1341
 					}
-					depth--
-					add(rulePegText, position1339)
-				}
-				if !_rules[ruleAction81]() {
-					goto l1337
-				}
-				depth--
-				add(ruleNonNegativeNumericLiteral, position1338)
-			}
-			return true
-		l1337:
-			position, tokenIndex, depth = position1337, tokenIndex1337, depth1337
-			return false
-		},
-		/* 111 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action82)> */
-		func() bool {
-			position1342, tokenIndex1342, depth1342 := position, tokenIndex, depth
-			{
-				position1343 := position
-				depth++
-				{
-					position1344 := position
-					depth++
+				l1947:
 					{
-						position1345, tokenIndex1345, depth1345 := position, tokenIndex, depth
-						if buffer[position] != rune('-') {
-							goto l1345
+						position1949, tokenIndex1949, depth1949 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1950
+						}
+						position++
+						goto l1949
+					l1950:
+						position, tokenIndex, depth = position1949, tokenIndex1949, depth1949
+						if buffer[position] != rune('E') {
+							goto l1944
 						}
 						position++
-						goto l1346
-					l1345:
-						position, tokenIndex, depth = position1345, tokenIndex1345, depth1345
-					}
-				l1346:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1342
 					}
-					position++
-				l1347:
+				l1949:
 					{
-						position1348, tokenIndex1348, depth1348 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1348
+						position1951, tokenIndex1951, depth1951 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1952
+						}
+						position++
+						goto l1951
+					l1952:
+						position, tokenIndex, depth = position1951, tokenIndex1951, depth1951
+						if buffer[position] != rune('P') {
+							goto l1944
 						}
 						position++
-						goto l1347
-					l1348:
-						position, tokenIndex, depth = position1348, tokenIndex1348, depth1348
 					}
-					if buffer[position] != rune('.') {
-						goto l1342
+				l1951:
+					{
+						position1953, tokenIndex1953, depth1953 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1954
+						}
+						position++
+						goto l1953
+					l1954:
+						position, tokenIndex, depth = position1953, tokenIndex1953, depth1953
+						if buffer[position] != rune('O') {
+							goto l1944
+						}
+						position++
 					}
-					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1342
+				l1953:
+					{
+						position1955, tokenIndex1955, depth1955 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1956
+						}
+						position++
+						goto l1955
+					l1956:
+						position, tokenIndex, depth = position1955, tokenIndex1955, depth1955
+						if buffer[position] != rune('R') {
+							goto l1944
+						}
+						position++
 					}
-					position++
-				l1349:
+				l1955:
 					{
-						position1350, tokenIndex1350, depth1350 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1350
+						position1957, tokenIndex1957, depth1957 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1958
+						}
+						position++
+						goto l1957
+					l1958:
+						position, tokenIndex, depth = position1957, tokenIndex1957, depth1957
+						if buffer[position] != rune('T') {
+							goto l1944
 						}
 						position++
-						goto l1349
-					l1350:
-						position, tokenIndex, depth = position1350, tokenIndex1350, depth1350
 					}
-					depth--
-					add(rulePegText, position1344)
-				}
-				if !_rules[ruleAction82]() {
-					goto l1342
-				}
-				depth--
-				add(ruleFloatLiteral, position1343)
-			}
-			return true
-		l1342:
-			position, tokenIndex, depth = position1342, tokenIndex1342, depth1342
-			return false
-		},
-		/* 112 Function <- <(<ident> Action83)> */
-		func() bool {
-			position1351, tokenIndex1351, depth1351 := position, tokenIndex, depth
-			{
-				position1352 := position
-				depth++
-				{
-					position1353 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1351
+				l1957:
+					if !_rules[rulesp]() {
+						goto l1944
 					}
-					depth--
-					add(rulePegText, position1353)
-				}
-				if !_rules[ruleAction83]() {
-					goto l1351
-				}
-				depth--
-				add(ruleFunction, position1352)
-			}
-			return true
-		l1351:
-			position, tokenIndex, depth = position1351, tokenIndex1351, depth1351
-			return false
-		},
-		/* 113 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action84)> */
-		func() bool {
-			position1354, tokenIndex1354, depth1354 := position, tokenIndex, depth
-			{
-				position1355 := position
-				depth++
-				{
-					position1356 := position
-					depth++
 					{
-						position1357, tokenIndex1357, depth1357 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1358
+						position1959, tokenIndex1959, depth1959 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1960
 						}
 						position++
-						goto l1357
-					l1358:
-						position, tokenIndex, depth = position1357, tokenIndex1357, depth1357
-						if buffer[position] != rune('N') {
-							goto l1354
+						goto l1959
+					l1960:
+						position, tokenIndex, depth = position1959, tokenIndex1959, depth1959
+						if buffer[position] != rune('L') {
+							goto l1944
 						}
 						position++
 					}
-				l1357:
+				l1959:
 					{
-						position1359, tokenIndex1359, depth1359 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1360
+						position1961, tokenIndex1961, depth1961 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l1962
 						}
 						position++
-						goto l1359
-					l1360:
-						position, tokenIndex, depth = position1359, tokenIndex1359, depth1359
-						if buffer[position] != rune('U') {
-							goto l1354
+						goto l1961
+					l1962:
+						position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+						if buffer[position] != rune('A') {
+							goto l1944
 						}
 						position++
 					}
-				l1359:
+				l1961:
 					{
-						position1361, tokenIndex1361, depth1361 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1362
+						position1963, tokenIndex1963, depth1963 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1964
 						}
 						position++
-						goto l1361
-					l1362:
-						position, tokenIndex, depth = position1361, tokenIndex1361, depth1361
-						if buffer[position] != rune('L') {
-							goto l1354
+						goto l1963
+					l1964:
+						position, tokenIndex, depth = position1963, tokenIndex1963, depth1963
+						if buffer[position] != rune('T') {
+							goto l1944
 						}
 						position++
 					}
-				l1361:
+				l1963:
 					{
-						position1363, tokenIndex1363, depth1363 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1364
+						position1965, tokenIndex1965, depth1965 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1966
 						}
 						position++
-						goto l1363
-					l1364:
-						position, tokenIndex, depth = position1363, tokenIndex1363, depth1363
-						if buffer[position] != rune('L') {
-							goto l1354
+						goto l1965
+					l1966:
+						position, tokenIndex, depth = position1965, tokenIndex1965, depth1965
+						if buffer[position] != rune('E') {
+							goto l1944
 						}
 						position++
 					}
-				l1363:
+				l1965:
 					depth--
-					add(rulePegText, position1356)
+					add(rulePegText, position1946)
 				}
-				if !_rules[ruleAction84]() {
-					goto l1354
+				if !_rules[ruleAction121]() {
+					goto l1944
 				}
 				depth--
-				add(ruleNullLiteral, position1355)
+				add(ruleReportLate, position1945)
 			}
 			return true
-		l1354:
-			position, tokenIndex, depth = position1354, tokenIndex1354, depth1354
+		l1944:
+			position, tokenIndex, depth = position1944, tokenIndex1944, depth1944
 			return false
 		},
-		/* 114 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action85)> */
+		/* 157 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action122)> */
 		func() bool {
-			position1365, tokenIndex1365, depth1365 := position, tokenIndex, depth
+			position1967, tokenIndex1967, depth1967 := position, tokenIndex, depth
 			{
-				position1366 := position
+				position1968 := position
 				depth++
 				{
-					position1367 := position
+					position1969 := position
 					depth++
 					{
-						position1368, tokenIndex1368, depth1368 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1369
+						position1970, tokenIndex1970, depth1970 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1971
 						}
 						position++
-						goto l1368
-					l1369:
-						position, tokenIndex, depth = position1368, tokenIndex1368, depth1368
-						if buffer[position] != rune('M') {
-							goto l1365
+						goto l1970
+					l1971:
+						position, tokenIndex, depth = position1970, tokenIndex1970, depth1970
+						if buffer[position] != rune('D') {
+							goto l1967
 						}
 						position++
 					}
-				l1368:
+				l1970:
 					{
-						position1370, tokenIndex1370, depth1370 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1371
+						position1972, tokenIndex1972, depth1972 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l1973
 						}
 						position++
-						goto l1370
-					l1371:
-						position, tokenIndex, depth = position1370, tokenIndex1370, depth1370
-						if buffer[position] != rune('I') {
-							goto l1365
+						goto l1972
+					l1973:
+						position, tokenIndex, depth = position1972, tokenIndex1972, depth1972
+						if buffer[position] != rune('R') {
+							goto l1967
 						}
 						position++
 					}
-				l1370:
+				l1972:
 					{
-						position1372, tokenIndex1372, depth1372 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1373
+						position1974, tokenIndex1974, depth1974 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1975
 						}
 						position++
-						goto l1372
-					l1373:
-						position, tokenIndex, depth = position1372, tokenIndex1372, depth1372
-						if buffer[position] != rune('S') {
-							goto l1365
+						goto l1974
+					l1975:
+						position, tokenIndex, depth = position1974, tokenIndex1974, depth1974
+						if buffer[position] != rune('O') {
+							goto l1967
 						}
 						position++
 					}
-				l1372:
+				l1974:
 					{
-						position1374, tokenIndex1374, depth1374 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1375
+						position1976, tokenIndex1976, depth1976 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l1977
 						}
 						position++
-						goto l1374
-					l1375:
-						position, tokenIndex, depth = position1374, tokenIndex1374, depth1374
-						if buffer[position] != rune('S') {
-							goto l1365
+						goto l1976
+					l1977:
+						position, tokenIndex, depth = position1976, tokenIndex1976, depth1976
+						if buffer[position] != rune('P') {
+							goto l1967
 						}
 						position++
 					}
-				l1374:
+				l1976:
+					if !_rules[rulesp]() {
+						goto l1967
+					}
 					{
-						position1376, tokenIndex1376, depth1376 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1377
+						position1978, tokenIndex1978, depth1978 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1979
 						}
 						position++
-						goto l1376
-					l1377:
-						position, tokenIndex, depth = position1376, tokenIndex1376, depth1376
-						if buffer[position] != rune('I') {
-							goto l1365
+						goto l1978
+					l1979:
+						position, tokenIndex, depth = position1978, tokenIndex1978, depth1978
+						if buffer[position] != rune('O') {
+							goto l1967
 						}
 						position++
 					}
-				l1376:
+				l1978:
 					{
-						position1378, tokenIndex1378, depth1378 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1379
+						position1980, tokenIndex1980, depth1980 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l1981
 						}
 						position++
-						goto l1378
-					l1379:
-						position, tokenIndex, depth = position1378, tokenIndex1378, depth1378
-						if buffer[position] != rune('N') {
-							goto l1365
+						goto l1980
+					l1981:
+						position, tokenIndex, depth = position1980, tokenIndex1980, depth1980
+						if buffer[position] != rune('L') {
+							goto l1967
 						}
 						position++
 					}
-				l1378:
+				l1980:
 					{
-						position1380, tokenIndex1380, depth1380 := position, tokenIndex, depth
-						if buffer[position] != rune('g') {
-							goto l1381
+						position1982, tokenIndex1982, depth1982 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1983
 						}
 						position++
-						goto l1380
-					l1381:
-						position, tokenIndex, depth = position1380, tokenIndex1380, depth1380
-						if buffer[position] != rune('G') {
-							goto l1365
+						goto l1982
+					l1983:
+						position, tokenIndex, depth = position1982, tokenIndex1982, depth1982
+						if buffer[position] != rune('D') {
+							goto l1967
+						}
+						position++
+					}
+				l1982:
+					{
+						position1984, tokenIndex1984, depth1984 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l1985
+						}
+						position++
+						goto l1984
+					l1985:
+						position, tokenIndex, depth = position1984, tokenIndex1984, depth1984
+						if buffer[position] != rune('E') {
+							goto l1967
+						}
+						position++
+					}
+				l1984:
+					{
+						position1986, tokenIndex1986, depth1986 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l1987
+						}
+						position++
+						goto l1986
+					l1987:
+						position, tokenIndex, depth = position1986, tokenIndex1986, depth1986
+						if buffer[position] != rune('S') {
+							goto l1967
+						}
+						position++
+					}
+				l1986:
+					{
+						position1988, tokenIndex1988, depth1988 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l1989
+						}
+						position++
+						goto l1988
+					l1989:
+						position, tokenIndex, depth = position1988, tokenIndex1988, depth1988
+						if buffer[position] != rune('T') {
+							goto l1967
 						}
 						position++
 					}
-				l1380:
+				l1988:
 					depth--
-					add(rulePegText, position1367)
-				}
-				if !_rules[ruleAction85]() {
-					goto l1365
+					add(rulePegText, position1969)
 				}
-				depth--
-				add(ruleMissing, position1366)
-			}
-			return true
-		l1365:
-			position, tokenIndex, depth = position1365, tokenIndex1365, depth1365
-			return false
-		},
-		/* 115 BooleanLiteral <- <(TRUE / FALSE)> */
-		func() bool {
-			position1382, tokenIndex1382, depth1382 := position, tokenIndex, depth
-			{
-				position1383 := position
-				depth++
-				{
-					position1384, tokenIndex1384, depth1384 := position, tokenIndex, depth
-					if !_rules[ruleTRUE]() {
-						goto l1385
-					}
-					goto l1384
-				l1385:
-					position, tokenIndex, depth = position1384, tokenIndex1384, depth1384
-					if !_rules[ruleFALSE]() {
-						goto l1382
-					}
+				if !_rules[ruleAction122]() {
+					goto l1967
 				}
-			l1384:
 				depth--
-				add(ruleBooleanLiteral, position1383)
+				add(ruleDropOldest, position1968)
 			}
 			return true
-		l1382:
-			position, tokenIndex, depth = position1382, tokenIndex1382, depth1382
+		l1967:
+			position, tokenIndex, depth = position1967, tokenIndex1967, depth1967
 			return false
 		},
-		/* 116 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action86)> */
+		/* 158 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action123)> */
 		func() bool {
-			position1386, tokenIndex1386, depth1386 := position, tokenIndex, depth
+			position1990, tokenIndex1990, depth1990 := position, tokenIndex, depth
 			{
-				position1387 := position
+				position1991 := position
 				depth++
 				{
-					position1388 := position
+					position1992 := position
 					depth++
 					{
-						position1389, tokenIndex1389, depth1389 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1390
+						position1993, tokenIndex1993, depth1993 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l1994
 						}
 						position++
-						goto l1389
-					l1390:
-						position, tokenIndex, depth = position1389, tokenIndex1389, depth1389
-						if buffer[position] != rune('T') {
-							goto l1386
+						goto l1993
+					l1994:
+						position, tokenIndex, depth = position1993, tokenIndex1993, depth1993
+						if buffer[position] != rune('D') {
+							goto l1990
 						}
 						position++
 					}
-				l1389:
+				l1993:
 					{
-						position1391, tokenIndex1391, depth1391 := position, tokenIndex, depth
+						position1995, tokenIndex1995, depth1995 := position, tokenIndex, depth
 						if buffer[position] != rune('r') {
-							goto l1392
+							goto l1996
 						}
 						position++
-						goto l1391
-					l1392:
-						position, tokenIndex, depth = position1391, tokenIndex1391, depth1391
+						goto l1995
+					l1996:
+						position, tokenIndex, depth = position1995, tokenIndex1995, depth1995
 						if buffer[position] != rune('R') {
-							goto l1386
+							goto l1990
 						}
 						position++
 					}
-				l1391:
+				l1995:
 					{
-						position1393, tokenIndex1393, depth1393 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1394
+						position1997, tokenIndex1997, depth1997 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l1998
 						}
 						position++
-						goto l1393
-					l1394:
-						position, tokenIndex, depth = position1393, tokenIndex1393, depth1393
-						if buffer[position] != rune('U') {
-							goto l1386
+						goto l1997
+					l1998:
+						position, tokenIndex, depth = position1997, tokenIndex1997, depth1997
+						if buffer[position] != rune('O') {
+							goto l1990
 						}
 						position++
 					}
-				l1393:
+				l1997:
 					{
-						position1395, tokenIndex1395, depth1395 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1396
+						position1999, tokenIndex1999, depth1999 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2000
 						}
 						position++
-						goto l1395
-					l1396:
-						position, tokenIndex, depth = position1395, tokenIndex1395, depth1395
-						if buffer[position] != rune('E') {
-							goto l1386
+						goto l1999
+					l2000:
+						position, tokenIndex, depth = position1999, tokenIndex1999, depth1999
+						if buffer[position] != rune('P') {
+							goto l1990
 						}
 						position++
 					}
-				l1395:
-					depth--
-					add(rulePegText, position1388)
-				}
-				if !_rules[ruleAction86]() {
-					goto l1386
-				}
-				depth--
-				add(ruleTRUE, position1387)
-			}
-			return true
-		l1386:
-			position, tokenIndex, depth = position1386, tokenIndex1386, depth1386
-			return false
-		},
-		/* 117 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action87)> */
-		func() bool {
-			position1397, tokenIndex1397, depth1397 := position, tokenIndex, depth
-			{
-				position1398 := position
-				depth++
-				{
-					position1399 := position
-					depth++
+				l1999:
+					if !_rules[rulesp]() {
+						goto l1990
+					}
 					{
-						position1400, tokenIndex1400, depth1400 := position, tokenIndex, depth
-						if buffer[position] != rune('f') {
-							goto l1401
+						position2001, tokenIndex2001, depth2001 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2002
 						}
 						position++
-						goto l1400
-					l1401:
-						position, tokenIndex, depth = position1400, tokenIndex1400, depth1400
-						if buffer[position] != rune('F') {
-							goto l1397
+						goto l2001
+					l2002:
+						position, tokenIndex, depth = position2001, tokenIndex2001, depth2001
+						if buffer[position] != rune('N') {
+							goto l1990
 						}
 						position++
 					}
-				l1400:
+				l2001:
 					{
-						position1402, tokenIndex1402, depth1402 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1403
+						position2003, tokenIndex2003, depth2003 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2004
 						}
 						position++
-						goto l1402
-					l1403:
-						position, tokenIndex, depth = position1402, tokenIndex1402, depth1402
-						if buffer[position] != rune('A') {
-							goto l1397
+						goto l2003
+					l2004:
+						position, tokenIndex, depth = position2003, tokenIndex2003, depth2003
+						if buffer[position] != rune('E') {
+							goto l1990
 						}
 						position++
 					}
-				l1402:
+				l2003:
 					{
-						position1404, tokenIndex1404, depth1404 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1405
+						position2005, tokenIndex2005, depth2005 := position, tokenIndex, depth
+						if buffer[position] != rune('w') {
+							goto l2006
 						}
 						position++
-						goto l1404
-					l1405:
-						position, tokenIndex, depth = position1404, tokenIndex1404, depth1404
-						if buffer[position] != rune('L') {
-							goto l1397
+						goto l2005
+					l2006:
+						position, tokenIndex, depth = position2005, tokenIndex2005, depth2005
+						if buffer[position] != rune('W') {
+							goto l1990
 						}
 						position++
 					}
-				l1404:
+				l2005:
 					{
-						position1406, tokenIndex1406, depth1406 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1407
+						position2007, tokenIndex2007, depth2007 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2008
 						}
 						position++
-						goto l1406
-					l1407:
-						position, tokenIndex, depth = position1406, tokenIndex1406, depth1406
-						if buffer[position] != rune('S') {
-							goto l1397
+						goto l2007
+					l2008:
+						position, tokenIndex, depth = position2007, tokenIndex2007, depth2007
+						if buffer[position] != rune('E') {
+							goto l1990
 						}
 						position++
 					}
-				l1406:
+				l2007:
 					{
-						position1408, tokenIndex1408, depth1408 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1409
+						position2009, tokenIndex2009, depth2009 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2010
 						}
 						position++
-						goto l1408
-					l1409:
-						position, tokenIndex, depth = position1408, tokenIndex1408, depth1408
-						if buffer[position] != rune('E') {
-							goto l1397
+						goto l2009
+					l2010:
+						position, tokenIndex, depth = position2009, tokenIndex2009, depth2009
+						if buffer[position] != rune('S') {
+							goto l1990
 						}
 						position++
 					}
-				l1408:
-					depth--
-					add(rulePegText, position1399)
-				}
-				if !_rules[ruleAction87]() {
-					goto l1397
-				}
-				depth--
-				add(ruleFALSE, position1398)
-			}
-			return true
-		l1397:
-			position, tokenIndex, depth = position1397, tokenIndex1397, depth1397
-			return false
-		},
-		/* 118 Wildcard <- <(<((ident ':' !':')? '*')> Action88)> */
-		func() bool {
-			position1410, tokenIndex1410, depth1410 := position, tokenIndex, depth
-			{
-				position1411 := position
-				depth++
-				{
-					position1412 := position
-					depth++
+				l2009:
 					{
-						position1413, tokenIndex1413, depth1413 := position, tokenIndex, depth
-						if !_rules[ruleident]() {
-							goto l1413
-						}
-						if buffer[position] != rune(':') {
-							goto l1413
+						position2011, tokenIndex2011, depth2011 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2012
 						}
 						position++
-						{
-							position1415, tokenIndex1415, depth1415 := position, tokenIndex, depth
-							if buffer[position] != rune(':') {
-								goto l1415
-							}
-							position++
-							goto l1413
-						l1415:
-							position, tokenIndex, depth = position1415, tokenIndex1415, depth1415
+						goto l2011
+					l2012:
+						position, tokenIndex, depth = position2011, tokenIndex2011, depth2011
+						if buffer[position] != rune('T') {
+							goto l1990
 						}
-						goto l1414
-					l1413:
-						position, tokenIndex, depth = position1413, tokenIndex1413, depth1413
-					}
-				l1414:
-					if buffer[position] != rune('*') {
-						goto l1410
+						position++
 					}
-					position++
+				l2011:
 					depth--
-					add(rulePegText, position1412)
+					add(rulePegText, position1992)
 				}
-				if !_rules[ruleAction88]() {
-					goto l1410
+				if !_rules[ruleAction123]() {
+					goto l1990
 				}
 				depth--
-				add(ruleWildcard, position1411)
+				add(ruleDropNewest, position1991)
 			}
 			return true
-		l1410:
-			position, tokenIndex, depth = position1410, tokenIndex1410, depth1410
+		l1990:
+			position, tokenIndex, depth = position1990, tokenIndex1990, depth1990
 			return false
 		},
-		/* 119 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action89)> */
+		/* 159 StreamIdentifier <- <(<ident> Action124)> */
 		func() bool {
-			position1416, tokenIndex1416, depth1416 := position, tokenIndex, depth
+			position2013, tokenIndex2013, depth2013 := position, tokenIndex, depth
 			{
-				position1417 := position
+				position2014 := position
 				depth++
 				{
-					position1418 := position
+					position2015 := position
 					depth++
-					if buffer[position] != rune('"') {
-						goto l1416
-					}
-					position++
-				l1419:
-					{
-						position1420, tokenIndex1420, depth1420 := position, tokenIndex, depth
-						{
-							position1421, tokenIndex1421, depth1421 := position, tokenIndex, depth
-							if buffer[position] != rune('"') {
-								goto l1422
-							}
-							position++
-							if buffer[position] != rune('"') {
-								goto l1422
-							}
-							position++
-							goto l1421
-						l1422:
-							position, tokenIndex, depth = position1421, tokenIndex1421, depth1421
-							{
-								position1423, tokenIndex1423, depth1423 := position, tokenIndex, depth
-								if buffer[position] != rune('"') {
-									goto l1423
-								}
-								position++
-								goto l1420
-							l1423:
-								position, tokenIndex, depth = position1423, tokenIndex1423, depth1423
-							}
-							if !matchDot() {
-								goto l1420
-							}
-						}
-					l1421:
-						goto l1419
-					l1420:
-						position, tokenIndex, depth = position1420, tokenIndex1420, depth1420
-					}
-					if buffer[position] != rune('"') {
-						goto l1416
+					if !_rules[ruleident]() {
+						goto l2013
 					}
-					position++
 					depth--
-					add(rulePegText, position1418)
+					add(rulePegText, position2015)
 				}
-				if !_rules[ruleAction89]() {
-					goto l1416
+				if !_rules[ruleAction124]() {
+					goto l2013
 				}
 				depth--
-				add(ruleStringLiteral, position1417)
+				add(ruleStreamIdentifier, position2014)
 			}
 			return true
-		l1416:
-			position, tokenIndex, depth = position1416, tokenIndex1416, depth1416
+		l2013:
+			position, tokenIndex, depth = position2013, tokenIndex2013, depth2013
 			return false
 		},
-		/* 120 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action90)> */
+		/* 160 SourceSinkType <- <(<ident> Action125)> */
 		func() bool {
-			position1424, tokenIndex1424, depth1424 := position, tokenIndex, depth
+			position2016, tokenIndex2016, depth2016 := position, tokenIndex, depth
 			{
-				position1425 := position
+				position2017 := position
 				depth++
 				{
-					position1426 := position
+					position2018 := position
 					depth++
-					{
-						position1427, tokenIndex1427, depth1427 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1428
-						}
-						position++
-						goto l1427
-					l1428:
-						position, tokenIndex, depth = position1427, tokenIndex1427, depth1427
-						if buffer[position] != rune('I') {
-							goto l1424
-						}
-						position++
-					}
-				l1427:
-					{
-						position1429, tokenIndex1429, depth1429 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1430
-						}
-						position++
-						goto l1429
-					l1430:
-						position, tokenIndex, depth = position1429, tokenIndex1429, depth1429
-						if buffer[position] != rune('S') {
-							goto l1424
-						}
-						position++
-					}
-				l1429:
-					{
-						position1431, tokenIndex1431, depth1431 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1432
-						}
-						position++
-						goto l1431
-					l1432:
-						position, tokenIndex, depth = position1431, tokenIndex1431, depth1431
-						if buffer[position] != rune('T') {
-							goto l1424
-						}
-						position++
-					}
-				l1431:
-					{
-						position1433, tokenIndex1433, depth1433 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1434
-						}
-						position++
-						goto l1433
-					l1434:
-						position, tokenIndex, depth = position1433, tokenIndex1433, depth1433
-						if buffer[position] != rune('R') {
-							goto l1424
-						}
-						position++
-					}
-				l1433:
-					{
-						position1435, tokenIndex1435, depth1435 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1436
-						}
-						position++
-						goto l1435
-					l1436:
-						position, tokenIndex, depth = position1435, tokenIndex1435, depth1435
-						if buffer[position] != rune('E') {
-							goto l1424
-						}
-						position++
-					}
-				l1435:
-					{
-						position1437, tokenIndex1437, depth1437 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1438
-						}
-						position++
-						goto l1437
-					l1438:
-						position, tokenIndex, depth = position1437, tokenIndex1437, depth1437
-						if buffer[position] != rune('A') {
-							goto l1424
-						}
-						position++
+					if !_rules[ruleident]() {
+						goto l2016
 					}
-				l1437:
-					{
-						position1439, tokenIndex1439, depth1439 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1440
-						}
-						position++
-						goto l1439
-					l1440:
-						position, tokenIndex, depth = position1439, tokenIndex1439, depth1439
-						if buffer[position] != rune('M') {
-							goto l1424
-						}
-						position++
+					depth--
+					add(rulePegText, position2018)
+				}
+				if !_rules[ruleAction125]() {
+					goto l2016
+				}
+				depth--
+				add(ruleSourceSinkType, position2017)
+			}
+			return true
+		l2016:
+			position, tokenIndex, depth = position2016, tokenIndex2016, depth2016
+			return false
+		},
+		/* 161 SourceSinkParamKey <- <(<ident> Action126)> */
+		func() bool {
+			position2019, tokenIndex2019, depth2019 := position, tokenIndex, depth
+			{
+				position2020 := position
+				depth++
+				{
+					position2021 := position
+					depth++
+					if !_rules[ruleident]() {
+						goto l2019
 					}
-				l1439:
 					depth--
-					add(rulePegText, position1426)
+					add(rulePegText, position2021)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1424
+				if !_rules[ruleAction126]() {
+					goto l2019
 				}
 				depth--
-				add(ruleISTREAM, position1425)
+				add(ruleSourceSinkParamKey, position2020)
 			}
 			return true
-		l1424:
-			position, tokenIndex, depth = position1424, tokenIndex1424, depth1424
+		l2019:
+			position, tokenIndex, depth = position2019, tokenIndex2019, depth2019
 			return false
 		},
-		/* 121 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action91)> */
+		/* 162 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action127)> */
 		func() bool {
-			position1441, tokenIndex1441, depth1441 := position, tokenIndex, depth
+			position2022, tokenIndex2022, depth2022 := position, tokenIndex, depth
 			{
-				position1442 := position
+				position2023 := position
 				depth++
 				{
-					position1443 := position
+					position2024 := position
 					depth++
 					{
-						position1444, tokenIndex1444, depth1444 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1445
+						position2025, tokenIndex2025, depth2025 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2026
 						}
 						position++
-						goto l1444
-					l1445:
-						position, tokenIndex, depth = position1444, tokenIndex1444, depth1444
-						if buffer[position] != rune('D') {
-							goto l1441
+						goto l2025
+					l2026:
+						position, tokenIndex, depth = position2025, tokenIndex2025, depth2025
+						if buffer[position] != rune('P') {
+							goto l2022
 						}
 						position++
 					}
-				l1444:
+				l2025:
 					{
-						position1446, tokenIndex1446, depth1446 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1447
+						position2027, tokenIndex2027, depth2027 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2028
 						}
 						position++
-						goto l1446
-					l1447:
-						position, tokenIndex, depth = position1446, tokenIndex1446, depth1446
-						if buffer[position] != rune('S') {
-							goto l1441
+						goto l2027
+					l2028:
+						position, tokenIndex, depth = position2027, tokenIndex2027, depth2027
+						if buffer[position] != rune('A') {
+							goto l2022
 						}
 						position++
 					}
-				l1446:
+				l2027:
 					{
-						position1448, tokenIndex1448, depth1448 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1449
+						position2029, tokenIndex2029, depth2029 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2030
 						}
 						position++
-						goto l1448
-					l1449:
-						position, tokenIndex, depth = position1448, tokenIndex1448, depth1448
-						if buffer[position] != rune('T') {
-							goto l1441
+						goto l2029
+					l2030:
+						position, tokenIndex, depth = position2029, tokenIndex2029, depth2029
+						if buffer[position] != rune('U') {
+							goto l2022
 						}
 						position++
 					}
-				l1448:
+				l2029:
 					{
-						position1450, tokenIndex1450, depth1450 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1451
+						position2031, tokenIndex2031, depth2031 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2032
 						}
 						position++
-						goto l1450
-					l1451:
-						position, tokenIndex, depth = position1450, tokenIndex1450, depth1450
-						if buffer[position] != rune('R') {
-							goto l1441
+						goto l2031
+					l2032:
+						position, tokenIndex, depth = position2031, tokenIndex2031, depth2031
+						if buffer[position] != rune('S') {
+							goto l2022
 						}
 						position++
 					}
-				l1450:
+				l2031:
 					{
-						position1452, tokenIndex1452, depth1452 := position, tokenIndex, depth
+						position2033, tokenIndex2033, depth2033 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1453
+							goto l2034
 						}
 						position++
-						goto l1452
-					l1453:
-						position, tokenIndex, depth = position1452, tokenIndex1452, depth1452
+						goto l2033
+					l2034:
+						position, tokenIndex, depth = position2033, tokenIndex2033, depth2033
 						if buffer[position] != rune('E') {
-							goto l1441
-						}
-						position++
-					}
-				l1452:
-					{
-						position1454, tokenIndex1454, depth1454 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1455
-						}
-						position++
-						goto l1454
-					l1455:
-						position, tokenIndex, depth = position1454, tokenIndex1454, depth1454
-						if buffer[position] != rune('A') {
-							goto l1441
+							goto l2022
 						}
 						position++
 					}
-				l1454:
+				l2033:
 					{
-						position1456, tokenIndex1456, depth1456 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1457
+						position2035, tokenIndex2035, depth2035 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2036
 						}
 						position++
-						goto l1456
-					l1457:
-						position, tokenIndex, depth = position1456, tokenIndex1456, depth1456
-						if buffer[position] != rune('M') {
-							goto l1441
+						goto l2035
+					l2036:
+						position, tokenIndex, depth = position2035, tokenIndex2035, depth2035
+						if buffer[position] != rune('D') {
+							goto l2022
 						}
 						position++
 					}
-				l1456:
+				l2035:
 					depth--
-					add(rulePegText, position1443)
+					add(rulePegText, position2024)
 				}
-				if !_rules[ruleAction91]() {
-					goto l1441
+				if !_rules[ruleAction127]() {
+					goto l2022
 				}
 				depth--
-				add(ruleDSTREAM, position1442)
+				add(rulePaused, position2023)
 			}
 			return true
-		l1441:
-			position, tokenIndex, depth = position1441, tokenIndex1441, depth1441
+		l2022:
+			position, tokenIndex, depth = position2022, tokenIndex2022, depth2022
 			return false
 		},
-		/* 122 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
+		/* 163 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action128)> */
 		func() bool {
-			position1458, tokenIndex1458, depth1458 := position, tokenIndex, depth
+			position2037, tokenIndex2037, depth2037 := position, tokenIndex, depth
 			{
-				position1459 := position
+				position2038 := position
 				depth++
 				{
-					position1460 := position
+					position2039 := position
 					depth++
 					{
-						position1461, tokenIndex1461, depth1461 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1462
+						position2040, tokenIndex2040, depth2040 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2041
 						}
 						position++
-						goto l1461
-					l1462:
-						position, tokenIndex, depth = position1461, tokenIndex1461, depth1461
-						if buffer[position] != rune('R') {
-							goto l1458
+						goto l2040
+					l2041:
+						position, tokenIndex, depth = position2040, tokenIndex2040, depth2040
+						if buffer[position] != rune('U') {
+							goto l2037
 						}
 						position++
 					}
-				l1461:
+				l2040:
 					{
-						position1463, tokenIndex1463, depth1463 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1464
+						position2042, tokenIndex2042, depth2042 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2043
 						}
 						position++
-						goto l1463
-					l1464:
-						position, tokenIndex, depth = position1463, tokenIndex1463, depth1463
-						if buffer[position] != rune('S') {
-							goto l1458
+						goto l2042
+					l2043:
+						position, tokenIndex, depth = position2042, tokenIndex2042, depth2042
+						if buffer[position] != rune('N') {
+							goto l2037
 						}
 						position++
 					}
-				l1463:
+				l2042:
 					{
-						position1465, tokenIndex1465, depth1465 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1466
+						position2044, tokenIndex2044, depth2044 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2045
 						}
 						position++
-						goto l1465
-					l1466:
-						position, tokenIndex, depth = position1465, tokenIndex1465, depth1465
-						if buffer[position] != rune('T') {
-							goto l1458
+						goto l2044
+					l2045:
+						position, tokenIndex, depth = position2044, tokenIndex2044, depth2044
+						if buffer[position] != rune('P') {
+							goto l2037
 						}
 						position++
 					}
-				l1465:
+				l2044:
 					{
-						position1467, tokenIndex1467, depth1467 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1468
+						position2046, tokenIndex2046, depth2046 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2047
 						}
 						position++
-						goto l1467
-					l1468:
-						position, tokenIndex, depth = position1467, tokenIndex1467, depth1467
-						if buffer[position] != rune('R') {
-							goto l1458
+						goto l2046
+					l2047:
+						position, tokenIndex, depth = position2046, tokenIndex2046, depth2046
+						if buffer[position] != rune('A') {
+							goto l2037
 						}
 						position++
 					}
-				l1467:
+				l2046:
 					{
-						position1469, tokenIndex1469, depth1469 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1470
+						position2048, tokenIndex2048, depth2048 := position, tokenIndex, depth
+						if buffer[position] != rune('u') {
+							goto l2049
 						}
 						position++
-						goto l1469
-					l1470:
-						position, tokenIndex, depth = position1469, tokenIndex1469, depth1469
-						if buffer[position] != rune('E') {
-							goto l1458
+						goto l2048
+					l2049:
+						position, tokenIndex, depth = position2048, tokenIndex2048, depth2048
+						if buffer[position] != rune('U') {
+							goto l2037
 						}
 						position++
 					}
-				l1469:
+				l2048:
 					{
-						position1471, tokenIndex1471, depth1471 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1472
+						position2050, tokenIndex2050, depth2050 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2051
 						}
 						position++
-						goto l1471
-					l1472:
-						position, tokenIndex, depth = position1471, tokenIndex1471, depth1471
-						if buffer[position] != rune('A') {
-							goto l1458
+						goto l2050
+					l2051:
+						position, tokenIndex, depth = position2050, tokenIndex2050, depth2050
+						if buffer[position] != rune('S') {
+							goto l2037
 						}
 						position++
 					}
-				l1471:
+				l2050:
 					{
-						position1473, tokenIndex1473, depth1473 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1474
+						position2052, tokenIndex2052, depth2052 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2053
 						}
 						position++
-						goto l1473
-					l1474:
-						position, tokenIndex, depth = position1473, tokenIndex1473, depth1473
-						if buffer[position] != rune('M') {
-							goto l1458
+						goto l2052
+					l2053:
+						position, tokenIndex, depth = position2052, tokenIndex2052, depth2052
+						if buffer[position] != rune('E') {
+							goto l2037
 						}
 						position++
 					}
-				l1473:
+				l2052:
+					{
+						position2054, tokenIndex2054, depth2054 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2055
+						}
+						position++
+						goto l2054
+					l2055:
+						position, tokenIndex, depth = position2054, tokenIndex2054, depth2054
+						if buffer[position] != rune('D') {
+							goto l2037
+						}
+						position++
+					}
+				l2054:
 					depth--
-					add(rulePegText, position1460)
+					add(rulePegText, position2039)
 				}
-				if !_rules[ruleAction92]() {
-					goto l1458
+				if !_rules[ruleAction128]() {
+					goto l2037
 				}
 				depth--
-				add(ruleRSTREAM, position1459)
+				add(ruleUnpaused, position2038)
 			}
 			return true
-		l1458:
-			position, tokenIndex, depth = position1458, tokenIndex1458, depth1458
+		l2037:
+			position, tokenIndex, depth = position2037, tokenIndex2037, depth2037
 			return false
 		},
-		/* 123 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action93)> */
+		/* 164 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action129)> */
 		func() bool {
-			position1475, tokenIndex1475, depth1475 := position, tokenIndex, depth
+			position2056, tokenIndex2056, depth2056 := position, tokenIndex, depth
 			{
-				position1476 := position
+				position2057 := position
 				depth++
 				{
-					position1477 := position
+					position2058 := position
 					depth++
 					{
-						position1478, tokenIndex1478, depth1478 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1479
+						position2059, tokenIndex2059, depth2059 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2060
 						}
 						position++
-						goto l1478
-					l1479:
-						position, tokenIndex, depth = position1478, tokenIndex1478, depth1478
-						if buffer[position] != rune('T') {
-							goto l1475
+						goto l2059
+					l2060:
+						position, tokenIndex, depth = position2059, tokenIndex2059, depth2059
+						if buffer[position] != rune('A') {
+							goto l2056
 						}
 						position++
 					}
-				l1478:
+				l2059:
 					{
-						position1480, tokenIndex1480, depth1480 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1481
+						position2061, tokenIndex2061, depth2061 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2062
 						}
 						position++
-						goto l1480
-					l1481:
-						position, tokenIndex, depth = position1480, tokenIndex1480, depth1480
-						if buffer[position] != rune('U') {
-							goto l1475
+						goto l2061
+					l2062:
+						position, tokenIndex, depth = position2061, tokenIndex2061, depth2061
+						if buffer[position] != rune('S') {
+							goto l2056
 						}
 						position++
 					}
-				l1480:
+				l2061:
 					{
-						position1482, tokenIndex1482, depth1482 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1483
+						position2063, tokenIndex2063, depth2063 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2064
 						}
 						position++
-						goto l1482
-					l1483:
-						position, tokenIndex, depth = position1482, tokenIndex1482, depth1482
-						if buffer[position] != rune('P') {
-							goto l1475
+						goto l2063
+					l2064:
+						position, tokenIndex, depth = position2063, tokenIndex2063, depth2063
+						if buffer[position] != rune('C') {
+							goto l2056
 						}
 						position++
 					}
-				l1482:
+				l2063:
+					depth--
+					add(rulePegText, position2058)
+				}
+				if !_rules[ruleAction129]() {
+					goto l2056
+				}
+				depth--
+				add(ruleAscending, position2057)
+			}
+			return true
+		l2056:
+			position, tokenIndex, depth = position2056, tokenIndex2056, depth2056
+			return false
+		},
+		/* 165 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action130)> */
+		func() bool {
+			position2065, tokenIndex2065, depth2065 := position, tokenIndex, depth
+			{
+				position2066 := position
+				depth++
+				{
+					position2067 := position
+					depth++
 					{
-						position1484, tokenIndex1484, depth1484 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1485
+						position2068, tokenIndex2068, depth2068 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2069
 						}
 						position++
-						goto l1484
-					l1485:
-						position, tokenIndex, depth = position1484, tokenIndex1484, depth1484
-						if buffer[position] != rune('L') {
-							goto l1475
+						goto l2068
+					l2069:
+						position, tokenIndex, depth = position2068, tokenIndex2068, depth2068
+						if buffer[position] != rune('D') {
+							goto l2065
 						}
 						position++
 					}
-				l1484:
+				l2068:
 					{
-						position1486, tokenIndex1486, depth1486 := position, tokenIndex, depth
+						position2070, tokenIndex2070, depth2070 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1487
+							goto l2071
 						}
 						position++
-						goto l1486
-					l1487:
-						position, tokenIndex, depth = position1486, tokenIndex1486, depth1486
+						goto l2070
+					l2071:
+						position, tokenIndex, depth = position2070, tokenIndex2070, depth2070
 						if buffer[position] != rune('E') {
-							goto l1475
+							goto l2065
 						}
 						position++
 					}
-				l1486:
+				l2070:
 					{
-						position1488, tokenIndex1488, depth1488 := position, tokenIndex, depth
+						position2072, tokenIndex2072, depth2072 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1489
+							goto l2073
 						}
 						position++
-						goto l1488
-					l1489:
-						position, tokenIndex, depth = position1488, tokenIndex1488, depth1488
+						goto l2072
+					l2073:
+						position, tokenIndex, depth = position2072, tokenIndex2072, depth2072
 						if buffer[position] != rune('S') {
-							goto l1475
+							goto l2065
 						}
 						position++
 					}
-				l1488:
+				l2072:
+					{
+						position2074, tokenIndex2074, depth2074 := position, tokenIndex, depth
+						if buffer[position] != rune('c') {
+							goto l2075
+						}
+						position++
+						goto l2074
+					l2075:
+						position, tokenIndex, depth = position2074, tokenIndex2074, depth2074
+						if buffer[position] != rune('C') {
+							goto l2065
+						}
+						position++
+					}
+				l2074:
 					depth--
-					add(rulePegText, position1477)
+					add(rulePegText, position2067)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1475
+				if !_rules[ruleAction130]() {
+					goto l2065
+				}
+				depth--
+				add(ruleDescending, position2066)
+			}
+			return true
+		l2065:
+			position, tokenIndex, depth = position2065, tokenIndex2065, depth2065
+			return false
+		},
+		/* 166 Type <- <(Bool / IntervalType / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		func() bool {
+			position2076, tokenIndex2076, depth2076 := position, tokenIndex, depth
+			{
+				position2077 := position
+				depth++
+				{
+					position2078, tokenIndex2078, depth2078 := position, tokenIndex, depth
+					if !_rules[ruleBool]() {
+						goto l2079
+					}
+					goto l2078
+				l2079:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleIntervalType]() {
+						goto l2080
+					}
+					goto l2078
+				l2080:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleInt]() {
+						goto l2081
+					}
+					goto l2078
+				l2081:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleFloat]() {
+						goto l2082
+					}
+					goto l2078
+				l2082:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleString]() {
+						goto l2083
+					}
+					goto l2078
+				l2083:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleBlob]() {
+						goto l2084
+					}
+					goto l2078
+				l2084:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleTimestamp]() {
+						goto l2085
+					}
+					goto l2078
+				l2085:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleArray]() {
+						goto l2086
+					}
+					goto l2078
+				l2086:
+					position, tokenIndex, depth = position2078, tokenIndex2078, depth2078
+					if !_rules[ruleMap]() {
+						goto l2076
+					}
 				}
+			l2078:
 				depth--
-				add(ruleTUPLES, position1476)
+				add(ruleType, position2077)
 			}
 			return true
-		l1475:
-			position, tokenIndex, depth = position1475, tokenIndex1475, depth1475
+		l2076:
+			position, tokenIndex, depth = position2076, tokenIndex2076, depth2076
 			return false
 		},
-		/* 124 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action94)> */
+		/* 167 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action131)> */
 		func() bool {
-			position1490, tokenIndex1490, depth1490 := position, tokenIndex, depth
+			position2087, tokenIndex2087, depth2087 := position, tokenIndex, depth
 			{
-				position1491 := position
+				position2088 := position
 				depth++
 				{
-					position1492 := position
+					position2089 := position
 					depth++
 					{
-						position1493, tokenIndex1493, depth1493 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1494
+						position2090, tokenIndex2090, depth2090 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2091
 						}
 						position++
-						goto l1493
-					l1494:
-						position, tokenIndex, depth = position1493, tokenIndex1493, depth1493
-						if buffer[position] != rune('S') {
-							goto l1490
+						goto l2090
+					l2091:
+						position, tokenIndex, depth = position2090, tokenIndex2090, depth2090
+						if buffer[position] != rune('B') {
+							goto l2087
 						}
 						position++
 					}
-				l1493:
+				l2090:
 					{
-						position1495, tokenIndex1495, depth1495 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1496
+						position2092, tokenIndex2092, depth2092 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2093
 						}
 						position++
-						goto l1495
-					l1496:
-						position, tokenIndex, depth = position1495, tokenIndex1495, depth1495
-						if buffer[position] != rune('E') {
-							goto l1490
+						goto l2092
+					l2093:
+						position, tokenIndex, depth = position2092, tokenIndex2092, depth2092
+						if buffer[position] != rune('O') {
+							goto l2087
 						}
 						position++
 					}
-				l1495:
+				l2092:
 					{
-						position1497, tokenIndex1497, depth1497 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1498
+						position2094, tokenIndex2094, depth2094 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2095
 						}
 						position++
-						goto l1497
-					l1498:
-						position, tokenIndex, depth = position1497, tokenIndex1497, depth1497
-						if buffer[position] != rune('C') {
-							goto l1490
+						goto l2094
+					l2095:
+						position, tokenIndex, depth = position2094, tokenIndex2094, depth2094
+						if buffer[position] != rune('O') {
+							goto l2087
 						}
 						position++
 					}
-				l1497:
+				l2094:
 					{
-						position1499, tokenIndex1499, depth1499 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1500
+						position2096, tokenIndex2096, depth2096 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2097
 						}
 						position++
-						goto l1499
-					l1500:
-						position, tokenIndex, depth = position1499, tokenIndex1499, depth1499
-						if buffer[position] != rune('O') {
-							goto l1490
+						goto l2096
+					l2097:
+						position, tokenIndex, depth = position2096, tokenIndex2096, depth2096
+						if buffer[position] != rune('L') {
+							goto l2087
 						}
 						position++
 					}
-				l1499:
+				l2096:
+					depth--
+					add(rulePegText, position2089)
+				}
+				if !_rules[ruleAction131]() {
+					goto l2087
+				}
+				depth--
+				add(ruleBool, position2088)
+			}
+			return true
+		l2087:
+			position, tokenIndex, depth = position2087, tokenIndex2087, depth2087
+			return false
+		},
+		/* 168 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action132)> */
+		func() bool {
+			position2098, tokenIndex2098, depth2098 := position, tokenIndex, depth
+			{
+				position2099 := position
+				depth++
+				{
+					position2100 := position
+					depth++
 					{
-						position1501, tokenIndex1501, depth1501 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1502
+						position2101, tokenIndex2101, depth2101 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2102
 						}
 						position++
-						goto l1501
-					l1502:
-						position, tokenIndex, depth = position1501, tokenIndex1501, depth1501
-						if buffer[position] != rune('N') {
-							goto l1490
+						goto l2101
+					l2102:
+						position, tokenIndex, depth = position2101, tokenIndex2101, depth2101
+						if buffer[position] != rune('I') {
+							goto l2098
 						}
 						position++
 					}
-				l1501:
+				l2101:
 					{
-						position1503, tokenIndex1503, depth1503 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1504
+						position2103, tokenIndex2103, depth2103 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2104
 						}
 						position++
-						goto l1503
-					l1504:
-						position, tokenIndex, depth = position1503, tokenIndex1503, depth1503
-						if buffer[position] != rune('D') {
-							goto l1490
+						goto l2103
+					l2104:
+						position, tokenIndex, depth = position2103, tokenIndex2103, depth2103
+						if buffer[position] != rune('N') {
+							goto l2098
 						}
 						position++
 					}
-				l1503:
+				l2103:
 					{
-						position1505, tokenIndex1505, depth1505 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1506
+						position2105, tokenIndex2105, depth2105 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2106
 						}
 						position++
-						goto l1505
-					l1506:
-						position, tokenIndex, depth = position1505, tokenIndex1505, depth1505
-						if buffer[position] != rune('S') {
-							goto l1490
+						goto l2105
+					l2106:
+						position, tokenIndex, depth = position2105, tokenIndex2105, depth2105
+						if buffer[position] != rune('T') {
+							goto l2098
 						}
 						position++
 					}
-				l1505:
+				l2105:
 					depth--
-					add(rulePegText, position1492)
+					add(rulePegText, position2100)
 				}
-				if !_rules[ruleAction94]() {
-					goto l1490
+				if !_rules[ruleAction132]() {
+					goto l2098
 				}
 				depth--
-				add(ruleSECONDS, position1491)
+				add(ruleInt, position2099)
 			}
 			return true
-		l1490:
-			position, tokenIndex, depth = position1490, tokenIndex1490, depth1490
+		l2098:
+			position, tokenIndex, depth = position2098, tokenIndex2098, depth2098
 			return false
 		},
-		/* 125 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action95)> */
+		/* 169 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action133)> */
 		func() bool {
-			position1507, tokenIndex1507, depth1507 := position, tokenIndex, depth
+			position2107, tokenIndex2107, depth2107 := position, tokenIndex, depth
 			{
-				position1508 := position
+				position2108 := position
 				depth++
 				{
-					position1509 := position
+					position2109 := position
 					depth++
 					{
-						position1510, tokenIndex1510, depth1510 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1511
+						position2110, tokenIndex2110, depth2110 := position, tokenIndex, depth
+						if buffer[position] != rune('f') {
+							goto l2111
 						}
 						position++
-						goto l1510
-					l1511:
-						position, tokenIndex, depth = position1510, tokenIndex1510, depth1510
-						if buffer[position] != rune('M') {
-							goto l1507
+						goto l2110
+					l2111:
+						position, tokenIndex, depth = position2110, tokenIndex2110, depth2110
+						if buffer[position] != rune('F') {
+							goto l2107
 						}
 						position++
 					}
-				l1510:
+				l2110:
 					{
-						position1512, tokenIndex1512, depth1512 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1513
+						position2112, tokenIndex2112, depth2112 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2113
 						}
 						position++
-						goto l1512
-					l1513:
-						position, tokenIndex, depth = position1512, tokenIndex1512, depth1512
-						if buffer[position] != rune('I') {
-							goto l1507
+						goto l2112
+					l2113:
+						position, tokenIndex, depth = position2112, tokenIndex2112, depth2112
+						if buffer[position] != rune('L') {
+							goto l2107
 						}
 						position++
 					}
-				l1512:
+				l2112:
 					{
-						position1514, tokenIndex1514, depth1514 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1515
+						position2114, tokenIndex2114, depth2114 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2115
 						}
 						position++
-						goto l1514
-					l1515:
-						position, tokenIndex, depth = position1514, tokenIndex1514, depth1514
-						if buffer[position] != rune('L') {
-							goto l1507
+						goto l2114
+					l2115:
+						position, tokenIndex, depth = position2114, tokenIndex2114, depth2114
+						if buffer[position] != rune('O') {
+							goto l2107
 						}
 						position++
 					}
-				l1514:
+				l2114:
 					{
-						position1516, tokenIndex1516, depth1516 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1517
+						position2116, tokenIndex2116, depth2116 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2117
 						}
 						position++
-						goto l1516
-					l1517:
-						position, tokenIndex, depth = position1516, tokenIndex1516, depth1516
-						if buffer[position] != rune('L') {
-							goto l1507
+						goto l2116
+					l2117:
+						position, tokenIndex, depth = position2116, tokenIndex2116, depth2116
+						if buffer[position] != rune('A') {
+							goto l2107
 						}
 						position++
 					}
-				l1516:
+				l2116:
 					{
-						position1518, tokenIndex1518, depth1518 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1519
+						position2118, tokenIndex2118, depth2118 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2119
 						}
 						position++
-						goto l1518
-					l1519:
-						position, tokenIndex, depth = position1518, tokenIndex1518, depth1518
-						if buffer[position] != rune('I') {
-							goto l1507
+						goto l2118
+					l2119:
+						position, tokenIndex, depth = position2118, tokenIndex2118, depth2118
+						if buffer[position] != rune('T') {
+							goto l2107
 						}
 						position++
 					}
-				l1518:
+				l2118:
+					depth--
+					add(rulePegText, position2109)
+				}
+				if !_rules[ruleAction133]() {
+					goto l2107
+				}
+				depth--
+				add(ruleFloat, position2108)
+			}
+			return true
+		l2107:
+			position, tokenIndex, depth = position2107, tokenIndex2107, depth2107
+			return false
+		},
+		/* 170 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action134)> */
+		func() bool {
+			position2120, tokenIndex2120, depth2120 := position, tokenIndex, depth
+			{
+				position2121 := position
+				depth++
+				{
+					position2122 := position
+					depth++
 					{
-						position1520, tokenIndex1520, depth1520 := position, tokenIndex, depth
+						position2123, tokenIndex2123, depth2123 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1521
+							goto l2124
 						}
 						position++
-						goto l1520
-					l1521:
-						position, tokenIndex, depth = position1520, tokenIndex1520, depth1520
+						goto l2123
+					l2124:
+						position, tokenIndex, depth = position2123, tokenIndex2123, depth2123
 						if buffer[position] != rune('S') {
-							goto l1507
+							goto l2120
 						}
 						position++
 					}
-				l1520:
+				l2123:
 					{
-						position1522, tokenIndex1522, depth1522 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1523
+						position2125, tokenIndex2125, depth2125 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2126
 						}
 						position++
-						goto l1522
-					l1523:
-						position, tokenIndex, depth = position1522, tokenIndex1522, depth1522
-						if buffer[position] != rune('E') {
-							goto l1507
+						goto l2125
+					l2126:
+						position, tokenIndex, depth = position2125, tokenIndex2125, depth2125
+						if buffer[position] != rune('T') {
+							goto l2120
 						}
 						position++
 					}
-				l1522:
+				l2125:
 					{
-						position1524, tokenIndex1524, depth1524 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1525
+						position2127, tokenIndex2127, depth2127 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2128
 						}
 						position++
-						goto l1524
-					l1525:
-						position, tokenIndex, depth = position1524, tokenIndex1524, depth1524
-						if buffer[position] != rune('C') {
-							goto l1507
+						goto l2127
+					l2128:
+						position, tokenIndex, depth = position2127, tokenIndex2127, depth2127
+						if buffer[position] != rune('R') {
+							goto l2120
 						}
 						position++
 					}
-				l1524:
+				l2127:
 					{
-						position1526, tokenIndex1526, depth1526 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1527
+						position2129, tokenIndex2129, depth2129 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2130
 						}
 						position++
-						goto l1526
-					l1527:
-						position, tokenIndex, depth = position1526, tokenIndex1526, depth1526
-						if buffer[position] != rune('O') {
-							goto l1507
+						goto l2129
+					l2130:
+						position, tokenIndex, depth = position2129, tokenIndex2129, depth2129
+						if buffer[position] != rune('I') {
+							goto l2120
 						}
 						position++
 					}
-				l1526:
+				l2129:
 					{
-						position1528, tokenIndex1528, depth1528 := position, tokenIndex, depth
+						position2131, tokenIndex2131, depth2131 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1529
+							goto l2132
 						}
 						position++
-						goto l1528
-					l1529:
-						position, tokenIndex, depth = position1528, tokenIndex1528, depth1528
+						goto l2131
+					l2132:
+						position, tokenIndex, depth = position2131, tokenIndex2131, depth2131
 						if buffer[position] != rune('N') {
-							goto l1507
+							goto l2120
 						}
 						position++
 					}
-				l1528:
-					{
-						position1530, tokenIndex1530, depth1530 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1531
-						}
-						position++
-						goto l1530
-					l1531:
-						position, tokenIndex, depth = position1530, tokenIndex1530, depth1530
-						if buffer[position] != rune('D') {
-							goto l1507
-						}
-						position++
-					}
-				l1530:
+				l2131:
 					{
-						position1532, tokenIndex1532, depth1532 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1533
+						position2133, tokenIndex2133, depth2133 := position, tokenIndex, depth
+						if buffer[position] != rune('g') {
+							goto l2134
 						}
 						position++
-						goto l1532
-					l1533:
-						position, tokenIndex, depth = position1532, tokenIndex1532, depth1532
-						if buffer[position] != rune('S') {
-							goto l1507
+						goto l2133
+					l2134:
+						position, tokenIndex, depth = position2133, tokenIndex2133, depth2133
+						if buffer[position] != rune('G') {
+							goto l2120
 						}
 						position++
 					}
-				l1532:
+				l2133:
 					depth--
-					add(rulePegText, position1509)
+					add(rulePegText, position2122)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1507
+				if !_rules[ruleAction134]() {
+					goto l2120
 				}
 				depth--
-				add(ruleMILLISECONDS, position1508)
+				add(ruleString, position2121)
 			}
 			return true
-		l1507:
-			position, tokenIndex, depth = position1507, tokenIndex1507, depth1507
+		l2120:
+			position, tokenIndex, depth = position2120, tokenIndex2120, depth2120
 			return false
 		},
-		/* 126 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action96)> */
+		/* 171 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action135)> */
 		func() bool {
-			position1534, tokenIndex1534, depth1534 := position, tokenIndex, depth
+			position2135, tokenIndex2135, depth2135 := position, tokenIndex, depth
 			{
-				position1535 := position
+				position2136 := position
 				depth++
 				{
-					position1536 := position
+					position2137 := position
 					depth++
 					{
-						position1537, tokenIndex1537, depth1537 := position, tokenIndex, depth
-						if buffer[position] != rune('w') {
-							goto l1538
+						position2138, tokenIndex2138, depth2138 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2139
 						}
 						position++
-						goto l1537
-					l1538:
-						position, tokenIndex, depth = position1537, tokenIndex1537, depth1537
-						if buffer[position] != rune('W') {
-							goto l1534
+						goto l2138
+					l2139:
+						position, tokenIndex, depth = position2138, tokenIndex2138, depth2138
+						if buffer[position] != rune('B') {
+							goto l2135
 						}
 						position++
 					}
-				l1537:
+				l2138:
 					{
-						position1539, tokenIndex1539, depth1539 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1540
+						position2140, tokenIndex2140, depth2140 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2141
 						}
 						position++
-						goto l1539
-					l1540:
-						position, tokenIndex, depth = position1539, tokenIndex1539, depth1539
-						if buffer[position] != rune('A') {
-							goto l1534
+						goto l2140
+					l2141:
+						position, tokenIndex, depth = position2140, tokenIndex2140, depth2140
+						if buffer[position] != rune('L') {
+							goto l2135
 						}
 						position++
 					}
-				l1539:
+				l2140:
 					{
-						position1541, tokenIndex1541, depth1541 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1542
+						position2142, tokenIndex2142, depth2142 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2143
 						}
 						position++
-						goto l1541
-					l1542:
-						position, tokenIndex, depth = position1541, tokenIndex1541, depth1541
-						if buffer[position] != rune('I') {
-							goto l1534
+						goto l2142
+					l2143:
+						position, tokenIndex, depth = position2142, tokenIndex2142, depth2142
+						if buffer[position] != rune('O') {
+							goto l2135
 						}
 						position++
 					}
-				l1541:
+				l2142:
 					{
-						position1543, tokenIndex1543, depth1543 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1544
+						position2144, tokenIndex2144, depth2144 := position, tokenIndex, depth
+						if buffer[position] != rune('b') {
+							goto l2145
 						}
 						position++
-						goto l1543
-					l1544:
-						position, tokenIndex, depth = position1543, tokenIndex1543, depth1543
-						if buffer[position] != rune('T') {
-							goto l1534
+						goto l2144
+					l2145:
+						position, tokenIndex, depth = position2144, tokenIndex2144, depth2144
+						if buffer[position] != rune('B') {
+							goto l2135
 						}
 						position++
 					}
-				l1543:
+				l2144:
 					depth--
-					add(rulePegText, position1536)
+					add(rulePegText, position2137)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1534
+				if !_rules[ruleAction135]() {
+					goto l2135
 				}
 				depth--
-				add(ruleWait, position1535)
+				add(ruleBlob, position2136)
 			}
 			return true
-		l1534:
-			position, tokenIndex, depth = position1534, tokenIndex1534, depth1534
+		l2135:
+			position, tokenIndex, depth = position2135, tokenIndex2135, depth2135
 			return false
 		},
-		/* 127 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action97)> */
+		/* 172 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action136)> */
 		func() bool {
-			position1545, tokenIndex1545, depth1545 := position, tokenIndex, depth
+			position2146, tokenIndex2146, depth2146 := position, tokenIndex, depth
 			{
-				position1546 := position
+				position2147 := position
 				depth++
 				{
-					position1547 := position
+					position2148 := position
 					depth++
 					{
-						position1548, tokenIndex1548, depth1548 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1549
-						}
-						position++
-						goto l1548
-					l1549:
-						position, tokenIndex, depth = position1548, tokenIndex1548, depth1548
-						if buffer[position] != rune('D') {
-							goto l1545
-						}
-						position++
-					}
-				l1548:
-					{
-						position1550, tokenIndex1550, depth1550 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1551
+						position2149, tokenIndex2149, depth2149 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2150
 						}
 						position++
-						goto l1550
-					l1551:
-						position, tokenIndex, depth = position1550, tokenIndex1550, depth1550
-						if buffer[position] != rune('R') {
-							goto l1545
+						goto l2149
+					l2150:
+						position, tokenIndex, depth = position2149, tokenIndex2149, depth2149
+						if buffer[position] != rune('T') {
+							goto l2146
 						}
 						position++
 					}
-				l1550:
+				l2149:
 					{
-						position1552, tokenIndex1552, depth1552 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1553
+						position2151, tokenIndex2151, depth2151 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2152
 						}
 						position++
-						goto l1552
-					l1553:
-						position, tokenIndex, depth = position1552, tokenIndex1552, depth1552
-						if buffer[position] != rune('O') {
-							goto l1545
+						goto l2151
+					l2152:
+						position, tokenIndex, depth = position2151, tokenIndex2151, depth2151
+						if buffer[position] != rune('I') {
+							goto l2146
 						}
 						position++
 					}
-				l1552:
+				l2151:
 					{
-						position1554, tokenIndex1554, depth1554 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1555
+						position2153, tokenIndex2153, depth2153 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2154
 						}
 						position++
-						goto l1554
-					l1555:
-						position, tokenIndex, depth = position1554, tokenIndex1554, depth1554
-						if buffer[position] != rune('P') {
-							goto l1545
+						goto l2153
+					l2154:
+						position, tokenIndex, depth = position2153, tokenIndex2153, depth2153
+						if buffer[position] != rune('M') {
+							goto l2146
 						}
 						position++
 					}
-				l1554:
-					if !_rules[rulesp]() {
-						goto l1545
-					}
+				l2153:
 					{
-						position1556, tokenIndex1556, depth1556 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1557
+						position2155, tokenIndex2155, depth2155 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2156
 						}
 						position++
-						goto l1556
-					l1557:
-						position, tokenIndex, depth = position1556, tokenIndex1556, depth1556
-						if buffer[position] != rune('O') {
-							goto l1545
+						goto l2155
+					l2156:
+						position, tokenIndex, depth = position2155, tokenIndex2155, depth2155
+						if buffer[position] != rune('E') {
+							goto l2146
 						}
 						position++
 					}
-				l1556:
+				l2155:
 					{
-						position1558, tokenIndex1558, depth1558 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1559
+						position2157, tokenIndex2157, depth2157 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2158
 						}
 						position++
-						goto l1558
-					l1559:
-						position, tokenIndex, depth = position1558, tokenIndex1558, depth1558
-						if buffer[position] != rune('L') {
-							goto l1545
+						goto l2157
+					l2158:
+						position, tokenIndex, depth = position2157, tokenIndex2157, depth2157
+						if buffer[position] != rune('S') {
+							goto l2146
 						}
 						position++
 					}
-				l1558:
+				l2157:
 					{
-						position1560, tokenIndex1560, depth1560 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1561
+						position2159, tokenIndex2159, depth2159 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2160
 						}
 						position++
-						goto l1560
-					l1561:
-						position, tokenIndex, depth = position1560, tokenIndex1560, depth1560
-						if buffer[position] != rune('D') {
-							goto l1545
+						goto l2159
+					l2160:
+						position, tokenIndex, depth = position2159, tokenIndex2159, depth2159
+						if buffer[position] != rune('T') {
+							goto l2146
 						}
 						position++
 					}
-				l1560:
+				l2159:
 					{
-						position1562, tokenIndex1562, depth1562 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1563
+						position2161, tokenIndex2161, depth2161 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2162
 						}
 						position++
-						goto l1562
-					l1563:
-						position, tokenIndex, depth = position1562, tokenIndex1562, depth1562
-						if buffer[position] != rune('E') {
-							goto l1545
+						goto l2161
+					l2162:
+						position, tokenIndex, depth = position2161, tokenIndex2161, depth2161
+						if buffer[position] != rune('A') {
+							goto l2146
 						}
 						position++
 					}
-				l1562:
+				l2161:
 					{
-						position1564, tokenIndex1564, depth1564 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1565
+						position2163, tokenIndex2163, depth2163 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2164
 						}
 						position++
-						goto l1564
-					l1565:
-						position, tokenIndex, depth = position1564, tokenIndex1564, depth1564
-						if buffer[position] != rune('S') {
-							goto l1545
+						goto l2163
+					l2164:
+						position, tokenIndex, depth = position2163, tokenIndex2163, depth2163
+						if buffer[position] != rune('M') {
+							goto l2146
 						}
 						position++
 					}
-				l1564:
+				l2163:
 					{
-						position1566, tokenIndex1566, depth1566 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1567
+						position2165, tokenIndex2165, depth2165 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2166
 						}
 						position++
-						goto l1566
-					l1567:
-						position, tokenIndex, depth = position1566, tokenIndex1566, depth1566
-						if buffer[position] != rune('T') {
-							goto l1545
+						goto l2165
+					l2166:
+						position, tokenIndex, depth = position2165, tokenIndex2165, depth2165
+						if buffer[position] != rune('P') {
+							goto l2146
 						}
 						position++
 					}
-				l1566:
+				l2165:
 					depth--
-					add(rulePegText, position1547)
+					add(rulePegText, position2148)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1545
+				if !_rules[ruleAction136]() {
+					goto l2146
 				}
 				depth--
-				add(ruleDropOldest, position1546)
+				add(ruleTimestamp, position2147)
 			}
 			return true
-		l1545:
-			position, tokenIndex, depth = position1545, tokenIndex1545, depth1545
+		l2146:
+			position, tokenIndex, depth = position2146, tokenIndex2146, depth2146
 			return false
 		},
-		/* 128 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action98)> */
+		/* 173 IntervalType <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('a' / 'A') ('l' / 'L'))> Action137)> */
 		func() bool {
-			position1568, tokenIndex1568, depth1568 := position, tokenIndex, depth
+			position2167, tokenIndex2167, depth2167 := position, tokenIndex, depth
 			{
-				position1569 := position
+				position2168 := position
 				depth++
 				{
-					position1570 := position
+					position2169 := position
 					depth++
 					{
-						position1571, tokenIndex1571, depth1571 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1572
-						}
-						position++
-						goto l1571
-					l1572:
-						position, tokenIndex, depth = position1571, tokenIndex1571, depth1571
-						if buffer[position] != rune('D') {
-							goto l1568
-						}
-						position++
-					}
-				l1571:
-					{
-						position1573, tokenIndex1573, depth1573 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1574
-						}
-						position++
-						goto l1573
-					l1574:
-						position, tokenIndex, depth = position1573, tokenIndex1573, depth1573
-						if buffer[position] != rune('R') {
-							goto l1568
-						}
-						position++
-					}
-				l1573:
-					{
-						position1575, tokenIndex1575, depth1575 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1576
+						position2170, tokenIndex2170, depth2170 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2171
 						}
 						position++
-						goto l1575
-					l1576:
-						position, tokenIndex, depth = position1575, tokenIndex1575, depth1575
-						if buffer[position] != rune('O') {
-							goto l1568
+						goto l2170
+					l2171:
+						position, tokenIndex, depth = position2170, tokenIndex2170, depth2170
+						if buffer[position] != rune('I') {
+							goto l2167
 						}
 						position++
 					}
-				l1575:
+				l2170:
 					{
-						position1577, tokenIndex1577, depth1577 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1578
+						position2172, tokenIndex2172, depth2172 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2173
 						}
 						position++
-						goto l1577
-					l1578:
-						position, tokenIndex, depth = position1577, tokenIndex1577, depth1577
-						if buffer[position] != rune('P') {
-							goto l1568
+						goto l2172
+					l2173:
+						position, tokenIndex, depth = position2172, tokenIndex2172, depth2172
+						if buffer[position] != rune('N') {
+							goto l2167
 						}
 						position++
 					}
-				l1577:
-					if !_rules[rulesp]() {
-						goto l1568
-					}
+				l2172:
 					{
-						position1579, tokenIndex1579, depth1579 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1580
+						position2174, tokenIndex2174, depth2174 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2175
 						}
 						position++
-						goto l1579
-					l1580:
-						position, tokenIndex, depth = position1579, tokenIndex1579, depth1579
-						if buffer[position] != rune('N') {
-							goto l1568
+						goto l2174
+					l2175:
+						position, tokenIndex, depth = position2174, tokenIndex2174, depth2174
+						if buffer[position] != rune('T') {
+							goto l2167
 						}
 						position++
 					}
-				l1579:
+				l2174:
 					{
-						position1581, tokenIndex1581, depth1581 := position, tokenIndex, depth
+						position2176, tokenIndex2176, depth2176 := position, tokenIndex, depth
 						if buffer[position] != rune('e') {
-							goto l1582
+							goto l2177
 						}
 						position++
-						goto l1581
-					l1582:
-						position, tokenIndex, depth = position1581, tokenIndex1581, depth1581
+						goto l2176
+					l2177:
+						position, tokenIndex, depth = position2176, tokenIndex2176, depth2176
 						if buffer[position] != rune('E') {
-							goto l1568
+							goto l2167
 						}
 						position++
 					}
-				l1581:
+				l2176:
 					{
-						position1583, tokenIndex1583, depth1583 := position, tokenIndex, depth
-						if buffer[position] != rune('w') {
-							goto l1584
+						position2178, tokenIndex2178, depth2178 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2179
 						}
 						position++
-						goto l1583
-					l1584:
-						position, tokenIndex, depth = position1583, tokenIndex1583, depth1583
-						if buffer[position] != rune('W') {
-							goto l1568
+						goto l2178
+					l2179:
+						position, tokenIndex, depth = position2178, tokenIndex2178, depth2178
+						if buffer[position] != rune('R') {
+							goto l2167
 						}
 						position++
 					}
-				l1583:
+				l2178:
 					{
-						position1585, tokenIndex1585, depth1585 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1586
+						position2180, tokenIndex2180, depth2180 := position, tokenIndex, depth
+						if buffer[position] != rune('v') {
+							goto l2181
 						}
 						position++
-						goto l1585
-					l1586:
-						position, tokenIndex, depth = position1585, tokenIndex1585, depth1585
-						if buffer[position] != rune('E') {
-							goto l1568
+						goto l2180
+					l2181:
+						position, tokenIndex, depth = position2180, tokenIndex2180, depth2180
+						if buffer[position] != rune('V') {
+							goto l2167
 						}
 						position++
 					}
-				l1585:
+				l2180:
 					{
-						position1587, tokenIndex1587, depth1587 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1588
+						position2182, tokenIndex2182, depth2182 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2183
 						}
 						position++
-						goto l1587
-					l1588:
-						position, tokenIndex, depth = position1587, tokenIndex1587, depth1587
-						if buffer[position] != rune('S') {
-							goto l1568
+						goto l2182
+					l2183:
+						position, tokenIndex, depth = position2182, tokenIndex2182, depth2182
+						if buffer[position] != rune('A') {
+							goto l2167
 						}
 						position++
 					}
-				l1587:
+				l2182:
 					{
-						position1589, tokenIndex1589, depth1589 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1590
+						position2184, tokenIndex2184, depth2184 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2185
 						}
 						position++
-						goto l1589
-					l1590:
-						position, tokenIndex, depth = position1589, tokenIndex1589, depth1589
-						if buffer[position] != rune('T') {
-							goto l1568
+						goto l2184
+					l2185:
+						position, tokenIndex, depth = position2184, tokenIndex2184, depth2184
+						if buffer[position] != rune('L') {
+							goto l2167
 						}
 						position++
 					}
-				l1589:
-					depth--
-					add(rulePegText, position1570)
-				}
-				if !_rules[ruleAction98]() {
-					goto l1568
-				}
-				depth--
-				add(ruleDropNewest, position1569)
-			}
-			return true
-		l1568:
-			position, tokenIndex, depth = position1568, tokenIndex1568, depth1568
-			return false
-		},
-		/* 129 StreamIdentifier <- <(<ident> Action99)> */
-		func() bool {
-			position1591, tokenIndex1591, depth1591 := position, tokenIndex, depth
-			{
-				position1592 := position
-				depth++
-				{
-					position1593 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1591
-					}
-					depth--
-					add(rulePegText, position1593)
-				}
-				if !_rules[ruleAction99]() {
-					goto l1591
-				}
-				depth--
-				add(ruleStreamIdentifier, position1592)
-			}
-			return true
-		l1591:
-			position, tokenIndex, depth = position1591, tokenIndex1591, depth1591
-			return false
-		},
-		/* 130 SourceSinkType <- <(<ident> Action100)> */
-		func() bool {
-			position1594, tokenIndex1594, depth1594 := position, tokenIndex, depth
-			{
-				position1595 := position
-				depth++
-				{
-					position1596 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1594
-					}
-					depth--
-					add(rulePegText, position1596)
-				}
-				if !_rules[ruleAction100]() {
-					goto l1594
-				}
-				depth--
-				add(ruleSourceSinkType, position1595)
-			}
-			return true
-		l1594:
-			position, tokenIndex, depth = position1594, tokenIndex1594, depth1594
-			return false
-		},
-		/* 131 SourceSinkParamKey <- <(<ident> Action101)> */
-		func() bool {
-			position1597, tokenIndex1597, depth1597 := position, tokenIndex, depth
-			{
-				position1598 := position
-				depth++
-				{
-					position1599 := position
-					depth++
-					if !_rules[ruleident]() {
-						goto l1597
-					}
+				l2184:
 					depth--
-					add(rulePegText, position1599)
+					add(rulePegText, position2169)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1597
+				if !_rules[ruleAction137]() {
+					goto l2167
 				}
 				depth--
-				add(ruleSourceSinkParamKey, position1598)
+				add(ruleIntervalType, position2168)
 			}
 			return true
-		l1597:
-			position, tokenIndex, depth = position1597, tokenIndex1597, depth1597
+		l2167:
+			position, tokenIndex, depth = position2167, tokenIndex2167, depth2167
 			return false
 		},
-		/* 132 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action102)> */
+		/* 174 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action138)> */
 		func() bool {
-			position1600, tokenIndex1600, depth1600 := position, tokenIndex, depth
+			position2186, tokenIndex2186, depth2186 := position, tokenIndex, depth
 			{
-				position1601 := position
+				position2187 := position
 				depth++
 				{
-					position1602 := position
+					position2188 := position
 					depth++
 					{
-						position1603, tokenIndex1603, depth1603 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1604
-						}
-						position++
-						goto l1603
-					l1604:
-						position, tokenIndex, depth = position1603, tokenIndex1603, depth1603
-						if buffer[position] != rune('P') {
-							goto l1600
-						}
-						position++
-					}
-				l1603:
-					{
-						position1605, tokenIndex1605, depth1605 := position, tokenIndex, depth
+						position2189, tokenIndex2189, depth2189 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l1606
+							goto l2190
 						}
 						position++
-						goto l1605
-					l1606:
-						position, tokenIndex, depth = position1605, tokenIndex1605, depth1605
+						goto l2189
+					l2190:
+						position, tokenIndex, depth = position2189, tokenIndex2189, depth2189
 						if buffer[position] != rune('A') {
-							goto l1600
+							goto l2186
 						}
 						position++
 					}
-				l1605:
+				l2189:
 					{
-						position1607, tokenIndex1607, depth1607 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1608
+						position2191, tokenIndex2191, depth2191 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2192
 						}
 						position++
-						goto l1607
-					l1608:
-						position, tokenIndex, depth = position1607, tokenIndex1607, depth1607
-						if buffer[position] != rune('U') {
-							goto l1600
+						goto l2191
+					l2192:
+						position, tokenIndex, depth = position2191, tokenIndex2191, depth2191
+						if buffer[position] != rune('R') {
+							goto l2186
 						}
 						position++
 					}
-				l1607:
+				l2191:
 					{
-						position1609, tokenIndex1609, depth1609 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1610
+						position2193, tokenIndex2193, depth2193 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2194
 						}
 						position++
-						goto l1609
-					l1610:
-						position, tokenIndex, depth = position1609, tokenIndex1609, depth1609
-						if buffer[position] != rune('S') {
-							goto l1600
+						goto l2193
+					l2194:
+						position, tokenIndex, depth = position2193, tokenIndex2193, depth2193
+						if buffer[position] != rune('R') {
+							goto l2186
 						}
 						position++
 					}
-				l1609:
+				l2193:
 					{
-						position1611, tokenIndex1611, depth1611 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1612
+						position2195, tokenIndex2195, depth2195 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2196
 						}
 						position++
-						goto l1611
-					l1612:
-						position, tokenIndex, depth = position1611, tokenIndex1611, depth1611
-						if buffer[position] != rune('E') {
-							goto l1600
+						goto l2195
+					l2196:
+						position, tokenIndex, depth = position2195, tokenIndex2195, depth2195
+						if buffer[position] != rune('A') {
+							goto l2186
 						}
 						position++
 					}
-				l1611:
+				l2195:
 					{
-						position1613, tokenIndex1613, depth1613 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1614
+						position2197, tokenIndex2197, depth2197 := position, tokenIndex, depth
+						if buffer[position] != rune('y') {
+							goto l2198
 						}
 						position++
-						goto l1613
-					l1614:
-						position, tokenIndex, depth = position1613, tokenIndex1613, depth1613
-						if buffer[position] != rune('D') {
-							goto l1600
+						goto l2197
+					l2198:
+						position, tokenIndex, depth = position2197, tokenIndex2197, depth2197
+						if buffer[position] != rune('Y') {
+							goto l2186
 						}
 						position++
 					}
-				l1613:
+				l2197:
 					depth--
-					add(rulePegText, position1602)
+					add(rulePegText, position2188)
 				}
-				if !_rules[ruleAction102]() {
-					goto l1600
+				if !_rules[ruleAction138]() {
+					goto l2186
 				}
 				depth--
-				add(rulePaused, position1601)
+				add(ruleArray, position2187)
 			}
 			return true
-		l1600:
-			position, tokenIndex, depth = position1600, tokenIndex1600, depth1600
+		l2186:
+			position, tokenIndex, depth = position2186, tokenIndex2186, depth2186
 			return false
 		},
-		/* 133 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action103)> */
+		/* 175 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action139)> */
 		func() bool {
-			position1615, tokenIndex1615, depth1615 := position, tokenIndex, depth
+			position2199, tokenIndex2199, depth2199 := position, tokenIndex, depth
 			{
-				position1616 := position
+				position2200 := position
 				depth++
 				{
-					position1617 := position
+					position2201 := position
 					depth++
 					{
-						position1618, tokenIndex1618, depth1618 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1619
-						}
-						position++
-						goto l1618
-					l1619:
-						position, tokenIndex, depth = position1618, tokenIndex1618, depth1618
-						if buffer[position] != rune('U') {
-							goto l1615
-						}
-						position++
-					}
-				l1618:
-					{
-						position1620, tokenIndex1620, depth1620 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1621
-						}
-						position++
-						goto l1620
-					l1621:
-						position, tokenIndex, depth = position1620, tokenIndex1620, depth1620
-						if buffer[position] != rune('N') {
-							goto l1615
-						}
-						position++
-					}
-				l1620:
-					{
-						position1622, tokenIndex1622, depth1622 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1623
+						position2202, tokenIndex2202, depth2202 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2203
 						}
 						position++
-						goto l1622
-					l1623:
-						position, tokenIndex, depth = position1622, tokenIndex1622, depth1622
-						if buffer[position] != rune('P') {
-							goto l1615
+						goto l2202
+					l2203:
+						position, tokenIndex, depth = position2202, tokenIndex2202, depth2202
+						if buffer[position] != rune('M') {
+							goto l2199
 						}
 						position++
 					}
-				l1622:
+				l2202:
 					{
-						position1624, tokenIndex1624, depth1624 := position, tokenIndex, depth
+						position2204, tokenIndex2204, depth2204 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l1625
+							goto l2205
 						}
 						position++
-						goto l1624
-					l1625:
-						position, tokenIndex, depth = position1624, tokenIndex1624, depth1624
+						goto l2204
+					l2205:
+						position, tokenIndex, depth = position2204, tokenIndex2204, depth2204
 						if buffer[position] != rune('A') {
-							goto l1615
-						}
-						position++
-					}
-				l1624:
-					{
-						position1626, tokenIndex1626, depth1626 := position, tokenIndex, depth
-						if buffer[position] != rune('u') {
-							goto l1627
-						}
-						position++
-						goto l1626
-					l1627:
-						position, tokenIndex, depth = position1626, tokenIndex1626, depth1626
-						if buffer[position] != rune('U') {
-							goto l1615
+							goto l2199
 						}
 						position++
 					}
-				l1626:
+				l2204:
 					{
-						position1628, tokenIndex1628, depth1628 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1629
+						position2206, tokenIndex2206, depth2206 := position, tokenIndex, depth
+						if buffer[position] != rune('p') {
+							goto l2207
 						}
 						position++
-						goto l1628
-					l1629:
-						position, tokenIndex, depth = position1628, tokenIndex1628, depth1628
-						if buffer[position] != rune('S') {
-							goto l1615
+						goto l2206
+					l2207:
+						position, tokenIndex, depth = position2206, tokenIndex2206, depth2206
+						if buffer[position] != rune('P') {
+							goto l2199
 						}
 						position++
 					}
-				l1628:
+				l2206:
+					depth--
+					add(rulePegText, position2201)
+				}
+				if !_rules[ruleAction139]() {
+					goto l2199
+				}
+				depth--
+				add(ruleMap, position2200)
+			}
+			return true
+		l2199:
+			position, tokenIndex, depth = position2199, tokenIndex2199, depth2199
+			return false
+		},
+		/* 176 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action140)> */
+		func() bool {
+			position2208, tokenIndex2208, depth2208 := position, tokenIndex, depth
+			{
+				position2209 := position
+				depth++
+				{
+					position2210 := position
+					depth++
 					{
-						position1630, tokenIndex1630, depth1630 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1631
+						position2211, tokenIndex2211, depth2211 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2212
 						}
 						position++
-						goto l1630
-					l1631:
-						position, tokenIndex, depth = position1630, tokenIndex1630, depth1630
-						if buffer[position] != rune('E') {
-							goto l1615
+						goto l2211
+					l2212:
+						position, tokenIndex, depth = position2211, tokenIndex2211, depth2211
+						if buffer[position] != rune('O') {
+							goto l2208
 						}
 						position++
 					}
-				l1630:
+				l2211:
 					{
-						position1632, tokenIndex1632, depth1632 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1633
+						position2213, tokenIndex2213, depth2213 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2214
 						}
 						position++
-						goto l1632
-					l1633:
-						position, tokenIndex, depth = position1632, tokenIndex1632, depth1632
-						if buffer[position] != rune('D') {
-							goto l1615
+						goto l2213
+					l2214:
+						position, tokenIndex, depth = position2213, tokenIndex2213, depth2213
+						if buffer[position] != rune('R') {
+							goto l2208
 						}
 						position++
 					}
-				l1632:
+				l2213:
 					depth--
-					add(rulePegText, position1617)
+					add(rulePegText, position2210)
 				}
-				if !_rules[ruleAction103]() {
-					goto l1615
+				if !_rules[ruleAction140]() {
+					goto l2208
 				}
 				depth--
-				add(ruleUnpaused, position1616)
+				add(ruleOr, position2209)
 			}
 			return true
-		l1615:
-			position, tokenIndex, depth = position1615, tokenIndex1615, depth1615
+		l2208:
+			position, tokenIndex, depth = position2208, tokenIndex2208, depth2208
 			return false
 		},
-		/* 134 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action104)> */
+		/* 177 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action141)> */
 		func() bool {
-			position1634, tokenIndex1634, depth1634 := position, tokenIndex, depth
+			position2215, tokenIndex2215, depth2215 := position, tokenIndex, depth
 			{
-				position1635 := position
+				position2216 := position
 				depth++
 				{
-					position1636 := position
+					position2217 := position
 					depth++
 					{
-						position1637, tokenIndex1637, depth1637 := position, tokenIndex, depth
+						position2218, tokenIndex2218, depth2218 := position, tokenIndex, depth
 						if buffer[position] != rune('a') {
-							goto l1638
+							goto l2219
 						}
 						position++
-						goto l1637
-					l1638:
-						position, tokenIndex, depth = position1637, tokenIndex1637, depth1637
+						goto l2218
+					l2219:
+						position, tokenIndex, depth = position2218, tokenIndex2218, depth2218
 						if buffer[position] != rune('A') {
-							goto l1634
+							goto l2215
 						}
 						position++
 					}
-				l1637:
+				l2218:
 					{
-						position1639, tokenIndex1639, depth1639 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1640
+						position2220, tokenIndex2220, depth2220 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2221
 						}
 						position++
-						goto l1639
-					l1640:
-						position, tokenIndex, depth = position1639, tokenIndex1639, depth1639
-						if buffer[position] != rune('S') {
-							goto l1634
+						goto l2220
+					l2221:
+						position, tokenIndex, depth = position2220, tokenIndex2220, depth2220
+						if buffer[position] != rune('N') {
+							goto l2215
 						}
 						position++
 					}
-				l1639:
+				l2220:
 					{
-						position1641, tokenIndex1641, depth1641 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1642
+						position2222, tokenIndex2222, depth2222 := position, tokenIndex, depth
+						if buffer[position] != rune('d') {
+							goto l2223
 						}
 						position++
-						goto l1641
-					l1642:
-						position, tokenIndex, depth = position1641, tokenIndex1641, depth1641
-						if buffer[position] != rune('C') {
-							goto l1634
+						goto l2222
+					l2223:
+						position, tokenIndex, depth = position2222, tokenIndex2222, depth2222
+						if buffer[position] != rune('D') {
+							goto l2215
 						}
 						position++
 					}
-				l1641:
+				l2222:
 					depth--
-					add(rulePegText, position1636)
+					add(rulePegText, position2217)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1634
+				if !_rules[ruleAction141]() {
+					goto l2215
 				}
 				depth--
-				add(ruleAscending, position1635)
+				add(ruleAnd, position2216)
 			}
 			return true
-		l1634:
-			position, tokenIndex, depth = position1634, tokenIndex1634, depth1634
+		l2215:
+			position, tokenIndex, depth = position2215, tokenIndex2215, depth2215
 			return false
 		},
-		/* 135 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action105)> */
+		/* 178 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action142)> */
 		func() bool {
-			position1643, tokenIndex1643, depth1643 := position, tokenIndex, depth
+			position2224, tokenIndex2224, depth2224 := position, tokenIndex, depth
 			{
-				position1644 := position
+				position2225 := position
 				depth++
 				{
-					position1645 := position
+					position2226 := position
 					depth++
 					{
-						position1646, tokenIndex1646, depth1646 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1647
-						}
-						position++
-						goto l1646
-					l1647:
-						position, tokenIndex, depth = position1646, tokenIndex1646, depth1646
-						if buffer[position] != rune('D') {
-							goto l1643
-						}
-						position++
-					}
-				l1646:
-					{
-						position1648, tokenIndex1648, depth1648 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1649
+						position2227, tokenIndex2227, depth2227 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2228
 						}
 						position++
-						goto l1648
-					l1649:
-						position, tokenIndex, depth = position1648, tokenIndex1648, depth1648
-						if buffer[position] != rune('E') {
-							goto l1643
+						goto l2227
+					l2228:
+						position, tokenIndex, depth = position2227, tokenIndex2227, depth2227
+						if buffer[position] != rune('N') {
+							goto l2224
 						}
 						position++
 					}
-				l1648:
+				l2227:
 					{
-						position1650, tokenIndex1650, depth1650 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1651
+						position2229, tokenIndex2229, depth2229 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2230
 						}
 						position++
-						goto l1650
-					l1651:
-						position, tokenIndex, depth = position1650, tokenIndex1650, depth1650
-						if buffer[position] != rune('S') {
-							goto l1643
+						goto l2229
+					l2230:
+						position, tokenIndex, depth = position2229, tokenIndex2229, depth2229
+						if buffer[position] != rune('O') {
+							goto l2224
 						}
 						position++
 					}
-				l1650:
+				l2229:
 					{
-						position1652, tokenIndex1652, depth1652 := position, tokenIndex, depth
-						if buffer[position] != rune('c') {
-							goto l1653
+						position2231, tokenIndex2231, depth2231 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2232
 						}
 						position++
-						goto l1652
-					l1653:
-						position, tokenIndex, depth = position1652, tokenIndex1652, depth1652
-						if buffer[position] != rune('C') {
-							goto l1643
+						goto l2231
+					l2232:
+						position, tokenIndex, depth = position2231, tokenIndex2231, depth2231
+						if buffer[position] != rune('T') {
+							goto l2224
 						}
 						position++
 					}
-				l1652:
+				l2231:
 					depth--
-					add(rulePegText, position1645)
+					add(rulePegText, position2226)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1643
+				if !_rules[ruleAction142]() {
+					goto l2224
 				}
 				depth--
-				add(ruleDescending, position1644)
+				add(ruleNot, position2225)
 			}
 			return true
-		l1643:
-			position, tokenIndex, depth = position1643, tokenIndex1643, depth1643
+		l2224:
+			position, tokenIndex, depth = position2224, tokenIndex2224, depth2224
 			return false
 		},
-		/* 136 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 179 Equal <- <(<'='> Action143)> */
 		func() bool {
-			position1654, tokenIndex1654, depth1654 := position, tokenIndex, depth
+			position2233, tokenIndex2233, depth2233 := position, tokenIndex, depth
 			{
-				position1655 := position
+				position2234 := position
 				depth++
 				{
-					position1656, tokenIndex1656, depth1656 := position, tokenIndex, depth
-					if !_rules[ruleBool]() {
-						goto l1657
-					}
-					goto l1656
-				l1657:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleInt]() {
-						goto l1658
-					}
-					goto l1656
-				l1658:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleFloat]() {
-						goto l1659
-					}
-					goto l1656
-				l1659:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleString]() {
-						goto l1660
-					}
-					goto l1656
-				l1660:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleBlob]() {
-						goto l1661
+					position2235 := position
+					depth++
+					if buffer[position] != rune('=') {
+						goto l2233
 					}
-					goto l1656
-				l1661:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleTimestamp]() {
-						goto l1662
+					position++
+					depth--
+					add(rulePegText, position2235)
+				}
+				if !_rules[ruleAction143]() {
+					goto l2233
+				}
+				depth--
+				add(ruleEqual, position2234)
+			}
+			return true
+		l2233:
+			position, tokenIndex, depth = position2233, tokenIndex2233, depth2233
+			return false
+		},
+		/* 180 Less <- <(<'<'> Action144)> */
+		func() bool {
+			position2236, tokenIndex2236, depth2236 := position, tokenIndex, depth
+			{
+				position2237 := position
+				depth++
+				{
+					position2238 := position
+					depth++
+					if buffer[position] != rune('<') {
+						goto l2236
 					}
-					goto l1656
-				l1662:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleArray]() {
-						goto l1663
+					position++
+					depth--
+					add(rulePegText, position2238)
+				}
+				if !_rules[ruleAction144]() {
+					goto l2236
+				}
+				depth--
+				add(ruleLess, position2237)
+			}
+			return true
+		l2236:
+			position, tokenIndex, depth = position2236, tokenIndex2236, depth2236
+			return false
+		},
+		/* 181 LessOrEqual <- <(<('<' '=')> Action145)> */
+		func() bool {
+			position2239, tokenIndex2239, depth2239 := position, tokenIndex, depth
+			{
+				position2240 := position
+				depth++
+				{
+					position2241 := position
+					depth++
+					if buffer[position] != rune('<') {
+						goto l2239
 					}
-					goto l1656
-				l1663:
-					position, tokenIndex, depth = position1656, tokenIndex1656, depth1656
-					if !_rules[ruleMap]() {
-						goto l1654
+					position++
+					if buffer[position] != rune('=') {
+						goto l2239
 					}
+					position++
+					depth--
+					add(rulePegText, position2241)
+				}
+				if !_rules[ruleAction145]() {
+					goto l2239
 				}
-			l1656:
 				depth--
-				add(ruleType, position1655)
+				add(ruleLessOrEqual, position2240)
 			}
 			return true
-		l1654:
-			position, tokenIndex, depth = position1654, tokenIndex1654, depth1654
+		l2239:
+			position, tokenIndex, depth = position2239, tokenIndex2239, depth2239
 			return false
 		},
-		/* 137 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action106)> */
+		/* 182 Greater <- <(<'>'> Action146)> */
 		func() bool {
-			position1664, tokenIndex1664, depth1664 := position, tokenIndex, depth
+			position2242, tokenIndex2242, depth2242 := position, tokenIndex, depth
 			{
-				position1665 := position
+				position2243 := position
 				depth++
 				{
-					position1666 := position
+					position2244 := position
 					depth++
-					{
-						position1667, tokenIndex1667, depth1667 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1668
-						}
-						position++
-						goto l1667
-					l1668:
-						position, tokenIndex, depth = position1667, tokenIndex1667, depth1667
-						if buffer[position] != rune('B') {
-							goto l1664
-						}
-						position++
-					}
-				l1667:
-					{
-						position1669, tokenIndex1669, depth1669 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1670
-						}
-						position++
-						goto l1669
-					l1670:
-						position, tokenIndex, depth = position1669, tokenIndex1669, depth1669
-						if buffer[position] != rune('O') {
-							goto l1664
-						}
-						position++
+					if buffer[position] != rune('>') {
+						goto l2242
 					}
-				l1669:
-					{
-						position1671, tokenIndex1671, depth1671 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1672
-						}
-						position++
-						goto l1671
-					l1672:
-						position, tokenIndex, depth = position1671, tokenIndex1671, depth1671
-						if buffer[position] != rune('O') {
-							goto l1664
-						}
-						position++
+					position++
+					depth--
+					add(rulePegText, position2244)
+				}
+				if !_rules[ruleAction146]() {
+					goto l2242
+				}
+				depth--
+				add(ruleGreater, position2243)
+			}
+			return true
+		l2242:
+			position, tokenIndex, depth = position2242, tokenIndex2242, depth2242
+			return false
+		},
+		/* 183 GreaterOrEqual <- <(<('>' '=')> Action147)> */
+		func() bool {
+			position2245, tokenIndex2245, depth2245 := position, tokenIndex, depth
+			{
+				position2246 := position
+				depth++
+				{
+					position2247 := position
+					depth++
+					if buffer[position] != rune('>') {
+						goto l2245
 					}
-				l1671:
-					{
-						position1673, tokenIndex1673, depth1673 := position, tokenIndex, depth
-						if buffer[position] != rune('l') {
-							goto l1674
-						}
-						position++
-						goto l1673
-					l1674:
-						position, tokenIndex, depth = position1673, tokenIndex1673, depth1673
-						if buffer[position] != rune('L') {
-							goto l1664
-						}
-						position++
+					position++
+					if buffer[position] != rune('=') {
+						goto l2245
 					}
-				l1673:
+					position++
 					depth--
-					add(rulePegText, position1666)
+					add(rulePegText, position2247)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1664
+				if !_rules[ruleAction147]() {
+					goto l2245
 				}
 				depth--
-				add(ruleBool, position1665)
+				add(ruleGreaterOrEqual, position2246)
 			}
 			return true
-		l1664:
-			position, tokenIndex, depth = position1664, tokenIndex1664, depth1664
+		l2245:
+			position, tokenIndex, depth = position2245, tokenIndex2245, depth2245
 			return false
 		},
-		/* 138 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action107)> */
+		/* 184 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action148)> */
 		func() bool {
-			position1675, tokenIndex1675, depth1675 := position, tokenIndex, depth
+			position2248, tokenIndex2248, depth2248 := position, tokenIndex, depth
 			{
-				position1676 := position
+				position2249 := position
 				depth++
 				{
-					position1677 := position
+					position2250 := position
 					depth++
 					{
-						position1678, tokenIndex1678, depth1678 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1679
-						}
-						position++
-						goto l1678
-					l1679:
-						position, tokenIndex, depth = position1678, tokenIndex1678, depth1678
-						if buffer[position] != rune('I') {
-							goto l1675
-						}
-						position++
-					}
-				l1678:
-					{
-						position1680, tokenIndex1680, depth1680 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1681
+						position2251, tokenIndex2251, depth2251 := position, tokenIndex, depth
+						if buffer[position] != rune('!') {
+							goto l2252
 						}
 						position++
-						goto l1680
-					l1681:
-						position, tokenIndex, depth = position1680, tokenIndex1680, depth1680
-						if buffer[position] != rune('N') {
-							goto l1675
+						if buffer[position] != rune('=') {
+							goto l2252
 						}
 						position++
-					}
-				l1680:
-					{
-						position1682, tokenIndex1682, depth1682 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1683
+						goto l2251
+					l2252:
+						position, tokenIndex, depth = position2251, tokenIndex2251, depth2251
+						if buffer[position] != rune('<') {
+							goto l2248
 						}
 						position++
-						goto l1682
-					l1683:
-						position, tokenIndex, depth = position1682, tokenIndex1682, depth1682
-						if buffer[position] != rune('T') {
-							goto l1675
+						if buffer[position] != rune('>') {
+							goto l2248
 						}
 						position++
 					}
-				l1682:
+				l2251:
 					depth--
-					add(rulePegText, position1677)
+					add(rulePegText, position2250)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1675
+				if !_rules[ruleAction148]() {
+					goto l2248
 				}
 				depth--
-				add(ruleInt, position1676)
+				add(ruleNotEqual, position2249)
 			}
 			return true
-		l1675:
-			position, tokenIndex, depth = position1675, tokenIndex1675, depth1675
+		l2248:
+			position, tokenIndex, depth = position2248, tokenIndex2248, depth2248
 			return false
 		},
-		/* 139 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action108)> */
+		/* 185 Like <- <(<(('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action149)> */
 		func() bool {
-			position1684, tokenIndex1684, depth1684 := position, tokenIndex, depth
+			position2253, tokenIndex2253, depth2253 := position, tokenIndex, depth
 			{
-				position1685 := position
+				position2254 := position
 				depth++
-				{
-					position1686 := position
-					depth++
-					{
-						position1687, tokenIndex1687, depth1687 := position, tokenIndex, depth
-						if buffer[position] != rune('f') {
-							goto l1688
-						}
-						position++
-						goto l1687
-					l1688:
-						position, tokenIndex, depth = position1687, tokenIndex1687, depth1687
-						if buffer[position] != rune('F') {
-							goto l1684
-						}
-						position++
-					}
-				l1687:
+				{
+					position2255 := position
+					depth++
 					{
-						position1689, tokenIndex1689, depth1689 := position, tokenIndex, depth
+						position2256, tokenIndex2256, depth2256 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l1690
+							goto l2257
 						}
 						position++
-						goto l1689
-					l1690:
-						position, tokenIndex, depth = position1689, tokenIndex1689, depth1689
+						goto l2256
+					l2257:
+						position, tokenIndex, depth = position2256, tokenIndex2256, depth2256
 						if buffer[position] != rune('L') {
-							goto l1684
+							goto l2253
 						}
 						position++
 					}
-				l1689:
+				l2256:
 					{
-						position1691, tokenIndex1691, depth1691 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1692
+						position2258, tokenIndex2258, depth2258 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2259
 						}
 						position++
-						goto l1691
-					l1692:
-						position, tokenIndex, depth = position1691, tokenIndex1691, depth1691
-						if buffer[position] != rune('O') {
-							goto l1684
+						goto l2258
+					l2259:
+						position, tokenIndex, depth = position2258, tokenIndex2258, depth2258
+						if buffer[position] != rune('I') {
+							goto l2253
 						}
 						position++
 					}
-				l1691:
+				l2258:
 					{
-						position1693, tokenIndex1693, depth1693 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1694
+						position2260, tokenIndex2260, depth2260 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2261
 						}
 						position++
-						goto l1693
-					l1694:
-						position, tokenIndex, depth = position1693, tokenIndex1693, depth1693
-						if buffer[position] != rune('A') {
-							goto l1684
+						goto l2260
+					l2261:
+						position, tokenIndex, depth = position2260, tokenIndex2260, depth2260
+						if buffer[position] != rune('K') {
+							goto l2253
 						}
 						position++
 					}
-				l1693:
+				l2260:
 					{
-						position1695, tokenIndex1695, depth1695 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1696
+						position2262, tokenIndex2262, depth2262 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2263
 						}
 						position++
-						goto l1695
-					l1696:
-						position, tokenIndex, depth = position1695, tokenIndex1695, depth1695
-						if buffer[position] != rune('T') {
-							goto l1684
+						goto l2262
+					l2263:
+						position, tokenIndex, depth = position2262, tokenIndex2262, depth2262
+						if buffer[position] != rune('E') {
+							goto l2253
 						}
 						position++
 					}
-				l1695:
+				l2262:
 					depth--
-					add(rulePegText, position1686)
+					add(rulePegText, position2255)
 				}
-				if !_rules[ruleAction108]() {
-					goto l1684
+				if !_rules[ruleAction149]() {
+					goto l2253
 				}
 				depth--
-				add(ruleFloat, position1685)
+				add(ruleLike, position2254)
 			}
 			return true
-		l1684:
-			position, tokenIndex, depth = position1684, tokenIndex1684, depth1684
+		l2253:
+			position, tokenIndex, depth = position2253, tokenIndex2253, depth2253
 			return false
 		},
-		/* 140 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action109)> */
+		/* 186 NotLike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action150)> */
 		func() bool {
-			position1697, tokenIndex1697, depth1697 := position, tokenIndex, depth
+			position2264, tokenIndex2264, depth2264 := position, tokenIndex, depth
 			{
-				position1698 := position
+				position2265 := position
 				depth++
 				{
-					position1699 := position
+					position2266 := position
 					depth++
 					{
-						position1700, tokenIndex1700, depth1700 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1701
+						position2267, tokenIndex2267, depth2267 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2268
 						}
 						position++
-						goto l1700
-					l1701:
-						position, tokenIndex, depth = position1700, tokenIndex1700, depth1700
-						if buffer[position] != rune('S') {
-							goto l1697
+						goto l2267
+					l2268:
+						position, tokenIndex, depth = position2267, tokenIndex2267, depth2267
+						if buffer[position] != rune('N') {
+							goto l2264
+						}
+						position++
+					}
+				l2267:
+					{
+						position2269, tokenIndex2269, depth2269 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2270
+						}
+						position++
+						goto l2269
+					l2270:
+						position, tokenIndex, depth = position2269, tokenIndex2269, depth2269
+						if buffer[position] != rune('O') {
+							goto l2264
 						}
 						position++
 					}
-				l1700:
+				l2269:
 					{
-						position1702, tokenIndex1702, depth1702 := position, tokenIndex, depth
+						position2271, tokenIndex2271, depth2271 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1703
+							goto l2272
 						}
 						position++
-						goto l1702
-					l1703:
-						position, tokenIndex, depth = position1702, tokenIndex1702, depth1702
+						goto l2271
+					l2272:
+						position, tokenIndex, depth = position2271, tokenIndex2271, depth2271
 						if buffer[position] != rune('T') {
-							goto l1697
+							goto l2264
 						}
 						position++
 					}
-				l1702:
+				l2271:
+					if !_rules[rulesp]() {
+						goto l2264
+					}
 					{
-						position1704, tokenIndex1704, depth1704 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1705
+						position2273, tokenIndex2273, depth2273 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2274
 						}
 						position++
-						goto l1704
-					l1705:
-						position, tokenIndex, depth = position1704, tokenIndex1704, depth1704
-						if buffer[position] != rune('R') {
-							goto l1697
+						goto l2273
+					l2274:
+						position, tokenIndex, depth = position2273, tokenIndex2273, depth2273
+						if buffer[position] != rune('L') {
+							goto l2264
 						}
 						position++
 					}
-				l1704:
+				l2273:
 					{
-						position1706, tokenIndex1706, depth1706 := position, tokenIndex, depth
+						position2275, tokenIndex2275, depth2275 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l1707
+							goto l2276
 						}
 						position++
-						goto l1706
-					l1707:
-						position, tokenIndex, depth = position1706, tokenIndex1706, depth1706
+						goto l2275
+					l2276:
+						position, tokenIndex, depth = position2275, tokenIndex2275, depth2275
 						if buffer[position] != rune('I') {
-							goto l1697
+							goto l2264
 						}
 						position++
 					}
-				l1706:
+				l2275:
 					{
-						position1708, tokenIndex1708, depth1708 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1709
+						position2277, tokenIndex2277, depth2277 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2278
 						}
 						position++
-						goto l1708
-					l1709:
-						position, tokenIndex, depth = position1708, tokenIndex1708, depth1708
-						if buffer[position] != rune('N') {
-							goto l1697
+						goto l2277
+					l2278:
+						position, tokenIndex, depth = position2277, tokenIndex2277, depth2277
+						if buffer[position] != rune('K') {
+							goto l2264
 						}
 						position++
 					}
-				l1708:
+				l2277:
 					{
-						position1710, tokenIndex1710, depth1710 := position, tokenIndex, depth
-						if buffer[position] != rune('g') {
-							goto l1711
+						position2279, tokenIndex2279, depth2279 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2280
 						}
 						position++
-						goto l1710
-					l1711:
-						position, tokenIndex, depth = position1710, tokenIndex1710, depth1710
-						if buffer[position] != rune('G') {
-							goto l1697
+						goto l2279
+					l2280:
+						position, tokenIndex, depth = position2279, tokenIndex2279, depth2279
+						if buffer[position] != rune('E') {
+							goto l2264
 						}
 						position++
 					}
-				l1710:
+				l2279:
 					depth--
-					add(rulePegText, position1699)
+					add(rulePegText, position2266)
 				}
-				if !_rules[ruleAction109]() {
-					goto l1697
+				if !_rules[ruleAction150]() {
+					goto l2264
 				}
 				depth--
-				add(ruleString, position1698)
+				add(ruleNotLike, position2265)
 			}
 			return true
-		l1697:
-			position, tokenIndex, depth = position1697, tokenIndex1697, depth1697
+		l2264:
+			position, tokenIndex, depth = position2264, tokenIndex2264, depth2264
 			return false
 		},
-		/* 141 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action110)> */
+		/* 187 ILike <- <(<(('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action151)> */
 		func() bool {
-			position1712, tokenIndex1712, depth1712 := position, tokenIndex, depth
+			position2281, tokenIndex2281, depth2281 := position, tokenIndex, depth
 			{
-				position1713 := position
+				position2282 := position
 				depth++
 				{
-					position1714 := position
+					position2283 := position
 					depth++
 					{
-						position1715, tokenIndex1715, depth1715 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1716
+						position2284, tokenIndex2284, depth2284 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2285
 						}
 						position++
-						goto l1715
-					l1716:
-						position, tokenIndex, depth = position1715, tokenIndex1715, depth1715
-						if buffer[position] != rune('B') {
-							goto l1712
+						goto l2284
+					l2285:
+						position, tokenIndex, depth = position2284, tokenIndex2284, depth2284
+						if buffer[position] != rune('I') {
+							goto l2281
 						}
 						position++
 					}
-				l1715:
+				l2284:
 					{
-						position1717, tokenIndex1717, depth1717 := position, tokenIndex, depth
+						position2286, tokenIndex2286, depth2286 := position, tokenIndex, depth
 						if buffer[position] != rune('l') {
-							goto l1718
+							goto l2287
 						}
 						position++
-						goto l1717
-					l1718:
-						position, tokenIndex, depth = position1717, tokenIndex1717, depth1717
+						goto l2286
+					l2287:
+						position, tokenIndex, depth = position2286, tokenIndex2286, depth2286
 						if buffer[position] != rune('L') {
-							goto l1712
+							goto l2281
 						}
 						position++
 					}
-				l1717:
+				l2286:
 					{
-						position1719, tokenIndex1719, depth1719 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1720
+						position2288, tokenIndex2288, depth2288 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2289
 						}
 						position++
-						goto l1719
-					l1720:
-						position, tokenIndex, depth = position1719, tokenIndex1719, depth1719
-						if buffer[position] != rune('O') {
-							goto l1712
+						goto l2288
+					l2289:
+						position, tokenIndex, depth = position2288, tokenIndex2288, depth2288
+						if buffer[position] != rune('I') {
+							goto l2281
 						}
 						position++
 					}
-				l1719:
+				l2288:
 					{
-						position1721, tokenIndex1721, depth1721 := position, tokenIndex, depth
-						if buffer[position] != rune('b') {
-							goto l1722
+						position2290, tokenIndex2290, depth2290 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2291
 						}
 						position++
-						goto l1721
-					l1722:
-						position, tokenIndex, depth = position1721, tokenIndex1721, depth1721
-						if buffer[position] != rune('B') {
-							goto l1712
+						goto l2290
+					l2291:
+						position, tokenIndex, depth = position2290, tokenIndex2290, depth2290
+						if buffer[position] != rune('K') {
+							goto l2281
 						}
 						position++
 					}
-				l1721:
+				l2290:
+					{
+						position2292, tokenIndex2292, depth2292 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2293
+						}
+						position++
+						goto l2292
+					l2293:
+						position, tokenIndex, depth = position2292, tokenIndex2292, depth2292
+						if buffer[position] != rune('E') {
+							goto l2281
+						}
+						position++
+					}
+				l2292:
 					depth--
-					add(rulePegText, position1714)
+					add(rulePegText, position2283)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1712
+				if !_rules[ruleAction151]() {
+					goto l2281
 				}
 				depth--
-				add(ruleBlob, position1713)
+				add(ruleILike, position2282)
 			}
 			return true
-		l1712:
-			position, tokenIndex, depth = position1712, tokenIndex1712, depth1712
+		l2281:
+			position, tokenIndex, depth = position2281, tokenIndex2281, depth2281
 			return false
 		},
-		/* 142 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action111)> */
+		/* 188 NotILike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action152)> */
 		func() bool {
-			position1723, tokenIndex1723, depth1723 := position, tokenIndex, depth
+			position2294, tokenIndex2294, depth2294 := position, tokenIndex, depth
 			{
-				position1724 := position
+				position2295 := position
 				depth++
 				{
-					position1725 := position
+					position2296 := position
 					depth++
 					{
-						position1726, tokenIndex1726, depth1726 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1727
+						position2297, tokenIndex2297, depth2297 := position, tokenIndex, depth
+						if buffer[position] != rune('n') {
+							goto l2298
 						}
 						position++
-						goto l1726
-					l1727:
-						position, tokenIndex, depth = position1726, tokenIndex1726, depth1726
-						if buffer[position] != rune('T') {
-							goto l1723
+						goto l2297
+					l2298:
+						position, tokenIndex, depth = position2297, tokenIndex2297, depth2297
+						if buffer[position] != rune('N') {
+							goto l2294
 						}
 						position++
 					}
-				l1726:
+				l2297:
 					{
-						position1728, tokenIndex1728, depth1728 := position, tokenIndex, depth
-						if buffer[position] != rune('i') {
-							goto l1729
+						position2299, tokenIndex2299, depth2299 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2300
 						}
 						position++
-						goto l1728
-					l1729:
-						position, tokenIndex, depth = position1728, tokenIndex1728, depth1728
-						if buffer[position] != rune('I') {
-							goto l1723
+						goto l2299
+					l2300:
+						position, tokenIndex, depth = position2299, tokenIndex2299, depth2299
+						if buffer[position] != rune('O') {
+							goto l2294
 						}
 						position++
 					}
-				l1728:
+				l2299:
 					{
-						position1730, tokenIndex1730, depth1730 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1731
+						position2301, tokenIndex2301, depth2301 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2302
 						}
 						position++
-						goto l1730
-					l1731:
-						position, tokenIndex, depth = position1730, tokenIndex1730, depth1730
-						if buffer[position] != rune('M') {
-							goto l1723
+						goto l2301
+					l2302:
+						position, tokenIndex, depth = position2301, tokenIndex2301, depth2301
+						if buffer[position] != rune('T') {
+							goto l2294
 						}
 						position++
 					}
-				l1730:
-					{
-						position1732, tokenIndex1732, depth1732 := position, tokenIndex, depth
-						if buffer[position] != rune('e') {
-							goto l1733
-						}
-						position++
-						goto l1732
-					l1733:
-						position, tokenIndex, depth = position1732, tokenIndex1732, depth1732
-						if buffer[position] != rune('E') {
-							goto l1723
-						}
-						position++
+				l2301:
+					if !_rules[rulesp]() {
+						goto l2294
 					}
-				l1732:
 					{
-						position1734, tokenIndex1734, depth1734 := position, tokenIndex, depth
-						if buffer[position] != rune('s') {
-							goto l1735
+						position2303, tokenIndex2303, depth2303 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2304
 						}
 						position++
-						goto l1734
-					l1735:
-						position, tokenIndex, depth = position1734, tokenIndex1734, depth1734
-						if buffer[position] != rune('S') {
-							goto l1723
+						goto l2303
+					l2304:
+						position, tokenIndex, depth = position2303, tokenIndex2303, depth2303
+						if buffer[position] != rune('I') {
+							goto l2294
 						}
 						position++
 					}
-				l1734:
+				l2303:
 					{
-						position1736, tokenIndex1736, depth1736 := position, tokenIndex, depth
-						if buffer[position] != rune('t') {
-							goto l1737
+						position2305, tokenIndex2305, depth2305 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2306
 						}
 						position++
-						goto l1736
-					l1737:
-						position, tokenIndex, depth = position1736, tokenIndex1736, depth1736
-						if buffer[position] != rune('T') {
-							goto l1723
+						goto l2305
+					l2306:
+						position, tokenIndex, depth = position2305, tokenIndex2305, depth2305
+						if buffer[position] != rune('L') {
+							goto l2294
 						}
 						position++
 					}
-				l1736:
+				l2305:
 					{
-						position1738, tokenIndex1738, depth1738 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1739
+						position2307, tokenIndex2307, depth2307 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2308
 						}
 						position++
-						goto l1738
-					l1739:
-						position, tokenIndex, depth = position1738, tokenIndex1738, depth1738
-						if buffer[position] != rune('A') {
-							goto l1723
+						goto l2307
+					l2308:
+						position, tokenIndex, depth = position2307, tokenIndex2307, depth2307
+						if buffer[position] != rune('I') {
+							goto l2294
 						}
 						position++
 					}
-				l1738:
+				l2307:
 					{
-						position1740, tokenIndex1740, depth1740 := position, tokenIndex, depth
-						if buffer[position] != rune('m') {
-							goto l1741
+						position2309, tokenIndex2309, depth2309 := position, tokenIndex, depth
+						if buffer[position] != rune('k') {
+							goto l2310
 						}
 						position++
-						goto l1740
-					l1741:
-						position, tokenIndex, depth = position1740, tokenIndex1740, depth1740
-						if buffer[position] != rune('M') {
-							goto l1723
+						goto l2309
+					l2310:
+						position, tokenIndex, depth = position2309, tokenIndex2309, depth2309
+						if buffer[position] != rune('K') {
+							goto l2294
 						}
 						position++
 					}
-				l1740:
+				l2309:
 					{
-						position1742, tokenIndex1742, depth1742 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1743
+						position2311, tokenIndex2311, depth2311 := position, tokenIndex, depth
+						if buffer[position] != rune('e') {
+							goto l2312
 						}
 						position++
-						goto l1742
-					l1743:
-						position, tokenIndex, depth = position1742, tokenIndex1742, depth1742
-						if buffer[position] != rune('P') {
-							goto l1723
+						goto l2311
+					l2312:
+						position, tokenIndex, depth = position2311, tokenIndex2311, depth2311
+						if buffer[position] != rune('E') {
+							goto l2294
 						}
 						position++
 					}
-				l1742:
+				l2311:
 					depth--
-					add(rulePegText, position1725)
+					add(rulePegText, position2296)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1723
+				if !_rules[ruleAction152]() {
+					goto l2294
 				}
 				depth--
-				add(ruleTimestamp, position1724)
+				add(ruleNotILike, position2295)
 			}
 			return true
-		l1723:
-			position, tokenIndex, depth = position1723, tokenIndex1723, depth1723
+		l2294:
+			position, tokenIndex, depth = position2294, tokenIndex2294, depth2294
 			return false
 		},
-		/* 143 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action112)> */
+		/* 189 SimilarTo <- <(<(('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R') sp (('t' / 'T') ('o' / 'O')))> Action153)> */
 		func() bool {
-			position1744, tokenIndex1744, depth1744 := position, tokenIndex, depth
+			position2313, tokenIndex2313, depth2313 := position, tokenIndex, depth
 			{
-				position1745 := position
+				position2314 := position
 				depth++
 				{
-					position1746 := position
+					position2315 := position
 					depth++
 					{
-						position1747, tokenIndex1747, depth1747 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1748
-						}
-						position++
-						goto l1747
-					l1748:
-						position, tokenIndex, depth = position1747, tokenIndex1747, depth1747
-						if buffer[position] != rune('A') {
-							goto l1744
-						}
-						position++
-					}
-				l1747:
-					{
-						position1749, tokenIndex1749, depth1749 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1750
-						}
-						position++
-						goto l1749
-					l1750:
-						position, tokenIndex, depth = position1749, tokenIndex1749, depth1749
-						if buffer[position] != rune('R') {
-							goto l1744
-						}
-						position++
-					}
-				l1749:
-					{
-						position1751, tokenIndex1751, depth1751 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1752
-						}
-						position++
-						goto l1751
-					l1752:
-						position, tokenIndex, depth = position1751, tokenIndex1751, depth1751
-						if buffer[position] != rune('R') {
-							goto l1744
-						}
-						position++
-					}
-				l1751:
-					{
-						position1753, tokenIndex1753, depth1753 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1754
+						position2316, tokenIndex2316, depth2316 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2317
 						}
 						position++
-						goto l1753
-					l1754:
-						position, tokenIndex, depth = position1753, tokenIndex1753, depth1753
-						if buffer[position] != rune('A') {
-							goto l1744
+						goto l2316
+					l2317:
+						position, tokenIndex, depth = position2316, tokenIndex2316, depth2316
+						if buffer[position] != rune('S') {
+							goto l2313
 						}
 						position++
 					}
-				l1753:
+				l2316:
 					{
-						position1755, tokenIndex1755, depth1755 := position, tokenIndex, depth
-						if buffer[position] != rune('y') {
-							goto l1756
+						position2318, tokenIndex2318, depth2318 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2319
 						}
 						position++
-						goto l1755
-					l1756:
-						position, tokenIndex, depth = position1755, tokenIndex1755, depth1755
-						if buffer[position] != rune('Y') {
-							goto l1744
+						goto l2318
+					l2319:
+						position, tokenIndex, depth = position2318, tokenIndex2318, depth2318
+						if buffer[position] != rune('I') {
+							goto l2313
 						}
 						position++
 					}
-				l1755:
-					depth--
-					add(rulePegText, position1746)
-				}
-				if !_rules[ruleAction112]() {
-					goto l1744
-				}
-				depth--
-				add(ruleArray, position1745)
-			}
-			return true
-		l1744:
-			position, tokenIndex, depth = position1744, tokenIndex1744, depth1744
-			return false
-		},
-		/* 144 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action113)> */
-		func() bool {
-			position1757, tokenIndex1757, depth1757 := position, tokenIndex, depth
-			{
-				position1758 := position
-				depth++
-				{
-					position1759 := position
-					depth++
+				l2318:
 					{
-						position1760, tokenIndex1760, depth1760 := position, tokenIndex, depth
+						position2320, tokenIndex2320, depth2320 := position, tokenIndex, depth
 						if buffer[position] != rune('m') {
-							goto l1761
+							goto l2321
 						}
 						position++
-						goto l1760
-					l1761:
-						position, tokenIndex, depth = position1760, tokenIndex1760, depth1760
+						goto l2320
+					l2321:
+						position, tokenIndex, depth = position2320, tokenIndex2320, depth2320
 						if buffer[position] != rune('M') {
-							goto l1757
-						}
-						position++
-					}
-				l1760:
-					{
-						position1762, tokenIndex1762, depth1762 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1763
-						}
-						position++
-						goto l1762
-					l1763:
-						position, tokenIndex, depth = position1762, tokenIndex1762, depth1762
-						if buffer[position] != rune('A') {
-							goto l1757
+							goto l2313
 						}
 						position++
 					}
-				l1762:
+				l2320:
 					{
-						position1764, tokenIndex1764, depth1764 := position, tokenIndex, depth
-						if buffer[position] != rune('p') {
-							goto l1765
+						position2322, tokenIndex2322, depth2322 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2323
 						}
 						position++
-						goto l1764
-					l1765:
-						position, tokenIndex, depth = position1764, tokenIndex1764, depth1764
-						if buffer[position] != rune('P') {
-							goto l1757
+						goto l2322
+					l2323:
+						position, tokenIndex, depth = position2322, tokenIndex2322, depth2322
+						if buffer[position] != rune('I') {
+							goto l2313
 						}
 						position++
 					}
-				l1764:
-					depth--
-					add(rulePegText, position1759)
-				}
-				if !_rules[ruleAction113]() {
-					goto l1757
-				}
-				depth--
-				add(ruleMap, position1758)
-			}
-			return true
-		l1757:
-			position, tokenIndex, depth = position1757, tokenIndex1757, depth1757
-			return false
-		},
-		/* 145 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action114)> */
-		func() bool {
-			position1766, tokenIndex1766, depth1766 := position, tokenIndex, depth
-			{
-				position1767 := position
-				depth++
-				{
-					position1768 := position
-					depth++
+				l2322:
 					{
-						position1769, tokenIndex1769, depth1769 := position, tokenIndex, depth
-						if buffer[position] != rune('o') {
-							goto l1770
+						position2324, tokenIndex2324, depth2324 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2325
 						}
 						position++
-						goto l1769
-					l1770:
-						position, tokenIndex, depth = position1769, tokenIndex1769, depth1769
-						if buffer[position] != rune('O') {
-							goto l1766
+						goto l2324
+					l2325:
+						position, tokenIndex, depth = position2324, tokenIndex2324, depth2324
+						if buffer[position] != rune('L') {
+							goto l2313
 						}
 						position++
 					}
-				l1769:
+				l2324:
 					{
-						position1771, tokenIndex1771, depth1771 := position, tokenIndex, depth
-						if buffer[position] != rune('r') {
-							goto l1772
+						position2326, tokenIndex2326, depth2326 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2327
 						}
 						position++
-						goto l1771
-					l1772:
-						position, tokenIndex, depth = position1771, tokenIndex1771, depth1771
-						if buffer[position] != rune('R') {
-							goto l1766
+						goto l2326
+					l2327:
+						position, tokenIndex, depth = position2326, tokenIndex2326, depth2326
+						if buffer[position] != rune('A') {
+							goto l2313
 						}
 						position++
-					}
-				l1771:
-					depth--
-					add(rulePegText, position1768)
-				}
-				if !_rules[ruleAction114]() {
-					goto l1766
-				}
-				depth--
-				add(ruleOr, position1767)
-			}
-			return true
-		l1766:
-			position, tokenIndex, depth = position1766, tokenIndex1766, depth1766
-			return false
-		},
-		/* 146 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action115)> */
-		func() bool {
-			position1773, tokenIndex1773, depth1773 := position, tokenIndex, depth
-			{
-				position1774 := position
-				depth++
-				{
-					position1775 := position
-					depth++
-					{
-						position1776, tokenIndex1776, depth1776 := position, tokenIndex, depth
-						if buffer[position] != rune('a') {
-							goto l1777
+					}
+				l2326:
+					{
+						position2328, tokenIndex2328, depth2328 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2329
 						}
 						position++
-						goto l1776
-					l1777:
-						position, tokenIndex, depth = position1776, tokenIndex1776, depth1776
-						if buffer[position] != rune('A') {
-							goto l1773
+						goto l2328
+					l2329:
+						position, tokenIndex, depth = position2328, tokenIndex2328, depth2328
+						if buffer[position] != rune('R') {
+							goto l2313
 						}
 						position++
 					}
-				l1776:
+				l2328:
+					if !_rules[rulesp]() {
+						goto l2313
+					}
 					{
-						position1778, tokenIndex1778, depth1778 := position, tokenIndex, depth
-						if buffer[position] != rune('n') {
-							goto l1779
+						position2330, tokenIndex2330, depth2330 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2331
 						}
 						position++
-						goto l1778
-					l1779:
-						position, tokenIndex, depth = position1778, tokenIndex1778, depth1778
-						if buffer[position] != rune('N') {
-							goto l1773
+						goto l2330
+					l2331:
+						position, tokenIndex, depth = position2330, tokenIndex2330, depth2330
+						if buffer[position] != rune('T') {
+							goto l2313
 						}
 						position++
 					}
-				l1778:
+				l2330:
 					{
-						position1780, tokenIndex1780, depth1780 := position, tokenIndex, depth
-						if buffer[position] != rune('d') {
-							goto l1781
+						position2332, tokenIndex2332, depth2332 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2333
 						}
 						position++
-						goto l1780
-					l1781:
-						position, tokenIndex, depth = position1780, tokenIndex1780, depth1780
-						if buffer[position] != rune('D') {
-							goto l1773
+						goto l2332
+					l2333:
+						position, tokenIndex, depth = position2332, tokenIndex2332, depth2332
+						if buffer[position] != rune('O') {
+							goto l2313
 						}
 						position++
 					}
-				l1780:
+				l2332:
 					depth--
-					add(rulePegText, position1775)
+					add(rulePegText, position2315)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1773
+				if !_rules[ruleAction153]() {
+					goto l2313
 				}
 				depth--
-				add(ruleAnd, position1774)
+				add(ruleSimilarTo, position2314)
 			}
 			return true
-		l1773:
-			position, tokenIndex, depth = position1773, tokenIndex1773, depth1773
+		l2313:
+			position, tokenIndex, depth = position2313, tokenIndex2313, depth2313
 			return false
 		},
-		/* 147 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action116)> */
+		/* 190 NotSimilarTo <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('s' / 'S') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('l' / 'L') ('a' / 'A') ('r' / 'R')) sp (('t' / 'T') ('o' / 'O')))> Action154)> */
 		func() bool {
-			position1782, tokenIndex1782, depth1782 := position, tokenIndex, depth
+			position2334, tokenIndex2334, depth2334 := position, tokenIndex, depth
 			{
-				position1783 := position
+				position2335 := position
 				depth++
 				{
-					position1784 := position
+					position2336 := position
 					depth++
 					{
-						position1785, tokenIndex1785, depth1785 := position, tokenIndex, depth
+						position2337, tokenIndex2337, depth2337 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1786
+							goto l2338
 						}
 						position++
-						goto l1785
-					l1786:
-						position, tokenIndex, depth = position1785, tokenIndex1785, depth1785
+						goto l2337
+					l2338:
+						position, tokenIndex, depth = position2337, tokenIndex2337, depth2337
 						if buffer[position] != rune('N') {
-							goto l1782
+							goto l2334
 						}
 						position++
 					}
-				l1785:
+				l2337:
 					{
-						position1787, tokenIndex1787, depth1787 := position, tokenIndex, depth
+						position2339, tokenIndex2339, depth2339 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1788
+							goto l2340
 						}
 						position++
-						goto l1787
-					l1788:
-						position, tokenIndex, depth = position1787, tokenIndex1787, depth1787
+						goto l2339
+					l2340:
+						position, tokenIndex, depth = position2339, tokenIndex2339, depth2339
 						if buffer[position] != rune('O') {
-							goto l1782
+							goto l2334
 						}
 						position++
 					}
-				l1787:
+				l2339:
 					{
-						position1789, tokenIndex1789, depth1789 := position, tokenIndex, depth
+						position2341, tokenIndex2341, depth2341 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1790
+							goto l2342
 						}
 						position++
-						goto l1789
-					l1790:
-						position, tokenIndex, depth = position1789, tokenIndex1789, depth1789
+						goto l2341
+					l2342:
+						position, tokenIndex, depth = position2341, tokenIndex2341, depth2341
 						if buffer[position] != rune('T') {
-							goto l1782
+							goto l2334
 						}
 						position++
 					}
-				l1789:
-					depth--
-					add(rulePegText, position1784)
-				}
-				if !_rules[ruleAction116]() {
-					goto l1782
-				}
-				depth--
-				add(ruleNot, position1783)
-			}
-			return true
-		l1782:
-			position, tokenIndex, depth = position1782, tokenIndex1782, depth1782
-			return false
-		},
-		/* 148 Equal <- <(<'='> Action117)> */
-		func() bool {
-			position1791, tokenIndex1791, depth1791 := position, tokenIndex, depth
-			{
-				position1792 := position
-				depth++
-				{
-					position1793 := position
-					depth++
-					if buffer[position] != rune('=') {
-						goto l1791
+				l2341:
+					if !_rules[rulesp]() {
+						goto l2334
 					}
-					position++
-					depth--
-					add(rulePegText, position1793)
-				}
-				if !_rules[ruleAction117]() {
-					goto l1791
-				}
-				depth--
-				add(ruleEqual, position1792)
-			}
-			return true
-		l1791:
-			position, tokenIndex, depth = position1791, tokenIndex1791, depth1791
-			return false
-		},
-		/* 149 Less <- <(<'<'> Action118)> */
-		func() bool {
-			position1794, tokenIndex1794, depth1794 := position, tokenIndex, depth
-			{
-				position1795 := position
-				depth++
-				{
-					position1796 := position
-					depth++
-					if buffer[position] != rune('<') {
-						goto l1794
+					{
+						position2343, tokenIndex2343, depth2343 := position, tokenIndex, depth
+						if buffer[position] != rune('s') {
+							goto l2344
+						}
+						position++
+						goto l2343
+					l2344:
+						position, tokenIndex, depth = position2343, tokenIndex2343, depth2343
+						if buffer[position] != rune('S') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					depth--
-					add(rulePegText, position1796)
-				}
-				if !_rules[ruleAction118]() {
-					goto l1794
-				}
-				depth--
-				add(ruleLess, position1795)
-			}
-			return true
-		l1794:
-			position, tokenIndex, depth = position1794, tokenIndex1794, depth1794
-			return false
-		},
-		/* 150 LessOrEqual <- <(<('<' '=')> Action119)> */
-		func() bool {
-			position1797, tokenIndex1797, depth1797 := position, tokenIndex, depth
-			{
-				position1798 := position
-				depth++
-				{
-					position1799 := position
-					depth++
-					if buffer[position] != rune('<') {
-						goto l1797
+				l2343:
+					{
+						position2345, tokenIndex2345, depth2345 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2346
+						}
+						position++
+						goto l2345
+					l2346:
+						position, tokenIndex, depth = position2345, tokenIndex2345, depth2345
+						if buffer[position] != rune('I') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1797
+				l2345:
+					{
+						position2347, tokenIndex2347, depth2347 := position, tokenIndex, depth
+						if buffer[position] != rune('m') {
+							goto l2348
+						}
+						position++
+						goto l2347
+					l2348:
+						position, tokenIndex, depth = position2347, tokenIndex2347, depth2347
+						if buffer[position] != rune('M') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					depth--
-					add(rulePegText, position1799)
-				}
-				if !_rules[ruleAction119]() {
-					goto l1797
-				}
-				depth--
-				add(ruleLessOrEqual, position1798)
-			}
-			return true
-		l1797:
-			position, tokenIndex, depth = position1797, tokenIndex1797, depth1797
-			return false
-		},
-		/* 151 Greater <- <(<'>'> Action120)> */
-		func() bool {
-			position1800, tokenIndex1800, depth1800 := position, tokenIndex, depth
-			{
-				position1801 := position
-				depth++
-				{
-					position1802 := position
-					depth++
-					if buffer[position] != rune('>') {
-						goto l1800
+				l2347:
+					{
+						position2349, tokenIndex2349, depth2349 := position, tokenIndex, depth
+						if buffer[position] != rune('i') {
+							goto l2350
+						}
+						position++
+						goto l2349
+					l2350:
+						position, tokenIndex, depth = position2349, tokenIndex2349, depth2349
+						if buffer[position] != rune('I') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					depth--
-					add(rulePegText, position1802)
-				}
-				if !_rules[ruleAction120]() {
-					goto l1800
-				}
-				depth--
-				add(ruleGreater, position1801)
-			}
-			return true
-		l1800:
-			position, tokenIndex, depth = position1800, tokenIndex1800, depth1800
-			return false
-		},
-		/* 152 GreaterOrEqual <- <(<('>' '=')> Action121)> */
-		func() bool {
-			position1803, tokenIndex1803, depth1803 := position, tokenIndex, depth
-			{
-				position1804 := position
-				depth++
-				{
-					position1805 := position
-					depth++
-					if buffer[position] != rune('>') {
-						goto l1803
+				l2349:
+					{
+						position2351, tokenIndex2351, depth2351 := position, tokenIndex, depth
+						if buffer[position] != rune('l') {
+							goto l2352
+						}
+						position++
+						goto l2351
+					l2352:
+						position, tokenIndex, depth = position2351, tokenIndex2351, depth2351
+						if buffer[position] != rune('L') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1803
+				l2351:
+					{
+						position2353, tokenIndex2353, depth2353 := position, tokenIndex, depth
+						if buffer[position] != rune('a') {
+							goto l2354
+						}
+						position++
+						goto l2353
+					l2354:
+						position, tokenIndex, depth = position2353, tokenIndex2353, depth2353
+						if buffer[position] != rune('A') {
+							goto l2334
+						}
+						position++
 					}
-					position++
-					depth--
-					add(rulePegText, position1805)
-				}
-				if !_rules[ruleAction121]() {
-					goto l1803
-				}
-				depth--
-				add(ruleGreaterOrEqual, position1804)
-			}
-			return true
-		l1803:
-			position, tokenIndex, depth = position1803, tokenIndex1803, depth1803
-			return false
-		},
-		/* 153 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action122)> */
-		func() bool {
-			position1806, tokenIndex1806, depth1806 := position, tokenIndex, depth
-			{
-				position1807 := position
-				depth++
-				{
-					position1808 := position
-					depth++
+				l2353:
 					{
-						position1809, tokenIndex1809, depth1809 := position, tokenIndex, depth
-						if buffer[position] != rune('!') {
-							goto l1810
+						position2355, tokenIndex2355, depth2355 := position, tokenIndex, depth
+						if buffer[position] != rune('r') {
+							goto l2356
 						}
 						position++
-						if buffer[position] != rune('=') {
-							goto l1810
+						goto l2355
+					l2356:
+						position, tokenIndex, depth = position2355, tokenIndex2355, depth2355
+						if buffer[position] != rune('R') {
+							goto l2334
 						}
 						position++
-						goto l1809
-					l1810:
-						position, tokenIndex, depth = position1809, tokenIndex1809, depth1809
-						if buffer[position] != rune('<') {
-							goto l1806
+					}
+				l2355:
+					if !_rules[rulesp]() {
+						goto l2334
+					}
+					{
+						position2357, tokenIndex2357, depth2357 := position, tokenIndex, depth
+						if buffer[position] != rune('t') {
+							goto l2358
 						}
 						position++
-						if buffer[position] != rune('>') {
-							goto l1806
+						goto l2357
+					l2358:
+						position, tokenIndex, depth = position2357, tokenIndex2357, depth2357
+						if buffer[position] != rune('T') {
+							goto l2334
+						}
+						position++
+					}
+				l2357:
+					{
+						position2359, tokenIndex2359, depth2359 := position, tokenIndex, depth
+						if buffer[position] != rune('o') {
+							goto l2360
+						}
+						position++
+						goto l2359
+					l2360:
+						position, tokenIndex, depth = position2359, tokenIndex2359, depth2359
+						if buffer[position] != rune('O') {
+							goto l2334
 						}
 						position++
 					}
-				l1809:
+				l2359:
 					depth--
-					add(rulePegText, position1808)
+					add(rulePegText, position2336)
 				}
-				if !_rules[ruleAction122]() {
-					goto l1806
+				if !_rules[ruleAction154]() {
+					goto l2334
 				}
 				depth--
-				add(ruleNotEqual, position1807)
+				add(ruleNotSimilarTo, position2335)
 			}
 			return true
-		l1806:
-			position, tokenIndex, depth = position1806, tokenIndex1806, depth1806
+		l2334:
+			position, tokenIndex, depth = position2334, tokenIndex2334, depth2334
 			return false
 		},
-		/* 154 Concat <- <(<('|' '|')> Action123)> */
+		/* 191 Concat <- <(<('|' '|')> Action155)> */
 		func() bool {
-			position1811, tokenIndex1811, depth1811 := position, tokenIndex, depth
+			position2361, tokenIndex2361, depth2361 := position, tokenIndex, depth
 			{
-				position1812 := position
+				position2362 := position
 				depth++
 				{
-					position1813 := position
+					position2363 := position
 					depth++
 					if buffer[position] != rune('|') {
-						goto l1811
+						goto l2361
 					}
 					position++
 					if buffer[position] != rune('|') {
-						goto l1811
+						goto l2361
 					}
 					position++
 					depth--
-					add(rulePegText, position1813)
+					add(rulePegText, position2363)
 				}
-				if !_rules[ruleAction123]() {
-					goto l1811
+				if !_rules[ruleAction155]() {
+					goto l2361
 				}
 				depth--
-				add(ruleConcat, position1812)
+				add(ruleConcat, position2362)
 			}
 			return true
-		l1811:
-			position, tokenIndex, depth = position1811, tokenIndex1811, depth1811
+		l2361:
+			position, tokenIndex, depth = position2361, tokenIndex2361, depth2361
 			return false
 		},
-		/* 155 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action124)> */
+		/* 192 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action156)> */
 		func() bool {
-			position1814, tokenIndex1814, depth1814 := position, tokenIndex, depth
+			position2364, tokenIndex2364, depth2364 := position, tokenIndex, depth
 			{
-				position1815 := position
+				position2365 := position
 				depth++
 				{
-					position1816 := position
+					position2366 := position
 					depth++
 					{
-						position1817, tokenIndex1817, depth1817 := position, tokenIndex, depth
+						position2367, tokenIndex2367, depth2367 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l1818
+							goto l2368
 						}
 						position++
-						goto l1817
-					l1818:
-						position, tokenIndex, depth = position1817, tokenIndex1817, depth1817
+						goto l2367
+					l2368:
+						position, tokenIndex, depth = position2367, tokenIndex2367, depth2367
 						if buffer[position] != rune('I') {
-							goto l1814
+							goto l2364
 						}
 						position++
 					}
-				l1817:
+				l2367:
 					{
-						position1819, tokenIndex1819, depth1819 := position, tokenIndex, depth
+						position2369, tokenIndex2369, depth2369 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1820
+							goto l2370
 						}
 						position++
-						goto l1819
-					l1820:
-						position, tokenIndex, depth = position1819, tokenIndex1819, depth1819
+						goto l2369
+					l2370:
+						position, tokenIndex, depth = position2369, tokenIndex2369, depth2369
 						if buffer[position] != rune('S') {
-							goto l1814
+							goto l2364
 						}
 						position++
 					}
-				l1819:
+				l2369:
 					depth--
-					add(rulePegText, position1816)
+					add(rulePegText, position2366)
 				}
-				if !_rules[ruleAction124]() {
-					goto l1814
+				if !_rules[ruleAction156]() {
+					goto l2364
 				}
 				depth--
-				add(ruleIs, position1815)
+				add(ruleIs, position2365)
 			}
 			return true
-		l1814:
-			position, tokenIndex, depth = position1814, tokenIndex1814, depth1814
+		l2364:
+			position, tokenIndex, depth = position2364, tokenIndex2364, depth2364
 			return false
 		},
-		/* 156 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action125)> */
+		/* 193 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action157)> */
 		func() bool {
-			position1821, tokenIndex1821, depth1821 := position, tokenIndex, depth
+			position2371, tokenIndex2371, depth2371 := position, tokenIndex, depth
 			{
-				position1822 := position
+				position2372 := position
 				depth++
 				{
-					position1823 := position
+					position2373 := position
 					depth++
 					{
-						position1824, tokenIndex1824, depth1824 := position, tokenIndex, depth
+						position2374, tokenIndex2374, depth2374 := position, tokenIndex, depth
 						if buffer[position] != rune('i') {
-							goto l1825
+							goto l2375
 						}
 						position++
-						goto l1824
-					l1825:
-						position, tokenIndex, depth = position1824, tokenIndex1824, depth1824
+						goto l2374
+					l2375:
+						position, tokenIndex, depth = position2374, tokenIndex2374, depth2374
 						if buffer[position] != rune('I') {
-							goto l1821
+							goto l2371
 						}
 						position++
 					}
-				l1824:
+				l2374:
 					{
-						position1826, tokenIndex1826, depth1826 := position, tokenIndex, depth
+						position2376, tokenIndex2376, depth2376 := position, tokenIndex, depth
 						if buffer[position] != rune('s') {
-							goto l1827
+							goto l2377
 						}
 						position++
-						goto l1826
-					l1827:
-						position, tokenIndex, depth = position1826, tokenIndex1826, depth1826
+						goto l2376
+					l2377:
+						position, tokenIndex, depth = position2376, tokenIndex2376, depth2376
 						if buffer[position] != rune('S') {
-							goto l1821
+							goto l2371
 						}
 						position++
 					}
-				l1826:
+				l2376:
 					if !_rules[rulesp]() {
-						goto l1821
+						goto l2371
 					}
 					{
-						position1828, tokenIndex1828, depth1828 := position, tokenIndex, depth
+						position2378, tokenIndex2378, depth2378 := position, tokenIndex, depth
 						if buffer[position] != rune('n') {
-							goto l1829
+							goto l2379
 						}
 						position++
-						goto l1828
-					l1829:
-						position, tokenIndex, depth = position1828, tokenIndex1828, depth1828
+						goto l2378
+					l2379:
+						position, tokenIndex, depth = position2378, tokenIndex2378, depth2378
 						if buffer[position] != rune('N') {
-							goto l1821
+							goto l2371
 						}
 						position++
 					}
-				l1828:
+				l2378:
 					{
-						position1830, tokenIndex1830, depth1830 := position, tokenIndex, depth
+						position2380, tokenIndex2380, depth2380 := position, tokenIndex, depth
 						if buffer[position] != rune('o') {
-							goto l1831
+							goto l2381
 						}
 						position++
-						goto l1830
-					l1831:
-						position, tokenIndex, depth = position1830, tokenIndex1830, depth1830
+						goto l2380
+					l2381:
+						position, tokenIndex, depth = position2380, tokenIndex2380, depth2380
 						if buffer[position] != rune('O') {
-							goto l1821
+							goto l2371
 						}
 						position++
 					}
-				l1830:
+				l2380:
 					{
-						position1832, tokenIndex1832, depth1832 := position, tokenIndex, depth
+						position2382, tokenIndex2382, depth2382 := position, tokenIndex, depth
 						if buffer[position] != rune('t') {
-							goto l1833
+							goto l2383
 						}
 						position++
-						goto l1832
-					l1833:
-						position, tokenIndex, depth = position1832, tokenIndex1832, depth1832
+						goto l2382
+					l2383:
+						position, tokenIndex, depth = position2382, tokenIndex2382, depth2382
 						if buffer[position] != rune('T') {
-							goto l1821
+							goto l2371
 						}
 						position++
 					}
-				l1832:
+				l2382:
 					depth--
-					add(rulePegText, position1823)
+					add(rulePegText, position2373)
 				}
-				if !_rules[ruleAction125]() {
-					goto l1821
+				if !_rules[ruleAction157]() {
+					goto l2371
 				}
 				depth--
-				add(ruleIsNot, position1822)
+				add(ruleIsNot, position2372)
 			}
 			return true
-		l1821:
-			position, tokenIndex, depth = position1821, tokenIndex1821, depth1821
+		l2371:
+			position, tokenIndex, depth = position2371, tokenIndex2371, depth2371
 			return false
 		},
-		/* 157 Plus <- <(<'+'> Action126)> */
+		/* 194 Plus <- <(<'+'> Action158)> */
 		func() bool {
-			position1834, tokenIndex1834, depth1834 := position, tokenIndex, depth
+			position2384, tokenIndex2384, depth2384 := position, tokenIndex, depth
 			{
-				position1835 := position
+				position2385 := position
 				depth++
 				{
-					position1836 := position
+					position2386 := position
 					depth++
 					if buffer[position] != rune('+') {
-						goto l1834
+						goto l2384
 					}
 					position++
 					depth--
-					add(rulePegText, position1836)
+					add(rulePegText, position2386)
 				}
-				if !_rules[ruleAction126]() {
-					goto l1834
+				if !_rules[ruleAction158]() {
+					goto l2384
 				}
 				depth--
-				add(rulePlus, position1835)
+				add(rulePlus, position2385)
 			}
 			return true
-		l1834:
-			position, tokenIndex, depth = position1834, tokenIndex1834, depth1834
+		l2384:
+			position, tokenIndex, depth = position2384, tokenIndex2384, depth2384
 			return false
 		},
-		/* 158 Minus <- <(<'-'> Action127)> */
+		/* 195 Minus <- <(<'-'> Action159)> */
 		func() bool {
-			position1837, tokenIndex1837, depth1837 := position, tokenIndex, depth
+			position2387, tokenIndex2387, depth2387 := position, tokenIndex, depth
 			{
-				position1838 := position
+				position2388 := position
 				depth++
 				{
-					position1839 := position
+					position2389 := position
 					depth++
 					if buffer[position] != rune('-') {
-						goto l1837
+						goto l2387
 					}
 					position++
 					depth--
-					add(rulePegText, position1839)
+					add(rulePegText, position2389)
 				}
-				if !_rules[ruleAction127]() {
-					goto l1837
+				if !_rules[ruleAction159]() {
+					goto l2387
 				}
 				depth--
-				add(ruleMinus, position1838)
+				add(ruleMinus, position2388)
 			}
 			return true
-		l1837:
-			position, tokenIndex, depth = position1837, tokenIndex1837, depth1837
+		l2387:
+			position, tokenIndex, depth = position2387, tokenIndex2387, depth2387
 			return false
 		},
-		/* 159 Multiply <- <(<'*'> Action128)> */
+		/* 196 Multiply <- <(<'*'> Action160)> */
 		func() bool {
-			position1840, tokenIndex1840, depth1840 := position, tokenIndex, depth
+			position2390, tokenIndex2390, depth2390 := position, tokenIndex, depth
 			{
-				position1841 := position
+				position2391 := position
 				depth++
 				{
-					position1842 := position
+					position2392 := position
 					depth++
 					if buffer[position] != rune('*') {
-						goto l1840
+						goto l2390
 					}
 					position++
 					depth--
-					add(rulePegText, position1842)
+					add(rulePegText, position2392)
 				}
-				if !_rules[ruleAction128]() {
-					goto l1840
+				if !_rules[ruleAction160]() {
+					goto l2390
 				}
 				depth--
-				add(ruleMultiply, position1841)
+				add(ruleMultiply, position2391)
 			}
 			return true
-		l1840:
-			position, tokenIndex, depth = position1840, tokenIndex1840, depth1840
+		l2390:
+			position, tokenIndex, depth = position2390, tokenIndex2390, depth2390
 			return false
 		},
-		/* 160 Divide <- <(<'/'> Action129)> */
+		/* 197 Divide <- <(<'/'> Action161)> */
 		func() bool {
-			position1843, tokenIndex1843, depth1843 := position, tokenIndex, depth
+			position2393, tokenIndex2393, depth2393 := position, tokenIndex, depth
 			{
-				position1844 := position
+				position2394 := position
 				depth++
 				{
-					position1845 := position
+					position2395 := position
 					depth++
 					if buffer[position] != rune('/') {
-						goto l1843
+						goto l2393
 					}
 					position++
 					depth--
-					add(rulePegText, position1845)
+					add(rulePegText, position2395)
 				}
-				if !_rules[ruleAction129]() {
-					goto l1843
+				if !_rules[ruleAction161]() {
+					goto l2393
 				}
 				depth--
-				add(ruleDivide, position1844)
+				add(ruleDivide, position2394)
 			}
 			return true
-		l1843:
-			position, tokenIndex, depth = position1843, tokenIndex1843, depth1843
+		l2393:
+			position, tokenIndex, depth = position2393, tokenIndex2393, depth2393
 			return false
 		},
-		/* 161 Modulo <- <(<'%'> Action130)> */
+		/* 198 Modulo <- <(<'%'> Action162)> */
 		func() bool {
-			position1846, tokenIndex1846, depth1846 := position, tokenIndex, depth
+			position2396, tokenIndex2396, depth2396 := position, tokenIndex, depth
 			{
-				position1847 := position
+				position2397 := position
 				depth++
 				{
-					position1848 := position
+					position2398 := position
 					depth++
 					if buffer[position] != rune('%') {
-						goto l1846
+						goto l2396
 					}
 					position++
 					depth--
-					add(rulePegText, position1848)
+					add(rulePegText, position2398)
 				}
-				if !_rules[ruleAction130]() {
-					goto l1846
+				if !_rules[ruleAction162]() {
+					goto l2396
 				}
 				depth--
-				add(ruleModulo, position1847)
+				add(ruleModulo, position2397)
 			}
 			return true
-		l1846:
-			position, tokenIndex, depth = position1846, tokenIndex1846, depth1846
+		l2396:
+			position, tokenIndex, depth = position2396, tokenIndex2396, depth2396
 			return false
 		},
-		/* 162 UnaryMinus <- <(<'-'> Action131)> */
+		/* 199 UnaryMinus <- <(<'-'> Action163)> */
 		func() bool {
-			position1849, tokenIndex1849, depth1849 := position, tokenIndex, depth
+			position2399, tokenIndex2399, depth2399 := position, tokenIndex, depth
 			{
-				position1850 := position
+				position2400 := position
 				depth++
 				{
-					position1851 := position
+					position2401 := position
 					depth++
 					if buffer[position] != rune('-') {
-						goto l1849
+						goto l2399
 					}
 					position++
 					depth--
-					add(rulePegText, position1851)
+					add(rulePegText, position2401)
 				}
-				if !_rules[ruleAction131]() {
-					goto l1849
+				if !_rules[ruleAction163]() {
+					goto l2399
 				}
 				depth--
-				add(ruleUnaryMinus, position1850)
+				add(ruleUnaryMinus, position2400)
 			}
 			return true
-		l1849:
-			position, tokenIndex, depth = position1849, tokenIndex1849, depth1849
+		l2399:
+			position, tokenIndex, depth = position2399, tokenIndex2399, depth2399
 			return false
 		},
-		/* 163 Identifier <- <(<ident> Action132)> */
+		/* 200 Identifier <- <(<ident> Action164)> */
 		func() bool {
-			position1852, tokenIndex1852, depth1852 := position, tokenIndex, depth
+			position2402, tokenIndex2402, depth2402 := position, tokenIndex, depth
 			{
-				position1853 := position
+				position2403 := position
 				depth++
 				{
-					position1854 := position
+					position2404 := position
 					depth++
 					if !_rules[ruleident]() {
-						goto l1852
+						goto l2402
 					}
 					depth--
-					add(rulePegText, position1854)
+					add(rulePegText, position2404)
 				}
-				if !_rules[ruleAction132]() {
-					goto l1852
+				if !_rules[ruleAction164]() {
+					goto l2402
 				}
 				depth--
-				add(ruleIdentifier, position1853)
+				add(ruleIdentifier, position2403)
 			}
 			return true
-		l1852:
-			position, tokenIndex, depth = position1852, tokenIndex1852, depth1852
+		l2402:
+			position, tokenIndex, depth = position2402, tokenIndex2402, depth2402
 			return false
 		},
-		/* 164 TargetIdentifier <- <(<('*' / jsonSetPath)> Action133)> */
+		/* 201 TargetIdentifier <- <(<('*' / jsonSetPath)> Action165)> */
 		func() bool {
-			position1855, tokenIndex1855, depth1855 := position, tokenIndex, depth
+			position2405, tokenIndex2405, depth2405 := position, tokenIndex, depth
 			{
-				position1856 := position
+				position2406 := position
 				depth++
 				{
-					position1857 := position
+					position2407 := position
 					depth++
 					{
-						position1858, tokenIndex1858, depth1858 := position, tokenIndex, depth
+						position2408, tokenIndex2408, depth2408 := position, tokenIndex, depth
 						if buffer[position] != rune('*') {
-							goto l1859
+							goto l2409
 						}
 						position++
-						goto l1858
-					l1859:
-						position, tokenIndex, depth = position1858, tokenIndex1858, depth1858
+						goto l2408
+					l2409:
+						position, tokenIndex, depth = position2408, tokenIndex2408, depth2408
 						if !_rules[rulejsonSetPath]() {
-							goto l1855
+							goto l2405
 						}
 					}
-				l1858:
+				l2408:
 					depth--
-					add(rulePegText, position1857)
+					add(rulePegText, position2407)
 				}
-				if !_rules[ruleAction133]() {
-					goto l1855
+				if !_rules[ruleAction165]() {
+					goto l2405
 				}
 				depth--
-				add(ruleTargetIdentifier, position1856)
+				add(ruleTargetIdentifier, position2406)
 			}
 			return true
-		l1855:
-			position, tokenIndex, depth = position1855, tokenIndex1855, depth1855
+		l2405:
+			position, tokenIndex, depth = position2405, tokenIndex2405, depth2405
 			return false
 		},
-		/* 165 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 202 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
 		func() bool {
-			position1860, tokenIndex1860, depth1860 := position, tokenIndex, depth
+			position2410, tokenIndex2410, depth2410 := position, tokenIndex, depth
 			{
-				position1861 := position
+				position2411 := position
 				depth++
 				{
-					position1862, tokenIndex1862, depth1862 := position, tokenIndex, depth
+					position2412, tokenIndex2412, depth2412 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1863
+						goto l2413
 					}
 					position++
-					goto l1862
-				l1863:
-					position, tokenIndex, depth = position1862, tokenIndex1862, depth1862
+					goto l2412
+				l2413:
+					position, tokenIndex, depth = position2412, tokenIndex2412, depth2412
 					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1860
+						goto l2410
 					}
 					position++
 				}
-			l1862:
-			l1864:
+			l2412:
+			l2414:
 				{
-					position1865, tokenIndex1865, depth1865 := position, tokenIndex, depth
+					position2415, tokenIndex2415, depth2415 := position, tokenIndex, depth
 					{
-						position1866, tokenIndex1866, depth1866 := position, tokenIndex, depth
+						position2416, tokenIndex2416, depth2416 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1867
+							goto l2417
 						}
 						position++
-						goto l1866
-					l1867:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2416
+					l2417:
+						position, tokenIndex, depth = position2416, tokenIndex2416, depth2416
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1868
+							goto l2418
 						}
 						position++
-						goto l1866
-					l1868:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2416
+					l2418:
+						position, tokenIndex, depth = position2416, tokenIndex2416, depth2416
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1869
+							goto l2419
 						}
 						position++
-						goto l1866
-					l1869:
-						position, tokenIndex, depth = position1866, tokenIndex1866, depth1866
+						goto l2416
+					l2419:
+						position, tokenIndex, depth = position2416, tokenIndex2416, depth2416
 						if buffer[position] != rune('_') {
-							goto l1865
+							goto l2415
 						}
 						position++
 					}
-				l1866:
-					goto l1864
-				l1865:
-					position, tokenIndex, depth = position1865, tokenIndex1865, depth1865
+				l2416:
+					goto l2414
+				l2415:
+					position, tokenIndex, depth = position2415, tokenIndex2415, depth2415
 				}
 				depth--
-				add(ruleident, position1861)
+				add(ruleident, position2411)
 			}
 			return true
-		l1860:
-			position, tokenIndex, depth = position1860, tokenIndex1860, depth1860
+		l2410:
+			position, tokenIndex, depth = position2410, tokenIndex2410, depth2410
 			return false
 		},
-		/* 166 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 203 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
 		func() bool {
-			position1870, tokenIndex1870, depth1870 := position, tokenIndex, depth
+			position2420, tokenIndex2420, depth2420 := position, tokenIndex, depth
 			{
-				position1871 := position
+				position2421 := position
 				depth++
 				if !_rules[rulejsonPathHead]() {
-					goto l1870
+					goto l2420
 				}
-			l1872:
+			l2422:
 				{
-					position1873, tokenIndex1873, depth1873 := position, tokenIndex, depth
+					position2423, tokenIndex2423, depth2423 := position, tokenIndex, depth
 					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1873
+						goto l2423
 					}
-					goto l1872
-				l1873:
-					position, tokenIndex, depth = position1873, tokenIndex1873, depth1873
+					goto l2422
+				l2423:
+					position, tokenIndex, depth = position2423, tokenIndex2423, depth2423
 				}
 				depth--
-				add(rulejsonGetPath, position1871)
+				add(rulejsonGetPath, position2421)
 			}
 			return true
-		l1870:
-			position, tokenIndex, depth = position1870, tokenIndex1870, depth1870
+		l2420:
+			position, tokenIndex, depth = position2420, tokenIndex2420, depth2420
 			return false
 		},
-		/* 167 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 204 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
 		func() bool {
-			position1874, tokenIndex1874, depth1874 := position, tokenIndex, depth
+			position2424, tokenIndex2424, depth2424 := position, tokenIndex, depth
 			{
-				position1875 := position
+				position2425 := position
 				depth++
 				if !_rules[rulejsonPathHead]() {
-					goto l1874
+					goto l2424
 				}
-			l1876:
+			l2426:
 				{
-					position1877, tokenIndex1877, depth1877 := position, tokenIndex, depth
+					position2427, tokenIndex2427, depth2427 := position, tokenIndex, depth
 					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1877
+						goto l2427
 					}
-					goto l1876
-				l1877:
-					position, tokenIndex, depth = position1877, tokenIndex1877, depth1877
+					goto l2426
+				l2427:
+					position, tokenIndex, depth = position2427, tokenIndex2427, depth2427
 				}
 				depth--
-				add(rulejsonSetPath, position1875)
+				add(rulejsonSetPath, position2425)
 			}
 			return true
-		l1874:
-			position, tokenIndex, depth = position1874, tokenIndex1874, depth1874
+		l2424:
+			position, tokenIndex, depth = position2424, tokenIndex2424, depth2424
 			return false
 		},
-		/* 168 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 205 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
 		func() bool {
-			position1878, tokenIndex1878, depth1878 := position, tokenIndex, depth
+			position2428, tokenIndex2428, depth2428 := position, tokenIndex, depth
 			{
-				position1879 := position
+				position2429 := position
 				depth++
 				{
-					position1880, tokenIndex1880, depth1880 := position, tokenIndex, depth
+					position2430, tokenIndex2430, depth2430 := position, tokenIndex, depth
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1881
+						goto l2431
 					}
-					goto l1880
-				l1881:
-					position, tokenIndex, depth = position1880, tokenIndex1880, depth1880
+					goto l2430
+				l2431:
+					position, tokenIndex, depth = position2430, tokenIndex2430, depth2430
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1878
+						goto l2428
 					}
 				}
-			l1880:
+			l2430:
 				depth--
-				add(rulejsonPathHead, position1879)
+				add(rulejsonPathHead, position2429)
 			}
 			return true
-		l1878:
-			position, tokenIndex, depth = position1878, tokenIndex1878, depth1878
+		l2428:
+			position, tokenIndex, depth = position2428, tokenIndex2428, depth2428
 			return false
 		},
-		/* 169 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 206 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
 		func() bool {
-			position1882, tokenIndex1882, depth1882 := position, tokenIndex, depth
+			position2432, tokenIndex2432, depth2432 := position, tokenIndex, depth
 			{
-				position1883 := position
+				position2433 := position
 				depth++
 				{
-					position1884, tokenIndex1884, depth1884 := position, tokenIndex, depth
+					position2434, tokenIndex2434, depth2434 := position, tokenIndex, depth
 					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1885
+						goto l2435
 					}
-					goto l1884
-				l1885:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2434
+				l2435:
+					position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1886
+						goto l2436
 					}
-					goto l1884
-				l1886:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2434
+				l2436:
+					position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
 					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1887
+						goto l2437
 					}
-					goto l1884
-				l1887:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2434
+				l2437:
+					position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
 					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1888
+						goto l2438
 					}
-					goto l1884
-				l1888:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2434
+				l2438:
+					position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
 					if !_rules[rulejsonArraySlice]() {
-						goto l1889
+						goto l2439
 					}
-					goto l1884
-				l1889:
-					position, tokenIndex, depth = position1884, tokenIndex1884, depth1884
+					goto l2434
+				l2439:
+					position, tokenIndex, depth = position2434, tokenIndex2434, depth2434
 					if !_rules[rulejsonArrayAccess]() {
-						goto l1882
+						goto l2432
 					}
 				}
-			l1884:
+			l2434:
 				depth--
-				add(rulejsonGetPathNonHead, position1883)
+				add(rulejsonGetPathNonHead, position2433)
 			}
 			return true
-		l1882:
-			position, tokenIndex, depth = position1882, tokenIndex1882, depth1882
+		l2432:
+			position, tokenIndex, depth = position2432, tokenIndex2432, depth2432
 			return false
 		},
-		/* 170 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 207 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
 		func() bool {
-			position1890, tokenIndex1890, depth1890 := position, tokenIndex, depth
+			position2440, tokenIndex2440, depth2440 := position, tokenIndex, depth
 			{
-				position1891 := position
+				position2441 := position
 				depth++
 				{
-					position1892, tokenIndex1892, depth1892 := position, tokenIndex, depth
+					position2442, tokenIndex2442, depth2442 := position, tokenIndex, depth
 					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1893
+						goto l2443
 					}
-					goto l1892
-				l1893:
-					position, tokenIndex, depth = position1892, tokenIndex1892, depth1892
+					goto l2442
+				l2443:
+					position, tokenIndex, depth = position2442, tokenIndex2442, depth2442
 					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1890
+						goto l2440
 					}
 				}
-			l1892:
+			l2442:
 				depth--
-				add(rulejsonSetPathNonHead, position1891)
+				add(rulejsonSetPathNonHead, position2441)
 			}
 			return true
-		l1890:
-			position, tokenIndex, depth = position1890, tokenIndex1890, depth1890
+		l2440:
+			position, tokenIndex, depth = position2440, tokenIndex2440, depth2440
 			return false
 		},
-		/* 171 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 208 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
 		func() bool {
-			position1894, tokenIndex1894, depth1894 := position, tokenIndex, depth
+			position2444, tokenIndex2444, depth2444 := position, tokenIndex, depth
 			{
-				position1895 := position
+				position2445 := position
 				depth++
 				{
-					position1896, tokenIndex1896, depth1896 := position, tokenIndex, depth
+					position2446, tokenIndex2446, depth2446 := position, tokenIndex, depth
 					if buffer[position] != rune('.') {
-						goto l1897
+						goto l2447
 					}
 					position++
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1897
+						goto l2447
 					}
-					goto l1896
-				l1897:
-					position, tokenIndex, depth = position1896, tokenIndex1896, depth1896
+					goto l2446
+				l2447:
+					position, tokenIndex, depth = position2446, tokenIndex2446, depth2446
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1894
+						goto l2444
 					}
 				}
-			l1896:
+			l2446:
 				depth--
-				add(rulejsonMapSingleLevel, position1895)
+				add(rulejsonMapSingleLevel, position2445)
 			}
 			return true
-		l1894:
-			position, tokenIndex, depth = position1894, tokenIndex1894, depth1894
+		l2444:
+			position, tokenIndex, depth = position2444, tokenIndex2444, depth2444
 			return false
 		},
-		/* 172 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 209 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
 		func() bool {
-			position1898, tokenIndex1898, depth1898 := position, tokenIndex, depth
+			position2448, tokenIndex2448, depth2448 := position, tokenIndex, depth
 			{
-				position1899 := position
+				position2449 := position
 				depth++
 				if buffer[position] != rune('.') {
-					goto l1898
+					goto l2448
 				}
 				position++
 				if buffer[position] != rune('.') {
-					goto l1898
+					goto l2448
 				}
 				position++
 				{
-					position1900, tokenIndex1900, depth1900 := position, tokenIndex, depth
+					position2450, tokenIndex2450, depth2450 := position, tokenIndex, depth
 					if !_rules[rulejsonMapAccessString]() {
-						goto l1901
+						goto l2451
 					}
-					goto l1900
-				l1901:
-					position, tokenIndex, depth = position1900, tokenIndex1900, depth1900
+					goto l2450
+				l2451:
+					position, tokenIndex, depth = position2450, tokenIndex2450, depth2450
 					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1898
+						goto l2448
 					}
 				}
-			l1900:
+			l2450:
 				depth--
-				add(rulejsonMapMultipleLevel, position1899)
+				add(rulejsonMapMultipleLevel, position2449)
 			}
 			return true
-		l1898:
-			position, tokenIndex, depth = position1898, tokenIndex1898, depth1898
+		l2448:
+			position, tokenIndex, depth = position2448, tokenIndex2448, depth2448
 			return false
 		},
-		/* 173 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 210 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
 		func() bool {
-			position1902, tokenIndex1902, depth1902 := position, tokenIndex, depth
+			position2452, tokenIndex2452, depth2452 := position, tokenIndex, depth
 			{
-				position1903 := position
+				position2453 := position
 				depth++
 				{
-					position1904 := position
+					position2454 := position
 					depth++
 					{
-						position1905, tokenIndex1905, depth1905 := position, tokenIndex, depth
+						position2455, tokenIndex2455, depth2455 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1906
+							goto l2456
 						}
 						position++
-						goto l1905
-					l1906:
-						position, tokenIndex, depth = position1905, tokenIndex1905, depth1905
+						goto l2455
+					l2456:
+						position, tokenIndex, depth = position2455, tokenIndex2455, depth2455
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1902
+							goto l2452
 						}
 						position++
 					}
-				l1905:
-				l1907:
+				l2455:
+				l2457:
 					{
-						position1908, tokenIndex1908, depth1908 := position, tokenIndex, depth
+						position2458, tokenIndex2458, depth2458 := position, tokenIndex, depth
 						{
-							position1909, tokenIndex1909, depth1909 := position, tokenIndex, depth
+							position2459, tokenIndex2459, depth2459 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1910
+								goto l2460
 							}
 							position++
-							goto l1909
-						l1910:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2459
+						l2460:
+							position, tokenIndex, depth = position2459, tokenIndex2459, depth2459
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1911
+								goto l2461
 							}
 							position++
-							goto l1909
-						l1911:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2459
+						l2461:
+							position, tokenIndex, depth = position2459, tokenIndex2459, depth2459
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1912
+								goto l2462
 							}
 							position++
-							goto l1909
-						l1912:
-							position, tokenIndex, depth = position1909, tokenIndex1909, depth1909
+							goto l2459
+						l2462:
+							position, tokenIndex, depth = position2459, tokenIndex2459, depth2459
 							if buffer[position] != rune('_') {
-								goto l1908
+								goto l2458
 							}
 							position++
-						}
-					l1909:
-						goto l1907
-					l1908:
-						position, tokenIndex, depth = position1908, tokenIndex1908, depth1908
+						}
+					l2459:
+						goto l2457
+					l2458:
+						position, tokenIndex, depth = position2458, tokenIndex2458, depth2458
 					}
 					depth--
-					add(rulePegText, position1904)
+					add(rulePegText, position2454)
 				}
 				depth--
-				add(rulejsonMapAccessString, position1903)
+				add(rulejsonMapAccessString, position2453)
 			}
 			return true
-		l1902:
-			position, tokenIndex, depth = position1902, tokenIndex1902, depth1902
+		l2452:
+			position, tokenIndex, depth = position2452, tokenIndex2452, depth2452
 			return false
 		},
-		/* 174 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 211 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
 		func() bool {
-			position1913, tokenIndex1913, depth1913 := position, tokenIndex, depth
+			position2463, tokenIndex2463, depth2463 := position, tokenIndex, depth
 			{
-				position1914 := position
+				position2464 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1913
+					goto l2463
 				}
 				position++
 				if !_rules[ruledoubleQuotedString]() {
-					goto l1913
+					goto l2463
 				}
 				if buffer[position] != rune(']') {
-					goto l1913
+					goto l2463
 				}
 				position++
 				depth--
-				add(rulejsonMapAccessBracket, position1914)
+				add(rulejsonMapAccessBracket, position2464)
 			}
 			return true
-		l1913:
-			position, tokenIndex, depth = position1913, tokenIndex1913, depth1913
+		l2463:
+			position, tokenIndex, depth = position2463, tokenIndex2463, depth2463
 			return false
 		},
-		/* 175 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 212 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
 		func() bool {
-			position1915, tokenIndex1915, depth1915 := position, tokenIndex, depth
+			position2465, tokenIndex2465, depth2465 := position, tokenIndex, depth
 			{
-				position1916 := position
+				position2466 := position
 				depth++
 				if buffer[position] != rune('"') {
-					goto l1915
+					goto l2465
 				}
 				position++
 				{
-					position1917 := position
+					position2467 := position
 					depth++
-				l1918:
+				l2468:
 					{
-						position1919, tokenIndex1919, depth1919 := position, tokenIndex, depth
+						position2469, tokenIndex2469, depth2469 := position, tokenIndex, depth
 						{
-							position1920, tokenIndex1920, depth1920 := position, tokenIndex, depth
+							position2470, tokenIndex2470, depth2470 := position, tokenIndex, depth
 							if buffer[position] != rune('"') {
-								goto l1921
+								goto l2471
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l1921
+								goto l2471
 							}
 							position++
-							goto l1920
-						l1921:
-							position, tokenIndex, depth = position1920, tokenIndex1920, depth1920
+							goto l2470
+						l2471:
+							position, tokenIndex, depth = position2470, tokenIndex2470, depth2470
 							{
-								position1922, tokenIndex1922, depth1922 := position, tokenIndex, depth
+								position2472, tokenIndex2472, depth2472 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l1922
+									goto l2472
 								}
 								position++
-								goto l1919
-							l1922:
-								position, tokenIndex, depth = position1922, tokenIndex1922, depth1922
+								goto l2469
+							l2472:
+								position, tokenIndex, depth = position2472, tokenIndex2472, depth2472
 							}
 							if !matchDot() {
-								goto l1919
+								goto l2469
 							}
 						}
-					l1920:
-						goto l1918
-					l1919:
-						position, tokenIndex, depth = position1919, tokenIndex1919, depth1919
+					l2470:
+						goto l2468
+					l2469:
+						position, tokenIndex, depth = position2469, tokenIndex2469, depth2469
 					}
 					depth--
-					add(rulePegText, position1917)
+					add(rulePegText, position2467)
 				}
 				if buffer[position] != rune('"') {
-					goto l1915
+					goto l2465
 				}
 				position++
 				depth--
-				add(ruledoubleQuotedString, position1916)
+				add(ruledoubleQuotedString, position2466)
 			}
 			return true
-		l1915:
-			position, tokenIndex, depth = position1915, tokenIndex1915, depth1915
+		l2465:
+			position, tokenIndex, depth = position2465, tokenIndex2465, depth2465
 			return false
 		},
-		/* 176 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 213 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
 		func() bool {
-			position1923, tokenIndex1923, depth1923 := position, tokenIndex, depth
+			position2473, tokenIndex2473, depth2473 := position, tokenIndex, depth
 			{
-				position1924 := position
+				position2474 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1923
+					goto l2473
 				}
 				position++
 				{
-					position1925 := position
+					position2475 := position
 					depth++
 					{
-						position1926, tokenIndex1926, depth1926 := position, tokenIndex, depth
+						position2476, tokenIndex2476, depth2476 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1926
+							goto l2476
 						}
 						position++
-						goto l1927
-					l1926:
-						position, tokenIndex, depth = position1926, tokenIndex1926, depth1926
+						goto l2477
+					l2476:
+						position, tokenIndex, depth = position2476, tokenIndex2476, depth2476
 					}
-				l1927:
+				l2477:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1923
+						goto l2473
 					}
 					position++
-				l1928:
+				l2478:
 					{
-						position1929, tokenIndex1929, depth1929 := position, tokenIndex, depth
+						position2479, tokenIndex2479, depth2479 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1929
+							goto l2479
 						}
 						position++
-						goto l1928
-					l1929:
-						position, tokenIndex, depth = position1929, tokenIndex1929, depth1929
+						goto l2478
+					l2479:
+						position, tokenIndex, depth = position2479, tokenIndex2479, depth2479
 					}
 					depth--
-					add(rulePegText, position1925)
+					add(rulePegText, position2475)
 				}
 				if buffer[position] != rune(']') {
-					goto l1923
+					goto l2473
 				}
 				position++
 				depth--
-				add(rulejsonArrayAccess, position1924)
+				add(rulejsonArrayAccess, position2474)
 			}
 			return true
-		l1923:
-			position, tokenIndex, depth = position1923, tokenIndex1923, depth1923
+		l2473:
+			position, tokenIndex, depth = position2473, tokenIndex2473, depth2473
 			return false
 		},
-		/* 177 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 214 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
 		func() bool {
-			position1930, tokenIndex1930, depth1930 := position, tokenIndex, depth
+			position2480, tokenIndex2480, depth2480 := position, tokenIndex, depth
 			{
-				position1931 := position
+				position2481 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1930
+					goto l2480
 				}
 				position++
 				{
-					position1932 := position
+					position2482 := position
 					depth++
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1930
+						goto l2480
 					}
 					position++
-				l1933:
+				l2483:
 					{
-						position1934, tokenIndex1934, depth1934 := position, tokenIndex, depth
+						position2484, tokenIndex2484, depth2484 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1934
+							goto l2484
 						}
 						position++
-						goto l1933
-					l1934:
-						position, tokenIndex, depth = position1934, tokenIndex1934, depth1934
+						goto l2483
+					l2484:
+						position, tokenIndex, depth = position2484, tokenIndex2484, depth2484
 					}
 					depth--
-					add(rulePegText, position1932)
+					add(rulePegText, position2482)
 				}
 				if buffer[position] != rune(']') {
-					goto l1930
+					goto l2480
 				}
 				position++
 				depth--
-				add(rulejsonNonNegativeArrayAccess, position1931)
+				add(rulejsonNonNegativeArrayAccess, position2481)
 			}
 			return true
-		l1930:
-			position, tokenIndex, depth = position1930, tokenIndex1930, depth1930
+		l2480:
+			position, tokenIndex, depth = position2480, tokenIndex2480, depth2480
 			return false
 		},
-		/* 178 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 215 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
 		func() bool {
-			position1935, tokenIndex1935, depth1935 := position, tokenIndex, depth
+			position2485, tokenIndex2485, depth2485 := position, tokenIndex, depth
 			{
-				position1936 := position
+				position2486 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1935
+					goto l2485
 				}
 				position++
 				{
-					position1937 := position
+					position2487 := position
 					depth++
 					{
-						position1938, tokenIndex1938, depth1938 := position, tokenIndex, depth
+						position2488, tokenIndex2488, depth2488 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1938
+							goto l2488
 						}
 						position++
-						goto l1939
-					l1938:
-						position, tokenIndex, depth = position1938, tokenIndex1938, depth1938
+						goto l2489
+					l2488:
+						position, tokenIndex, depth = position2488, tokenIndex2488, depth2488
 					}
-				l1939:
+				l2489:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1935
+						goto l2485
 					}
 					position++
-				l1940:
+				l2490:
 					{
-						position1941, tokenIndex1941, depth1941 := position, tokenIndex, depth
+						position2491, tokenIndex2491, depth2491 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1941
+							goto l2491
 						}
 						position++
-						goto l1940
-					l1941:
-						position, tokenIndex, depth = position1941, tokenIndex1941, depth1941
+						goto l2490
+					l2491:
+						position, tokenIndex, depth = position2491, tokenIndex2491, depth2491
 					}
 					if buffer[position] != rune(':') {
-						goto l1935
+						goto l2485
 					}
 					position++
 					{
-						position1942, tokenIndex1942, depth1942 := position, tokenIndex, depth
+						position2492, tokenIndex2492, depth2492 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l1942
+							goto l2492
 						}
 						position++
-						goto l1943
-					l1942:
-						position, tokenIndex, depth = position1942, tokenIndex1942, depth1942
+						goto l2493
+					l2492:
+						position, tokenIndex, depth = position2492, tokenIndex2492, depth2492
 					}
-				l1943:
+				l2493:
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1935
+						goto l2485
 					}
 					position++
-				l1944:
+				l2494:
 					{
-						position1945, tokenIndex1945, depth1945 := position, tokenIndex, depth
+						position2495, tokenIndex2495, depth2495 := position, tokenIndex, depth
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1945
+							goto l2495
 						}
 						position++
-						goto l1944
-					l1945:
-						position, tokenIndex, depth = position1945, tokenIndex1945, depth1945
+						goto l2494
+					l2495:
+						position, tokenIndex, depth = position2495, tokenIndex2495, depth2495
 					}
 					{
-						position1946, tokenIndex1946, depth1946 := position, tokenIndex, depth
+						position2496, tokenIndex2496, depth2496 := position, tokenIndex, depth
 						if buffer[position] != rune(':') {
-							goto l1946
+							goto l2496
 						}
 						position++
 						{
-							position1948, tokenIndex1948, depth1948 := position, tokenIndex, depth
+							position2498, tokenIndex2498, depth2498 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1948
+								goto l2498
 							}
 							position++
-							goto l1949
-						l1948:
-							position, tokenIndex, depth = position1948, tokenIndex1948, depth1948
+							goto l2499
+						l2498:
+							position, tokenIndex, depth = position2498, tokenIndex2498, depth2498
 						}
-					l1949:
+					l2499:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1946
+							goto l2496
 						}
 						position++
-					l1950:
+					l2500:
 						{
-							position1951, tokenIndex1951, depth1951 := position, tokenIndex, depth
+							position2501, tokenIndex2501, depth2501 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1951
+								goto l2501
 							}
 							position++
-							goto l1950
-						l1951:
-							position, tokenIndex, depth = position1951, tokenIndex1951, depth1951
+							goto l2500
+						l2501:
+							position, tokenIndex, depth = position2501, tokenIndex2501, depth2501
 						}
-						goto l1947
-					l1946:
-						position, tokenIndex, depth = position1946, tokenIndex1946, depth1946
+						goto l2497
+					l2496:
+						position, tokenIndex, depth = position2496, tokenIndex2496, depth2496
 					}
-				l1947:
+				l2497:
 					depth--
-					add(rulePegText, position1937)
+					add(rulePegText, position2487)
 				}
 				if buffer[position] != rune(']') {
-					goto l1935
+					goto l2485
 				}
 				position++
 				depth--
-				add(rulejsonArraySlice, position1936)
+				add(rulejsonArraySlice, position2486)
 			}
 			return true
-		l1935:
-			position, tokenIndex, depth = position1935, tokenIndex1935, depth1935
+		l2485:
+			position, tokenIndex, depth = position2485, tokenIndex2485, depth2485
 			return false
 		},
-		/* 179 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 216 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
 		func() bool {
-			position1952, tokenIndex1952, depth1952 := position, tokenIndex, depth
+			position2502, tokenIndex2502, depth2502 := position, tokenIndex, depth
 			{
-				position1953 := position
+				position2503 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1952
+					goto l2502
 				}
 				position++
 				{
-					position1954 := position
+					position2504 := position
 					depth++
 					{
-						position1955, tokenIndex1955, depth1955 := position, tokenIndex, depth
+						position2505, tokenIndex2505, depth2505 := position, tokenIndex, depth
 						if buffer[position] != rune(':') {
-							goto l1956
+							goto l2506
 						}
 						position++
 						{
-							position1957, tokenIndex1957, depth1957 := position, tokenIndex, depth
+							position2507, tokenIndex2507, depth2507 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1957
+								goto l2507
 							}
 							position++
-							goto l1958
-						l1957:
-							position, tokenIndex, depth = position1957, tokenIndex1957, depth1957
+							goto l2508
+						l2507:
+							position, tokenIndex, depth = position2507, tokenIndex2507, depth2507
 						}
-					l1958:
+					l2508:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1956
+							goto l2506
 						}
 						position++
-					l1959:
+					l2509:
 						{
-							position1960, tokenIndex1960, depth1960 := position, tokenIndex, depth
+							position2510, tokenIndex2510, depth2510 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1960
+								goto l2510
 							}
 							position++
-							goto l1959
-						l1960:
-							position, tokenIndex, depth = position1960, tokenIndex1960, depth1960
+							goto l2509
+						l2510:
+							position, tokenIndex, depth = position2510, tokenIndex2510, depth2510
 						}
-						goto l1955
-					l1956:
-						position, tokenIndex, depth = position1955, tokenIndex1955, depth1955
+						goto l2505
+					l2506:
+						position, tokenIndex, depth = position2505, tokenIndex2505, depth2505
 						{
-							position1961, tokenIndex1961, depth1961 := position, tokenIndex, depth
+							position2511, tokenIndex2511, depth2511 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l1961
+								goto l2511
 							}
 							position++
-							goto l1962
-						l1961:
-							position, tokenIndex, depth = position1961, tokenIndex1961, depth1961
+							goto l2512
+						l2511:
+							position, tokenIndex, depth = position2511, tokenIndex2511, depth2511
 						}
-					l1962:
+					l2512:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1952
+							goto l2502
 						}
 						position++
-					l1963:
+					l2513:
 						{
-							position1964, tokenIndex1964, depth1964 := position, tokenIndex, depth
+							position2514, tokenIndex2514, depth2514 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1964
+								goto l2514
 							}
 							position++
-							goto l1963
-						l1964:
-							position, tokenIndex, depth = position1964, tokenIndex1964, depth1964
+							goto l2513
+						l2514:
+							position, tokenIndex, depth = position2514, tokenIndex2514, depth2514
 						}
 						if buffer[position] != rune(':') {
-							goto l1952
+							goto l2502
 						}
 						position++
 					}
-				l1955:
+				l2505:
 					depth--
-					add(rulePegText, position1954)
+					add(rulePegText, position2504)
 				}
 				if buffer[position] != rune(']') {
-					goto l1952
+					goto l2502
 				}
 				position++
 				depth--
-				add(rulejsonArrayPartialSlice, position1953)
+				add(rulejsonArrayPartialSlice, position2503)
 			}
 			return true
-		l1952:
-			position, tokenIndex, depth = position1952, tokenIndex1952, depth1952
+		l2502:
+			position, tokenIndex, depth = position2502, tokenIndex2502, depth2502
 			return false
 		},
-		/* 180 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 217 jsonArrayFullSlice <- <('[' ':' ']')> */
 		func() bool {
-			position1965, tokenIndex1965, depth1965 := position, tokenIndex, depth
+			position2515, tokenIndex2515, depth2515 := position, tokenIndex, depth
 			{
-				position1966 := position
+				position2516 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l1965
+					goto l2515
 				}
 				position++
 				if buffer[position] != rune(':') {
-					goto l1965
+					goto l2515
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l1965
+					goto l2515
 				}
 				position++
 				depth--
-				add(rulejsonArrayFullSlice, position1966)
+				add(rulejsonArrayFullSlice, position2516)
 			}
 			return true
-		l1965:
-			position, tokenIndex, depth = position1965, tokenIndex1965, depth1965
+		l2515:
+			position, tokenIndex, depth = position2515, tokenIndex2515, depth2515
 			return false
 		},
-		/* 181 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 218 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
 		func() bool {
-			position1967, tokenIndex1967, depth1967 := position, tokenIndex, depth
+			position2517, tokenIndex2517, depth2517 := position, tokenIndex, depth
 			{
-				position1968 := position
+				position2518 := position
 				depth++
 				{
-					position1969, tokenIndex1969, depth1969 := position, tokenIndex, depth
+					position2519, tokenIndex2519, depth2519 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l1970
+						goto l2520
 					}
 					position++
-					goto l1969
-				l1970:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2519
+				l2520:
+					position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 					if buffer[position] != rune('\t') {
-						goto l1971
+						goto l2521
 					}
 					position++
-					goto l1969
-				l1971:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2519
+				l2521:
+					position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 					if buffer[position] != rune('\n') {
-						goto l1972
+						goto l2522
 					}
 					position++
-					goto l1969
-				l1972:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2519
+				l2522:
+					position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 					if buffer[position] != rune('\r') {
-						goto l1973
+						goto l2523
 					}
 					position++
-					goto l1969
-				l1973:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2519
+				l2523:
+					position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 					if !_rules[rulecomment]() {
-						goto l1974
+						goto l2524
 					}
-					goto l1969
-				l1974:
-					position, tokenIndex, depth = position1969, tokenIndex1969, depth1969
+					goto l2519
+				l2524:
+					position, tokenIndex, depth = position2519, tokenIndex2519, depth2519
 					if !_rules[rulefinalComment]() {
-						goto l1967
+						goto l2517
 					}
 				}
-			l1969:
+			l2519:
 				depth--
-				add(rulespElem, position1968)
+				add(rulespElem, position2518)
 			}
 			return true
-		l1967:
-			position, tokenIndex, depth = position1967, tokenIndex1967, depth1967
+		l2517:
+			position, tokenIndex, depth = position2517, tokenIndex2517, depth2517
 			return false
 		},
-		/* 182 sp <- <spElem+> */
+		/* 219 sp <- <spElem+> */
 		func() bool {
-			position1975, tokenIndex1975, depth1975 := position, tokenIndex, depth
+			position2525, tokenIndex2525, depth2525 := position, tokenIndex, depth
 			{
-				position1976 := position
+				position2526 := position
 				depth++
 				if !_rules[rulespElem]() {
-					goto l1975
+					goto l2525
 				}
-			l1977:
+			l2527:
 				{
-					position1978, tokenIndex1978, depth1978 := position, tokenIndex, depth
+					position2528, tokenIndex2528, depth2528 := position, tokenIndex, depth
 					if !_rules[rulespElem]() {
-						goto l1978
+						goto l2528
 					}
-					goto l1977
-				l1978:
-					position, tokenIndex, depth = position1978, tokenIndex1978, depth1978
+					goto l2527
+				l2528:
+					position, tokenIndex, depth = position2528, tokenIndex2528, depth2528
 				}
 				depth--
-				add(rulesp, position1976)
+				add(rulesp, position2526)
 			}
 			return true
-		l1975:
-			position, tokenIndex, depth = position1975, tokenIndex1975, depth1975
+		l2525:
+			position, tokenIndex, depth = position2525, tokenIndex2525, depth2525
 			return false
 		},
-		/* 183 spOpt <- <spElem*> */
+		/* 220 spOpt <- <spElem*> */
 		func() bool {
 			{
-				position1980 := position
+				position2530 := position
 				depth++
-			l1981:
+			l2531:
 				{
-					position1982, tokenIndex1982, depth1982 := position, tokenIndex, depth
+					position2532, tokenIndex2532, depth2532 := position, tokenIndex, depth
 					if !_rules[rulespElem]() {
-						goto l1982
+						goto l2532
 					}
-					goto l1981
-				l1982:
-					position, tokenIndex, depth = position1982, tokenIndex1982, depth1982
+					goto l2531
+				l2532:
+					position, tokenIndex, depth = position2532, tokenIndex2532, depth2532
 				}
 				depth--
-				add(rulespOpt, position1980)
+				add(rulespOpt, position2530)
 			}
 			return true
 		},
-		/* 184 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 221 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
 		func() bool {
-			position1983, tokenIndex1983, depth1983 := position, tokenIndex, depth
+			position2533, tokenIndex2533, depth2533 := position, tokenIndex, depth
 			{
-				position1984 := position
+				position2534 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1983
+					goto l2533
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1983
+					goto l2533
 				}
 				position++
-			l1985:
+			l2535:
 				{
-					position1986, tokenIndex1986, depth1986 := position, tokenIndex, depth
+					position2536, tokenIndex2536, depth2536 := position, tokenIndex, depth
 					{
-						position1987, tokenIndex1987, depth1987 := position, tokenIndex, depth
+						position2537, tokenIndex2537, depth2537 := position, tokenIndex, depth
 						{
-							position1988, tokenIndex1988, depth1988 := position, tokenIndex, depth
+							position2538, tokenIndex2538, depth2538 := position, tokenIndex, depth
 							if buffer[position] != rune('\r') {
-								goto l1989
+								goto l2539
 							}
 							position++
-							goto l1988
-						l1989:
-							position, tokenIndex, depth = position1988, tokenIndex1988, depth1988
+							goto l2538
+						l2539:
+							position, tokenIndex, depth = position2538, tokenIndex2538, depth2538
 							if buffer[position] != rune('\n') {
-								goto l1987
+								goto l2537
 							}
 							position++
 						}
-					l1988:
-						goto l1986
-					l1987:
-						position, tokenIndex, depth = position1987, tokenIndex1987, depth1987
+					l2538:
+						goto l2536
+					l2537:
+						position, tokenIndex, depth = position2537, tokenIndex2537, depth2537
 					}
 					if !matchDot() {
-						goto l1986
+						goto l2536
 					}
-					goto l1985
-				l1986:
-					position, tokenIndex, depth = position1986, tokenIndex1986, depth1986
+					goto l2535
+				l2536:
+					position, tokenIndex, depth = position2536, tokenIndex2536, depth2536
 				}
 				{
-					position1990, tokenIndex1990, depth1990 := position, tokenIndex, depth
+					position2540, tokenIndex2540, depth2540 := position, tokenIndex, depth
 					if buffer[position] != rune('\r') {
-						goto l1991
+						goto l2541
 					}
 					position++
-					goto l1990
-				l1991:
-					position, tokenIndex, depth = position1990, tokenIndex1990, depth1990
+					goto l2540
+				l2541:
+					position, tokenIndex, depth = position2540, tokenIndex2540, depth2540
 					if buffer[position] != rune('\n') {
-						goto l1983
+						goto l2533
 					}
 					position++
 				}
-			l1990:
+			l2540:
 				depth--
-				add(rulecomment, position1984)
+				add(rulecomment, position2534)
 			}
 			return true
-		l1983:
-			position, tokenIndex, depth = position1983, tokenIndex1983, depth1983
+		l2533:
+			position, tokenIndex, depth = position2533, tokenIndex2533, depth2533
 			return false
 		},
-		/* 185 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 222 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
 		func() bool {
-			position1992, tokenIndex1992, depth1992 := position, tokenIndex, depth
+			position2542, tokenIndex2542, depth2542 := position, tokenIndex, depth
 			{
-				position1993 := position
+				position2543 := position
 				depth++
 				if buffer[position] != rune('-') {
-					goto l1992
+					goto l2542
 				}
 				position++
 				if buffer[position] != rune('-') {
-					goto l1992
+					goto l2542
 				}
 				position++
-			l1994:
+			l2544:
 				{
-					position1995, tokenIndex1995, depth1995 := position, tokenIndex, depth
+					position2545, tokenIndex2545, depth2545 := position, tokenIndex, depth
 					{
-						position1996, tokenIndex1996, depth1996 := position, tokenIndex, depth
+						position2546, tokenIndex2546, depth2546 := position, tokenIndex, depth
 						{
-							position1997, tokenIndex1997, depth1997 := position, tokenIndex, depth
+							position2547, tokenIndex2547, depth2547 := position, tokenIndex, depth
 							if buffer[position] != rune('\r') {
-								goto l1998
+								goto l2548
 							}
 							position++
-							goto l1997
-						l1998:
-							position, tokenIndex, depth = position1997, tokenIndex1997, depth1997
+							goto l2547
+						l2548:
+							position, tokenIndex, depth = position2547, tokenIndex2547, depth2547
 							if buffer[position] != rune('\n') {
-								goto l1996
+								goto l2546
 							}
 							position++
 						}
-					l1997:
-						goto l1995
-					l1996:
-						position, tokenIndex, depth = position1996, tokenIndex1996, depth1996
+					l2547:
+						goto l2545
+					l2546:
+						position, tokenIndex, depth = position2546, tokenIndex2546, depth2546
 					}
 					if !matchDot() {
-						goto l1995
+						goto l2545
 					}
-					goto l1994
-				l1995:
-					position, tokenIndex, depth = position1995, tokenIndex1995, depth1995
+					goto l2544
+				l2545:
+					position, tokenIndex, depth = position2545, tokenIndex2545, depth2545
 				}
 				{
-					position1999, tokenIndex1999, depth1999 := position, tokenIndex, depth
+					position2549, tokenIndex2549, depth2549 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l1999
+						goto l2549
 					}
-					goto l1992
-				l1999:
-					position, tokenIndex, depth = position1999, tokenIndex1999, depth1999
+					goto l2542
+				l2549:
+					position, tokenIndex, depth = position2549, tokenIndex2549, depth2549
 				}
 				depth--
-				add(rulefinalComment, position1993)
+				add(rulefinalComment, position2543)
 			}
 			return true
-		l1992:
-			position, tokenIndex, depth = position1992, tokenIndex1992, depth1992
+		l2542:
+			position, tokenIndex, depth = position2542, tokenIndex2542, depth2542
 			return false
 		},
 		nil,
-		/* 188 Action0 <- <{
+		/* 225 Action0 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -17607,7 +22134,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 189 Action1 <- <{
+		/* 226 Action1 <- <{
 		    p.IncludeTrailingWhitespace(begin, end)
 		}> */
 		func() bool {
@@ -17616,7 +22143,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 190 Action2 <- <{
+		/* 227 Action2 <- <{
 		    p.AssembleSelect()
 		}> */
 		func() bool {
@@ -17625,7 +22152,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 191 Action3 <- <{
+		/* 228 Action3 <- <{
 		    p.AssembleSelectUnion(begin, end)
 		}> */
 		func() bool {
@@ -17634,7 +22161,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 192 Action4 <- <{
+		/* 229 Action4 <- <{
 		    p.AssembleCreateStreamAsSelect()
 		}> */
 		func() bool {
@@ -17643,7 +22170,7 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 193 Action5 <- <{
+		/* 230 Action5 <- <{
 		    p.AssembleCreateStreamAsSelectUnion()
 		}> */
 		func() bool {
@@ -17652,8 +22179,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 194 Action6 <- <{
-		    p.AssembleCreateSource()
+		/* 231 Action6 <- <{
+		    p.AssembleParallelism(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17661,8 +22188,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 195 Action7 <- <{
-		    p.AssembleCreateSink()
+		/* 232 Action7 <- <{
+		    p.AssembleCreateSource()
 		}> */
 		func() bool {
 			{
@@ -17670,8 +22197,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 196 Action8 <- <{
-		    p.AssembleCreateState()
+		/* 233 Action8 <- <{
+		    p.AssembleCreateSink()
 		}> */
 		func() bool {
 			{
@@ -17679,8 +22206,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 197 Action9 <- <{
-		    p.AssembleUpdateState()
+		/* 234 Action9 <- <{
+		    p.AssembleCreateState()
 		}> */
 		func() bool {
 			{
@@ -17688,8 +22215,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 198 Action10 <- <{
-		    p.AssembleUpdateSource()
+		/* 235 Action10 <- <{
+		    p.AssembleUpdateState()
 		}> */
 		func() bool {
 			{
@@ -17697,8 +22224,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 199 Action11 <- <{
-		    p.AssembleUpdateSink()
+		/* 236 Action11 <- <{
+		    p.AssembleUpdateSource()
 		}> */
 		func() bool {
 			{
@@ -17706,8 +22233,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 200 Action12 <- <{
-		    p.AssembleInsertIntoFrom()
+		/* 237 Action12 <- <{
+		    p.AssembleUpdateSink()
 		}> */
 		func() bool {
 			{
@@ -17715,8 +22242,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 201 Action13 <- <{
-		    p.AssemblePauseSource()
+		/* 238 Action13 <- <{
+		    p.AssembleInsertIntoFrom()
 		}> */
 		func() bool {
 			{
@@ -17724,8 +22251,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 202 Action14 <- <{
-		    p.AssembleResumeSource()
+		/* 239 Action14 <- <{
+		    p.AssemblePauseSource()
 		}> */
 		func() bool {
 			{
@@ -17733,8 +22260,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 203 Action15 <- <{
-		    p.AssembleRewindSource()
+		/* 240 Action15 <- <{
+		    p.AssembleResumeSource()
 		}> */
 		func() bool {
 			{
@@ -17742,8 +22269,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 204 Action16 <- <{
-		    p.AssembleDropSource()
+		/* 241 Action16 <- <{
+		    p.AssembleRewindSource()
 		}> */
 		func() bool {
 			{
@@ -17751,8 +22278,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 205 Action17 <- <{
-		    p.AssembleDropStream()
+		/* 242 Action17 <- <{
+		    p.EnsureRewindPosition(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17760,8 +22287,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 206 Action18 <- <{
-		    p.AssembleDropSink()
+		/* 243 Action18 <- <{
+		    p.AssembleRewindToOffset(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17769,8 +22296,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 207 Action19 <- <{
-		    p.AssembleDropState()
+		/* 244 Action19 <- <{
+		    p.AssembleRewindToTimestamp(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17778,8 +22305,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 208 Action20 <- <{
-		    p.AssembleLoadState()
+		/* 245 Action20 <- <{
+		    p.AssembleDropSource()
 		}> */
 		func() bool {
 			{
@@ -17787,8 +22314,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 209 Action21 <- <{
-		    p.AssembleLoadStateOrCreate()
+		/* 246 Action21 <- <{
+		    p.AssembleDropStream()
 		}> */
 		func() bool {
 			{
@@ -17796,8 +22323,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 210 Action22 <- <{
-		    p.AssembleSaveState()
+		/* 247 Action22 <- <{
+		    p.AssembleDropSink()
 		}> */
 		func() bool {
 			{
@@ -17805,8 +22332,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 211 Action23 <- <{
-		    p.AssembleEval(begin, end)
+		/* 248 Action23 <- <{
+		    p.AssembleDropState()
 		}> */
 		func() bool {
 			{
@@ -17814,8 +22341,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 212 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 249 Action24 <- <{
+		    p.AssembleLoadState()
 		}> */
 		func() bool {
 			{
@@ -17823,8 +22350,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 213 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 250 Action25 <- <{
+		    p.AssembleLoadStateOrCreate()
 		}> */
 		func() bool {
 			{
@@ -17832,8 +22359,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 214 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 251 Action26 <- <{
+		    p.AssembleSaveState()
 		}> */
 		func() bool {
 			{
@@ -17841,8 +22368,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 215 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 252 Action27 <- <{
+		    p.AssembleEval(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17850,8 +22377,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 216 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 253 Action28 <- <{
+		    p.AssembleExplain()
 		}> */
 		func() bool {
 			{
@@ -17859,8 +22386,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 217 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 254 Action29 <- <{
+		    p.AssembleEmitter()
 		}> */
 		func() bool {
 			{
@@ -17868,8 +22395,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 218 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 255 Action30 <- <{
+		    p.AssembleEmitterOptions(begin, end)
 		}> */
 		func() bool {
 			{
@@ -17877,8 +22404,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 219 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 256 Action31 <- <{
+		    p.AssembleEmitterLimit()
 		}> */
 		func() bool {
 			{
@@ -17886,8 +22413,8 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 220 Action32 <- <{
-		    p.AssembleAlias()
+		/* 257 Action32 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
 		}> */
 		func() bool {
 			{
@@ -17895,937 +22422,1229 @@ func (p *bqlPegBackend) Init() {
 			}
 			return true
 		},
-		/* 221 Action33 <- <{
+		/* 258 Action33 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		}> */
+		func() bool {
+			{
+				add(ruleAction33, position)
+			}
+			return true
+		},
+		/* 259 Action34 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		}> */
+		func() bool {
+			{
+				add(ruleAction34, position)
+			}
+			return true
+		},
+		/* 260 Action35 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		}> */
+		func() bool {
+			{
+				add(ruleAction35, position)
+			}
+			return true
+		},
+		/* 261 Action36 <- <{
+		    p.AssembleProjections(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction36, position)
+			}
+			return true
+		},
+		/* 262 Action37 <- <{
+		    p.AssembleAlias()
+		}> */
+		func() bool {
+			{
+				add(ruleAction37, position)
+			}
+			return true
+		},
+		/* 263 Action38 <- <{
 		    // This is *always* executed, even if there is no
 		    // FROM clause present in the statement.
 		    p.AssembleWindowedFrom(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 222 Action34 <- <{
+		/* 264 Action39 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 223 Action35 <- <{
+		/* 265 Action40 <- <{
 		    p.AssembleInterval()
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction40, position)
+			}
+			return true
+		},
+		/* 266 Action41 <- <{
+		    p.AssembleJoin()
+		}> */
+		func() bool {
+			{
+				add(ruleAction41, position)
+			}
+			return true
+		},
+		/* 267 Action42 <- <{
+		    p.EnsureJoinType(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 224 Action36 <- <{
+		/* 268 Action43 <- <{
 		    // This is *always* executed, even if there is no
 		    // WHERE clause present in the statement.
 		    p.AssembleFilter(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 225 Action37 <- <{
+		/* 269 Action44 <- <{
 		    // This is *always* executed, even if there is no
 		    // GROUP BY clause present in the statement.
 		    p.AssembleGrouping(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
-		/* 226 Action38 <- <{
+		/* 270 Action45 <- <{
 		    // This is *always* executed, even if there is no
 		    // HAVING clause present in the statement.
 		    p.AssembleHaving(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction45, position)
+			}
+			return true
+		},
+		/* 271 Action46 <- <{
+		    // This is *always* executed, even if there is no
+		    // ORDER BY clause present in the statement.
+		    p.AssembleOrderBy(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction46, position)
+			}
+			return true
+		},
+		/* 272 Action47 <- <{
+		    // This is *always* executed, even if there is no
+		    // LIMIT clause present in the statement.
+		    p.AssembleLimit(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction47, position)
+			}
+			return true
+		},
+		/* 273 Action48 <- <{
+		    p.AssembleOffset(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction48, position)
 			}
 			return true
 		},
-		/* 227 Action39 <- <{
+		/* 274 Action49 <- <{
 		    p.EnsureAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction49, position)
 			}
 			return true
 		},
-		/* 228 Action40 <- <{
+		/* 275 Action50 <- <{
 		    p.AssembleAliasedStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction50, position)
 			}
 			return true
 		},
-		/* 229 Action41 <- <{
+		/* 276 Action51 <- <{
 		    p.AssembleStreamWindow()
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction51, position)
+			}
+			return true
+		},
+		/* 277 Action52 <- <{
+		    p.AssembleSubquery(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction52, position)
 			}
 			return true
 		},
-		/* 230 Action42 <- <{
+		/* 278 Action53 <- <{
 		    p.AssembleUDSFFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction53, position)
+			}
+			return true
+		},
+		/* 279 Action54 <- <{
+		    p.EnsureSlideSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction54, position)
+			}
+			return true
+		},
+		/* 280 Action55 <- <{
+		    p.EnsureLatenessSpec(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction55, position)
+			}
+			return true
+		},
+		/* 281 Action56 <- <{
+		    p.AssembleLateness()
+		}> */
+		func() bool {
+			{
+				add(ruleAction56, position)
+			}
+			return true
+		},
+		/* 282 Action57 <- <{
+		    p.EnsureLateTuplePolicy(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 231 Action43 <- <{
+		/* 283 Action58 <- <{
 		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 232 Action44 <- <{
+		/* 284 Action59 <- <{
 		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 233 Action45 <- <{
+		/* 285 Action60 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 234 Action46 <- <{
+		/* 286 Action61 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 235 Action47 <- <{
+		/* 287 Action62 <- <{
 		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 236 Action48 <- <{
+		/* 288 Action63 <- <{
 		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 237 Action49 <- <{
+		/* 289 Action64 <- <{
 		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 238 Action50 <- <{
+		/* 290 Action65 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 239 Action51 <- <{
+		/* 291 Action66 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 240 Action52 <- <{
+		/* 292 Action67 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 241 Action53 <- <{
+		/* 293 Action68 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 242 Action54 <- <{
+		/* 294 Action69 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 243 Action55 <- <{
+		/* 295 Action70 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 244 Action56 <- <{
+		/* 296 Action71 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 245 Action57 <- <{
+		/* 297 Action72 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 246 Action58 <- <{
+		/* 298 Action73 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction73, position)
 			}
 			return true
 		},
-		/* 247 Action59 <- <{
+		/* 299 Action74 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 248 Action60 <- <{
+		/* 300 Action75 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 249 Action61 <- <{
+		/* 301 Action76 <- <{
 		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 250 Action62 <- <{
+		/* 302 Action77 <- <{
 		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 251 Action63 <- <{
+		/* 303 Action78 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 252 Action64 <- <{
+		/* 304 Action79 <- <{
 		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 253 Action65 <- <{
+		/* 305 Action80 <- <{
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 254 Action66 <- <{
+		/* 306 Action81 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 255 Action67 <- <{
+		/* 307 Action82 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction82, position)
+			}
+			return true
+		},
+		/* 308 Action83 <- <{
+		    p.AssembleLambda()
+		}> */
+		func() bool {
+			{
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 256 Action68 <- <{
+		/* 309 Action84 <- <{
 		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 257 Action69 <- <{
+		/* 310 Action85 <- <{
 		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 258 Action70 <- <{
+		/* 311 Action86 <- <{
 		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction86, position)
 			}
 			return true
 		},
-		/* 259 Action71 <- <{
+		/* 312 Action87 <- <{
 		    p.AssembleExpressions(begin, end)
 		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 260 Action72 <- <{
+		/* 313 Action88 <- <{
 		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 261 Action73 <- <{
+		/* 314 Action89 <- <{
 		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 262 Action74 <- <{
+		/* 315 Action90 <- <{
 		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 263 Action75 <- <{
+		/* 316 Action91 <- <{
 		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 264 Action76 <- <{
+		/* 317 Action92 <- <{
 		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 265 Action77 <- <{
+		/* 318 Action93 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 266 Action78 <- <{
+		/* 319 Action94 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 267 Action79 <- <{
+		/* 320 Action95 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 268 Action80 <- <{
+		/* 321 Action96 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 269 Action81 <- <{
+		/* 322 Action97 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 270 Action82 <- <{
+		/* 323 Action98 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 271 Action83 <- <{
+		/* 324 Action99 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 272 Action84 <- <{
+		/* 325 Action100 <- <{
 		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 273 Action85 <- <{
+		/* 326 Action101 <- <{
 		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 274 Action86 <- <{
+		/* 327 Action102 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 275 Action87 <- <{
+		/* 328 Action103 <- <{
 		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 276 Action88 <- <{
+		/* 329 Action104 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 277 Action89 <- <{
+		/* 330 Action105 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 278 Action90 <- <{
+		/* 331 Action106 <- <{
 		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 279 Action91 <- <{
+		/* 332 Action107 <- <{
 		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 280 Action92 <- <{
+		/* 333 Action108 <- <{
 		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction108, position)
+			}
+			return true
+		},
+		/* 334 Action109 <- <{
+		    p.PushComponent(begin, end, RangeWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction109, position)
+			}
+			return true
+		},
+		/* 335 Action110 <- <{
+		    p.PushComponent(begin, end, TumblingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction110, position)
+			}
+			return true
+		},
+		/* 336 Action111 <- <{
+		    p.PushComponent(begin, end, HoppingWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction111, position)
+			}
+			return true
+		},
+		/* 337 Action112 <- <{
+		    p.PushComponent(begin, end, SessionWindow)
+		}> */
+		func() bool {
+			{
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 281 Action93 <- <{
+		/* 338 Action113 <- <{
 		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 282 Action94 <- <{
+		/* 339 Action114 <- <{
 		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 283 Action95 <- <{
+		/* 340 Action115 <- <{
 		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 284 Action96 <- <{
+		/* 341 Action116 <- <{
+		    p.PushComponent(begin, end, InnerJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction116, position)
+			}
+			return true
+		},
+		/* 342 Action117 <- <{
+		    p.PushComponent(begin, end, LeftJoin)
+		}> */
+		func() bool {
+			{
+				add(ruleAction117, position)
+			}
+			return true
+		},
+		/* 343 Action118 <- <{
 		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction118, position)
+			}
+			return true
+		},
+		/* 344 Action119 <- <{
+		    p.PushComponent(begin, end, DropLate)
+		}> */
+		func() bool {
+			{
+				add(ruleAction119, position)
+			}
+			return true
+		},
+		/* 345 Action120 <- <{
+		    p.PushComponent(begin, end, CorrectLate)
+		}> */
+		func() bool {
+			{
+				add(ruleAction120, position)
+			}
+			return true
+		},
+		/* 346 Action121 <- <{
+		    p.PushComponent(begin, end, ReportLate)
+		}> */
+		func() bool {
+			{
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 285 Action97 <- <{
+		/* 347 Action122 <- <{
 		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 286 Action98 <- <{
+		/* 348 Action123 <- <{
 		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 287 Action99 <- <{
+		/* 349 Action124 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 288 Action100 <- <{
+		/* 350 Action125 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 289 Action101 <- <{
+		/* 351 Action126 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 290 Action102 <- <{
+		/* 352 Action127 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 291 Action103 <- <{
+		/* 353 Action128 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 292 Action104 <- <{
+		/* 354 Action129 <- <{
 		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 293 Action105 <- <{
+		/* 355 Action130 <- <{
 		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 294 Action106 <- <{
+		/* 356 Action131 <- <{
 		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 295 Action107 <- <{
+		/* 357 Action132 <- <{
 		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 296 Action108 <- <{
+		/* 358 Action133 <- <{
 		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction133, position)
 			}
 			return true
 		},
-		/* 297 Action109 <- <{
+		/* 359 Action134 <- <{
 		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction134, position)
 			}
 			return true
 		},
-		/* 298 Action110 <- <{
+		/* 360 Action135 <- <{
 		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 299 Action111 <- <{
+		/* 361 Action136 <- <{
 		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction136, position)
 			}
 			return true
 		},
-		/* 300 Action112 <- <{
+		/* 362 Action137 <- <{
+		    p.PushComponent(begin, end, Interval)
+		}> */
+		func() bool {
+			{
+				add(ruleAction137, position)
+			}
+			return true
+		},
+		/* 363 Action138 <- <{
 		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 301 Action113 <- <{
+		/* 364 Action139 <- <{
 		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 302 Action114 <- <{
+		/* 365 Action140 <- <{
 		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction140, position)
 			}
 			return true
 		},
-		/* 303 Action115 <- <{
+		/* 366 Action141 <- <{
 		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 304 Action116 <- <{
+		/* 367 Action142 <- <{
 		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction142, position)
 			}
 			return true
 		},
-		/* 305 Action117 <- <{
+		/* 368 Action143 <- <{
 		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 306 Action118 <- <{
+		/* 369 Action144 <- <{
 		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 307 Action119 <- <{
+		/* 370 Action145 <- <{
 		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 308 Action120 <- <{
+		/* 371 Action146 <- <{
 		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 309 Action121 <- <{
+		/* 372 Action147 <- <{
 		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 310 Action122 <- <{
+		/* 373 Action148 <- <{
 		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction148, position)
+			}
+			return true
+		},
+		/* 374 Action149 <- <{
+		    p.PushComponent(begin, end, Like)
+		}> */
+		func() bool {
+			{
+				add(ruleAction149, position)
+			}
+			return true
+		},
+		/* 375 Action150 <- <{
+		    p.PushComponent(begin, end, NotLike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction150, position)
+			}
+			return true
+		},
+		/* 376 Action151 <- <{
+		    p.PushComponent(begin, end, ILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction151, position)
+			}
+			return true
+		},
+		/* 377 Action152 <- <{
+		    p.PushComponent(begin, end, NotILike)
+		}> */
+		func() bool {
+			{
+				add(ruleAction152, position)
+			}
+			return true
+		},
+		/* 378 Action153 <- <{
+		    p.PushComponent(begin, end, SimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction153, position)
+			}
+			return true
+		},
+		/* 379 Action154 <- <{
+		    p.PushComponent(begin, end, NotSimilarTo)
+		}> */
+		func() bool {
+			{
+				add(ruleAction154, position)
 			}
 			return true
 		},
-		/* 311 Action123 <- <{
+		/* 380 Action155 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction155, position)
 			}
 			return true
 		},
-		/* 312 Action124 <- <{
+		/* 381 Action156 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction156, position)
 			}
 			return true
 		},
-		/* 313 Action125 <- <{
+		/* 382 Action157 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction157, position)
 			}
 			return true
 		},
-		/* 314 Action126 <- <{
+		/* 383 Action158 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction158, position)
 			}
 			return true
 		},
-		/* 315 Action127 <- <{
+		/* 384 Action159 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 316 Action128 <- <{
+		/* 385 Action160 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 317 Action129 <- <{
+		/* 386 Action161 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 318 Action130 <- <{
+		/* 387 Action162 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 319 Action131 <- <{
+		/* 388 Action163 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 320 Action132 <- <{
+		/* 389 Action164 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction164, position)
 			}
 			return true
 		},
-		/* 321 Action133 <- <{
+		/* 390 Action165 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction165, position)
 			}
 			return true
 		},
@@ -41,20 +41,43 @@ func TestExpressionParser(t *testing.T) {
 				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+		// Lambda
+		"transform(a, x -> x * 2)": {[]Expression{FuncAppAST{FuncName("transform"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"},
+				LambdaAST{"x", BinaryOpAST{Multiply, LambdaParam{"x"}, NumericLiteral{2}}}}}, nil}}, "transform(a, x -> x * 2)"},
+		"filter(a,x->x.y[0] > b AND s:x)": {[]Expression{FuncAppAST{FuncName("filter"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"},
+				LambdaAST{"x", BinaryOpAST{And,
+					BinaryOpAST{Greater, LambdaParam{"x.y[0]"}, RowValue{"", "b"}},
+					RowValue{"s", "x"}}}}}, nil}}, "filter(a, x -> x.y[0] > b AND s:x)"},
+		"f(x -> f(x, xy, y -> x + y), y -> y)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{
+				LambdaAST{"x", FuncAppAST{FuncName("f"),
+					ExpressionsAST{[]Expression{LambdaParam{"x"}, RowValue{"", "xy"},
+						LambdaAST{"y", BinaryOpAST{Plus, LambdaParam{"x"}, LambdaParam{"y"}}}}}, nil}},
+				LambdaAST{"y", LambdaParam{"y"}}}}, nil}}, "f(x -> f(x, xy, y -> x + y), y -> y)"},
+		"f(x -> g(x -> x))": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{
+				LambdaAST{"x", FuncAppAST{FuncName("g"),
+					ExpressionsAST{[]Expression{LambdaAST{"x", LambdaParam{"x"}}}}, nil}}}}, nil}}, "f(x -> g(x -> x))"},
+		"x -> x":      {nil, ""}, // lambdas are only valid as function parameters
+		"f((x -> x))": {nil, ""},
 		// Type Cast
-		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
-		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
-		"CAST(a*2 AS FLOAT)":   {[]Expression{TypeCastAST{BinaryOpAST{Multiply, RowValue{"", "a"}, NumericLiteral{2}}, Float}}, "CAST(a * 2 AS FLOAT)"},
-		"CAST(2.1 AS STRING)":  {[]Expression{TypeCastAST{FloatLiteral{2.1}, String}}, "CAST(2.1 AS STRING)"},
-		`CAST("hoge" AS BLOB)`: {[]Expression{TypeCastAST{StringLiteral{"hoge"}, Blob}}, `CAST("hoge" AS BLOB)`},
-		"CAST(0 AS TIMESTAMP)": {[]Expression{TypeCastAST{NumericLiteral{0}, Timestamp}}, "CAST(0 AS TIMESTAMP)"},
-		"CAST(2.1 AS ARRAY)":   {[]Expression{TypeCastAST{FloatLiteral{2.1}, Array}}, "CAST(2.1 AS ARRAY)"},
-		`CAST("a" AS MAP)`:     {[]Expression{TypeCastAST{StringLiteral{"a"}, Map}}, `CAST("a" AS MAP)`},
-		"2.1::INT":             {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
-		"int::STRING":          {[]Expression{TypeCastAST{RowValue{"", "int"}, String}}, "int::STRING"},
-		"x:int::STRING":        {[]Expression{TypeCastAST{RowValue{"x", "int"}, String}}, "x:int::STRING"},
-		"ts()::STRING":         {[]Expression{TypeCastAST{RowMeta{"", TimestampMeta}, String}}, "ts()::STRING"},
-		"tab:ts()::STRING":     {[]Expression{TypeCastAST{RowMeta{"tab", TimestampMeta}, String}}, "tab:ts()::STRING"},
+		"CAST(2.1 AS BOOL)":         {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
+		"CAST(2.1 AS INT)":          {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
+		"CAST(a*2 AS FLOAT)":        {[]Expression{TypeCastAST{BinaryOpAST{Multiply, RowValue{"", "a"}, NumericLiteral{2}}, Float}}, "CAST(a * 2 AS FLOAT)"},
+		"CAST(2.1 AS STRING)":       {[]Expression{TypeCastAST{FloatLiteral{2.1}, String}}, "CAST(2.1 AS STRING)"},
+		`CAST("hoge" AS BLOB)`:      {[]Expression{TypeCastAST{StringLiteral{"hoge"}, Blob}}, `CAST("hoge" AS BLOB)`},
+		"CAST(0 AS TIMESTAMP)":      {[]Expression{TypeCastAST{NumericLiteral{0}, Timestamp}}, "CAST(0 AS TIMESTAMP)"},
+		"CAST(2.1 AS ARRAY)":        {[]Expression{TypeCastAST{FloatLiteral{2.1}, Array}}, "CAST(2.1 AS ARRAY)"},
+		`CAST("a" AS MAP)`:          {[]Expression{TypeCastAST{StringLiteral{"a"}, Map}}, `CAST("a" AS MAP)`},
+		`CAST("1 day" AS INTERVAL)`: {[]Expression{TypeCastAST{StringLiteral{"1 day"}, Interval}}, `CAST("1 day" AS INTERVAL)`},
+		"2.1::INT":                  {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
+		`"5 min"::interval`:         {[]Expression{TypeCastAST{StringLiteral{"5 min"}, Interval}}, `CAST("5 min" AS INTERVAL)`},
+		"int::STRING":               {[]Expression{TypeCastAST{RowValue{"", "int"}, String}}, "int::STRING"},
+		"x:int::STRING":             {[]Expression{TypeCastAST{RowValue{"x", "int"}, String}}, "x:int::STRING"},
+		"ts()::STRING":              {[]Expression{TypeCastAST{RowMeta{"", TimestampMeta}, String}}, "ts()::STRING"},
+		"tab:ts()::STRING":          {[]Expression{TypeCastAST{RowMeta{"tab", TimestampMeta}, String}}, "tab:ts()::STRING"},
 		// RowValue
 		"a":         {[]Expression{RowValue{"", "a"}}, "a"},
 		"-a":        {[]Expression{UnaryOpAST{UnaryMinus, RowValue{"", "a"}}}, "-a"},
@@ -116,10 +139,10 @@ func TestExpressionParser(t *testing.T) {
 		"CASE WHEN true THEN 3 END":          {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{true}, NumericLiteral{3}}}, nil}}, "CASE WHEN TRUE THEN 3 END"},
 		"CASE WHEN false THEN 3 ELSE 6 END":  {[]Expression{ConditionCaseAST{[]WhenThenPairAST{{BoolLiteral{false}, NumericLiteral{3}}}, NumericLiteral{6}}}, "CASE WHEN FALSE THEN 3 ELSE 6 END"},
 		// NumericLiteral
-		"2":    {[]Expression{NumericLiteral{2}}, "2"},
-		"-2":   {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
-		"- -2": {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
-		"999999999999999999999999999": {nil, ""}, // int64 overflow
+		"2":                           {[]Expression{NumericLiteral{2}}, "2"},
+		"-2":                          {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "-2"},
+		"- -2":                        {[]Expression{UnaryOpAST{UnaryMinus, NumericLiteral{-2}}}, "- -2"}, // like PostgreSQL
+		"999999999999999999999999999": {nil, ""},                                                          // int64 overflow
 		// FloatLiteral
 		"1.2":   {[]Expression{FloatLiteral{1.2}}, "1.2"},
 		"-3.14": {[]Expression{UnaryOpAST{UnaryMinus, FloatLiteral{3.14}}}, "-3.14"},
@@ -148,6 +171,15 @@ func TestExpressionParser(t *testing.T) {
 		"a >= 2": {[]Expression{BinaryOpAST{GreaterOrEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a >= 2"},
 		"a != 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
 		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
+		// Pattern matching
+		`a LIKE "x%"`:         {[]Expression{BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a LIKE "x%"`},
+		`a NOT LIKE "x%"`:     {[]Expression{BinaryOpAST{NotLike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT LIKE "x%"`},
+		`a ilike "x%"`:        {[]Expression{BinaryOpAST{ILike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a ILIKE "x%"`},
+		`a NOT  ILIKE "x%"`:   {[]Expression{BinaryOpAST{NotILike, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a NOT ILIKE "x%"`},
+		`a SIMILAR TO "x"`:    {[]Expression{BinaryOpAST{SimilarTo, RowValue{"", "a"}, StringLiteral{"x"}}}, `a SIMILAR TO "x"`},
+		`a NOT SIMILAR TO b`:  {[]Expression{BinaryOpAST{NotSimilarTo, RowValue{"", "a"}, RowValue{"", "b"}}}, `a NOT SIMILAR TO b`},
+		`a LIKE "x" LIKE "y"`: {nil, ""},
+		`a LIKE`:              {nil, ""},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
 		// IS Expressions
@@ -199,6 +231,11 @@ func TestExpressionParser(t *testing.T) {
 		"2 || a = 4": {[]Expression{BinaryOpAST{Equal,
 			BinaryOpAST{Concat, NumericLiteral{2}, RowValue{"", "a"}},
 			NumericLiteral{4}}}, "2 || a = 4"},
+		`a || "b" LIKE c AND d`: {[]Expression{BinaryOpAST{And,
+			BinaryOpAST{Like, BinaryOpAST{Concat, RowValue{"", "a"}, StringLiteral{"b"}}, RowValue{"", "c"}},
+			RowValue{"", "d"}}}, `a || "b" LIKE c AND d`},
+		`NOT a NOT LIKE b`: {[]Expression{UnaryOpAST{Not,
+			BinaryOpAST{NotLike, RowValue{"", "a"}, RowValue{"", "b"}}}}, `NOT (a NOT LIKE b)`},
 		"-2.1::INT": {[]Expression{UnaryOpAST{UnaryMinus,
 			TypeCastAST{FloatLiteral{2.1}, Int}}}, "-CAST(2.1 AS INT)"},
 		/// Left-Associativity
@@ -68,19 +68,24 @@ func (ps *parseStack) Peek() (value *ParsedComponent) {
 // a single SelectStmt element.
 //
 //  EmitterAST
+//  LimitAST
+//  OrderByAST
 //  HavingAST
 //  GroupingAST
 //  FilterAST
 //  WindowedFromAST
 //  ProjectionsAST
 //   =>
-//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST}
+//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST,
+//    OrderByAST, LimitAST}
 func (ps *parseStack) AssembleSelect() {
 	// pop the components from the stack in reverse order
-	_having, _grouping, _filter, _from, _projections, _emitter := ps.pop6()
+	_limit, _orderBy, _having, _grouping, _filter, _from, _projections, _emitter := ps.pop8()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	limit := _limit.comp.(LimitAST)
+	orderBy := _orderBy.comp.(OrderByAST)
 	having := _having.comp.(HavingAST)
 	grouping := _grouping.comp.(GroupingAST)
 	filter := _filter.comp.(FilterAST)
@@ -89,8 +94,9 @@ func (ps *parseStack) AssembleSelect() {
 	emitter := _emitter.comp.(EmitterAST)
 
 	// assemble the SelectStmt and push it back
-	s := SelectStmt{emitter, projections, from, filter, grouping, having}
-	se := ParsedComponent{_emitter.begin, _having.end, s}
+	s := SelectStmt{emitter, projections, from, filter, grouping, having,
+		orderBy, limit}
+	se := ParsedComponent{_emitter.begin, _limit.end, s}
 	ps.Push(&se)
 }
 
@@ -118,24 +124,48 @@ func (ps *parseStack) AssembleSelectUnion(begin int, end int) {
 // replaces them by a single CreateStreamAsSelectStmt element.
 //
 //  SelectStmt
+//  ParallelismAST
 //  StreamIdentifier
 //   =>
-//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt}
+//  CreateStreamAsSelectStmt{StreamIdentifier, ParallelismAST, SelectStmt}
 func (ps *parseStack) AssembleCreateStreamAsSelect() {
 	// now pop the components from the stack in reverse order
-	_select, _name := ps.pop2()
+	_select, _parallelism, _name := ps.pop3()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	s := _select.comp.(SelectStmt)
+	parallelism := _parallelism.comp.(ParallelismAST)
 	name := _name.comp.(StreamIdentifier)
 
 	// assemble the SelectStmt and push it back
-	css := CreateStreamAsSelectStmt{name, s}
+	css := CreateStreamAsSelectStmt{name, parallelism, s}
 	se := ParsedComponent{_name.begin, _select.end, css}
 	ps.Push(&se)
 }
 
+// AssembleParallelism takes the NumericLiteral on top of the stack
+// (if there is a WITH PARALLELISM clause) and wraps a ParallelismAST
+// struct around it. If there is no such clause, an empty
+// ParallelismAST struct is used.
+//
+//  NumericLiteral
+//   =>
+//  ParallelismAST{NumericLiteral}
+func (ps *parseStack) AssembleParallelism(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, ParallelismAST{})
+	} else {
+		// if the stack is empty at this point, this is
+		// a serious parser bug
+		p := ps.Pop()
+		if begin > p.begin || end < p.end {
+			panic("the item on top of the stack is not within given range")
+		}
+		ps.PushComponent(begin, end, ParallelismAST{p.comp.(NumericLiteral).Value})
+	}
+}
+
 // AssembleCreateStreamAsSelectUnion takes the topmost elements from the
 // stack, assuming they are components of a CREATE STREAM statement, and
 // replaces them by a single CreateStreamAsSelectUnionStmt element.
@@ -343,19 +373,66 @@ func (ps *parseStack) AssembleResumeSource() {
 // assuming they are components of a REWIND SOURCE statement, and
 // replaces them by a single RewindSourceStmt element.
 //
+//  RewindPositionAST
 //  StreamIdentifier
 //   =>
-//  RewindSourceStmt{StreamIdentifier}
+//  RewindSourceStmt{StreamIdentifier, RewindPositionAST}
 func (ps *parseStack) AssembleRewindSource() {
 	// pop the components from the stack in reverse order
-	_name := ps.Pop()
+	_pos, _name := ps.pop2()
 
+	pos := _pos.comp.(RewindPositionAST)
 	name := _name.comp.(StreamIdentifier)
 
-	se := ParsedComponent{_name.begin, _name.end, RewindSourceStmt{name}}
+	se := ParsedComponent{_name.begin, _pos.end, RewindSourceStmt{name, pos}}
 	ps.Push(&se)
 }
 
+// AssembleRewindToOffset takes the NumericLiteral on top of the stack
+// and replaces it by a RewindPositionAST pointing to the offset.
+//
+//  NumericLiteral
+//   =>
+//  RewindPositionAST{OffsetRewindPosition, int64, ""}
+func (ps *parseStack) AssembleRewindToOffset(begin int, end int) {
+	_offset := ps.Pop()
+	offset := _offset.comp.(NumericLiteral)
+
+	ps.PushComponent(begin, end, RewindPositionAST{OffsetRewindPosition, offset.Value, ""})
+}
+
+// AssembleRewindToTimestamp takes the StringLiteral on top of the stack
+// and replaces it by a RewindPositionAST pointing to the timestamp.
+//
+//  StringLiteral
+//   =>
+//  RewindPositionAST{TimestampRewindPosition, 0, string}
+func (ps *parseStack) AssembleRewindToTimestamp(begin int, end int) {
+	_ts := ps.Pop()
+	ts := _ts.comp.(StringLiteral)
+
+	ps.PushComponent(begin, end, RewindPositionAST{TimestampRewindPosition, 0, ts.Value})
+}
+
+// EnsureRewindPosition makes sure that the top element of the stack
+// is a RewindPositionAST element. If there is no TO clause in a REWIND
+// SOURCE statement, a RewindPositionAST with an unspecified type is
+// pushed.
+func (ps *parseStack) EnsureRewindPosition(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, RewindPositionAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(RewindPositionAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
 // AssembleDropSource takes the topmost elements from the stack,
 // assuming they are components of a DROP SOURCE statement, and
 // replaces them by a single DropSourceStmt element.
@@ -529,6 +606,20 @@ func (ps *parseStack) AssembleEval(begin, end int) {
 	ps.Push(&se)
 }
 
+// AssembleExplain takes the topmost element from the stack, assuming
+// it is a statement to be explained, and replaces it by a single
+// ExplainStmt element.
+//
+//  SelectStmt
+//   =>
+//  ExplainStmt{SelectStmt}
+func (ps *parseStack) AssembleExplain() {
+	_stmt := ps.Pop()
+
+	se := ParsedComponent{_stmt.begin, _stmt.end, ExplainStmt{_stmt.comp}}
+	ps.Push(&se)
+}
+
 /* Projections/Columns */
 
 // AssembleEmitter takes the topmost elements from the stack, assuming
@@ -646,28 +737,83 @@ func (ps *parseStack) AssembleAlias() {
 
 // AssembleWindowedFrom takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure they are all
-// AliasedStreamWindowAST elements and wraps a WindowedFromAST struct
-// around them. If there are no such elements, adds an
+// AliasedStreamWindowAST (or JoinAST) elements and wraps a WindowedFromAST
+// struct around them. If there are no such elements, adds an
 // empty WindowedFromAST struct to the stack.
 //
 //  AliasedStreamWindowAST
 //  AliasedStreamWindowAST
 //   =>
-//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST]}
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], []}
+// or
+//  JoinAST
+//  AliasedStreamWindowAST
+//  AliasedStreamWindowAST
+//   =>
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST], [JoinAST]}
 func (ps *parseStack) AssembleWindowedFrom(begin int, end int) {
 	if begin == end {
 		// push an empty FROM clause
 		ps.PushComponent(begin, end, WindowedFromAST{})
 	} else {
 		elems := ps.collectElements(begin, end)
-		rels := make([]AliasedStreamWindowAST, len(elems), len(elems))
-		for i, elem := range elems {
+		rels := make([]AliasedStreamWindowAST, 0, len(elems))
+		var joins []JoinAST
+		for _, elem := range elems {
+			if j, ok := elem.(JoinAST); ok {
+				joins = append(joins, j)
+				continue
+			}
 			// (if this conversion fails, this is a fundamental parser bug)
 			e := elem.(AliasedStreamWindowAST)
-			rels[i] = e
+			rels = append(rels, e)
 		}
 		// push the grouped list back
-		ps.PushComponent(begin, end, WindowedFromAST{rels})
+		ps.PushComponent(begin, end, WindowedFromAST{rels, joins})
+	}
+}
+
+// AssembleJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN clause, and replaces them by the
+// joined relation and a JoinAST element describing the join.
+//
+//  Expression
+//  AliasedStreamWindowAST
+//  JoinType
+//   =>
+//  JoinAST{JoinType, Expression}
+//  AliasedStreamWindowAST
+func (ps *parseStack) AssembleJoin() {
+	// pop the components from the stack in reverse order
+	_on, _rel, _type := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	on := _on.comp.(Expression)
+	rel := _rel.comp.(AliasedStreamWindowAST)
+	joinType := _type.comp.(JoinType)
+
+	// push back the relation and then the JoinAST (the stack does
+	// not allow overlapping items, so the JoinAST covers only the
+	// part after the relation)
+	ps.PushComponent(_rel.begin, _rel.end, rel)
+	ps.PushComponent(_rel.end, _on.end, JoinAST{joinType, on})
+}
+
+// EnsureJoinType makes sure that the top element of the stack
+// is a JoinType element.
+func (ps *parseStack) EnsureJoinType(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, InnerJoin)
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(JoinType)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
 	}
 }
 
@@ -778,6 +924,72 @@ func (ps *parseStack) AssembleHaving(begin int, end int) {
 	}
 }
 
+// AssembleOrderBy takes the elements from the stack that
+// correspond to the input[begin:end] string (if there is an
+// ORDER BY clause) and wraps an OrderByAST struct around them.
+// If there is no ORDER BY clause, an empty OrderByAST struct
+// is used.
+//
+//  SortedExpressionAST
+//  SortedExpressionAST
+//   =>
+//  OrderByAST{[SortedExpressionAST, SortedExpressionAST]}
+func (ps *parseStack) AssembleOrderBy(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var ordering []SortedExpressionAST
+	if len(elems) > 0 {
+		ordering = make([]SortedExpressionAST, len(elems))
+		for i := range elems {
+			ordering[i] = elems[i].(SortedExpressionAST)
+		}
+	}
+	ps.PushComponent(begin, end, OrderByAST{ordering})
+}
+
+// AssembleLimit takes the NumericLiteral on top of the stack
+// (if there is a LIMIT clause) and wraps a LimitAST struct
+// around it. If there is no LIMIT clause, an empty LimitAST
+// struct is used.
+//
+//  NumericLiteral
+//   =>
+//  LimitAST{true, NumericLiteral, 0}
+func (ps *parseStack) AssembleLimit(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, LimitAST{})
+	} else {
+		// if the stack is empty at this point, this is
+		// a serious parser bug
+		l := ps.Pop()
+		if begin > l.begin || end < l.end {
+			panic("the item on top of the stack is not within given range")
+		}
+		ps.PushComponent(begin, end, LimitAST{true, l.comp.(NumericLiteral).Value, 0})
+	}
+}
+
+// AssembleOffset takes the NumericLiteral on top of the stack
+// (if there is an OFFSET clause) and stores it in the LimitAST
+// struct below it. If there is no OFFSET clause, the stack is
+// left unchanged.
+//
+//  NumericLiteral
+//  LimitAST{HasLimit, Limit, 0}
+//   =>
+//  LimitAST{HasLimit, Limit, NumericLiteral}
+func (ps *parseStack) AssembleOffset(begin int, end int) {
+	if begin == end {
+		return
+	}
+	_offset, _limit := ps.pop2()
+	if begin > _offset.begin || end < _offset.end {
+		panic("the item on top of the stack is not within given range")
+	}
+	limit := _limit.comp.(LimitAST)
+	limit.Offset = _offset.comp.(NumericLiteral).Value
+	ps.PushComponent(_limit.begin, end, limit)
+}
+
 // AssembleAliasedStreamWindow takes the topmost elements from the stack, assuming
 // they are components of an AS clause, and replaces them by
 // a single AliasedStreamWindowAST element.
@@ -819,21 +1031,47 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 // they are components of an AS clause, and replaces them by
 // a single StreamWindowAST element.
 //
-//  IntervalAST
+//  SheddingOption
+//  NumericLiteral
+//  LatenessAST
+//  IntervalAST (slide)
+//  IntervalAST (range)
+//  WindowType
 //  Stream
 //   =>
-//  StreamWindowAST{Stream, IntervalAST}
+//  StreamWindowAST{Stream, WindowType, IntervalAST, IntervalAST, LatenessAST, int64, SheddingOption}
 func (ps *parseStack) AssembleStreamWindow() {
 	// pop the components from the stack in reverse order
-	_shedding, _capacity, _range, _rel := ps.pop4()
+	_shedding, _capacity, _lateness, _slide, _range, _window, _rel := ps.pop7()
 
 	rel := _rel.comp.(Stream)
+	window := _window.comp.(WindowType)
 	rangeAst := _range.comp.(IntervalAST)
+	slide := _slide.comp.(IntervalAST)
+	lateness := _lateness.comp.(LatenessAST)
 	capacity := _capacity.comp.(NumericLiteral)
 	shedding := _shedding.comp.(SheddingOption)
 
-	ps.PushComponent(_rel.begin, _shedding.end, StreamWindowAST{rel, rangeAst,
-		capacity.Value, shedding})
+	ps.PushComponent(_rel.begin, _shedding.end, StreamWindowAST{rel, window,
+		rangeAst, slide, lateness, capacity.Value, shedding})
+}
+
+// AssembleLateness takes the topmost elements from the stack, assuming
+// they are components of a LATENESS clause, and replaces them by
+// a single LatenessAST element.
+//
+//  LateTuplePolicy
+//  IntervalAST
+//   =>
+//  LatenessAST{IntervalAST, LateTuplePolicy}
+func (ps *parseStack) AssembleLateness() {
+	// pop the components from the stack in reverse order
+	_policy, _interval := ps.pop2()
+
+	interval := _interval.comp.(IntervalAST)
+	policy := _policy.comp.(LateTuplePolicy)
+
+	ps.PushComponent(_interval.begin, _policy.end, LatenessAST{interval, policy})
 }
 
 // AssembleUDSFFuncApp takes the topmost elements from the stack,
@@ -842,17 +1080,32 @@ func (ps *parseStack) AssembleStreamWindow() {
 //
 //  FuncAppAST{Function, ExpressionsAST}
 //   =>
-//  Stream{UDSFStream, Function, ExpressionAST.Expressions}
+//  Stream{UDSFStream, Function, ExpressionAST.Expressions, nil}
 func (ps *parseStack) AssembleUDSFFuncApp() {
 	_fun := ps.Pop()
 
 	fun := _fun.comp.(FuncAppAST)
 
 	se := ParsedComponent{_fun.begin, _fun.end,
-		Stream{UDSFStream, string(fun.Function), fun.Expressions}}
+		Stream{UDSFStream, string(fun.Function), fun.Expressions, nil}}
 	ps.Push(&se)
 }
 
+// AssembleSubquery takes the SelectStmt on top of the stack,
+// assuming it is a subquery in a FROM clause, and replaces it by
+// a single Stream element.
+//
+//  SelectStmt
+//   =>
+//  Stream{SubqueryStream, "", nil, &SelectStmt}
+func (ps *parseStack) AssembleSubquery(begin int, end int) {
+	_select := ps.Pop()
+
+	s := _select.comp.(SelectStmt)
+
+	ps.PushComponent(begin, end, Stream{SubqueryStream, "", nil, &s})
+}
+
 // EnsureCapacitySpec makes sure that the top element of the stack
 // is a NumericLiteral element.
 func (ps *parseStack) EnsureCapacitySpec(begin int, end int) {
@@ -870,6 +1123,60 @@ func (ps *parseStack) EnsureCapacitySpec(begin int, end int) {
 	}
 }
 
+// EnsureSlideSpec makes sure that the top element of the stack
+// is an IntervalAST element describing the SLIDE of a window. If
+// there is no SLIDE specified, an IntervalAST with unspecified unit
+// is pushed.
+func (ps *parseStack) EnsureSlideSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, IntervalAST{FloatLiteral{0}, UnspecifiedIntervalUnit})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(IntervalAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// EnsureLatenessSpec makes sure that the top element of the stack
+// is a LatenessAST element. If there is no LATENESS specified, a
+// LatenessAST with unspecified unit is pushed.
+func (ps *parseStack) EnsureLatenessSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, LatenessAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(LatenessAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// EnsureLateTuplePolicy makes sure that the top element of the stack
+// is a LateTuplePolicy element.
+func (ps *parseStack) EnsureLateTuplePolicy(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, UnspecifiedLateTuplePolicy)
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(LateTuplePolicy)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
 // EnsureSheddingSpec makes sure that the top element of the stack
 // is a SheddingOption element.
 func (ps *parseStack) EnsureSheddingSpec(begin int, end int) {
@@ -1149,6 +1456,24 @@ func (ps *parseStack) AssembleSortedExpression() {
 	ps.PushComponent(_expr.begin, _sortOrder.end, SortedExpressionAST{expr, sortOrder})
 }
 
+// AssembleLambda takes the topmost elements from the stack, assuming
+// they are components of a lambda expression, and replaces them by
+// a single LambdaAST element. References to the parameter in the body
+// are replaced by LambdaParam elements.
+//
+//  Expression
+//  Identifier
+//   =>
+//  LambdaAST{Identifier, Expression}
+func (ps *parseStack) AssembleLambda() {
+	_body, _param := ps.pop2()
+
+	body := _body.comp.(Expression)
+	param := string(_param.comp.(Identifier))
+
+	ps.PushComponent(_param.begin, _body.end, LambdaAST{param, bindLambdaParam(body, param)})
+}
+
 // AssembleArray takes the topmost elements from the stack, assuming
 // they are components of an array expression, and replaces them
 // by a single ArrayAST element.
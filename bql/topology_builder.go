@@ -1,6 +1,7 @@
 package bql
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
@@ -8,9 +9,11 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
 	"math"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
 type TopologyBuilder struct {
@@ -21,19 +24,43 @@ type TopologyBuilder struct {
 	SourceCreators SourceCreatorRegistry
 	SinkCreators   SinkCreatorRegistry
 	UDSStorage     udf.UDSStorage
-}
 
-// TODO: Provide AtomicTopologyBuilder which support building multiple nodes
-// in an atomic manner (kind of transactionally)
+	// recovering is true between StartRecovery and FinishRecovery.
+	recovering bool
+	// recovery is the checkpoint the topology is being recovered from.
+	// It's nil when there's no checkpoint to be restored.
+	recovery *Checkpoint
+	// sourcesToResume has the names of the sources which were created
+	// as paused only because the topology was being recovered.
+	sourcesToResume []string
+
+	// checkpointer takes checkpoints of the topology. It's nil when
+	// checkpointing isn't enabled.
+	checkpointer *Checkpointer
+
+	// stmtMutex serializes AddStmt and AddStmtsAtomically so that a statement
+	// issued concurrently isn't processed as a part of a transaction.
+	stmtMutex sync.Mutex
+
+	// tx is the transaction of AddStmtsAtomically in progress. It's nil when
+	// statements aren't being added atomically. It's protected by stmtMutex.
+	tx *stmtTransaction
+
+	// timeMutex protects createdAt and updatedAt.
+	timeMutex sync.RWMutex
+	createdAt time.Time
+	updatedAt time.Time
+}
 
 // NewTopologyBuilder creates a new TopologyBuilder which dynamically creates
 // nodes from BQL statements. The target Topology can be shared by
 // multiple TopologyBuilders.
 //
-// TopologyBuilder doesn't support atomic topology building. For example,
-// when a user wants to add three statement and the second statement fails,
-// only the node created from the first statement is registered to the topology
-// and it starts to generate tuples. Others won't be registered.
+// AddStmt doesn't support atomic topology building. For example, when a user
+// wants to add three statement and the second statement fails, only the node
+// created from the first statement is registered to the topology and it
+// starts to generate tuples. Others won't be registered. Use
+// AddStmtsAtomically to add statements atomically.
 func NewTopologyBuilder(t core.Topology) (*TopologyBuilder, error) {
 	udsfs, err := udf.CopyGlobalUDSFCreatorRegistry()
 	if err != nil {
@@ -72,6 +99,8 @@ func NewTopologyBuilder(t core.Topology) (*TopologyBuilder, error) {
 		SinkCreators:   sinks,
 		UDSStorage:     udf.NewInMemoryUDSStorage(),
 	}
+	tb.createdAt = time.Now()
+	tb.updatedAt = tb.createdAt
 	return tb, nil
 }
 
@@ -91,9 +120,41 @@ func (tb *TopologyBuilder) Topology() core.Topology {
 	return tb.topology
 }
 
+// CreatedAt returns the time when the TopologyBuilder was created.
+func (tb *TopologyBuilder) CreatedAt() time.Time {
+	tb.timeMutex.RLock()
+	defer tb.timeMutex.RUnlock()
+	return tb.createdAt
+}
+
+// UpdatedAt returns the time when the topology was last updated through the
+// TopologyBuilder.
+func (tb *TopologyBuilder) UpdatedAt() time.Time {
+	tb.timeMutex.RLock()
+	defer tb.timeMutex.RUnlock()
+	return tb.updatedAt
+}
+
+// Touch sets the time when the topology was last updated to the current
+// time. It's called when AddStmt succeeds. Other components changing the
+// topology without AddStmt can call it.
+func (tb *TopologyBuilder) Touch() {
+	tb.timeMutex.Lock()
+	defer tb.timeMutex.Unlock()
+	tb.updatedAt = time.Now()
+}
+
 // AddStmt add a node created from a statement to the topology. It returns
 // a created node. It returns a nil node when the statement is CREATE STATE.
 func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
+	n, err := tb.addStmt(stmt)
+	if err == nil {
+		tb.Touch()
+	}
+	return n, err
+}
+
+func (tb *TopologyBuilder) addStmt(stmt interface{}) (core.Node, error) {
 	// TODO: Enable StopOnDisconnect properly
 
 	// check the type of statement
@@ -116,9 +177,28 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		return tb.topology.AddSource(string(stmt.Name), source, &core.SourceConfig{
-			PausedOnStartup: stmt.Paused == parser.Yes,
+		if err := tb.restoreSource(string(stmt.Name), source); err != nil {
+			if err := source.Stop(tb.topology.Context()); err != nil {
+				tb.topology.Context().ErrLog(err).WithField("node_name", stmt.Name).
+					Error("Cannot stop the source which couldn't be restored")
+			}
+			return nil, err
+		}
+		paused := stmt.Paused == parser.Yes
+		sn, err := tb.topology.AddSource(string(stmt.Name), source, &core.SourceConfig{
+			PausedOnStartup: paused || tb.recovering || tb.tx != nil,
 		})
+		if err != nil {
+			return nil, err
+		}
+		if !paused {
+			if tb.recovering {
+				tb.sourcesToResume = append(tb.sourcesToResume, sn.Name())
+			} else if tb.tx != nil {
+				tb.tx.sourcesToResume = append(tb.tx.sourcesToResume, sn.Name())
+			}
+		}
+		return sn, nil
 
 	case parser.CreateStreamAsSelectStmt:
 		return tb.createStreamAsSelectStmt(&stmt)
@@ -138,9 +218,10 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
+				parser.ParallelismAST{},
 				selStmt,
 			}
-			box, err := tb.AddStmt(tmpStmt)
+			box, err := tb.addStmt(tmpStmt)
 			if err != nil {
 				removeTmpNodes()
 				return nil, err
@@ -165,7 +246,7 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			}
 		}
 		for _, node := range nodes {
-			node.StopOnDisconnect(core.Inbound | core.Outbound)
+			tb.stopOnDisconnect(node, core.Inbound|core.Outbound)
 			node.RemoveOnStop()
 		}
 		node.StopOnDisconnect(core.Inbound)
@@ -238,7 +319,7 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			c.Type = stmt.Type
 			c.Name = stmt.Name
 			c.Params = stmt.CreateSpecs.Params
-			return tb.AddStmt(c)
+			return tb.addStmt(c)
 		}
 		return nil, err
 
@@ -301,22 +382,28 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		return nil, err
 
 	case parser.InsertIntoFromStmt:
-		// get the sink to add an input to
-		sink, err := tb.topology.Sink(string(stmt.Sink))
-		if err != nil {
-			return nil, err
-		}
-		// now connect the sink to the specified box
-		if err := sink.Input(string(stmt.Input), nil); err != nil {
-			return nil, err
+		if tb.tx != nil {
+			sink, err := tb.topology.Sink(string(stmt.Sink))
+			if err != nil {
+				return nil, err
+			}
+			// the sink will be connected when the transaction is committed
+			if _, err := tb.topology.Node(string(stmt.Input)); err != nil {
+				return nil, err
+			}
+			tb.tx.inputs = append(tb.tx.inputs, stmt)
+			return sink, nil
 		}
-		return sink, nil
+		return tb.connectSink(stmt)
 
 	case parser.PauseSourceStmt:
 		src, err := tb.topology.Source(string(stmt.Source))
 		if err != nil {
 			return nil, err
 		}
+		if tb.tx != nil {
+			tb.tx.pause(src)
+		}
 		if err := src.Pause(); err != nil {
 			return nil, err
 		}
@@ -327,6 +414,16 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
+		if tb.recovering {
+			// the source will be resumed by FinishRecovery
+			tb.sourcesToResume = append(tb.sourcesToResume, src.Name())
+			return src, nil
+		}
+		if tb.tx != nil {
+			// the source will be resumed when the transaction is committed
+			tb.tx.sourcesToResume = append(tb.tx.sourcesToResume, src.Name())
+			return src, nil
+		}
 		if err := src.Resume(); err != nil {
 			return nil, err
 		}
@@ -337,8 +434,23 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		if err := src.Rewind(); err != nil {
-			return nil, err
+		switch stmt.Type {
+		case parser.OffsetRewindPosition:
+			if err := src.Seek(core.SeekPosition{Offset: stmt.Offset}); err != nil {
+				return nil, err
+			}
+		case parser.TimestampRewindPosition:
+			ts, err := data.ToTimestamp(data.String(stmt.Timestamp))
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp in REWIND SOURCE: %v", err)
+			}
+			if err := src.Seek(core.SeekPosition{Timestamp: ts}); err != nil {
+				return nil, err
+			}
+		default:
+			if err := src.Rewind(); err != nil {
+				return nil, err
+			}
 		}
 		return src, nil
 	}
@@ -346,6 +458,21 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 	return nil, fmt.Errorf("statement of type %T is unimplemented", stmt)
 }
 
+// connectSink adds the input specified by the INSERT INTO statement to the
+// sink.
+func (tb *TopologyBuilder) connectSink(stmt parser.InsertIntoFromStmt) (core.Node, error) {
+	// get the sink to add an input to
+	sink, err := tb.topology.Sink(string(stmt.Sink))
+	if err != nil {
+		return nil, err
+	}
+	// now connect the sink to the specified box
+	if err := sink.Input(string(stmt.Input), nil); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
 // udsfBox is a core.Box which runs a UDSF in the stream mode.
 type udsfBox struct {
 	f udf.UDSF
@@ -407,19 +534,35 @@ func (s *udsfSource) Stop(ctx *core.Context) error {
 }
 
 func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt) (core.Node, error) {
+	// subqueries in the FROM clause are computed by separate boxes
+	// that the SELECT statement then reads from
+	selectStmt := stmt.Select
+	subqueries, err := tb.materializeSubqueries(&selectStmt)
+	if err != nil {
+		return nil, err
+	}
+
 	// insert a bqlBox that executes the SELECT statement
 	outName := string(stmt.Name)
-	box := NewBQLBox(&stmt.Select, tb.Reg)
-	// add all the referenced relations as named inputs
-	dbox, err := tb.topology.AddBox(outName, box, nil)
+	var dbox core.BoxNode
+	box, config, err := tb.newBQLBox(stmt.ParallelismAST, &selectStmt)
+	if err == nil {
+		// add all the referenced relations as named inputs
+		dbox, err = tb.topology.AddBox(outName, box, config)
+	}
 	if err != nil {
+		for _, n := range subqueries {
+			tb.topology.Remove(n)
+		}
 		return nil, err
 	}
-	// provide a function to the BQL box to remove itself from the topology
-	box.removeMe = func() { go tb.topology.Remove(outName) }
+	if b, ok := box.(*bqlBox); ok {
+		// provide a function to the BQL box to remove itself from the topology
+		b.removeMe = func() { go tb.topology.Remove(outName) }
+	}
 
 	removeNodes := true
-	var temporaryNodes []string
+	temporaryNodes := subqueries
 	defer func() {
 		if !removeNodes {
 			return
@@ -433,7 +576,7 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 
 	connected := map[string]bool{}
 	var pausedSources []core.SourceNode
-	for _, rel := range stmt.Select.Relations {
+	for _, rel := range selectStmt.Relations {
 		switch rel.Type {
 		case parser.ActualStream:
 			if connected[rel.Name] {
@@ -471,7 +614,7 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 			} else if rel.Shedding == parser.Wait {
 				conf.DropMode = core.DropNone
 			}
-			if err := dbox.Input(rel.Name, conf); err != nil {
+			if err := tb.connectBox(dbox, rel.Name, conf); err != nil {
 				return nil, err
 			}
 			connected[rel.Name] = true
@@ -491,10 +634,25 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				rel.Type)
 		}
 	}
-	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
+	tb.stopOnDisconnect(dbox, core.Inbound)
+
+	// The boxes computing subqueries stop (and are removed) when this
+	// box stops. This can only be enabled now that they are connected.
+	for _, n := range subqueries {
+		sub, err := tb.topology.Box(n)
+		if err != nil {
+			return nil, err
+		}
+		tb.stopOnDisconnect(sub, core.Inbound|core.Outbound)
+	}
 
 	// Resume all UDSFs running in the source mode as fairly as possible.
+	// They're resumed when the transaction is committed if it's in progress.
 	for _, sn := range pausedSources {
+		if tb.tx != nil {
+			tb.tx.sourcesToResume = append(tb.tx.sourcesToResume, sn.Name())
+			continue
+		}
 		if err := sn.Resume(); err != nil {
 			return nil, err
 		}
@@ -503,6 +661,71 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 	return dbox, nil
 }
 
+// newBQLBox creates a box executing the given SELECT statement and the
+// configuration it has to be added to the topology with. A statement
+// with a WITH PARALLELISM clause is executed by a parallelBQLBox.
+func (tb *TopologyBuilder) newBQLBox(p parser.ParallelismAST, stmt *parser.SelectStmt) (core.Box, *core.BoxConfig, error) {
+	if p.Parallelism < 0 {
+		return nil, nil, fmt.Errorf("PARALLELISM must not be negative, not %d", p.Parallelism)
+	}
+	if p.Parallelism <= 1 {
+		return NewBQLBox(stmt, tb.Reg), nil, nil
+	}
+	box, err := newParallelBQLBox(stmt, tb.Reg, int(p.Parallelism))
+	if err != nil {
+		return nil, nil, err
+	}
+	return box, box.config(), nil
+}
+
+// materializeSubqueries creates a box with a generated name for every
+// subquery in the FROM clause of the given statement (as in
+// `FROM (SELECT ISTREAM ...) [RANGE ...] AS x`) and replaces the
+// subquery by a reference to that box's stream. It returns the names
+// of the created boxes. The relations of the given statement are not
+// modified in place.
+func (tb *TopologyBuilder) materializeSubqueries(stmt *parser.SelectStmt) ([]string, error) {
+	var names []string
+	var rels []parser.AliasedStreamWindowAST
+	for i, rel := range stmt.Relations {
+		if rel.Type != parser.SubqueryStream {
+			continue
+		}
+		if rel.Alias == "" {
+			err := fmt.Errorf("a subquery in the FROM clause must have an alias")
+			for _, n := range names {
+				tb.topology.Remove(n)
+			}
+			return nil, err
+		}
+		tmpName := fmt.Sprintf("sensorbee_tmp_subquery_%v", topologyBuilderNextTemporaryID())
+		tmpStmt := parser.CreateStreamAsSelectStmt{
+			parser.StreamIdentifier(tmpName),
+			parser.ParallelismAST{},
+			*rel.Subquery,
+		}
+		box, err := tb.createStreamAsSelectStmt(&tmpStmt)
+		if err != nil {
+			for _, n := range names {
+				tb.topology.Remove(n)
+			}
+			return nil, err
+		}
+		box.(core.BoxNode).RemoveOnStop()
+		names = append(names, tmpName)
+
+		if rels == nil {
+			rels = make([]parser.AliasedStreamWindowAST, len(stmt.Relations))
+			copy(rels, stmt.Relations)
+		}
+		rels[i].Stream = parser.Stream{parser.ActualStream, tmpName, nil, nil}
+	}
+	if rels != nil {
+		stmt.Relations = rels
+	}
+	return names, nil
+}
+
 // setUpUDSFStream creates a Source or a Box from a UDSF. When it creates a
 // Source, it will return the corresponding core.SourceNode of it. Otherwise,
 // it returns nil for core.SourceNode. It also returns the temporary name of
@@ -609,7 +832,7 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 		return nil, "", err
 	}
 	for input, config := range decl.ListInputs() {
-		if err := bn.Input(input, &core.BoxInputConfig{
+		if err := tb.connectBox(bn, input, &core.BoxInputConfig{
 			InputName: config.InputName,
 		}); err != nil {
 			return nil, "", err
@@ -618,7 +841,7 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	if err := addInput(); err != nil {
 		return nil, "", err
 	}
-	bn.StopOnDisconnect(core.Inbound | core.Outbound)
+	tb.stopOnDisconnect(bn, core.Inbound|core.Outbound)
 	bn.RemoveOnStop()
 	return nil, temporaryName, nil
 }
@@ -719,6 +942,7 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
+				parser.ParallelismAST{},
 				parser.SelectStmt{
 					stmt.EmitterAST,
 					stmt.ProjectionsAST,
@@ -726,6 +950,8 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 					stmt.FilterAST,
 					stmt.GroupingAST,
 					stmt.HavingAST,
+					stmt.OrderByAST,
+					stmt.LimitAST,
 				},
 			}
 			box, err := tb.AddStmt(tmpStmt)
@@ -812,6 +1038,290 @@ func (tb *TopologyBuilder) RunEvalStmt(stmt *parser.EvalStmt) (data.Value, error
 	return execution.EvaluateOnInput(expr, inputRow, tb.Reg)
 }
 
+// RunExplainStmt returns how the statement contained in the given
+// ExplainStmt would be executed. The statement is analyzed and its physical
+// plan is created in the same way as AddStmt does, but no node is added to
+// the topology. Nodes which the statement reads from must exist.
+//
+// The result has the description of the plan returned by execution.Explain.
+// A SELECT UNION statement results in a map having "selects" field, which
+// is an array of the descriptions of its SELECT statements. A CREATE STREAM
+// statement additionally has "stream" and "parallelism" fields. A window on
+// a subquery has the description of the subquery in its "subquery" field and
+// a window on a UDSF has the name and the arity of the UDSF in its "udsf"
+// field.
+func (tb *TopologyBuilder) RunExplainStmt(stmt *parser.ExplainStmt) (data.Map, error) {
+	switch s := stmt.Stmt.(type) {
+	case parser.SelectStmt:
+		return tb.explainSelect(&s)
+
+	case parser.SelectUnionStmt:
+		return tb.explainSelectUnion(s.Selects)
+
+	case parser.CreateStreamAsSelectStmt:
+		if s.Parallelism < 0 {
+			return nil, fmt.Errorf("PARALLELISM must not be negative, not %d", s.Parallelism)
+		}
+		if err := tb.checkNewStreamName(string(s.Name)); err != nil {
+			return nil, err
+		}
+		m, err := tb.explainSelect(&s.Select)
+		if err != nil {
+			return nil, err
+		}
+		m["stream"] = data.String(s.Name)
+		m["parallelism"] = data.Int(1)
+		if s.Parallelism > 1 {
+			m["parallelism"] = data.Int(s.Parallelism)
+		}
+		return m, nil
+
+	case parser.CreateStreamAsSelectUnionStmt:
+		if err := tb.checkNewStreamName(string(s.Name)); err != nil {
+			return nil, err
+		}
+		m, err := tb.explainSelectUnion(s.Selects)
+		if err != nil {
+			return nil, err
+		}
+		m["stream"] = data.String(s.Name)
+		return m, nil
+	}
+	return nil, fmt.Errorf("statement of type %T cannot be explained", stmt.Stmt)
+}
+
+func (tb *TopologyBuilder) checkNewStreamName(name string) error {
+	if err := core.ValidateSymbol(name); err != nil {
+		return err
+	}
+	if _, err := tb.topology.Node(name); err == nil {
+		return fmt.Errorf("the name is already used: %v", name)
+	} else if !core.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (tb *TopologyBuilder) explainSelectUnion(stmts []parser.SelectStmt) (data.Map, error) {
+	selects := make(data.Array, len(stmts))
+	for i := range stmts {
+		m, err := tb.explainSelect(&stmts[i])
+		if err != nil {
+			return nil, err
+		}
+		selects[i] = m
+	}
+	return data.Map{
+		"selects": selects,
+	}, nil
+}
+
+// explainSelect explains the SELECT statement after validating its input
+// relations in the same way as createStreamAsSelectStmt does.
+func (tb *TopologyBuilder) explainSelect(stmt *parser.SelectStmt) (data.Map, error) {
+	selectStmt := *stmt
+	selectStmt.Relations = make([]parser.AliasedStreamWindowAST, len(stmt.Relations))
+	copy(selectStmt.Relations, stmt.Relations)
+
+	details := make([]data.Map, len(stmt.Relations))
+	for i, rel := range stmt.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if _, err := tb.topology.Node(rel.Name); err != nil {
+				return nil, err
+			}
+
+		case parser.UDSFStream:
+			for _, expr := range rel.Params {
+				if _, err := execution.EvaluateFoldable(expr, tb.Reg); err != nil {
+					return nil, err
+				}
+			}
+			if _, err := tb.UDSFCreators.Lookup(rel.Name, len(rel.Params)); err != nil {
+				return nil, err
+			}
+			details[i] = data.Map{
+				"udsf": data.Map{
+					"name":  data.String(rel.Name),
+					"arity": data.Int(len(rel.Params)),
+				},
+			}
+
+		case parser.SubqueryStream:
+			if rel.Alias == "" {
+				return nil, fmt.Errorf("a subquery in the FROM clause must have an alias")
+			}
+			sub, err := tb.explainSelect(rel.Subquery)
+			if err != nil {
+				return nil, err
+			}
+			details[i] = data.Map{
+				"subquery": sub,
+			}
+			// the subquery is read as a stream like materializeSubqueries does
+			selectStmt.Relations[i].Stream = parser.Stream{parser.ActualStream, rel.Alias, nil, nil}
+
+		default:
+			return nil, fmt.Errorf("input stream of type %s not implemented",
+				rel.Type)
+		}
+	}
+
+	m, err := execution.Explain(selectStmt, tb.Reg)
+	if err != nil {
+		return nil, err
+	}
+	windows, _ := m["windows"].(data.Array)
+	for i, d := range details {
+		if d == nil || i >= len(windows) {
+			continue
+		}
+		w, ok := windows[i].(data.Map)
+		if !ok {
+			continue
+		}
+		for k, v := range d {
+			w[k] = v
+		}
+		if _, ok := d["subquery"]; ok {
+			delete(w, "stream")
+			w["stream_type"] = data.String("subquery")
+		}
+	}
+	return m, nil
+}
+
+// SetCheckpointer sets the Checkpointer taking checkpoints of the topology
+// so that the owner of the TopologyBuilder can stop it with the topology.
+func (tb *TopologyBuilder) SetCheckpointer(c *Checkpointer) {
+	tb.checkpointer = c
+}
+
+// Checkpointer returns the Checkpointer set by SetCheckpointer. It returns nil
+// when checkpointing isn't enabled.
+func (tb *TopologyBuilder) Checkpointer() *Checkpointer {
+	return tb.checkpointer
+}
+
+// StartRecovery starts recovering the topology from the given checkpoint.
+// Sources created by CREATE SOURCE statements are restored from the
+// checkpoint before they're added to the topology, and they're created as
+// paused until FinishRecovery is called so that no tuple is generated
+// before the other nodes are restored. RESUME SOURCE statements are also
+// deferred until FinishRecovery is called. cp can be nil when there's no
+// checkpoint to be restored.
+func (tb *TopologyBuilder) StartRecovery(cp *Checkpoint) {
+	tb.recovering = true
+	tb.recovery = cp
+}
+
+// FinishRecovery restores the states and the boxes of the topology from the
+// checkpoint given to StartRecovery and resumes the sources paused due to the
+// recovery. States which don't exist in the topology are created from the
+// checkpoint. Nodes which don't exist in the topology are ignored.
+func (tb *TopologyBuilder) FinishRecovery() error {
+	if !tb.recovering {
+		return fmt.Errorf("the topology isn't being recovered")
+	}
+	cp := tb.recovery
+	names := tb.sourcesToResume
+	tb.recovering = false
+	tb.recovery = nil
+	tb.sourcesToResume = nil
+
+	if cp != nil {
+		if err := tb.restoreCheckpoint(cp); err != nil {
+			return err
+		}
+	}
+	for _, name := range names {
+		sn, err := tb.topology.Source(name)
+		if err != nil {
+			if core.IsNotExist(err) { // removed during the recovery
+				continue
+			}
+			return err
+		}
+		if err := sn.Resume(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreSource restores a newly created source from the checkpoint which
+// the topology is being recovered from, if any.
+func (tb *TopologyBuilder) restoreSource(name string, s core.Source) error {
+	if tb.recovery == nil {
+		return nil
+	}
+	v, ok := tb.recovery.Nodes[name]
+	if !ok {
+		return nil
+	}
+	c, ok := s.(core.Checkpointable)
+	if !ok {
+		return nil
+	}
+	if err := c.LoadCheckpoint(tb.topology.Context(), v); err != nil {
+		return fmt.Errorf("cannot restore the source '%v': %v", name, err)
+	}
+	return nil
+}
+
+// restoreCheckpoint restores states and boxes from the checkpoint. Sources
+// have already been restored by restoreSource.
+func (tb *TopologyBuilder) restoreCheckpoint(cp *Checkpoint) error {
+	ctx := tb.topology.Context()
+	reg := ctx.SharedStates
+	for name, st := range cp.States {
+		if _, err := reg.Get(name); err == nil {
+			if err := tb.replaceState(st.TypeName, name, bytes.NewReader(st.Data), data.Map{}); err != nil {
+				return fmt.Errorf("cannot restore the state '%v': %v", name, err)
+			}
+			continue
+		} else if !core.IsNotExist(err) {
+			return err
+		}
+
+		// The state was created after the topology had been set up.
+		loader, err := tb.stateLoader(st.TypeName, name)
+		if err != nil {
+			return err
+		}
+		s, err := loader.LoadState(ctx, bytes.NewReader(st.Data), data.Map{})
+		if err != nil {
+			return fmt.Errorf("cannot restore the state '%v': %v", name, err)
+		}
+		if err := reg.Add(name, st.TypeName, s); err != nil {
+			return err
+		}
+	}
+
+	for name, v := range cp.Nodes {
+		n, err := tb.topology.Node(name)
+		if err != nil {
+			// Names of temporary nodes change every time they're created.
+			ctx.ErrLog(err).WithField("node_name", name).
+				Warn("The checkpoint of the node is ignored")
+			continue
+		}
+		if n.Type() != core.NTBox {
+			continue
+		}
+		c := checkpointableOf(n)
+		if c == nil {
+			ctx.Log().WithField("node_name", name).
+				Warn("The checkpoint of the node is ignored because it cannot be restored")
+			continue
+		}
+		if err := c.LoadCheckpoint(ctx, v); err != nil {
+			return fmt.Errorf("cannot restore the box '%v': %v", name, err)
+		}
+	}
+	return nil
+}
+
 func (tb *TopologyBuilder) saveState(name, tag string) error {
 	st, err := tb.topology.Context().SharedStates.Get(name)
 	if err != nil {
@@ -853,14 +1363,28 @@ func (tb *TopologyBuilder) loadState(typeName, name, tag string, params data.Map
 		return core.IsNotExist(err), err
 	}
 	defer r.Close()
+	return false, tb.replaceState(typeName, name, r, params)
+}
 
+// stateLoader returns the udf.UDSLoader of the given type.
+func (tb *TopologyBuilder) stateLoader(typeName, name string) (udf.UDSLoader, error) {
 	c, err := tb.UDSCreators.Lookup(typeName)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	loader, ok := c.(udf.UDSLoader)
 	if !ok {
-		return false, fmt.Errorf("the state '%v-%v' cannot be loaded", name, tag)
+		return nil, fmt.Errorf("the state '%v' cannot be loaded", name)
+	}
+	return loader, nil
+}
+
+// replaceState loads a state from the reader and replaces the current
+// instance of the state with it.
+func (tb *TopologyBuilder) replaceState(typeName, name string, r io.Reader, params data.Map) error {
+	loader, err := tb.stateLoader(typeName, name)
+	if err != nil {
+		return err
 	}
 
 	// If the state is loaded and it provides Load method, Load method will be
@@ -870,22 +1394,22 @@ func (tb *TopologyBuilder) loadState(typeName, name, tag string, params data.Map
 	if err != nil {
 		// TODO: check if the error is "not found". Return only on other errors.
 	} else if t, err := reg.Type(name); err != nil {
-		return false, err
+		return err
 	} else if t != typeName {
-		return false, fmt.Errorf("type name doesn't much to the current state's type")
+		return fmt.Errorf("type name doesn't much to the current state's type")
 	}
 
 	if l, ok := s.(core.LoadableSharedState); ok {
-		return false, l.Load(tb.topology.Context(), r, params)
+		return l.Load(tb.topology.Context(), r, params)
 	}
 
 	newState, err := loader.LoadState(tb.topology.Context(), r, params)
 	if err != nil {
-		return false, err
+		return err
 	}
 	prev, err := reg.Replace(name, typeName, newState)
 	if err != nil {
-		return false, err
+		return err
 	}
 	if prev != nil {
 		if err := prev.Terminate(tb.topology.Context()); err != nil {
@@ -893,5 +1417,5 @@ func (tb *TopologyBuilder) loadState(typeName, name, tag string, params data.Map
 				Error("Cannot terminate the previous instance of the loaded state")
 		}
 	}
-	return false, nil
+	return nil
 }
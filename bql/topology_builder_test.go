@@ -1,10 +1,13 @@
 package bql
 
 import (
+	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io/ioutil"
+	"os"
 	"testing"
 	"time"
 )
@@ -176,6 +179,15 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 				})
 			})
 
+			Convey("If the UDSF is the built-in unnest", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT RSTREAM int FROM
+                unnest("s", "int") [RANGE 1 TUPLES]`)
+
+				Convey("Then there should be no error", func() {
+					So(err, ShouldBeNil)
+				})
+			})
+
 			Convey("If the UDSF doesn't depend on an input", func() {
 				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
                 test_sequence(4) [RANGE 2 SECONDS] WHERE int=2`)
@@ -235,6 +247,80 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When running CREATE STREAM AS SELECT with a subquery", func() {
+			numNodes := len(tb.topology.Nodes())
+
+			Convey("If the subquery has an alias", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int FROM
+                (SELECT ISTREAM int FROM s [RANGE 1 TUPLES] WHERE int % 2 = 0) [RANGE 2 TUPLES] AS x`)
+
+				Convey("Then there should be no error", func() {
+					So(err, ShouldBeNil)
+				})
+
+				Convey("And the subquery should be computed by an additional box", func() {
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes+2)
+				})
+
+				Convey("And when dropping the stream", func() {
+					So(addBQLToTopology(tb, `DROP STREAM t`), ShouldBeNil)
+
+					Convey("Then the box of the subquery should be removed as well", func() {
+						waitForExpectedCondition(func() bool {
+							return len(tb.topology.Nodes()) == numNodes
+						})
+						So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+					})
+				})
+			})
+
+			Convey("If subqueries are nested and joined", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int, s:int FROM
+                (SELECT ISTREAM y:int FROM (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS y)
+                [RANGE 2 TUPLES] AS x JOIN s [RANGE 2 TUPLES] ON x:int = s:int`)
+
+				Convey("Then there should be no error", func() {
+					So(err, ShouldBeNil)
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes+3)
+				})
+			})
+
+			Convey("If the subquery has no alias", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]) [RANGE 2 TUPLES]`)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "must have an alias")
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+
+			Convey("If the subquery refers to a non-existing stream", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int FROM
+                (SELECT ISTREAM int FROM bar [RANGE 1 TUPLES]) [RANGE 2 TUPLES] AS x`)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldContainSubstring, "was not found")
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+
+			Convey("If the outer query is invalid", func() {
+				err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM x:int FROM
+                (SELECT ISTREAM int FROM s [RANGE 1 TUPLES]) [RANGE 2 TUPLES] AS x, bar [RANGE 1 TUPLES]`)
+
+				Convey("Then an error should be returned", func() {
+					So(err, ShouldNotBeNil)
+					waitForExpectedCondition(func() bool {
+						return len(tb.topology.Nodes()) == numNodes
+					})
+					So(len(tb.topology.Nodes()), ShouldEqual, numNodes)
+				})
+			})
+		})
+
 		Convey("When running CREATE STREAM AS SELECT on a non-existing stream", func() {
 			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
                 bar [RANGE 2 SECONDS] WHERE int=2`)
@@ -718,6 +804,79 @@ func TestSaveLoadStateStmt(t *testing.T) {
 	})
 }
 
+func TestRewindSourceStmt(t *testing.T) {
+	f, err := ioutil.TempFile("", "sbtest_bql_rewind_source")
+	if err != nil {
+		t.Fatal("Cannot create a temp file:", err)
+	}
+	name := f.Name()
+	defer os.Remove(name)
+	for i := 1; i <= 4; i++ {
+		if _, err := fmt.Fprintf(f, `{"int":%v, "ts":"2015-04-10T10:23:0%vZ"}`+"\n", i, i); err != nil {
+			t.Fatal("Cannot write to the temp file:", err)
+		}
+	}
+	f.Close()
+
+	Convey("Given a BQL TopologyBuilder with a seekable source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, fmt.Sprintf(`
+			CREATE PAUSED SOURCE source TYPE file
+				WITH path=%v, rewindable=true, timestamp_field="ts";
+			CREATE PAUSED SOURCE source2 TYPE dummy;
+			CREATE SINK snk TYPE collector;
+			INSERT INTO snk FROM source;
+			RESUME SOURCE source;`, parser.StringLiteral{name}.String())), ShouldBeNil)
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+		si.Wait(4)
+
+		Convey("When rewinding the source to an offset", func() {
+			So(addBQLToTopology(tb, `REWIND SOURCE source TO OFFSET 2;`), ShouldBeNil)
+			si.Wait(6)
+
+			Convey("Then the sink should receive tuples from the offset", func() {
+				So(si.len(), ShouldEqual, 6)
+				So(si.get(4).Data["int"], ShouldEqual, data.Int(3))
+				So(si.get(5).Data["int"], ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When rewinding the source to a timestamp", func() {
+			So(addBQLToTopology(tb, `REWIND SOURCE source TO TIMESTAMP "2015-04-10T10:23:04Z";`), ShouldBeNil)
+			si.Wait(5)
+
+			Convey("Then the sink should receive tuples from the timestamp", func() {
+				So(si.len(), ShouldEqual, 5)
+				So(si.get(4).Data["int"], ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When rewinding the source to an invalid timestamp", func() {
+			err := addBQLToTopology(tb, `REWIND SOURCE source TO TIMESTAMP "yesterday";`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When rewinding a source which isn't seekable to an offset", func() {
+			err := addBQLToTopology(tb, `REWIND SOURCE source2 TO OFFSET 2;`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestUpdateSourceStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
@@ -841,6 +1000,32 @@ func TestSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When issuing a SELECT stmt with a subquery", func() {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(`SELECT ISTREAM x:int FROM
+				(SELECT ISTREAM int FROM s [RANGE 1 TUPLES] WHERE int % 2 = 0) [RANGE 1 TUPLES] AS x;`)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.SelectStmt)
+			_, ch, err := tb.AddSelectStmt(&stmt)
+			So(err, ShouldBeNil)
+			So(addBQLToTopology(tb, `RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then the chan should receive the tuples computed by the subquery", func() {
+				cnt := 0
+				for _ = range ch {
+					cnt++
+				}
+				So(cnt, ShouldEqual, 2)
+
+				Convey("And all temporary boxes should eventually be removed from the topology", func() {
+					waitForExpectedCondition(func() bool {
+						return len(dt.Boxes()) == 0
+					})
+					So(dt.Boxes(), ShouldBeEmpty)
+				})
+			})
+		})
+
 		Convey("When issuing a SELECT stmt referencing an unknown source", func() {
 			bp := parser.New()
 			numNodes := len(tb.topology.Nodes())
@@ -1033,6 +1218,98 @@ func TestEvalStmt(t *testing.T) {
 	})
 }
 
+func TestExplainStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE source TYPE dummy;`), ShouldBeNil)
+
+		explain := func(s string) (data.Map, error) {
+			istmt, _, err := parser.New().ParseStmt(s)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.ExplainStmt)
+			return tb.RunExplainStmt(&stmt)
+		}
+
+		Convey("When explaining a CREATE STREAM statement", func() {
+			m, err := explain(`EXPLAIN CREATE STREAM box WITH PARALLELISM 2 AS
+				SELECT ISTREAM int, count(int) FROM source [RANGE 2 TUPLES] GROUP BY int`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the plan", func() {
+				So(m["stream"], ShouldEqual, data.String("box"))
+				So(m["parallelism"], ShouldEqual, data.Int(2))
+				So(m["physical_plan"], ShouldEqual, data.String("groupby"))
+			})
+
+			Convey("Then no node should be added", func() {
+				So(dt.Nodes(), ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When explaining a SELECT UNION statement", func() {
+			m, err := explain(`EXPLAIN SELECT RSTREAM int FROM source [RANGE 1 TUPLES]
+				UNION ALL SELECT ISTREAM int FROM source [RANGE 2 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the plan of each SELECT", func() {
+				s := m["selects"].(data.Array)
+				So(s, ShouldHaveLength, 2)
+				So(s[0].(data.Map)["physical_plan"], ShouldEqual, data.String("filter"))
+				So(s[1].(data.Map)["physical_plan"], ShouldEqual, data.String("default"))
+			})
+		})
+
+		Convey("When explaining a SELECT statement reading from a UDSF and a subquery", func() {
+			m, err := explain(`EXPLAIN SELECT RSTREAM d:int, s:int FROM
+				duplicate("source", 2) [RANGE 1 TUPLES] AS d,
+				(SELECT RSTREAM int FROM source [RANGE 1 TUPLES]) [RANGE 1 TUPLES] AS s`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should have the UDSF", func() {
+				w := m["windows"].(data.Array)[0].(data.Map)
+				So(w["stream_type"], ShouldEqual, data.String("udsf"))
+				So(w["udsf"], ShouldResemble, data.Map{
+					"name":  data.String("duplicate"),
+					"arity": data.Int(2),
+				})
+			})
+
+			Convey("Then it should have the plan of the subquery", func() {
+				w := m["windows"].(data.Array)[1].(data.Map)
+				So(w["stream_type"], ShouldEqual, data.String("subquery"))
+				So(w, ShouldNotContainKey, "stream")
+				sub := w["subquery"].(data.Map)
+				So(sub["physical_plan"], ShouldEqual, data.String("filter"))
+			})
+
+			Convey("Then no node should be added", func() {
+				So(dt.Nodes(), ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When explaining a statement reading from a nonexistent stream", func() {
+			_, err := explain(`EXPLAIN SELECT ISTREAM * FROM no_such_stream [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining a CREATE STREAM statement having an existing name", func() {
+			_, err := explain(`EXPLAIN CREATE STREAM source AS SELECT ISTREAM * FROM source [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestDropSourceStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
@@ -1146,3 +1423,39 @@ func waitForExpectedCondition(f func() bool) {
 		time.Sleep(time.Nanosecond)
 	}
 }
+
+func TestTopologyBuilderTimestamps(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		created := tb.CreatedAt()
+
+		Convey("Then it should have the same created and updated time", func() {
+			So(created.IsZero(), ShouldBeFalse)
+			So(tb.UpdatedAt(), ShouldResemble, created)
+		})
+
+		Convey("When adding a statement", func() {
+			time.Sleep(time.Millisecond)
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE source TYPE dummy;`), ShouldBeNil)
+
+			Convey("Then the updated time should be changed", func() {
+				So(tb.UpdatedAt(), ShouldHappenAfter, created)
+				So(tb.CreatedAt(), ShouldResemble, created)
+			})
+		})
+
+		Convey("When adding an invalid statement", func() {
+			time.Sleep(time.Millisecond)
+			So(addBQLToTopology(tb, `RESUME SOURCE no_such_source;`), ShouldNotBeNil)
+
+			Convey("Then the updated time shouldn't be changed", func() {
+				So(tb.UpdatedAt(), ShouldResemble, created)
+			})
+		})
+	})
+}
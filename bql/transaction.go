@@ -0,0 +1,283 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"strings"
+)
+
+// StmtError is an error returned from TopologyBuilder.AddStmtsAtomically. It
+// has the statement which caused the error.
+type StmtError struct {
+	// Stmt is the statement which couldn't be processed.
+	Stmt interface{}
+
+	// Err is the error returned when processing Stmt.
+	Err error
+}
+
+func (e *StmtError) Error() string {
+	return e.Err.Error()
+}
+
+// stmtTransaction records changes made to a topology by statements issued
+// through TopologyBuilder.AddStmtsAtomically so that they can be rolled back.
+type stmtTransaction struct {
+	// nodes has lower-cased names of nodes existing before the transaction.
+	nodes map[string]bool
+
+	// states has names of shared states existing before the transaction.
+	states map[string]bool
+
+	// sourcesToResume has the names of the sources which will be resumed when
+	// the transaction is committed.
+	sourcesToResume []string
+
+	// pausedSources has the names of the existing sources which were paused
+	// in the transaction. They're resumed again on rollback.
+	pausedSources []string
+
+	// inputs has INSERT INTO statements issued in the transaction, which are
+	// deferred until the transaction is committed so that sinks don't receive
+	// tuples before that and because an input cannot be removed from a sink.
+	inputs []parser.InsertIntoFromStmt
+
+	// boxInputs has connections from nodes existing before the transaction
+	// to boxes created in it. They're deferred until the transaction is
+	// committed so that new boxes don't process tuples before that.
+	boxInputs []txBoxInput
+
+	// stopOnDisconnect has directions given to StopOnDisconnect of boxes
+	// having deferred inputs. A box having no input would stop immediately
+	// if StopOnDisconnect was called with core.Inbound.
+	stopOnDisconnect map[string]core.ConnDir
+}
+
+// txBoxInput is a connection to a box deferred by a transaction.
+type txBoxInput struct {
+	box    string
+	input  string
+	config *core.BoxInputConfig
+}
+
+func newStmtTransaction(t core.Topology) (*stmtTransaction, error) {
+	tx := &stmtTransaction{
+		nodes:            map[string]bool{},
+		states:           map[string]bool{},
+		stopOnDisconnect: map[string]core.ConnDir{},
+	}
+	for name := range t.Nodes() {
+		tx.nodes[strings.ToLower(name)] = true
+	}
+	states, err := t.Context().SharedStates.List()
+	if err != nil {
+		return nil, err
+	}
+	for name := range states {
+		tx.states[name] = true
+	}
+	return tx, nil
+}
+
+// existed returns true if the node existed before the transaction.
+func (tx *stmtTransaction) existed(name string) bool {
+	return tx.nodes[strings.ToLower(name)]
+}
+
+// hasDeferredInputs returns true if the box has inputs whose connections are
+// deferred until the transaction is committed.
+func (tx *stmtTransaction) hasDeferredInputs(box string) bool {
+	for _, in := range tx.boxInputs {
+		if strings.EqualFold(in.box, box) {
+			return true
+		}
+	}
+	return false
+}
+
+// pause records that the source is being paused in the transaction.
+func (tx *stmtTransaction) pause(sn core.SourceNode) {
+	names := tx.sourcesToResume[:0]
+	for _, n := range tx.sourcesToResume {
+		if !strings.EqualFold(n, sn.Name()) {
+			names = append(names, n)
+		}
+	}
+	tx.sourcesToResume = names
+
+	if tx.existed(sn.Name()) && sn.State().Get() == core.TSRunning {
+		tx.pausedSources = append(tx.pausedSources, sn.Name())
+	}
+}
+
+// validateAtomicStmt returns an error when the statement cannot be rolled
+// back and cannot be issued in AddStmtsAtomically.
+func validateAtomicStmt(stmt interface{}) error {
+	switch stmt.(type) {
+	case parser.CreateSourceStmt, parser.CreateStreamAsSelectStmt,
+		parser.CreateStreamAsSelectUnionStmt, parser.CreateSinkStmt,
+		parser.CreateStateStmt, parser.InsertIntoFromStmt,
+		parser.PauseSourceStmt, parser.ResumeSourceStmt:
+		return nil
+	}
+	return fmt.Errorf("the statement cannot be issued atomically: %v", stmt)
+}
+
+// AddStmtsAtomically adds all statements to the topology in an atomic
+// manner. It returns nodes created from the statements in the same order
+// as AddStmt does.
+//
+// Sources created in this method are paused until all statements are
+// processed and RESUME SOURCE statements are deferred until then. Likewise,
+// INSERT INTO statements and connections from existing nodes to new boxes
+// are deferred so that no new node processes tuples before all statements
+// succeed. When one of the statements fails, all nodes and states created
+// by the statements are removed, existing sources paused by the statements
+// are resumed, and a *StmtError having the failed statement is returned. As
+// a result, the topology stays unchanged except that inputs of existing sinks
+// which were connected before a failure while committing the transaction
+// cannot be removed.
+//
+// Only statements which can be rolled back, that are CREATE SOURCE, CREATE
+// STREAM, CREATE SINK, CREATE STATE, INSERT INTO, PAUSE SOURCE, and RESUME
+// SOURCE, can be issued. Other statements are rejected before any statement
+// is processed.
+//
+// AddStmt and AddStmtsAtomically of the same TopologyBuilder are serialized
+// for the whole transaction. However, the transaction assumes that the
+// topology isn't modified concurrently by other TopologyBuilders.
+func (tb *TopologyBuilder) AddStmtsAtomically(stmts []interface{}) ([]core.Node, error) {
+	for _, stmt := range stmts {
+		if err := validateAtomicStmt(stmt); err != nil {
+			return nil, &StmtError{stmt, err}
+		}
+	}
+	tb.stmtMutex.Lock()
+	defer tb.stmtMutex.Unlock()
+	tx, err := newStmtTransaction(tb.topology)
+	if err != nil {
+		return nil, err
+	}
+	tb.tx = tx
+	defer func() {
+		tb.tx = nil
+	}()
+
+	nodes := make([]core.Node, 0, len(stmts))
+	for _, stmt := range stmts {
+		n, err := tb.addStmt(stmt)
+		if err != nil {
+			tb.rollback(tx)
+			return nil, &StmtError{stmt, err}
+		}
+		nodes = append(nodes, n)
+	}
+
+	for _, in := range tx.boxInputs {
+		bn, err := tb.topology.Box(in.box)
+		if err == nil {
+			err = bn.Input(in.input, in.config)
+		}
+		if err != nil {
+			tb.rollback(tx)
+			return nil, err
+		}
+	}
+	for name, dir := range tx.stopOnDisconnect {
+		bn, err := tb.topology.Box(name)
+		if err != nil {
+			tb.rollback(tx)
+			return nil, err
+		}
+		bn.StopOnDisconnect(dir)
+	}
+	for _, stmt := range tx.inputs {
+		if _, err := tb.connectSink(stmt); err != nil {
+			tb.rollback(tx)
+			return nil, &StmtError{stmt, err}
+		}
+	}
+	for _, name := range tx.sourcesToResume {
+		sn, err := tb.topology.Source(name)
+		if err != nil {
+			if core.IsNotExist(err) { // removed in the transaction
+				continue
+			}
+			tb.rollback(tx)
+			return nil, err
+		}
+		if err := sn.Resume(); err != nil {
+			tb.rollback(tx)
+			return nil, err
+		}
+	}
+	tb.Touch()
+	return nodes, nil
+}
+
+// connectBox adds the input to the box. When a transaction is in progress
+// and the input is a node existing before it, the connection is deferred
+// until the transaction is committed.
+func (tb *TopologyBuilder) connectBox(bn core.BoxNode, input string, config *core.BoxInputConfig) error {
+	if tb.tx == nil || !tb.tx.existed(input) {
+		return bn.Input(input, config)
+	}
+	if _, err := tb.topology.Node(input); err != nil {
+		return err
+	}
+	tb.tx.boxInputs = append(tb.tx.boxInputs, txBoxInput{bn.Name(), input, config})
+	return nil
+}
+
+// stopOnDisconnect calls StopOnDisconnect of the box. When the box has inputs
+// deferred by the transaction in progress, the call is also deferred until
+// the inputs are connected.
+func (tb *TopologyBuilder) stopOnDisconnect(bn core.BoxNode, dir core.ConnDir) {
+	if tb.tx == nil || !tb.tx.hasDeferredInputs(bn.Name()) {
+		bn.StopOnDisconnect(dir)
+		return
+	}
+	tb.tx.stopOnDisconnect[bn.Name()] |= dir
+}
+
+// rollback removes all nodes and states created in the transaction and
+// resumes sources paused in it. Errors are only logged so that rollback can
+// proceed as much as possible.
+func (tb *TopologyBuilder) rollback(tx *stmtTransaction) {
+	ctx := tb.topology.Context()
+	for name, n := range tb.topology.Nodes() {
+		if tx.existed(name) {
+			continue
+		}
+		if err := tb.topology.Remove(n.Name()); err != nil && !core.IsNotExist(err) {
+			ctx.ErrLog(err).WithField("node_name", n.Name()).
+				Error("Cannot remove the node created in the transaction")
+		}
+	}
+
+	if states, err := ctx.SharedStates.List(); err != nil {
+		ctx.ErrLog(err).Error("Cannot list shared states to be removed")
+	} else {
+		for name := range states {
+			if tx.states[name] {
+				continue
+			}
+			if _, err := ctx.SharedStates.Remove(name); err != nil && !core.IsNotExist(err) {
+				ctx.ErrLog(err).WithField("state_name", name).
+					Error("Cannot remove the state created in the transaction")
+			}
+		}
+	}
+
+	for _, name := range tx.pausedSources {
+		sn, err := tb.topology.Source(name)
+		if err != nil {
+			continue
+		}
+		if err := sn.Resume(); err != nil {
+			ctx.ErrLog(err).WithField("node_name", name).
+				Error("Cannot resume the source paused in the transaction")
+		}
+	}
+}
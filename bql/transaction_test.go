@@ -0,0 +1,187 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"testing"
+)
+
+func addBQLAtomically(tb *TopologyBuilder, bql string) ([]core.Node, error) {
+	stmts, err := parser.New().ParseStmts(bql)
+	if err != nil {
+		return nil, err
+	}
+	return tb.AddStmtsAtomically(stmts)
+}
+
+func TestAddStmtsAtomically(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder having a sink and a running source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE existing_source TYPE dummy WITH num=4;
+			RESUME SOURCE existing_source;
+			CREATE SINK existing_sink TYPE collector;
+			CREATE STATE existing_state TYPE dummy_uds WITH num=1;
+		`), ShouldBeNil)
+		created := tb.UpdatedAt()
+
+		Convey("When adding valid statements atomically", func() {
+			nodes, err := addBQLAtomically(tb, `
+				CREATE SOURCE source TYPE dummy WITH num=4;
+				CREATE STREAM box AS SELECT ISTREAM * FROM source [RANGE 1 TUPLES];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				INSERT INTO existing_sink FROM box;
+				CREATE STATE s TYPE dummy_uds WITH num=1;
+			`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return created nodes", func() {
+				So(nodes, ShouldHaveLength, 6)
+				So(nodes[0].Name(), ShouldEqual, "source")
+				So(nodes[1].Name(), ShouldEqual, "box")
+				So(nodes[5], ShouldBeNil)
+			})
+
+			Convey("Then the sinks should receive all tuples", func() {
+				sn, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sn.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+
+				sn, err = dt.Sink("existing_sink")
+				So(err, ShouldBeNil)
+				si = sn.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+			})
+
+			Convey("Then the state should be created", func() {
+				_, err := dt.Context().SharedStates.Get("s")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the updated time should be changed", func() {
+				So(tb.UpdatedAt(), ShouldHappenOnOrAfter, created)
+			})
+		})
+
+		Convey("When adding statements with a paused source atomically", func() {
+			_, err := addBQLAtomically(tb, `
+				CREATE PAUSED SOURCE source TYPE dummy WITH num=4;
+				CREATE PAUSED SOURCE source2 TYPE dummy WITH num=4;
+				RESUME SOURCE source2;
+				CREATE SOURCE source3 TYPE dummy WITH num=4;
+				PAUSE SOURCE source3;
+			`)
+			So(err, ShouldBeNil)
+
+			Convey("Then only resumed sources should be running", func() {
+				sn, err := dt.Source("source")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldEqual, core.TSPaused)
+
+				sn, err = dt.Source("source2")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldBeIn, []core.TopologyState{core.TSRunning, core.TSStopped})
+
+				sn, err = dt.Source("source3")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldEqual, core.TSPaused)
+			})
+		})
+
+		Convey("When adding a stream reading from an existing stream atomically", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE paused_source TYPE dummy WITH num=4;
+				CREATE STREAM existing_box AS SELECT RSTREAM * FROM paused_source [RANGE 1 TUPLES];
+			`), ShouldBeNil)
+			_, err := addBQLAtomically(tb, `
+				CREATE STREAM box AS SELECT RSTREAM * FROM existing_box [RANGE 1 TUPLES];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				RESUME SOURCE paused_source;
+			`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the sink should receive all tuples", func() {
+				sn, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sn.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+			})
+		})
+
+		Convey("When one of the statements fails", func() {
+			_, err := addBQLAtomically(tb, `
+				CREATE SOURCE source TYPE dummy WITH num=4;
+				CREATE STREAM box AS SELECT RSTREAM * FROM source [RANGE 1 TUPLES];
+				CREATE STATE s TYPE dummy_uds WITH num=1;
+				PAUSE SOURCE existing_source;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				INSERT INTO existing_sink FROM box;
+				CREATE STREAM box2 AS SELECT RSTREAM * FROM no_such_source [RANGE 1 TUPLES];
+			`)
+
+			Convey("Then it should fail with the statement", func() {
+				So(err, ShouldNotBeNil)
+				e, ok := err.(*StmtError)
+				So(ok, ShouldBeTrue)
+				_, ok = e.Stmt.(parser.CreateStreamAsSelectStmt)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then the created nodes should be removed", func() {
+				nodes := dt.Nodes()
+				So(nodes, ShouldHaveLength, 2)
+				So(nodes, ShouldContainKey, "existing_source")
+				So(nodes, ShouldContainKey, "existing_sink")
+			})
+
+			Convey("Then the created state should be removed", func() {
+				_, err := dt.Context().SharedStates.Get("s")
+				So(err, ShouldNotBeNil)
+				_, err = dt.Context().SharedStates.Get("existing_state")
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the paused source should be resumed", func() {
+				sn, err := dt.Source("existing_source")
+				So(err, ShouldBeNil)
+				So(sn.State().Get(), ShouldBeIn, []core.TopologyState{core.TSRunning, core.TSStopped})
+			})
+
+			Convey("Then the updated time shouldn't be changed", func() {
+				So(tb.UpdatedAt(), ShouldResemble, created)
+			})
+		})
+
+		Convey("When adding a statement which cannot be rolled back", func() {
+			_, err := addBQLAtomically(tb, `
+				CREATE SOURCE source TYPE dummy WITH num=4;
+				DROP SINK existing_sink;
+			`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				e, ok := err.(*StmtError)
+				So(ok, ShouldBeTrue)
+				_, ok = e.Stmt.(parser.DropSinkStmt)
+				So(ok, ShouldBeTrue)
+			})
+
+			Convey("Then no statement should be processed", func() {
+				So(dt.Nodes(), ShouldHaveLength, 2)
+			})
+		})
+	})
+}
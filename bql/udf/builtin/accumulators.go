@@ -0,0 +1,371 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+)
+
+// The accumulators in this file implement udf.UDAFState for the aggregate
+// functions having a single parameter, so that execution plans can maintain
+// their results while rows enter and leave a window.
+
+// mergeError is returned from Merge when the other state belongs to a
+// different aggregate function.
+func mergeError(s, other udf.UDAFState) error {
+	return fmt.Errorf("cannot merge %T into %T", other, s)
+}
+
+// countAccumulator maintains the result of countFunc.
+type countAccumulator struct {
+	count int64
+}
+
+func (a *countAccumulator) Accumulate(ctx *core.Context, args ...data.Value) error {
+	if args[0].Type() != data.TypeNull {
+		a.count++
+	}
+	return nil
+}
+
+func (a *countAccumulator) Retract(ctx *core.Context, args ...data.Value) error {
+	if args[0].Type() != data.TypeNull {
+		a.count--
+	}
+	return nil
+}
+
+func (a *countAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*countAccumulator)
+	if !ok {
+		return mergeError(a, other)
+	}
+	a.count += o.count
+	return nil
+}
+
+func (a *countAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(a.count), nil
+}
+
+// sumAccumulator maintains the result of sumFunc. Int and Float
+// values are summed up separately so that the integer sum is exact
+// as long as there are only Int values.
+type sumAccumulator struct {
+	intSum int64
+	// floatSum and floatComp are the sum of finite Float values and
+	// its compensation computed by Neumaier's summation algorithm so
+	// that retracting values doesn't accumulate rounding errors.
+	floatSum  float64
+	floatComp float64
+	// numNaNs, numPosInfs, and numNegInfs are the numbers of
+	// non-finite Float values, which aren't added to floatSum so that
+	// they can be retracted again
+	numNaNs    int64
+	numPosInfs int64
+	numNegInfs int64
+	numFloats  int64
+	// numValues is the number of non-null values
+	numValues int64
+}
+
+func (a *sumAccumulator) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		a.intSum += sign * i
+		a.numValues += sign
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		switch {
+		case math.IsNaN(f):
+			a.numNaNs += sign
+		case math.IsInf(f, 1):
+			a.numPosInfs += sign
+		case math.IsInf(f, -1):
+			a.numNegInfs += sign
+		default:
+			a.addFloat(float64(sign) * f)
+		}
+		a.numFloats += sign
+		a.numValues += sign
+		if a.numFloats == 0 {
+			// discard the rounding errors left by retracted values
+			a.floatSum = 0
+			a.floatComp = 0
+		}
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	return nil
+}
+
+// addFloat adds a finite value to floatSum using Neumaier's algorithm.
+func (a *sumAccumulator) addFloat(f float64) {
+	t := a.floatSum + f
+	if math.IsInf(t, 0) {
+		// the sum overflowed and the compensation would become NaN
+		a.floatSum = t
+		return
+	}
+	if math.Abs(a.floatSum) >= math.Abs(f) {
+		a.floatComp += (a.floatSum - t) + f
+	} else {
+		a.floatComp += (f - t) + a.floatSum
+	}
+	a.floatSum = t
+}
+
+// floatTotal returns the sum of all Float values.
+func (a *sumAccumulator) floatTotal() float64 {
+	switch {
+	case a.numNaNs > 0 || (a.numPosInfs > 0 && a.numNegInfs > 0):
+		return math.NaN()
+	case a.numPosInfs > 0:
+		return math.Inf(1)
+	case a.numNegInfs > 0:
+		return math.Inf(-1)
+	}
+	return a.floatSum + a.floatComp
+}
+
+// total returns the sum of all Int and Float values as a float64.
+func (a *sumAccumulator) total() float64 {
+	if a.numFloats == a.numValues {
+		return a.floatTotal()
+	}
+	return float64(a.intSum) + a.floatTotal()
+}
+
+func (a *sumAccumulator) Accumulate(ctx *core.Context, args ...data.Value) error {
+	return a.update(args[0], 1)
+}
+
+func (a *sumAccumulator) Retract(ctx *core.Context, args ...data.Value) error {
+	return a.update(args[0], -1)
+}
+
+func (a *sumAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*sumAccumulator)
+	if !ok {
+		return mergeError(a, other)
+	}
+	a.merge(o)
+	return nil
+}
+
+func (a *sumAccumulator) merge(o *sumAccumulator) {
+	a.intSum += o.intSum
+	a.addFloat(o.floatSum)
+	a.floatComp += o.floatComp
+	a.numNaNs += o.numNaNs
+	a.numPosInfs += o.numPosInfs
+	a.numNegInfs += o.numNegInfs
+	a.numFloats += o.numFloats
+	a.numValues += o.numValues
+}
+
+func (a *sumAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	if a.numValues == 0 {
+		return data.Null{}, nil
+	}
+	if a.numFloats == 0 {
+		return data.Int(a.intSum), nil
+	}
+	return data.Float(a.total()), nil
+}
+
+// avgAccumulator maintains the result of avgFunc.
+type avgAccumulator struct {
+	sumAccumulator
+}
+
+func (a *avgAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*avgAccumulator)
+	if !ok {
+		return mergeError(a, other)
+	}
+	a.merge(&o.sumAccumulator)
+	return nil
+}
+
+func (a *avgAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	if a.numValues == 0 {
+		return data.Null{}, nil
+	}
+	return data.Float(a.total() / float64(a.numValues)), nil
+}
+
+// boolAccumulator maintains the result of boolAndFunc (if and is
+// true) or boolOrFunc (otherwise).
+type boolAccumulator struct {
+	and    bool
+	trues  int64
+	falses int64
+}
+
+func (a *boolAccumulator) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeBool:
+		if b, _ := data.AsBool(v); b {
+			a.trues += sign
+		} else {
+			a.falses += sign
+		}
+	case data.TypeNull:
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a bool", v, v)
+	}
+	return nil
+}
+
+func (a *boolAccumulator) Accumulate(ctx *core.Context, args ...data.Value) error {
+	return a.update(args[0], 1)
+}
+
+func (a *boolAccumulator) Retract(ctx *core.Context, args ...data.Value) error {
+	return a.update(args[0], -1)
+}
+
+func (a *boolAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*boolAccumulator)
+	if !ok || o.and != a.and {
+		return mergeError(a, other)
+	}
+	a.trues += o.trues
+	a.falses += o.falses
+	return nil
+}
+
+func (a *boolAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	if a.trues+a.falses == 0 {
+		return data.Null{}, nil
+	}
+	if a.and {
+		return data.Bool(a.falses == 0), nil
+	}
+	return data.Bool(a.trues > 0), nil
+}
+
+// valueCount is an item of the multiset held by extremumAccumulator.
+type valueCount struct {
+	value data.Value
+	count int64
+}
+
+// extremumAccumulator maintains the result of maxFunc or minFunc,
+// depending on the given aggregate. It holds all distinct values
+// with their multiplicity. As long as the current result is not
+// retracted, new values are just combined with the result; otherwise
+// the result is recomputed from all distinct values when it is
+// requested.
+type extremumAccumulator struct {
+	aggregate func([]data.Value) (data.Value, error)
+	values    map[data.HashValue][]valueCount
+	result    data.Value
+	// dirty is true if result must be recomputed
+	dirty bool
+}
+
+// sameValue returns true if both values are equal and have the same
+// type. (Int and Float values must be distinguished because the type
+// of the result depends on them.)
+func sameValue(v1, v2 data.Value) bool {
+	return v1.Type() == v2.Type() && data.Equal(v1, v2)
+}
+
+func newExtremumAccumulator(aggregate func([]data.Value) (data.Value, error)) *extremumAccumulator {
+	return &extremumAccumulator{
+		aggregate: aggregate,
+		values:    map[data.HashValue][]valueCount{},
+		dirty:     true,
+	}
+}
+
+// add adds a value n times to the multiset of values.
+func (a *extremumAccumulator) add(v data.Value, n int64) {
+	h := data.Hash(v)
+	counts := a.values[h]
+	for i := range counts {
+		if sameValue(counts[i].value, v) {
+			counts[i].count += n
+			return
+		}
+	}
+	a.values[h] = append(counts, valueCount{v, n})
+}
+
+func (a *extremumAccumulator) Accumulate(ctx *core.Context, args ...data.Value) error {
+	v := args[0]
+	a.add(v, 1)
+	if a.dirty {
+		return nil
+	}
+	res, err := a.aggregate([]data.Value{a.result, v})
+	if err != nil {
+		return err
+	}
+	a.result = res
+	return nil
+}
+
+func (a *extremumAccumulator) Retract(ctx *core.Context, args ...data.Value) error {
+	v := args[0]
+	h := data.Hash(v)
+	counts := a.values[h]
+	for i := range counts {
+		if !sameValue(counts[i].value, v) {
+			continue
+		}
+		counts[i].count--
+		if counts[i].count == 0 {
+			counts = append(counts[:i], counts[i+1:]...)
+			if len(counts) == 0 {
+				delete(a.values, h)
+			} else {
+				a.values[h] = counts
+			}
+		}
+		if !a.dirty && data.Equal(a.result, v) {
+			a.dirty = true
+		}
+		return nil
+	}
+	return fmt.Errorf("value %s was not added before", v)
+}
+
+func (a *extremumAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*extremumAccumulator)
+	if !ok {
+		return mergeError(a, other)
+	}
+	for _, counts := range o.values {
+		for _, c := range counts {
+			a.add(c.value, c.count)
+		}
+	}
+	a.dirty = true
+	return nil
+}
+
+func (a *extremumAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	if a.dirty {
+		// the result only depends on the distinct values
+		distinct := make([]data.Value, 0, len(a.values))
+		for _, counts := range a.values {
+			for _, c := range counts {
+				distinct = append(distinct, c.value)
+			}
+		}
+		res, err := a.aggregate(distinct)
+		if err != nil {
+			return nil, err
+		}
+		a.result = res
+		a.dirty = false
+	}
+	return a.result, nil
+}
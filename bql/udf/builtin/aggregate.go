@@ -35,6 +35,18 @@ func (f *singleParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.V
 	return f.aggFun(arr)
 }
 
+// incrementalAggFunc is a template for aggregate functions that
+// have exactly one parameter and can also be computed incrementally
+// as a udf.UDAF
+type incrementalAggFunc struct {
+	singleParamAggFunc
+	newState func() udf.UDAFState
+}
+
+func (f *incrementalAggFunc) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return f.newState(), nil
+}
+
 // twoParamAggFunc is a template for aggregate functions that
 // have exactly two (aggregation) parameters
 type twoParamAggFunc struct {
@@ -71,16 +83,21 @@ func (f *twoParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Valu
 //
 //  Input: anything (aggregated)
 //  Return Type: Int
-var countFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		// count() is O(n) in the spirit of PostgreSQL
-		c := int64(0)
-		for _, item := range arr {
-			if item.Type() != data.TypeNull {
-				c++
+var countFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			// count() is O(n) in the spirit of PostgreSQL
+			c := int64(0)
+			for _, item := range arr {
+				if item.Type() != data.TypeNull {
+					c++
+				}
 			}
-		}
-		return data.Int(c), nil
+			return data.Int(c), nil
+		},
+	},
+	newState: func() udf.UDAFState {
+		return &countAccumulator{}
 	},
 }
 
@@ -108,34 +125,39 @@ var arrayAggFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: Float (Null on empty input)
-var avgFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		count := int64(0)
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				sum += float64(i)
-				count++
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				count++
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var avgFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if count == 0 {
-			// only null inputs
-			return data.Null{}, nil
-		}
-		return data.Float(sum / float64(count)), nil
+			sum := float64(0.0)
+			count := int64(0)
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					sum += float64(i)
+					count++
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					count++
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if count == 0 {
+				// only null inputs
+				return data.Null{}, nil
+			}
+			return data.Float(sum / float64(count)), nil
+		},
+	},
+	newState: func() udf.UDAFState {
+		return &avgAccumulator{}
 	},
 }
 
@@ -197,36 +219,41 @@ var medianFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Bool (aggregated)
 //  Return Type: Bool (Null on empty input)
-var boolAndFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		result := true
-		onlyNulls := true
-		for _, item := range arr {
-			if item.Type() == data.TypeBool {
-				b, _ := data.AsBool(item)
-				if !b {
-					result = b
-					// note that if we break here, we will not notice
-					// if there are un-boolable values further below
-					// and therefore become dependent on the order
-					// of rows, which is not good. therefore we do
-					// not break here.
+var boolAndFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
+			}
+			result := true
+			onlyNulls := true
+			for _, item := range arr {
+				if item.Type() == data.TypeBool {
+					b, _ := data.AsBool(item)
+					if !b {
+						result = b
+						// note that if we break here, we will not notice
+						// if there are un-boolable values further below
+						// and therefore become dependent on the order
+						// of rows, which is not good. therefore we do
+						// not break here.
+					}
+					onlyNulls = false
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a bool",
+						item, item)
 				}
-				onlyNulls = false
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a bool",
-					item, item)
 			}
-		}
-		if onlyNulls {
-			return data.Null{}, nil
-		}
-		return data.Bool(result), nil
+			if onlyNulls {
+				return data.Null{}, nil
+			}
+			return data.Bool(result), nil
+		},
+	},
+	newState: func() udf.UDAFState {
+		return &boolAccumulator{and: true}
 	},
 }
 
@@ -238,36 +265,41 @@ var boolAndFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Bool (aggregated)
 //  Return Type: Bool (Null on empty input)
-var boolOrFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		result := false
-		onlyNulls := true
-		for _, item := range arr {
-			if item.Type() == data.TypeBool {
-				b, _ := data.AsBool(item)
-				if b {
-					result = b
-					// note that if we break here, we will not notice
-					// if there are un-boolable values further below
-					// and therefore become dependent on the order
-					// of rows, which is not good. therefore we do
-					// not break here.
+var boolOrFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
+			}
+			result := false
+			onlyNulls := true
+			for _, item := range arr {
+				if item.Type() == data.TypeBool {
+					b, _ := data.AsBool(item)
+					if b {
+						result = b
+						// note that if we break here, we will not notice
+						// if there are un-boolable values further below
+						// and therefore become dependent on the order
+						// of rows, which is not good. therefore we do
+						// not break here.
+					}
+					onlyNulls = false
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a bool",
+						item, item)
 				}
-				onlyNulls = false
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a bool",
-					item, item)
 			}
-		}
-		if onlyNulls {
-			return data.Null{}, nil
-		}
-		return data.Bool(result), nil
+			if onlyNulls {
+				return data.Null{}, nil
+			}
+			return data.Bool(result), nil
+		},
+	},
+	newState: func() udf.UDAFState {
+		return &boolAccumulator{and: false}
 	},
 }
 
@@ -322,66 +354,74 @@ var jsonObjectAggFunc udf.UDF = &twoParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as maximal input value (Null on empty input)
-var maxFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
-			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			maxTime, _ := data.AsTimestamp(arr[firstNonNull])
-			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if maxTime.Sub(t).Seconds() < 0 {
-						maxTime = t
-					}
-				} else if item.Type() == data.TypeNull {
-					continue
-				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
-						item, item)
-				}
-			}
-			return data.Timestamp(maxTime), nil
+var maxFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: maxValue,
+	},
+	newState: func() udf.UDAFState {
+		return newExtremumAccumulator(maxValue)
+	},
+}
+
+// maxValue computes the result of maxFunc.
+func maxValue(arr []data.Value) (data.Value, error) {
+	if len(arr) == 0 {
+		return data.Null{}, nil
+	}
+	// deal with the case of leading nulls and only nulls
+	firstNonNull := -1
+	for i, item := range arr {
+		if item.Type() != data.TypeNull {
+			firstNonNull = i
+			break
 		}
-		// else: numeric
-		maxFloat := -float64(math.MaxFloat64)
-		maxInt := int64(math.MinInt64)
+	}
+	if firstNonNull == -1 {
+		return data.Null{}, nil
+	}
+	// if we have timestamp-shaped data
+	if arr[firstNonNull].Type() == data.TypeTimestamp {
+		maxTime, _ := data.AsTimestamp(arr[firstNonNull])
 		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i > maxInt {
-					maxInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f > maxFloat {
-					maxFloat = f
+			if item.Type() == data.TypeTimestamp {
+				t, _ := data.AsTimestamp(item)
+				if maxTime.Sub(t).Seconds() < 0 {
+					maxTime = t
 				}
 			} else if item.Type() == data.TypeNull {
 				continue
 			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
 					item, item)
 			}
 		}
-		if float64(maxInt) >= maxFloat {
-			return data.Int(maxInt), nil
+		return data.Timestamp(maxTime), nil
+	}
+	// else: numeric
+	maxFloat := -float64(math.MaxFloat64)
+	maxInt := int64(math.MinInt64)
+	for _, item := range arr[firstNonNull:] {
+		if item.Type() == data.TypeInt {
+			i, _ := data.AsInt(item)
+			if i > maxInt {
+				maxInt = i
+			}
+		} else if item.Type() == data.TypeFloat {
+			f, _ := data.AsFloat(item)
+			if f > maxFloat {
+				maxFloat = f
+			}
+		} else if item.Type() == data.TypeNull {
+			continue
+		} else {
+			return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				item, item)
 		}
-		return data.Float(maxFloat), nil
-	},
+	}
+	if float64(maxInt) >= maxFloat {
+		return data.Int(maxInt), nil
+	}
+	return data.Float(maxFloat), nil
 }
 
 // minFunc is an aggregate function that computes the minimum
@@ -392,66 +432,74 @@ var maxFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as minimal input value (Null on empty input)
-var minFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
-			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			minTime, _ := data.AsTimestamp(arr[firstNonNull])
-			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if minTime.Sub(t).Seconds() > 0 {
-						minTime = t
-					}
-				} else if item.Type() == data.TypeNull {
-					continue
-				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
-						item, item)
-				}
-			}
-			return data.Timestamp(minTime), nil
+var minFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: minValue,
+	},
+	newState: func() udf.UDAFState {
+		return newExtremumAccumulator(minValue)
+	},
+}
+
+// minValue computes the result of minFunc.
+func minValue(arr []data.Value) (data.Value, error) {
+	if len(arr) == 0 {
+		return data.Null{}, nil
+	}
+	// deal with the case of leading nulls and only nulls
+	firstNonNull := -1
+	for i, item := range arr {
+		if item.Type() != data.TypeNull {
+			firstNonNull = i
+			break
 		}
-		// else: numeric
-		minFloat := float64(math.MaxFloat64)
-		minInt := int64(math.MaxInt64)
+	}
+	if firstNonNull == -1 {
+		return data.Null{}, nil
+	}
+	// if we have timestamp-shaped data
+	if arr[firstNonNull].Type() == data.TypeTimestamp {
+		minTime, _ := data.AsTimestamp(arr[firstNonNull])
 		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i < minInt {
-					minInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f < minFloat {
-					minFloat = f
+			if item.Type() == data.TypeTimestamp {
+				t, _ := data.AsTimestamp(item)
+				if minTime.Sub(t).Seconds() > 0 {
+					minTime = t
 				}
 			} else if item.Type() == data.TypeNull {
 				continue
 			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
 					item, item)
 			}
 		}
-		if float64(minInt) <= minFloat {
-			return data.Int(minInt), nil
+		return data.Timestamp(minTime), nil
+	}
+	// else: numeric
+	minFloat := float64(math.MaxFloat64)
+	minInt := int64(math.MaxInt64)
+	for _, item := range arr[firstNonNull:] {
+		if item.Type() == data.TypeInt {
+			i, _ := data.AsInt(item)
+			if i < minInt {
+				minInt = i
+			}
+		} else if item.Type() == data.TypeFloat {
+			f, _ := data.AsFloat(item)
+			if f < minFloat {
+				minFloat = f
+			}
+		} else if item.Type() == data.TypeNull {
+			continue
+		} else {
+			return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+				item, item)
 		}
-		return data.Float(minFloat), nil
-	},
+	}
+	if float64(minInt) <= minFloat {
+		return data.Int(minInt), nil
+	}
+	return data.Float(minFloat), nil
 }
 
 type stringAggFuncTmpl struct {
@@ -513,49 +561,54 @@ var stringAggFunc udf.UDF = &stringAggFuncTmpl{}
 //  Input: Int or Float (aggregated)
 //  Return Type: Float if the input contains a Float, Int otherwise
 //   (Null on empty input)
-var sumFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		intSum := int64(0)
-		hadFloat := false
-		onlyNulls := true
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				// if intSum overflows here, so be it. maybe later
-				// additions will fix the situation again. if we
-				// try to detect this here and return an error, we
-				// become dependent on the input order of numbers.
-				intSum += i
-				f := float64(i)
-				sum += f
-				onlyNulls = false
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				hadFloat = true
-				onlyNulls = false
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var sumFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if onlyNulls {
-			return data.Null{}, nil
-		}
-		if !hadFloat {
-			// if we had only integers, return the integer sum
-			// (this is better than converting the float sum
-			// back to int64 because we inherit Go's way of dealing
-			// with overflows)
-			return data.Int(intSum), nil
-		}
-		return data.Float(sum), nil
+			sum := float64(0.0)
+			intSum := int64(0)
+			hadFloat := false
+			onlyNulls := true
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					// if intSum overflows here, so be it. maybe later
+					// additions will fix the situation again. if we
+					// try to detect this here and return an error, we
+					// become dependent on the input order of numbers.
+					intSum += i
+					f := float64(i)
+					sum += f
+					onlyNulls = false
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					hadFloat = true
+					onlyNulls = false
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if onlyNulls {
+				return data.Null{}, nil
+			}
+			if !hadFloat {
+				// if we had only integers, return the integer sum
+				// (this is better than converting the float sum
+				// back to int64 because we inherit Go's way of dealing
+				// with overflows)
+				return data.Int(intSum), nil
+			}
+			return data.Float(sum), nil
+		},
+	},
+	newState: func() udf.UDAFState {
+		return &sumAccumulator{}
 	},
 }
 
@@ -252,6 +252,64 @@ func TestUnaryAggregateFuncs(t *testing.T) {
 				})
 			}
 
+			if agg, ok := f.(udf.UDAF); ok {
+				for i, tc := range testCase.inputs {
+					tc := tc
+					arr, err := data.AsArray(tc.input)
+					if err != nil {
+						continue
+					}
+
+					Convey(fmt.Sprintf("[%d] When adding %s to an accumulator", i, tc.input), func() {
+						acc, err := agg.Init(nil)
+						So(err, ShouldBeNil)
+						err = func() error {
+							for _, v := range arr {
+								if err := acc.Accumulate(nil, v); err != nil {
+									return err
+								}
+							}
+							// adding and retracting a value must not
+							// change the result
+							if len(arr) > 0 {
+								if err := acc.Accumulate(nil, arr[0]); err != nil {
+									return err
+								}
+								if err := acc.Retract(nil, arr[0]); err != nil {
+									return err
+								}
+							}
+							return nil
+						}()
+						var val data.Value
+						if err == nil {
+							val, err = acc.Finalize(nil)
+						}
+
+						if tc.expected == nil {
+							Convey("Then it should fail", func() {
+								So(err, ShouldNotBeNil)
+							})
+						} else {
+							Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+								So(err, ShouldBeNil)
+								if val.Type() == data.TypeFloat && tc.expected.Type() == data.TypeFloat {
+									fActual, _ := data.AsFloat(val)
+									fExpected, _ := data.AsFloat(tc.expected)
+									if math.IsInf(fExpected, 0) {
+										So(math.IsInf(fActual, 0), ShouldBeTrue)
+									} else {
+										So(val, ShouldAlmostEqual, tc.expected, 0.0000001)
+									}
+								} else {
+									So(val, ShouldResemble, tc.expected)
+								}
+							})
+						}
+					})
+				}
+			}
+
 			Convey("Then it should equal the one in the default registry", func() {
 				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 1)
 				if dispatcher, ok := regFun.(*arityDispatcher); ok {
@@ -374,3 +432,92 @@ func TestBinaryAggregateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestSumAccumulator(t *testing.T) {
+	Convey("Given an accumulator of sum", t, func() {
+		acc, err := sumFunc.(udf.UDAF).Init(nil)
+		So(err, ShouldBeNil)
+
+		Convey("When adding and retracting values of different magnitudes", func() {
+			So(acc.Accumulate(nil, data.Float(1e20)), ShouldBeNil)
+			So(acc.Accumulate(nil, data.Float(1)), ShouldBeNil)
+			So(acc.Retract(nil, data.Float(1e20)), ShouldBeNil)
+
+			Convey("Then the result should be exact", func() {
+				v, err := acc.Finalize(nil)
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(1))
+			})
+		})
+
+		Convey("When retracting all Float values", func() {
+			So(acc.Accumulate(nil, data.Float(0.1)), ShouldBeNil)
+			So(acc.Accumulate(nil, data.Float(0.2)), ShouldBeNil)
+			So(acc.Accumulate(nil, data.Int(3)), ShouldBeNil)
+			So(acc.Retract(nil, data.Float(0.2)), ShouldBeNil)
+			So(acc.Retract(nil, data.Float(0.1)), ShouldBeNil)
+			So(acc.Accumulate(nil, data.Float(0.3)), ShouldBeNil)
+
+			Convey("Then no rounding error should be left", func() {
+				v, err := acc.Finalize(nil)
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(3.3))
+			})
+		})
+
+		Convey("When adding NaN", func() {
+			So(acc.Accumulate(nil, data.Float(1)), ShouldBeNil)
+			So(acc.Accumulate(nil, data.Float(math.NaN())), ShouldBeNil)
+
+			Convey("Then the result should be NaN", func() {
+				v, err := acc.Finalize(nil)
+				So(err, ShouldBeNil)
+				f, _ := data.AsFloat(v)
+				So(math.IsNaN(f), ShouldBeTrue)
+			})
+
+			Convey("And retracting it", func() {
+				So(acc.Retract(nil, data.Float(math.NaN())), ShouldBeNil)
+
+				Convey("Then the result should be finite again", func() {
+					v, err := acc.Finalize(nil)
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Float(1))
+				})
+			})
+		})
+
+		Convey("When adding infinities", func() {
+			So(acc.Accumulate(nil, data.Float(math.Inf(1))), ShouldBeNil)
+
+			Convey("Then the result should be infinite", func() {
+				v, err := acc.Finalize(nil)
+				So(err, ShouldBeNil)
+				f, _ := data.AsFloat(v)
+				So(math.IsInf(f, 1), ShouldBeTrue)
+			})
+
+			Convey("And adding an infinity of the opposite sign", func() {
+				So(acc.Accumulate(nil, data.Float(math.Inf(-1))), ShouldBeNil)
+
+				Convey("Then the result should be NaN", func() {
+					v, err := acc.Finalize(nil)
+					So(err, ShouldBeNil)
+					f, _ := data.AsFloat(v)
+					So(math.IsNaN(f), ShouldBeTrue)
+				})
+			})
+
+			Convey("And retracting it", func() {
+				So(acc.Retract(nil, data.Float(math.Inf(1))), ShouldBeNil)
+				So(acc.Accumulate(nil, data.Float(2)), ShouldBeNil)
+
+				Convey("Then the result should be finite again", func() {
+					v, err := acc.Finalize(nil)
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Float(2))
+				})
+			})
+		})
+	})
+}
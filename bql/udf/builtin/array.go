@@ -5,6 +5,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 )
 
 // arrayLengthFunc returns the length of the given array.
@@ -23,3 +24,189 @@ var arrayLengthFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Val
 	}
 	return nil, fmt.Errorf("%v is not an array", arg)
 })
+
+// arrayContainsFunc returns true if the array contains the given value.
+// Values are compared like with the `=` operator, so Int(2) matches
+// Float(2.0). If the value is NULL, the result is NULL.
+//
+// It can be used in BQL as `array_contains`.
+//
+//  Input: Array, Any
+//  Return Type: Bool
+var arrayContainsFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arg data.Value, v data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull || v.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arg)
+	}
+	for _, elem := range a {
+		if elem.Type() != data.TypeNull && data.Equal(elem, v) {
+			return data.Bool(true), nil
+		}
+	}
+	return data.Bool(false), nil
+})
+
+// sliceArray returns the elements from start (inclusive) to end
+// (exclusive) of an array. Both indexes start from zero and are counted
+// from the end of the array if they are negative, just like the slice
+// `a[start:end]` in a JSON Path. When end is omitted, the slice extends
+// to the end of the array.
+func sliceArray(args ...data.Value) (data.Value, error) {
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	a, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", args[0])
+	}
+	bound := func(v data.Value) (int, error) {
+		i, err := data.AsInt(v)
+		if err != nil {
+			return 0, fmt.Errorf("%v is not an integer", v)
+		}
+		if i < 0 {
+			i += int64(len(a))
+		}
+		if i < 0 {
+			return 0, nil
+		} else if i > int64(len(a)) {
+			return len(a), nil
+		}
+		return int(i), nil
+	}
+	start, err := bound(args[1])
+	if err != nil {
+		return nil, err
+	}
+	end := len(a)
+	if len(args) > 2 {
+		if end, err = bound(args[2]); err != nil {
+			return nil, err
+		}
+	}
+	if start >= end {
+		return data.Array{}, nil
+	}
+	// copy the values into a new array
+	return append(data.Array{}, a[start:end]...), nil
+}
+
+// arraySliceFunc returns a part of an array. See sliceArray for the
+// meaning of the indexes.
+//
+// It can be used in BQL as `array_slice`.
+//
+//  Input: Array, Int, [Int]
+//  Return Type: Array
+var arraySliceFunc udf.UDF = &arityDispatcher{
+	binary: udf.BinaryFunc(func(ctx *core.Context, a, start data.Value) (data.Value, error) {
+		return sliceArray(a, start)
+	}),
+	ternary: udf.TernaryFunc(func(ctx *core.Context, a, start, end data.Value) (data.Value, error) {
+		return sliceArray(a, start, end)
+	}),
+}
+
+// arrayConcatFunc concatenates all arrays given as input arguments.
+// Null values are ignored (treated like []), non-array arguments
+// lead to an error.
+//
+// It can be used in BQL as `array_concat`.
+//
+//  Input: n * Array
+//  Return Type: Array
+var arrayConcatFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		output := data.Array{}
+		for _, item := range args {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			a, err := data.AsArray(item)
+			if err != nil {
+				return nil, fmt.Errorf("%v is not an array", item)
+			}
+			output = append(output, a...)
+		}
+		return output, nil
+	},
+}
+
+// arrayDistinctFunc removes duplicate elements from an array. The first
+// occurrence of each element is kept in the original order. Elements
+// are compared like with the `=` operator, except that NULL elements
+// are considered to be equal.
+//
+// It can be used in BQL as `array_distinct`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayDistinctFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arg)
+	}
+	output := data.Array{}
+	seen := map[data.HashValue][]data.Value{}
+	for _, elem := range a {
+		h := data.Hash(elem)
+		duplicate := false
+		for _, v := range seen[h] {
+			if data.Equal(v, elem) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			seen[h] = append(seen[h], elem)
+			output = append(output, elem)
+		}
+	}
+	return output, nil
+})
+
+// sortableArray sorts values in the order defined by data.Less.
+type sortableArray data.Array
+
+func (a sortableArray) Len() int {
+	return len(a)
+}
+
+func (a sortableArray) Less(i, j int) bool {
+	return data.Less(a[i], a[j])
+}
+
+func (a sortableArray) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+
+// arraySortFunc sorts the elements of an array in ascending order.
+// Values of different types are ordered like in ORDER BY, i.e., NULL
+// elements come first, followed by Bool, numeric, String, Blob,
+// Timestamp, Array, Map, and Interval values.
+//
+// It can be used in BQL as `array_sort`.
+//
+//  Input: Array
+//  Return Type: Array
+var arraySortFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	a, err := data.AsArray(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not an array", arg)
+	}
+	output := append(data.Array{}, a...)
+	sort.Stable(sortableArray(output))
+	return output, nil
+})
@@ -80,3 +80,109 @@ func TestUnaryArrayFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestArrayFuncs(t *testing.T) {
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"array_contains", arrayContainsFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(1), data.Int(2)}, data.Int(2)}, data.Bool(true)},
+			{[]data.Value{data.Array{data.Int(1), data.Int(3)}, data.Float(3)}, data.Bool(true)},
+			{[]data.Value{data.Array{data.Int(1), data.Int(2)}, data.String("2")}, data.Bool(false)},
+			{[]data.Value{data.Array{data.Null{}, data.Map{"a": data.Int(1)}}, data.Map{"a": data.Int(1)}}, data.Bool(true)},
+			{[]data.Value{data.Array{data.Null{}}, data.Null{}}, data.Null{}},
+			{[]data.Value{data.Null{}, data.Int(1)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(1), data.Int(1)}, nil},
+		}},
+		{"array_slice", arraySliceFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(1), data.Int(2), data.Int(3)}, data.Int(1), data.Int(2)},
+				data.Array{data.Int(2)}},
+			{[]data.Value{data.Array{data.Int(1), data.Int(2), data.Int(3)}, data.Int(1)},
+				data.Array{data.Int(2), data.Int(3)}},
+			{[]data.Value{data.Array{data.Int(1), data.Int(2), data.Int(3)}, data.Int(-2), data.Int(-1)},
+				data.Array{data.Int(2)}},
+			{[]data.Value{data.Array{data.Int(1), data.Int(2), data.Int(3)}, data.Int(-5), data.Int(5)},
+				data.Array{data.Int(1), data.Int(2), data.Int(3)}},
+			{[]data.Value{data.Array{data.Int(1), data.Int(2), data.Int(3)}, data.Int(2), data.Int(1)},
+				data.Array{}},
+			{[]data.Value{data.Null{}, data.Int(1), data.Int(2)}, data.Null{}},
+			{[]data.Value{data.Array{data.Int(1)}, data.Null{}, data.Int(2)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Map{}, data.Int(1), data.Int(2)}, nil},
+			{[]data.Value{data.Array{data.Int(1)}, data.String("1"), data.Int(2)}, nil},
+		}},
+		{"array_concat", arrayConcatFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(1)}, data.Null{}, data.Array{data.Int(2), data.Int(1)}},
+				data.Array{data.Int(1), data.Int(2), data.Int(1)}},
+			{[]data.Value{data.Array{data.Int(1)}}, data.Array{data.Int(1)}},
+			{[]data.Value{data.Null{}}, data.Array{}},
+			// invalid cases
+			{[]data.Value{data.Array{data.Int(1)}, data.Int(2)}, nil},
+		}},
+		{"array_distinct", arrayDistinctFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(2), data.Int(1), data.Float(2), data.Null{}, data.String("1"), data.Null{}, data.Int(1)}},
+				data.Array{data.Int(2), data.Int(1), data.Null{}, data.String("1")}},
+			{[]data.Value{data.Array{data.Array{data.Int(1)}, data.Array{data.Int(1)}}},
+				data.Array{data.Array{data.Int(1)}}},
+			{[]data.Value{data.Array{}}, data.Array{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Map{}}, nil},
+		}},
+		{"array_sort", arraySortFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(3), data.String("a"), data.Float(1.5), data.Null{}, data.Int(1)}},
+				data.Array{data.Null{}, data.Int(1), data.Float(1.5), data.Int(3), data.String("a")}},
+			{[]data.Value{data.Array{}}, data.Array{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("a")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, len(allInputs[0].input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given an array", t, func() {
+		a := data.Array{data.Int(3), data.Int(1), data.Int(2)}
+
+		Convey("When sorting or slicing it", func() {
+			_, err := arraySortFunc.Call(nil, a)
+			So(err, ShouldBeNil)
+			s, err := arraySliceFunc.Call(nil, a, data.Int(0))
+			So(err, ShouldBeNil)
+			s.(data.Array)[0] = data.Int(4)
+
+			Convey("Then the original array should not be modified", func() {
+				So(a, ShouldResemble, data.Array{data.Int(3), data.Int(1), data.Int(2)})
+			})
+		})
+	})
+}
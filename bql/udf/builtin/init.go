@@ -45,16 +45,25 @@ func init() {
 	udf.RegisterGlobalUDF("concat_ws", concatWsFunc)
 	udf.RegisterGlobalUDF("format", formatFunc)
 	udf.RegisterGlobalUDF("lower", lowerFunc)
+	udf.RegisterGlobalUDF("lpad", lpadFunc)
 	udf.RegisterGlobalUDF("ltrim", &arityDispatcher{
 		unary: ltrimSpaceFunc, binary: ltrimFunc})
 	udf.RegisterGlobalUDF("md5", md5Func)
 	udf.RegisterGlobalUDF("octet_length", octetLengthFunc)
 	udf.RegisterGlobalUDF("overlay", &arityDispatcher{
 		ternary: overlayFunc, quaternary: overlayFunc})
+	udf.RegisterGlobalUDF("regexp_match", regexpMatchFunc)
+	udf.RegisterGlobalUDF("regexp_replace", regexpReplaceFunc)
+	udf.RegisterGlobalUDF("regexp_split_to_array", regexpSplitToArrayFunc)
+	udf.RegisterGlobalUDF("repeat", repeatFunc)
+	udf.RegisterGlobalUDF("reverse", reverseFunc)
+	udf.RegisterGlobalUDF("rpad", rpadFunc)
 	udf.RegisterGlobalUDF("rtrim", &arityDispatcher{
 		unary: rtrimSpaceFunc, binary: rtrimFunc})
 	udf.RegisterGlobalUDF("sha1", sha1Func)
 	udf.RegisterGlobalUDF("sha256", sha256Func)
+	udf.RegisterGlobalUDF("split_part", splitPartFunc)
+	udf.RegisterGlobalUDF("starts_with", startsWithFunc)
 	udf.RegisterGlobalUDF("strpos", strposFunc)
 	udf.RegisterGlobalUDF("substring", &arityDispatcher{
 		binary: substringFunc, ternary: substringFunc})
@@ -64,8 +73,26 @@ func init() {
 	// time functions
 	udf.RegisterGlobalUDF("distance_us", diffUsFunc)
 	udf.RegisterGlobalUDF("clock_timestamp", clockTimestampFunc)
+	udf.RegisterGlobalUDF("date_bin", dateBinFunc)
+	udf.RegisterGlobalUDF("date_part", datePartFunc)
+	udf.RegisterGlobalUDF("date_trunc", dateTruncFunc)
+	udf.RegisterGlobalUDF("extract", datePartFunc)
+	udf.RegisterGlobalUDF("timezone", timezoneFunc)
+	udf.RegisterGlobalUDF("to_char", toCharFunc)
+	udf.RegisterGlobalUDF("to_timestamp", toTimestampFunc)
 	// array functions
+	udf.RegisterGlobalUDF("array_concat", arrayConcatFunc)
+	udf.RegisterGlobalUDF("array_contains", arrayContainsFunc)
+	udf.RegisterGlobalUDF("array_distinct", arrayDistinctFunc)
 	udf.RegisterGlobalUDF("array_length", arrayLengthFunc)
+	udf.RegisterGlobalUDF("array_slice", arraySliceFunc)
+	udf.RegisterGlobalUDF("array_sort", arraySortFunc)
+	udf.RegisterGlobalUDSFCreator("unnest", udf.MustConvertToUDSFCreator(createUnnestUDSF))
+	// map functions
+	udf.RegisterGlobalUDF("map_keys", mapKeysFunc)
+	udf.RegisterGlobalUDF("map_merge", mapMergeFunc)
+	udf.RegisterGlobalUDF("map_remove", mapRemoveFunc)
+	udf.RegisterGlobalUDF("map_values", mapValuesFunc)
 	// aggregate functions
 	udf.RegisterGlobalUDF("array_agg", arrayAggFunc)
 	udf.RegisterGlobalUDF("avg", avgFunc)
@@ -78,6 +105,33 @@ func init() {
 	udf.RegisterGlobalUDF("min", minFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	// statistical aggregate functions
+	udf.RegisterGlobalUDF("corr", corrFunc)
+	udf.RegisterGlobalUDF("covar_pop", covarPopFunc)
+	udf.RegisterGlobalUDF("covar_samp", covarSampFunc)
+	udf.RegisterGlobalUDF("ewma", ewmaFunc)
+	udf.RegisterGlobalUDF("first", firstFunc)
+	udf.RegisterGlobalUDF("last", lastFunc)
+	udf.RegisterGlobalUDF("mode", modeFunc)
+	udf.RegisterGlobalUDF("percentile_cont", percentileContFunc)
+	udf.RegisterGlobalUDF("percentile_disc", percentileDiscFunc)
+	udf.RegisterGlobalUDF("stddev", stddevSampFunc)
+	udf.RegisterGlobalUDF("stddev_pop", stddevPopFunc)
+	udf.RegisterGlobalUDF("stddev_samp", stddevSampFunc)
+	udf.RegisterGlobalUDF("variance", varSampFunc)
+	udf.RegisterGlobalUDF("var_pop", varPopFunc)
+	udf.RegisterGlobalUDF("var_samp", varSampFunc)
+	// approximate aggregate functions
+	udf.RegisterGlobalUDF("approx_count_distinct", approxCountDistinctFunc)
+	udf.RegisterGlobalUDF("approx_most_frequent", approxMostFrequentFunc)
+	udf.RegisterGlobalUDF("approx_percentile", approxPercentileFunc)
+	// sketch states
+	udf.RegisterGlobalUDSCreator("count_min_sketch", countMinSketchType)
+	udf.RegisterGlobalUDSCreator("hyperloglog", hyperLogLogType)
+	udf.RegisterGlobalUDSCreator("tdigest", tDigestType)
+	udf.RegisterGlobalUDF("count_min_sketch_estimate", countMinSketchEstimateFunc)
+	udf.RegisterGlobalUDF("hyperloglog_cardinality", hyperLogLogCardinalityFunc)
+	udf.RegisterGlobalUDF("tdigest_quantile", tDigestQuantileFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
@@ -0,0 +1,130 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// sortedMapKeys returns the keys of a map in ascending order so that
+// the results of map_keys and map_values are deterministic.
+func sortedMapKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mapKeysFunc returns the keys of a map in ascending order.
+//
+// It can be used in BQL as `map_keys`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapKeysFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	output := make(data.Array, 0, len(m))
+	for _, k := range sortedMapKeys(m) {
+		output = append(output, data.String(k))
+	}
+	return output, nil
+})
+
+// mapValuesFunc returns the values of a map in the order of their
+// keys, i.e., in the same order as map_keys returns the keys.
+//
+// It can be used in BQL as `map_values`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapValuesFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	output := make(data.Array, 0, len(m))
+	for _, k := range sortedMapKeys(m) {
+		output = append(output, m[k])
+	}
+	return output, nil
+})
+
+// mapMergeFunc merges all maps given as input arguments into a new
+// map. When a key exists in multiple maps, the value of the last one
+// is used. Nested maps are not merged recursively. Null values are
+// ignored (treated like {}), non-map arguments lead to an error.
+//
+// It can be used in BQL as `map_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		output := data.Map{}
+		for _, item := range args {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			m, err := data.AsMap(item)
+			if err != nil {
+				return nil, fmt.Errorf("%v is not a map", item)
+			}
+			for k, v := range m {
+				output[k] = v
+			}
+		}
+		return output, nil
+	},
+}
+
+// mapRemoveFunc returns a copy of a map without the given keys.
+// Keys that do not exist in the map and Null keys are ignored.
+//
+// It can be used in BQL as `map_remove`.
+//
+//  Input: Map, n * String
+//  Return Type: Map
+var mapRemoveFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if args[0].Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		m, err := data.AsMap(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%v is not a map", args[0])
+		}
+		removed := map[string]bool{}
+		for _, item := range args[1:] {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			k, err := data.AsString(item)
+			if err != nil {
+				return nil, fmt.Errorf("%v is not a string", item)
+			}
+			removed[k] = true
+		}
+		output := make(data.Map, len(m))
+		for k, v := range m {
+			if !removed[k] {
+				output[k] = v
+			}
+		}
+		return output, nil
+	},
+}
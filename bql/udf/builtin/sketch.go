@@ -0,0 +1,440 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// This file has probabilistic data structures ("sketches") that summarize
+// a stream of values in a bounded amount of memory. They are used by
+// approximate aggregate functions and by the corresponding UDSs.
+
+const (
+	// sketchFormatVersion is the version of the binary format of all
+	// sketches in this file. It's written as the first byte.
+	sketchFormatVersion = 1
+
+	hllMinPrecision     = 4
+	hllMaxPrecision     = 18
+	hllDefaultPrecision = 14
+
+	tDigestDefaultCompression = 100
+
+	cmsDefaultWidth = 2048
+	cmsDefaultDepth = 4
+)
+
+// hashValue returns a well-mixed 64-bit hash of a value. Values that are
+// equal according to data.Equal have the same hash.
+func hashValue(v data.Value) uint64 {
+	// data.Hash uses FNV-1a whose higher bits aren't mixed well enough
+	// for HyperLogLog, so the finalizer of MurmurHash3 is applied.
+	h := uint64(data.Hash(v))
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// hyperLogLog estimates the number of distinct values. It uses
+// 2^precision bytes of memory and its relative standard error is
+// about 1.04/sqrt(2^precision).
+type hyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func newHyperLogLog(precision int) (*hyperLogLog, error) {
+	if precision < hllMinPrecision || precision > hllMaxPrecision {
+		return nil, fmt.Errorf("precision must be in [%v, %v]: %v",
+			hllMinPrecision, hllMaxPrecision, precision)
+	}
+	return &hyperLogLog{
+		precision: uint8(precision),
+		registers: make([]uint8, 1<<uint(precision)),
+	}, nil
+}
+
+func (h *hyperLogLog) add(hash uint64) {
+	idx := hash >> (64 - h.precision)
+	// the guard bit limits the rank to 64-precision+1
+	w := hash<<h.precision | 1<<(h.precision-1)
+	rank := uint8(bits.LeadingZeros64(w)) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+func (h *hyperLogLog) count() int64 {
+	m := float64(len(h.registers))
+	var alpha float64
+	switch len(h.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	e := alpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		// linear counting is more accurate for small cardinalities
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int64(e + 0.5)
+}
+
+func (h *hyperLogLog) merge(o *hyperLogLog) error {
+	if h.precision != o.precision {
+		return fmt.Errorf("cannot merge HyperLogLogs with different precisions: %v and %v",
+			h.precision, o.precision)
+	}
+	for i, r := range o.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+func (h *hyperLogLog) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 2+len(h.registers))
+	b = append(b, sketchFormatVersion, h.precision)
+	return append(b, h.registers...), nil
+}
+
+func (h *hyperLogLog) UnmarshalBinary(b []byte) error {
+	if len(b) < 2 || b[0] != sketchFormatVersion {
+		return errors.New("unsupported format of HyperLogLog")
+	}
+	n, err := newHyperLogLog(int(b[1]))
+	if err != nil {
+		return err
+	}
+	if len(b)-2 != len(n.registers) {
+		return errors.New("the number of HyperLogLog registers doesn't match its precision")
+	}
+	copy(n.registers, b[2:])
+	*h = *n
+	return nil
+}
+
+// centroid is a cluster of values in a tDigest.
+type centroid struct {
+	mean  float64
+	count float64
+}
+
+type centroidList []centroid
+
+func (l centroidList) Len() int {
+	return len(l)
+}
+
+func (l centroidList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (l centroidList) Less(i, j int) bool {
+	return l[i].mean < l[j].mean
+}
+
+// tDigest estimates quantiles of a distribution. It is the merging
+// variant of t-digest by Ted Dunning: values are buffered and merged
+// into centroids whose size is limited by the compression parameter,
+// with smaller centroids near the tails of the distribution. The number
+// of centroids is O(compression).
+type tDigest struct {
+	compression float64
+	centroids   centroidList
+	buffer      centroidList
+	count       float64
+	min         float64
+	max         float64
+}
+
+func newTDigest(compression float64) (*tDigest, error) {
+	if !(compression >= 10 && compression <= 10000) {
+		return nil, fmt.Errorf("compression must be in [10, 10000]: %v", compression)
+	}
+	return &tDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}, nil
+}
+
+func (t *tDigest) add(x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return fmt.Errorf("cannot add %v to t-digest", x)
+	}
+	t.addCentroid(centroid{x, 1})
+	return nil
+}
+
+func (t *tDigest) addCentroid(c centroid) {
+	t.buffer = append(t.buffer, c)
+	t.count += c.count
+	t.min = math.Min(t.min, c.mean)
+	t.max = math.Max(t.max, c.mean)
+	if len(t.buffer) >= int(5*t.compression) {
+		t.compress()
+	}
+}
+
+// compress merges the buffered values into the centroids.
+func (t *tDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+	all := append(t.centroids, t.buffer...)
+	sort.Sort(all)
+	t.buffer = t.buffer[:0]
+
+	// k is the scale function k1 of the t-digest paper. Centroids
+	// can be merged as long as their q-range spans at most 1 in k.
+	k := func(q float64) float64 {
+		return t.compression / (2 * math.Pi) * math.Asin(2*q-1)
+	}
+	kInv := func(k float64) float64 {
+		return (math.Sin(k*2*math.Pi/t.compression) + 1) / 2
+	}
+
+	merged := make(centroidList, 0, len(all))
+	cur := all[0]
+	soFar := 0.0
+	qLimit := kInv(k(0) + 1)
+	for _, c := range all[1:] {
+		if (soFar+cur.count+c.count)/t.count <= qLimit {
+			cur.count += c.count
+			cur.mean += (c.mean - cur.mean) * c.count / cur.count
+			continue
+		}
+		merged = append(merged, cur)
+		soFar += cur.count
+		qLimit = kInv(k(soFar/t.count) + 1)
+		cur = c
+	}
+	t.centroids = append(merged, cur)
+}
+
+// quantile returns the estimated q-quantile of the added values. It
+// returns NaN if no value has been added.
+func (t *tDigest) quantile(q float64) float64 {
+	t.compress()
+	cs := t.centroids
+	if len(cs) == 0 {
+		return math.NaN()
+	}
+	if len(cs) == 1 {
+		return cs[0].mean
+	}
+
+	// each centroid's values are assumed to be spread evenly around
+	// its mean, so the mean is at the middle of its weight
+	target := q * t.count
+	weightSoFar := cs[0].count / 2
+	if target < weightSoFar {
+		return t.min + (cs[0].mean-t.min)*target/weightSoFar
+	}
+	for i := 0; i < len(cs)-1; i++ {
+		dw := (cs[i].count + cs[i+1].count) / 2
+		if weightSoFar+dw > target {
+			return cs[i].mean + (cs[i+1].mean-cs[i].mean)*(target-weightSoFar)/dw
+		}
+		weightSoFar += dw
+	}
+	last := cs[len(cs)-1]
+	frac := math.Min((target-weightSoFar)/(last.count/2), 1)
+	return last.mean + (t.max-last.mean)*frac
+}
+
+func (t *tDigest) merge(o *tDigest) {
+	for _, cs := range []centroidList{o.centroids, o.buffer} {
+		for _, c := range cs {
+			t.addCentroid(c)
+		}
+	}
+}
+
+func (t *tDigest) MarshalBinary() ([]byte, error) {
+	t.compress()
+	b := bytes.NewBuffer(nil)
+	b.WriteByte(sketchFormatVersion)
+	values := []interface{}{t.compression, t.count, t.min, t.max, uint32(len(t.centroids))}
+	for _, c := range t.centroids {
+		values = append(values, c.mean, c.count)
+	}
+	for _, v := range values {
+		if err := binary.Write(b, binary.LittleEndian, v); err != nil {
+			return nil, err
+		}
+	}
+	return b.Bytes(), nil
+}
+
+func (t *tDigest) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 || b[0] != sketchFormatVersion {
+		return errors.New("unsupported format of t-digest")
+	}
+	r := bytes.NewReader(b[1:])
+	var header struct {
+		Compression, Count, Min, Max float64
+		NumCentroids                 uint32
+	}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	n, err := newTDigest(header.Compression)
+	if err != nil {
+		return err
+	}
+	if int64(r.Len()) != int64(header.NumCentroids)*16 {
+		return errors.New("the number of t-digest centroids doesn't match the data")
+	}
+	n.centroids = make(centroidList, header.NumCentroids)
+	for i := range n.centroids {
+		var c [2]float64
+		if err := binary.Read(r, binary.LittleEndian, &c); err != nil {
+			return err
+		}
+		n.centroids[i] = centroid{c[0], c[1]}
+	}
+	n.count, n.min, n.max = header.Count, header.Min, header.Max
+	*t = *n
+	return nil
+}
+
+// countMinSketch estimates the number of occurrences of values. An
+// estimate is never smaller than the actual number as long as counts
+// are only added, and exceeds it by at most e*total/width with a
+// probability of 1-exp(-depth).
+type countMinSketch struct {
+	width  uint32
+	depth  uint32
+	counts []int64
+	total  int64
+}
+
+func newCountMinSketch(width, depth int) (*countMinSketch, error) {
+	if width < 1 || width > 1<<20 {
+		return nil, fmt.Errorf("width must be in [1, %v]: %v", 1<<20, width)
+	}
+	if depth < 1 || depth > 32 {
+		return nil, fmt.Errorf("depth must be in [1, 32]: %v", depth)
+	}
+	return &countMinSketch{
+		width:  uint32(width),
+		depth:  uint32(depth),
+		counts: make([]int64, width*depth),
+	}, nil
+}
+
+// cell returns the index of the counter of the hash in the i-th row.
+func (s *countMinSketch) cell(hash uint64, i uint32) int {
+	// the hash functions of the rows are derived from two halves of
+	// the hash (Kirsch and Mitzenmacher)
+	h := uint32(hash) + i*uint32(hash>>32)
+	return int(i*s.width + h%s.width)
+}
+
+// add adds c to the count of the hash. c can be negative to remove
+// occurrences that have been added before.
+func (s *countMinSketch) add(hash uint64, c int64) {
+	for i := uint32(0); i < s.depth; i++ {
+		s.counts[s.cell(hash, i)] += c
+	}
+	s.total += c
+}
+
+func (s *countMinSketch) estimate(hash uint64) int64 {
+	min := int64(math.MaxInt64)
+	for i := uint32(0); i < s.depth; i++ {
+		if c := s.counts[s.cell(hash, i)]; c < min {
+			min = c
+		}
+	}
+	if min < 0 {
+		return 0
+	}
+	return min
+}
+
+func (s *countMinSketch) merge(o *countMinSketch) error {
+	if s.width != o.width || s.depth != o.depth {
+		return fmt.Errorf("cannot merge count-min sketches with different sizes: %vx%v and %vx%v",
+			s.width, s.depth, o.width, o.depth)
+	}
+	for i, c := range o.counts {
+		s.counts[i] += c
+	}
+	s.total += o.total
+	return nil
+}
+
+func (s *countMinSketch) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 1, 1+3*binary.MaxVarintLen64+len(s.counts)*2)
+	b[0] = sketchFormatVersion
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, v := range []int64{int64(s.width), int64(s.depth), s.total} {
+		b = append(b, buf[:binary.PutVarint(buf, v)]...)
+	}
+	for _, c := range s.counts {
+		b = append(b, buf[:binary.PutVarint(buf, c)]...)
+	}
+	return b, nil
+}
+
+func (s *countMinSketch) UnmarshalBinary(b []byte) error {
+	if len(b) < 1 || b[0] != sketchFormatVersion {
+		return errors.New("unsupported format of count-min sketch")
+	}
+	r := bytes.NewReader(b[1:])
+	var header [3]int64
+	for i := range header {
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return err
+		}
+		header[i] = v
+	}
+	if header[0] > math.MaxInt32 || header[1] > math.MaxInt32 {
+		return errors.New("invalid size of count-min sketch")
+	}
+	n, err := newCountMinSketch(int(header[0]), int(header[1]))
+	if err != nil {
+		return err
+	}
+	for i := range n.counts {
+		v, err := binary.ReadVarint(r)
+		if err != nil {
+			return err
+		}
+		n.counts[i] = v
+	}
+	if r.Len() != 0 {
+		return errors.New("count-min sketch has extra data")
+	}
+	n.total = header[2]
+	*s = *n
+	return nil
+}
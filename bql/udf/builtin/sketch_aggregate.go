@@ -0,0 +1,289 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// sketchUDAF is a template for approximate aggregate functions that
+// summarize their input in a sketch of bounded size.
+type sketchUDAF struct {
+	arity    int
+	newState func() udf.UDAFState
+}
+
+func (f *sketchUDAF) Accept(arity int) bool {
+	return arity == f.arity
+}
+
+func (f *sketchUDAF) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return f.newState(), nil
+}
+
+func toNumber(v data.Value) (float64, error) {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return float64(i), nil
+	case data.TypeFloat:
+		return data.AsFloat(v)
+	}
+	return 0, fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+}
+
+// approxCountDistinctFunc is an aggregate function that estimates the
+// number of distinct non-null values passed in using HyperLogLog. The
+// relative standard error of the estimate is about 0.8%.
+//
+// It can be used in BQL as `approx_count_distinct`.
+//
+//  Input: anything (aggregated)
+//  Return Type: Int
+var approxCountDistinctFunc = udf.AggregateFunc(&sketchUDAF{
+	arity: 1,
+	newState: func() udf.UDAFState {
+		h, _ := newHyperLogLog(hllDefaultPrecision)
+		return &countDistinctState{h}
+	},
+})
+
+type countDistinctState struct {
+	hll *hyperLogLog
+}
+
+func (s *countDistinctState) Accumulate(ctx *core.Context, args ...data.Value) error {
+	if args[0].Type() != data.TypeNull {
+		s.hll.add(hashValue(args[0]))
+	}
+	return nil
+}
+
+func (s *countDistinctState) Retract(ctx *core.Context, args ...data.Value) error {
+	return udf.ErrRetractionNotSupported
+}
+
+func (s *countDistinctState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*countDistinctState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T into %T", other, s)
+	}
+	return s.hll.merge(o.hll)
+}
+
+func (s *countDistinctState) Finalize(ctx *core.Context) (data.Value, error) {
+	return data.Int(s.hll.count()), nil
+}
+
+// approxPercentileFunc is an aggregate function that estimates the
+// given percentile (as a fraction between 0 and 1) of all input values
+// using t-digest. Null values are ignored, non-numeric values lead to
+// an error. The percentile should be the same for all rows.
+//
+// It can be used in BQL as `approx_percentile`.
+//
+//  Input: Int or Float (aggregated), percentile (Float)
+//  Return Type: Float (Null on empty input)
+var approxPercentileFunc = udf.AggregateFunc(&sketchUDAF{
+	arity: 2,
+	newState: func() udf.UDAFState {
+		t, _ := newTDigest(tDigestDefaultCompression)
+		return &percentileState{digest: t}
+	},
+})
+
+type percentileState struct {
+	digest *tDigest
+	q      float64
+	hasQ   bool
+}
+
+func (s *percentileState) Accumulate(ctx *core.Context, args ...data.Value) error {
+	q, err := toNumber(args[1])
+	if err != nil {
+		return err
+	}
+	if q < 0 || q > 1 {
+		return fmt.Errorf("percentile must be in [0, 1]: %v", q)
+	}
+	s.q, s.hasQ = q, true
+	if args[0].Type() == data.TypeNull {
+		return nil
+	}
+	x, err := toNumber(args[0])
+	if err != nil {
+		return err
+	}
+	return s.digest.add(x)
+}
+
+func (s *percentileState) Retract(ctx *core.Context, args ...data.Value) error {
+	return udf.ErrRetractionNotSupported
+}
+
+func (s *percentileState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*percentileState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T into %T", other, s)
+	}
+	s.digest.merge(o.digest)
+	if !s.hasQ {
+		s.q, s.hasQ = o.q, o.hasQ
+	}
+	return nil
+}
+
+func (s *percentileState) Finalize(ctx *core.Context) (data.Value, error) {
+	if s.digest.count == 0 {
+		return data.Null{}, nil
+	}
+	return data.Float(s.digest.quantile(s.q)), nil
+}
+
+// approxMostFrequentFunc is an aggregate function that estimates the k
+// most frequent non-null values and their numbers of occurrences using a
+// count-min sketch. At most 10*k candidates are tracked, so values that
+// were evicted and become frequent later may be missing in the result.
+// k must be in [1, 1000] and should be the same for all rows.
+//
+// It can be used in BQL as `approx_most_frequent`.
+//
+//  Input: anything (aggregated), k (Int)
+//  Return Type: Array of Maps {"value": value, "count": estimated count},
+//  in descending order of the count
+var approxMostFrequentFunc = udf.AggregateFunc(&sketchUDAF{
+	arity: 2,
+	newState: func() udf.UDAFState {
+		cms, _ := newCountMinSketch(cmsDefaultWidth, cmsDefaultDepth)
+		return &mostFrequentState{
+			cms:        cms,
+			candidates: map[uint64]data.Value{},
+		}
+	},
+})
+
+type mostFrequentState struct {
+	cms        *countMinSketch
+	k          int
+	candidates map[uint64]data.Value
+}
+
+func (s *mostFrequentState) setK(v data.Value) error {
+	k, err := data.AsInt(v)
+	if err != nil {
+		return fmt.Errorf("k must be an integer: %v", v)
+	}
+	if k < 1 || k > 1000 {
+		return fmt.Errorf("k must be in [1, 1000]: %v", k)
+	}
+	s.k = int(k)
+	return nil
+}
+
+func (s *mostFrequentState) Accumulate(ctx *core.Context, args ...data.Value) error {
+	if err := s.setK(args[1]); err != nil {
+		return err
+	}
+	if args[0].Type() == data.TypeNull {
+		return nil
+	}
+	h := hashValue(args[0])
+	s.cms.add(h, 1)
+	s.addCandidate(h, args[0])
+	return nil
+}
+
+// addCandidate tracks the value if there are less than 10*k candidates
+// or if its estimated count is larger than the one of a candidate.
+func (s *mostFrequentState) addCandidate(h uint64, v data.Value) {
+	if _, ok := s.candidates[h]; ok {
+		return
+	}
+	if len(s.candidates) < 10*s.k {
+		s.candidates[h] = v
+		return
+	}
+	minHash, minCount := uint64(0), int64(-1)
+	for ch := range s.candidates {
+		if c := s.cms.estimate(ch); minCount < 0 || c < minCount {
+			minHash, minCount = ch, c
+		}
+	}
+	if s.cms.estimate(h) > minCount {
+		delete(s.candidates, minHash)
+		s.candidates[h] = v
+	}
+}
+
+func (s *mostFrequentState) Retract(ctx *core.Context, args ...data.Value) error {
+	if args[0].Type() == data.TypeNull {
+		return nil
+	}
+	// candidates are kept since their count is estimated again in
+	// Finalize, and the ones that are gone are not returned
+	s.cms.add(hashValue(args[0]), -1)
+	return nil
+}
+
+func (s *mostFrequentState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*mostFrequentState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T into %T", other, s)
+	}
+	if err := s.cms.merge(o.cms); err != nil {
+		return err
+	}
+	if s.k == 0 {
+		s.k = o.k
+	}
+	for h, v := range o.candidates {
+		s.addCandidate(h, v)
+	}
+	return nil
+}
+
+type frequentValue struct {
+	value data.Value
+	count int64
+}
+
+type frequentValueList []frequentValue
+
+func (l frequentValueList) Len() int {
+	return len(l)
+}
+
+func (l frequentValueList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (l frequentValueList) Less(i, j int) bool {
+	if l[i].count != l[j].count {
+		return l[i].count > l[j].count
+	}
+	// make the order deterministic
+	return l[i].value.String() < l[j].value.String()
+}
+
+func (s *mostFrequentState) Finalize(ctx *core.Context) (data.Value, error) {
+	values := make(frequentValueList, 0, len(s.candidates))
+	for h, v := range s.candidates {
+		if c := s.cms.estimate(h); c > 0 {
+			values = append(values, frequentValue{v, c})
+		}
+	}
+	sort.Sort(values)
+	if len(values) > s.k {
+		values = values[:s.k]
+	}
+	res := make(data.Array, len(values))
+	for i, v := range values {
+		res[i] = data.Map{
+			"value": v.value,
+			"count": data.Int(v.count),
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,176 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// repeat returns an array having the value n times.
+func repeat(v data.Value, n int) data.Array {
+	arr := make(data.Array, n)
+	for i := range arr {
+		arr[i] = v
+	}
+	return arr
+}
+
+func TestApproxCountDistinct(t *testing.T) {
+	ctx := core.NewContext(nil)
+	f := approxCountDistinctFunc
+
+	Convey("Given approx_count_distinct", t, func() {
+		Convey("Then it should be an aggregate function", func() {
+			So(f.Accept(1), ShouldBeTrue)
+			So(f.Accept(2), ShouldBeFalse)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+		})
+
+		Convey("Then it should count distinct non-null values", func() {
+			res, err := f.Call(ctx, data.Array{data.Int(1), data.Null{}, data.Float(1),
+				data.String("1"), data.Int(2), data.String("1")})
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, data.Int(3))
+		})
+
+		Convey("Then it should return 0 on empty input", func() {
+			res, err := f.Call(ctx, data.Array{})
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, data.Int(0))
+		})
+
+		Convey("Then its state should not support retraction", func() {
+			s, err := f.(udf.UDAF).Init(ctx)
+			So(err, ShouldBeNil)
+			So(s.Retract(ctx, data.Int(1)), ShouldEqual, udf.ErrRetractionNotSupported)
+		})
+	})
+}
+
+func TestApproxPercentile(t *testing.T) {
+	ctx := core.NewContext(nil)
+	f := approxPercentileFunc
+
+	Convey("Given approx_percentile", t, func() {
+		values := data.Array{}
+		for i := 1; i <= 101; i++ {
+			values = append(values, data.Int(i))
+		}
+		values = append(values, data.Null{})
+
+		Convey("Then it should estimate the percentile", func() {
+			res, err := f.Call(ctx, values, repeat(data.Float(0.5), len(values)))
+			So(err, ShouldBeNil)
+			So(res, ShouldHaveSameTypeAs, data.Float(0))
+			So(res, ShouldAlmostEqual, 51, 1)
+		})
+
+		Convey("Then it should return Null on empty input", func() {
+			res, err := f.Call(ctx, data.Array{data.Null{}}, data.Array{data.Float(0.5)})
+			So(err, ShouldBeNil)
+			So(res, ShouldResemble, data.Null{})
+		})
+
+		Convey("Then it should fail with a non-numeric value", func() {
+			_, err := f.Call(ctx, data.Array{data.String("a")}, data.Array{data.Float(0.5)})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then it should fail with an invalid percentile", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1)}, data.Array{data.Float(1.5)})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("Then merging states should estimate the percentile of both", func() {
+			udaf := f.(udf.UDAF)
+			s1, err := udaf.Init(ctx)
+			So(err, ShouldBeNil)
+			s2, err := udaf.Init(ctx)
+			So(err, ShouldBeNil)
+			for i := 1; i <= 100; i++ {
+				So(s1.Accumulate(ctx, data.Int(i), data.Float(0)), ShouldBeNil)
+				So(s2.Accumulate(ctx, data.Int(100+i), data.Float(0)), ShouldBeNil)
+			}
+			So(s1.Merge(ctx, s2), ShouldBeNil)
+			res, err := s1.Finalize(ctx)
+			So(err, ShouldBeNil)
+			So(res, ShouldEqual, data.Float(1))
+		})
+	})
+}
+
+func TestApproxMostFrequent(t *testing.T) {
+	ctx := core.NewContext(nil)
+	f := approxMostFrequentFunc
+
+	Convey("Given approx_most_frequent", t, func() {
+		values := data.Array{}
+		for i := 0; i < 5; i++ {
+			for j := 0; j <= i; j++ {
+				values = append(values, data.Int(i))
+			}
+		}
+		values = append(values, data.Null{}, data.Null{}, data.Null{}, data.Null{}, data.Null{}, data.Null{})
+
+		Convey("Then it should return the most frequent values", func() {
+			res, err := f.Call(ctx, values, repeat(data.Int(2), len(values)))
+			So(err, ShouldBeNil)
+			So(res, ShouldResemble, data.Array{
+				data.Map{"value": data.Int(4), "count": data.Int(5)},
+				data.Map{"value": data.Int(3), "count": data.Int(4)},
+			})
+		})
+
+		Convey("Then it should fail with an invalid k", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1)}, data.Array{data.Int(0)})
+			So(err, ShouldNotBeNil)
+			_, err = f.Call(ctx, data.Array{data.Int(1)}, data.Array{data.String("a")})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("When retracting values from its state", func() {
+			s, err := f.(udf.UDAF).Init(ctx)
+			So(err, ShouldBeNil)
+			for _, v := range values {
+				So(s.Accumulate(ctx, v, data.Int(2)), ShouldBeNil)
+			}
+			for i := 0; i < 4; i++ {
+				So(s.Retract(ctx, data.Int(4), data.Int(2)), ShouldBeNil)
+			}
+
+			Convey("Then the result should reflect the retraction", func() {
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, data.Array{
+					data.Map{"value": data.Int(3), "count": data.Int(4)},
+					data.Map{"value": data.Int(2), "count": data.Int(3)},
+				})
+			})
+		})
+
+		Convey("When there are more distinct values than candidates", func() {
+			s, err := f.(udf.UDAF).Init(ctx)
+			So(err, ShouldBeNil)
+			for i := 0; i < 100; i++ {
+				So(s.Accumulate(ctx, data.Int(i), data.Int(1)), ShouldBeNil)
+			}
+			for i := 0; i < 3; i++ {
+				So(s.Accumulate(ctx, data.String("frequent"), data.Int(1)), ShouldBeNil)
+			}
+
+			Convey("Then the number of candidates should be bounded", func() {
+				So(len(s.(*mostFrequentState).candidates), ShouldEqual, 10)
+			})
+
+			Convey("Then a frequent value should still be found", func() {
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldResemble, data.Array{
+					data.Map{"value": data.String("frequent"), "count": data.Int(3)},
+				})
+			})
+		})
+	})
+}
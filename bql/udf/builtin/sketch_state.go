@@ -0,0 +1,352 @@
+package builtin
+
+import (
+	"bufio"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"io/ioutil"
+	"math"
+	"sync"
+)
+
+// sketch is a data structure in sketch.go that can be used as a UDS.
+type sketch interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+
+	// addValue adds a non-null value to the sketch.
+	addValue(v data.Value) error
+}
+
+func (h *hyperLogLog) addValue(v data.Value) error {
+	h.add(hashValue(v))
+	return nil
+}
+
+func (t *tDigest) addValue(v data.Value) error {
+	x, err := toNumber(v)
+	if err != nil {
+		return err
+	}
+	return t.add(x)
+}
+
+func (s *countMinSketch) addValue(v data.Value) error {
+	s.add(hashValue(v), 1)
+	return nil
+}
+
+// sketchType creates sketches of one type and is used as a UDSLoader.
+type sketchType struct {
+	name string
+	// create creates a new sketch with the parameters given by
+	// the CREATE STATE statement
+	create func(params data.Map) (sketch, error)
+	// empty returns a sketch that saved data can be unmarshaled to
+	empty func() sketch
+}
+
+// sketchState is a UDS that adds a field of the tuples written to it to
+// a sketch. It can be saved and loaded. The field is specified by the
+// "field" parameter of the CREATE STATE statement, which is a JSON Path.
+// Tuples that don't have the field lead to an error, Null values are
+// ignored.
+type sketchState struct {
+	m      sync.Mutex
+	typ    *sketchType
+	field  string
+	path   data.Path
+	sketch sketch
+}
+
+// sketchStateFormatVersion is the version of the format written by
+// sketchState.Save.
+const sketchStateFormatVersion = 1
+
+func (t *sketchType) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	v, ok := params["field"]
+	if !ok {
+		return nil, errors.New("field parameter is missing")
+	}
+	field, err := data.AsString(v)
+	if err != nil {
+		return nil, fmt.Errorf("field parameter must be a string: %v", err)
+	}
+	path, err := data.CompilePath(field)
+	if err != nil {
+		return nil, fmt.Errorf("field parameter has an invalid path: %v", err)
+	}
+	sk, err := t.create(params)
+	if err != nil {
+		return nil, err
+	}
+	return &sketchState{
+		typ:    t,
+		field:  field,
+		path:   path,
+		sketch: sk,
+	}, nil
+}
+
+func (t *sketchType) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	s := &sketchState{typ: t}
+	if err := s.Load(ctx, r, params); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *sketchState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (s *sketchState) Write(ctx *core.Context, t *core.Tuple) error {
+	v, err := t.Data.Get(s.path)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.sketch.addValue(v)
+}
+
+// Save writes the version of the format, the length of the field path
+// as an uvarint, the field path, and the binary form of the sketch.
+func (s *sketchState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.Lock()
+	b, err := s.sketch.MarshalBinary()
+	s.m.Unlock()
+	if err != nil {
+		return err
+	}
+
+	header := make([]byte, 1+binary.MaxVarintLen64, 1+binary.MaxVarintLen64+len(s.field))
+	header[0] = sketchStateFormatVersion
+	header = header[:1+binary.PutUvarint(header[1:], uint64(len(s.field)))]
+	header = append(header, s.field...)
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func (s *sketchState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	br := bufio.NewReader(r)
+	if v, err := br.ReadByte(); err != nil {
+		return err
+	} else if v != sketchStateFormatVersion {
+		return fmt.Errorf("unsupported format version of %v state: %v", s.typ.name, v)
+	}
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return err
+	}
+	if n > math.MaxInt16 {
+		return fmt.Errorf("field path of %v state is too long: %v", s.typ.name, n)
+	}
+	field := make([]byte, n)
+	if _, err := io.ReadFull(br, field); err != nil {
+		return err
+	}
+	path, err := data.CompilePath(string(field))
+	if err != nil {
+		return err
+	}
+	b, err := ioutil.ReadAll(br)
+	if err != nil {
+		return err
+	}
+	sk := s.typ.empty()
+	if err := sk.UnmarshalBinary(b); err != nil {
+		return err
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.field, s.path, s.sketch = string(field), path, sk
+	return nil
+}
+
+// intParam returns the integer value of the parameter or def if it's missing.
+func intParam(params data.Map, name string, def int64) (int64, error) {
+	v, ok := params[name]
+	if !ok {
+		return def, nil
+	}
+	i, err := data.ToInt(v)
+	if err != nil {
+		return 0, fmt.Errorf("%v parameter must be an integer: %v", name, err)
+	}
+	return i, nil
+}
+
+// hyperLogLogType is a UDS type that estimates the number of distinct
+// values of a field.
+//
+// It can be used in BQL as `hyperloglog`.
+//
+//  Parameters:
+//   field: a JSON Path to the field (required)
+//   precision: the logarithm of the number of registers in [4, 18]
+//    (default 14)
+var hyperLogLogType = &sketchType{
+	name: "hyperloglog",
+	create: func(params data.Map) (sketch, error) {
+		p, err := intParam(params, "precision", hllDefaultPrecision)
+		if err != nil {
+			return nil, err
+		}
+		return newHyperLogLog(int(p))
+	},
+	empty: func() sketch {
+		return &hyperLogLog{}
+	},
+}
+
+// tDigestType is a UDS type that estimates quantiles of a numeric field.
+//
+// It can be used in BQL as `tdigest`.
+//
+//  Parameters:
+//   field: a JSON Path to the field (required)
+//   compression: the compression in [10, 10000], which bounds the
+//    number of centroids (default 100)
+var tDigestType = &sketchType{
+	name: "tdigest",
+	create: func(params data.Map) (sketch, error) {
+		c := float64(tDigestDefaultCompression)
+		if v, ok := params["compression"]; ok {
+			var err error
+			if c, err = data.ToFloat(v); err != nil {
+				return nil, fmt.Errorf("compression parameter must be a number: %v", err)
+			}
+		}
+		return newTDigest(c)
+	},
+	empty: func() sketch {
+		return &tDigest{}
+	},
+}
+
+// countMinSketchType is a UDS type that estimates the number of
+// occurrences of values of a field.
+//
+// It can be used in BQL as `count_min_sketch`.
+//
+//  Parameters:
+//   field: a JSON Path to the field (required)
+//   width: the number of counters per row (default 2048)
+//   depth: the number of rows (default 4)
+var countMinSketchType = &sketchType{
+	name: "count_min_sketch",
+	create: func(params data.Map) (sketch, error) {
+		w, err := intParam(params, "width", cmsDefaultWidth)
+		if err != nil {
+			return nil, err
+		}
+		d, err := intParam(params, "depth", cmsDefaultDepth)
+		if err != nil {
+			return nil, err
+		}
+		if w > math.MaxInt32 || d > math.MaxInt32 {
+			return nil, fmt.Errorf("the size of count-min sketch is too large: %vx%v", w, d)
+		}
+		return newCountMinSketch(int(w), int(d))
+	},
+	empty: func() sketch {
+		return &countMinSketch{}
+	},
+}
+
+// lookupSketchState returns the UDS having the given name if it has
+// the given type.
+func lookupSketchState(ctx *core.Context, name data.Value, typ *sketchType) (*sketchState, error) {
+	n, err := data.AsString(name)
+	if err != nil {
+		return nil, fmt.Errorf("the name of the state must be a string: %v", name)
+	}
+	st, err := ctx.SharedStates.Get(n)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*sketchState)
+	if !ok || s.typ != typ {
+		return nil, fmt.Errorf("state '%v' isn't a %v state", n, typ.name)
+	}
+	return s, nil
+}
+
+// hyperLogLogCardinalityFunc returns the estimated number of distinct
+// values added to a hyperloglog state.
+//
+// It can be used in BQL as `hyperloglog_cardinality`.
+//
+//  Input: the name of a hyperloglog state (String)
+//  Return Type: Int
+var hyperLogLogCardinalityFunc = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupSketchState(ctx, name, hyperLogLogType)
+	if err != nil {
+		return nil, err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Int(s.sketch.(*hyperLogLog).count()), nil
+})
+
+// tDigestQuantileFunc returns the estimated quantile of the values added
+// to a tdigest state.
+//
+// It can be used in BQL as `tdigest_quantile`.
+//
+//  Input: the name of a tdigest state (String), quantile in [0, 1] (Float)
+//  Return Type: Float (Null if no value was added)
+var tDigestQuantileFunc = udf.BinaryFunc(func(ctx *core.Context, name, quantile data.Value) (data.Value, error) {
+	q, err := toNumber(quantile)
+	if err != nil {
+		return nil, err
+	}
+	if q < 0 || q > 1 {
+		return nil, fmt.Errorf("quantile must be in [0, 1]: %v", q)
+	}
+	s, err := lookupSketchState(ctx, name, tDigestType)
+	if err != nil {
+		return nil, err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	t := s.sketch.(*tDigest)
+	if t.count == 0 {
+		return data.Null{}, nil
+	}
+	return data.Float(t.quantile(q)), nil
+})
+
+// countMinSketchEstimateFunc returns the estimated number of occurrences
+// of a value in a count_min_sketch state.
+//
+// It can be used in BQL as `count_min_sketch_estimate`.
+//
+//  Input: the name of a count_min_sketch state (String), value (any)
+//  Return Type: Int
+var countMinSketchEstimateFunc = udf.BinaryFunc(func(ctx *core.Context, name, v data.Value) (data.Value, error) {
+	s, err := lookupSketchState(ctx, name, countMinSketchType)
+	if err != nil {
+		return nil, err
+	}
+	if v.Type() == data.TypeNull {
+		return data.Int(0), nil
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	return data.Int(s.sketch.(*countMinSketch).estimate(hashValue(v))), nil
+})
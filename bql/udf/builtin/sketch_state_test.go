@@ -0,0 +1,128 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestSketchStates(t *testing.T) {
+	cases := []struct {
+		typ    *sketchType
+		params data.Map
+		query  func(ctx *core.Context) (data.Value, error)
+	}{
+		{hyperLogLogType, data.Map{"precision": data.Int(10)},
+			func(ctx *core.Context) (data.Value, error) {
+				return hyperLogLogCardinalityFunc.Call(ctx, data.String("s"))
+			}},
+		{tDigestType, data.Map{"compression": data.Int(50)},
+			func(ctx *core.Context) (data.Value, error) {
+				return tDigestQuantileFunc.Call(ctx, data.String("s"), data.Float(0.5))
+			}},
+		{countMinSketchType, data.Map{"width": data.Int(100), "depth": data.Int(2)},
+			func(ctx *core.Context) (data.Value, error) {
+				return countMinSketchEstimateFunc.Call(ctx, data.String("s"), data.Int(3))
+			}},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey("Given a "+c.typ.name+" state", t, func() {
+			ctx := core.NewContext(nil)
+			c.params["field"] = data.String("a.b")
+			st, err := c.typ.CreateState(ctx, c.params)
+			So(err, ShouldBeNil)
+			So(ctx.SharedStates.Add("s", c.typ.name, st), ShouldBeNil)
+			s := st.(*sketchState)
+
+			Convey("When writing tuples to it", func() {
+				for i := 0; i < 7; i++ {
+					t := core.NewTuple(data.Map{"a": data.Map{"b": data.Int(i % 4)}})
+					So(s.Write(ctx, t), ShouldBeNil)
+				}
+				So(s.Write(ctx, core.NewTuple(data.Map{"a": data.Map{"b": data.Null{}}})), ShouldBeNil)
+				res, err := c.query(ctx)
+				So(err, ShouldBeNil)
+
+				Convey("Then it should be queried", func() {
+					switch c.typ {
+					case hyperLogLogType:
+						So(res, ShouldEqual, data.Int(4))
+					case tDigestType:
+						So(res, ShouldEqual, data.Float(1))
+					case countMinSketchType:
+						So(res, ShouldEqual, data.Int(1))
+					}
+				})
+
+				Convey("Then it should fail with a tuple without the field", func() {
+					So(s.Write(ctx, core.NewTuple(data.Map{"a": data.Int(1)})), ShouldNotBeNil)
+				})
+
+				Convey("And saving it", func() {
+					buf := bytes.NewBuffer(nil)
+					So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+					saved := buf.Bytes()
+
+					Convey("Then a state loaded from the data should have the same result", func() {
+						loaded, err := c.typ.LoadState(ctx, bytes.NewReader(saved), data.Map{})
+						So(err, ShouldBeNil)
+						_, err = ctx.SharedStates.Replace("s", c.typ.name, loaded)
+						So(err, ShouldBeNil)
+						So(loaded.(*sketchState).field, ShouldEqual, "a.b")
+						r, err := c.query(ctx)
+						So(err, ShouldBeNil)
+						So(r, ShouldResemble, res)
+					})
+
+					Convey("Then the state should be overwritten by the data", func() {
+						So(s.Write(ctx, core.NewTuple(data.Map{"a": data.Map{"b": data.Int(3)}})), ShouldBeNil)
+						So(s.Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+						r, err := c.query(ctx)
+						So(err, ShouldBeNil)
+						So(r, ShouldResemble, res)
+					})
+
+					Convey("Then loading truncated data should fail", func() {
+						_, err := c.typ.LoadState(ctx, bytes.NewReader(saved[:len(saved)-1]), data.Map{})
+						So(err, ShouldNotBeNil)
+					})
+				})
+			})
+
+			Convey("Then a query for a state of another type should fail", func() {
+				for _, o := range cases {
+					if o.typ != c.typ {
+						_, err := o.query(ctx)
+						So(err, ShouldNotBeNil)
+					}
+				}
+			})
+		})
+	}
+
+	Convey("Given invalid parameters", t, func() {
+		ctx := core.NewContext(nil)
+		invalid := []struct {
+			typ    *sketchType
+			params data.Map
+		}{
+			{hyperLogLogType, data.Map{}},
+			{hyperLogLogType, data.Map{"field": data.Int(1)}},
+			{hyperLogLogType, data.Map{"field": data.String("a[")}},
+			{hyperLogLogType, data.Map{"field": data.String("a"), "precision": data.Int(30)}},
+			{tDigestType, data.Map{"field": data.String("a"), "compression": data.String("a")}},
+			{countMinSketchType, data.Map{"field": data.String("a"), "width": data.Int(0)}},
+		}
+
+		Convey("Then creating states should fail", func() {
+			for _, c := range invalid {
+				_, err := c.typ.CreateState(ctx, c.params)
+				So(err, ShouldNotBeNil)
+			}
+		})
+	})
+}
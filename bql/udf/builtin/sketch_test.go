@@ -0,0 +1,230 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	Convey("Given a HyperLogLog", t, func() {
+		h, err := newHyperLogLog(hllDefaultPrecision)
+		So(err, ShouldBeNil)
+
+		Convey("When adding a few distinct values", func() {
+			for i := 0; i < 3; i++ {
+				for _, v := range []data.Value{data.Int(1), data.Float(2.0), data.String("a")} {
+					h.add(hashValue(v))
+				}
+			}
+
+			Convey("Then the count should be exact", func() {
+				So(h.count(), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When adding many distinct values", func() {
+			n := 100000
+			for i := 0; i < n; i++ {
+				h.add(hashValue(data.Int(i)))
+			}
+
+			Convey("Then the count should be close to the actual number", func() {
+				So(h.count(), ShouldAlmostEqual, n, float64(n)*0.03)
+			})
+
+			Convey("Then it should be restored from its binary form", func() {
+				b, err := h.MarshalBinary()
+				So(err, ShouldBeNil)
+				h2 := &hyperLogLog{}
+				So(h2.UnmarshalBinary(b), ShouldBeNil)
+				So(h2, ShouldResemble, h)
+			})
+
+			Convey("Then merging an overlapping HyperLogLog should count the union", func() {
+				o, err := newHyperLogLog(hllDefaultPrecision)
+				So(err, ShouldBeNil)
+				for i := n / 2; i < 2*n; i++ {
+					o.add(hashValue(data.Int(i)))
+				}
+				So(h.merge(o), ShouldBeNil)
+				So(h.count(), ShouldAlmostEqual, 2*n, float64(2*n)*0.03)
+			})
+		})
+
+		Convey("Then it cannot be merged with one of a different precision", func() {
+			o, err := newHyperLogLog(hllMinPrecision)
+			So(err, ShouldBeNil)
+			So(h.merge(o), ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given invalid precisions", t, func() {
+		for _, p := range []int{hllMinPrecision - 1, hllMaxPrecision + 1} {
+			Convey(fmt.Sprintf("Then creating a HyperLogLog with %v should fail", p), func() {
+				_, err := newHyperLogLog(p)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+
+	Convey("Given invalid binary data", t, func() {
+		cases := [][]byte{{}, {2, 4}, {1, 30}, {1, 4, 0}}
+		for i, b := range cases {
+			b := b
+			Convey(fmt.Sprintf("Then unmarshaling case %v should fail", i), func() {
+				So((&hyperLogLog{}).UnmarshalBinary(b), ShouldNotBeNil)
+			})
+		}
+	})
+}
+
+func TestTDigest(t *testing.T) {
+	Convey("Given a t-digest", t, func() {
+		d, err := newTDigest(tDigestDefaultCompression)
+		So(err, ShouldBeNil)
+
+		Convey("When no value has been added", func() {
+			Convey("Then the quantile should be NaN", func() {
+				So(math.IsNaN(d.quantile(0.5)), ShouldBeTrue)
+			})
+		})
+
+		Convey("When adding a single value", func() {
+			So(d.add(3), ShouldBeNil)
+
+			Convey("Then all quantiles should be the value", func() {
+				So(d.quantile(0), ShouldEqual, 3)
+				So(d.quantile(0.5), ShouldEqual, 3)
+				So(d.quantile(1), ShouldEqual, 3)
+			})
+		})
+
+		Convey("When adding many values", func() {
+			n := 10000
+			r := rand.New(rand.NewSource(1))
+			for _, i := range r.Perm(n) {
+				So(d.add(float64(i+1)), ShouldBeNil)
+			}
+
+			Convey("Then the number of centroids should be bounded", func() {
+				d.compress()
+				So(len(d.centroids), ShouldBeLessThan, 2*tDigestDefaultCompression)
+			})
+
+			Convey("Then the quantiles should be close to the actual ones", func() {
+				So(d.quantile(0), ShouldEqual, 1)
+				So(d.quantile(1), ShouldEqual, n)
+				for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+					So(d.quantile(q), ShouldAlmostEqual, q*float64(n), float64(n)*0.005)
+				}
+			})
+
+			Convey("Then it should be restored from its binary form", func() {
+				b, err := d.MarshalBinary()
+				So(err, ShouldBeNil)
+				d2 := &tDigest{}
+				So(d2.UnmarshalBinary(b), ShouldBeNil)
+				So(d2.quantile(0.3), ShouldEqual, d.quantile(0.3))
+				So(d2.count, ShouldEqual, n)
+			})
+
+			Convey("Then merging another t-digest should estimate the quantiles of both", func() {
+				o, err := newTDigest(tDigestDefaultCompression)
+				So(err, ShouldBeNil)
+				for i := 0; i < n; i++ {
+					So(o.add(float64(n+i+1)), ShouldBeNil)
+				}
+				d.merge(o)
+				So(d.quantile(0.5), ShouldAlmostEqual, n, float64(n)*0.01)
+				So(d.quantile(1), ShouldEqual, 2*n)
+			})
+		})
+
+		Convey("Then adding NaN or infinity should fail", func() {
+			So(d.add(math.NaN()), ShouldNotBeNil)
+			So(d.add(math.Inf(1)), ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given invalid binary data", t, func() {
+		d, err := newTDigest(tDigestDefaultCompression)
+		So(err, ShouldBeNil)
+		So(d.add(1), ShouldBeNil)
+		b, err := d.MarshalBinary()
+		So(err, ShouldBeNil)
+
+		Convey("Then unmarshaling truncated data should fail", func() {
+			So((&tDigest{}).UnmarshalBinary(b[:len(b)-1]), ShouldNotBeNil)
+			So((&tDigest{}).UnmarshalBinary(b[:10]), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestCountMinSketch(t *testing.T) {
+	Convey("Given a count-min sketch", t, func() {
+		s, err := newCountMinSketch(cmsDefaultWidth, cmsDefaultDepth)
+		So(err, ShouldBeNil)
+
+		Convey("When adding values with a skewed distribution", func() {
+			counts := map[int]int64{}
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 100000; i++ {
+				v := int(r.ExpFloat64() * 100)
+				counts[v]++
+				s.add(hashValue(data.Int(v)), 1)
+			}
+
+			Convey("Then the estimates should never be smaller than the actual counts", func() {
+				for v, c := range counts {
+					est := s.estimate(hashValue(data.Int(v)))
+					So(est, ShouldBeGreaterThanOrEqualTo, c)
+					So(est, ShouldBeLessThanOrEqualTo, c+100000*3/cmsDefaultWidth)
+				}
+			})
+
+			Convey("Then retracting values should decrease the estimates", func() {
+				before := s.estimate(hashValue(data.Int(0)))
+				s.add(hashValue(data.Int(0)), -counts[0])
+				So(s.estimate(hashValue(data.Int(0))), ShouldBeLessThan, before)
+			})
+
+			Convey("Then it should be restored from its binary form", func() {
+				b, err := s.MarshalBinary()
+				So(err, ShouldBeNil)
+				s2 := &countMinSketch{}
+				So(s2.UnmarshalBinary(b), ShouldBeNil)
+				So(s2, ShouldResemble, s)
+			})
+		})
+
+		Convey("Then it cannot be merged with a sketch of a different size", func() {
+			o, err := newCountMinSketch(cmsDefaultWidth, 1)
+			So(err, ShouldBeNil)
+			So(s.merge(o), ShouldNotBeNil)
+		})
+
+		Convey("Then merging a sketch of the same size should add the counts", func() {
+			o, err := newCountMinSketch(cmsDefaultWidth, cmsDefaultDepth)
+			So(err, ShouldBeNil)
+			s.add(hashValue(data.String("a")), 2)
+			o.add(hashValue(data.String("a")), 3)
+			So(s.merge(o), ShouldBeNil)
+			So(s.estimate(hashValue(data.String("a"))), ShouldEqual, 5)
+			So(s.total, ShouldEqual, 5)
+		})
+	})
+
+	Convey("Given invalid sizes", t, func() {
+		for _, wd := range [][2]int{{0, 1}, {1, 0}, {1 << 21, 1}, {1, 33}} {
+			wd := wd
+			Convey(fmt.Sprintf("Then creating a count-min sketch with %v should fail", wd), func() {
+				_, err := newCountMinSketch(wd[0], wd[1])
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
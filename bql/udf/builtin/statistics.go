@@ -0,0 +1,655 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+	"time"
+)
+
+// paramAggFunc is a template for aggregate functions that have
+// an aggregation parameter followed by a non-aggregation parameter
+type paramAggFunc struct {
+	aggFun func([]data.Value, data.Value) (data.Value, error)
+}
+
+func (f *paramAggFunc) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *paramAggFunc) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *paramAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	return f.aggFun(arr, args[1])
+}
+
+// momentsAccumulator maintains the number, the mean, and the sum of
+// squared deviations from the mean of numeric values using Welford's
+// algorithm, which is numerically more stable than summing squares.
+type momentsAccumulator struct {
+	n    int64
+	mean float64
+	m2   float64
+	// result computes the result of the aggregate function
+	result func(n int64, m2 float64) data.Value
+}
+
+func (a *momentsAccumulator) Accumulate(ctx *core.Context, args ...data.Value) error {
+	v := args[0]
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x, err := toNumber(v)
+	if err != nil {
+		return err
+	}
+	a.n++
+	d := x - a.mean
+	a.mean += d / float64(a.n)
+	a.m2 += d * (x - a.mean)
+	return nil
+}
+
+func (a *momentsAccumulator) Retract(ctx *core.Context, args ...data.Value) error {
+	v := args[0]
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x, err := toNumber(v)
+	if err != nil {
+		return err
+	}
+	if a.n <= 1 {
+		a.n, a.mean, a.m2 = 0, 0, 0
+		return nil
+	}
+	oldMean := a.mean
+	a.mean -= (x - a.mean) / float64(a.n-1)
+	a.m2 -= (x - a.mean) * (x - oldMean)
+	a.n--
+	if a.m2 < 0 {
+		// rounding errors
+		a.m2 = 0
+	}
+	return nil
+}
+
+// Merge combines the moments of two sets of values with the formula of
+// Chan et al.
+func (a *momentsAccumulator) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*momentsAccumulator)
+	if !ok {
+		return mergeError(a, other)
+	}
+	if o.n == 0 {
+		return nil
+	}
+	n := a.n + o.n
+	d := o.mean - a.mean
+	a.mean += d * float64(o.n) / float64(n)
+	a.m2 += o.m2 + d*d*float64(a.n)*float64(o.n)/float64(n)
+	a.n = n
+	return nil
+}
+
+func (a *momentsAccumulator) Finalize(ctx *core.Context) (data.Value, error) {
+	return a.result(a.n, a.m2), nil
+}
+
+// newMomentsAggFunc creates an aggregate function whose result is
+// computed from the number of non-null input values and the sum of
+// their squared deviations from the mean.
+func newMomentsAggFunc(result func(n int64, m2 float64) data.Value) udf.UDF {
+	return &incrementalAggFunc{
+		singleParamAggFunc: singleParamAggFunc{
+			aggFun: func(arr []data.Value) (data.Value, error) {
+				a := &momentsAccumulator{result: result}
+				for _, item := range arr {
+					if err := a.Accumulate(nil, item); err != nil {
+						return nil, err
+					}
+				}
+				return a.Finalize(nil)
+			},
+		},
+		newState: func() udf.UDAFState {
+			return &momentsAccumulator{result: result}
+		},
+	}
+}
+
+// varSampFunc is an aggregate function that computes the sample
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_samp` or `variance`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are less than two input values)
+var varSampFunc = newMomentsAggFunc(func(n int64, m2 float64) data.Value {
+	if n < 2 {
+		return data.Null{}
+	}
+	return data.Float(m2 / float64(n-1))
+})
+
+// varPopFunc is an aggregate function that computes the population
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var varPopFunc = newMomentsAggFunc(func(n int64, m2 float64) data.Value {
+	if n < 1 {
+		return data.Null{}
+	}
+	return data.Float(m2 / float64(n))
+})
+
+// stddevSampFunc is an aggregate function that computes the sample
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_samp` or `stddev`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are less than two input values)
+var stddevSampFunc = newMomentsAggFunc(func(n int64, m2 float64) data.Value {
+	if n < 2 {
+		return data.Null{}
+	}
+	return data.Float(math.Sqrt(m2 / float64(n-1)))
+})
+
+// stddevPopFunc is an aggregate function that computes the population
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var stddevPopFunc = newMomentsAggFunc(func(n int64, m2 float64) data.Value {
+	if n < 1 {
+		return data.Null{}
+	}
+	return data.Float(math.Sqrt(m2 / float64(n)))
+})
+
+// bivariateUDAF is a template for aggregate functions of pairs of
+// numbers (Y, X) that are computed from their co-moments.
+type bivariateUDAF struct {
+	result func(s *bivariateState) data.Value
+}
+
+func (f *bivariateUDAF) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *bivariateUDAF) Init(ctx *core.Context) (udf.UDAFState, error) {
+	return &bivariateState{udaf: f}, nil
+}
+
+// bivariateState maintains the number of pairs, the means, the sums of
+// squared deviations from the means, and the sum of the products of the
+// deviations of X and Y. Pairs having a Null value are ignored.
+type bivariateState struct {
+	udaf         *bivariateUDAF
+	n            int64
+	meanX, meanY float64
+	m2X, m2Y     float64
+	c            float64
+}
+
+// pair returns false if either of the values is Null.
+func (s *bivariateState) pair(args []data.Value) (y, x float64, ok bool, err error) {
+	if args[0].Type() == data.TypeNull || args[1].Type() == data.TypeNull {
+		return 0, 0, false, nil
+	}
+	if y, err = toNumber(args[0]); err != nil {
+		return 0, 0, false, err
+	}
+	if x, err = toNumber(args[1]); err != nil {
+		return 0, 0, false, err
+	}
+	return y, x, true, nil
+}
+
+func (s *bivariateState) Accumulate(ctx *core.Context, args ...data.Value) error {
+	y, x, ok, err := s.pair(args)
+	if !ok {
+		return err
+	}
+	s.n++
+	dx := x - s.meanX
+	s.meanX += dx / float64(s.n)
+	dy := y - s.meanY
+	s.meanY += dy / float64(s.n)
+	s.m2X += dx * (x - s.meanX)
+	s.m2Y += dy * (y - s.meanY)
+	s.c += dx * (y - s.meanY)
+	return nil
+}
+
+func (s *bivariateState) Retract(ctx *core.Context, args ...data.Value) error {
+	y, x, ok, err := s.pair(args)
+	if !ok {
+		return err
+	}
+	if s.n <= 1 {
+		*s = bivariateState{udaf: s.udaf}
+		return nil
+	}
+	n := float64(s.n - 1)
+	meanX := s.meanX - (x-s.meanX)/n
+	meanY := s.meanY - (y-s.meanY)/n
+	s.m2X -= (x - meanX) * (x - s.meanX)
+	s.m2Y -= (y - meanY) * (y - s.meanY)
+	s.c -= (x - meanX) * (y - s.meanY)
+	s.n--
+	s.meanX, s.meanY = meanX, meanY
+	if s.m2X < 0 {
+		s.m2X = 0
+	}
+	if s.m2Y < 0 {
+		s.m2Y = 0
+	}
+	return nil
+}
+
+func (s *bivariateState) Merge(ctx *core.Context, other udf.UDAFState) error {
+	o, ok := other.(*bivariateState)
+	if !ok {
+		return fmt.Errorf("cannot merge %T into %T", other, s)
+	}
+	if o.n == 0 {
+		return nil
+	}
+	if s.n == 0 {
+		*s = *o
+		return nil
+	}
+	n := float64(s.n + o.n)
+	w := float64(s.n) * float64(o.n) / n
+	dx := o.meanX - s.meanX
+	dy := o.meanY - s.meanY
+	s.m2X += o.m2X + dx*dx*w
+	s.m2Y += o.m2Y + dy*dy*w
+	s.c += o.c + dx*dy*w
+	s.meanX += dx * float64(o.n) / n
+	s.meanY += dy * float64(o.n) / n
+	s.n += o.n
+	return nil
+}
+
+func (s *bivariateState) Finalize(ctx *core.Context) (data.Value, error) {
+	return s.udaf.result(s), nil
+}
+
+// corrFunc is an aggregate function that computes the correlation
+// coefficient of pairs of input values. Pairs having a Null value are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `corr`.
+//
+//  Input: Y, X as Int or Float (both aggregated)
+//  Return Type: Float (Null if there are less than two pairs or
+//  either of the variables has no variance)
+var corrFunc = udf.AggregateFunc(&bivariateUDAF{
+	result: func(s *bivariateState) data.Value {
+		if s.n < 2 || s.m2X == 0 || s.m2Y == 0 {
+			return data.Null{}
+		}
+		return data.Float(s.c / math.Sqrt(s.m2X*s.m2Y))
+	},
+})
+
+// covarSampFunc is an aggregate function that computes the sample
+// covariance of pairs of input values. Pairs having a Null value are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_samp`.
+//
+//  Input: Y, X as Int or Float (both aggregated)
+//  Return Type: Float (Null if there are less than two pairs)
+var covarSampFunc = udf.AggregateFunc(&bivariateUDAF{
+	result: func(s *bivariateState) data.Value {
+		if s.n < 2 {
+			return data.Null{}
+		}
+		return data.Float(s.c / float64(s.n-1))
+	},
+})
+
+// covarPopFunc is an aggregate function that computes the population
+// covariance of pairs of input values. Pairs having a Null value are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_pop`.
+//
+//  Input: Y, X as Int or Float (both aggregated)
+//  Return Type: Float (Null on empty input)
+var covarPopFunc = udf.AggregateFunc(&bivariateUDAF{
+	result: func(s *bivariateState) data.Value {
+		if s.n < 1 {
+			return data.Null{}
+		}
+		return data.Float(s.c / float64(s.n))
+	},
+})
+
+// numberValue is a non-null numeric input value of a percentile.
+type numberValue struct {
+	value data.Value
+	x     float64
+}
+
+type numberValueList []numberValue
+
+func (l numberValueList) Len() int {
+	return len(l)
+}
+
+func (l numberValueList) Swap(i, j int) {
+	l[i], l[j] = l[j], l[i]
+}
+
+func (l numberValueList) Less(i, j int) bool {
+	return l[i].x < l[j].x
+}
+
+// percentileAggFunc creates an aggregate function that computes one
+// or more percentiles of the sorted non-null input values. fraction
+// can be a number between 0 and 1 or an array of such numbers, in
+// which case an array of the results is returned.
+func percentileAggFunc(percentile func(l numberValueList, f float64) data.Value) udf.UDF {
+	return &paramAggFunc{
+		aggFun: func(arr []data.Value, fraction data.Value) (data.Value, error) {
+			var fractions []float64
+			switch fraction.Type() {
+			case data.TypeNull:
+				return data.Null{}, nil
+			case data.TypeArray:
+				fa, _ := data.AsArray(fraction)
+				for _, f := range fa {
+					x, err := toNumber(f)
+					if err != nil {
+						return nil, err
+					}
+					fractions = append(fractions, x)
+				}
+			default:
+				x, err := toNumber(fraction)
+				if err != nil {
+					return nil, err
+				}
+				fractions = append(fractions, x)
+			}
+			for _, f := range fractions {
+				if f < 0 || f > 1 || math.IsNaN(f) {
+					return nil, fmt.Errorf("percentile value %v is not between 0 and 1", f)
+				}
+			}
+
+			values := make(numberValueList, 0, len(arr))
+			for _, item := range arr {
+				if item.Type() == data.TypeNull {
+					continue
+				}
+				x, err := toNumber(item)
+				if err != nil {
+					return nil, err
+				}
+				values = append(values, numberValue{item, x})
+			}
+			if len(values) == 0 {
+				return data.Null{}, nil
+			}
+			sort.Stable(values)
+
+			if fraction.Type() != data.TypeArray {
+				return percentile(values, fractions[0]), nil
+			}
+			res := make(data.Array, len(fractions))
+			for i, f := range fractions {
+				res[i] = percentile(values, f)
+			}
+			return res, nil
+		},
+	}
+}
+
+// percentileContFunc is an aggregate function that computes a
+// continuous percentile of all input values, i.e., it interpolates
+// between the adjacent input values if needed. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `percentile_cont`.
+//
+//  Input: Int or Float (aggregated), fraction between 0 and 1
+//   (Float or Array of Floats)
+//  Return Type: Float or Array of Floats (Null on empty input)
+var percentileContFunc = percentileAggFunc(func(l numberValueList, f float64) data.Value {
+	pos := f * float64(len(l)-1)
+	lower := math.Floor(pos)
+	x := l[int(lower)].x
+	if pos > lower {
+		x += (pos - lower) * (l[int(lower)+1].x - x)
+	}
+	return data.Float(x)
+})
+
+// percentileDiscFunc is an aggregate function that computes a discrete
+// percentile of all input values, i.e., it returns the first input
+// value whose position in the ordering equals or exceeds the specified
+// fraction. Null values are ignored, non-numeric values lead to an
+// error.
+//
+// It can be used in BQL as `percentile_disc`.
+//
+//  Input: Int or Float (aggregated), fraction between 0 and 1
+//   (Float or Array of Floats)
+//  Return Type: same as input, or Array of them (Null on empty input)
+var percentileDiscFunc = percentileAggFunc(func(l numberValueList, f float64) data.Value {
+	i := int(math.Ceil(f*float64(len(l)))) - 1
+	if i < 0 {
+		i = 0
+	}
+	return l[i].value
+})
+
+// modeFunc is an aggregate function that returns the most frequent
+// input value. If there are multiple most frequent values, the one
+// that appears first is returned, so the result can be controlled
+// by an ORDER BY clause. Null values are ignored.
+//
+// It can be used in BQL as `mode`.
+//
+//  Input: anything (aggregated)
+//  Return Type: same as input (Null on empty input)
+var modeFunc udf.UDF = &singleParamAggFunc{
+	aggFun: func(arr []data.Value) (data.Value, error) {
+		type modeCandidate struct {
+			valueCount
+			// first is the index of the first occurrence
+			first int
+		}
+		counts := map[data.HashValue][]*modeCandidate{}
+		var mode *modeCandidate
+		for i, item := range arr {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			h := data.Hash(item)
+			var c *modeCandidate
+			for _, vc := range counts[h] {
+				if sameValue(vc.value, item) {
+					c = vc
+					break
+				}
+			}
+			if c == nil {
+				c = &modeCandidate{valueCount{item, 0}, i}
+				counts[h] = append(counts[h], c)
+			}
+			c.count++
+			if mode == nil || c.count > mode.count ||
+				(c.count == mode.count && c.first < mode.first) {
+				mode = c
+			}
+		}
+		if mode == nil {
+			return data.Null{}, nil
+		}
+		return mode.value, nil
+	},
+}
+
+// firstLastAggFunc is a template for aggregate functions that return
+// the first or the last input value. When a second parameter is given,
+// the value having the earliest or latest timestamp is returned.
+// Otherwise, values are taken in the order they are passed in, which
+// can be specified by an ORDER BY clause.
+type firstLastAggFunc struct {
+	last bool
+}
+
+func (f *firstLastAggFunc) Accept(arity int) bool {
+	return arity == 1 || arity == 2
+}
+
+func (f *firstLastAggFunc) IsAggregationParameter(k int) bool {
+	return k == 0 || k == 1
+}
+
+func (f *firstLastAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, fmt.Errorf("function takes one or two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	if len(args) == 1 {
+		return f.byOrder(arr), nil
+	}
+	ts, err := data.AsArray(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[1])
+	}
+	if len(arr) != len(ts) {
+		return nil, fmt.Errorf("arrays have different lengths")
+	}
+	return f.byTimestamp(arr, ts)
+}
+
+func (f *firstLastAggFunc) byOrder(arr data.Array) data.Value {
+	for i := range arr {
+		item := arr[i]
+		if f.last {
+			item = arr[len(arr)-1-i]
+		}
+		if item.Type() != data.TypeNull {
+			return item
+		}
+	}
+	return data.Null{}
+}
+
+func (f *firstLastAggFunc) byTimestamp(arr, ts data.Array) (data.Value, error) {
+	var res data.Value = data.Null{}
+	var resTime time.Time
+	for i, item := range arr {
+		if item.Type() == data.TypeNull || ts[i].Type() == data.TypeNull {
+			continue
+		}
+		t, err := data.AsTimestamp(ts[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+				ts[i], ts[i])
+		}
+		// the earlier value wins for first and the later one for
+		// last if timestamps are equal
+		if res.Type() == data.TypeNull ||
+			(!f.last && t.Before(resTime)) ||
+			(f.last && !t.Before(resTime)) {
+			res, resTime = item, t
+		}
+	}
+	return res, nil
+}
+
+// firstFunc is an aggregate function that returns the first non-null
+// input value, or the one having the earliest timestamp if timestamps
+// are given.
+//
+// It can be used in BQL as `first`.
+//
+//  Input: anything (aggregated), optionally Timestamp (aggregated)
+//  Return Type: same as input (Null on empty input)
+var firstFunc udf.UDF = &firstLastAggFunc{last: false}
+
+// lastFunc is an aggregate function that returns the last non-null
+// input value, or the one having the latest timestamp if timestamps
+// are given.
+//
+// It can be used in BQL as `last`.
+//
+//  Input: anything (aggregated), optionally Timestamp (aggregated)
+//  Return Type: same as input (Null on empty input)
+var lastFunc udf.UDF = &firstLastAggFunc{last: true}
+
+// ewmaFunc is an aggregate function that computes the exponentially
+// weighted moving average of the input values in the order they are
+// passed in, which can be specified by an ORDER BY clause. The first
+// value is used as the initial average and each following value x
+// updates the average to alpha*x + (1-alpha)*average. Null values are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `ewma`.
+//
+//  Input: Int or Float (aggregated), alpha in (0, 1] (Float)
+//  Return Type: Float (Null on empty input)
+var ewmaFunc udf.UDF = &paramAggFunc{
+	aggFun: func(arr []data.Value, alphaValue data.Value) (data.Value, error) {
+		alpha, err := toNumber(alphaValue)
+		if err != nil {
+			return nil, err
+		}
+		if !(alpha > 0 && alpha <= 1) {
+			return nil, fmt.Errorf("alpha must be in (0, 1]: %v", alpha)
+		}
+		var res data.Value = data.Null{}
+		avg := 0.0
+		for _, item := range arr {
+			if item.Type() == data.TypeNull {
+				continue
+			}
+			x, err := toNumber(item)
+			if err != nil {
+				return nil, err
+			}
+			if res.Type() == data.TypeNull {
+				avg = x
+			} else {
+				avg = alpha*x + (1-alpha)*avg
+			}
+			res = data.Float(avg)
+		}
+		return res, nil
+	},
+}
@@ -0,0 +1,415 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestVarianceFuncs(t *testing.T) {
+	// 2, 4, 4, 4, 5, 5, 7, 9 has mean 5 and sum of squared deviations 32
+	input := data.Array{data.Int(2), data.Int(4), data.Null{}, data.Float(4),
+		data.Int(4), data.Int(5), data.Float(5), data.Int(7), data.Int(9)}
+
+	cases := []struct {
+		name     string
+		f        udf.UDF
+		expected float64
+	}{
+		{"var_samp", varSampFunc, 32.0 / 7},
+		{"var_pop", varPopFunc, 4},
+		{"stddev_samp", stddevSampFunc, math.Sqrt(32.0 / 7)},
+		{"stddev_pop", stddevPopFunc, 2},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("Given the %s function", c.name), t, func() {
+			Convey("When evaluating it on numbers", func() {
+				v, err := c.f.Call(nil, input)
+
+				Convey("Then it should return the expected value", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, data.Float(c.expected), 1e-9)
+				})
+			})
+
+			Convey("When computing it incrementally", func() {
+				acc, err := c.f.(udf.UDAF).Init(nil)
+				So(err, ShouldBeNil)
+				So(acc.Accumulate(nil, data.Int(100)), ShouldBeNil)
+				for _, x := range input {
+					So(acc.Accumulate(nil, x), ShouldBeNil)
+				}
+				So(acc.Retract(nil, data.Int(100)), ShouldBeNil)
+				v, err := acc.Finalize(nil)
+
+				Convey("Then retracted values should not affect the result", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, data.Float(c.expected), 1e-9)
+				})
+			})
+
+			Convey("When merging states computed on parts of the input", func() {
+				s1, err := c.f.(udf.UDAF).Init(nil)
+				So(err, ShouldBeNil)
+				s2, err := c.f.(udf.UDAF).Init(nil)
+				So(err, ShouldBeNil)
+				for i, x := range input {
+					if i%2 == 0 {
+						So(s1.Accumulate(nil, x), ShouldBeNil)
+					} else {
+						So(s2.Accumulate(nil, x), ShouldBeNil)
+					}
+				}
+				So(s1.Merge(nil, s2), ShouldBeNil)
+				v, err := s1.Finalize(nil)
+
+				Convey("Then the result should be the same as for the whole input", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, data.Float(c.expected), 1e-9)
+				})
+			})
+
+			Convey("When evaluating it on non-numeric values", func() {
+				_, err := c.f.Call(nil, data.Array{data.Int(1), data.String("a")})
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When evaluating it on empty input", func() {
+				v, err := c.f.Call(nil, data.Array{data.Null{}})
+
+				Convey("Then it should return null", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Null{})
+				})
+			})
+		})
+	}
+
+	Convey("Given the sample variance and standard deviation", t, func() {
+		Convey("When evaluating them on a single value", func() {
+			v, err := varSampFunc.Call(nil, data.Array{data.Int(1)})
+			So(err, ShouldBeNil)
+			s, err := stddevSampFunc.Call(nil, data.Array{data.Int(1)})
+			So(err, ShouldBeNil)
+
+			Convey("Then they should return null", func() {
+				So(v, ShouldResemble, data.Null{})
+				So(s, ShouldResemble, data.Null{})
+			})
+		})
+	})
+}
+
+func TestBivariateFuncs(t *testing.T) {
+	ys := data.Array{data.Int(1), data.Int(3), data.Null{}, data.Float(5), data.Int(9)}
+	xs := data.Array{data.Int(1), data.Int(2), data.Int(3), data.Int(3), data.Null{}}
+	// valid pairs are (1, 1), (3, 2), (5, 3), whose co-moment is 4 and
+	// whose sums of squared deviations are 8 (Y) and 2 (X)
+
+	cases := []struct {
+		name     string
+		f        udf.UDF
+		expected data.Value
+	}{
+		{"corr", corrFunc, data.Float(1)},
+		{"covar_samp", covarSampFunc, data.Float(2)},
+		{"covar_pop", covarPopFunc, data.Float(4.0 / 3)},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("Given the %s function", c.name), t, func() {
+			ctx := core.NewContext(nil)
+
+			Convey("When evaluating it on pairs of numbers", func() {
+				v, err := c.f.Call(ctx, ys, xs)
+
+				Convey("Then it should return the expected value", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, c.expected, 1e-9)
+				})
+			})
+
+			Convey("When accumulating, retracting, and merging states", func() {
+				f := c.f.(udf.UDAF)
+				s1, err := f.Init(ctx)
+				So(err, ShouldBeNil)
+				s2, err := f.Init(ctx)
+				So(err, ShouldBeNil)
+				So(s1.Accumulate(ctx, data.Int(10), data.Int(-4)), ShouldBeNil)
+				for i := range ys {
+					s := s1
+					if i%2 == 1 {
+						s = s2
+					}
+					So(s.Accumulate(ctx, ys[i], xs[i]), ShouldBeNil)
+				}
+				So(s1.Merge(ctx, s2), ShouldBeNil)
+				So(s1.Retract(ctx, data.Int(10), data.Int(-4)), ShouldBeNil)
+				v, err := s1.Finalize(ctx)
+
+				Convey("Then it should return the same value", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldAlmostEqual, c.expected, 1e-9)
+				})
+			})
+
+			Convey("When evaluating it on non-numeric values", func() {
+				_, err := c.f.Call(ctx, data.Array{data.String("a")}, data.Array{data.Int(1)})
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When evaluating it on empty input", func() {
+				v, err := c.f.Call(ctx, data.Array{}, data.Array{})
+
+				Convey("Then it should return null", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Null{})
+				})
+			})
+		})
+	}
+
+	Convey("Given the corr function", t, func() {
+		Convey("When one variable is constant", func() {
+			v, err := corrFunc.Call(nil, data.Array{data.Int(1), data.Int(2)},
+				data.Array{data.Int(3), data.Int(3)})
+
+			Convey("Then it should return null", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+	})
+}
+
+func TestPercentileFuncs(t *testing.T) {
+	input := data.Array{data.Int(4), data.Null{}, data.Int(1), data.Float(3), data.Int(2)}
+
+	Convey("Given the percentile_cont function", t, func() {
+		cases := []struct {
+			fraction data.Value
+			expected data.Value
+		}{
+			{data.Float(0), data.Float(1)},
+			{data.Float(0.5), data.Float(2.5)},
+			{data.Float(0.9), data.Float(3.7)},
+			{data.Int(1), data.Float(4)},
+			{data.Array{data.Float(0.25), data.Float(0.5)},
+				data.Array{data.Float(1.75), data.Float(2.5)}},
+			{data.Null{}, data.Null{}},
+		}
+
+		Convey("When evaluating it with fractions", func() {
+			Convey("Then it should interpolate between values", func() {
+				for _, c := range cases {
+					v, err := percentileContFunc.Call(nil, input, c.fraction)
+					So(err, ShouldBeNil)
+					if arr, ok := c.expected.(data.Array); ok {
+						So(v, ShouldHaveLength, len(arr))
+						for i := range arr {
+							So(v.(data.Array)[i], ShouldAlmostEqual, arr[i], 1e-9)
+						}
+					} else if c.expected.Type() == data.TypeFloat {
+						So(v, ShouldAlmostEqual, c.expected, 1e-9)
+					} else {
+						So(v, ShouldResemble, c.expected)
+					}
+				}
+			})
+		})
+	})
+
+	Convey("Given the percentile_disc function", t, func() {
+		cases := []struct {
+			fraction data.Value
+			expected data.Value
+		}{
+			{data.Float(0), data.Int(1)},
+			{data.Float(0.5), data.Int(2)},
+			{data.Float(0.51), data.Float(3)},
+			{data.Float(1), data.Int(4)},
+			{data.Array{data.Float(0.25), data.Float(0.75)},
+				data.Array{data.Int(1), data.Float(3)}},
+		}
+
+		Convey("When evaluating it with fractions", func() {
+			Convey("Then it should return input values", func() {
+				for _, c := range cases {
+					v, err := percentileDiscFunc.Call(nil, input, c.fraction)
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, c.expected)
+				}
+			})
+		})
+	})
+
+	for name, f := range map[string]udf.UDF{
+		"percentile_cont": percentileContFunc,
+		"percentile_disc": percentileDiscFunc,
+	} {
+		f := f
+		Convey(fmt.Sprintf("Given the %s function and invalid input", name), t, func() {
+			Convey("When evaluating it with an invalid fraction", func() {
+				Convey("Then it should fail", func() {
+					for _, fr := range []data.Value{data.Float(-0.1), data.Float(1.1),
+						data.String("a"), data.Array{data.Float(0.5), data.Int(2)}} {
+						_, err := f.Call(nil, input, fr)
+						So(err, ShouldNotBeNil)
+					}
+				})
+			})
+
+			Convey("When evaluating it on non-numeric values", func() {
+				_, err := f.Call(nil, data.Array{data.String("a")}, data.Float(0.5))
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+
+			Convey("When evaluating it on empty input", func() {
+				v, err := f.Call(nil, data.Array{data.Null{}}, data.Float(0.5))
+
+				Convey("Then it should return null", func() {
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, data.Null{})
+				})
+			})
+		})
+	}
+}
+
+func TestModeFunc(t *testing.T) {
+	Convey("Given the mode function", t, func() {
+		cases := []struct {
+			input    data.Array
+			expected data.Value
+		}{
+			{data.Array{}, data.Null{}},
+			{data.Array{data.Null{}, data.Null{}}, data.Null{}},
+			{data.Array{data.String("a"), data.String("b"), data.String("b")}, data.String("b")},
+			{data.Array{data.Null{}, data.Null{}, data.Int(1)}, data.Int(1)},
+			// Int and Float are different values
+			{data.Array{data.Int(1), data.Float(2), data.Float(1), data.Float(2)}, data.Float(2)},
+			// the first one wins on ties
+			{data.Array{data.Int(2), data.Int(1), data.Int(1), data.Int(2)}, data.Int(2)},
+			{data.Array{data.Map{"a": data.Int(1)}, data.Map{"a": data.Int(1)}},
+				data.Map{"a": data.Int(1)}},
+		}
+
+		Convey("When evaluating it", func() {
+			Convey("Then it should return the most frequent value", func() {
+				for _, c := range cases {
+					v, err := modeFunc.Call(nil, c.input)
+					So(err, ShouldBeNil)
+					So(v, ShouldResemble, c.expected)
+				}
+			})
+		})
+	})
+}
+
+func TestFirstLastFuncs(t *testing.T) {
+	ts := func(sec int) data.Value {
+		return data.Timestamp(time.Date(2015, time.May, 1, 14, 27, sec, 0, time.UTC))
+	}
+	values := data.Array{data.Null{}, data.Int(1), data.Int(2), data.Int(3), data.Int(4), data.Null{}}
+	times := data.Array{ts(0), ts(5), ts(3), data.Null{}, ts(5), ts(9)}
+
+	Convey("Given the first and last functions", t, func() {
+		Convey("When evaluating them without timestamps", func() {
+			f, err := firstFunc.Call(nil, values)
+			So(err, ShouldBeNil)
+			l, err := lastFunc.Call(nil, values)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should return the first and last non-null value", func() {
+				So(f, ShouldResemble, data.Int(1))
+				So(l, ShouldResemble, data.Int(4))
+			})
+		})
+
+		Convey("When evaluating them with timestamps", func() {
+			f, err := firstFunc.Call(nil, values, times)
+			So(err, ShouldBeNil)
+			l, err := lastFunc.Call(nil, values, times)
+			So(err, ShouldBeNil)
+
+			Convey("Then they should return the values having the earliest and latest timestamps", func() {
+				So(f, ShouldResemble, data.Int(2))
+				So(l, ShouldResemble, data.Int(4))
+			})
+		})
+
+		Convey("When evaluating them on empty input", func() {
+			f, err := firstFunc.Call(nil, data.Array{data.Null{}})
+			So(err, ShouldBeNil)
+			l, err := lastFunc.Call(nil, data.Array{data.Int(1)}, data.Array{data.Null{}})
+			So(err, ShouldBeNil)
+
+			Convey("Then they should return null", func() {
+				So(f, ShouldResemble, data.Null{})
+				So(l, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When evaluating them with invalid timestamps", func() {
+			_, err := firstFunc.Call(nil, data.Array{data.Int(1)}, data.Array{data.Int(2)})
+			So(err, ShouldNotBeNil)
+			_, err = lastFunc.Call(nil, data.Array{data.Int(1)}, data.Array{ts(1), ts(2)})
+
+			Convey("Then they should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestEWMAFunc(t *testing.T) {
+	Convey("Given the ewma function", t, func() {
+		Convey("When evaluating it on numbers", func() {
+			v, err := ewmaFunc.Call(nil, data.Array{data.Int(2), data.Null{}, data.Float(4), data.Int(8)},
+				data.Float(0.5))
+
+			Convey("Then it should weight later values more", func() {
+				So(err, ShouldBeNil)
+				// 2 -> 3 -> 5.5
+				So(v, ShouldAlmostEqual, data.Float(5.5), 1e-9)
+			})
+		})
+
+		Convey("When evaluating it on empty input", func() {
+			v, err := ewmaFunc.Call(nil, data.Array{data.Null{}}, data.Float(0.5))
+
+			Convey("Then it should return null", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When evaluating it with invalid input", func() {
+			Convey("Then it should fail", func() {
+				for _, alpha := range []data.Value{data.Float(0), data.Float(1.5), data.Null{}} {
+					_, err := ewmaFunc.Call(nil, data.Array{data.Int(1)}, alpha)
+					So(err, ShouldNotBeNil)
+				}
+				_, err := ewmaFunc.Call(nil, data.Array{data.String("a")}, data.Float(0.5))
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -645,3 +645,452 @@ func decodeJSON(ctx *core.Context, v data.Value) (data.Value, error) {
 		return nil, fmt.Errorf("ill-formed JSON (starting with %c)", first)
 	}
 }
+
+// startsWithFunc returns true if the first string starts with
+// the second one.
+//
+// It can be used in BQL as `starts_with`.
+//
+//  Input: 2 * String
+//  Return Type: Bool
+var startsWithFunc udf.UDF = &twoParamStringFunc{
+	strFun: func(str, prefix string) data.Value {
+		return data.Bool(strings.HasPrefix(str, prefix))
+	},
+}
+
+// reverseFunc reverses the order of the characters in a string.
+//
+// It can be used in BQL as `reverse`.
+//
+//  Input: String
+//  Return Type: String
+var reverseFunc udf.UDF = &singleParamStringFunc{
+	strFun: func(s string) data.Value {
+		runes := []rune(s)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return data.String(string(runes))
+	},
+}
+
+// stringIntArgs converts the arguments of functions like repeat(str, n)
+// that take a string and an integer followed by other strings. It returns
+// false when one of the arguments is Null.
+func stringIntArgs(args []data.Value) (str string, n int64, rest []string, ok bool, err error) {
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return "", 0, nil, false, nil
+		}
+	}
+	if str, err = data.AsString(args[0]); err != nil {
+		return "", 0, nil, false, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	if n, err = data.AsInt(args[1]); err != nil {
+		return "", 0, nil, false, fmt.Errorf("cannot interpret %s as an integer", args[1])
+	}
+	for _, a := range args[2:] {
+		s, err := data.AsString(a)
+		if err != nil {
+			return "", 0, nil, false, fmt.Errorf("cannot interpret %s as a string", a)
+		}
+		rest = append(rest, s)
+	}
+	return str, n, rest, true, nil
+}
+
+// maxStringLength is the maximum length in bytes of a string
+// generated by repeat, lpad, and rpad. It's the same as the limit
+// of PostgreSQL and prevents a single call from exhausting memory.
+const maxStringLength = 1 << 30
+
+// repeatFunc repeats a string the given number of times. The
+// result is an empty string if the number is not positive. It
+// fails when the result would be longer than 1 GB.
+//
+// It can be used in BQL as `repeat`.
+//
+//  Input: String, Int
+//  Return Type: String
+var repeatFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("function takes exactly two arguments")
+		}
+		str, n, _, ok, err := stringIntArgs(args)
+		if !ok {
+			return data.Null{}, err
+		}
+		if n <= 0 {
+			return data.String(""), nil
+		}
+		if len(str) > 0 && n > maxStringLength/int64(len(str)) {
+			return nil, fmt.Errorf("the result would be longer than %d bytes", maxStringLength)
+		}
+		return data.String(strings.Repeat(str, int(n))), nil
+	},
+}
+
+// padString fills a string up to the given length (in characters) by
+// prepending or appending the fill string. If the string is already
+// longer than the length, it is truncated (on the right). It fails
+// when the result would be longer than maxStringLength bytes.
+func padString(args []data.Value, left bool) (data.Value, error) {
+	if len(args) < 2 || len(args) > 3 {
+		return nil, fmt.Errorf("function takes two or three arguments")
+	}
+	str, n, rest, ok, err := stringIntArgs(args)
+	if !ok {
+		return data.Null{}, err
+	}
+	fill := " "
+	if len(rest) > 0 {
+		fill = rest[0]
+	}
+	runes := []rune(str)
+	if n < 0 {
+		n = 0
+	}
+	if int64(len(runes)) >= n {
+		return data.String(string(runes[:n])), nil
+	}
+	fillRunes := []rune(fill)
+	if len(fillRunes) == 0 {
+		return data.String(str), nil
+	}
+	// every character takes at least one byte
+	if n > maxStringLength {
+		return nil, fmt.Errorf("the result would be longer than %d bytes", maxStringLength)
+	}
+	padLen := n - int64(len(runes))
+	numFills := int64(len(fillRunes))
+	size := int64(len(str)) + padLen/numFills*int64(len(fill)) +
+		int64(len(string(fillRunes[:padLen%numFills])))
+	if size > maxStringLength {
+		return nil, fmt.Errorf("the result would be longer than %d bytes", maxStringLength)
+	}
+	padding := make([]rune, padLen)
+	for i := range padding {
+		padding[i] = fillRunes[i%len(fillRunes)]
+	}
+	if left {
+		return data.String(string(padding) + str), nil
+	}
+	return data.String(str + string(padding)), nil
+}
+
+// lpadFunc fills up a string to the given length by prepending
+// the characters in the third argument (a space by default). If
+// the string is already longer than the length, it is truncated.
+// It fails when the result would be longer than 1 GB.
+//
+// It can be used in BQL as `lpad`.
+//
+//  Input: String, Int, [String]
+//  Return Type: String
+var lpadFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		return padString(args, true)
+	},
+}
+
+// rpadFunc fills up a string to the given length by appending
+// the characters in the third argument (a space by default). If
+// the string is already longer than the length, it is truncated.
+// It fails when the result would be longer than 1 GB.
+//
+// It can be used in BQL as `rpad`.
+//
+//  Input: String, Int, [String]
+//  Return Type: String
+var rpadFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		return padString(args, false)
+	},
+}
+
+// splitPartFunc splits a string at occurrences of the delimiter and
+// returns the n-th field (counting from one). When n is negative, the
+// field is counted from the end. An empty string is returned if there
+// are fewer fields than |n|.
+//
+// It can be used in BQL as `split_part`.
+//
+//  Input: String, String, Int
+//  Return Type: String
+var splitPartFunc udf.UDF = &variadicFunc{
+	minParams: 3,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) != 3 {
+			return nil, fmt.Errorf("function takes exactly three arguments")
+		}
+		// reorder the arguments to (string, n, delimiter)
+		str, n, rest, ok, err := stringIntArgs([]data.Value{args[0], args[2], args[1]})
+		if !ok {
+			return data.Null{}, err
+		}
+		if n == 0 {
+			return nil, fmt.Errorf("field position must not be zero")
+		}
+		var fields []string
+		if rest[0] == "" {
+			fields = []string{str}
+		} else {
+			fields = strings.Split(str, rest[0])
+		}
+		if n < 0 {
+			n += int64(len(fields)) + 1
+		}
+		if n < 1 || n > int64(len(fields)) {
+			return data.String(""), nil
+		}
+		return data.String(fields[n-1]), nil
+	},
+}
+
+// regexpFuncTmpl is a template for functions taking a string and
+// a regular expression followed by other parameters. The regular
+// expression is compiled only once when it is a constant.
+type regexpFuncTmpl struct {
+	minParams int
+	maxParams int
+	// flagsIdx is the index of the optional parameter having flags
+	flagsIdx int
+	// global is true if the function accepts the "g" flag
+	global    bool
+	regexpFun func(re *regexp.Regexp, global bool, args []data.Value) (data.Value, error)
+
+	// re and globalSet are the compiled regular expression and the
+	// "g" flag of a specialized function
+	re        *regexp.Regexp
+	globalSet bool
+}
+
+func (f *regexpFuncTmpl) Accept(arity int) bool {
+	return arity >= f.minParams && arity <= f.maxParams
+}
+
+func (f *regexpFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+// compile compiles the regular expression with flags, which are "i"
+// (case-insensitive), "c" (case-sensitive), "s" ("." matches a newline),
+// "m" (^ and $ match at line breaks), and "g" (replace all occurrences)
+// if the function accepts it.
+func (f *regexpFuncTmpl) compile(pattern data.Value, flags []data.Value) (*regexp.Regexp, bool, error) {
+	p, err := data.AsString(pattern)
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot interpret %s as a regular expression", pattern)
+	}
+	global := false
+	prefix := ""
+	if len(flags) > 0 {
+		fs, err := data.AsString(flags[0])
+		if err != nil {
+			return nil, false, fmt.Errorf("cannot interpret %s as flags", flags[0])
+		}
+		for _, c := range fs {
+			switch {
+			case c == 'i' || c == 's' || c == 'm':
+				prefix += string(c)
+			case c == 'c':
+				prefix = strings.Replace(prefix, "i", "", -1)
+			case c == 'g' && f.global:
+				global = true
+			default:
+				return nil, false, fmt.Errorf("invalid regular expression flag: %c", c)
+			}
+		}
+	}
+	if prefix != "" {
+		p = "(?" + prefix + ")" + p
+	}
+	re, err := regexp.Compile(p)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid regular expression: %v", err)
+	}
+	return re, global, nil
+}
+
+func (f *regexpFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function takes %d to %d arguments", f.minParams, f.maxParams)
+	}
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	re, global := f.re, f.globalSet
+	if re == nil {
+		var err error
+		if re, global, err = f.compile(args[1], args[f.flagsIdx:]); err != nil {
+			return nil, err
+		}
+	}
+	return f.regexpFun(re, global, args)
+}
+
+// Specialize compiles the regular expression beforehand when it and
+// the flags are constants.
+func (f *regexpFuncTmpl) Specialize(consts []data.Value) (udf.UDF, error) {
+	if consts[1] == nil || consts[1].Type() == data.TypeNull {
+		return f, nil
+	}
+	flags := consts[f.flagsIdx:]
+	if len(flags) > 0 && (flags[0] == nil || flags[0].Type() == data.TypeNull) {
+		return f, nil
+	}
+	re, global, err := f.compile(consts[1], flags)
+	if err != nil {
+		return nil, err
+	}
+	specialized := *f
+	specialized.re, specialized.globalSet = re, global
+	return &specialized, nil
+}
+
+// regexpSubject returns the string to which a regular expression
+// is applied.
+func regexpSubject(v data.Value) (string, error) {
+	s, err := data.AsString(v)
+	if err != nil {
+		return "", fmt.Errorf("cannot interpret %s as a string", v)
+	}
+	return s, nil
+}
+
+// regexpMatchFunc matches a regular expression with a string and returns
+// an array of the substrings matched by the parenthesized subexpressions,
+// or an array having the whole match if there are none. Null is returned
+// when there is no match. The optional third parameter has flags such
+// as "i" (see regexpFuncTmpl.compile).
+//
+// It can be used in BQL as `regexp_match`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array (of String)
+var regexpMatchFunc udf.UDF = &regexpFuncTmpl{
+	minParams: 2,
+	maxParams: 3,
+	flagsIdx:  2,
+	regexpFun: func(re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		s, err := regexpSubject(args[0])
+		if err != nil {
+			return nil, err
+		}
+		m := re.FindStringSubmatchIndex(s)
+		if m == nil {
+			return data.Null{}, nil
+		}
+		if len(m) > 2 {
+			// omit the whole match if there are subexpressions
+			m = m[2:]
+		}
+		arr := make(data.Array, len(m)/2)
+		for i := range arr {
+			if m[2*i] < 0 {
+				arr[i] = data.Null{}
+			} else {
+				arr[i] = data.String(s[m[2*i]:m[2*i+1]])
+			}
+		}
+		return arr, nil
+	},
+}
+
+// regexpReplacement converts a replacement string of regexp_replace,
+// in which \1 to \9 refer to parenthesized subexpressions and \& to the
+// whole match, to the syntax of regexp.Regexp.Expand.
+func regexpReplacement(r string) string {
+	var b []byte
+	for i := 0; i < len(r); i++ {
+		switch c := r[i]; {
+		case c == '$':
+			b = append(b, "$$"...)
+		case c == '\\' && i+1 < len(r):
+			i++
+			switch d := r[i]; {
+			case '1' <= d && d <= '9':
+				b = append(b, "${"...)
+				b = append(b, d, '}')
+			case d == '&':
+				b = append(b, "${0}"...)
+			default:
+				b = append(b, d)
+			}
+		default:
+			b = append(b, c)
+		}
+	}
+	return string(b)
+}
+
+// regexpReplaceFunc replaces the first substring matching a regular
+// expression with the replacement string, in which \1 to \9 refer to
+// parenthesized subexpressions and \& refers to the whole match. All
+// matches are replaced if the optional fourth parameter has the "g" flag
+// (see regexpFuncTmpl.compile for other flags).
+//
+// It can be used in BQL as `regexp_replace`.
+//
+//  Input: String, String, String, [String]
+//  Return Type: String
+var regexpReplaceFunc udf.UDF = &regexpFuncTmpl{
+	minParams: 3,
+	maxParams: 4,
+	flagsIdx:  3,
+	global:    true,
+	regexpFun: func(re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		s, err := regexpSubject(args[0])
+		if err != nil {
+			return nil, err
+		}
+		r, err := data.AsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[2])
+		}
+		tmpl := regexpReplacement(r)
+		if global {
+			return data.String(re.ReplaceAllString(s, tmpl)), nil
+		}
+		m := re.FindStringSubmatchIndex(s)
+		if m == nil {
+			return data.String(s), nil
+		}
+		dst := []byte(s[:m[0]])
+		dst = re.ExpandString(dst, tmpl, s, m)
+		return data.String(string(dst) + s[m[1]:]), nil
+	},
+}
+
+// regexpSplitToArrayFunc splits a string at the substrings matching
+// a regular expression. The optional third parameter has flags (see
+// regexpFuncTmpl.compile).
+//
+// It can be used in BQL as `regexp_split_to_array`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array (of String)
+var regexpSplitToArrayFunc udf.UDF = &regexpFuncTmpl{
+	minParams: 2,
+	maxParams: 3,
+	flagsIdx:  2,
+	regexpFun: func(re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		s, err := regexpSubject(args[0])
+		if err != nil {
+			return nil, err
+		}
+		parts := re.Split(s, -1)
+		arr := make(data.Array, len(parts))
+		for i, p := range parts {
+			arr[i] = data.String(p)
+		}
+		return arr, nil
+	},
+}
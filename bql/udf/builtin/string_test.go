@@ -71,6 +71,11 @@ func TestUnaryStringFuncs(t *testing.T) {
 			{data.String("abc\n"), data.String("03cfd743661f07975fa2f1220c5194cbaff48451")},
 			{data.String("日本語\n"), data.String("eb2ac8ca4ec0f3913058ccd239dd984bda31a821")},
 		}},
+		{"reverse", reverseFunc, []udfUnaryTestCaseInput{
+			{data.String(""), data.String("")},
+			{data.String("jose"), data.String("esoj")},
+			{data.String("日本語"), data.String("語本日")},
+		}},
 		{"sha256", sha256Func, []udfUnaryTestCaseInput{
 			{data.String("abc\n"), data.String("edeaaff3f1774ad2888673770c6d64097e391bc362d7d6fb34982ddf0efd18cb")},
 			{data.String("日本語\n"), data.String("a43d56ae90ff2daebd847bf06f9c0a7b416f48f89b6e9dbefe7886540c94b550")},
@@ -171,6 +176,43 @@ func TestBinaryStringFuncs(t *testing.T) {
 			{data.String("trimzzzy"), data.String("xyz"), data.String("trim")},
 			{data.String("zzzytrimz"), data.String("xyz"), data.String("zzzytrim")},
 		}},
+		{"starts_with", startsWithFunc, []udfBinaryTestCaseInput{
+			{data.String("Thomas"), data.String("Th"), data.Bool(true)},
+			{data.String("Thomas"), data.String(""), data.Bool(true)},
+			{data.String("Thomas"), data.String("th"), data.Bool(false)},
+		}},
+		{"repeat", repeatFunc, []udfBinaryTestCaseInput{
+			{data.String("ab"), data.Int(3), data.String("ababab")},
+			{data.String("ab"), data.Int(0), data.String("")},
+			{data.String("ab"), data.Int(-1), data.String("")},
+			{data.String("ab"), data.String("3"), nil},
+			// too long results
+			{data.String("ab"), data.Int(1<<29 + 1), nil},
+			{data.String("ab"), data.Int(math.MaxInt64), nil},
+		}},
+		{"lpad", lpadFunc, []udfBinaryTestCaseInput{
+			{data.String("hi"), data.Int(5), data.String("   hi")},
+			{data.String("日本語"), data.Int(2), data.String("日本")},
+			{data.String("hi"), data.Int(-1), data.String("")},
+		}},
+		{"rpad", rpadFunc, []udfBinaryTestCaseInput{
+			{data.String("hi"), data.Int(5), data.String("hi   ")},
+			{data.String("日本語"), data.Int(2), data.String("日本")},
+			{data.String("hi"), data.Int(math.MaxInt64), nil},
+		}},
+		{"regexp_match", regexpMatchFunc, []udfBinaryTestCaseInput{
+			{data.String("foobarbequebaz"), data.String("ba."), data.Array{data.String("bar")}},
+			{data.String("foobarbequebaz"), data.String("(bar)(beque)"),
+				data.Array{data.String("bar"), data.String("beque")}},
+			{data.String("foobar"), data.String("(x)?bar"), data.Array{data.Null{}}},
+			{data.String("foobar"), data.String("qux"), data.Null{}},
+			{data.String("foobar"), data.String("("), nil},
+		}},
+		{"regexp_split_to_array", regexpSplitToArrayFunc, []udfBinaryTestCaseInput{
+			{data.String("hello world  again"), data.String(`\s+`),
+				data.Array{data.String("hello"), data.String("world"), data.String("again")}},
+			{data.String("abc"), data.String("x"), data.Array{data.String("abc")}},
+		}},
 		{"btrim", btrimFunc, []udfBinaryTestCaseInput{
 			{data.String("zzzytrimz"), data.String("xyz"), data.String("trim")},
 		}},
@@ -261,6 +303,33 @@ func Test3aryStringFuncs(t *testing.T) {
 			{data.Int(3), data.String("hom"), data.Int(1), nil},
 			{data.String("Txxxxas"), data.Int(4), data.Int(1), nil},
 		}},
+		{"regexp_match", regexpMatchFunc, []udf3aryTestCaseInput{
+			{data.String("FooBar"), data.String("b(a)r"), data.String("i"), data.Array{data.String("a")}},
+			{data.String("FooBar"), data.String("b(a)r"), data.String("ic"), data.Null{}},
+			{data.String("FooBar"), data.String("bar"), data.String("g"), nil},
+			{data.String("FooBar"), data.String("bar"), data.String("x"), nil},
+		}},
+		{"regexp_replace", regexpReplaceFunc, []udf3aryTestCaseInput{
+			{data.String("foobarbaz"), data.String("b(..)"), data.String(`X\1Y`), data.String("fooXarYbaz")},
+			{data.String("foobarbaz"), data.String("b.."), data.String(`<\&>$1`), data.String("foo<bar>$1baz")},
+			{data.String("foobarbaz"), data.String("qux"), data.String("X"), data.String("foobarbaz")},
+		}},
+		{"split_part", splitPartFunc, []udf3aryTestCaseInput{
+			{data.String("abc~@~def~@~ghi"), data.String("~@~"), data.Int(2), data.String("def")},
+			{data.String("abc,def,ghi"), data.String(","), data.Int(-1), data.String("ghi")},
+			{data.String("abc,def,ghi"), data.String(","), data.Int(4), data.String("")},
+			{data.String("abc,def"), data.String(""), data.Int(1), data.String("abc,def")},
+			{data.String("abc,def"), data.String(","), data.Int(0), nil},
+		}},
+		{"lpad", lpadFunc, []udf3aryTestCaseInput{
+			{data.String("hi"), data.Int(5), data.String("xy"), data.String("xyxhi")},
+			{data.String("hi"), data.Int(5), data.String(""), data.String("hi")},
+		}},
+		{"rpad", rpadFunc, []udf3aryTestCaseInput{
+			{data.String("hi"), data.Int(5), data.String("xy"), data.String("hixyx")},
+			// a multi-byte fill string can exceed the limit in bytes
+			{data.String("hi"), data.Int(1 << 29), data.String("日本"), nil},
+		}},
 		{"substring", substringFunc, []udf3aryTestCaseInput{
 			// substring(string, fromIdx, length)
 			{data.String("Thomas"), data.Int(0), data.Int(2), data.String("Th")},
@@ -354,6 +423,13 @@ func Test4aryStringFuncs(t *testing.T) {
 			{data.String("Txxxxas"), data.String(""), data.Int(1), data.Int(4), data.String("Tas")},
 			{data.String(""), data.String("hom"), data.Int(1), data.Int(1), data.String("hom")},
 		}},
+		{"regexp_replace", regexpReplaceFunc, []udf4aryTestCaseInput{
+			{data.String("foobarbaz"), data.String("b(.)"), data.String(`X\1`), data.String("g"),
+				data.String("fooXarXaz")},
+			{data.String("fooBarBaz"), data.String("b"), data.String("X"), data.String("gi"),
+				data.String("fooXarXaz")},
+			{data.String("foobarbaz"), data.String("b"), data.String("X"), data.String("q"), nil},
+		}},
 	}
 
 	for _, testCase := range udf4aryTestCases {
@@ -636,3 +712,42 @@ func TestDecodeJSON(t *testing.T) {
 		})
 	})
 }
+
+func TestRegexpFuncSpecialization(t *testing.T) {
+	Convey("Given the regexp_replace function", t, func() {
+		f := regexpReplaceFunc.(udf.CallSiteSpecializer)
+
+		Convey("When specializing it for a constant pattern and flags", func() {
+			s, err := f.Specialize([]data.Value{nil, data.String("b"), nil, data.String("g")})
+			So(err, ShouldBeNil)
+
+			Convey("Then the pattern should be compiled beforehand", func() {
+				So(s, ShouldNotPointTo, f)
+				So(s.(*regexpFuncTmpl).re, ShouldNotBeNil)
+			})
+
+			Convey("Then it should give the same results", func() {
+				v, err := s.Call(nil, data.String("abcb"), data.String("b"), data.String("X"), data.String("g"))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.String("aXcX"))
+			})
+		})
+
+		Convey("When specializing it for a non-constant pattern", func() {
+			s, err := f.Specialize([]data.Value{data.String("abcb"), nil, data.String("X"), data.String("g")})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should not change", func() {
+				So(s, ShouldPointTo, f)
+			})
+		})
+
+		Convey("When specializing it for an invalid constant pattern", func() {
+			_, err := f.Specialize([]data.Value{nil, data.String("("), nil})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -5,6 +5,9 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -54,3 +57,503 @@ var diffUsFunc udf.UDF = &diffUsFuncTmpl{}
 var clockTimestampFunc = udf.MustConvertGeneric(func() time.Time {
 	return time.Now().In(time.UTC)
 })
+
+// timeFuncTmpl is a template for the date/time functions that take
+// between minParams and maxParams parameters. They return Null if
+// any of the arguments is Null.
+type timeFuncTmpl struct {
+	minParams int
+	maxParams int
+	timeFun   func(args ...data.Value) (data.Value, error)
+}
+
+func (f *timeFuncTmpl) Accept(arity int) bool {
+	return arity >= f.minParams && arity <= f.maxParams
+}
+
+func (f *timeFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *timeFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		if f.minParams == f.maxParams {
+			return nil, fmt.Errorf("function takes exactly %d parameters", f.minParams)
+		}
+		return nil, fmt.Errorf("function takes %d to %d parameters", f.minParams, f.maxParams)
+	}
+	for _, a := range args {
+		if a.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	return f.timeFun(args...)
+}
+
+var (
+	locationsMutex sync.Mutex
+	locations      = map[string]*time.Location{}
+)
+
+// toLocation returns the time zone having the given name. In addition to
+// the names of the IANA Time Zone Database, "UTC", "Z", and offsets from
+// UTC such as "+09", "+09:00", or "-0530" are supported.
+func toLocation(v data.Value) (*time.Location, error) {
+	name, err := data.AsString(v)
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "UTC", "utc", "Z":
+		return time.UTC, nil
+	}
+
+	locationsMutex.Lock()
+	defer locationsMutex.Unlock()
+	if loc, ok := locations[name]; ok {
+		return loc, nil
+	}
+	var loc *time.Location
+	if name != "" && (name[0] == '+' || name[0] == '-') {
+		t, err := time.Parse("-07", name)
+		for _, layout := range []string{"-07:00", "-0700"} {
+			if err == nil {
+				break
+			}
+			t, err = time.Parse(layout, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone offset: %v", name)
+		}
+		_, offset := t.Zone()
+		loc = time.FixedZone(name, offset)
+	} else {
+		loc, err = time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone: %v", name)
+		}
+	}
+	locations[name] = loc
+	return loc, nil
+}
+
+// timeFieldAliases maps the plural and singular forms of fields to the
+// names used internally.
+var timeFieldAliases = map[string]string{
+	"microsecond": "microseconds",
+	"millisecond": "milliseconds",
+	"seconds":     "second",
+	"minutes":     "minute",
+	"hours":       "hour",
+	"days":        "day",
+	"weeks":       "week",
+	"months":      "month",
+	"quarters":    "quarter",
+	"years":       "year",
+	"decades":     "decade",
+	"centuries":   "century",
+	"millennia":   "millennium",
+	"millenniums": "millennium",
+}
+
+// toTimeField returns the normalized name of a field such as "hour" given
+// to date_trunc or date_part.
+func toTimeField(v data.Value) (string, error) {
+	s, err := data.AsString(v)
+	if err != nil {
+		return "", err
+	}
+	s = strings.ToLower(strings.TrimSpace(s))
+	if a, ok := timeFieldAliases[s]; ok {
+		return a, nil
+	}
+	return s, nil
+}
+
+// timeArg converts the argument to a Timestamp in the time zone given
+// as another argument, if any.
+func timeArg(v data.Value, zone []data.Value) (time.Time, error) {
+	t, err := data.ToTimestamp(v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(zone) > 0 {
+		loc, err := toLocation(zone[0])
+		if err != nil {
+			return time.Time{}, err
+		}
+		t = t.In(loc)
+	}
+	return t, nil
+}
+
+// floorDiv is integer division rounding towards negative infinity.
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((b - 1 - a) / b)
+	}
+	return a / b
+}
+
+// truncateTime truncates the time to the precision given by the field
+// in the time's location. Weeks start on Monday, and centuries and
+// millennia start with the year 1 like 2001.
+func truncateTime(t time.Time, field string) (time.Time, error) {
+	y, m, d := t.Date()
+	hh, mm, ss := t.Clock()
+	ns := t.Nanosecond()
+	switch field {
+	case "millennium":
+		y = floorDiv(y-1, 1000)*1000 + 1
+		fallthrough
+	case "century":
+		if field == "century" {
+			y = floorDiv(y-1, 100)*100 + 1
+		}
+		fallthrough
+	case "decade":
+		if field == "decade" {
+			y = floorDiv(y, 10) * 10
+		}
+		fallthrough
+	case "year":
+		m = time.January
+		fallthrough
+	case "quarter":
+		if field == "quarter" {
+			m = (m-1)/3*3 + 1
+		}
+		fallthrough
+	case "month":
+		d = 1
+		fallthrough
+	case "day":
+		hh = 0
+		fallthrough
+	case "hour":
+		mm = 0
+		fallthrough
+	case "minute":
+		ss = 0
+		fallthrough
+	case "second":
+		ns = 0
+	case "week":
+		d -= isoWeekday(t) - 1
+		hh, mm, ss, ns = 0, 0, 0, 0
+	case "milliseconds":
+		ns -= ns % int(time.Millisecond)
+	case "microseconds":
+		ns -= ns % int(time.Microsecond)
+	default:
+		return time.Time{}, fmt.Errorf("unit '%v' is not supported by date_trunc", field)
+	}
+	return time.Date(y, m, d, hh, mm, ss, ns, t.Location()), nil
+}
+
+// dateTruncFunc truncates a timestamp to the specified precision, which
+// is one of microseconds, milliseconds, second, minute, hour, day, week,
+// month, quarter, year, decade, century, and millennium (or their plural
+// forms). The timestamp is truncated in its own time zone unless a time
+// zone is given as the third argument.
+// It is useful to put timestamps into buckets for grouping.
+//
+// It can be used in BQL as `date_trunc`.
+//
+//  Input: String, Timestamp, [String]
+//  Return Type: Timestamp
+var dateTruncFunc udf.UDF = &timeFuncTmpl{2, 3,
+	func(args ...data.Value) (data.Value, error) {
+		field, err := toTimeField(args[0])
+		if err != nil {
+			return nil, err
+		}
+		t, err := timeArg(args[1], args[2:])
+		if err != nil {
+			return nil, err
+		}
+		loc := t.Location()
+		if len(args) == 3 {
+			// return the result in the original time zone
+			orig, _ := data.ToTimestamp(args[1])
+			loc = orig.Location()
+		}
+		t, err = truncateTime(t, field)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t.In(loc)), nil
+	},
+}
+
+// dateBinFunc puts a timestamp into a bucket having the width of the
+// given interval and returns the start of the bucket. Buckets are
+// aligned to the origin given as the third argument, which defaults to
+// 1970-01-01T00:00:00Z. The interval cannot contain months.
+//
+// It can be used in BQL as `date_bin`.
+//
+//  Input: Interval, Timestamp, [Timestamp]
+//  Return Type: Timestamp
+var dateBinFunc udf.UDF = &timeFuncTmpl{2, 3,
+	func(args ...data.Value) (data.Value, error) {
+		iv, err := data.ToInterval(args[0])
+		if err != nil {
+			return nil, err
+		}
+		stride, err := data.ToDuration(iv)
+		if err != nil {
+			return nil, err
+		}
+		if stride <= 0 {
+			return nil, fmt.Errorf("stride must be greater than zero")
+		}
+		t, err := data.ToTimestamp(args[1])
+		if err != nil {
+			return nil, err
+		}
+		origin := time.Unix(0, 0)
+		if len(args) == 3 {
+			if origin, err = data.ToTimestamp(args[2]); err != nil {
+				return nil, err
+			}
+		}
+		diff := t.Sub(origin)
+		bin := diff - diff%stride
+		if diff%stride < 0 {
+			bin -= stride
+		}
+		return data.Timestamp(origin.Add(bin).In(t.Location())), nil
+	},
+}
+
+// timePart returns the value of the field of the time. The fields are
+// the ones of PostgreSQL's date_part.
+func timePart(t time.Time, field string) (float64, error) {
+	sec := float64(t.Second()) + float64(t.Nanosecond())/1e9
+	y := t.Year()
+	switch field {
+	case "microseconds":
+		return float64(t.Second()*1000000 + t.Nanosecond()/1000), nil
+	case "milliseconds":
+		return sec * 1000, nil
+	case "second":
+		return sec, nil
+	case "minute":
+		return float64(t.Minute()), nil
+	case "hour":
+		return float64(t.Hour()), nil
+	case "day":
+		return float64(t.Day()), nil
+	case "dow":
+		return float64(t.Weekday()), nil
+	case "isodow":
+		return float64(isoWeekday(t)), nil
+	case "doy":
+		return float64(t.YearDay()), nil
+	case "week":
+		_, w := t.ISOWeek()
+		return float64(w), nil
+	case "isoyear":
+		iy, _ := t.ISOWeek()
+		return float64(iy), nil
+	case "month":
+		return float64(t.Month()), nil
+	case "quarter":
+		return float64((t.Month()-1)/3 + 1), nil
+	case "year":
+		return float64(y), nil
+	case "decade":
+		return float64(floorDiv(y, 10)), nil
+	case "century":
+		return float64(century(y)), nil
+	case "millennium":
+		if y > 0 {
+			return float64((y + 999) / 1000), nil
+		}
+		return float64(-((999 - y) / 1000)), nil
+	case "epoch":
+		return float64(t.Unix()) + float64(t.Nanosecond())/1e9, nil
+	case "timezone":
+		_, offset := t.Zone()
+		return float64(offset), nil
+	case "timezone_hour":
+		_, offset := t.Zone()
+		return float64(offset / 3600), nil
+	case "timezone_minute":
+		_, offset := t.Zone()
+		return float64(offset / 60 % 60), nil
+	}
+	return 0, fmt.Errorf("unit '%v' is not supported for timestamps", field)
+}
+
+// intervalPart returns the value of the field of the interval. Like
+// PostgreSQL, fields are extracted from the normalized representation,
+// e.g. the hour of "1 day 25:00:00" is 25 and the month of "14 months"
+// is 2.
+func intervalPart(iv data.Interval, field string) (float64, error) {
+	us := iv.Microseconds
+	sec := float64(us%(60*1000000)) / 1e6
+	y := iv.Months / 12
+	switch field {
+	case "microseconds":
+		return sec * 1e6, nil
+	case "milliseconds":
+		return sec * 1e3, nil
+	case "second":
+		return sec, nil
+	case "minute":
+		return float64(us / (60 * 1000000) % 60), nil
+	case "hour":
+		return float64(us / (3600 * 1000000)), nil
+	case "day":
+		return float64(iv.Days), nil
+	case "month":
+		return float64(iv.Months % 12), nil
+	case "quarter":
+		return float64(iv.Months%12/3 + 1), nil
+	case "year":
+		return float64(y), nil
+	case "decade":
+		return float64(y / 10), nil
+	case "century":
+		return float64(y / 100), nil
+	case "millennium":
+		return float64(y / 1000), nil
+	case "epoch":
+		return iv.Seconds(), nil
+	}
+	return 0, fmt.Errorf("unit '%v' is not supported for intervals", field)
+}
+
+// datePartFunc returns the value of a field of a timestamp or an interval
+// like PostgreSQL's date_part. The fields of a timestamp are taken in its
+// own time zone unless a time zone is given as the third argument.
+//
+// Fields of timestamps are microseconds, milliseconds, second, minute,
+// hour, day, dow (0-6, Sunday is 0), isodow (1-7, Sunday is 7), doy, week
+// (ISO 8601), isoyear, month, quarter, year, decade, century, millennium,
+// epoch (seconds since 1970-01-01T00:00:00Z), timezone (offset in
+// seconds), timezone_hour, and timezone_minute. Fields of intervals are
+// microseconds, milliseconds, second, minute, hour, day, month, quarter,
+// year, decade, century, millennium, and epoch.
+//
+// It can be used in BQL as `date_part` and `extract`.
+//
+//  Input: String, Timestamp or Interval, [String]
+//  Return Type: Float
+var datePartFunc udf.UDF = &timeFuncTmpl{2, 3,
+	func(args ...data.Value) (data.Value, error) {
+		field, err := toTimeField(args[0])
+		if err != nil {
+			return nil, err
+		}
+		var v float64
+		if args[1].Type() == data.TypeInterval {
+			iv, _ := data.AsInterval(args[1])
+			v, err = intervalPart(iv, field)
+		} else {
+			var t time.Time
+			if t, err = timeArg(args[1], args[2:]); err != nil {
+				return nil, err
+			}
+			v, err = timePart(t, field)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return data.Float(v), nil
+	},
+}
+
+// toCharFunc formats a timestamp according to a template like
+// PostgreSQL's to_char. The template can contain the following patterns:
+// HH, HH12, HH24, MI, SS, MS, US, SSSS, AM, PM, A.M., P.M., YYYY, YYY,
+// YY, Y, IYYY, IW, ID, MONTH, MON, MM, DAY, DY, DDD, DD, D, WW, Q, CC,
+// TZ, and OF. Names such as MONTH are capitalized in the same way as the
+// pattern (e.g. "Month" results in "January"). The FM prefix suppresses
+// padding, and text in double quotes is output as it is. The timestamp
+// is formatted in its own time zone unless a time zone is given as the
+// third argument.
+//
+// It can be used in BQL as `to_char`.
+//
+//  Input: Timestamp, String, [String]
+//  Return Type: String
+var toCharFunc udf.UDF = &timeFuncTmpl{2, 3,
+	func(args ...data.Value) (data.Value, error) {
+		t, err := timeArg(args[0], args[2:])
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := data.AsString(args[1])
+		if err != nil {
+			return nil, err
+		}
+		return data.String(formatTime(t, tmpl)), nil
+	},
+}
+
+// toTimestampFunc converts a string to a Timestamp according to a
+// template, which has the same patterns as to_char except TZ. The time is
+// interpreted in UTC, or in the time zone given as the third argument,
+// unless it has an offset matched by OF. When only one numeric argument
+// is given, it is interpreted as seconds since 1970-01-01T00:00:00Z.
+//
+// It can be used in BQL as `to_timestamp`.
+//
+//  Input: String, String, [String] or Int/Float
+//  Return Type: Timestamp
+var toTimestampFunc udf.UDF = &timeFuncTmpl{1, 3,
+	func(args ...data.Value) (data.Value, error) {
+		if len(args) == 1 {
+			switch args[0].Type() {
+			case data.TypeInt, data.TypeFloat:
+			default:
+				return nil, fmt.Errorf("cannot interpret %v as seconds", args[0])
+			}
+			f, _ := data.ToFloat(args[0])
+			sec := math.Floor(f)
+			return data.Timestamp(time.Unix(int64(sec), int64((f-sec)*1e9)).In(time.UTC)), nil
+		}
+		s, err := data.AsString(args[0])
+		if err != nil {
+			return nil, err
+		}
+		tmpl, err := data.AsString(args[1])
+		if err != nil {
+			return nil, err
+		}
+		loc := time.UTC
+		if len(args) == 3 {
+			if loc, err = toLocation(args[2]); err != nil {
+				return nil, err
+			}
+		}
+		t, err := parseTime(s, tmpl, loc)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t.In(time.UTC)), nil
+	},
+}
+
+// timezoneFunc converts a timestamp to the given time zone, which is
+// either a name of the IANA Time Zone Database such as "Asia/Tokyo",
+// "UTC", or an offset such as "+09:00". The converted timestamp refers
+// to the same instant, but date_trunc, date_part, and to_char will use
+// the time zone.
+//
+// It can be used in BQL as `timezone`.
+//
+//  Input: String, Timestamp
+//  Return Type: Timestamp
+var timezoneFunc udf.UDF = &timeFuncTmpl{2, 2,
+	func(args ...data.Value) (data.Value, error) {
+		t, err := timeArg(args[1], args[:1])
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t), nil
+	},
+}
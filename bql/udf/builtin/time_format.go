@@ -0,0 +1,420 @@
+package builtin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// dateTimeItem is an item of a template of to_char and to_timestamp,
+// which is either a template pattern like "YYYY" or literal text.
+type dateTimeItem struct {
+	// pattern is the upper case name of the pattern or empty for text
+	pattern string
+	// text is the literal text or the pattern as written in the template,
+	// whose case determines the case of names in the output
+	text string
+	// fillMode is true if the pattern has the FM prefix, which
+	// suppresses padding
+	fillMode bool
+}
+
+// dateTimePatterns are the template patterns supported by to_char and
+// to_timestamp. They're matched case-insensitively in this order, so
+// longer patterns must come first.
+var dateTimePatterns = []string{
+	"HH24", "HH12", "HH", "MI", "SSSS", "SS", "MS", "US",
+	"A.M.", "P.M.", "AM", "PM",
+	"IYYY", "YYYY", "YYY", "YY", "Y", "IW", "ID",
+	"MONTH", "MON", "MM", "DAY", "DDD", "DD", "DY", "D",
+	"WW", "Q", "CC", "TZ", "OF",
+}
+
+// parseDateTimeTemplate splits a template of to_char or to_timestamp into
+// patterns and literal text. Text in double quotes is always literal.
+func parseDateTimeTemplate(tmpl string) []dateTimeItem {
+	var items []dateTimeItem
+	addText := func(s string) {
+		if n := len(items); n > 0 && items[n-1].pattern == "" {
+			items[n-1].text += s
+		} else {
+			items = append(items, dateTimeItem{text: s})
+		}
+	}
+
+	for i := 0; i < len(tmpl); {
+		if tmpl[i] == '"' {
+			// quoted text, which may contain escaped double quotes
+			var text []byte
+			i++
+			for ; i < len(tmpl) && tmpl[i] != '"'; i++ {
+				if tmpl[i] == '\\' && i+1 < len(tmpl) {
+					i++
+				}
+				text = append(text, tmpl[i])
+			}
+			i++
+			addText(string(text))
+			continue
+		}
+
+		fillMode := false
+		rest := tmpl[i:]
+		if len(rest) > 2 && strings.EqualFold(rest[:2], "FM") {
+			fillMode = true
+			rest = rest[2:]
+		}
+		matched := false
+		for _, p := range dateTimePatterns {
+			if len(rest) >= len(p) && strings.EqualFold(rest[:len(p)], p) {
+				items = append(items, dateTimeItem{p, rest[:len(p)], fillMode})
+				i += len(tmpl[i:]) - len(rest) + len(p)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			addText(tmpl[i : i+1])
+			i++
+		}
+	}
+	return items
+}
+
+// applyCase returns s in upper case, capitalized, or lower case depending
+// on the case of the pattern as written in the template (e.g. "MONTH",
+// "Month", or "month").
+func applyCase(s, text string) string {
+	switch {
+	case len(text) > 1 && unicode.IsUpper(rune(text[0])) && unicode.IsUpper(rune(text[1])):
+		return strings.ToUpper(s)
+	case unicode.IsUpper(rune(text[0])):
+		return strings.ToUpper(s[:1]) + strings.ToLower(s[1:])
+	}
+	return strings.ToLower(s)
+}
+
+// formatTime formats the time according to a template of to_char.
+func formatTime(t time.Time, tmpl string) string {
+	var b []byte
+	for _, item := range parseDateTimeTemplate(tmpl) {
+		if item.pattern == "" {
+			b = append(b, item.text...)
+			continue
+		}
+		num := func(n, width int) {
+			s := strconv.Itoa(n)
+			if n < 0 {
+				s = strconv.Itoa(-n)
+			}
+			if !item.fillMode && len(s) < width {
+				s = strings.Repeat("0", width-len(s)) + s
+			}
+			if n < 0 {
+				s = "-" + s
+			}
+			b = append(b, s...)
+		}
+		name := func(s string, width int) {
+			s = applyCase(s, item.text)
+			if !item.fillMode && len(s) < width {
+				s += strings.Repeat(" ", width-len(s))
+			}
+			b = append(b, s...)
+		}
+
+		switch item.pattern {
+		case "HH", "HH12":
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			num(h, 2)
+		case "HH24":
+			num(t.Hour(), 2)
+		case "MI":
+			num(t.Minute(), 2)
+		case "SS":
+			num(t.Second(), 2)
+		case "SSSS":
+			num(t.Hour()*3600+t.Minute()*60+t.Second(), 1)
+		case "MS":
+			num(t.Nanosecond()/int(time.Millisecond), 3)
+		case "US":
+			num(t.Nanosecond()/int(time.Microsecond), 6)
+		case "AM", "PM", "A.M.", "P.M.":
+			s := "AM"
+			if t.Hour() >= 12 {
+				s = "PM"
+			}
+			if strings.Contains(item.pattern, ".") {
+				s = s[:1] + "." + s[1:] + "."
+			}
+			name(s, 0)
+		case "YYYY":
+			num(t.Year(), 4)
+		case "YYY":
+			num(t.Year()%1000, 3)
+		case "YY":
+			num(t.Year()%100, 2)
+		case "Y":
+			num(t.Year()%10, 1)
+		case "IYYY":
+			y, _ := t.ISOWeek()
+			num(y, 4)
+		case "IW":
+			_, w := t.ISOWeek()
+			num(w, 2)
+		case "ID":
+			num(isoWeekday(t), 1)
+		case "MONTH":
+			name(t.Month().String(), 9)
+		case "MON":
+			name(t.Month().String()[:3], 0)
+		case "MM":
+			num(int(t.Month()), 2)
+		case "DAY":
+			name(t.Weekday().String(), 9)
+		case "DY":
+			name(t.Weekday().String()[:3], 0)
+		case "DDD":
+			num(t.YearDay(), 3)
+		case "DD":
+			num(t.Day(), 2)
+		case "D":
+			num(int(t.Weekday())+1, 1)
+		case "WW":
+			num((t.YearDay()-1)/7+1, 2)
+		case "Q":
+			num((int(t.Month())-1)/3+1, 1)
+		case "CC":
+			num(century(t.Year()), 2)
+		case "TZ":
+			zone, _ := t.Zone()
+			name(zone, 0)
+		case "OF":
+			_, offset := t.Zone()
+			s := "+"
+			if offset < 0 {
+				s, offset = "-", -offset
+			}
+			s += fmt.Sprintf("%02d", offset/3600)
+			if m := offset % 3600 / 60; m != 0 {
+				s += fmt.Sprintf(":%02d", m)
+			}
+			b = append(b, s...)
+		}
+	}
+	return string(b)
+}
+
+// isoWeekday returns the day of the week from Monday (1) to Sunday (7).
+func isoWeekday(t time.Time) int {
+	if d := int(t.Weekday()); d != 0 {
+		return d
+	}
+	return 7
+}
+
+// century returns the century of the year, where the 21st century
+// starts with 2001.
+func century(y int) int {
+	if y > 0 {
+		return (y + 99) / 100
+	}
+	return -((99 - y) / 100)
+}
+
+// parseTime parses a string according to a template of to_timestamp.
+// The time is interpreted in the given location unless the string has
+// an offset specified by the OF pattern. Date fields that are missing
+// default to January 1 of the year 1, and time fields to zero.
+func parseTime(s, tmpl string, loc *time.Location) (time.Time, error) {
+	var (
+		year, month, day        = 1, 1, 1
+		yearDay                 = 0
+		hour, minute, sec, nano = 0, 0, 0, 0
+		hour12, pm              = false, false
+		offset                  *int
+	)
+	errInvalid := func(what string) error {
+		return fmt.Errorf("invalid value for %v in '%v' for the format '%v'", what, s, tmpl)
+	}
+	rest := s
+
+	for _, item := range parseDateTimeTemplate(tmpl) {
+		if item.pattern == "" {
+			for _, c := range item.text {
+				if unicode.IsSpace(c) {
+					rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+					continue
+				}
+				if rest == "" {
+					return time.Time{}, errInvalid(fmt.Sprintf("'%c'", c))
+				}
+				r := rune(rest[0])
+				if r == c || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+					// other separators are accepted like PostgreSQL does
+					rest = rest[1:]
+					continue
+				}
+				return time.Time{}, errInvalid(fmt.Sprintf("'%c'", c))
+			}
+			continue
+		}
+
+		// number reads an unsigned integer having at most width digits
+		number := func(width int) (int, error) {
+			rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+			n := 0
+			for n < len(rest) && n < width && rest[n] >= '0' && rest[n] <= '9' {
+				n++
+			}
+			if n == 0 {
+				return 0, errInvalid(item.text)
+			}
+			v, _ := strconv.Atoi(rest[:n])
+			rest = rest[n:]
+			return v, nil
+		}
+		// name reads one of the names (or their 3-letter abbreviations)
+		// case-insensitively and returns its index
+		name := func(names []string) (int, error) {
+			rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+			for _, full := range []bool{true, false} {
+				for i, n := range names {
+					if !full {
+						n = n[:3]
+					}
+					if len(rest) >= len(n) && strings.EqualFold(rest[:len(n)], n) {
+						rest = rest[len(n):]
+						return i, nil
+					}
+				}
+			}
+			return 0, errInvalid(item.text)
+		}
+
+		var err error
+		switch item.pattern {
+		case "HH", "HH12":
+			hour, err = number(2)
+			hour12 = true
+		case "HH24":
+			hour, err = number(2)
+		case "MI":
+			minute, err = number(2)
+		case "SS":
+			sec, err = number(2)
+		case "SSSS":
+			var secs int
+			secs, err = number(5)
+			hour, minute, sec = secs/3600, secs/60%60, secs%60
+		case "MS":
+			var ms int
+			ms, err = number(3)
+			nano = ms * int(time.Millisecond)
+		case "US":
+			var us int
+			us, err = number(6)
+			nano = us * int(time.Microsecond)
+		case "AM", "PM", "A.M.", "P.M.":
+			var i int
+			i, err = name([]string{"AM", "PM", "A.M.", "P.M."})
+			pm = i%2 == 1
+		case "YYYY", "IYYY", "YYY":
+			year, err = number(4)
+		case "YY":
+			year, err = number(2)
+			if year < 70 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case "Y":
+			year, err = number(1)
+			year += 2000
+		case "MONTH", "MON":
+			var i int
+			i, err = name(monthNames)
+			month = i + 1
+		case "MM":
+			month, err = number(2)
+		case "DAY", "DY":
+			_, err = name(dayNames)
+		case "DDD":
+			yearDay, err = number(3)
+		case "DD":
+			day, err = number(2)
+		case "D", "ID", "Q":
+			_, err = number(1)
+		case "IW", "WW", "CC":
+			_, err = number(2)
+		case "OF":
+			rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+			sign := 1
+			if strings.HasPrefix(rest, "-") {
+				sign = -1
+			} else if !strings.HasPrefix(rest, "+") {
+				return time.Time{}, errInvalid(item.text)
+			}
+			rest = rest[1:]
+			var h, m int
+			if h, err = number(2); err != nil {
+				break
+			}
+			if strings.HasPrefix(rest, ":") {
+				rest = rest[1:]
+				if m, err = number(2); err != nil {
+					break
+				}
+			}
+			o := sign * (h*3600 + m*60)
+			offset = &o
+		case "TZ":
+			return time.Time{}, fmt.Errorf("TZ is not supported by to_timestamp, use OF instead")
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if strings.TrimSpace(rest) != "" {
+		return time.Time{}, fmt.Errorf("'%v' has extra characters for the format '%v'", s, tmpl)
+	}
+
+	if hour12 {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, fmt.Errorf("hour %v is out of range for the 12-hour clock", hour)
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	} else if pm && hour < 12 {
+		hour += 12
+	}
+	if offset != nil {
+		loc = time.FixedZone("", *offset)
+	}
+	if yearDay > 0 {
+		t := time.Date(year, time.January, yearDay, hour, minute, sec, nano, loc)
+		if t.Year() != year {
+			return time.Time{}, fmt.Errorf("day of the year %v is out of range", yearDay)
+		}
+		return t, nil
+	}
+	t := time.Date(year, time.Month(month), day, hour, minute, sec, nano, loc)
+	if t.Day() != day || int(t.Month()) != month || hour > 23 || minute > 59 || sec > 59 {
+		return time.Time{}, fmt.Errorf("date/time field value out of range: '%v'", s)
+	}
+	return t, nil
+}
+
+var (
+	monthNames = []string{"January", "February", "March", "April", "May",
+		"June", "July", "August", "September", "October", "November", "December"}
+	dayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday",
+		"Thursday", "Friday", "Saturday"}
+)
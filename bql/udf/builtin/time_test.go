@@ -123,3 +123,177 @@ func TestBinaryDateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestDateTimeFuncs(t *testing.T) {
+	ts := func(y int, mon time.Month, d, h, m, s, ns int) data.Value {
+		return data.Timestamp(time.Date(y, mon, d, h, m, s, ns, time.UTC))
+	}
+	someTime := ts(2015, time.May, 2, 14, 27, 35, 123456789) // Saturday
+	jst := time.FixedZone("JST", 9*3600)
+
+	type dateTimeTestCase struct {
+		args     []data.Value
+		expected data.Value // nil means an error
+	}
+	cases := []struct {
+		name  string
+		f     udf.UDF
+		cases []dateTimeTestCase
+	}{
+		{"date_trunc", dateTruncFunc, []dateTimeTestCase{
+			{[]data.Value{data.String("microseconds"), someTime}, ts(2015, time.May, 2, 14, 27, 35, 123456000)},
+			{[]data.Value{data.String("milliseconds"), someTime}, ts(2015, time.May, 2, 14, 27, 35, 123000000)},
+			{[]data.Value{data.String("second"), someTime}, ts(2015, time.May, 2, 14, 27, 35, 0)},
+			{[]data.Value{data.String("Minutes"), someTime}, ts(2015, time.May, 2, 14, 27, 0, 0)},
+			{[]data.Value{data.String("hour"), someTime}, ts(2015, time.May, 2, 14, 0, 0, 0)},
+			{[]data.Value{data.String("day"), someTime}, ts(2015, time.May, 2, 0, 0, 0, 0)},
+			{[]data.Value{data.String("week"), someTime}, ts(2015, time.April, 27, 0, 0, 0, 0)},
+			{[]data.Value{data.String("month"), someTime}, ts(2015, time.May, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.String("quarter"), someTime}, ts(2015, time.April, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.String("year"), someTime}, ts(2015, time.January, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.String("decade"), someTime}, ts(2010, time.January, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.String("century"), someTime}, ts(2001, time.January, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.String("millennium"), someTime}, ts(2001, time.January, 1, 0, 0, 0, 0)},
+			// truncated in the given time zone
+			{[]data.Value{data.String("day"), someTime, data.String("+09:00")},
+				ts(2015, time.May, 1, 15, 0, 0, 0)},
+			{[]data.Value{data.String("fortnight"), someTime}, nil},
+			{[]data.Value{data.String("day"), someTime, data.String("Nowhere/Nothing")}, nil},
+			{[]data.Value{data.Null{}, someTime}, data.Null{}},
+			{[]data.Value{data.String("day"), data.Null{}}, data.Null{}},
+		}},
+		{"date_bin", dateBinFunc, []dateTimeTestCase{
+			{[]data.Value{data.String("15 minutes"), someTime}, ts(2015, time.May, 2, 14, 15, 0, 0)},
+			{[]data.Value{data.Interval{0, 0, 10 * 60 * 1000000}, someTime,
+				ts(2015, time.May, 2, 0, 5, 0, 0)}, ts(2015, time.May, 2, 14, 25, 0, 0)},
+			{[]data.Value{data.String("1 day"), ts(1969, time.December, 31, 12, 0, 0, 0)},
+				ts(1969, time.December, 31, 0, 0, 0, 0)},
+			{[]data.Value{data.String("1 month"), someTime}, nil},
+			{[]data.Value{data.String("-1 hour"), someTime}, nil},
+		}},
+		{"date_part", datePartFunc, []dateTimeTestCase{
+			{[]data.Value{data.String("microseconds"), someTime}, data.Float(35123456)},
+			{[]data.Value{data.String("second"), someTime}, data.Float(35.123456789)},
+			{[]data.Value{data.String("minute"), someTime}, data.Float(27)},
+			{[]data.Value{data.String("hour"), someTime}, data.Float(14)},
+			{[]data.Value{data.String("hour"), someTime, data.String("+09")}, data.Float(23)},
+			{[]data.Value{data.String("day"), someTime}, data.Float(2)},
+			{[]data.Value{data.String("dow"), someTime}, data.Float(6)},
+			{[]data.Value{data.String("isodow"), ts(2015, time.May, 3, 0, 0, 0, 0)}, data.Float(7)},
+			{[]data.Value{data.String("doy"), someTime}, data.Float(122)},
+			{[]data.Value{data.String("week"), ts(2016, time.January, 1, 0, 0, 0, 0)}, data.Float(53)},
+			{[]data.Value{data.String("isoyear"), ts(2016, time.January, 1, 0, 0, 0, 0)}, data.Float(2015)},
+			{[]data.Value{data.String("month"), someTime}, data.Float(5)},
+			{[]data.Value{data.String("quarter"), someTime}, data.Float(2)},
+			{[]data.Value{data.String("year"), someTime}, data.Float(2015)},
+			{[]data.Value{data.String("century"), ts(2000, time.January, 1, 0, 0, 0, 0)}, data.Float(20)},
+			{[]data.Value{data.String("epoch"), ts(1970, time.January, 1, 0, 1, 0, 500000000)}, data.Float(60.5)},
+			{[]data.Value{data.String("timezone"), data.Timestamp(time.Date(2015, 1, 1, 0, 0, 0, 0, jst))},
+				data.Float(9 * 3600)},
+			{[]data.Value{data.String("hour"), data.Interval{14, 3, 25 * 3600 * 1000000}}, data.Float(25)},
+			{[]data.Value{data.String("month"), data.Interval{14, 3, 0}}, data.Float(2)},
+			{[]data.Value{data.String("year"), data.Interval{14, 3, 0}}, data.Float(1)},
+			{[]data.Value{data.String("epoch"), data.Interval{0, 1, 1000000}}, data.Float(86401)},
+			{[]data.Value{data.String("dow"), data.Interval{0, 1, 0}}, nil},
+			{[]data.Value{data.String("fortnight"), someTime}, nil},
+		}},
+		{"to_char", toCharFunc, []dateTimeTestCase{
+			{[]data.Value{someTime, data.String("YYYY-MM-DD HH24:MI:SS.MS")},
+				data.String("2015-05-02 14:27:35.123")},
+			{[]data.Value{someTime, data.String("FMHH12:MI PM, Day, FMDD Mon YY")},
+				data.String("2:27 PM, Saturday , 2 May 15")},
+			{[]data.Value{someTime, data.String("FMMonth month MONTH dy")},
+				data.String("May may       MAY       sat")},
+			{[]data.Value{someTime, data.String(`"Q"Q "week" IW, DDD, US`)},
+				data.String("Q2 week 18, 122, 123456")},
+			{[]data.Value{someTime, data.String("HH24:MI TZ OF"), data.String("+05:30")},
+				data.String("19:57 +05:30 +05:30")},
+		}},
+		{"to_timestamp", toTimestampFunc, []dateTimeTestCase{
+			{[]data.Value{data.String("2015-05-02 14:27:35.123"), data.String("YYYY-MM-DD HH24:MI:SS.MS")},
+				ts(2015, time.May, 2, 14, 27, 35, 123000000)},
+			{[]data.Value{data.String("02 May 2015 2:27 pm"), data.String("DD Mon YYYY HH:MI AM")},
+				ts(2015, time.May, 2, 14, 27, 0, 0)},
+			{[]data.Value{data.String("2015/05/02 14:27"), data.String("YYYY-MM-DD HH24:MI"),
+				data.String("+09:00")}, ts(2015, time.May, 2, 5, 27, 0, 0)},
+			{[]data.Value{data.String("2015-05-02T14:27:00+09"), data.String(`YYYY-MM-DD"T"HH24:MI:SSOF`)},
+				ts(2015, time.May, 2, 5, 27, 0, 0)},
+			{[]data.Value{data.String("15.122"), data.String("YY.DDD")}, ts(2015, time.May, 2, 0, 0, 0, 0)},
+			{[]data.Value{data.Float(60.5)}, ts(1970, time.January, 1, 0, 1, 0, 500000000)},
+			{[]data.Value{data.Int(-1)}, ts(1969, time.December, 31, 23, 59, 59, 0)},
+			{[]data.Value{data.String("2015-02-30"), data.String("YYYY-MM-DD")}, nil},
+			{[]data.Value{data.String("2015-05-02 extra"), data.String("YYYY-MM-DD")}, nil},
+			{[]data.Value{data.String("13:00 PM"), data.String("HH:MI PM")}, nil},
+			{[]data.Value{data.String("May"), data.String("MM")}, nil},
+			{[]data.Value{data.String("JST"), data.String("TZ")}, nil},
+			{[]data.Value{data.String("2015")}, nil},
+		}},
+		{"timezone", timezoneFunc, []dateTimeTestCase{
+			{[]data.Value{data.String("+09:00"), someTime}, someTime},
+			{[]data.Value{data.String("UTC"), someTime}, someTime},
+			{[]data.Value{data.String("+9 hours"), someTime}, nil},
+			{[]data.Value{data.Null{}, someTime}, data.Null{}},
+		}},
+	}
+
+	for _, c := range cases {
+		c := c
+		Convey(fmt.Sprintf("Given the %s function", c.name), t, func() {
+			for _, tc := range c.cases {
+				tc := tc
+				Convey(fmt.Sprintf("When evaluating it on %v", tc.args), func() {
+					val, err := c.f.Call(nil, tc.args...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %v", tc.expected), func() {
+							So(err, ShouldBeNil)
+							switch tc.expected.Type() {
+							case data.TypeFloat:
+								So(val, ShouldAlmostEqual, tc.expected, 0.0000001)
+							case data.TypeTimestamp:
+								// compare instants as the time zone may differ
+								So(val.Type(), ShouldEqual, data.TypeTimestamp)
+								actual, _ := data.AsTimestamp(val)
+								expected, _ := data.AsTimestamp(tc.expected)
+								So(actual.Equal(expected), ShouldBeTrue)
+							default:
+								So(val, ShouldResemble, tc.expected)
+							}
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(c.name, 2)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, c.f)
+			})
+		})
+	}
+
+	Convey("Given the timezone function", t, func() {
+		Convey("When converting a timestamp to another time zone", func() {
+			val, err := timezoneFunc.Call(nil, data.String("+09:00"), someTime)
+			So(err, ShouldBeNil)
+
+			Convey("Then the local time should be the one in the time zone", func() {
+				s, err := toCharFunc.Call(nil, val, data.String("YYYY-MM-DD HH24:MI OF"))
+				So(err, ShouldBeNil)
+				So(s, ShouldEqual, data.String("2015-05-02 23:27 +09"))
+			})
+		})
+	})
+
+	Convey("Given the extract function", t, func() {
+		Convey("Then it should be registered as an alias of date_part", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("extract", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldEqual, datePartFunc)
+		})
+	})
+}
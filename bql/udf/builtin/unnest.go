@@ -0,0 +1,61 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// unnestUDSF expands an array in the tuples of a stream. For each
+// element of the array, it emits a copy of the tuple in which the
+// array is replaced by the element. Tuples having an empty array,
+// NULL, or no value at the path do not produce any output.
+//
+// It can be used in BQL as `unnest`:
+//
+//	SELECT RSTREAM order_id, items.name
+//	  FROM unnest("orders", "items") [RANGE 1 TUPLES];
+//
+//  Input: String (stream name), String (JSON Path of the array)
+type unnestUDSF struct {
+	path data.Path
+}
+
+func (u *unnestUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	v, err := t.Data.Get(u.path)
+	if err != nil || v.Type() == data.TypeNull {
+		return nil
+	}
+	a, err := data.AsArray(v)
+	if err != nil {
+		return fmt.Errorf("%v is not an array", v)
+	}
+	for _, elem := range a {
+		out := t.Copy()
+		if err := out.Data.Set(u.path, elem); err != nil {
+			return err
+		}
+		if err := w.Write(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *unnestUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func createUnnestUDSF(decl udf.UDSFDeclarer, stream string, path string) (udf.UDSF, error) {
+	p, err := data.CompilePath(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := decl.Input(stream, nil); err != nil {
+		return nil, err
+	}
+	return &unnestUDSF{
+		path: p,
+	}, nil
+}
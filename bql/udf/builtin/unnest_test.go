@@ -0,0 +1,79 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestUnnestUDSF(t *testing.T) {
+	Convey("Given the unnest UDSF", t, func() {
+		ctx := core.NewContext(nil)
+		decl := udf.NewUDSFDeclarer()
+		f, err := createUnnestUDSF(decl, "s", "a.items")
+		So(err, ShouldBeNil)
+		So(decl.ListInputs(), ShouldContainKey, "s")
+
+		var output []data.Map
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			output = append(output, t.Data)
+			return nil
+		})
+
+		Convey("When processing a tuple having an array", func() {
+			err := f.Process(ctx, core.NewTuple(data.Map{
+				"id": data.Int(1),
+				"a":  data.Map{"items": data.Array{data.String("x"), data.String("y")}},
+			}), w)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit a tuple for each element", func() {
+				So(output, ShouldResemble, []data.Map{
+					{"id": data.Int(1), "a": data.Map{"items": data.String("x")}},
+					{"id": data.Int(1), "a": data.Map{"items": data.String("y")}},
+				})
+			})
+		})
+
+		Convey("When processing tuples without elements", func() {
+			for _, d := range []data.Map{
+				{"a": data.Map{"items": data.Array{}}},
+				{"a": data.Map{"items": data.Null{}}},
+				{"a": data.Map{}},
+			} {
+				So(f.Process(ctx, core.NewTuple(d), w), ShouldBeNil)
+			}
+
+			Convey("Then it should not emit anything", func() {
+				So(output, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When processing a tuple having a non-array value", func() {
+			err := f.Process(ctx, core.NewTuple(data.Map{
+				"a": data.Map{"items": data.Int(1)},
+			}), w)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should be registered as a UDSF", func() {
+			r, err := udf.CopyGlobalUDSFCreatorRegistry()
+			So(err, ShouldBeNil)
+			_, err = r.Lookup("unnest", 2)
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("Given an invalid path", t, func() {
+		_, err := createUnnestUDSF(udf.NewUDSFDeclarer(), "s", "a[")
+
+		Convey("Then the unnest UDSF cannot be created", func() {
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
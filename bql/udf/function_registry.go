@@ -24,6 +24,21 @@ type UDF interface {
 	IsAggregationParameter(k int) bool
 }
 
+// CallSiteSpecializer is a UDF that can prepare itself for a call site
+// where some of the arguments are constants, e.g., to compile a regular
+// expression given as a literal only once instead of on every call.
+type CallSiteSpecializer interface {
+	UDF
+
+	// Specialize returns the UDF to be called at a call site instead of
+	// this one. consts has an element for each argument, which is the
+	// value of the argument if it's a constant and nil otherwise. The
+	// returned UDF still receives all arguments when it's called. An
+	// error is returned when the constants are invalid, e.g., when a
+	// regular expression cannot be compiled.
+	Specialize(consts []data.Value) (UDF, error)
+}
+
 type function struct {
 	f     func(*core.Context, ...data.Value) (data.Value, error)
 	arity int
@@ -177,7 +192,7 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	switch lowerName {
 	case "count", "avg", "max", "min", "sum",
 		"coalesce", "lower", "upper", "octet_length",
-		"substring":
+		"substring", "first", "last", "extract", "repeat":
 		// skip check
 	default:
 		if err := core.ValidateSymbol(name); err != nil {
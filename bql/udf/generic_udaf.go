@@ -0,0 +1,268 @@
+package udf
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"reflect"
+)
+
+// ConvertGenericUDAF creates a new UDAF from a function returning a new
+// state of the aggregate function, e.g. func() *myState. The function may
+// receive a *core.Context and may return an error as its second return
+// value. The type of the state must have the following methods, each of
+// which may receive a *core.Context as its first parameter:
+//
+//	- Accumulate(args...) [error] (required)
+//	- Retract(args...) [error]
+//	- Merge(other T) [error], where T is the type of the state
+//	- Finalize() (R[, error]) (required)
+//
+// Arguments of Accumulate and Retract are converted in the same way as
+// ConvertGeneric converts arguments, and both methods must have the same
+// number of parameters, which is the arity of the UDAF. Variadic methods
+// are not supported. R must be convertible to data.Value. If Retract or
+// Merge is missing, the corresponding method of UDAFState returns an error.
+//
+// For example, a UDAF computing the sum of squares can be created as
+// follows:
+//
+//	type sumSquares struct {
+//		sum float64
+//	}
+//
+//	func (s *sumSquares) Accumulate(v float64) { s.sum += v * v }
+//	func (s *sumSquares) Retract(v float64)    { s.sum -= v * v }
+//	func (s *sumSquares) Merge(o *sumSquares)  { s.sum += o.sum }
+//	func (s *sumSquares) Finalize() float64    { return s.sum }
+//
+//	udaf, err := ConvertGenericUDAF(func() *sumSquares { return &sumSquares{} })
+func ConvertGenericUDAF(init interface{}) (UDAF, error) {
+	t := reflect.TypeOf(init)
+	if t == nil || t.Kind() != reflect.Func {
+		return nil, errors.New("the argument must be a function")
+	}
+	g := &genericUDAF{
+		init:       reflect.ValueOf(init),
+		hasContext: genericFuncHasContext(t),
+	}
+	if t.IsVariadic() || t.NumIn() > 1 || (t.NumIn() == 1 && !g.hasContext) {
+		return nil, errors.New("the function must not have parameters other than *core.Context")
+	}
+	switch t.NumOut() {
+	case 2:
+		if !t.Out(1).Implements(reflect.TypeOf(func(error) {}).In(0)) {
+			return nil, fmt.Errorf("the second return value must be an error: %v", t.Out(1))
+		}
+		g.hasError = true
+	case 1:
+	default:
+		return nil, fmt.Errorf("the number of return values must be 1 or 2: %v", t.NumOut())
+	}
+	state := t.Out(0)
+	if state.Kind() == reflect.Interface {
+		return nil, fmt.Errorf("the state must have a concrete type: %v", state)
+	}
+
+	var err error
+	if g.accumulate, err = newGenericMethod(state, "Accumulate", true); err != nil {
+		return nil, err
+	}
+	if g.retract, err = newGenericMethod(state, "Retract", false); err != nil {
+		return nil, err
+	}
+	if g.merge, err = newGenericMethod(state, "Merge", false); err != nil {
+		return nil, err
+	}
+	if g.finalize, err = newGenericMethod(state, "Finalize", true); err != nil {
+		return nil, err
+	}
+
+	g.arity = len(g.accumulate.converters)
+	if g.arity == 0 {
+		return nil, errors.New("Accumulate must have at least one argument")
+	}
+	if g.retract != nil && len(g.retract.converters) != g.arity {
+		return nil, errors.New("Retract must have the same number of arguments as Accumulate")
+	}
+	for _, m := range []*genericMethod{g.accumulate, g.retract, g.merge} {
+		if m == nil {
+			continue
+		}
+		if n := m.method.Type().NumOut(); n > 1 || (n == 1 && !m.hasError) {
+			return nil, fmt.Errorf("%v must return nothing or an error", m.name)
+		}
+	}
+	if g.merge != nil {
+		mt := g.merge.method.Type()
+		if mt.NumIn() != g.merge.argStart+1 || mt.In(g.merge.argStart) != state {
+			return nil, fmt.Errorf("Merge must receive another state of type %v", state)
+		}
+		g.merge.converters = nil
+	}
+	ft := g.finalize.method.Type()
+	if ft.NumIn() != g.finalize.argStart {
+		return nil, errors.New("Finalize must not have parameters other than *core.Context")
+	}
+	if hasError, err := checkGenericFuncReturnTypes(ft); err != nil {
+		return nil, fmt.Errorf("Finalize has an invalid return type: %v", err)
+	} else if hasError != g.finalize.hasError {
+		return nil, errors.New("Finalize must return an error as its second return value")
+	}
+	return g, nil
+}
+
+// MustConvertGenericUDAF is like ConvertGenericUDAF, but panics on errors.
+func MustConvertGenericUDAF(init interface{}) UDAF {
+	f, err := ConvertGenericUDAF(init)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// genericMethod is a method of the state of a genericUDAF. The receiver
+// is passed as the first argument of method.
+type genericMethod struct {
+	name       string
+	method     reflect.Value
+	hasContext bool
+	hasError   bool
+	// argStart is the index of the first parameter of the method
+	// excluding the receiver and *core.Context
+	argStart   int
+	converters []argumentConverter
+}
+
+// newGenericMethod returns nil if the type doesn't have the method
+// and it isn't required.
+func newGenericMethod(state reflect.Type, name string, required bool) (*genericMethod, error) {
+	m, ok := state.MethodByName(name)
+	if !ok {
+		if required {
+			return nil, fmt.Errorf("the state doesn't have a %v method: %v", name, state)
+		}
+		return nil, nil
+	}
+	t := m.Type
+	if t.IsVariadic() {
+		return nil, fmt.Errorf("%v must not be variadic", name)
+	}
+	g := &genericMethod{
+		name:     name,
+		method:   m.Func,
+		argStart: 1,
+	}
+	if t.NumIn() > 1 && reflect.TypeOf(&core.Context{}).AssignableTo(t.In(1)) {
+		g.hasContext = true
+		g.argStart++
+	}
+	if n := t.NumOut(); n > 0 {
+		g.hasError = t.Out(n - 1).Implements(reflect.TypeOf(func(error) {}).In(0))
+	}
+	if name == "Merge" {
+		// the other state isn't converted from a data.Value
+		return g, nil
+	}
+	convs, err := createGenericConverters(t, g.argStart)
+	if err != nil {
+		return nil, err
+	}
+	g.converters = convs
+	return g, nil
+}
+
+func (m *genericMethod) call(ctx *core.Context, recv reflect.Value, args ...reflect.Value) ([]reflect.Value, error) {
+	in := make([]reflect.Value, 0, len(args)+2)
+	in = append(in, recv)
+	if m.hasContext {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	in = append(in, args...)
+	out := m.method.Call(in)
+	if m.hasError && !out[len(out)-1].IsNil() {
+		return nil, out[len(out)-1].Interface().(error)
+	}
+	return out, nil
+}
+
+func (m *genericMethod) convertAndCall(ctx *core.Context, recv reflect.Value, args []data.Value) error {
+	if len(args) != len(m.converters) {
+		return fmt.Errorf("%v takes %v arguments, not %v", m.name, len(m.converters), len(args))
+	}
+	in := make([]reflect.Value, len(args))
+	for i, arg := range args {
+		v, err := m.converters[i](arg)
+		if err != nil {
+			return err
+		}
+		in[i] = reflect.ValueOf(v)
+	}
+	_, err := m.call(ctx, recv, in...)
+	return err
+}
+
+type genericUDAF struct {
+	init       reflect.Value
+	hasContext bool
+	hasError   bool
+	arity      int
+
+	accumulate *genericMethod
+	retract    *genericMethod
+	merge      *genericMethod
+	finalize   *genericMethod
+}
+
+func (g *genericUDAF) Accept(arity int) bool {
+	return arity == g.arity
+}
+
+func (g *genericUDAF) Init(ctx *core.Context) (UDAFState, error) {
+	var in []reflect.Value
+	if g.hasContext {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+	out := g.init.Call(in)
+	if g.hasError && !out[1].IsNil() {
+		return nil, out[1].Interface().(error)
+	}
+	return &genericUDAFState{g, out[0]}, nil
+}
+
+type genericUDAFState struct {
+	udaf  *genericUDAF
+	state reflect.Value
+}
+
+func (s *genericUDAFState) Accumulate(ctx *core.Context, args ...data.Value) error {
+	return s.udaf.accumulate.convertAndCall(ctx, s.state, args)
+}
+
+func (s *genericUDAFState) Retract(ctx *core.Context, args ...data.Value) error {
+	if s.udaf.retract == nil {
+		return ErrRetractionNotSupported
+	}
+	return s.udaf.retract.convertAndCall(ctx, s.state, args)
+}
+
+func (s *genericUDAFState) Merge(ctx *core.Context, other UDAFState) error {
+	o, ok := other.(*genericUDAFState)
+	if !ok || o.udaf != s.udaf {
+		return errors.New("cannot merge states of different aggregate functions")
+	}
+	if s.udaf.merge == nil {
+		return errors.New("the aggregate function doesn't support merging")
+	}
+	_, err := s.udaf.merge.call(ctx, s.state, o.state)
+	return err
+}
+
+func (s *genericUDAFState) Finalize(ctx *core.Context) (data.Value, error) {
+	out, err := s.udaf.finalize.call(ctx, s.state)
+	if err != nil {
+		return nil, err
+	}
+	return data.NewValue(out[0].Interface())
+}
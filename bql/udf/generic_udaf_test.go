@@ -0,0 +1,193 @@
+package udf
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+type weightedSum struct {
+	sum float64
+}
+
+func (s *weightedSum) Accumulate(ctx *core.Context, v float64, w int) error {
+	if w < 0 {
+		return errors.New("negative weight")
+	}
+	s.sum += v * float64(w)
+	return nil
+}
+
+func (s *weightedSum) Retract(v float64, w int) {
+	s.sum -= v * float64(w)
+}
+
+func (s *weightedSum) Merge(o *weightedSum) {
+	s.sum += o.sum
+}
+
+func (s *weightedSum) Finalize() float64 {
+	return s.sum
+}
+
+type concatState struct {
+	strs []string
+}
+
+func (s *concatState) Accumulate(v string) {
+	s.strs = append(s.strs, v)
+}
+
+func (s *concatState) Finalize(ctx *core.Context) (string, error) {
+	if len(s.strs) == 0 {
+		return "", errors.New("no values")
+	}
+	res := ""
+	for _, str := range s.strs {
+		res += str
+	}
+	return res, nil
+}
+
+func TestGenericUDAF(t *testing.T) {
+	ctx := &core.Context{}
+
+	Convey("Given a generic UDAF with all methods", t, func() {
+		f, err := ConvertGenericUDAF(func() *weightedSum { return &weightedSum{} })
+		So(err, ShouldBeNil)
+
+		Convey("Then it should accept two arguments", func() {
+			So(f.Accept(2), ShouldBeTrue)
+			So(f.Accept(1), ShouldBeFalse)
+			So(f.Accept(3), ShouldBeFalse)
+		})
+
+		Convey("When accumulating values", func() {
+			s, err := f.Init(ctx)
+			So(err, ShouldBeNil)
+			So(s.Accumulate(ctx, data.Float(1.5), data.Int(2)), ShouldBeNil)
+			So(s.Accumulate(ctx, data.Int(2), data.String("3")), ShouldBeNil)
+
+			Convey("Then it should return the result", func() {
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(9))
+			})
+
+			Convey("Then it should return the result after a retraction", func() {
+				So(s.Retract(ctx, data.Float(1.5), data.Int(2)), ShouldBeNil)
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(6))
+			})
+
+			Convey("Then it should merge another state", func() {
+				o, err := f.Init(ctx)
+				So(err, ShouldBeNil)
+				So(o.Accumulate(ctx, data.Float(0.5), data.Int(2)), ShouldBeNil)
+				So(s.Merge(ctx, o), ShouldBeNil)
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(10))
+			})
+
+			Convey("Then a new state should be empty", func() {
+				o, err := f.Init(ctx)
+				So(err, ShouldBeNil)
+				res, err := o.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(0))
+			})
+
+			Convey("Then it should fail with an error returned from the method", func() {
+				So(s.Accumulate(ctx, data.Float(1), data.Int(-1)), ShouldNotBeNil)
+			})
+
+			Convey("Then it should fail with arguments that cannot be converted", func() {
+				So(s.Accumulate(ctx, data.Map{}, data.Int(1)), ShouldNotBeNil)
+			})
+
+			Convey("Then it should fail with a wrong number of arguments", func() {
+				So(s.Accumulate(ctx, data.Float(1)), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a generic UDAF without Retract and Merge", t, func() {
+		f, err := ConvertGenericUDAF(func(ctx *core.Context) (*concatState, error) {
+			return &concatState{}, nil
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When accumulating values", func() {
+			s, err := f.Init(ctx)
+			So(err, ShouldBeNil)
+			So(s.Accumulate(ctx, data.String("a")), ShouldBeNil)
+			So(s.Accumulate(ctx, data.Int(1)), ShouldBeNil)
+
+			Convey("Then it should return the result", func() {
+				res, err := s.Finalize(ctx)
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.String("a1"))
+			})
+
+			Convey("Then it should not support retraction", func() {
+				So(s.Retract(ctx, data.String("a")), ShouldEqual, ErrRetractionNotSupported)
+			})
+
+			Convey("Then it should not support merging", func() {
+				o, err := f.Init(ctx)
+				So(err, ShouldBeNil)
+				So(s.Merge(ctx, o), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When finalizing an empty state", func() {
+			s, err := f.Init(ctx)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the error of Finalize", func() {
+				_, err := s.Finalize(ctx)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a generic UDAF whose init function fails", t, func() {
+		f, err := ConvertGenericUDAF(func() (*concatState, error) {
+			return nil, errors.New("failure")
+		})
+		So(err, ShouldBeNil)
+
+		Convey("Then Init should fail", func() {
+			_, err := f.Init(ctx)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given invalid functions", t, func() {
+		cases := []struct {
+			title string
+			f     interface{}
+		}{
+			{"When passing a non-function", &weightedSum{}},
+			{"When passing a function with parameters", func(i int) *weightedSum { return nil }},
+			{"When passing a function returning an interface", func() interface{} { return nil }},
+			{"When passing a function returning a state without Accumulate", func() *struct{} { return nil }},
+			{"When passing a function returning a state without Finalize", func() weightedSum { return weightedSum{} }},
+		}
+
+		for _, c := range cases {
+			c := c
+			Convey(c.title, func() {
+				_, err := ConvertGenericUDAF(c.f)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+}
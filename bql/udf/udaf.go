@@ -0,0 +1,114 @@
+package udf
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// ErrRetractionNotSupported is returned from UDAFState.Retract when the
+// state cannot remove a value once it has been accumulated. Execution
+// plans then rebuild the state from the remaining values.
+var ErrRetractionNotSupported = errors.New("the aggregate function doesn't support retraction")
+
+// UDAF is a user defined aggregate function that computes its result
+// by accumulating the values of one row at a time in a state, instead
+// of receiving arrays of all values of a group like aggregate UDFs do.
+// All parameters of a UDAF are aggregation parameters.
+//
+// A UDAF can be registered with RegisterGlobalUDAF or, after converting
+// it with AggregateFunc, with any FunctionManager.
+type UDAF interface {
+	// Accept checks if the function accepts the given number of arguments
+	// excluding core.Context.
+	Accept(arity int) bool
+
+	// Init returns a new state for an empty set of values.
+	Init(ctx *core.Context) (UDAFState, error)
+}
+
+// UDAFState holds the state of a UDAF for one group of rows.
+type UDAFState interface {
+	// Accumulate adds the arguments computed from one row to the state.
+	// If an error is returned, the state is undefined and must not be
+	// used anymore.
+	Accumulate(ctx *core.Context, args ...data.Value) error
+
+	// Retract removes the arguments of a row that have been accumulated
+	// before. It returns ErrRetractionNotSupported if the state doesn't
+	// support retraction.
+	Retract(ctx *core.Context, args ...data.Value) error
+
+	// Merge adds all values accumulated in another state of the same
+	// UDAF to this state. The other state must not be used afterwards.
+	Merge(ctx *core.Context, other UDAFState) error
+
+	// Finalize returns the result of the aggregate function over the
+	// values accumulated so far. It doesn't modify the state, so values
+	// can still be accumulated or retracted afterwards.
+	Finalize(ctx *core.Context) (data.Value, error)
+}
+
+// AggregateFunc creates an aggregate UDF from a UDAF. When the UDF is
+// called with arrays of values (one array per parameter), it accumulates
+// the values at the same index in a new state and returns the final
+// result. The returned UDF also implements UDAF so that execution plans
+// can maintain its state incrementally.
+func AggregateFunc(f UDAF) UDF {
+	return &udafFunc{f}
+}
+
+type udafFunc struct {
+	UDAF
+}
+
+func (f *udafFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("UDAF must have at least one argument")
+	}
+	arrs := make([]data.Array, len(args))
+	for i, arg := range args {
+		arr, err := data.AsArray(arg)
+		if err != nil {
+			return nil, fmt.Errorf("function needs array input, not %T", arg)
+		}
+		if i > 0 && len(arr) != len(arrs[0]) {
+			return nil, fmt.Errorf("all arguments must have the same length")
+		}
+		arrs[i] = arr
+	}
+
+	s, err := f.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]data.Value, len(arrs))
+	for i := range arrs[0] {
+		for j, arr := range arrs {
+			row[j] = arr[i]
+		}
+		if err := s.Accumulate(ctx, row...); err != nil {
+			return nil, err
+		}
+	}
+	return s.Finalize(ctx)
+}
+
+func (f *udafFunc) IsAggregationParameter(k int) bool {
+	return true
+}
+
+// RegisterGlobalUDAF adds a UDAF which is visible to all topologies.
+// See RegisterGlobalUDF for details.
+func RegisterGlobalUDAF(name string, f UDAF) error {
+	return RegisterGlobalUDF(name, AggregateFunc(f))
+}
+
+// MustRegisterGlobalUDAF is like RegisterGlobalUDAF but
+// panics if an error occurred.
+func MustRegisterGlobalUDAF(name string, f UDAF) {
+	if err := RegisterGlobalUDAF(name, f); err != nil {
+		panic(fmt.Errorf("udf.MustRegisterGlobalUDAF: cannot register '%v': %v", name, err))
+	}
+}
@@ -0,0 +1,79 @@
+package udf
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestAggregateFunc(t *testing.T) {
+	ctx := &core.Context{}
+
+	Convey("Given a UDAF converted to a UDF", t, func() {
+		f := AggregateFunc(MustConvertGenericUDAF(func() *weightedSum { return &weightedSum{} }))
+
+		Convey("Then all parameters should be aggregation parameters", func() {
+			So(f.Accept(2), ShouldBeTrue)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+			So(f.IsAggregationParameter(1), ShouldBeTrue)
+		})
+
+		Convey("Then it should still be a UDAF", func() {
+			_, ok := f.(UDAF)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("When calling it with arrays", func() {
+			res, err := f.Call(ctx, data.Array{data.Float(1.5), data.Int(2)},
+				data.Array{data.Int(2), data.Int(3)})
+
+			Convey("Then it should accumulate the values at the same index", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(9))
+			})
+		})
+
+		Convey("When calling it with empty arrays", func() {
+			res, err := f.Call(ctx, data.Array{}, data.Array{})
+
+			Convey("Then it should return the result for an empty state", func() {
+				So(err, ShouldBeNil)
+				So(res, ShouldEqual, data.Float(0))
+			})
+		})
+
+		Convey("When calling it with arrays of different lengths", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1)}, data.Array{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When calling it with a non-array", func() {
+			_, err := f.Call(ctx, data.Int(1), data.Array{data.Int(1)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a default function registry", t, func() {
+		fr := NewDefaultFunctionRegistry(ctx)
+
+		Convey("When registering a UDAF", func() {
+			err := fr.Register("weighted_sum", AggregateFunc(MustConvertGenericUDAF(func() *weightedSum {
+				return &weightedSum{}
+			})))
+			So(err, ShouldBeNil)
+
+			Convey("Then it can be looked up as an aggregate function", func() {
+				f, err := fr.Lookup("weighted_sum", 2)
+				So(err, ShouldBeNil)
+				So(f.IsAggregationParameter(0), ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -59,6 +59,15 @@ type UDSStorage interface {
 	List(topology string) (map[string][]string, error)
 }
 
+// UDSStorageDeleter is implemented by a UDSStorage which can delete saved
+// states.
+type UDSStorageDeleter interface {
+	// Delete deletes the saved data of the state having the tag. It returns
+	// core.NotExistError when the state doesn't have the tag. When a tag is an
+	// empty string, "default" will be used.
+	Delete(topology, state, tag string) error
+}
+
 // UDSStorageWriter is used to save a state. An instance of UDSStorageWriter
 // doesn't have to be thread-safe. It means that an instance may not be able to
 // be used from multiple goroutines. However, different instances can be used
@@ -126,6 +135,8 @@ func (s *inMemoryUDSStorage) Load(topology, state, tag string) (io.ReadCloser, e
 	if !ok {
 		return nil, core.NotExistError(fmt.Errorf("a topology '%v' was not found", topology))
 	}
+	t.m.RLock()
+	defer t.m.RUnlock()
 	st, ok := t.states[state]
 	if !ok {
 		return nil, core.NotExistError(fmt.Errorf("a UDS '%v' was not found", state))
@@ -157,6 +168,35 @@ func (s *inMemoryUDSStorage) List(topology string) (map[string][]string, error)
 	return t.list(), nil
 }
 
+func (s *inMemoryUDSStorage) Delete(topology, state, tag string) error {
+	if tag == "" || strings.ToLower(tag) == "default" {
+		tag = "default"
+	} else if err := core.ValidateSymbol(tag); err != nil {
+		return fmt.Errorf("tag is ill-formatted: %v", err)
+	}
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+	t, ok := s.topologies[topology]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("a topology '%v' was not found", topology))
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	st, ok := t.states[state]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("a UDS '%v' was not found", state))
+	}
+	if _, ok := st[tag]; !ok {
+		return core.NotExistError(fmt.Errorf("a UDS '%v' doesn't have a tag '%v'", state, tag))
+	}
+	delete(st, tag)
+	if len(st) == 0 {
+		delete(t.states, state)
+	}
+	return nil
+}
+
 type topologyUDSStorage struct {
 	m            sync.RWMutex
 	topologyName string
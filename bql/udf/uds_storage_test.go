@@ -198,6 +198,29 @@ func TestInMemoryUDSStorage(t *testing.T) {
 			})
 		})
 
+		Convey("When deleting the state", func() {
+			So(s.(UDSStorageDeleter).Delete("test_topology", "state1", ""), ShouldBeNil)
+
+			Convey("Then it shouldn't be able to be loaded", func() {
+				_, err := s.Load("test_topology", "state1", "")
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then it shouldn't be listed", func() {
+				m, err := s.List("test_topology")
+				So(err, ShouldBeNil)
+				So(m, ShouldBeEmpty)
+			})
+
+			Convey("And deleting it again", func() {
+				err := s.(UDSStorageDeleter).Delete("test_topology", "state1", "")
+
+				Convey("Then it should fail with a not exist error", func() {
+					So(core.IsNotExist(err), ShouldBeTrue)
+				})
+			})
+		})
+
 		Convey("When writing to a committed writer", func() {
 			_, err := io.WriteString(w, "hogehoge")
 
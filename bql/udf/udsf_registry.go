@@ -43,8 +43,15 @@ func NewDefaultUDSFCreatorRegistry() UDSFCreatorRegistry {
 }
 
 func (r *defaultUDSFCreatorRegistry) Register(typeName string, c UDSFCreator) error {
-	if err := core.ValidateSymbol(typeName); err != nil {
-		return fmt.Errorf("invalid name for function: %s", err.Error())
+	// some built-in UDSFs have names that are reserved
+	// words, so we need to add exceptions for them
+	switch strings.ToLower(typeName) {
+	case "unnest":
+		// skip check
+	default:
+		if err := core.ValidateSymbol(typeName); err != nil {
+			return fmt.Errorf("invalid name for function: %s", err.Error())
+		}
 	}
 
 	r.m.Lock()
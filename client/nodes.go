@@ -0,0 +1,72 @@
+package client
+
+import (
+	"path"
+	"time"
+)
+
+// PauseSource pauses the source in the topology. The caller has to close
+// the body of the response.
+func (r *Requester) PauseSource(topology, source string) (*Response, error) {
+	return r.Do(Put, nodePath(topology, "sources", source), map[string]interface{}{
+		"state": "paused",
+	})
+}
+
+// ResumeSource resumes the source in the topology. The caller has to close
+// the body of the response.
+func (r *Requester) ResumeSource(topology, source string) (*Response, error) {
+	return r.Do(Put, nodePath(topology, "sources", source), map[string]interface{}{
+		"state": "running",
+	})
+}
+
+// RewindSource rewinds the source in the topology to the beginning of its
+// stream. The caller has to close the body of the response.
+func (r *Requester) RewindSource(topology, source string) (*Response, error) {
+	return r.Do(Post, rewindPath(topology, source), nil)
+}
+
+// RewindSourceToOffset rewinds the source in the topology to the given
+// offset. The source must support seeking. The caller has to close the
+// body of the response.
+func (r *Requester) RewindSourceToOffset(topology, source string, offset int64) (*Response, error) {
+	return r.Do(Post, rewindPath(topology, source), map[string]interface{}{
+		"offset": offset,
+	})
+}
+
+// RewindSourceToTimestamp rewinds the source in the topology to the first
+// tuple whose timestamp is not before the given time. The source must
+// support seeking. The caller has to close the body of the response.
+func (r *Requester) RewindSourceToTimestamp(topology, source string, ts time.Time) (*Response, error) {
+	return r.Do(Post, rewindPath(topology, source), map[string]interface{}{
+		"timestamp": ts.Format(time.RFC3339Nano),
+	})
+}
+
+// DropSource drops the source from the topology. The caller has to close
+// the body of the response.
+func (r *Requester) DropSource(topology, source string) (*Response, error) {
+	return r.Do(Delete, nodePath(topology, "sources", source), nil)
+}
+
+// DropStream drops the stream from the topology. The caller has to close
+// the body of the response.
+func (r *Requester) DropStream(topology, stream string) (*Response, error) {
+	return r.Do(Delete, nodePath(topology, "streams", stream), nil)
+}
+
+// DropSink drops the sink from the topology. The caller has to close the
+// body of the response.
+func (r *Requester) DropSink(topology, sink string) (*Response, error) {
+	return r.Do(Delete, nodePath(topology, "sinks", sink), nil)
+}
+
+func nodePath(topology, nodeType, name string) string {
+	return path.Join("/topologies", topology, nodeType, name)
+}
+
+func rewindPath(topology, source string) string {
+	return path.Join(nodePath(topology, "sources", source), "rewind")
+}
@@ -47,6 +47,43 @@ func TestServerStatus(t *testing.T) {
 	})
 }
 
+func TestServerMetrics(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server having a topology", t, func() {
+		res, _, err := do(r, Post, "/topologies", map[string]interface{}{
+			"name": "test_topology",
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+		Reset(func() {
+			do(r, Delete, "/topologies/test_topology", nil)
+		})
+
+		res, _, err = do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+			"queries": `CREATE PAUSED SOURCE test_source TYPE dummy;`,
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+		Convey("When getting metrics", func() {
+			res, err := r.Do(Get, "/metrics", nil)
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then the response should be in the Prometheus text format", func() {
+				So(res.Raw.Header.Get("Content-Type"), ShouldStartWith, "text/plain")
+				b, err := res.Body()
+				So(err, ShouldBeNil)
+				So(string(b), ShouldContainSubstring,
+					`sensorbee_node_info{topology="test_topology",node="test_source",node_type="source",state="paused"} 1`)
+			})
+		})
+	})
+}
+
 func jsonNumberToInt64(n interface{}) int64 {
 	ret, err := n.(json.Number).Int64()
 	if err != nil {
@@ -119,6 +119,19 @@ func TestSinks(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
 			})
+
+			Convey("Then dropping the sink should succeed", func() {
+				res, err := r.DropSink("test_topology", "test_sink")
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				res.Close()
+
+				Convey("And the sink should be removed", func() {
+					res, _, err := do(r, Get, "/topologies/test_topology/sinks/test_sink", nil)
+					So(err, ShouldBeNil)
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+				})
+			})
 		})
 	})
 }
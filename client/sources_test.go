@@ -1,12 +1,14 @@
 package client
 
 import (
+	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"gopkg.in/sensorbee/sensorbee.v0/server/testutil"
 	"net/http"
 	"testing"
+	"time"
 )
 
 func TestSources(t *testing.T) {
@@ -122,3 +124,175 @@ func TestSources(t *testing.T) {
 		})
 	})
 }
+
+func TestSourceActions(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server with a topology having a source", t, func() {
+		res, _, err := do(r, Post, "/topologies", map[string]interface{}{
+			"name": "test_topology",
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+		Reset(func() {
+			do(r, Delete, "/topologies/test_topology", nil)
+		})
+
+		res, _, err = do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+			"queries": `CREATE PAUSED SOURCE test_source TYPE rewindable_dummy;
+						CREATE PAUSED SOURCE nonrewindable_source TYPE dummy;`,
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+		type showRes struct {
+			Topology string           `json:"topology"`
+			Source   *response.Source `json:"source"`
+		}
+
+		Convey("When resuming the source", func() {
+			res, err := r.ResumeSource("test_topology", "test_source")
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+			Convey("Then the source should be running", func() {
+				s := showRes{}
+				So(res.ReadJSON(&s), ShouldBeNil)
+				So(s.Source.State, ShouldEqual, "running")
+			})
+
+			Convey("Then pausing the source should succeed", func() {
+				res, err := r.PauseSource("test_topology", "test_source")
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				s := showRes{}
+				So(res.ReadJSON(&s), ShouldBeNil)
+				So(s.Source.State, ShouldEqual, "paused")
+			})
+		})
+
+		Convey("When rewinding the source", func() {
+			res, err := r.RewindSource("test_topology", "test_source")
+			So(err, ShouldBeNil)
+
+			Convey("Then it should succeed", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				res.Close()
+			})
+		})
+
+		Convey("When rewinding the source with an empty body", func() {
+			res, err := r.Do(Post, "/topologies/test_topology/sources/test_source/rewind", map[string]interface{}{})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should succeed", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				res.Close()
+			})
+		})
+
+		Convey("When rewinding a non-seekable source to an offset", func() {
+			res, err := r.RewindSourceToOffset("test_topology", "test_source", 1)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				e, err := res.Error()
+				So(err, ShouldBeNil)
+				msg, err := data.AsString(e.Meta["error"])
+				So(err, ShouldBeNil)
+				So(msg, ShouldContainSubstring, "seeking")
+			})
+		})
+
+		Convey("When rewinding a non-seekable source to a timestamp", func() {
+			res, err := r.RewindSourceToTimestamp("test_topology", "test_source",
+				time.Date(2016, time.January, 1, 0, 0, 0, 0, time.UTC))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				e, err := res.Error()
+				So(err, ShouldBeNil)
+				msg, err := data.AsString(e.Meta["error"])
+				So(err, ShouldBeNil)
+				So(msg, ShouldContainSubstring, "seeking")
+			})
+		})
+
+		Convey("When rewinding the source with an invalid position", func() {
+			for _, body := range []map[string]interface{}{
+				{"offset": -1},
+				{"offset": 1.5},
+				{"offset": "1"},
+				{"timestamp": 1},
+				{"offset": 1, "timestamp": "2016-01-01T00:00:00Z"},
+			} {
+				res, err := r.Do(Post, "/topologies/test_topology/sources/test_source/rewind", body)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then it should fail with %v", body), func() {
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+					res.Close()
+				})
+			}
+		})
+
+		Convey("When rewinding a non-rewindable source", func() {
+			res, err := r.RewindSource("test_topology", "nonrewindable_source")
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				res.Close()
+			})
+		})
+
+		Convey("When updating the source with an invalid state", func() {
+			res, err := r.Do(Put, "/topologies/test_topology/sources/test_source", map[string]interface{}{
+				"state": "stopped",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				res.Close()
+			})
+		})
+
+		Convey("When updating the source without a state", func() {
+			res, err := r.Do(Put, "/topologies/test_topology/sources/test_source", map[string]interface{}{})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				res.Close()
+			})
+		})
+
+		Convey("When dropping the source", func() {
+			res, err := r.DropSource("test_topology", "test_source")
+			So(err, ShouldBeNil)
+			So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+			res.Close()
+
+			Convey("Then the source should be removed", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/sources/test_source", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When dropping a nonexistent source", func() {
+			res, err := r.DropSource("test_topology", "no_such_source")
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+				res.Close()
+			})
+		})
+	})
+}
@@ -120,6 +120,26 @@ func TestStreams(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
 			})
+
+			Convey("Then dropping the stream should succeed", func() {
+				res, err := r.DropStream("test_topology", "test_stream")
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				res.Close()
+
+				Convey("And the stream should be removed", func() {
+					res, _, err := do(r, Get, "/topologies/test_topology/streams/test_stream", nil)
+					So(err, ShouldBeNil)
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+				})
+			})
+
+			Convey("Then dropping the stream as a source should fail", func() {
+				res, err := r.DropSource("test_topology", "test_stream")
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+				res.Close()
+			})
 		})
 	})
 }
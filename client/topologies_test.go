@@ -39,6 +39,64 @@ func TestEmptyTopologies(t *testing.T) {
 				So(err, ShouldBeNil)
 				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
 				So(jscan(js, "/topology/name"), ShouldEqual, "test_topology")
+				So(jscan(js, "/topology/state"), ShouldEqual, "running")
+				So(jscan(js, "/topology/created_at"), ShouldNotBeBlank)
+				So(jscan(js, "/topology/updated_at"), ShouldNotBeBlank)
+			})
+
+			Convey("And updating the topology", func() {
+				res, js, err := do(r, Put, "/topologies/test_topology", map[string]interface{}{
+					"state": "paused",
+					"flags": map[string]interface{}{
+						"tuple_trace": true,
+					},
+				})
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+				Convey("Then the topology should be paused", func() {
+					So(jscan(js, "/topology/state"), ShouldEqual, "paused")
+				})
+
+				Convey("Then the flag should be changed", func() {
+					So(jscan(js, "/topology/flags/tuple_trace"), ShouldBeTrue)
+					So(jscan(js, "/topology/flags/log_dropped_tuples"), ShouldBeFalse)
+				})
+
+				Convey("Then resuming it should succeed", func() {
+					res, js, err := do(r, Put, "/topologies/test_topology", map[string]interface{}{
+						"state": "running",
+					})
+					So(err, ShouldBeNil)
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+					So(jscan(js, "/topology/state"), ShouldEqual, "running")
+					So(jscan(js, "/topology/flags/tuple_trace"), ShouldBeTrue)
+				})
+			})
+
+			Convey("And updating the topology with invalid values", func() {
+				res, js, err := do(r, Put, "/topologies/test_topology", map[string]interface{}{
+					"state": "stopped",
+					"flags": map[string]interface{}{
+						"tuple_trace":  "yes",
+						"no_such_flag": true,
+					},
+				})
+				So(err, ShouldBeNil)
+
+				Convey("Then it should fail", func() {
+					So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+					So(jscan(js, "/error/meta/state[0]"), ShouldNotBeBlank)
+					So(jscan(js, "/error/meta/flags[0]"), ShouldNotBeBlank)
+					So(jscan(js, "/error/meta/flags[1]"), ShouldNotBeBlank)
+				})
+
+				Convey("Then the topology shouldn't be changed", func() {
+					_, js, err := do(r, Get, "/topologies/test_topology", nil)
+					So(err, ShouldBeNil)
+					So(jscan(js, "/topology/state"), ShouldEqual, "running")
+					So(jscan(js, "/topology/flags/tuple_trace"), ShouldBeFalse)
+				})
 			})
 
 			Convey("And creating another topology having the same name", func() {
@@ -183,6 +241,68 @@ func TestTopologiesQueries(t *testing.T) {
 
 			// TODO: check the response json
 		})
+
+		Convey("When issuing statements transactionally", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `CREATE PAUSED SOURCE test_source TYPE dummy;
+					CREATE SINK test_sink TYPE stdout;
+					INSERT INTO test_sink FROM test_source;`,
+				"transactional": true,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should succeed", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+			})
+
+			Convey("Then the nodes should be created", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/sources/test_source", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+				res, _, err = do(r, Get, "/topologies/test_topology/sinks/test_sink", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+			})
+		})
+
+		Convey("When issuing statements transactionally and one of them fails", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `CREATE PAUSED SOURCE test_source TYPE dummy;
+					CREATE SINK test_sink TYPE stdout;
+					INSERT INTO test_sink FROM no_such_source;`,
+				"transactional": true,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(jscan(js, "/error/meta/statement"), ShouldStartWith, "INSERT INTO")
+			})
+
+			Convey("Then no node should be created", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/sources/test_source", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+
+				res, _, err = do(r, Get, "/topologies/test_topology/sinks/test_sink", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When issuing statements with an invalid transactional flag", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries":       `CREATE SINK test_sink TYPE stdout;`,
+				"transactional": "yes",
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(jscan(js, "/error/meta/transactional[0]"), ShouldNotBeBlank)
+			})
+		})
 	})
 }
 
@@ -377,6 +497,74 @@ func TestTopologiesQueriesEvalStmt(t *testing.T) {
 	})
 }
 
+func TestTopologiesQueriesExplainStmt(t *testing.T) {
+	s := testutil.NewServer()
+	defer s.Close()
+	r := newTestRequester(s)
+
+	Convey("Given an API server with a topology having a paused source", t, func() {
+		res, _, err := do(r, Post, "/topologies", map[string]interface{}{
+			"name": "test_topology",
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+		Reset(func() {
+			do(r, Delete, "/topologies/test_topology", nil)
+		})
+
+		res, _, err = do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+			"queries": `CREATE PAUSED SOURCE source TYPE dummy;`,
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+
+		Convey("When issuing an EXPLAIN statement", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EXPLAIN CREATE STREAM box AS SELECT ISTREAM abs(int) FROM source [RANGE 2 TUPLES]`,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should return the plan", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+				So(jscan(js, "/plan/stream"), ShouldEqual, "box")
+				So(jscan(js, "/plan/physical_plan"), ShouldEqual, "default")
+				So(jscan(js, "/plan/udfs[0]/name"), ShouldEqual, "abs")
+				So(jscan(js, "/plan/windows[0]/stream"), ShouldEqual, "source")
+			})
+
+			Convey("Then the stream shouldn't be created", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/streams/box", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When issuing an EXPLAIN statement with another statement", func() {
+			res, _, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EXPLAIN SELECT ISTREAM * FROM source [RANGE 1 TUPLES];
+					CREATE SINK snk TYPE stdout;`,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+			})
+		})
+
+		Convey("When issuing an EXPLAIN statement having an undefined UDF", func() {
+			res, js, err := do(r, Post, "/topologies/test_topology/queries", map[string]interface{}{
+				"queries": `EXPLAIN SELECT ISTREAM no_such_udf(int) FROM source [RANGE 1 TUPLES]`,
+			})
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusBadRequest)
+				So(jscan(js, "/error/meta/error"), ShouldNotBeBlank)
+			})
+		})
+	})
+}
+
 func TestTopologiesQueriesSelectStmtWebSocket(t *testing.T) {
 	// TODO: Because results from a SELECT stmt needs to be returned through
 	// hijacking, a real HTTP server is required. Support Hijack method in test
@@ -611,3 +799,80 @@ func TestTopologiesQueriesEvalStmtWebSocket(t *testing.T) {
 		})
 	})
 }
+
+func TestTopologiesQueriesWebSocket(t *testing.T) {
+	testutil.TestAPIWithRealHTTPServer = true
+
+	s := testutil.NewServer()
+	defer func() {
+		testutil.TestAPIWithRealHTTPServer = false
+		s.Close()
+	}()
+	r := newTestRequester(s)
+
+	Convey("Given an API server with a topology and a WebSocket connection", t, func() {
+		res, _, err := do(r, Post, "/topologies", map[string]interface{}{
+			"name": "test_topology",
+		})
+		So(err, ShouldBeNil)
+		So(res.Raw.StatusCode, ShouldEqual, http.StatusOK)
+		Reset(func() {
+			do(r, Delete, "/topologies/test_topology", nil)
+		})
+
+		conn, err := websocket.Dial("ws"+s.URL()[len("http"):]+"/api/v1/topologies/test_topology/wsqueries",
+			"", s.URL())
+		So(err, ShouldBeNil)
+		Reset(func() {
+			conn.Close()
+		})
+
+		Convey("When issuing statements transactionally and one of them fails", func() {
+			So(websocket.JSON.Send(conn, map[string]interface{}{
+				"rid": 1,
+				"payload": map[string]interface{}{
+					"queries": `CREATE PAUSED SOURCE test_source TYPE dummy;
+						CREATE SINK test_sink TYPE stdout;
+						INSERT INTO test_sink FROM no_such_source;`,
+					"transactional": true,
+				},
+			}), ShouldBeNil)
+			var js map[string]interface{}
+			So(websocket.JSON.Receive(conn, &js), ShouldBeNil)
+			So(jscan(js, "/rid"), ShouldEqual, 1)
+
+			Convey("Then it should fail", func() {
+				So(jscan(js, "/type"), ShouldEqual, "error")
+				So(jscan(js, "/payload/meta/statement"), ShouldStartWith, "INSERT INTO")
+			})
+
+			Convey("Then no node should be created", func() {
+				res, _, err := do(r, Get, "/topologies/test_topology/sources/test_source", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+
+				res, _, err = do(r, Get, "/topologies/test_topology/sinks/test_sink", nil)
+				So(err, ShouldBeNil)
+				So(res.Raw.StatusCode, ShouldEqual, http.StatusNotFound)
+			})
+		})
+
+		Convey("When issuing statements with an invalid transactional flag", func() {
+			So(websocket.JSON.Send(conn, map[string]interface{}{
+				"rid": 2,
+				"payload": map[string]interface{}{
+					"queries":       `CREATE SINK test_sink TYPE stdout;`,
+					"transactional": "yes",
+				},
+			}), ShouldBeNil)
+			var js map[string]interface{}
+			So(websocket.JSON.Receive(conn, &js), ShouldBeNil)
+			So(jscan(js, "/rid"), ShouldEqual, 2)
+
+			Convey("Then it should fail", func() {
+				So(jscan(js, "/type"), ShouldEqual, "error")
+				So(jscan(js, "/payload/meta/transactional[0]"), ShouldNotBeBlank)
+			})
+		})
+	})
+}
@@ -101,6 +101,13 @@ func inputFromSelect(stmt *parser.SelectStmt) ([]string, error) {
 			for n := range decl.ListInputs() {
 				names = append(names, n)
 			}
+
+		case parser.SubqueryStream:
+			ns, err := inputFromSelect(rel.Subquery)
+			if err != nil {
+				return nil, err
+			}
+			names = append(names, ns...)
 		}
 	}
 	return names, nil
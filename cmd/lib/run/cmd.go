@@ -97,6 +97,8 @@ func Run(c *cli.Context) error {
 			Addr:    conf.Network.ListenOn,
 			Handler: jascoRoot,
 		}
+		defer server.StopTopologies(cgvars.Logger, cgvars.Topologies)
+
 		cgvars.Logger.Infof("Starting the server on %v", conf.Network.ListenOn)
 		if err := s.ListenAndServe(); err != nil {
 			return fmt.Errorf("Cannot start the server: %v", err)
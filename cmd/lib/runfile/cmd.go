@@ -52,6 +52,10 @@ func SetUp() cli.Command {
 			Value: "",
 			Usage: "name of the topology",
 		},
+		cli.BoolFlag{
+			Name:  "transactional",
+			Usage: "add all statements atomically so that no node is created when one of them fails",
+		},
 	}
 	return cmd
 }
@@ -106,6 +110,7 @@ func Run(c *cli.Context) error {
 		logger := logrus.New()
 		logger.Out = w
 		logger.Level = logLevel
+		logger.Formatter = conf.Logging.CreateFormatter()
 
 		udsStorage, err := setUpUDSStorage(&conf.Storage.UDS)
 		if err != nil {
@@ -127,7 +132,7 @@ func Run(c *cli.Context) error {
 			return emptyError
 		}
 
-		if err := setUpBQLStmt(tb, bqlFile); err != nil {
+		if err := setUpBQLStmt(tb, bqlFile, c.Bool("transactional")); err != nil {
 			logger.WithFields(logrus.Fields{
 				"err":      err,
 				"bql_file": bqlFile,
@@ -221,7 +226,7 @@ func setUpTopology(name string, logger *logrus.Logger, conf *config.Config, us u
 	return tb, nil
 }
 
-func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string) error {
+func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string, transactional bool) error {
 	queries, err := func() (string, error) {
 		f, err := os.Open(bqlFile)
 		if err != nil {
@@ -245,22 +250,50 @@ func setUpBQLStmt(tb *bql.TopologyBuilder, bqlFile string) error {
 		return err
 	}
 
+	if transactional {
+		nodes, err := tb.AddStmtsAtomically(stmts)
+		if err != nil {
+			l := tb.Topology().Context().ErrLog(err)
+			if e, ok := err.(*bql.StmtError); ok {
+				l = l.WithField("stmt", e.Stmt)
+			}
+			l.Error("Cannot add statements to the topology")
+			return err
+		}
+		for _, n := range nodes {
+			if err := checkSourceNode(n); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for _, stmt := range stmts {
 		// TODO: if stmt is CREATE SOURCE, create it with PAUSED
 		if n, err := tb.AddStmt(stmt); err != nil {
 			tb.Topology().Context().ErrLog(err).WithField("stmt", stmt).Error(
 				"Cannot add a statement to the topology")
 			return err // FIXME: logger output "err" two twice
-		} else if n != nil && n.Type() == core.NTSource {
-			sn, _ := n.(core.SourceNode)
-			if _, ok := sn.Source().(core.RewindableSource); ok {
-				return fmt.Errorf(`rewindable source "%v" isn't supported`, n.Name())
-			}
+		} else if err := checkSourceNode(n); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// checkSourceNode returns an error if the node is a source which runfile
+// doesn't support.
+func checkSourceNode(n core.Node) error {
+	if n == nil || n.Type() != core.NTSource {
+		return nil
+	}
+	sn, _ := n.(core.SourceNode)
+	if _, ok := sn.Source().(core.RewindableSource); ok {
+		return fmt.Errorf(`rewindable source "%v" isn't supported`, n.Name())
+	}
+	return nil
+}
+
 func saveStates(tb *bql.TopologyBuilder, saveUDSList string) error {
 	states, err := tb.Topology().Context().SharedStates.List()
 	if err != nil {
@@ -72,7 +72,7 @@ func (b *bqlCmd) Init() error {
 // Name returns BQL start words.
 func (b *bqlCmd) Name() []string {
 	return []string{"select", "create", "update", "insert", "pause", "resume",
-		"rewind", "drop", "save", "load", "eval"}
+		"rewind", "drop", "save", "load", "eval", "explain"}
 }
 
 func (b *bqlCmd) Input(input string) (cmdInputStatusType, error) {
@@ -131,16 +131,18 @@ func sendBQLQueries(requester *client.Requester, queries string) {
 		return
 	}
 
-	// check if we have a JSON body that contains a "result" field;
-	// if so, print it.
+	// check if we have a JSON body that contains a "result" field
+	// or a "plan" field returned from an EXPLAIN statement; if so,
+	// print it.
 	// NB. We should also display some more status information that
 	// is reported by most statements.
 	var data map[string]interface{}
 	err = res.ReadJSON(&data)
 	if err == nil {
-		result, ok := data["result"]
-		if ok {
+		if result, ok := data["result"]; ok {
 			printJSONResult(result)
+		} else if plan, ok := data["plan"]; ok {
+			printIndentedJSONResult(plan)
 		}
 	}
 
@@ -157,6 +159,17 @@ func printJSONResult(v interface{}) {
 	fmt.Printf("%s\n", data)
 }
 
+// printIndentedJSONResult prints a result in indented JSON format. It's used
+// to print a large result such as a plan returned from an EXPLAIN statement.
+func printIndentedJSONResult(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot marshal the result into a JSON: %v\n", err)
+		return
+	}
+	fmt.Printf("%s\n", data)
+}
+
 func showStreamResponses(res *client.Response) {
 	ch, err := res.ReadStreamJSON()
 	if err != nil {
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"strings"
 )
 
@@ -109,6 +110,26 @@ type NamedInputBox interface {
 	InputNames() []string
 }
 
+// PartitionedBox is a box which processes tuples differently depending on
+// the partitions they are assigned to. When a PartitionedBox is added with
+// BoxConfig.PartitionKey and BoxConfig.Parallelism greater than 1,
+// ProcessPartition is called instead of Process.
+type PartitionedBox interface {
+	Box
+
+	// ProcessPartition is the same as Process except that it also receives
+	// the index of the partition of the tuple, which is in
+	// [0, BoxConfig.Parallelism). Tuples in the same partition are always
+	// processed by the same goroutine one by one.
+	ProcessPartition(ctx *Context, t *Tuple, w Writer, partition int) error
+}
+
+// PartitionIndex returns the index of the partition, in [0, n), which a tuple
+// whose partition key is k is assigned to when BoxConfig.Parallelism is n.
+func PartitionIndex(k data.Value, n int) int {
+	return int(uint64(data.Hash(k)) % uint64(n))
+}
+
 // BoxFunc can be used to add all methods required to fulfill the Box
 // interface to a normal function with the signature
 //   func(ctx *Context, t *Tuple, s Writer) error
@@ -180,3 +201,14 @@ func (wa *boxWriterAdapter) Write(ctx *Context, t *Tuple) error {
 	tracing(t, ctx, ETInput, wa.name)
 	return wa.box.Process(ctx, t, wa.dst)
 }
+
+// writePartition writes a tuple assigned to the given partition to the Box.
+// It calls ProcessPartition if the Box is a PartitionedBox.
+func (wa *boxWriterAdapter) writePartition(ctx *Context, t *Tuple, partition int) error {
+	pb, ok := wa.box.(PartitionedBox)
+	if !ok {
+		return wa.Write(ctx, t)
+	}
+	tracing(t, ctx, ETInput, wa.name)
+	return pb.ProcessPartition(ctx, t, wa.dst, partition)
+}
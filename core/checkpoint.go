@@ -0,0 +1,29 @@
+package core
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Checkpointable is a Source or a Box whose internal state can be included in
+// a checkpoint of a topology. For example, a Source reading a file can report
+// the offset of the next line to be read, and a Box can report the tuples it
+// holds in its windows.
+//
+// A topology is checkpointed while it's quiescent: all running Sources are
+// paused and no tuple is being processed by Boxes or Sinks. Therefore, the
+// states of all nodes in a checkpoint are consistent with each other.
+type Checkpointable interface {
+	// SaveCheckpoint returns the current state of the node. The returned
+	// value must not be modified by the node afterwards because it'll be
+	// serialized after SaveCheckpoint returns.
+	//
+	// SaveCheckpoint is only called while no tuple is written to or by the
+	// node. A Source must not count a tuple whose Write hasn't returned yet
+	// (e.g. because the Source is paused) as written.
+	SaveCheckpoint(ctx *Context) (data.Value, error)
+
+	// LoadCheckpoint restores the state returned by SaveCheckpoint. It's
+	// called on a newly created node before it generates or receives any
+	// tuple. A Source is restored before it's added to a topology.
+	LoadCheckpoint(ctx *Context, v data.Value) error
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync/atomic"
 )
 
 type defaultBoxNode struct {
@@ -15,6 +16,10 @@ type defaultBoxNode struct {
 	gracefulStopEnabled bool
 	stopOnDisconnectDir ConnDir
 	runErr              error
+
+	// pw is the partitionedWriter used while the box is running with
+	// a partition key. It's protected by stateMutex.
+	pw *partitionedWriter
 }
 
 func (db *defaultBoxNode) Type() NodeType {
@@ -86,7 +91,21 @@ func (db *defaultBoxNode) run() (runErr error) {
 	}()
 	db.state.Set(TSRunning)
 	w := newBoxWriterAdapter(db.box, db.name, db.dsts)
-	db.runErr = db.srcs.pour(db.topology.ctx, w, 1) // TODO: make parallelism configurable
+	if db.config.PartitionKey == nil || db.config.Parallelism <= 1 {
+		db.runErr = db.srcs.pour(db.topology.ctx, w, db.config.Parallelism)
+		return
+	}
+
+	// Tuples are read by a single goroutine and dispatched to the
+	// goroutine processing their partition.
+	pw := newPartitionedWriter(db.topology.ctx, db.srcs, w, db.config.Parallelism, db.config.PartitionKey)
+	db.stateMutex.Lock()
+	db.pw = pw
+	db.stateMutex.Unlock()
+	db.runErr = db.srcs.pour(db.topology.ctx, pw, 1)
+	if err := pw.close(); db.runErr == nil {
+		db.runErr = err
+	}
 	return
 }
 
@@ -134,11 +153,16 @@ func (db *defaultBoxNode) Status() data.Map {
 	gstop := db.gracefulStopEnabled
 	connDir := db.stopOnDisconnectDir
 	removeOnStop := db.config.RemoveOnStop
+	pw := db.pw
 	db.stateMutex.Unlock()
 
+	inputStats := db.srcs.status()
+	if pw != nil {
+		inputStats["num_queued_in_partitions"] = data.Int(atomic.LoadInt64(&pw.numQueued))
+	}
 	m := data.Map{
 		"state":        data.String(st.String()),
-		"input_stats":  db.srcs.status(),
+		"input_stats":  inputStats,
 		"output_stats": db.dsts.status(),
 		"behaviors": data.Map{
 			"stop_on_inbound_disconnect":  data.Bool((connDir & Inbound) != 0),
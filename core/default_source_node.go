@@ -192,6 +192,20 @@ func (ds *defaultSourceNode) Rewind() error {
 	return rs.Rewind(ds.topology.ctx)
 }
 
+func (ds *defaultSourceNode) Seek(pos SeekPosition) error {
+	ss, ok := ds.source.(SeekableSource)
+	if !ok {
+		return errors.New("the source doesn't support seeking")
+	}
+
+	ds.stateMutex.Lock()
+	defer ds.stateMutex.Unlock()
+	if ds.state.getWithoutLock() >= TSStopping {
+		return errors.New("the source is stopped")
+	}
+	return ss.Seek(ds.topology.ctx, pos)
+}
+
 func (ds *defaultSourceNode) Status() data.Map {
 	ds.stateMutex.Lock()
 	st := ds.state.getWithoutLock()
@@ -18,6 +18,12 @@ type defaultTopology struct {
 	boxes     map[string]*defaultBoxNode
 	sinks     map[string]*defaultSinkNode
 
+	// pausedSources has lower-cased names of the sources paused by Pause,
+	// including sources added while the topology is paused. Resume only
+	// resumes these sources so that sources paused individually stay paused.
+	// It's protected by nodeMutex.
+	pausedSources map[string]bool
+
 	state      *topologyStateHolder
 	stateMutex sync.Mutex
 
@@ -40,6 +46,8 @@ func NewDefaultTopology(ctx *Context, name string) (Topology, error) {
 		sources: map[string]*defaultSourceNode{},
 		boxes:   map[string]*defaultBoxNode{},
 		sinks:   map[string]*defaultSinkNode{},
+
+		pausedSources: map[string]bool{},
 	}
 	t.state = newTopologyStateHolder(&t.stateMutex)
 	t.state.state = TSRunning // A topology is running by default.
@@ -89,6 +97,14 @@ func (t *defaultTopology) AddSource(name string, s Source, config *SourceConfig)
 	}
 	ds.config = &SourceConfig{}
 	*ds.config = *config
+	pausedByTopology := false
+	if t.state.Get() == TSPaused && !config.PausedOnStartup {
+		// All sources in a paused topology must be paused so that they
+		// can be resumed at once by Resume.
+		ds.config.PausedOnStartup = true
+		ds.pausedOnStartup = true
+		pausedByTopology = true
+	}
 	ds.dsts.callback = ds.dstCallback
 	if err := t.checkNodeNameDuplication(name); err != nil {
 		// Because the source isn't started yet, it doesn't return an error.
@@ -96,6 +112,9 @@ func (t *defaultTopology) AddSource(name string, s Source, config *SourceConfig)
 		return nil, err
 	}
 	t.sources[strings.ToLower(name)] = ds
+	if pausedByTopology {
+		t.pausedSources[strings.ToLower(name)] = true
+	}
 
 	go func() {
 		// TODO: Support lazy invocation
@@ -116,7 +135,7 @@ func (t *defaultTopology) AddSource(name string, s Source, config *SourceConfig)
 		}
 	}()
 
-	if config.PausedOnStartup {
+	if ds.pausedOnStartup {
 		ds.state.Wait(TSPaused)
 	} else {
 		ds.state.Wait(TSRunning)
@@ -149,6 +168,9 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	if config == nil {
 		config = &BoxConfig{}
 	}
+	if config.Parallelism < 0 {
+		return nil, fmt.Errorf("the parallelism of a box must not be negative: %v", config.Parallelism)
+	}
 
 	t.nodeMutex.Lock()
 	defer t.nodeMutex.Unlock()
@@ -337,6 +359,68 @@ func (t *defaultTopology) State() TopologyStateHolder {
 	return t.state
 }
 
+func (t *defaultTopology) Pause() error {
+	return t.setSourcesState(TSPaused)
+}
+
+func (t *defaultTopology) Resume() error {
+	return t.setSourcesState(TSRunning)
+}
+
+// setSourcesState pauses or resumes sources depending on s, which must be
+// TSPaused or TSRunning. When s is TSPaused, all running sources are paused
+// and recorded in pausedSources. Otherwise, only the sources recorded in
+// pausedSources are resumed. The state of the topology is also set to s.
+func (t *defaultTopology) setSourcesState(s TopologyState) error {
+	// Holding nodeMutex prevents sources from being added or removed while
+	// their states are being changed.
+	t.nodeMutex.Lock()
+	defer t.nodeMutex.Unlock()
+	if t.state.Get() >= TSStopping {
+		return fmt.Errorf("the topology is already stopped")
+	}
+
+	set := func(src *defaultSourceNode, s TopologyState) error {
+		if s == TSPaused {
+			return src.Pause()
+		}
+		return src.Resume()
+	}
+	prev := TSRunning
+	if s == TSRunning {
+		prev = TSPaused
+	}
+
+	var changed []*defaultSourceNode
+	for name, src := range t.sources {
+		if st := src.state.Get(); st == s || st >= TSStopping {
+			continue
+		}
+		if s == TSRunning && !t.pausedSources[name] {
+			continue
+		}
+		if err := set(src, s); err != nil {
+			for _, c := range changed {
+				if err := set(c, prev); err != nil {
+					t.ctx.ErrLog(err).WithFields(nodeLogFields(NTSource, c.name)).
+						Error("Cannot restore the state of the source")
+				}
+			}
+			return fmt.Errorf("cannot change the state of source '%v' to %v: %v", src.name, s, err)
+		}
+		changed = append(changed, src)
+	}
+
+	if s == TSPaused {
+		for _, src := range changed {
+			t.pausedSources[strings.ToLower(src.name)] = true
+		}
+	} else {
+		t.pausedSources = map[string]bool{}
+	}
+	return t.state.Set(s)
+}
+
 func (t *defaultTopology) Remove(name string) error {
 	lowerName := strings.ToLower(name)
 	n, err := func() (Node, error) {
@@ -350,6 +434,7 @@ func (t *defaultTopology) Remove(name string) error {
 		switch n.Type() {
 		case NTSource:
 			delete(t.sources, lowerName)
+			delete(t.pausedSources, lowerName)
 		case NTBox:
 			delete(t.boxes, lowerName)
 		case NTSink:
@@ -608,6 +608,86 @@ func TestDefaultTopologySetup(t *testing.T) {
 // 2. Multiple sinks (including fan-out)
 // 3. Multiple sources (including JOIN)
 
+func TestDefaultTopologyPauseResume(t *testing.T) {
+	Convey("Given a default topology having two sources", t, func() {
+		dt, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			dt.Stop()
+		})
+
+		s1, err := dt.AddSource("source1", NewTupleIncrementalEmitterSource(freshTuples()), nil)
+		So(err, ShouldBeNil)
+		s2, err := dt.AddSource("source2", NewTupleIncrementalEmitterSource(freshTuples()), &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("When pausing the topology", func() {
+			So(dt.Pause(), ShouldBeNil)
+
+			Convey("Then all sources should be paused", func() {
+				So(s1.State().Get(), ShouldEqual, TSPaused)
+				So(s2.State().Get(), ShouldEqual, TSPaused)
+			})
+
+			Convey("Then the topology should be paused", func() {
+				So(dt.State().Get(), ShouldEqual, TSPaused)
+			})
+
+			Convey("Then a new source should be paused", func() {
+				s3, err := dt.AddSource("source3", NewTupleIncrementalEmitterSource(freshTuples()), nil)
+				So(err, ShouldBeNil)
+				So(s3.State().Get(), ShouldEqual, TSPaused)
+
+				Convey("And it should be resumed with the topology", func() {
+					So(dt.Resume(), ShouldBeNil)
+					So(s3.State().Get(), ShouldEqual, TSRunning)
+				})
+			})
+
+			Convey("Then pausing it again shouldn't fail", func() {
+				So(dt.Pause(), ShouldBeNil)
+			})
+
+			Convey("And resuming the topology", func() {
+				So(dt.Resume(), ShouldBeNil)
+
+				Convey("Then only sources paused by the topology should be running", func() {
+					So(s1.State().Get(), ShouldEqual, TSRunning)
+					So(s2.State().Get(), ShouldEqual, TSPaused)
+				})
+
+				Convey("Then the topology should be running", func() {
+					So(dt.State().Get(), ShouldEqual, TSRunning)
+				})
+			})
+		})
+
+		Convey("When pausing the topology having a stopped source", func() {
+			So(s1.Stop(), ShouldBeNil)
+			So(dt.Pause(), ShouldBeNil)
+
+			Convey("Then the stopped source should be ignored", func() {
+				So(s1.State().Get(), ShouldEqual, TSStopped)
+				So(s2.State().Get(), ShouldEqual, TSPaused)
+			})
+		})
+
+		Convey("When stopping the topology", func() {
+			So(dt.Stop(), ShouldBeNil)
+
+			Convey("Then Pause should fail", func() {
+				So(dt.Pause(), ShouldNotBeNil)
+			})
+
+			Convey("Then Resume should fail", func() {
+				So(dt.Resume(), ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestLinearDefaultTopology(t *testing.T) {
 	Convey("Given a simple linear topology", t, func() {
 		/*
@@ -146,6 +146,9 @@ type Node interface {
 	//	* num_errors: the number of errors that the node failed to process tuples
 	//	              including temporary errors
 	//	* inputs: the information of data sources connected to the node
+	//	* num_queued_in_partitions: the number of tuples received by a Box
+	//	                            having BoxConfig.PartitionKey which
+	//	                            haven't been processed yet
 	//
 	// "inputs" field in "input_stats" contains the input statistics of each
 	// data sources as data.Map. Each input has the following information:
@@ -206,6 +209,14 @@ type SourceNode interface {
 	// node is already stopped.
 	Rewind() error
 
+	// Seek rewinds the stream to the given position if the Source supports
+	// it (i.e. it's a SeekableSource). Like Rewind, Seek doesn't resume a
+	// paused Source.
+	//
+	// Seek returns an error if the Source doesn't support Seek, or the node
+	// is already stopped.
+	Seek(pos SeekPosition) error
+
 	// StopOnDisconnect tells the Source that it may automatically stop when all
 	// outband connections (channels or pipes) are closed. After calling this
 	// method, the Source can automatically stop even if Stop method isn't
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"sync/atomic"
+)
+
+// partitionQueueCapacity is the capacity of the channel between
+// partitionedWriter.Write and each goroutine processing a partition.
+const partitionQueueCapacity = 64
+
+// partitionedWriter writes tuples to the target Writer from a fixed number
+// of goroutines. Each tuple is assigned to one of those goroutines based on
+// the hash value of its key, so that tuples having the same key are written
+// in the order in which Write is called. When the target Writer is a
+// partitionWriter, it also receives the index of the goroutine, which is the
+// partition of the tuple.
+//
+// Errors returned from the target Writer are handled in the same way as
+// dataSources.pouringThread handles them. Once the target returns a fatal
+// error, the goroutine which received it stops writing tuples and Write
+// returns the error so that the caller also stops.
+//
+// It is the user's responsibility to store an object of this struct in
+// 64-bit aligned memory. See dataDestinations for details.
+type partitionedWriter struct {
+	// numQueued must be here for 64-bit alignment. It is the number of
+	// tuples passed to Write which haven't been processed yet.
+	numQueued int64
+
+	ctx  *Context
+	srcs *dataSources
+	w    Writer
+	key  func(t *Tuple) (data.Value, error)
+
+	queues []chan *partitionedTuple
+	wg     sync.WaitGroup
+
+	// m protects fatalErr.
+	m        sync.RWMutex
+	fatalErr error
+}
+
+type partitionedTuple struct {
+	ctx *Context
+	t   *Tuple
+}
+
+// partitionWriter is a Writer which can receive the partition of a tuple
+// from partitionedWriter.
+type partitionWriter interface {
+	writePartition(ctx *Context, t *Tuple, partition int) error
+}
+
+func newPartitionedWriter(ctx *Context, srcs *dataSources, w Writer, parallelism int,
+	key func(t *Tuple) (data.Value, error)) *partitionedWriter {
+	pw := &partitionedWriter{
+		ctx:    ctx,
+		srcs:   srcs,
+		w:      w,
+		key:    key,
+		queues: make([]chan *partitionedTuple, parallelism),
+	}
+	for i := range pw.queues {
+		q := make(chan *partitionedTuple, partitionQueueCapacity)
+		pw.queues[i] = q
+		pw.wg.Add(1)
+		go func(partition int) {
+			defer pw.wg.Done()
+			pw.process(q, partition)
+		}(i)
+	}
+	return pw
+}
+
+// Write passes the tuple to the goroutine processing its partition. It
+// blocks while the queue of the partition is full. It returns an error
+// when the key of the tuple cannot be computed or when one of the
+// goroutines received a fatal error.
+func (pw *partitionedWriter) Write(ctx *Context, t *Tuple) error {
+	pw.m.RLock()
+	err := pw.fatalErr
+	pw.m.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	k, err := pw.key(t)
+	if err != nil {
+		return fmt.Errorf("cannot compute the partition key of a tuple: %v", err)
+	}
+	i := PartitionIndex(k, len(pw.queues))
+	atomic.AddInt64(&pw.numQueued, 1)
+	pw.queues[i] <- &partitionedTuple{ctx, t}
+	return nil
+}
+
+func (pw *partitionedWriter) process(q <-chan *partitionedTuple, partition int) {
+	stopped := false
+	for pt := range q {
+		pw.processTuple(pt, partition, &stopped)
+		atomic.AddInt64(&pw.numQueued, -1)
+	}
+}
+
+// processTuple writes a tuple taken from the queue of the given partition.
+// stopped is set to true when the target returns a fatal error.
+func (pw *partitionedWriter) processTuple(pt *partitionedTuple, partition int, stopped *bool) {
+	if *stopped {
+		// The target must not be called after it returned a fatal
+		// error, but the queue is drained so that Write doesn't block.
+		pw.ctx.droppedTuple(pt.t, pw.srcs.nodeType, pw.srcs.nodeName, ETInput,
+			fmt.Errorf("'%v' already stopped due to a fatal error", pw.srcs.nodeName))
+		return
+	}
+
+	err := pw.write(pt, partition)
+	if err == nil {
+		return
+	}
+
+	switch {
+	case IsFatalError(err):
+		atomic.AddInt64(&pw.srcs.numErrors, 1)
+		*stopped = true
+		// logging is done by the caller of Write or close
+		pw.m.Lock()
+		if pw.fatalErr == nil {
+			pw.fatalErr = err
+		}
+		pw.m.Unlock()
+
+	case IsTemporaryError(err):
+		atomic.AddInt64(&pw.srcs.numErrors, 1)
+	}
+	pw.ctx.droppedTuple(pt.t, pw.srcs.nodeType, pw.srcs.nodeName, ETInput, err)
+}
+
+// write writes a tuple to the target Writer. A panic is turned into a
+// fatal error.
+func (pw *partitionedWriter) write(pt *partitionedTuple, partition int) (retErr error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if err, ok := e.(error); ok {
+				retErr = FatalError(err)
+			} else {
+				retErr = FatalError(fmt.Errorf("'%v' got an unknown error through panic: %v", pw.srcs.nodeName, e))
+			}
+		}
+	}()
+	if w, ok := pw.w.(partitionWriter); ok {
+		return w.writePartition(pt.ctx, pt.t, partition)
+	}
+	return pw.w.Write(pt.ctx, pt.t)
+}
+
+// close waits until all tuples passed to Write are processed. It returns
+// the fatal error which one of the goroutines received, if any. Write must
+// not be called after calling close.
+func (pw *partitionedWriter) close() error {
+	for _, q := range pw.queues {
+		close(q)
+	}
+	pw.wg.Wait()
+
+	pw.m.RLock()
+	defer pw.m.RUnlock()
+	return pw.fatalErr
+}
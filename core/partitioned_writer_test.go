@@ -0,0 +1,187 @@
+package core
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+	"time"
+)
+
+// concurrencyCheckerBox forwards tuples after a short sleep and records
+// the maximum number of concurrent calls of Process.
+type concurrencyCheckerBox struct {
+	m       sync.Mutex
+	running int
+	max     int
+}
+
+func (b *concurrencyCheckerBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	b.m.Lock()
+	b.running++
+	if b.running > b.max {
+		b.max = b.running
+	}
+	b.m.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	b.m.Lock()
+	b.running--
+	b.m.Unlock()
+	return w.Write(ctx, t)
+}
+
+// partitionRecorderBox forwards tuples with the partition they are
+// assigned to.
+type partitionRecorderBox struct {
+}
+
+func (b *partitionRecorderBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	return fmt.Errorf("Process must not be called on a partitioned box")
+}
+
+func (b *partitionRecorderBox) ProcessPartition(ctx *Context, t *Tuple, w Writer, partition int) error {
+	t = t.Copy()
+	t.Data["partition"] = data.Int(partition)
+	return w.Write(ctx, t)
+}
+
+func TestParallelBox(t *testing.T) {
+	tuples := make([]*Tuple, 100)
+	for i := range tuples {
+		tuples[i] = NewTuple(data.Map{
+			"key": data.Int(i % 7),
+			"seq": data.Int(i),
+		})
+	}
+	partitionKey := func(t *Tuple) (data.Value, error) {
+		return t.Data.Get(data.MustCompilePath("key"))
+	}
+
+	Convey("Given a default topology", t, func() {
+		ctx := NewContext(nil)
+		tp, err := NewDefaultTopology(ctx, "test")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			tp.Stop()
+		})
+
+		son, err := tp.AddSource("source", NewTupleEmitterSource(tuples), &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		si := NewTupleCollectorSink()
+		var bn BoxNode
+		runBox := func(b Box, config *BoxConfig) {
+			bn, err = tp.AddBox("box", b, config)
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+			bn.StopOnDisconnect(Inbound)
+
+			sin, err := tp.AddSink("sink", si, nil)
+			So(err, ShouldBeNil)
+			So(sin.Input("box", nil), ShouldBeNil)
+			sin.StopOnDisconnect()
+
+			So(son.Resume(), ShouldBeNil)
+			sin.State().Wait(TSStopped)
+		}
+		run := func(config *BoxConfig) *concurrencyCheckerBox {
+			b := &concurrencyCheckerBox{}
+			runBox(b, config)
+			return b
+		}
+
+		Convey("When adding a box with parallelism", func() {
+			b := run(&BoxConfig{Parallelism: 4})
+
+			Convey("Then all tuples should be processed", func() {
+				So(si.len(), ShouldEqual, len(tuples))
+			})
+
+			Convey("Then tuples should be processed concurrently", func() {
+				So(b.max, ShouldBeGreaterThan, 1)
+				So(b.max, ShouldBeLessThanOrEqualTo, 4)
+			})
+		})
+
+		Convey("When adding a box with parallelism and a partition key", func() {
+			b := run(&BoxConfig{
+				Parallelism:  4,
+				PartitionKey: partitionKey,
+			})
+
+			Convey("Then all tuples should be processed", func() {
+				So(si.len(), ShouldEqual, len(tuples))
+			})
+
+			Convey("Then tuples should be processed concurrently", func() {
+				So(b.max, ShouldBeGreaterThan, 1)
+				So(b.max, ShouldBeLessThanOrEqualTo, 4)
+			})
+
+			Convey("Then tuples having the same key should be processed in order", func() {
+				last := map[int64]int64{}
+				si.forEachTuple(func(t *Tuple) {
+					k, _ := data.AsInt(t.Data["key"])
+					seq, _ := data.AsInt(t.Data["seq"])
+					if prev, ok := last[k]; ok {
+						So(seq, ShouldBeGreaterThan, prev)
+					}
+					last[k] = seq
+				})
+				So(len(last), ShouldEqual, 7)
+			})
+
+			Convey("Then no tuple should be queued in partitions", func() {
+				v, err := bn.Status().Get(data.MustCompilePath("input_stats.num_queued_in_partitions"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When adding a partitioned box with parallelism and a partition key", func() {
+			runBox(&partitionRecorderBox{}, &BoxConfig{
+				Parallelism:  4,
+				PartitionKey: partitionKey,
+			})
+
+			Convey("Then all tuples should be processed with their partitions", func() {
+				So(si.len(), ShouldEqual, len(tuples))
+				si.forEachTuple(func(t *Tuple) {
+					p, err := data.AsInt(t.Data["partition"])
+					So(err, ShouldBeNil)
+					So(p, ShouldEqual, int64(PartitionIndex(t.Data["key"], 4)))
+				})
+			})
+		})
+
+		Convey("When the partition key of some tuples cannot be computed", func() {
+			run(&BoxConfig{
+				Parallelism: 4,
+				PartitionKey: func(t *Tuple) (data.Value, error) {
+					seq, _ := data.AsInt(t.Data["seq"])
+					if seq%10 == 0 {
+						return nil, fmt.Errorf("no key for %v", seq)
+					}
+					return partitionKey(t)
+				},
+			})
+
+			Convey("Then those tuples should be dropped", func() {
+				So(si.len(), ShouldEqual, len(tuples)-10)
+			})
+		})
+
+		Convey("When adding a box with a negative parallelism", func() {
+			_, err := tp.AddBox("box", &DoesNothingBox{}, &BoxConfig{Parallelism: -1})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
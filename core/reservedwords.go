@@ -144,6 +144,7 @@ var reservedWords = map[string]struct{}{
 	"hour":                  struct{}{},
 	"identity":              struct{}{},
 	"if":                    struct{}{},
+	"ilike":                 struct{}{},
 	"immediate":             struct{}{},
 	"in":                    struct{}{},
 	"include":               struct{}{},
@@ -304,6 +305,7 @@ var reservedWords = map[string]struct{}{
 	"set":                   struct{}{},
 	"show":                  struct{}{},
 	"signal":                struct{}{},
+	"similar":               struct{}{},
 	"size":                  struct{}{},
 	"smallint":              struct{}{},
 	"some":                  struct{}{},
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"sync"
+	"time"
 )
 
 // A Source describes an entity that inserts data into a topology
@@ -49,6 +50,50 @@ type RewindableSource interface {
 	Rewind(ctx *Context) error
 }
 
+// SeekPosition is a position in a stream generated by a SeekableSource.
+type SeekPosition struct {
+	// Offset is the offset of a tuple in the stream. The first tuple of the
+	// stream has the offset 0.
+	Offset int64
+
+	// Timestamp, when it isn't zero, points to the first tuple in the stream
+	// whose timestamp isn't before it. Offset is ignored in that case.
+	Timestamp time.Time
+}
+
+// SeekableSource is a RewindableSource which can also rewind its stream to a
+// position other than the beginning (e.g. the offset of a line in a file).
+type SeekableSource interface {
+	RewindableSource
+
+	// Offset returns the offset of the next tuple the Source writes.
+	Offset() int64
+
+	// Seek rewinds the stream so that the Source generates tuples from the
+	// given position. It follows the same rules as Rewind regarding pause
+	// and resume.
+	Seek(ctx *Context, pos SeekPosition) error
+}
+
+// Seeker is a Source which can start generating its stream from a position
+// other than the beginning. When the Source passed to NewRewindableSource
+// implements Seeker, the returned Source satisfies SeekableSource and
+// Checkpointable. ImplementSourceStop also returns a Checkpointable Source
+// for a Seeker.
+type Seeker interface {
+	Source
+
+	// Offset returns the offset of the next tuple GenerateStream writes. A
+	// tuple whose Write hasn't returned yet must not be counted.
+	Offset() int64
+
+	// SetStartPosition sets the position from which the next call of
+	// GenerateStream starts generating the stream. It doesn't affect
+	// GenerateStream which is already running, and the position only
+	// applies to the first next call of GenerateStream.
+	SetStartPosition(pos SeekPosition) error
+}
+
 type rewindableSource struct {
 	rwm              sync.RWMutex
 	state            *topologyStateHolder
@@ -102,6 +147,7 @@ var (
 // if the given source implements them:
 //
 //	* Statuser
+//	* Seeker (as SeekableSource and Checkpointable)
 //
 // Known issue: There's one problem with NewRewindableSource. Stop method could
 // block when the original source's GenerateStream doesn't generate any tuple
@@ -109,7 +155,14 @@ var (
 // whether the source is stopped is only determined by the error returned from
 // Write.
 func NewRewindableSource(s Source) RewindableSource {
-	return newRewindableSource(s, true)
+	r := newRewindableSource(s, true)
+	if sk, ok := s.(Seeker); ok {
+		return &seekableSource{
+			rewindableSource: r,
+			seeker:           sk,
+		}
+	}
+	return r
 }
 
 func newRewindableSource(s Source, rewindEnabled bool) *rewindableSource {
@@ -296,9 +349,16 @@ func (r *rewindableSource) Status() data.Map {
 // RewindableSource interface.
 func ImplementSourceStop(s Source) Source {
 	// This is implemented as a rewindableSource with rewind disabled.
-	return &nonRewindableSourceAdapter{
+	a := &nonRewindableSourceAdapter{
 		rewindableSource: newRewindableSource(s, false),
 	}
+	if sk, ok := s.(Seeker); ok {
+		return &nonRewindableSeekerAdapter{
+			nonRewindableSourceAdapter: a,
+			seeker:                     sk,
+		}
+	}
+	return a
 }
 
 // nonRewindableSourceAdapter wraps rewindableSource but doesn't provide
@@ -312,3 +372,70 @@ func (n *nonRewindableSourceAdapter) Rewind() {
 	// defined in rewindableSource so that the source returned from
 	// ImplementSourceStop becomes incompatible with RewindableSource interface.
 }
+
+// seekableSource is a rewindableSource wrapping a Seeker.
+type seekableSource struct {
+	*rewindableSource
+	seeker Seeker
+}
+
+var (
+	_ SeekableSource = &seekableSource{}
+	_ Checkpointable = &seekableSource{}
+)
+
+func (s *seekableSource) Offset() int64 {
+	return s.seeker.Offset()
+}
+
+func (s *seekableSource) Seek(ctx *Context, pos SeekPosition) error {
+	if err := s.seeker.SetStartPosition(pos); err != nil {
+		return err
+	}
+	return s.Rewind(ctx)
+}
+
+func (s *seekableSource) Status() data.Map {
+	m := s.rewindableSource.Status()
+	m["offset"] = data.Int(s.seeker.Offset())
+	return m
+}
+
+func (s *seekableSource) SaveCheckpoint(ctx *Context) (data.Value, error) {
+	return saveSeekerCheckpoint(s.seeker)
+}
+
+func (s *seekableSource) LoadCheckpoint(ctx *Context, v data.Value) error {
+	return loadSeekerCheckpoint(s.seeker, v)
+}
+
+// nonRewindableSeekerAdapter is a nonRewindableSourceAdapter wrapping a
+// Seeker. It can be checkpointed although it cannot be rewound.
+type nonRewindableSeekerAdapter struct {
+	*nonRewindableSourceAdapter
+	seeker Seeker
+}
+
+var (
+	_ Checkpointable = &nonRewindableSeekerAdapter{}
+)
+
+func (n *nonRewindableSeekerAdapter) SaveCheckpoint(ctx *Context) (data.Value, error) {
+	return saveSeekerCheckpoint(n.seeker)
+}
+
+func (n *nonRewindableSeekerAdapter) LoadCheckpoint(ctx *Context, v data.Value) error {
+	return loadSeekerCheckpoint(n.seeker, v)
+}
+
+func saveSeekerCheckpoint(s Seeker) (data.Value, error) {
+	return data.Int(s.Offset()), nil
+}
+
+func loadSeekerCheckpoint(s Seeker, v data.Value) error {
+	o, err := data.AsInt(v)
+	if err != nil {
+		return err
+	}
+	return s.SetStartPosition(SeekPosition{Offset: o})
+}
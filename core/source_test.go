@@ -4,6 +4,7 @@ import (
 	"errors"
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
 	"testing"
 	"time"
 )
@@ -139,6 +140,172 @@ func TestRewindableSource(t *testing.T) {
 	})
 }
 
+// dummySeekerSource emits tuples having "int" from its start position to
+// num-1. The timestamp of each tuple is "int" seconds from the Unix epoch.
+type dummySeekerSource struct {
+	num    int64
+	m      sync.Mutex
+	offset int64
+	start  *SeekPosition
+}
+
+func (d *dummySeekerSource) GenerateStream(ctx *Context, w Writer) error {
+	d.m.Lock()
+	i := int64(0)
+	if d.start != nil {
+		if d.start.Timestamp.IsZero() {
+			i = d.start.Offset
+		} else {
+			for i < d.num && time.Unix(i, 0).Before(d.start.Timestamp) {
+				i++
+			}
+		}
+		d.start = nil
+	}
+	d.offset = i
+	d.m.Unlock()
+
+	for ; i < d.num; i++ {
+		t := NewTuple(data.Map{"int": data.Int(i)})
+		t.Timestamp = time.Unix(i, 0)
+		if err := w.Write(ctx, t); err != nil {
+			return err
+		}
+		d.m.Lock()
+		d.offset = i + 1
+		d.m.Unlock()
+	}
+	return nil
+}
+
+func (d *dummySeekerSource) Stop(ctx *Context) error {
+	return nil
+}
+
+func (d *dummySeekerSource) Offset() int64 {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.offset
+}
+
+func (d *dummySeekerSource) SetStartPosition(pos SeekPosition) error {
+	if pos.Offset < 0 {
+		return errors.New("offset must not be negative")
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	d.start = &pos
+	return nil
+}
+
+func TestSeekableSource(t *testing.T) {
+	Convey("Given a default topology with a seekable source", t, func() {
+		t, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := &dummySeekerSource{num: 8}
+		son, err := t.AddSource("source", NewRewindableSource(so), &SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("source", nil), ShouldBeNil)
+		So(son.Resume(), ShouldBeNil)
+		si.Wait(8)
+
+		Convey("When getting the status of the source", func() {
+			st := son.Status()
+
+			Convey("Then it should have the offset", func() {
+				v, err := st.Get(data.MustCompilePath("source.offset"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(8))
+			})
+		})
+
+		Convey("When seeking to an offset", func() {
+			So(son.Seek(SeekPosition{Offset: 5}), ShouldBeNil)
+			si.Wait(11)
+
+			Convey("Then the sink should receive tuples from the offset", func() {
+				So(si.len(), ShouldEqual, 11)
+				for i := 0; i < 3; i++ {
+					So(si.get(8 + i).Data["int"], ShouldEqual, data.Int(5+i))
+				}
+			})
+		})
+
+		Convey("When seeking to a timestamp", func() {
+			So(son.Seek(SeekPosition{Timestamp: time.Unix(6, 0)}), ShouldBeNil)
+			si.Wait(10)
+
+			Convey("Then the sink should receive tuples from the timestamp", func() {
+				So(si.len(), ShouldEqual, 10)
+				So(si.get(8).Data["int"], ShouldEqual, data.Int(6))
+				So(si.get(9).Data["int"], ShouldEqual, data.Int(7))
+			})
+		})
+
+		Convey("When seeking to an invalid position", func() {
+			err := son.Seek(SeekPosition{Offset: -1})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When seeking a non-seekable source", func() {
+			son2, err := t.AddSource("source2", NewRewindableSource(NewTupleEmitterSource(freshTuples())), nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(son2.Seek(SeekPosition{}), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When seeking the stopped source", func() {
+			So(son.Stop(), ShouldBeNil)
+
+			Convey("Then it should fail", func() {
+				So(son.Seek(SeekPosition{}), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a seeker source", t, func() {
+		ctx := NewContext(nil)
+		so := &dummySeekerSource{num: 8}
+
+		Convey("When wrapping it with ImplementSourceStop", func() {
+			s := ImplementSourceStop(so)
+
+			Convey("Then it shouldn't be seekable", func() {
+				_, ok := s.(SeekableSource)
+				So(ok, ShouldBeFalse)
+			})
+
+			Convey("Then it should be checkpointable", func() {
+				c, ok := s.(Checkpointable)
+				So(ok, ShouldBeTrue)
+				So(c.LoadCheckpoint(ctx, data.Int(3)), ShouldBeNil)
+
+				si := NewTupleCollectorSink()
+				So(s.GenerateStream(ctx, si), ShouldBeNil)
+				So(si.len(), ShouldEqual, 5)
+				v, err := c.SaveCheckpoint(ctx)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(8))
+			})
+		})
+	})
+}
+
 type dummyNonstoppableSource struct {
 	stopped bool
 }
@@ -1,5 +1,9 @@
 package core
 
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
 // Topology is a topology which can add Sources, Boxes, and Sinks
 // dynamically. Boxes and Sinks can also add inputs dynamically from running
 // Sources or Boxes.
@@ -51,7 +55,19 @@ type Topology interface {
 	// isn't relevant to those nodes have.
 	State() TopologyStateHolder
 
-	// TODO: low priority: Pause, Resume
+	// Pause pauses all sources in the topology. When one of the sources
+	// cannot be paused, sources paused by this method are resumed again and
+	// an error is returned. Sources which are already stopped are ignored.
+	// A source added while the topology is paused is also paused even if
+	// SourceConfig.PausedOnStartup is false.
+	Pause() error
+
+	// Resume resumes the sources paused by Pause, including sources added
+	// while the topology is paused. Sources which were already paused before
+	// Pause was called stay paused. When one of the sources cannot be
+	// resumed, sources resumed by this method are paused again and an error
+	// is returned. Sources which are already stopped are ignored.
+	Resume() error
 
 	// Node returns a node registered to the topology. It returns NotExistError
 	// when the topology doesn't have the node.
@@ -105,7 +121,22 @@ type SourceConfig struct {
 
 // BoxConfig has configuration parameters of a Box node.
 type BoxConfig struct {
-	// TODO: parallelism
+	// Parallelism is the number of goroutines which concurrently call
+	// the Process method of the box. When it's 0 or 1, tuples are
+	// processed one by one. Because the order of output tuples isn't
+	// preserved, Parallelism should only be set for a box which is
+	// safe for concurrent calls of Process and doesn't depend on the
+	// order of tuples, unless PartitionKey is also set.
+	Parallelism int
+
+	// PartitionKey computes the key of a tuple. When it isn't nil and
+	// Parallelism is greater than 1, tuples having the same key are
+	// always processed by the same goroutine in the order of arrival,
+	// so that the order of tuples is preserved per key. A tuple for
+	// which PartitionKey returns an error is dropped. When the box is a
+	// PartitionedBox, it receives the index of the partition computed
+	// by PartitionIndex.
+	PartitionKey func(t *Tuple) (data.Value, error)
 
 	// RemoveOnStop is a flag which indicates the stop state of the topology.
 	// If it is true, the box is removed.
@@ -47,6 +47,10 @@ func (a Array) asMap() (Map, error) {
 	return nil, castError(a.Type(), TypeMap)
 }
 
+func (a Array) asInterval() (Interval, error) {
+	return Interval{}, castError(a.Type(), TypeInterval)
+}
+
 func (a Array) clone() Value {
 	out := make([]Value, len(a))
 	for idx, val := range a {
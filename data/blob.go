@@ -47,6 +47,10 @@ func (b Blob) asMap() (Map, error) {
 	return nil, castError(b.Type(), TypeMap)
 }
 
+func (b Blob) asInterval() (Interval, error) {
+	return Interval{}, castError(b.Type(), TypeInterval)
+}
+
 func (b Blob) clone() Value {
 	out := make([]byte, len(b))
 	for idx, val := range b {
@@ -53,6 +53,10 @@ func (b Bool) asMap() (Map, error) {
 	return nil, castError(b.Type(), TypeMap)
 }
 
+func (b Bool) asInterval() (Interval, error) {
+	return Interval{}, castError(b.Type(), TypeInterval)
+}
+
 func (b Bool) clone() Value {
 	return Bool(b)
 }
@@ -46,6 +46,10 @@ func (f Float) asMap() (Map, error) {
 	return nil, castError(f.Type(), TypeMap)
 }
 
+func (f Float) asInterval() (Interval, error) {
+	return Interval{}, castError(f.Type(), TypeInterval)
+}
+
 func (f Float) clone() Value {
 	return Float(f)
 }
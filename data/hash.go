@@ -87,6 +87,11 @@ func Equal(v1 Value, v2 Value) bool {
 		rhs, _ := v2.asTimestamp()
 		return lhs.Equal(rhs)
 
+	case TypeInterval:
+		lhs, _ := v1.asInterval()
+		rhs, _ := v2.asInterval()
+		return lhs.totalMicroseconds() == rhs.totalMicroseconds()
+
 	case TypeArray:
 		lhs, _ := v1.asArray()
 		rhs, _ := v2.asArray()
@@ -127,13 +132,14 @@ func Equal(v1 Value, v2 Value) bool {
 // It can be used, for example, with the functions of the sort package.
 // The rules for sorting are as follows:
 // - When the types are different:
-//   Null < Bool < Int/Float < String < Blob < Timestamp < Array < Map
+//   Null < Bool < Int/Float < String < Blob < Timestamp < Array < Map < Interval
 // - When the type is the same:
 //   - Null: always false
 //   - Bool: false < true
 //   - Int/Float: usual < comparison; Ints and Floats can also be compared
 //   - String: usual < comparison
 //   - Timestamp: value as returned by Time.Before()
+//   - Interval: length with 30-day months and 24-hour days
 //   - Blob, Array, Map: shorter collections are less than longer collections;
 //     when length is equal hash values are compared
 func Less(v1 Value, v2 Value) bool {
@@ -193,6 +199,11 @@ func Less(v1 Value, v2 Value) bool {
 		rhs, _ := v2.asTimestamp()
 		return lhs.Before(rhs)
 
+	case TypeInterval:
+		lhs, _ := v1.asInterval()
+		rhs, _ := v2.asInterval()
+		return lhs.totalMicroseconds() < rhs.totalMicroseconds()
+
 	case TypeArray:
 		lhs, _ := v1.asArray()
 		rhs, _ := v2.asArray()
@@ -301,6 +312,13 @@ func updateHash(v Value, h io.Writer, buffer []byte) []byte {
 		buffer = appendInt32(buffer, TypeInt, int32(t.Nanosecond()/1000)) // Use microseconds
 		h.Write(buffer)
 
+	case TypeInterval:
+		// Intervals that are equal have the same length, but not
+		// necessarily the same fields
+		i, _ := v.asInterval()
+		buffer = appendInt64(buffer, TypeInterval, i.totalMicroseconds())
+		h.Write(buffer)
+
 	case TypeArray:
 		a, _ := v.asArray()
 		buffer = appendInt32(buffer, TypeArray, int32(len(a)))
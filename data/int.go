@@ -48,6 +48,10 @@ func (i Int) asMap() (Map, error) {
 	return nil, castError(i.Type(), TypeMap)
 }
 
+func (i Int) asInterval() (Interval, error) {
+	return Interval{}, castError(i.Type(), TypeInterval)
+}
+
 func (i Int) clone() Value {
 	return Int(i)
 }
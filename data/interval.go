@@ -0,0 +1,379 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Interval is a span of time having microsecond-precision. It can be
+// assigned to Value. Like PostgreSQL's interval, it holds months, days,
+// and microseconds separately because the lengths of a month and a day
+// depend on the Timestamp the Interval is added to. When Intervals are
+// compared, a month is treated as 30 days and a day as 24 hours.
+type Interval struct {
+	Months       int64
+	Days         int64
+	Microseconds int64
+}
+
+const (
+	microsecondsPerSecond = int64(time.Second / time.Microsecond)
+	microsecondsPerDay    = 24 * 60 * 60 * microsecondsPerSecond
+	daysPerMonth          = 30
+)
+
+// NewInterval returns an Interval having the given duration. Full days
+// of 24 hours are held in Days.
+func NewInterval(d time.Duration) Interval {
+	us := int64(d / time.Microsecond)
+	return Interval{
+		Days:         us / microsecondsPerDay,
+		Microseconds: us % microsecondsPerDay,
+	}
+}
+
+// Type returns TypeID of Interval. It's always TypeInterval.
+func (i Interval) Type() TypeID {
+	return TypeInterval
+}
+
+func (i Interval) asBool() (bool, error) {
+	return false, castError(i.Type(), TypeBool)
+}
+
+func (i Interval) asInt() (int64, error) {
+	return 0, castError(i.Type(), TypeInt)
+}
+
+func (i Interval) asFloat() (float64, error) {
+	return 0, castError(i.Type(), TypeFloat)
+}
+
+func (i Interval) asString() (string, error) {
+	return "", castError(i.Type(), TypeString)
+}
+
+func (i Interval) asBlob() ([]byte, error) {
+	return nil, castError(i.Type(), TypeBlob)
+}
+
+func (i Interval) asTimestamp() (time.Time, error) {
+	return time.Time{}, castError(i.Type(), TypeTimestamp)
+}
+
+func (i Interval) asArray() (Array, error) {
+	return nil, castError(i.Type(), TypeArray)
+}
+
+func (i Interval) asMap() (Map, error) {
+	return nil, castError(i.Type(), TypeMap)
+}
+
+func (i Interval) asInterval() (Interval, error) {
+	return i, nil
+}
+
+func (i Interval) clone() Value {
+	return i
+}
+
+// MarshalJSON marshals an Interval to JSON. An Interval is encoded as a
+// string in the same format as ToString returns.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalJSON reconstructs an Interval from a JSON string in a format
+// supported by ParseInterval.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	s, err := strconv.Unquote(string(data))
+	if err != nil {
+		return fmt.Errorf("an interval must be a JSON string: %s", data)
+	}
+	iv, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = iv
+	return nil
+}
+
+// String returns JSON representation of an Interval, which is a quoted
+// string like "1 year 2 mons 3 days 04:05:06.7".
+func (i Interval) String() string {
+	return strconv.Quote(i.format())
+}
+
+// format returns the Interval in the output format of PostgreSQL.
+func (i Interval) format() string {
+	var parts []string
+	unit := func(n int64, name string) {
+		if n == 0 {
+			return
+		}
+		if n == 1 || n == -1 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, name))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", n, name))
+		}
+	}
+	unit(i.Months/12, "year")
+	unit(i.Months%12, "mon")
+	unit(i.Days, "day")
+	if i.Microseconds != 0 || len(parts) == 0 {
+		us := i.Microseconds
+		sign := ""
+		if us < 0 {
+			sign = "-"
+			us = -us
+		}
+		secs := us / microsecondsPerSecond
+		s := fmt.Sprintf("%s%02d:%02d:%02d", sign, secs/3600, secs/60%60, secs%60)
+		if frac := us % microsecondsPerSecond; frac != 0 {
+			s += strings.TrimRight(fmt.Sprintf(".%06d", frac), "0")
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, " ")
+}
+
+// totalMicroseconds returns the length of the Interval with 30-day months
+// and 24-hour days, which is used to compare Intervals.
+func (i Interval) totalMicroseconds() int64 {
+	return (i.Months*daysPerMonth+i.Days)*microsecondsPerDay + i.Microseconds
+}
+
+// Seconds returns the number of seconds in the Interval. Like PostgreSQL's
+// EXTRACT(EPOCH FROM interval), a year is treated as 365.25 days, a month
+// as 30 days, and a day as 24 hours.
+func (i Interval) Seconds() float64 {
+	days := float64(i.Months/12)*365.25 + float64(i.Months%12*daysPerMonth+i.Days)
+	return days*float64(microsecondsPerDay/microsecondsPerSecond) +
+		float64(i.Microseconds)/float64(microsecondsPerSecond)
+}
+
+// Add returns the field-wise sum of two Intervals.
+func (i Interval) Add(o Interval) Interval {
+	return Interval{i.Months + o.Months, i.Days + o.Days, i.Microseconds + o.Microseconds}
+}
+
+// Scale multiplies each field of the Interval by f. As in PostgreSQL,
+// fractional months are carried over to days, and fractional days to
+// microseconds.
+func (i Interval) Scale(f float64) Interval {
+	months := float64(i.Months) * f
+	days := float64(i.Days)*f + (months-math.Trunc(months))*daysPerMonth
+	us := float64(i.Microseconds)*f + (days-math.Trunc(days))*float64(microsecondsPerDay)
+	return Interval{int64(months), int64(days), int64(math.Floor(us + 0.5))}
+}
+
+// AddTo adds the Interval to the time. Months and days are added in the
+// time's location so that, e.g., adding 1 day across a change of the
+// daylight saving time keeps the time of the day. If the day of the month
+// doesn't exist in the resulting month, the last day of the month is used
+// like PostgreSQL does.
+func (i Interval) AddTo(t time.Time) time.Time {
+	if i.Months != 0 {
+		y, m, d := t.Date()
+		months := int64(y)*12 + int64(m-1) + i.Months
+		y, m = int(months/12), time.Month(months%12+1)
+		if months < 0 && months%12 != 0 {
+			y, m = y-1, m+12
+		}
+		if last := daysIn(y, m); d > last {
+			d = last
+		}
+		hh, mm, ss := t.Clock()
+		t = time.Date(y, m, d, hh, mm, ss, t.Nanosecond(), t.Location())
+	}
+	if i.Days != 0 {
+		t = t.AddDate(0, 0, int(i.Days))
+	}
+	return t.Add(time.Duration(i.Microseconds) * time.Microsecond)
+}
+
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// intervalUnits maps unit names accepted by ParseInterval to the Interval
+// of one unit.
+var intervalUnits = map[string]Interval{}
+
+func init() {
+	add := func(iv Interval, names ...string) {
+		for _, n := range names {
+			intervalUnits[n] = iv
+		}
+	}
+	add(Interval{0, 0, 1}, "microsecond", "microseconds", "us", "usec", "usecs")
+	add(Interval{0, 0, 1000}, "millisecond", "milliseconds", "ms", "msec", "msecs")
+	add(Interval{0, 0, microsecondsPerSecond}, "second", "seconds", "s", "sec", "secs")
+	add(Interval{0, 0, 60 * microsecondsPerSecond}, "minute", "minutes", "m", "min", "mins")
+	add(Interval{0, 0, 3600 * microsecondsPerSecond}, "hour", "hours", "h", "hr", "hrs")
+	add(Interval{0, 1, 0}, "day", "days", "d")
+	add(Interval{0, 7, 0}, "week", "weeks", "w")
+	add(Interval{1, 0, 0}, "month", "months", "mon", "mons")
+	add(Interval{12, 0, 0}, "year", "years", "y", "yr", "yrs")
+	add(Interval{120, 0, 0}, "decade", "decades")
+	add(Interval{1200, 0, 0}, "century", "centuries")
+	add(Interval{12000, 0, 0}, "millennium", "millennia")
+}
+
+// ParseInterval parses an Interval. It supports the formats of PostgreSQL
+// such as "1 day 2 hours", "@ 3 mins ago", "1 year -01:30:00", and
+// "1.5 weeks" as well as ISO 8601 durations such as "P1Y2M3DT4H5M6S".
+// Fractional months are carried over to days, and fractional days to
+// microseconds.
+func ParseInterval(s string) (Interval, error) {
+	str := strings.TrimSpace(s)
+	if len(str) > 1 && (str[0] == 'P' || str[0] == 'p') {
+		return parseISO8601Interval(str)
+	}
+	fields := strings.Fields(strings.ToLower(str))
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+	ago := false
+	if len(fields) > 0 && fields[len(fields)-1] == "ago" {
+		ago = true
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+	}
+
+	var res Interval
+	for len(fields) > 0 {
+		f := fields[0]
+		if strings.Contains(f, ":") {
+			us, err := parseIntervalClock(f)
+			if err != nil {
+				return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+			}
+			res.Microseconds += us
+			fields = fields[1:]
+			continue
+		}
+		// the unit may be written directly after the number, e.g., "5m"
+		numEnd := strings.IndexFunc(f, func(r rune) bool {
+			return !(r >= '0' && r <= '9' || r == '.' || r == '-' || r == '+')
+		})
+		num, unit := f, ""
+		if numEnd >= 0 {
+			num, unit = f[:numEnd], f[numEnd:]
+		}
+		fields = fields[1:]
+		if unit == "" {
+			if len(fields) == 0 {
+				return Interval{}, fmt.Errorf("invalid interval: '%v': missing unit", s)
+			}
+			unit, fields = fields[0], fields[1:]
+		}
+		x, err := strconv.ParseFloat(num, 64)
+		if err != nil {
+			return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+		}
+		u, ok := intervalUnits[unit]
+		if !ok {
+			return Interval{}, fmt.Errorf("invalid interval: '%v': unknown unit '%v'", s, unit)
+		}
+		res = res.Add(u.Scale(x))
+	}
+	if ago {
+		res = res.Scale(-1)
+	}
+	return res, nil
+}
+
+// parseIntervalClock parses a time like "-01:02:03.5" or "01:02" (hours
+// and minutes) and returns the number of microseconds.
+func parseIntervalClock(s string) (int64, error) {
+	sign := int64(1)
+	if strings.HasPrefix(s, "-") {
+		sign, s = -1, s[1:]
+	} else if strings.HasPrefix(s, "+") {
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("too many colons")
+	}
+	var us int64
+	for i, p := range parts {
+		if i == 2 {
+			secs, err := strconv.ParseFloat(p, 64)
+			if err != nil || secs < 0 || secs >= 60 {
+				return 0, fmt.Errorf("invalid seconds: %v", p)
+			}
+			us = us*60 + int64(math.Floor(secs*float64(microsecondsPerSecond)+0.5))
+			continue
+		}
+		n, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		if i == 1 && n >= 60 {
+			return 0, fmt.Errorf("invalid minutes: %v", p)
+		}
+		us = us*60 + int64(n)*microsecondsPerSecond
+	}
+	if len(parts) == 2 {
+		// hours and minutes
+		us *= 60
+	}
+	return sign * us, nil
+}
+
+// parseISO8601Interval parses a duration like "P1Y2M3W4DT5H6M7.5S".
+func parseISO8601Interval(s string) (Interval, error) {
+	var res Interval
+	inTime := false
+	rest := strings.ToUpper(s[1:])
+	if rest == "" || rest == "T" {
+		return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+	}
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			if inTime {
+				return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		end := strings.IndexAny(rest, "YMWDHS")
+		if end <= 0 {
+			return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+		}
+		x, err := strconv.ParseFloat(rest[:end], 64)
+		if err != nil {
+			return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+		}
+		var unit string
+		switch d := rest[end]; {
+		case d == 'Y' && !inTime:
+			unit = "year"
+		case d == 'M' && !inTime:
+			unit = "month"
+		case d == 'W' && !inTime:
+			unit = "week"
+		case d == 'D' && !inTime:
+			unit = "day"
+		case d == 'H' && inTime:
+			unit = "hour"
+		case d == 'M' && inTime:
+			unit = "minute"
+		case d == 'S' && inTime:
+			unit = "second"
+		default:
+			return Interval{}, fmt.Errorf("invalid interval: '%v'", s)
+		}
+		res = res.Add(intervalUnits[unit].Scale(x))
+		rest = rest[end+1:]
+	}
+	return res, nil
+}
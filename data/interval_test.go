@@ -0,0 +1,163 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseInterval(t *testing.T) {
+	const (
+		sec = 1000000
+		min = 60 * sec
+		hr  = 60 * min
+	)
+
+	Convey("Given valid interval strings", t, func() {
+		cases := []struct {
+			input    string
+			expected Interval
+		}{
+			{"5 minutes", Interval{0, 0, 5 * min}},
+			{"5m", Interval{0, 0, 5 * min}},
+			{"1 DAY 2 Hours", Interval{0, 1, 2 * hr}},
+			{"@ 3 mins ago", Interval{0, 0, -3 * min}},
+			{"1 year 2 mons -3 days", Interval{14, -3, 0}},
+			{"2 weeks", Interval{0, 14, 0}},
+			{"1.5 months", Interval{1, 15, 0}},
+			{"1.5 days", Interval{0, 1, 12 * hr}},
+			{"0.25 sec", Interval{0, 0, sec / 4}},
+			{"1 day 01:02:03.5", Interval{0, 1, hr + 2*min + 3*sec + sec/2}},
+			{"-01:30", Interval{0, 0, -hr - 30*min}},
+			{"1 century", Interval{1200, 0, 0}},
+			{"P1Y2M3DT4H5M6.5S", Interval{14, 3, 4*hr + 5*min + 6*sec + sec/2}},
+			{"PT1M", Interval{0, 0, min}},
+			{"P2W", Interval{0, 14, 0}},
+		}
+
+		Convey("When parsing them", func() {
+			Convey("Then they should be parsed correctly", func() {
+				for _, c := range cases {
+					iv, err := ParseInterval(c.input)
+					So(err, ShouldBeNil)
+					So(iv, ShouldResemble, c.expected)
+				}
+			})
+		})
+	})
+
+	Convey("Given invalid interval strings", t, func() {
+		inputs := []string{"", "ago", "5", "5 parsecs", "five minutes", "1:60",
+			"1:2:3:4", "P", "PT", "P1H", "PT1D", "P1Y1"}
+
+		Convey("When parsing them", func() {
+			Convey("Then they should fail", func() {
+				for _, in := range inputs {
+					_, err := ParseInterval(in)
+					So(err, ShouldNotBeNil)
+				}
+			})
+		})
+	})
+}
+
+func TestIntervalString(t *testing.T) {
+	Convey("Given intervals", t, func() {
+		cases := []struct {
+			input    Interval
+			expected string
+		}{
+			{Interval{}, "00:00:00"},
+			{Interval{1, 1, 0}, "1 mon 1 day"},
+			{Interval{-25, -2, -1500000}, "-2 years -1 mon -2 days -00:00:01.5"},
+			{Interval{0, 0, 36000000001}, "10:00:00.000001"},
+		}
+
+		Convey("When converting them to strings", func() {
+			Convey("Then they should be formatted like PostgreSQL", func() {
+				for _, c := range cases {
+					So(c.input.String(), ShouldEqual, fmt.Sprintf("%q", c.expected))
+					// the output can be parsed again
+					iv, err := ParseInterval(c.expected)
+					So(err, ShouldBeNil)
+					So(iv, ShouldResemble, c.input)
+				}
+			})
+		})
+
+		Convey("When marshaling them to JSON and back", func() {
+			b, err := json.Marshal(Map{"i": Interval{14, 3, 1000}})
+			So(err, ShouldBeNil)
+			var i struct{ I Interval }
+			So(json.Unmarshal(b, &i), ShouldBeNil)
+
+			Convey("Then the value should be restored", func() {
+				So(string(b), ShouldEqual, `{"i":"1 year 2 mons 3 days 00:00:00.001"}`)
+				So(i.I, ShouldResemble, Interval{14, 3, 1000})
+			})
+		})
+	})
+}
+
+func TestIntervalArithmetic(t *testing.T) {
+	Convey("Given a timestamp", t, func() {
+		ts := time.Date(2015, time.January, 31, 10, 0, 0, 0, time.UTC)
+
+		Convey("When adding intervals to it", func() {
+			Convey("Then months should be clamped to the end of the month", func() {
+				So(Interval{1, 0, 0}.AddTo(ts), ShouldResemble,
+					time.Date(2015, time.February, 28, 10, 0, 0, 0, time.UTC))
+				So(Interval{-2, 0, 0}.AddTo(ts), ShouldResemble,
+					time.Date(2014, time.November, 30, 10, 0, 0, 0, time.UTC))
+				So(Interval{13, 1, 0}.AddTo(ts), ShouldResemble,
+					time.Date(2016, time.March, 1, 10, 0, 0, 0, time.UTC))
+			})
+
+			Convey("Then days and microseconds should be added", func() {
+				So(Interval{0, -31, 5}.AddTo(ts), ShouldResemble,
+					time.Date(2014, time.December, 31, 10, 0, 0, 5000, time.UTC))
+			})
+		})
+
+		Convey("When adding a day across a change of the daylight saving time", func() {
+			loc := time.FixedZone("dummy", 0)
+			if l, err := time.LoadLocation("America/New_York"); err == nil {
+				loc = l
+			}
+			start := time.Date(2015, time.March, 7, 12, 0, 0, 0, loc)
+
+			Convey("Then the time of the day should be kept", func() {
+				So(Interval{0, 1, 0}.AddTo(start).Hour(), ShouldEqual, 12)
+			})
+		})
+	})
+
+	Convey("Given intervals", t, func() {
+		Convey("When scaling them", func() {
+			Convey("Then fractions should be carried over to smaller fields", func() {
+				So(Interval{3, 1, 0}.Scale(0.5), ShouldResemble, Interval{1, 15, 43200000000})
+				So(Interval{1, 2, 3}.Scale(-2), ShouldResemble, Interval{-2, -4, -6})
+			})
+		})
+
+		Convey("When creating them from a duration", func() {
+			Convey("Then full days should be held in Days", func() {
+				So(NewInterval(49*time.Hour+time.Microsecond), ShouldResemble,
+					Interval{0, 2, 3600000001})
+			})
+		})
+
+		Convey("When comparing them", func() {
+			Convey("Then a month should equal 30 days", func() {
+				So(Equal(Interval{1, 0, 0}, Interval{0, 30, 0}), ShouldBeTrue)
+				So(Hash(Interval{1, 0, 0}), ShouldEqual, Hash(Interval{0, 30, 0}))
+				So(Equal(Interval{0, 1, 0}, Interval{0, 0, 86400000000}), ShouldBeTrue)
+				So(Less(Interval{0, 29, 0}, Interval{1, 0, 0}), ShouldBeTrue)
+				So(Less(Interval{1, 0, 0}, Interval{0, 29, 0}), ShouldBeFalse)
+			})
+		})
+	})
+}
@@ -48,6 +48,10 @@ func (m Map) asMap() (Map, error) {
 	return m, nil
 }
 
+func (m Map) asInterval() (Interval, error) {
+	return Interval{}, castError(m.Type(), TypeInterval)
+}
+
 func (m Map) clone() Value {
 	return m.Copy()
 }
@@ -46,6 +46,10 @@ func (n Null) asMap() (Map, error) {
 	return nil, castError(n.Type(), TypeMap)
 }
 
+func (n Null) asInterval() (Interval, error) {
+	return Interval{}, castError(n.Type(), TypeInterval)
+}
+
 func (n Null) clone() Value {
 	return Null{}
 }
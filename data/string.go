@@ -46,6 +46,10 @@ func (s String) asMap() (Map, error) {
 	return nil, castError(s.Type(), TypeMap)
 }
 
+func (s String) asInterval() (Interval, error) {
+	return Interval{}, castError(s.Type(), TypeInterval)
+}
+
 func (s String) clone() Value {
 	return String(s)
 }
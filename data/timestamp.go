@@ -46,6 +46,10 @@ func (t Timestamp) asMap() (Map, error) {
 	return nil, castError(t.Type(), TypeMap)
 }
 
+func (t Timestamp) asInterval() (Interval, error) {
+	return Interval{}, castError(t.Type(), TypeInterval)
+}
+
 func (t Timestamp) clone() Value {
 	return Timestamp(t)
 }
@@ -64,6 +64,12 @@ func AsMap(v Value) (Map, error) {
 	return v.asMap()
 }
 
+// AsInterval returns an Interval only when the type of Value is
+// TypeInterval, otherwise it returns error.
+func AsInterval(v Value) (Interval, error) {
+	return v.asInterval()
+}
+
 // ToBool converts a given Value to a bool, if possible. The conversion
 // rules are similar to those in Python:
 //
@@ -76,6 +82,7 @@ func AsMap(v Value) (Map, error) {
 //  * Timestamp: true if IsZero() is false
 //  * Array: true if non-empty
 //  * Map: true if non-empty
+//  * Interval: true if any of its fields is non-zero
 func ToBool(v Value) (bool, error) {
 	defaultValue := false
 	switch v.Type() {
@@ -104,6 +111,9 @@ func ToBool(v Value) (bool, error) {
 	case TypeMap:
 		val, _ := v.asMap()
 		return len(val) > 0, nil
+	case TypeInterval:
+		val, _ := v.asInterval()
+		return val != Interval{}, nil
 	default:
 		return defaultValue,
 			fmt.Errorf("cannot convert %T to bool", v)
@@ -124,6 +134,8 @@ func ToBool(v Value) (bool, error) {
 //  * Timestamp: the number of second elapsed since January 1, 1970 UTC.
 //  * Array: (error)
 //  * Map: (error)
+//  * Interval: the number of seconds as returned by Interval.Seconds,
+//    truncated
 func ToInt(v Value) (int64, error) {
 	defaultValue := int64(0)
 	switch v.Type() {
@@ -152,6 +164,9 @@ func ToInt(v Value) (int64, error) {
 		// return only second part
 		seconds := time.Duration(val.Unix())
 		return int64(seconds), nil
+	case TypeInterval:
+		val, _ := v.asInterval()
+		return ToInt(Float(val.Seconds()))
 	default:
 		return defaultValue,
 			fmt.Errorf("cannot convert %T to int64", v)
@@ -172,6 +187,7 @@ func ToInt(v Value) (int64, error) {
 //    January 1, 1970 UTC, with a decimal part
 //  * Array: (error)
 //  * Map: (error)
+//  * Interval: the number of seconds as returned by Interval.Seconds
 func ToFloat(v Value) (float64, error) {
 	defaultValue := float64(0)
 	switch v.Type() {
@@ -198,6 +214,9 @@ func ToFloat(v Value) (float64, error) {
 		// Note that due to numerical issues, this sometimes yields different
 		// results within the range of machine precision.
 		return float64(val.Unix()) + float64(val.Nanosecond())/1e9, nil
+	case TypeInterval:
+		val, _ := v.asInterval()
+		return val.Seconds(), nil
 	default:
 		return defaultValue,
 			fmt.Errorf("cannot convert %T to float64", v)
@@ -211,6 +230,7 @@ func ToFloat(v Value) (float64, error) {
 //  * String: the actual string
 //  * Blob: base64-encoded string
 //  * Timestamp: ISO 8601 representation, see time.RFC3339
+//  * Interval: PostgreSQL's representation, e.g. "1 day 02:03:04"
 //  * other: Go's "%#v" representation
 func ToString(v Value) (string, error) {
 	switch v.Type() {
@@ -227,6 +247,9 @@ func ToString(v Value) (string, error) {
 	case TypeTimestamp:
 		val, _ := v.asTimestamp()
 		return val.Format(time.RFC3339Nano), nil
+	case TypeInterval:
+		val, _ := v.asInterval()
+		return val.format(), nil
 	case TypeArray, TypeMap:
 		return v.String(), nil
 	default:
@@ -316,6 +339,7 @@ func ToTimestamp(v Value) (time.Time, error) {
 //	* Int: Converted to seconds (e.g. 3 is equal to 3 seconds)
 //	* Float: Converted to seconds (e.g. 3.141592 equals 3s + 141ms + 592us)
 //	* String: time.ParseDuration will be called
+//	* Interval: its length with 24-hour days (months lead to an error)
 //	* other: (error)
 func ToDuration(v Value) (time.Duration, error) {
 	switch v.Type() {
@@ -331,7 +355,47 @@ func ToDuration(v Value) (time.Duration, error) {
 	case TypeString:
 		s, _ := v.asString()
 		return time.ParseDuration(s)
+	case TypeInterval:
+		i, _ := v.asInterval()
+		if i.Months != 0 {
+			return 0, fmt.Errorf("cannot convert an interval having months to Duration: %v", i)
+		}
+		return time.Duration(i.Days*microsecondsPerDay+i.Microseconds) * time.Microsecond, nil
 	default:
 		return 0, fmt.Errorf("cannot convert %T to Duration", v)
 	}
 }
+
+// ToInterval converts a Value to an Interval, if possible.
+// The conversion rules are as follows:
+//
+//  * Null: zero interval
+//  * Int: the given number of seconds
+//  * Float: the given number of seconds, where the decimal part will be
+//    rounded to microseconds
+//  * String: parsed by ParseInterval
+//  * Interval: actual value
+//  * other: (error)
+func ToInterval(v Value) (Interval, error) {
+	switch v.Type() {
+	case TypeNull:
+		return Interval{}, nil
+	case TypeInt:
+		i, _ := v.asInt()
+		return NewInterval(time.Duration(i) * time.Second), nil
+	case TypeFloat:
+		f, _ := v.asFloat()
+		if math.IsNaN(f) || math.Abs(f) > MaxConvFloat64/float64(time.Second) {
+			return Interval{}, fmt.Errorf("%v is out of bounds for interval conversion", f)
+		}
+		us := math.Floor(f*float64(microsecondsPerSecond) + 0.5)
+		return NewInterval(time.Duration(us) * time.Microsecond), nil
+	case TypeString:
+		s, _ := v.asString()
+		return ParseInterval(s)
+	case TypeInterval:
+		return v.asInterval()
+	default:
+		return Interval{}, fmt.Errorf("cannot convert %T to Interval", v)
+	}
+}
@@ -56,6 +56,10 @@ func TestToBool(t *testing.T) {
 			{"empty", Map{}, false},
 			{"non-empty", Map{"a": Int(2), "b": String("foo")}, true},
 		},
+		"Interval": {
+			{"zero", Interval{}, false},
+			{"non-zero", Interval{0, 0, 1}, true},
+		},
 	}
 
 	toFun := func(v Value) (interface{}, error) {
@@ -118,6 +122,11 @@ func TestToInt(t *testing.T) {
 			{"empty", Map{}, nil},
 			{"non-empty", Map{"a": Int(2), "b": String("foo")}, nil},
 		},
+		"Interval": {
+			{"zero", Interval{}, int64(0)},
+			{"days and seconds", Interval{0, 1, 2500000}, int64(86402)},
+			{"negative", Interval{0, 0, -2500000}, int64(-2)},
+		},
 	}
 
 	toFun := func(v Value) (interface{}, error) {
@@ -184,6 +193,11 @@ func TestToFloat(t *testing.T) {
 			{"empty", Map{}, nil},
 			{"non-empty", Map{"a": Int(2), "b": String("foo")}, nil},
 		},
+		"Interval": {
+			{"zero", Interval{}, 0.0},
+			{"days and seconds", Interval{0, 1, 2500000}, 86402.5},
+			{"years and months", Interval{13, 0, 0}, 31557600.0 + 30*86400},
+		},
 	}
 
 	toFun := func(v Value) (interface{}, error) {
@@ -238,6 +252,10 @@ func TestToString(t *testing.T) {
 			// that we will always get the same string
 			// convTestInput{"non-empty", Map{"a": Int(2), "b": String("foo")}, `data.Map{"a":2, "b":"foo"}`},
 		},
+		"Interval": {
+			{"zero", Interval{}, "00:00:00"},
+			{"non-zero", Interval{14, 3, 3723500000}, "1 year 2 mons 3 days 01:02:03.5"},
+		},
 	}
 
 	toFun := func(v Value) (interface{}, error) {
@@ -393,6 +411,10 @@ func TestToDuration(t *testing.T) {
 			{"empty", Map{}, nil},
 			{"non-empty", Map{"a": Int(2), "b": String("foo")}, nil},
 		},
+		"Interval": {
+			{"days and seconds", Interval{0, 1, 1000}, 24*time.Hour + time.Millisecond},
+			{"months", Interval{1, 0, 0}, nil},
+		},
 	}
 
 	toFun := func(v Value) (interface{}, error) {
@@ -402,6 +424,41 @@ func TestToDuration(t *testing.T) {
 	runConversionTestCases(t, toFun, "ToDuration", testCases)
 }
 
+func TestToInterval(t *testing.T) {
+	testCases := map[string][]convTestInput{
+		"Null": {
+			{"Null", Null{}, Interval{}},
+		},
+		"Bool": {
+			{"true", True, nil},
+		},
+		"Int": {
+			{"positive", Int(90000), Interval{0, 1, 3600000000}},
+			{"negative", Int(-2), Interval{0, 0, -2000000}},
+		},
+		"Float": {
+			{"positive", Float(3.14), Interval{0, 0, 3140000}},
+			{"NaN", Float(math.NaN()), nil},
+		},
+		"String": {
+			{"empty", String(""), nil},
+			{"interval", String("1 day 2 hours"), Interval{0, 1, 7200000000}},
+		},
+		"Timestamp": {
+			{"now", Timestamp(time.Now()), nil},
+		},
+		"Interval": {
+			{"non-zero", Interval{1, 2, 3}, Interval{1, 2, 3}},
+		},
+	}
+
+	toFun := func(v Value) (interface{}, error) {
+		val, err := ToInterval(v)
+		return val, err
+	}
+	runConversionTestCases(t, toFun, "ToInterval", testCases)
+}
+
 func runConversionTestCases(t *testing.T,
 	toFun func(v Value) (interface{}, error),
 	funcName string,
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/ugorji/go/codec"
+	"io"
 	"math"
 	"reflect"
 	"time"
@@ -34,6 +35,7 @@ type Value interface {
 	asTimestamp() (time.Time, error)
 	asArray() (Array, error)
 	asMap() (Map, error)
+	asInterval() (Interval, error)
 	clone() Value
 	String() string
 }
@@ -65,6 +67,8 @@ const (
 	TypeArray
 	// TypeMap is a TypeID of Map.
 	TypeMap
+	// TypeInterval is a TypeID of Interval.
+	TypeInterval
 )
 
 func (t TypeID) String() string {
@@ -87,6 +91,8 @@ func (t TypeID) String() string {
 		return "array"
 	case TypeMap:
 		return "map"
+	case TypeInterval:
+		return "interval"
 	default:
 		return "unknown"
 	}
@@ -284,6 +290,46 @@ func MarshalMsgpack(m Map) ([]byte, error) {
 	return out, err
 }
 
+// MsgpackDecoder reads Maps from a stream of msgpack encoded maps such as
+// a file written by MsgpackEncoder.
+type MsgpackDecoder struct {
+	dec *codec.Decoder
+}
+
+// NewMsgpackDecoder returns a MsgpackDecoder reading from r.
+func NewMsgpackDecoder(r io.Reader) *MsgpackDecoder {
+	return &MsgpackDecoder{
+		dec: codec.NewDecoder(r, msgpackHandle),
+	}
+}
+
+// Decode reads the next Map from the stream. It returns io.EOF when the
+// stream has no more data.
+func (d *MsgpackDecoder) Decode() (Map, error) {
+	var m map[string]interface{}
+	if err := d.dec.Decode(&m); err != nil {
+		return nil, err
+	}
+	return NewMap(m)
+}
+
+// MsgpackEncoder writes Maps to a stream in msgpack serialization.
+type MsgpackEncoder struct {
+	enc *codec.Encoder
+}
+
+// NewMsgpackEncoder returns a MsgpackEncoder writing to w.
+func NewMsgpackEncoder(w io.Writer) *MsgpackEncoder {
+	return &MsgpackEncoder{
+		enc: codec.NewEncoder(w, msgpackHandle),
+	}
+}
+
+// Encode writes m to the stream.
+func (e *MsgpackEncoder) Encode(m Map) error {
+	return e.enc.Encode(NewIMap(m))
+}
+
 // NewIMap returns a map[string]interface{} object from Map.
 func NewIMap(m Map) map[string]interface{} {
 	result := map[string]interface{}{}
@@ -324,6 +370,8 @@ func newIValue(v Value) interface{} {
 	case TypeMap:
 		innerMap, _ := v.asMap()
 		result = NewIMap(innerMap)
+	case TypeInterval:
+		result, _ = ToString(v)
 	case TypeNull:
 		result = nil
 	default:
@@ -1,10 +1,12 @@
 package data
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/ugorji/go/codec"
+	"io"
 	"math"
 	"testing"
 	"time"
@@ -210,6 +212,34 @@ func TestMarshalMsgpack(t *testing.T) {
 	})
 }
 
+func TestMsgpackStream(t *testing.T) {
+	Convey("Given a msgpack stream written by MsgpackEncoder", t, func() {
+		maps := []Map{
+			{"int": Int(1), "string": String("a")},
+			{"array": Array{Float(1.5), Null{}}, "map": Map{"bool": True}},
+		}
+		buf := bytes.NewBuffer(nil)
+		enc := NewMsgpackEncoder(buf)
+		for _, m := range maps {
+			So(enc.Encode(m), ShouldBeNil)
+		}
+
+		Convey("When reading it with MsgpackDecoder", func() {
+			dec := NewMsgpackDecoder(buf)
+
+			Convey("Then it should return all maps and io.EOF", func() {
+				for _, m := range maps {
+					actual, err := dec.Decode()
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, m)
+				}
+				_, err := dec.Decode()
+				So(err, ShouldEqual, io.EOF)
+			})
+		})
+	})
+}
+
 func TestValue(t *testing.T) {
 	var testData = Map{
 		"bool":   Bool(true),
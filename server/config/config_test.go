@@ -79,6 +79,10 @@ func TestConfigToMap(t *testing.T) {
 				LogDroppedTuples:         true,
 				LogDestinationlessTuples: true,
 				SummarizeDroppedTuples:   true,
+				Format:                   "text",
+				Rotation: LogRotation{
+					MaxSize: 100,
+				},
 			},
 		}
 		Convey("When convert to data.Map", func() {
@@ -90,10 +94,12 @@ func TestConfigToMap(t *testing.T) {
 					},
 					"topologies": data.Map{
 						"t1": data.Map{
-							"bql_file": data.String("t1.bql"),
+							"bql_file":            data.String("t1.bql"),
+							"checkpoint_interval": data.Int(0),
 						},
 						"t2": data.Map{
-							"bql_file": data.String("t2.bql"),
+							"bql_file":            data.String("t2.bql"),
+							"checkpoint_interval": data.Int(0),
 						},
 					},
 					"storage": data.Map{
@@ -110,6 +116,14 @@ func TestConfigToMap(t *testing.T) {
 						"log_dropped_tuples":         data.True,
 						"log_destinationless_tuples": data.True,
 						"summarize_dropped_tuples":   data.True,
+						"format":                     data.String("text"),
+						"rotation": data.Map{
+							"max_size":    data.Int(100),
+							"interval":    data.Int(0),
+							"max_backups": data.Int(0),
+							"max_age":     data.Int(0),
+							"compress":    data.False,
+						},
 					},
 				}
 				So(ac, ShouldResemble, ex)
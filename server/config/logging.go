@@ -1,10 +1,17 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	"github.com/xeipuuv/gojsonschema"
 	"gopkg.in/natefinch/lumberjack.v2"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
@@ -46,8 +53,43 @@ type Logging struct {
 	// JSON parsers. This parameter only works when LogDroppedTuples is true.
 	SummarizeDroppedTuples bool `json:"summarize_dropped_tuples" yaml:"summarize_dropped_tuples"`
 
-	// TODO: add log rotation
-	// TODO: add log formatting
+	// Format is the format of log entries. It can be one of followings:
+	//
+	//	- text: human readable text, which is colored when Target is a terminal
+	//	- logfmt: key=value pairs separated by spaces
+	//	- json: a JSON object per line
+	Format string `json:"format" yaml:"format"`
+
+	// Rotation has parameters of log rotation. It's only used when Target is
+	// a file path.
+	Rotation LogRotation `json:"rotation" yaml:"rotation"`
+}
+
+// LogRotation has configuration parameters for log rotation. A log file is
+// rotated when its size exceeds MaxSize or Interval has passed since the
+// last rotation, whichever comes first. A rotated file is renamed to a name
+// having the time of the rotation, e.g. sensorbee-2016-01-02T03-04-05.000.log.
+type LogRotation struct {
+	// MaxSize is the maximum size of a log file in megabytes. The default
+	// value is 100.
+	MaxSize int `json:"max_size" yaml:"max_size"`
+
+	// Interval is the interval of time-based rotation in seconds. Log files
+	// are only rotated based on their sizes when Interval is 0, which is the
+	// default value.
+	Interval int `json:"interval" yaml:"interval"`
+
+	// MaxBackups is the maximum number of rotated files to retain. All rotated
+	// files are retained when it's 0, which is the default value.
+	MaxBackups int `json:"max_backups" yaml:"max_backups"`
+
+	// MaxAge is the maximum number of days to retain rotated files. Rotated
+	// files aren't removed based on their ages when it's 0, which is the
+	// default value.
+	MaxAge int `json:"max_age" yaml:"max_age"`
+
+	// Compress controls whether rotated files are compressed with gzip.
+	Compress bool `json:"compress" yaml:"compress"`
 }
 
 var (
@@ -68,6 +110,34 @@ var (
 		},
 		"summarize_dropped_tuples": {
 			"type": "boolean"
+		},
+		"format": {
+			"enum": ["text", "logfmt", "json"]
+		},
+		"rotation": {
+			"type": "object",
+			"properties": {
+				"max_size": {
+					"type": "integer",
+					"minimum": 1
+				},
+				"interval": {
+					"type": "integer",
+					"minimum": 0
+				},
+				"max_backups": {
+					"type": "integer",
+					"minimum": 0
+				},
+				"max_age": {
+					"type": "integer",
+					"minimum": 0
+				},
+				"compress": {
+					"type": "boolean"
+				}
+			},
+			"additionalProperties": false
 		}
 	},
 	"additionalProperties": false
@@ -98,6 +168,14 @@ func newLogging(m data.Map) *Logging {
 		LogDroppedTuples:         mustToBool(getWithDefault(m, "log_dropped_tuples", data.False)),
 		LogDestinationlessTuples: mustToBool(getWithDefault(m, "log_destinationless_tuples", data.False)),
 		SummarizeDroppedTuples:   mustToBool(getWithDefault(m, "summarize_dropped_tuples", data.False)),
+		Format:                   mustAsString(getWithDefault(m, "format", data.String("text"))),
+		Rotation: LogRotation{
+			MaxSize:    int(mustToInt(getWithDefault(m, "rotation.max_size", data.Int(100)))),
+			Interval:   int(mustToInt(getWithDefault(m, "rotation.interval", data.Int(0)))),
+			MaxBackups: int(mustToInt(getWithDefault(m, "rotation.max_backups", data.Int(0)))),
+			MaxAge:     int(mustToInt(getWithDefault(m, "rotation.max_age", data.Int(0)))),
+			Compress:   mustToBool(getWithDefault(m, "rotation.compress", data.False)),
+		},
 	}
 }
 
@@ -114,7 +192,7 @@ func (n *nopCloser) Close() error {
 }
 
 // CreateWriter creates io.Writer for loggers. When Target is a file, the writer
-// supports log rotation using lumberjack.
+// supports log rotation using lumberjack as configured by Rotation.
 func (l *Logging) CreateWriter() (io.WriteCloser, error) {
 	// TODO: config package should probably concentrate on parsing and validating
 	// config files and this should be moved to the server.
@@ -132,10 +210,128 @@ func (l *Logging) CreateWriter() (io.WriteCloser, error) {
 		}
 		f.Close()
 
-		return &lumberjack.Logger{
-			Filename: l.Target,
-			// TODO: set rotation options
-		}, nil
+		lj := &lumberjack.Logger{
+			Filename:   l.Target,
+			MaxSize:    l.Rotation.MaxSize,
+			MaxBackups: l.Rotation.MaxBackups,
+			MaxAge:     l.Rotation.MaxAge,
+			LocalTime:  true,
+			Compress:   l.Rotation.Compress,
+		}
+		if l.Rotation.Interval <= 0 {
+			return lj, nil
+		}
+		return newPeriodicRotator(lj, time.Duration(l.Rotation.Interval)*time.Second), nil
+	}
+}
+
+// periodicRotator rotates a log file periodically in addition to size-based
+// rotation done by lumberjack.
+type periodicRotator struct {
+	*lumberjack.Logger
+	stop     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func newPeriodicRotator(l *lumberjack.Logger, interval time.Duration) *periodicRotator {
+	r := &periodicRotator{
+		Logger: l,
+		stop:   make(chan struct{}),
+	}
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				if err := r.Rotate(); err != nil {
+					fmt.Fprintf(os.Stderr, "cannot rotate the log file %v: %v\n", l.Filename, err)
+				}
+			}
+		}
+	}()
+	return r
+}
+
+func (r *periodicRotator) Close() error {
+	r.stopOnce.Do(func() {
+		close(r.stop)
+	})
+	r.wg.Wait()
+	return r.Logger.Close()
+}
+
+// CreateFormatter creates logrus.Formatter for loggers from Format.
+func (l *Logging) CreateFormatter() logrus.Formatter {
+	switch l.Format {
+	case "json":
+		return &logrus.JSONFormatter{}
+	case "logfmt":
+		return &logfmtFormatter{}
+	default:
+		return &logrus.TextFormatter{}
+	}
+}
+
+// logfmtFormatter formats a log entry as a single line of logfmt, i.e.
+// key=value pairs separated by spaces. The line starts with time, level,
+// and msg, which are followed by the fields of the entry sorted by their
+// keys. A field whose key is one of those three is written with the
+// "fields." prefix like logrus.TextFormatter does.
+type logfmtFormatter struct{}
+
+func (f *logfmtFormatter) Format(e *logrus.Entry) ([]byte, error) {
+	b := &bytes.Buffer{}
+	writeLogfmtPair(b, "time", e.Time.Format(time.RFC3339Nano))
+	writeLogfmtPair(b, "level", e.Level.String())
+	writeLogfmtPair(b, "msg", e.Message)
+
+	keys := make([]string, 0, len(e.Data))
+	for k := range e.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		var v string
+		switch d := e.Data[k].(type) {
+		case string:
+			v = d
+		case error:
+			v = d.Error()
+		default:
+			v = fmt.Sprint(d)
+		}
+		key := k
+		switch k {
+		case "time", "level", "msg":
+			key = "fields." + k
+		}
+		writeLogfmtPair(b, key, v)
+	}
+	b.WriteByte('\n')
+	return b.Bytes(), nil
+}
+
+// writeLogfmtPair writes key=value to b. The value is quoted when it's
+// empty or contains a space, '=', '"', or a control character.
+func writeLogfmtPair(b *bytes.Buffer, key, value string) {
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(key)
+	b.WriteByte('=')
+	needsQuoting := value == "" || strings.IndexFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == 0x7f
+	}) >= 0
+	if needsQuoting {
+		b.WriteString(strconv.Quote(value))
+	} else {
+		b.WriteString(value)
 	}
 }
 
@@ -147,5 +343,13 @@ func (l *Logging) ToMap() data.Map {
 		"log_dropped_tuples":         data.Bool(l.LogDroppedTuples),
 		"log_destinationless_tuples": data.Bool(l.LogDestinationlessTuples),
 		"summarize_dropped_tuples":   data.Bool(l.SummarizeDroppedTuples),
+		"format":                     data.String(l.Format),
+		"rotation": data.Map{
+			"max_size":    data.Int(l.Rotation.MaxSize),
+			"interval":    data.Int(l.Rotation.Interval),
+			"max_backups": data.Int(l.Rotation.MaxBackups),
+			"max_age":     data.Int(l.Rotation.MaxAge),
+			"compress":    data.Bool(l.Rotation.Compress),
+		},
 	}
 }
@@ -2,10 +2,15 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/Sirupsen/logrus"
 	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 func TestLogging(t *testing.T) {
@@ -32,6 +37,8 @@ func TestLogging(t *testing.T) {
 				So(l.MinLogLevel, ShouldEqual, "info")
 				So(l.LogDroppedTuples, ShouldBeFalse)
 				So(l.SummarizeDroppedTuples, ShouldBeFalse)
+				So(l.Format, ShouldEqual, "text")
+				So(l.Rotation, ShouldResemble, LogRotation{MaxSize: 100})
 			})
 		})
 
@@ -130,5 +137,138 @@ func TestLogging(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("When validating format", func() {
+			for _, f := range []string{"text", "logfmt", "json"} {
+				Convey(fmt.Sprint("Then it should accept ", f), func() {
+					l, err := NewLogging(toMap(fmt.Sprintf(`{"target":"stderr","format":"%v"}`, f)))
+					So(err, ShouldBeNil)
+					So(l.Format, ShouldEqual, f)
+				})
+			}
+
+			for _, f := range [][]interface{}{{"empty", `""`}, {"invalid", `"xml"`}, {"invalid type", 1}} {
+				Convey(fmt.Sprintf("Then it should reject %v value", f[0]), func() {
+					_, err := NewLogging(toMap(fmt.Sprintf(`{"target":"stderr","format":%v}`, f[1])))
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+
+		Convey("When validating rotation", func() {
+			Convey("Then it should accept all parameters", func() {
+				l, err := NewLogging(toMap(`{"target":"stderr","rotation":{"max_size":10,"interval":3600,"max_backups":5,"max_age":7,"compress":true}}`))
+				So(err, ShouldBeNil)
+				So(l.Rotation, ShouldResemble, LogRotation{
+					MaxSize:    10,
+					Interval:   3600,
+					MaxBackups: 5,
+					MaxAge:     7,
+					Compress:   true,
+				})
+			})
+
+			for _, r := range [][]interface{}{
+				{"zero max_size", `{"max_size":0}`},
+				{"negative interval", `{"interval":-1}`},
+				{"non-integer max_backups", `{"max_backups":1.5}`},
+				{"negative max_age", `{"max_age":-1}`},
+				{"non-bool compress", `{"compress":"true"}`},
+				{"undefined field", `{"max_files":1}`},
+				{"non-object", `1`},
+			} {
+				Convey(fmt.Sprintf("Then it should reject %v", r[0]), func() {
+					_, err := NewLogging(toMap(fmt.Sprintf(`{"target":"stderr","rotation":%v}`, r[1])))
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
+	})
+
+	Convey("Given a logging config having a file target", t, func() {
+		dir, err := ioutil.TempDir("", "sbtest_config_logging")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			os.RemoveAll(dir)
+		})
+		target := filepath.Join(dir, "sensorbee.log")
+
+		Convey("When creating a writer without time-based rotation", func() {
+			l, err := NewLogging(toMap(fmt.Sprintf(`{"target":"%v","rotation":{"max_size":10,"max_backups":5,"compress":true}}`, target)))
+			So(err, ShouldBeNil)
+			w, err := l.CreateWriter()
+			So(err, ShouldBeNil)
+			Reset(func() {
+				w.Close()
+			})
+
+			Convey("Then it should be a lumberjack logger having rotation parameters", func() {
+				lj, ok := w.(*lumberjack.Logger)
+				So(ok, ShouldBeTrue)
+				So(lj.Filename, ShouldEqual, target)
+				So(lj.MaxSize, ShouldEqual, 10)
+				So(lj.MaxBackups, ShouldEqual, 5)
+				So(lj.Compress, ShouldBeTrue)
+			})
+		})
+
+		Convey("When creating a writer with time-based rotation", func() {
+			l, err := NewLogging(toMap(fmt.Sprintf(`{"target":"%v","rotation":{"interval":1}}`, target)))
+			So(err, ShouldBeNil)
+			w, err := l.CreateWriter()
+			So(err, ShouldBeNil)
+			Reset(func() {
+				w.Close()
+			})
+			_, err = w.Write([]byte("test\n"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the log file should be rotated", func() {
+				var files []os.FileInfo
+				for i := 0; i < 300 && len(files) < 2; i++ {
+					time.Sleep(10 * time.Millisecond)
+					files, err = ioutil.ReadDir(dir)
+					So(err, ShouldBeNil)
+				}
+				So(len(files), ShouldBeGreaterThanOrEqualTo, 2)
+			})
+
+			Convey("Then it should be able to be closed", func() {
+				So(w.Close(), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given a logging config", t, func() {
+		Convey("When creating a formatter for json", func() {
+			l, err := NewLogging(toMap(`{"format":"json"}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be a JSON formatter", func() {
+				So(l.CreateFormatter(), ShouldHaveSameTypeAs, &logrus.JSONFormatter{})
+			})
+		})
+
+		Convey("When creating a formatter for logfmt", func() {
+			l, err := NewLogging(toMap(`{"format":"logfmt"}`))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should format an entry as logfmt", func() {
+				e := logrus.NewEntry(logrus.New()).WithFields(logrus.Fields{
+					"node":  "my source",
+					"count": 3,
+					"err":   fmt.Errorf(`cannot parse "a=b"`),
+					"empty": "",
+					"msg":   "clash",
+				})
+				e.Time = time.Date(2016, time.January, 2, 3, 4, 5, 0, time.UTC)
+				e.Level = logrus.WarnLevel
+				e.Message = "an error occurred"
+				b, err := l.CreateFormatter().Format(e)
+				So(err, ShouldBeNil)
+				So(string(b), ShouldEqual, `time=2016-01-02T03:04:05Z level=warning msg="an error occurred" `+
+					`count=3 empty="" err="cannot parse \"a=b\"" fields.msg=clash node="my source"`+"\n")
+			})
+		})
 	})
 }
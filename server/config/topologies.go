@@ -13,6 +13,12 @@ type Topology struct {
 
 	// BQLFile is a file path to the BQL file executed on start up.
 	BQLFile string `json:"bql_file" yaml:"bql_file"`
+
+	// CheckpointInterval is the interval in seconds at which the state of
+	// the topology is checkpointed to the UDS storage. When it's positive,
+	// the topology is also restored from the last checkpoint on start up.
+	// Checkpointing is disabled when it's 0, which is the default value.
+	CheckpointInterval int `json:"checkpoint_interval" yaml:"checkpoint_interval"`
 }
 
 // Topologies is a set of configuration of topologies.
@@ -32,6 +38,10 @@ var (
 						"bql_file": {
 							"type": "string",
 							"minLength": 1
+						},
+						"checkpoint_interval": {
+							"type": "integer",
+							"minimum": 0
 						}
 					},
 					"additionalProperties": false
@@ -73,8 +83,9 @@ func newTopologies(m data.Map) Topologies {
 			conf = data.Map{}
 		}
 		t := &Topology{
-			Name:    name,
-			BQLFile: mustAsString(getWithDefault(mustAsMap(conf), "bql_file", data.String(""))),
+			Name:               name,
+			BQLFile:            mustAsString(getWithDefault(mustAsMap(conf), "bql_file", data.String(""))),
+			CheckpointInterval: int(mustToInt(getWithDefault(mustAsMap(conf), "checkpoint_interval", data.Int(0)))),
 		}
 		ts[name] = t
 	}
@@ -87,7 +98,8 @@ func (ts *Topologies) ToMap() data.Map {
 	for k, v := range *ts {
 		v := v
 		m[k] = data.Map{
-			"bql_file": data.String(v.BQLFile),
+			"bql_file":            data.String(v.BQLFile),
+			"checkpoint_interval": data.Int(v.CheckpointInterval),
 		}
 	}
 	return m
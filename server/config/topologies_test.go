@@ -15,6 +15,7 @@ func TestTopologies(t *testing.T) {
 			Convey("Then it should have given parameters", func() {
 				So(ts["test1"].Name, ShouldEqual, "test1")
 				So(ts["test1"].BQLFile, ShouldEqual, "")
+				So(ts["test1"].CheckpointInterval, ShouldEqual, 0)
 				So(ts["test2"].Name, ShouldEqual, "test2")
 				So(ts["test2"].BQLFile, ShouldEqual, "/path/to/hoge.bql")
 				So(ts["test3"].Name, ShouldEqual, "test3")
@@ -57,5 +58,22 @@ func TestTopologies(t *testing.T) {
 				})
 			}
 		})
+
+		Convey("When validating checkpoint_interval", func() {
+			for _, i := range []int{0, 1, 60} {
+				Convey(fmt.Sprint("Then it should accept ", i), func() {
+					ts, err := NewTopologies(toMap(fmt.Sprintf(`{"test":{"checkpoint_interval":%v}}`, i)))
+					So(err, ShouldBeNil)
+					So(ts["test"].CheckpointInterval, ShouldEqual, i)
+				})
+			}
+
+			for _, i := range [][]interface{}{{"negative", -1}, {"non-integer", 1.5}, {"invalid type", `"1"`}} {
+				Convey(fmt.Sprintf("Then it should reject %v value", i[0]), func() {
+					_, err := NewTopologies(toMap(fmt.Sprintf(`{"test":{"checkpoint_interval":%v}}`, i[1])))
+					So(err, ShouldNotBeNil)
+				})
+			}
+		})
 	})
 }
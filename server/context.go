@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/gocraft/web"
@@ -75,6 +76,7 @@ func SetUpContextGlobalVariables(conf *config.Config) (*ContextGlobalVariables,
 		}
 	}()
 	logger.Out = w
+	logger.Formatter = conf.Logging.CreateFormatter()
 
 	closeWriter = false
 	return &ContextGlobalVariables{
@@ -134,20 +136,7 @@ func setUpTopologies(logger *logrus.Logger, r TopologyRegistry, conf *config.Con
 	stopAll := true
 	defer func() {
 		if stopAll {
-			ts, err := r.List()
-			if err != nil {
-				logger.WithField("err", err).Error("Cannot list topologies for clean up")
-				return
-			}
-
-			for name, t := range ts {
-				if err := t.Topology().Stop(); err != nil {
-					logger.WithFields(logrus.Fields{
-						"err":      err,
-						"topology": name,
-					}).Error("Cannot stop the topology")
-				}
-			}
+			StopTopologies(logger, r)
 		}
 	}()
 
@@ -192,11 +181,6 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 	}
 	tb.UDSStorage = us
 
-	bqlFilePath := conf.Topologies[name].BQLFile
-	if bqlFilePath == "" {
-		return tb, nil
-	}
-
 	shouldStop := true
 	defer func() {
 		if shouldStop {
@@ -209,6 +193,76 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 		}
 	}()
 
+	interval := conf.Topologies[name].CheckpointInterval
+	if interval > 0 {
+		cp, err := bql.LoadCheckpoint(us, name)
+		if err != nil && !core.IsNotExist(err) {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot load the checkpoint of the topology")
+			return nil, err
+		}
+		// cp is nil when no checkpoint has been saved yet
+		tb.StartRecovery(cp)
+	}
+
+	if err := runBQLFile(logger, name, tb, conf.Topologies[name].BQLFile); err != nil {
+		return nil, err
+	}
+
+	if interval > 0 {
+		if err := tb.FinishRecovery(); err != nil {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot recover the topology from the checkpoint")
+			return nil, err
+		}
+		c := bql.NewCheckpointer(tb, time.Duration(interval)*time.Second)
+		c.Start()
+		tb.SetCheckpointer(c)
+	}
+
+	shouldStop = false
+	return tb, nil
+}
+
+// stopTopology stops taking checkpoints of the topology, if enabled, and
+// stops the topology.
+func stopTopology(tb *bql.TopologyBuilder) error {
+	if c := tb.Checkpointer(); c != nil {
+		c.Stop()
+	}
+	return tb.Topology().Stop()
+}
+
+// StopTopologies stops all topologies in the registry. It's called when the
+// server is shut down.
+func StopTopologies(logger *logrus.Logger, r TopologyRegistry) {
+	ts, err := r.List()
+	if err != nil {
+		logger.WithField("err", err).Error("Cannot list topologies to be stopped")
+		return
+	}
+
+	for name, tb := range ts {
+		if err := stopTopology(tb); err != nil {
+			logger.WithFields(logrus.Fields{
+				"err":      err,
+				"topology": name,
+			}).Error("Cannot stop the topology")
+		}
+	}
+}
+
+// runBQLFile executes all statements in the BQL file. It does nothing when
+// bqlFilePath is empty.
+func runBQLFile(logger *logrus.Logger, name string, tb *bql.TopologyBuilder, bqlFilePath string) error {
+	if bqlFilePath == "" {
+		return nil
+	}
+
 	queries, err := ioutil.ReadFile(bqlFilePath)
 	if err != nil {
 		logger.WithFields(logrus.Fields{
@@ -216,14 +270,14 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 			"topology": name,
 			"path":     bqlFilePath,
 		}).Error("Cannot read a BQL file")
-		return nil, err
+		return err
 	}
 
 	// TODO: improve error handling
 	bp := parser.New()
 	stmts, err := bp.ParseStmts(string(queries))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	for _, stmt := range stmts {
@@ -233,10 +287,8 @@ func setUpTopology(logger *logrus.Logger, name string, conf *config.Config, us u
 				"topology": name,
 				"stmt":     stmt,
 			}).Error("Cannot add a statement to the topology")
-			return nil, err
+			return err
 		}
 	}
-
-	shouldStop = false
-	return tb, nil
+	return nil
 }
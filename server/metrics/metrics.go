@@ -0,0 +1,204 @@
+// Package metrics exports statistics of topologies in the Prometheus text
+// exposition format so that they can be scraped by Prometheus or other
+// monitoring systems supporting the format.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sort"
+	"strings"
+)
+
+// ContentType is the value of the Content-Type header of a response
+// containing metrics written by Write.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	inputStatsPath     = data.MustCompilePath("input_stats")
+	outputStatsPath    = data.MustCompilePath("output_stats")
+	numReceivedPath    = data.MustCompilePath("num_received_total")
+	numErrorsPath      = data.MustCompilePath("num_errors")
+	numQueuedPartsPath = data.MustCompilePath("num_queued_in_partitions")
+	inputsPath         = data.MustCompilePath("inputs")
+	numSentPath        = data.MustCompilePath("num_sent_total")
+	numDroppedPath     = data.MustCompilePath("num_dropped")
+	udfErrorsPath      = data.MustCompilePath("box.num_udf_errors")
+)
+
+// family is a set of samples having the same metric name.
+type family struct {
+	name    string
+	help    string
+	typ     string
+	samples []sample
+}
+
+type sample struct {
+	labels []string // pairs of label names and values
+	value  int64
+}
+
+func (f *family) add(v data.Value, labels ...string) {
+	if v == nil {
+		return
+	}
+	i, err := data.ToInt(v)
+	if err != nil {
+		return
+	}
+	f.samples = append(f.samples, sample{labels, i})
+}
+
+// Write writes metrics of the given topologies to w. The keys of the map are
+// the names of the topologies. Metrics having no sample aren't written.
+func Write(w io.Writer, topologies map[string]core.Topology) error {
+	var (
+		nodes = &family{name: "sensorbee_node_info", typ: "gauge",
+			help: "Information of a node in a topology. The value is always 1."}
+		received = &family{name: "sensorbee_node_received_tuples_total", typ: "counter",
+			help: "The number of tuples received by a node."}
+		errs = &family{name: "sensorbee_node_errors_total", typ: "counter",
+			help: "The number of errors that a node failed to process tuples."}
+		queuedParts = &family{name: "sensorbee_node_queued_in_partitions", typ: "gauge",
+			help: "The number of tuples waiting to be processed by partitions of a box."}
+		sent = &family{name: "sensorbee_node_sent_tuples_total", typ: "counter",
+			help: "The number of tuples sent from a node including dropped ones."}
+		dropped = &family{name: "sensorbee_node_dropped_tuples_total", typ: "counter",
+			help: "The number of tuples dropped because a node had no destination."}
+		udfErrs = &family{name: "sensorbee_udf_errors_total", typ: "counter",
+			help: "The number of errors returned from a UDF called by a box."}
+		edgeReceived = &family{name: "sensorbee_edge_received_tuples_total", typ: "counter",
+			help: "The number of tuples received through an edge."}
+		edgeQueued = &family{name: "sensorbee_edge_queued_tuples", typ: "gauge",
+			help: "The number of tuples buffered in the queue of an edge."}
+		edgeQueueSize = &family{name: "sensorbee_edge_queue_size", typ: "gauge",
+			help: "The capacity of the queue of an edge."}
+	)
+
+	for _, topName := range sortedTopologyNames(topologies) {
+		ns := topologies[topName].Nodes()
+		for _, name := range sortedNodeNames(ns) {
+			n := ns[name]
+			nodeType := n.Type().String()
+			st := n.Status()
+			labels := []string{"topology", topName, "node", name, "node_type", nodeType}
+
+			nodes.samples = append(nodes.samples, sample{
+				append(labels, "state", n.State().Get().String()), 1})
+
+			if in, ok := getMap(st, inputStatsPath); ok {
+				received.add(get(in, numReceivedPath), labels...)
+				errs.add(get(in, numErrorsPath), labels...)
+				queuedParts.add(get(in, numQueuedPartsPath), labels...)
+
+				inputs, _ := getMap(in, inputsPath)
+				for _, sender := range sortedKeys(inputs) {
+					is, err := data.AsMap(inputs[sender])
+					if err != nil {
+						continue
+					}
+					el := []string{"topology", topName, "sender", sender, "receiver", name}
+					edgeReceived.add(is["num_received"], el...)
+					edgeQueued.add(is["num_queued"], el...)
+					edgeQueueSize.add(is["queue_size"], el...)
+				}
+			}
+
+			if out, ok := getMap(st, outputStatsPath); ok {
+				sent.add(get(out, numSentPath), labels...)
+				dropped.add(get(out, numDroppedPath), labels...)
+			}
+
+			udfs, _ := getMap(st, udfErrorsPath)
+			for _, f := range sortedKeys(udfs) {
+				udfErrs.add(udfs[f], "topology", topName, "node", name, "function", f)
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, f := range []*family{nodes, received, errs, queuedParts, sent, dropped,
+		udfErrs, edgeReceived, edgeQueued, edgeQueueSize} {
+		writeFamily(bw, f)
+	}
+	return bw.Flush()
+}
+
+func writeFamily(w *bufio.Writer, f *family) {
+	if len(f.samples) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "# HELP %v %v\n", f.name, f.help)
+	fmt.Fprintf(w, "# TYPE %v %v\n", f.name, f.typ)
+	for _, s := range f.samples {
+		w.WriteString(f.name)
+		if len(s.labels) > 0 {
+			w.WriteByte('{')
+			for i := 0; i < len(s.labels); i += 2 {
+				if i > 0 {
+					w.WriteByte(',')
+				}
+				fmt.Fprintf(w, `%v="%v"`, s.labels[i], escapeLabelValue(s.labels[i+1]))
+			}
+			w.WriteByte('}')
+		}
+		fmt.Fprintf(w, " %v\n", s.value)
+	}
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(s string) string {
+	return labelValueEscaper.Replace(s)
+}
+
+func get(m data.Map, p data.Path) data.Value {
+	v, err := m.Get(p)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func getMap(m data.Map, p data.Path) (data.Map, bool) {
+	v := get(m, p)
+	if v == nil {
+		return nil, false
+	}
+	res, err := data.AsMap(v)
+	if err != nil {
+		return nil, false
+	}
+	return res, true
+}
+
+func sortedTopologyNames(m map[string]core.Topology) []string {
+	names := make([]string, 0, len(m))
+	for n := range m {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedNodeNames(m map[string]core.Node) []string {
+	names := make([]string, 0, len(m))
+	for n := range m {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
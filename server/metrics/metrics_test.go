@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type blockingSource struct {
+	num  int
+	stop chan struct{}
+}
+
+func (s *blockingSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	for i := 0; i < s.num; i++ {
+		if err := w.Write(ctx, core.NewTuple(data.Map{"i": data.Int(i)})); err != nil {
+			return err
+		}
+	}
+	<-s.stop
+	return nil
+}
+
+func (s *blockingSource) Stop(ctx *core.Context) error {
+	close(s.stop)
+	return nil
+}
+
+type statusBox struct{}
+
+func (b *statusBox) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	return w.Write(ctx, t)
+}
+
+func (b *statusBox) Status() data.Map {
+	return data.Map{
+		"num_udf_errors": data.Map{
+			"f": data.Int(2),
+		},
+	}
+}
+
+type countingSink struct {
+	wg sync.WaitGroup
+}
+
+func (s *countingSink) Write(ctx *core.Context, t *core.Tuple) error {
+	s.wg.Done()
+	return nil
+}
+
+func (s *countingSink) Close(ctx *core.Context) error {
+	return nil
+}
+
+func TestWrite(t *testing.T) {
+	Convey("Given a topology having a source, a box, and a sink", t, func() {
+		ctx := core.NewContext(nil)
+		tp, err := core.NewDefaultTopology(ctx, "test_topology")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			tp.Stop()
+		})
+
+		so, err := tp.AddSource("source", &blockingSource{num: 3, stop: make(chan struct{})}, &core.SourceConfig{
+			PausedOnStartup: true,
+		})
+		So(err, ShouldBeNil)
+		bn, err := tp.AddBox("box", &statusBox{}, nil)
+		So(err, ShouldBeNil)
+		So(bn.Input("source", &core.BoxInputConfig{Capacity: 16}), ShouldBeNil)
+		si := &countingSink{}
+		si.wg.Add(3)
+		sn, err := tp.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sn.Input("box", nil), ShouldBeNil)
+		So(so.Resume(), ShouldBeNil)
+		si.wg.Wait()
+
+		Convey("When writing its metrics", func() {
+			buf := bytes.NewBuffer(nil)
+			So(Write(buf, map[string]core.Topology{"test_topology": tp}), ShouldBeNil)
+			res := buf.String()
+
+			Convey("Then it should contain information of nodes", func() {
+				So(res, ShouldContainSubstring, "# TYPE sensorbee_node_info gauge\n")
+				So(res, ShouldContainSubstring, `sensorbee_node_info{topology="test_topology",node="box",node_type="box",state="running"} 1`)
+				So(res, ShouldContainSubstring, `sensorbee_node_info{topology="test_topology",node="source",node_type="source",state="running"} 1`)
+			})
+
+			Convey("Then it should contain statistics of nodes", func() {
+				So(res, ShouldContainSubstring, "# TYPE sensorbee_node_received_tuples_total counter\n")
+				So(res, ShouldContainSubstring, `sensorbee_node_received_tuples_total{topology="test_topology",node="sink",node_type="sink"} 3`)
+				So(res, ShouldContainSubstring, `sensorbee_node_sent_tuples_total{topology="test_topology",node="source",node_type="source"} 3`)
+				So(res, ShouldContainSubstring, `sensorbee_node_dropped_tuples_total{topology="test_topology",node="box",node_type="box"} 0`)
+				So(res, ShouldContainSubstring, `sensorbee_node_errors_total{topology="test_topology",node="box",node_type="box"} 0`)
+			})
+
+			Convey("Then it should contain statistics of edges", func() {
+				So(res, ShouldContainSubstring, `sensorbee_edge_received_tuples_total{topology="test_topology",sender="source",receiver="box"} 3`)
+				So(res, ShouldContainSubstring, `sensorbee_edge_queued_tuples{topology="test_topology",sender="box",receiver="sink"} 0`)
+				So(res, ShouldContainSubstring, `sensorbee_edge_queue_size{topology="test_topology",sender="source",receiver="box"} 16`)
+			})
+
+			Convey("Then it should contain the numbers of UDF errors", func() {
+				So(res, ShouldContainSubstring, `sensorbee_udf_errors_total{topology="test_topology",node="box",function="f"} 2`)
+			})
+
+			Convey("Then nodes should be sorted by their names", func() {
+				i := strings.Index(res, `node="box"`)
+				So(i, ShouldBeGreaterThan, 0)
+				So(i, ShouldBeLessThan, strings.Index(res, `node="sink"`))
+				So(strings.Index(res, `node="sink"`), ShouldBeLessThan, strings.Index(res, `node="source"`))
+			})
+		})
+	})
+
+	Convey("Given no topology", t, func() {
+		Convey("When writing metrics", func() {
+			buf := bytes.NewBuffer(nil)
+			So(Write(buf, nil), ShouldBeNil)
+
+			Convey("Then nothing should be written", func() {
+				So(buf.Len(), ShouldEqual, 0)
+			})
+		})
+	})
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	Convey("Given a label value having special characters", t, func() {
+		s := "a\\b\"c\nd"
+
+		Convey("When escaping it", func() {
+			e := escapeLabelValue(s)
+
+			Convey("Then they should be escaped", func() {
+				So(e, ShouldEqual, `a\\b\"c\nd`)
+			})
+		})
+	})
+}
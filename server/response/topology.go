@@ -1,21 +1,59 @@
 package response
 
 import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"time"
 )
 
 // Topology is a part of the response which topologies.show action returns.
 type Topology struct {
 	// Name is the name of the topology.
 	Name string `json:"name"`
+
+	// State is the current state of the topology. It's "paused" when all
+	// sources in the topology are paused by the topology.
+	State string `json:"state"`
+
+	// Flags has flags of the topology which can be changed at runtime.
+	Flags *TopologyFlags `json:"flags"`
+
+	// CreatedAt is the time when the topology was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time when the topology was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TopologyFlags has flags of core.ContextFlags. Names of the fields are same
+// as the corresponding parameters in the logging section of the config.
+type TopologyFlags struct {
+	TupleTrace               bool `json:"tuple_trace"`
+	LogDroppedTuples         bool `json:"log_dropped_tuples"`
+	LogDestinationlessTuples bool `json:"log_destinationless_tuples"`
+	SummarizeDroppedTuples   bool `json:"summarize_dropped_tuples"`
 }
 
 // NewTopology creates a new response of a topology.
-func NewTopology(t core.Topology) *Topology {
+func NewTopology(tb *bql.TopologyBuilder) *Topology {
+	t := tb.Topology()
 	return &Topology{
-		Name: t.Name(),
+		Name:      t.Name(),
+		State:     t.State().Get().String(),
+		Flags:     NewTopologyFlags(&t.Context().Flags),
+		CreatedAt: tb.CreatedAt(),
+		UpdatedAt: tb.UpdatedAt(),
+	}
+}
+
+// NewTopologyFlags creates a new response of flags of a topology.
+func NewTopologyFlags(f *core.ContextFlags) *TopologyFlags {
+	return &TopologyFlags{
+		TupleTrace:               f.TupleTrace.Enabled(),
+		LogDroppedTuples:         f.DroppedTupleLog.Enabled(),
+		LogDestinationlessTuples: f.DestinationlessTupleLog.Enabled(),
+		SummarizeDroppedTuples:   f.DroppedTupleSummarization.Enabled(),
 	}
 }
 
-// TODO: add created_at/updated_at
 // TODO: add other information
@@ -2,6 +2,9 @@ package server
 
 import (
 	"github.com/gocraft/web"
+	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/server/metrics"
 	"os"
 	"os/user"
 	"runtime"
@@ -21,6 +24,7 @@ type serverStatus struct {
 func setUpServerStatusRouter(prefix string, router *web.Router) {
 	root := router.Subrouter(serverStatus{}, "")
 	root.Get("/runtime_status", (*serverStatus).RuntimeStatus)
+	root.Get("/metrics", (*serverStatus).Metrics)
 }
 
 func (ss *serverStatus) RuntimeStatus(rw web.ResponseWriter, req *web.Request) {
@@ -57,3 +61,23 @@ func (ss *serverStatus) RuntimeStatus(rw web.ResponseWriter, req *web.Request) {
 	}
 	ss.Render(res)
 }
+
+// Metrics returns statistics of all topologies in the Prometheus text format.
+func (ss *serverStatus) Metrics(rw web.ResponseWriter, req *web.Request) {
+	ts, err := ss.topologies.List()
+	if err != nil {
+		ss.ErrLog(err).Error("Cannot list registered topologies")
+		ss.RenderError(jasco.NewInternalServerError(err))
+		return
+	}
+
+	tps := make(map[string]core.Topology, len(ts))
+	for name, tb := range ts {
+		tps[name] = tb.Topology()
+	}
+	rw.Header().Set("Content-Type", metrics.ContentType)
+	if err := metrics.Write(rw, tps); err != nil {
+		// The header has already been written.
+		ss.ErrLog(err).Error("Cannot write metrics")
+	}
+}
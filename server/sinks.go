@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"net/http"
@@ -18,6 +19,7 @@ func setUpSinksRouter(prefix string, router *web.Router) {
 	root.Middleware((*sinks).fetchSink)
 	root.Get("/", (*sinks).Index)
 	root.Get("/:sinkName", (*sinks).Show)
+	root.Delete("/:sinkName", (*sinks).Destroy)
 }
 
 func (sc *sinks) fetchSink(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +65,10 @@ func (sc *sinks) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
-// TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
-// done by queries.
+// Destroy drops the sink from the topology.
+func (sc *sinks) Destroy(rw web.ResponseWriter, req *web.Request) {
+	if !sc.processNodeStmt(parser.DropSinkStmt{parser.StreamIdentifier(sc.sink.Name())}) {
+		return
+	}
+	sc.Render(map[string]interface{}{})
+}
@@ -3,7 +3,9 @@ package server
 import (
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"net/http"
 )
@@ -18,6 +20,9 @@ func setUpSourcesRouter(prefix string, router *web.Router) {
 	root.Middleware((*sources).fetchSource)
 	root.Get("/", (*sources).Index)
 	root.Get("/:sourceName", (*sources).Show)
+	root.Put("/:sourceName", (*sources).Update)
+	root.Post("/:sourceName/rewind", (*sources).Rewind)
+	root.Delete("/:sourceName", (*sources).Destroy)
 }
 
 func (sc *sources) fetchSource(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +68,147 @@ func (sc *sources) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
-// TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
-// done by queries.
+// Update changes the state of the source. The request body must have "state"
+// field which is either "paused" or "running".
+func (sc *sources) Update(rw web.ResponseWriter, req *web.Request) {
+	var js map[string]interface{}
+	if apiErr := sc.ParseBody(&js); apiErr != nil {
+		sc.ErrLog(apiErr.Err).Error("Cannot parse the request json")
+		sc.RenderError(apiErr)
+		return
+	}
+
+	form, err := data.NewMap(js)
+	if err != nil {
+		sc.ErrLog(err).WithField("body", js).Error("The request json may contain invalid value")
+		sc.RenderError(jasco.NewError(formValidationErrorCode, "The request json may contain invalid values.",
+			http.StatusBadRequest, err))
+		return
+	}
+
+	v, ok := form["state"]
+	if !ok {
+		sc.Log().Error("The required 'state' field is missing")
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			http.StatusBadRequest, nil)
+		e.Meta["state"] = []string{"field is missing"}
+		sc.RenderError(e)
+		return
+	}
+	state, err := data.AsString(v)
+	if err != nil {
+		sc.ErrLog(err).Error("'state' field isn't a string")
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			http.StatusBadRequest, nil)
+		e.Meta["state"] = []string{"value must be a string"}
+		sc.RenderError(e)
+		return
+	}
+
+	var stmt interface{}
+	switch state {
+	case core.TSPaused.String():
+		stmt = parser.PauseSourceStmt{parser.StreamIdentifier(sc.src.Name())}
+	case core.TSRunning.String():
+		stmt = parser.ResumeSourceStmt{parser.StreamIdentifier(sc.src.Name())}
+	default:
+		sc.Log().WithField("state", state).Error("'state' field has an unsupported value")
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			http.StatusBadRequest, nil)
+		e.Meta["state"] = []string{"value must be 'paused' or 'running'"}
+		sc.RenderError(e)
+		return
+	}
+	if !sc.processNodeStmt(stmt) {
+		return
+	}
+	sc.Show(rw, req)
+}
+
+// Rewind rewinds the source. The source must be rewindable. The request body
+// is optional. It can have either "offset" field (an integer) or "timestamp"
+// field (a string) to rewind the source to the position instead of the
+// beginning of its stream, like the TO clause of REWIND SOURCE.
+func (sc *sources) Rewind(rw web.ResponseWriter, req *web.Request) {
+	var pos parser.RewindPositionAST
+	if req.ContentLength != 0 {
+		var js map[string]interface{}
+		if apiErr := sc.ParseBody(&js); apiErr != nil {
+			sc.ErrLog(apiErr.Err).Error("Cannot parse the request json")
+			sc.RenderError(apiErr)
+			return
+		}
+
+		form, err := data.NewMap(js)
+		if err != nil {
+			sc.ErrLog(err).WithField("body", js).Error("The request json may contain invalid value")
+			sc.RenderError(jasco.NewError(formValidationErrorCode, "The request json may contain invalid values.",
+				http.StatusBadRequest, err))
+			return
+		}
+
+		p, e := sc.parseRewindPosition(form)
+		if e != nil {
+			sc.RenderError(e)
+			return
+		}
+		pos = p
+	}
+
+	if !sc.processNodeStmt(parser.RewindSourceStmt{parser.StreamIdentifier(sc.src.Name()), pos}) {
+		return
+	}
+	sc.Show(rw, req)
+}
+
+func (sc *sources) parseRewindPosition(form data.Map) (parser.RewindPositionAST, *jasco.Error) {
+	offset, hasOffset := form["offset"]
+	timestamp, hasTimestamp := form["timestamp"]
+	newError := func(field, msg string) *jasco.Error {
+		sc.Log().WithField(field, form[field]).Errorf("'%v' field is invalid: %v", field, msg)
+		e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+			http.StatusBadRequest, nil)
+		e.Meta[field] = []string{msg}
+		return e
+	}
+
+	switch {
+	case hasOffset && hasTimestamp:
+		return parser.RewindPositionAST{}, newError("offset", "cannot be used with 'timestamp'")
+
+	case hasOffset:
+		var o int64
+		switch v := offset.(type) {
+		case data.Int:
+			o = int64(v)
+		case data.Float:
+			// JSON numbers may be decoded as floats
+			if float64(v) != float64(int64(v)) {
+				return parser.RewindPositionAST{}, newError("offset", "value must be an integer")
+			}
+			o = int64(v)
+		default:
+			return parser.RewindPositionAST{}, newError("offset", "value must be an integer")
+		}
+		if o < 0 {
+			return parser.RewindPositionAST{}, newError("offset", "value must not be negative")
+		}
+		return parser.RewindPositionAST{parser.OffsetRewindPosition, o, ""}, nil
+
+	case hasTimestamp:
+		ts, err := data.AsString(timestamp)
+		if err != nil {
+			return parser.RewindPositionAST{}, newError("timestamp", "value must be a string")
+		}
+		return parser.RewindPositionAST{parser.TimestampRewindPosition, 0, ts}, nil
+	}
+	return parser.RewindPositionAST{}, nil
+}
+
+// Destroy drops the source from the topology.
+func (sc *sources) Destroy(rw web.ResponseWriter, req *web.Request) {
+	if !sc.processNodeStmt(parser.DropSourceStmt{parser.StreamIdentifier(sc.src.Name())}) {
+		return
+	}
+	sc.Render(map[string]interface{}{})
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"github.com/gocraft/web"
 	"gopkg.in/pfnet/jasco.v1"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/server/response"
 	"net/http"
@@ -18,6 +19,7 @@ func setUpStreamsRouter(prefix string, router *web.Router) {
 	root.Middleware((*streams).fetchStream)
 	root.Get("/", (*streams).Index)
 	root.Get("/:streamName", (*streams).Show)
+	root.Delete("/:streamName", (*streams).Destroy)
 }
 
 func (sc *streams) fetchStream(rw web.ResponseWriter, req *web.Request, next web.NextMiddlewareFunc) {
@@ -63,5 +65,10 @@ func (sc *streams) Show(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
-// TODO: Support Update(e.g. pause/resume) and Destroy if necessary. They can be
-// done by queries.
+// Destroy drops the stream from the topology.
+func (sc *streams) Destroy(rw web.ResponseWriter, req *web.Request) {
+	if !sc.processNodeStmt(parser.DropStreamStmt{parser.StreamIdentifier(sc.stream.Name())}) {
+		return
+	}
+	sc.Render(map[string]interface{}{})
+}
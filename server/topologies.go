@@ -34,6 +34,7 @@ func setUpTopologiesRouter(prefix string, router *web.Router) {
 	root.Post("/", (*topologies).Create)
 	root.Get("/", (*topologies).Index)
 	root.Get(`/:topologyName`, (*topologies).Show)
+	root.Put(`/:topologyName`, (*topologies).Update)
 	root.Delete(`/:topologyName`, (*topologies).Destroy)
 	root.Post(`/:topologyName/queries`, (*topologies).Queries)
 	root.Get(`/:topologyName/wsqueries`, (*topologies).WebSocketQueries)
@@ -161,7 +162,7 @@ func (tc *topologies) Create(rw web.ResponseWriter, req *web.Request) {
 
 	// TODO: return 201
 	tc.Render(map[string]interface{}{
-		"topology": response.NewTopology(tb.Topology()),
+		"topology": response.NewTopology(tb),
 	})
 }
 
@@ -176,7 +177,7 @@ func (tc *topologies) Index(rw web.ResponseWriter, req *web.Request) {
 
 	res := []*response.Topology{}
 	for _, tb := range ts {
-		res = append(res, response.NewTopology(tb.Topology()))
+		res = append(res, response.NewTopology(tb))
 	}
 	tc.Render(map[string]interface{}{
 		"topologies": res,
@@ -190,11 +191,117 @@ func (tc *topologies) Show(rw web.ResponseWriter, req *web.Request) {
 		return
 	}
 	tc.Render(map[string]interface{}{
-		"topology": response.NewTopology(tb.Topology()),
+		"topology": response.NewTopology(tb),
 	})
 }
 
-// TODO: provide Update action (change state of the topology, etc.)
+// Update changes the state or flags of the topology. The request body can
+// have the following fields:
+//
+//	* state: "paused" to pause all sources or "running" to resume the sources
+//	         paused by the topology
+//	* flags: a map having tuple_trace, log_dropped_tuples,
+//	         log_destinationless_tuples, and summarize_dropped_tuples as
+//	         bool values
+//
+// Omitted fields aren't changed.
+func (tc *topologies) Update(rw web.ResponseWriter, req *web.Request) {
+	tb := tc.fetchTopology()
+	if tb == nil {
+		return
+	}
+
+	var js map[string]interface{}
+	if apiErr := tc.ParseBody(&js); apiErr != nil {
+		tc.ErrLog(apiErr.Err).Error("Cannot parse the request json")
+		tc.RenderError(apiErr)
+		return
+	}
+
+	form, err := data.NewMap(js)
+	if err != nil {
+		tc.ErrLog(err).WithField("body", js).Error("The request json may contain invalid value")
+		tc.RenderError(jasco.NewError(formValidationErrorCode, "The request json may contain invalid values.",
+			http.StatusBadRequest, err))
+		return
+	}
+
+	// Validate all fields before changing anything.
+	e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+		http.StatusBadRequest, nil)
+	var state string
+	if v, ok := form["state"]; ok {
+		if s, err := data.AsString(v); err != nil {
+			e.Meta["state"] = []string{"value must be a string"}
+		} else if s != core.TSPaused.String() && s != core.TSRunning.String() {
+			e.Meta["state"] = []string{"value must be 'paused' or 'running'"}
+		} else {
+			state = s
+		}
+	}
+
+	flags := &tb.Topology().Context().Flags
+	targets := map[string]*core.AtomicFlag{
+		"tuple_trace":                &flags.TupleTrace,
+		"log_dropped_tuples":         &flags.DroppedTupleLog,
+		"log_destinationless_tuples": &flags.DestinationlessTupleLog,
+		"summarize_dropped_tuples":   &flags.DroppedTupleSummarization,
+	}
+	newFlags := map[*core.AtomicFlag]bool{}
+	if v, ok := form["flags"]; ok {
+		if m, err := data.AsMap(v); err != nil {
+			e.Meta["flags"] = []string{"value must be a map"}
+		} else {
+			var errs []string
+			for k, v := range m {
+				f, ok := targets[k]
+				if !ok {
+					errs = append(errs, fmt.Sprintf("%v is not supported", k))
+					continue
+				}
+				b, err := data.AsBool(v)
+				if err != nil {
+					errs = append(errs, fmt.Sprintf("%v must be a bool", k))
+					continue
+				}
+				newFlags[f] = b
+			}
+			if len(errs) > 0 {
+				e.Meta["flags"] = errs
+			}
+		}
+	}
+	if len(e.Meta) > 0 {
+		tc.Log().Error("The request body is invalid")
+		tc.RenderError(e)
+		return
+	}
+
+	switch state {
+	case core.TSPaused.String():
+		err = tb.Topology().Pause()
+	case core.TSRunning.String():
+		err = tb.Topology().Resume()
+	}
+	if err != nil {
+		tc.ErrLog(err).Error("Cannot change the state of the topology")
+		e := jasco.NewError(formValidationErrorCode, "The state of the topology cannot be changed.",
+			http.StatusBadRequest, err)
+		e.Meta["state"] = []string{err.Error()}
+		tc.RenderError(e)
+		return
+	}
+	for f, b := range newFlags {
+		f.Set(b)
+	}
+	if state != "" || len(newFlags) > 0 {
+		tb.Touch()
+	}
+
+	tc.Render(map[string]interface{}{
+		"topology": response.NewTopology(tb),
+	})
+}
 
 func (tc *topologies) Destroy(rw web.ResponseWriter, req *web.Request) {
 	tb, err := tc.topologies.Unregister(tc.topologyName)
@@ -206,10 +313,15 @@ func (tc *topologies) Destroy(rw web.ResponseWriter, req *web.Request) {
 	}
 	stopped := true
 	if tb != nil {
-		if err := tb.Topology().Stop(); err != nil {
+		if err := stopTopology(tb); err != nil {
 			stopped = false
 			tc.ErrLog(err).Error("Cannot stop the topology")
 		}
+		if tb.Checkpointer() != nil {
+			if err := bql.DeleteCheckpoint(tb.UDSStorage, tb.Topology().Name()); err != nil && !core.IsNotExist(err) {
+				tc.ErrLog(err).Error("Cannot delete the checkpoint of the topology")
+			}
+		}
 	}
 
 	if stopped {
@@ -273,21 +385,55 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 		} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 			tc.handleEvalStmt(rw, stmt, stmtStr)
 			return
+		} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+			tc.handleExplainStmt(rw, stmt, stmtStr)
+			return
 		}
 	}
 
-	// TODO: handle this atomically
-	for _, stmt := range stmts {
-		// TODO: change the return value of AddStmt to support the new response format.
-		_, err := tb.AddStmt(stmt)
+	transactional := false
+	if v, ok := form["transactional"]; ok {
+		b, err := data.AsBool(v)
 		if err != nil {
-			tc.ErrLog(err).Error("Cannot process a statement")
+			tc.ErrLog(err).Error("'transactional' must be a boolean")
+			e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+				http.StatusBadRequest, nil)
+			e.Meta["transactional"] = []string{"'transactional' must be a boolean"}
+			tc.RenderError(e)
+			return
+		}
+		transactional = b
+	}
+
+	if transactional {
+		// TODO: change the return value of AddStmtsAtomically to support the new response format.
+		if _, err := tb.AddStmtsAtomically(stmts); err != nil {
+			var stmt interface{}
+			if e, ok := err.(*bql.StmtError); ok {
+				stmt = e.Stmt
+			}
+			tc.ErrLog(err).Error("Cannot process statements atomically")
 			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
 			e.Meta["error"] = err.Error()
-			e.Meta["statement"] = fmt.Sprint(stmt)
+			if stmt != nil {
+				e.Meta["statement"] = fmt.Sprint(stmt)
+			}
 			tc.RenderError(e)
 			return
 		}
+	} else {
+		for _, stmt := range stmts {
+			// TODO: change the return value of AddStmt to support the new response format.
+			_, err := tb.AddStmt(stmt)
+			if err != nil {
+				tc.ErrLog(err).Error("Cannot process a statement")
+				e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+				e.Meta["error"] = err.Error()
+				e.Meta["statement"] = fmt.Sprint(stmt)
+				tc.RenderError(e)
+				return
+			}
+		}
 	}
 
 	// TODO: support the new format
@@ -298,6 +444,22 @@ func (tc *topologies) Queries(rw web.ResponseWriter, req *web.Request) {
 	})
 }
 
+// processNodeStmt processes a statement built by an action of a node such as
+// a source or a stream so that the action behaves in the same way as BQL.
+// When this method returns false, it has already rendered an error and the
+// caller can just return from the action.
+func (tc *topologies) processNodeStmt(stmt interface{}) bool {
+	if _, err := tc.topology.AddStmt(stmt); err != nil {
+		tc.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = fmt.Sprint(stmt)
+		tc.RenderError(e)
+		return false
+	}
+	return true
+}
+
 func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error) {
 	// TODO: use mapstructure when parameters get too many
 	var queries string
@@ -336,6 +498,8 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 			dataReturningStmtIndex = len(stmts)
 		} else if _, ok := stmt.(parser.EvalStmt); ok {
 			dataReturningStmtIndex = len(stmts)
+		} else if _, ok := stmt.(parser.ExplainStmt); ok {
+			dataReturningStmtIndex = len(stmts)
 		}
 
 		stmts = append(stmts, stmt)
@@ -344,10 +508,10 @@ func (tc *topologies) parseQueries(form data.Map) ([]interface{}, *jasco.Error)
 
 	if dataReturningStmtIndex >= 0 {
 		if len(stmts) != 1 {
-			errMsg := "A SELECT or EVAL statement cannot be issued with other statements"
+			errMsg := "A SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			tc.Log().Error(errMsg)
 			e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, nil)
-			e.Meta["error"] = "a SELECT or EVAL statement cannot be issued with other statements"
+			e.Meta["error"] = "a SELECT, EVAL, or EXPLAIN statement cannot be issued with other statements"
 			e.Meta["statement"] = fmt.Sprint(stmts[dataReturningStmtIndex])
 			return nil, e
 		}
@@ -524,6 +688,27 @@ func (tc *topologies) handleEvalStmt(rw web.ResponseWriter, stmt parser.EvalStmt
 	})
 }
 
+func (tc *topologies) handleExplainStmt(rw web.ResponseWriter, stmt parser.ExplainStmt, stmtStr string) {
+	tb := tc.fetchTopology()
+	if tb == nil { // just in case
+		return
+	}
+
+	plan, err := tb.RunExplainStmt(&stmt)
+	if err != nil {
+		tc.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		tc.RenderError(e)
+		return
+	}
+
+	tc.Render(map[string]interface{}{
+		"plan": plan,
+	})
+}
+
 // WebSocketQueries handles requests using WebSocket. A single WebSocket
 // connection can concurrently issue multiple requests including requests
 // containing a SELECT statement.
@@ -696,6 +881,19 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 		stmts = ss
 	}
 
+	transactional := false
+	if v, ok := payload["transactional"]; ok {
+		b, err := data.AsBool(v)
+		if err != nil {
+			w.ErrLog(err).Error("'transactional' must be a boolean")
+			e := jasco.NewError(formValidationErrorCode, "The request body is invalid.",
+				http.StatusBadRequest, nil)
+			e.Meta["transactional"] = []string{"'transactional' must be a boolean"}
+			return w.sendErr(e)
+		}
+		transactional = b
+	}
+
 	// Although these requests may fail asynchronously, the connect is probably
 	// still alive and next processWebSocketMessage can detect disconnection.
 	// So, the following code block always returns true.
@@ -711,21 +909,41 @@ func (tc *topologies) processWebSocketMessage(conn *websocket.Conn, tb *bql.Topo
 			} else if stmt, ok := stmts[0].(parser.EvalStmt); ok {
 				w.handleEvalStmtWebSocket(conn, stmt, stmtStr)
 				return
+			} else if stmt, ok := stmts[0].(parser.ExplainStmt); ok {
+				w.handleExplainStmtWebSocket(conn, stmt, stmtStr)
+				return
 			}
 		}
 
-		// TODO: handle this atomically
-		for _, stmt := range stmts {
-			// TODO: change the return value of AddStmt to support the new response format.
-			_, err = tb.AddStmt(stmt)
-			if err != nil {
-				w.ErrLog(err).Error("Cannot process a statement")
+		if transactional {
+			// TODO: change the return value of AddStmtsAtomically to support the new response format.
+			if _, err := tb.AddStmtsAtomically(stmts); err != nil {
+				var stmt interface{}
+				if e, ok := err.(*bql.StmtError); ok {
+					stmt = e.Stmt
+				}
+				w.ErrLog(err).Error("Cannot process statements atomically")
 				e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
 				e.Meta["error"] = err.Error()
-				e.Meta["statement"] = fmt.Sprint(stmt)
+				if stmt != nil {
+					e.Meta["statement"] = fmt.Sprint(stmt)
+				}
 				w.sendErr(e)
 				return
 			}
+		} else {
+			for _, stmt := range stmts {
+				// TODO: change the return value of AddStmt to support the new response format.
+				_, err := tb.AddStmt(stmt)
+				if err != nil {
+					w.ErrLog(err).Error("Cannot process a statement")
+					e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+					e.Meta["error"] = err.Error()
+					e.Meta["statement"] = fmt.Sprint(stmt)
+					w.sendErr(e)
+					return
+				}
+			}
 		}
 
 		// TODO: define a proper response format
@@ -876,3 +1094,27 @@ func (w *webSocketTopologyQueryHandler) handleEvalStmtWebSocket(conn *websocket.
 		return
 	}
 }
+
+func (w *webSocketTopologyQueryHandler) handleExplainStmtWebSocket(conn *websocket.Conn, stmt parser.ExplainStmt, stmtStr string) {
+	tb := w.tc.fetchTopology()
+	if tb == nil { // just in case
+		return
+	}
+
+	plan, err := tb.RunExplainStmt(&stmt)
+	if err != nil {
+		w.ErrLog(err).Error("Cannot process a statement")
+		e := jasco.NewError(bqlStmtProcessingErrorCode, "Cannot process a statement", http.StatusBadRequest, err)
+		e.Meta["error"] = err.Error()
+		e.Meta["statement"] = stmtStr
+		w.sendErr(e)
+		return
+	}
+
+	if err := w.send("plan", map[string]interface{}{
+		"plan": plan,
+	}); err != nil {
+		w.ErrLog(err).Error("Cannot send data to the WebSocket client")
+		return
+	}
+}
@@ -24,7 +24,8 @@ type fsUDSStorage struct {
 }
 
 var (
-	_ udf.UDSStorage = &fsUDSStorage{}
+	_ udf.UDSStorage        = &fsUDSStorage{}
+	_ udf.UDSStorageDeleter = &fsUDSStorage{}
 )
 
 func NewFS(dir, tempDir string) (udf.UDSStorage, error) {
@@ -91,6 +92,22 @@ func (s *fsUDSStorage) Load(topology, state, tag string) (io.ReadCloser, error)
 	return f, nil
 }
 
+func (s *fsUDSStorage) Delete(topology, state, tag string) error {
+	if tag == "" || strings.ToLower(tag) == "default" {
+		tag = "default"
+	} else if err := core.ValidateSymbol(tag); err != nil {
+		return err
+	}
+
+	if err := os.Remove(s.stateFilepath(topology, state, tag)); err != nil {
+		if os.IsNotExist(err) {
+			return core.NotExistError(err)
+		}
+		return err
+	}
+	return nil
+}
+
 var (
 	fsUDSStorageFilePathRegexp = regexp.MustCompile(`^(.+)-(.+)-(.+).state$`)
 )